@@ -76,10 +76,49 @@ type AddonSpec struct {
 	// NeedsPKI determines if channels should provision a CA and a cert-manager issuer for the addon.
 	NeedsPKI bool `json:"needsPKI,omitempty"`
 
+	// PKISecretName is an existing Secret in kube-system holding the CA that backs the cert-manager issuer of the addon.
+	// If empty, channels provisions a CA for the addon.
+	PKISecretName string `json:"pkiSecretName,omitempty"`
+
 	Version string `json:"version,omitempty"`
 
 	// PruneSpec specifies how old objects should be removed (pruned).
 	Prune *PruneSpec `json:"prune,omitempty"`
+
+	// Adopt determines if channels should take over objects of the addon which were installed outside of kOps.
+	// Workloads whose selector differs from the manifest are replaced.
+	Adopt bool `json:"adopt,omitempty"`
+
+	// Chart is a Helm chart rendered and applied instead of the manifest.
+	// Charts are re-applied each time the channel is applied, correcting drift.
+	Chart *ChartSpec `json:"chart,omitempty"`
+}
+
+// ChartSpec specifies a Helm chart.
+type ChartSpec struct {
+	// Repository is the URL of the chart repository, either an https:// chart repository or an oci:// registry.
+	Repository string `json:"repository,omitempty"`
+
+	// Chart is the name of the chart in the repository.
+	Chart string `json:"chart,omitempty"`
+
+	// Version is the version of the chart.
+	Version string `json:"version,omitempty"`
+
+	// Values are the values of the chart, in YAML.
+	Values string `json:"values,omitempty"`
+
+	// ValuesFrom lists Secrets in the namespace of the addon holding values, merged in order over Values.
+	ValuesFrom []ChartValuesSource `json:"valuesFrom,omitempty"`
+}
+
+// ChartValuesSource references values of a Helm chart stored in a Secret.
+type ChartValuesSource struct {
+	// SecretName is the name of the Secret.
+	SecretName string `json:"secretName,omitempty"`
+
+	// Key is the key of the Secret holding the values.
+	Key string `json:"key,omitempty"`
 }
 
 // PruneSpec specifies how old objects should be removed (pruned).
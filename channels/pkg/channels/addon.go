@@ -118,7 +118,7 @@ func (a *Addon) GetRequiredUpdates(ctx context.Context, k8sClient kubernetes.Int
 	pkiInstalled := true
 
 	if a.Spec.NeedsPKI {
-		needsPKI, err := channel.IsPKIInstalled(ctx, k8sClient, cmClient)
+		needsPKI, err := channel.IsPKIInstalled(ctx, k8sClient, cmClient, a.pkiSecretName())
 		if err != nil {
 			return nil, err
 		}
@@ -126,7 +126,12 @@ func (a *Addon) GetRequiredUpdates(ctx context.Context, k8sClient kubernetes.Int
 	}
 
 	if existingVersion != nil && !newVersion.replaces(a.Name, existingVersion) {
-		newVersion = nil
+		if a.Spec.Chart == nil {
+			newVersion = nil
+		} else {
+			// Charts are re-applied to correct any drift of their objects
+			klog.V(2).Infof("re-applying chart of addon %q", a.Name)
+		}
 	}
 
 	if pkiInstalled && newVersion == nil {
@@ -184,17 +189,41 @@ func (a *Addon) EnsureUpdated(ctx context.Context, vfsContext *vfs.VFSContext, k
 }
 
 func (a *Addon) updateAddon(ctx context.Context, k8sClient kubernetes.Interface, vfsContext *vfs.VFSContext, pruner *Pruner, applier Applier, required *AddonUpdate) error {
-	manifestURL, err := a.GetManifestFullUrl()
-	if err != nil {
-		return err
-	}
+	var source string
+	var data []byte
+	if a.Spec.Chart != nil {
+		source = chartSource(a.Spec.Chart)
+		klog.Infof("Applying chart %q", source)
+
+		if err := ensureNamespace(ctx, k8sClient, a.GetNamespace()); err != nil {
+			return err
+		}
+		manifest, err := a.renderChart(ctx, k8sClient, pruner.RESTMapper)
+		if err != nil {
+			return err
+		}
+		data = manifest
+	} else {
+		manifestURL, err := a.GetManifestFullUrl()
+		if err != nil {
+			return err
+		}
+		source = manifestURL.String()
 
-	klog.Infof("Applying update from %q", manifestURL)
+		klog.Infof("Applying update from %q", manifestURL)
 
-	// We copy the manifest to a temp file because it is likely e.g. an s3 URL, which kubectl can't read
-	data, err := vfsContext.ReadFile(manifestURL.String())
-	if err != nil {
-		return fmt.Errorf("error reading manifest: %w", err)
+		// We copy the manifest to a temp file because it is likely e.g. an s3 URL, which kubectl can't read
+		manifest, err := vfsContext.ReadFile(manifestURL.String())
+		if err != nil {
+			return fmt.Errorf("error reading manifest: %w", err)
+		}
+		data = manifest
+	}
+
+	if a.Spec.Adopt {
+		if err := pruner.Adopt(ctx, a.Name, data); err != nil {
+			return fmt.Errorf("error adopting addon from %q: %w", source, err)
+		}
 	}
 
 	var merr error
@@ -219,7 +248,11 @@ func (a *Addon) updateAddon(ctx context.Context, k8sClient kubernetes.Interface,
 	}
 
 	if merr != nil {
-		return fmt.Errorf("error updating addon from %q: %w", manifestURL, merr)
+		return fmt.Errorf("error updating addon from %q: %w", source, merr)
+	}
+
+	if err := pruner.PruneInventory(ctx, k8sClient, a.Name, data); err != nil {
+		return fmt.Errorf("error pruning objects removed from addon %q: %w", a.Name, err)
 	}
 
 	if err := a.AddNeedsUpdateLabel(ctx, k8sClient, required); err != nil {
@@ -227,7 +260,7 @@ func (a *Addon) updateAddon(ctx context.Context, k8sClient kubernetes.Interface,
 	}
 
 	channel := a.buildChannel()
-	err = channel.SetInstalledVersion(ctx, k8sClient, a.ChannelVersion())
+	err := channel.SetInstalledVersion(ctx, k8sClient, a.ChannelVersion())
 	if err != nil {
 		return fmt.Errorf("error applying annotation to record addon installation: %v", err)
 	}
@@ -279,8 +312,62 @@ func (a *Addon) patchNeedsUpdateLabel(ctx context.Context, k8sClient kubernetes.
 	return nil
 }
 
+// pkiSecretName is the name of the secret holding the CA backing the issuer of the addon
+func (a *Addon) pkiSecretName() string {
+	if a.Spec.PKISecretName != "" {
+		return a.Spec.PKISecretName
+	}
+	return a.Name + "-ca"
+}
+
 func (a *Addon) installPKI(ctx context.Context, k8sClient kubernetes.Interface, cmClient certmanager.Interface) error {
 	klog.Infof("installing PKI for %q", a.Name)
+	secretName := a.pkiSecretName()
+	if a.Spec.PKISecretName == "" {
+		if err := a.installPKISecret(ctx, k8sClient, secretName); err != nil {
+			return err
+		}
+	}
+
+	issuer := &cmv1.Issuer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.Name,
+			Namespace: "kube-system",
+		},
+		Spec: cmv1.IssuerSpec{
+			IssuerConfig: cmv1.IssuerConfig{
+				CA: &cmv1.CAIssuer{
+					SecretName: secretName,
+				},
+			},
+		},
+	}
+
+	issuers := cmClient.CertmanagerV1().Issuers("kube-system")
+	_, err := issuers.Create(ctx, issuer, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// The issuer may be backed by another CA, if the CA of the addon changed
+	existing, err := issuers.Get(ctx, a.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if existing.Spec.CA != nil && existing.Spec.CA.SecretName == secretName {
+		return nil
+	}
+	klog.Infof("updating issuer for %q to use CA secret %q", a.Name, secretName)
+	existing.Spec = issuer.Spec
+	_, err = issuers.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// installPKISecret provisions a CA for the addon
+func (a *Addon) installPKISecret(ctx context.Context, k8sClient kubernetes.Interface, secretName string) error {
 	req := &pki.IssueCertRequest{
 		Type: "ca",
 		Subject: pkix.Name{
@@ -295,8 +382,6 @@ func (a *Addon) installPKI(ctx context.Context, k8sClient kubernetes.Interface,
 		return err
 	}
 
-	secretName := a.Name + "-ca"
-
 	certString, err := cert.AsString()
 	if err != nil {
 		return err
@@ -320,24 +405,5 @@ func (a *Addon) installPKI(ctx context.Context, k8sClient kubernetes.Interface,
 	if err != nil && !errors.IsAlreadyExists(err) {
 		return err
 	}
-
-	issuer := &cmv1.Issuer{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      a.Name,
-			Namespace: "kube-system",
-		},
-		Spec: cmv1.IssuerSpec{
-			IssuerConfig: cmv1.IssuerConfig{
-				CA: &cmv1.CAIssuer{
-					SecretName: secretName,
-				},
-			},
-		},
-	}
-
-	_, err = cmClient.CertmanagerV1().Issuers("kube-system").Create(ctx, issuer, metav1.CreateOptions{})
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return err
-	}
 	return nil
 }
@@ -21,8 +21,10 @@ import (
 	"testing"
 
 	"github.com/blang/semver/v4"
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	fakecertmanager "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakekubernetes "k8s.io/client-go/kubernetes/fake"
@@ -363,3 +365,69 @@ func Test_InstallPKI(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func Test_InstallPKIWithCASecret(t *testing.T) {
+	ctx := context.Background()
+	fakek8s := fakekubernetes.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubernetes-ca",
+				Namespace: "kube-system",
+			},
+		},
+	)
+	fakecm := fakecertmanager.NewSimpleClientset(
+		&cmv1.Issuer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "kube-system",
+			},
+			Spec: cmv1.IssuerSpec{
+				IssuerConfig: cmv1.IssuerConfig{
+					CA: &cmv1.CAIssuer{SecretName: "test-ca"},
+				},
+			},
+		},
+	)
+	addon := &Addon{
+		Name: "test",
+		Spec: &api.AddonSpec{
+			Name:          fi.PtrTo("test"),
+			NeedsPKI:      true,
+			PKISecretName: "kubernetes-ca",
+		},
+	}
+	channel := addon.buildChannel()
+
+	isInstalled, err := channel.IsPKIInstalled(ctx, fakek8s, fakecm, addon.pkiSecretName())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if isInstalled {
+		t.Error("claims PKI installed when the issuer is backed by another CA")
+	}
+
+	err = addon.installPKI(ctx, fakek8s, fakecm)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := fakek8s.CoreV1().Secrets("kube-system").Get(ctx, "test-ca", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("expected no CA to be provisioned for the addon, got %v", err)
+	}
+	issuer, err := fakecm.CertmanagerV1().Issuers("kube-system").Get(ctx, "test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issuer.Spec.CA == nil || issuer.Spec.CA.SecretName != "kubernetes-ca" {
+		t.Errorf("expected issuer to be backed by secret %q, got %v", "kubernetes-ca", issuer.Spec.CA)
+	}
+
+	isInstalled, err = channel.IsPKIInstalled(ctx, fakek8s, fakecm, addon.pkiSecretName())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !isInstalled {
+		t.Error("claims PKI is not installed when it is")
+	}
+}
@@ -156,8 +156,9 @@ func (c *Channel) GetInstalledVersion(ctx context.Context, k8sClient kubernetes.
 	return ParseChannelVersion(annotationValue)
 }
 
-func (c *Channel) IsPKIInstalled(ctx context.Context, k8sClient kubernetes.Interface, cmClient certmanager.Interface) (bool, error) {
-	_, err := k8sClient.CoreV1().Secrets("kube-system").Get(ctx, c.Name+"-ca", metav1.GetOptions{})
+// IsPKIInstalled checks that the CA secret and the issuer of the channel exist, and that the issuer is backed by the CA secret.
+func (c *Channel) IsPKIInstalled(ctx context.Context, k8sClient kubernetes.Interface, cmClient certmanager.Interface, secretName string) (bool, error) {
+	_, err := k8sClient.CoreV1().Secrets("kube-system").Get(ctx, secretName, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		return false, nil
 	}
@@ -165,13 +166,16 @@ func (c *Channel) IsPKIInstalled(ctx context.Context, k8sClient kubernetes.Inter
 		return true, err
 	}
 
-	_, err = cmClient.CertmanagerV1().Issuers("kube-system").Get(ctx, c.Name, metav1.GetOptions{})
+	issuer, err := cmClient.CertmanagerV1().Issuers("kube-system").Get(ctx, c.Name, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		return false, nil
 	}
 	if err != nil {
 		return true, err
 	}
+	if issuer.Spec.CA != nil && issuer.Spec.CA.SecretName != secretName {
+		return false, nil
+	}
 
 	return true, nil
 }
@@ -39,7 +39,7 @@ func Test_IsPKIInstalled(t *testing.T) {
 	channel := &Channel{
 		Name: "test",
 	}
-	isInstalled, err := channel.IsPKIInstalled(ctx, fakek8s, fakecm)
+	isInstalled, err := channel.IsPKIInstalled(ctx, fakek8s, fakecm, "test-ca")
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -69,7 +69,7 @@ func Test_IsPKIInstalled(t *testing.T) {
 		},
 	)
 
-	isInstalled, err = channel.IsPKIInstalled(ctx, fakek8s, fakecm)
+	isInstalled, err = channel.IsPKIInstalled(ctx, fakek8s, fakecm, "test-ca")
 	if err != nil {
 		t.Errorf("unexpected error: %v", err)
 	}
@@ -0,0 +1,426 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/getter"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/channels/pkg/api"
+	"k8s.io/kops/pkg/kubemanifest"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// defaultValuesKey is the key of a values Secret read when no key is specified
+	defaultValuesKey = "values.yaml"
+	// helmHookAnnotation marks the objects of a chart which are only run by Helm itself
+	helmHookAnnotation = "helm.sh/hook"
+)
+
+// chartSource describes the chart of the addon, for logging
+func chartSource(spec *api.ChartSpec) string {
+	return strings.TrimSuffix(spec.Repository, "/") + "/" + spec.Chart + "@" + spec.Version
+}
+
+// renderChart fetches the chart of the addon and renders it into a manifest
+func (a *Addon) renderChart(ctx context.Context, k8sClient kubernetes.Interface, restMapper meta.RESTMapper) ([]byte, error) {
+	spec := a.Spec.Chart
+
+	values, err := chartValues(ctx, k8sClient, a.GetNamespace(), spec)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := fetchChart(spec)
+	if err != nil {
+		return nil, err
+	}
+	chrt, err := loader.LoadArchive(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("error loading chart %q: %w", chartSource(spec), err)
+	}
+
+	caps := chartutil.DefaultCapabilities.Copy()
+	serverVersion, err := k8sClient.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubernetes version: %w", err)
+	}
+	caps.KubeVersion = chartutil.KubeVersion{
+		Version: serverVersion.GitVersion,
+		Major:   serverVersion.Major,
+		Minor:   serverVersion.Minor,
+	}
+
+	objects, err := renderChartObjects(chrt, a.Name, a.GetNamespace(), values, caps)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering chart %q: %w", chartSource(spec), err)
+	}
+	if err := labelAndNamespaceObjects(objects, a.Name, a.GetNamespace(), restMapper); err != nil {
+		return nil, err
+	}
+	return objects.ToYAML()
+}
+
+// chartValues reads the values of the chart, merging the values from Secrets in order over the inline values
+func chartValues(ctx context.Context, k8sClient kubernetes.Interface, namespace string, spec *api.ChartSpec) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if spec.Values != "" {
+		if err := yaml.Unmarshal([]byte(spec.Values), &values); err != nil {
+			return nil, fmt.Errorf("error parsing values of chart %q: %w", chartSource(spec), err)
+		}
+	}
+
+	for _, from := range spec.ValuesFrom {
+		secret, err := k8sClient.CoreV1().Secrets(namespace).Get(ctx, from.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error reading values of chart %q from secret %s/%s: %w", chartSource(spec), namespace, from.SecretName, err)
+		}
+		key := from.Key
+		if key == "" {
+			key = defaultValuesKey
+		}
+		data, found := secret.Data[key]
+		if !found {
+			return nil, fmt.Errorf("secret %s/%s does not have key %q holding values of chart %q", namespace, from.SecretName, key, chartSource(spec))
+		}
+		secretValues := map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &secretValues); err != nil {
+			return nil, fmt.Errorf("error parsing values of chart %q from secret %s/%s: %w", chartSource(spec), namespace, from.SecretName, err)
+		}
+		values = mergeValues(values, secretValues)
+	}
+
+	return values, nil
+}
+
+// mergeValues merges b over a, the way helm merges values files
+func mergeValues(a, b map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		if v, ok := v.(map[string]interface{}); ok {
+			if av, ok := out[k].(map[string]interface{}); ok {
+				out[k] = mergeValues(av, v)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// fetchChart downloads the chart archive.
+// Chart versions are immutable, so archives of charts from HTTP repositories are cached across runs under their digest,
+// which is verified before a cached archive is used. Archives of OCI charts are not cached.
+func fetchChart(spec *api.ChartSpec) ([]byte, error) {
+	getters := getter.All(cli.New())
+
+	if strings.HasPrefix(spec.Repository, "oci://") {
+		b, err := download(strings.TrimSuffix(spec.Repository, "/")+"/"+spec.Chart+":"+spec.Version, getters)
+		if err != nil {
+			return nil, fmt.Errorf("error downloading chart %q: %w", chartSource(spec), err)
+		}
+		return b, nil
+	}
+
+	chartURL, digest, err := findChartURL(spec, getters)
+	if err != nil {
+		return nil, fmt.Errorf("error finding chart %q: %w", chartSource(spec), err)
+	}
+
+	cacheDir, err := chartCacheDir()
+	if err != nil {
+		klog.Warningf("not caching chart %q: %v", chartSource(spec), err)
+	}
+	var cachePath string
+	if cacheDir != "" && digest != "" {
+		cachePath = filepath.Join(cacheDir, digest+".tgz")
+		if b, err := os.ReadFile(cachePath); err == nil {
+			if verifyDigest(b, digest) == nil {
+				return b, nil
+			}
+			klog.Warningf("ignoring cached archive of chart %q not matching its digest", chartSource(spec))
+		}
+	}
+
+	b, err := download(chartURL, getters)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading chart %q: %w", chartSource(spec), err)
+	}
+	if digest != "" {
+		if err := verifyDigest(b, digest); err != nil {
+			return nil, fmt.Errorf("error verifying chart %q: %w", chartSource(spec), err)
+		}
+	}
+
+	if cachePath != "" {
+		if err := writeCachedChart(cachePath, b); err != nil {
+			klog.Warningf("unable to cache chart %q: %v", chartSource(spec), err)
+		}
+	}
+	return b, nil
+}
+
+// chartCacheDir returns the directory caching chart archives, in the cache directory of the user.
+// The directory is created private to the user, and is not used if anyone else could write to it.
+func chartCacheDir() (string, error) {
+	userCacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(userCacheDir, "kops", "channels-charts")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("%s is not a directory", dir)
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		return "", fmt.Errorf("%s is accessible by other users (mode %v)", dir, info.Mode().Perm())
+	}
+	return dir, nil
+}
+
+// writeCachedChart writes the archive to the cache atomically, so concurrent runs never read a partial archive
+func writeCachedChart(cachePath string, b []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(cachePath), ".chart-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), cachePath)
+}
+
+// verifyDigest checks the archive matches the sha256 digest of the chart in the repository index
+func verifyDigest(b []byte, digest string) error {
+	hash := sha256.Sum256(b)
+	if actual := hex.EncodeToString(hash[:]); actual != digest {
+		return fmt.Errorf("digest %s of archive does not match expected digest %s", actual, digest)
+	}
+	return nil
+}
+
+// chartIndex is the part of the index.yaml of a chart repository which locates the archives of the charts
+type chartIndex struct {
+	Entries map[string][]chartIndexEntry `json:"entries"`
+}
+
+type chartIndexEntry struct {
+	Version string   `json:"version"`
+	URLs    []string `json:"urls"`
+	Digest  string   `json:"digest"`
+}
+
+// findChartURL finds the URL and sha256 digest of the archive of the chart version in the index of its repository.
+// URLs relative to the repository are resolved, as helm does. The digest is empty if the index doesn't record it.
+func findChartURL(spec *api.ChartSpec, getters getter.Providers) (string, string, error) {
+	repository := strings.TrimSuffix(spec.Repository, "/") + "/"
+	b, err := download(repository+"index.yaml", getters)
+	if err != nil {
+		return "", "", err
+	}
+	index := &chartIndex{}
+	if err := yaml.Unmarshal(b, index); err != nil {
+		return "", "", fmt.Errorf("error parsing index of repository %q: %w", spec.Repository, err)
+	}
+
+	for _, entry := range index.Entries[spec.Chart] {
+		if entry.Version != spec.Version || len(entry.URLs) == 0 {
+			continue
+		}
+		base, err := url.Parse(repository)
+		if err != nil {
+			return "", "", fmt.Errorf("error parsing repository URL %q: %w", spec.Repository, err)
+		}
+		u, err := base.Parse(entry.URLs[0])
+		if err != nil {
+			return "", "", fmt.Errorf("error parsing chart URL %q: %w", entry.URLs[0], err)
+		}
+		digest := strings.ToLower(strings.TrimPrefix(entry.Digest, "sha256:"))
+		if b, err := hex.DecodeString(digest); err != nil || (digest != "" && len(b) != sha256.Size) {
+			return "", "", fmt.Errorf("invalid digest %q of chart %q in repository %q", entry.Digest, spec.Chart, spec.Repository)
+		}
+		return u.String(), digest, nil
+	}
+	return "", "", fmt.Errorf("version %q of chart %q not found in repository %q", spec.Version, spec.Chart, spec.Repository)
+}
+
+// download gets the content at a URL with the helm getter of its scheme
+func download(rawURL string, getters getter.Providers) ([]byte, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing URL %q: %w", rawURL, err)
+	}
+	g, err := getters.ByScheme(u.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := g.Get(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderChartObjects renders the CRDs and templates of the chart.
+// Hooks are skipped, as they are only run by Helm itself.
+func renderChartObjects(chrt *chart.Chart, releaseName string, namespace string, values map[string]interface{}, caps *chartutil.Capabilities) (kubemanifest.ObjectList, error) {
+	options := chartutil.ReleaseOptions{
+		Name:      releaseName,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
+	}
+	renderValues, err := chartutil.ToRenderValues(chrt, values, options, caps)
+	if err != nil {
+		return nil, err
+	}
+	files, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []string
+	for _, crd := range chrt.CRDObjects() {
+		manifests = append(manifests, string(crd.File.Data))
+	}
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if path.Base(name) == "NOTES.txt" {
+			continue
+		}
+		manifests = append(manifests, files[name])
+	}
+
+	var objects kubemanifest.ObjectList
+	for _, manifest := range manifests {
+		parsed, err := kubemanifest.LoadObjectsFrom([]byte(manifest))
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range parsed {
+			if _, found := object.ToUnstructured().GetAnnotations()[helmHookAnnotation]; found {
+				klog.Infof("skipping helm hook %s %q", object.Kind(), object.GetName())
+				continue
+			}
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+// labelAndNamespaceObjects labels the objects as part of the addon, so they can be pruned,
+// and sets the namespace of namespaced objects which do not specify one, as helm does.
+func labelAndNamespaceObjects(objects kubemanifest.ObjectList, addonName string, namespace string, restMapper meta.RESTMapper) error {
+	// Custom resources of the chart may not be served yet, so their scope is taken from the CRDs of the chart
+	namespaced := make(map[schema.GroupKind]bool)
+	for _, object := range objects {
+		if object.GroupVersionKind().GroupKind() != (schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}) {
+			continue
+		}
+		crd := object.ToUnstructured()
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+		namespaced[schema.GroupKind{Group: group, Kind: kind}] = scope == "Namespaced"
+	}
+
+	for _, object := range objects {
+		u := object.ToUnstructured()
+
+		labels := u.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[addonNameLabel] = addonName
+		u.SetLabels(labels)
+
+		if u.GetNamespace() != "" {
+			continue
+		}
+		gvk := object.GroupVersionKind()
+		isNamespaced, found := namespaced[gvk.GroupKind()]
+		if !found {
+			mapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+			if err != nil {
+				return fmt.Errorf("unable to find resource for %s: %w", gvk, err)
+			}
+			isNamespaced = mapping.Scope.Name() == meta.RESTScopeNameNamespace
+		}
+		if isNamespaced {
+			u.SetNamespace(namespace)
+		}
+	}
+	return nil
+}
+
+// ensureNamespace creates the namespace of a chart, as helm install --create-namespace does
+func ensureNamespace(ctx context.Context, k8sClient kubernetes.Interface, name string) error {
+	_, err := k8sClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("error getting namespace %q: %w", name, err)
+	}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if _, err := k8sClient.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("error creating namespace %q: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/getter"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kops/channels/pkg/api"
+)
+
+func testChart() *chart.Chart {
+	return &chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       "podinfo",
+			Version:    "6.7.1",
+		},
+		Values: map[string]interface{}{
+			"replicas": 1,
+			"image":    map[string]interface{}{"repository": "ghcr.io/stefanprodan/podinfo", "tag": "6.7.1"},
+		},
+		Templates: []*chart.File{
+			{
+				Name: "templates/deployment.yaml",
+				Data: []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - name: podinfo
+        image: {{ .Values.image.repository }}:{{ .Values.image.tag }}
+`),
+			},
+			{
+				Name: "templates/clusterrole.yaml",
+				Data: []byte(`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: {{ .Release.Name }}
+`),
+			},
+			{
+				Name: "templates/widget.yaml",
+				Data: []byte(`apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: {{ .Release.Name }}
+`),
+			},
+			{
+				Name: "templates/test.yaml",
+				Data: []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: {{ .Release.Name }}-test
+  annotations:
+    helm.sh/hook: test
+`),
+			},
+			{
+				Name: "templates/NOTES.txt",
+				Data: []byte(`Installed {{ .Release.Name }}`),
+			},
+		},
+		Files: []*chart.File{
+			{
+				Name: "crds/widgets.yaml",
+				Data: []byte(`apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+  scope: Namespaced
+`),
+			},
+		},
+	}
+}
+
+func Test_RenderChartObjects(t *testing.T) {
+	values := map[string]interface{}{"replicas": 3}
+	objects, err := renderChartObjects(testChart(), "podinfo.example.com", "podinfo", values, chartutil.DefaultCapabilities)
+	if err != nil {
+		t.Fatalf("unexpected error rendering chart: %v", err)
+	}
+
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	restMapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+	restMapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, meta.RESTScopeRoot)
+	if err := labelAndNamespaceObjects(objects, "podinfo.example.com", "podinfo", restMapper); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var actual []string
+	for _, object := range objects {
+		u := object.ToUnstructured()
+		if u.GetLabels()[addonNameLabel] != "podinfo.example.com" {
+			t.Errorf("expected %s %q to be labelled as part of the addon", u.GetKind(), u.GetName())
+		}
+		actual = append(actual, u.GetKind()+"/"+u.GetNamespace()+"/"+u.GetName())
+	}
+	expected := []string{
+		"CustomResourceDefinition//widgets.example.com",
+		"ClusterRole//podinfo.example.com",
+		"Deployment/podinfo/podinfo.example.com",
+		"Widget/podinfo/podinfo.example.com",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected objects %v, got %v", expected, actual)
+	}
+
+	deploymentSpec := &appsv1.DeploymentSpec{}
+	if err := objects[2].Reparse(deploymentSpec, "spec"); err != nil {
+		t.Fatalf("error parsing deployment: %v", err)
+	}
+	if replicas := deploymentSpec.Replicas; replicas == nil || *replicas != 3 {
+		t.Errorf("expected the values to set 3 replicas, got %v", replicas)
+	}
+}
+
+func Test_ChartValues(t *testing.T) {
+	ctx := context.Background()
+	fakek8s := fakekubernetes.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo-values", Namespace: "podinfo"},
+			Data: map[string][]byte{
+				"values.yaml": []byte("image:\n  tag: 6.7.2\n"),
+				"replicas":    []byte("replicas: 5\n"),
+			},
+		},
+	)
+
+	spec := &api.ChartSpec{
+		Repository: "oci://ghcr.io/stefanprodan/charts",
+		Chart:      "podinfo",
+		Version:    "6.7.1",
+		Values:     "replicas: 2\nimage:\n  repository: registry.example.com/podinfo\n  tag: 6.7.1\n",
+		ValuesFrom: []api.ChartValuesSource{
+			{SecretName: "podinfo-values"},
+			{SecretName: "podinfo-values", Key: "replicas"},
+		},
+	}
+	values, err := chartValues(ctx, fakek8s, "podinfo", spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{
+		"replicas": float64(5),
+		"image": map[string]interface{}{
+			"repository": "registry.example.com/podinfo",
+			"tag":        "6.7.2",
+		},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected values %v, got %v", expected, values)
+	}
+
+	spec.ValuesFrom = []api.ChartValuesSource{{SecretName: "podinfo-values", Key: "missing"}}
+	if _, err := chartValues(ctx, fakek8s, "podinfo", spec); err == nil {
+		t.Errorf("expected error reading a missing key")
+	}
+}
+
+func Test_FindChartURL(t *testing.T) {
+	index := `apiVersion: v1
+entries:
+  podinfo:
+  - version: 6.7.1
+    urls:
+    - podinfo-6.7.1.tgz
+    digest: 0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef
+  - version: 6.7.0
+    urls:
+    - https://charts.example.com/podinfo-6.7.0.tgz
+  - version: 6.6.0
+    urls:
+    - podinfo-6.6.0.tgz
+    digest: ../../podinfo
+`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/charts/index.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(index))
+	}))
+	defer server.Close()
+
+	getters := getter.All(cli.New())
+	grid := []struct {
+		Version        string
+		Expected       string
+		ExpectedDigest string
+	}{
+		{Version: "6.7.1", Expected: server.URL + "/charts/podinfo-6.7.1.tgz", ExpectedDigest: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"},
+		{Version: "6.7.0", Expected: "https://charts.example.com/podinfo-6.7.0.tgz"},
+		{Version: "6.6.0"},
+		{Version: "6.5.0"},
+	}
+	for _, g := range grid {
+		spec := &api.ChartSpec{Repository: server.URL + "/charts", Chart: "podinfo", Version: g.Version}
+		u, digest, err := findChartURL(spec, getters)
+		if g.Expected == "" {
+			if err == nil {
+				t.Errorf("expected error finding version %s, got %q", g.Version, u)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error finding version %s: %v", g.Version, err)
+			continue
+		}
+		if u != g.Expected {
+			t.Errorf("expected URL %q for version %s, got %q", g.Expected, g.Version, u)
+		}
+		if digest != g.ExpectedDigest {
+			t.Errorf("expected digest %q for version %s, got %q", g.ExpectedDigest, g.Version, digest)
+		}
+	}
+}
+
+func Test_FetchChart(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	archive := []byte("chart archive")
+	hash := sha256.Sum256(archive)
+	digest := hex.EncodeToString(hash[:])
+
+	served := archive
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/charts/index.yaml":
+			w.Write([]byte("entries:\n  podinfo:\n  - version: 6.7.1\n    urls: [podinfo-6.7.1.tgz]\n    digest: " + digest + "\n"))
+		case "/charts/podinfo-6.7.1.tgz":
+			downloads++
+			w.Write(served)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	spec := &api.ChartSpec{Repository: server.URL + "/charts", Chart: "podinfo", Version: "6.7.1"}
+	for i := 0; i < 2; i++ {
+		b, err := fetchChart(spec)
+		if err != nil {
+			t.Fatalf("unexpected error fetching chart: %v", err)
+		}
+		if !bytes.Equal(b, archive) {
+			t.Fatalf("unexpected archive %q", b)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("expected the archive to be downloaded once and then cached, got %d downloads", downloads)
+	}
+
+	dir, err := chartCacheDir()
+	if err != nil {
+		t.Fatalf("unexpected error getting cache directory: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, digest+".tgz"), []byte("tampered"), 0o600); err != nil {
+		t.Fatalf("error tampering with cached chart: %v", err)
+	}
+	if b, err := fetchChart(spec); err != nil || !bytes.Equal(b, archive) {
+		t.Errorf("expected a tampered cached archive to be downloaded again, got %q, %v", b, err)
+	}
+	if downloads != 2 {
+		t.Errorf("expected the tampered archive to be downloaded again, got %d downloads", downloads)
+	}
+
+	served = []byte("tampered")
+	os.Remove(filepath.Join(dir, digest+".tgz"))
+	if _, err := fetchChart(spec); err == nil {
+		t.Errorf("expected error fetching an archive not matching its digest")
+	}
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/kubemanifest"
+)
+
+const (
+	// inventoryNamespace is the namespace of the ConfigMaps recording the objects of each addon
+	inventoryNamespace = "kube-system"
+	// inventoryKey is the key of the ConfigMap data listing the objects
+	inventoryKey = "objects"
+	// addonNameLabel is the label kOps sets on the objects of its addons, matching addonmanifests.KopsAddonLabelKey
+	addonNameLabel = "addon.kops.k8s.io/name"
+)
+
+// InventoryObject identifies an object applied as part of an addon
+type InventoryObject struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// String encodes the object as group/kind/namespace/name
+func (o InventoryObject) String() string {
+	return o.Group + "/" + o.Kind + "/" + o.Namespace + "/" + o.Name
+}
+
+func (o InventoryObject) groupKind() schema.GroupKind {
+	return schema.GroupKind{Group: o.Group, Kind: o.Kind}
+}
+
+// ParseInventoryObject parses an object encoded by InventoryObject.String
+func ParseInventoryObject(s string) (InventoryObject, error) {
+	tokens := strings.Split(s, "/")
+	if len(tokens) != 4 || tokens[1] == "" || tokens[3] == "" {
+		return InventoryObject{}, fmt.Errorf("invalid inventory object %q", s)
+	}
+	return InventoryObject{Group: tokens[0], Kind: tokens[1], Namespace: tokens[2], Name: tokens[3]}, nil
+}
+
+// neverPruneGroupKinds are never pruned, even when removed from the manifest:
+// deleting a Namespace deletes everything in it, and deleting a CustomResourceDefinition deletes all its instances.
+var neverPruneGroupKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "Namespace"}:                                    true,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: true,
+}
+
+// InventoryName is the name of the ConfigMap recording the objects of the addon
+func InventoryName(addonName string) string {
+	return "kops-addon-inventory." + addonName
+}
+
+// inventoryFromManifest lists the objects of the manifest, sorted
+func inventoryFromManifest(manifest []byte) ([]InventoryObject, error) {
+	objects, err := kubemanifest.LoadObjectsFrom(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse objects: %w", err)
+	}
+
+	var inventory []InventoryObject
+	for _, object := range objects {
+		gv, err := schema.ParseGroupVersion(object.APIVersion())
+		if err != nil || gv.Version == "" {
+			return nil, fmt.Errorf("failed to parse apiVersion %q", object.APIVersion())
+		}
+		if object.Kind() == "" {
+			return nil, fmt.Errorf("failed to find kind in object")
+		}
+		inventory = append(inventory, InventoryObject{
+			Group:     gv.Group,
+			Kind:      object.Kind(),
+			Namespace: object.GetNamespace(),
+			Name:      object.GetName(),
+		})
+	}
+	sortInventory(inventory)
+	return inventory, nil
+}
+
+func sortInventory(inventory []InventoryObject) {
+	sort.Slice(inventory, func(i, j int) bool {
+		return inventory[i].String() < inventory[j].String()
+	})
+}
+
+// removedObjects returns the objects of the previous inventory which are not in the current one
+func removedObjects(previous, current []InventoryObject) []InventoryObject {
+	keep := make(map[InventoryObject]bool)
+	for _, o := range current {
+		keep[o] = true
+	}
+	var removed []InventoryObject
+	for _, o := range previous {
+		if !keep[o] {
+			removed = append(removed, o)
+		}
+	}
+	return removed
+}
+
+// ReadInventory reads the objects recorded for the addon; it returns nil if no inventory was recorded yet
+func ReadInventory(ctx context.Context, k8sClient kubernetes.Interface, addonName string) ([]InventoryObject, error) {
+	cm, err := k8sClient.CoreV1().ConfigMaps(inventoryNamespace).Get(ctx, InventoryName(addonName), metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading inventory of addon %q: %w", addonName, err)
+	}
+
+	var inventory []InventoryObject
+	for _, line := range strings.Split(cm.Data[inventoryKey], "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		o, err := ParseInventoryObject(line)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing inventory of addon %q: %w", addonName, err)
+		}
+		inventory = append(inventory, o)
+	}
+	return inventory, nil
+}
+
+// writeInventory records the objects of the addon
+func writeInventory(ctx context.Context, k8sClient kubernetes.Interface, addonName string, inventory []InventoryObject) error {
+	var lines []string
+	for _, o := range inventory {
+		lines = append(lines, o.String())
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InventoryName(addonName),
+			Namespace: inventoryNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "kops",
+			},
+		},
+		Data: map[string]string{
+			inventoryKey: strings.Join(lines, "\n"),
+		},
+	}
+
+	configMaps := k8sClient.CoreV1().ConfigMaps(inventoryNamespace)
+	existing, err := configMaps.Get(ctx, cm.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("error reading inventory of addon %q: %w", addonName, err)
+		}
+		if _, err := configMaps.Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating inventory of addon %q: %w", addonName, err)
+		}
+		return nil
+	}
+	if reflect.DeepEqual(existing.Data, cm.Data) {
+		return nil
+	}
+	existing.Data = cm.Data
+	if _, err := configMaps.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating inventory of addon %q: %w", addonName, err)
+	}
+	return nil
+}
+
+// PruneInventory deletes the objects recorded for a previous version of the addon which are not in the manifest,
+// and then records the objects of the manifest.
+// Objects which are no longer labelled as part of the addon, for example because they moved to another addon, are kept.
+func (p *Pruner) PruneInventory(ctx context.Context, k8sClient kubernetes.Interface, addonName string, manifest []byte) error {
+	current, err := inventoryFromManifest(manifest)
+	if err != nil {
+		return err
+	}
+	previous, err := ReadInventory(ctx, k8sClient, addonName)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range removedObjects(previous, current) {
+		if neverPruneGroupKinds[o.groupKind()] {
+			klog.Infof("not pruning %s of addon %q", o, addonName)
+			continue
+		}
+		if err := p.pruneInventoryObject(ctx, addonName, o); err != nil {
+			return err
+		}
+	}
+
+	return writeInventory(ctx, k8sClient, addonName, current)
+}
+
+func (p *Pruner) pruneInventoryObject(ctx context.Context, addonName string, o InventoryObject) error {
+	restMapping, err := p.RESTMapper.RESTMapping(o.groupKind())
+	if err != nil {
+		// The kind is no longer served, so there is nothing to prune
+		klog.Warningf("unable to find resource for %s, not pruning %s: %v", o.groupKind(), o, err)
+		return nil
+	}
+
+	var resource dynamic.ResourceInterface
+	if o.Namespace != "" {
+		resource = p.Client.Resource(restMapping.Resource).Namespace(o.Namespace)
+	} else {
+		resource = p.Client.Resource(restMapping.Resource)
+	}
+
+	actual, err := resource.Get(ctx, o.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting %s: %w", o, err)
+	}
+	if actual.GetLabels()[addonNameLabel] != addonName {
+		klog.Infof("not pruning %s, which is no longer part of addon %q", o, addonName)
+		return nil
+	}
+
+	klog.Infof("pruning %s, which was removed from addon %q", o, addonName)
+	if err := resource.Delete(ctx, o.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %s: %w", o, err)
+	}
+	return nil
+}
+
+// Adopt prepares objects installed outside of kOps to be taken over by the addon.
+// Workloads whose immutable selector differs from the manifest cannot be updated in place, so they are deleted to be recreated.
+func (p *Pruner) Adopt(ctx context.Context, addonName string, manifest []byte) error {
+	objects, err := kubemanifest.LoadObjectsFrom(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to parse objects: %w", err)
+	}
+
+	for _, object := range objects {
+		gv, err := schema.ParseGroupVersion(object.APIVersion())
+		if err != nil {
+			return fmt.Errorf("failed to parse apiVersion %q", object.APIVersion())
+		}
+		gk := schema.GroupKind{Group: gv.Group, Kind: object.Kind()}
+		if gk.Group != "apps" || (gk.Kind != "Deployment" && gk.Kind != "DaemonSet" && gk.Kind != "StatefulSet") {
+			continue
+		}
+
+		restMapping, err := p.RESTMapper.RESTMapping(gk)
+		if err != nil {
+			return fmt.Errorf("unable to find resource for %s: %w", gk, err)
+		}
+		resource := p.Client.Resource(restMapping.Resource).Namespace(object.GetNamespace())
+		actual, err := resource.Get(ctx, object.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("error getting %s %s/%s: %w", gk.Kind, object.GetNamespace(), object.GetName(), err)
+		}
+		if actual.GetLabels()[addonNameLabel] == addonName {
+			// Already managed by the addon
+			continue
+		}
+
+		desiredSelector := &metav1.LabelSelector{}
+		if err := object.Reparse(desiredSelector, "spec", "selector"); err != nil {
+			return fmt.Errorf("failed to parse selector of %s %s/%s: %w", gk.Kind, object.GetNamespace(), object.GetName(), err)
+		}
+		actualSelector := &metav1.LabelSelector{}
+		if selector, found, _ := unstructured.NestedMap(actual.Object, "spec", "selector"); found {
+			if err := kubemanifest.NewObject(selector).Reparse(actualSelector); err != nil {
+				return fmt.Errorf("failed to parse selector of %s %s/%s: %w", gk.Kind, object.GetNamespace(), object.GetName(), err)
+			}
+		}
+		if reflect.DeepEqual(desiredSelector, actualSelector) {
+			klog.Infof("adopting %s %s/%s into addon %q", gk.Kind, object.GetNamespace(), object.GetName(), addonName)
+			continue
+		}
+
+		klog.Infof("replacing %s %s/%s to adopt it into addon %q, as its selector differs", gk.Kind, object.GetNamespace(), object.GetName(), addonName)
+		propagation := metav1.DeletePropagationBackground
+		if err := resource.Delete(ctx, object.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %s/%s: %w", gk.Kind, object.GetNamespace(), object.GetName(), err)
+		}
+	}
+
+	return nil
+}
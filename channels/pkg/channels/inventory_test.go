@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channels
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	fakekubernetes "k8s.io/client-go/kubernetes/fake"
+)
+
+const inventoryManifest = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: coredns
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: system:coredns
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coredns
+  namespace: kube-system
+`
+
+func Test_InventoryFromManifest(t *testing.T) {
+	inventory, err := inventoryFromManifest([]byte(inventoryManifest))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []InventoryObject{
+		{Group: "", Kind: "ServiceAccount", Namespace: "kube-system", Name: "coredns"},
+		{Group: "apps", Kind: "Deployment", Namespace: "kube-system", Name: "coredns"},
+		{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Namespace: "", Name: "system:coredns"},
+	}
+	if !reflect.DeepEqual(inventory, expected) {
+		t.Errorf("expected %v, got %v", expected, inventory)
+	}
+}
+
+func Test_ParseInventoryObject(t *testing.T) {
+	grid := []struct {
+		Input    string
+		Expected *InventoryObject
+	}{
+		{
+			Input:    "apps/Deployment/kube-system/coredns",
+			Expected: &InventoryObject{Group: "apps", Kind: "Deployment", Namespace: "kube-system", Name: "coredns"},
+		},
+		{
+			Input:    "/Namespace//kube-system",
+			Expected: &InventoryObject{Kind: "Namespace", Name: "kube-system"},
+		},
+		{
+			Input: "apps/Deployment/coredns",
+		},
+		{
+			Input: "apps//kube-system/coredns",
+		},
+	}
+
+	for _, g := range grid {
+		o, err := ParseInventoryObject(g.Input)
+		if g.Expected == nil {
+			if err == nil {
+				t.Errorf("expected error parsing %q", g.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %v", g.Input, err)
+			continue
+		}
+		if o != *g.Expected {
+			t.Errorf("expected %v, got %v", *g.Expected, o)
+		}
+		if o.String() != g.Input {
+			t.Errorf("expected %q to round-trip, got %q", g.Input, o.String())
+		}
+	}
+}
+
+func Test_RemovedObjects(t *testing.T) {
+	deployment := InventoryObject{Group: "apps", Kind: "Deployment", Namespace: "kube-system", Name: "coredns"}
+	configMap := InventoryObject{Kind: "ConfigMap", Namespace: "kube-system", Name: "coredns"}
+	serviceAccount := InventoryObject{Kind: "ServiceAccount", Namespace: "kube-system", Name: "coredns"}
+
+	removed := removedObjects([]InventoryObject{configMap, deployment}, []InventoryObject{deployment, serviceAccount})
+	expected := []InventoryObject{configMap}
+	if !reflect.DeepEqual(removed, expected) {
+		t.Errorf("expected %v, got %v", expected, removed)
+	}
+
+	if removed := removedObjects(nil, []InventoryObject{deployment}); len(removed) != 0 {
+		t.Errorf("expected nothing removed without a previous inventory, got %v", removed)
+	}
+}
+
+func Test_WriteInventory(t *testing.T) {
+	ctx := context.Background()
+	fakek8s := fakekubernetes.NewSimpleClientset()
+
+	inventory, err := ReadInventory(ctx, fakek8s, "coredns.addons.k8s.io")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if inventory != nil {
+		t.Fatalf("expected no inventory, got %v", inventory)
+	}
+
+	reducedManifest := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coredns
+  namespace: kube-system
+`
+	for _, manifest := range []string{inventoryManifest, reducedManifest} {
+		expected, err := inventoryFromManifest([]byte(manifest))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := writeInventory(ctx, fakek8s, "coredns.addons.k8s.io", expected); err != nil {
+			t.Fatalf("unexpected error writing inventory: %v", err)
+		}
+		inventory, err := ReadInventory(ctx, fakek8s, "coredns.addons.k8s.io")
+		if err != nil {
+			t.Fatalf("unexpected error reading inventory: %v", err)
+		}
+		if !reflect.DeepEqual(inventory, expected) {
+			t.Errorf("expected %v, got %v", expected, inventory)
+		}
+	}
+}
@@ -31,6 +31,9 @@ type MockAutoscaling struct {
 	Groups            map[string]*autoscalingtypes.AutoScalingGroup
 	WarmPoolInstances map[string][]autoscalingtypes.Instance
 	LifecycleHooks    map[string]*autoscalingtypes.LifecycleHook
+	ScheduledActions  map[string]*autoscalingtypes.ScheduledUpdateGroupAction
+	// ScalingActivities are the scaling activities of each autoscaling group, newest first
+	ScalingActivities map[string][]autoscalingtypes.Activity
 }
 
 var _ awsinterfaces.AutoScalingAPI = &MockAutoscaling{}
@@ -359,3 +359,66 @@ func (m *MockAutoscaling) DescribeLifecycleHooks(ctx context.Context, input *aut
 	}
 	return response, nil
 }
+
+func (m *MockAutoscaling) PutScheduledUpdateGroupAction(ctx context.Context, input *autoscaling.PutScheduledUpdateGroupActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	action := &autoscalingtypes.ScheduledUpdateGroupAction{
+		AutoScalingGroupName: input.AutoScalingGroupName,
+		ScheduledActionName:  input.ScheduledActionName,
+		Recurrence:           input.Recurrence,
+		TimeZone:             input.TimeZone,
+		MinSize:              input.MinSize,
+		MaxSize:              input.MaxSize,
+		DesiredCapacity:      input.DesiredCapacity,
+	}
+
+	if m.ScheduledActions == nil {
+		m.ScheduledActions = make(map[string]*autoscalingtypes.ScheduledUpdateGroupAction)
+	}
+	name := *input.AutoScalingGroupName + "::" + *input.ScheduledActionName
+	m.ScheduledActions[name] = action
+
+	return &autoscaling.PutScheduledUpdateGroupActionOutput{}, nil
+}
+
+func (m *MockAutoscaling) DescribeScheduledActions(ctx context.Context, input *autoscaling.DescribeScheduledActionsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	response := &autoscaling.DescribeScheduledActionsOutput{}
+	for _, scheduledActionName := range input.ScheduledActionNames {
+		name := *input.AutoScalingGroupName + "::" + scheduledActionName
+
+		action := m.ScheduledActions[name]
+		if action != nil {
+			response.ScheduledUpdateGroupActions = append(response.ScheduledUpdateGroupActions, *action)
+		}
+	}
+	return response, nil
+}
+
+func (m *MockAutoscaling) DeleteScheduledAction(ctx context.Context, input *autoscaling.DeleteScheduledActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteScheduledActionOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	name := *input.AutoScalingGroupName + "::" + *input.ScheduledActionName
+	delete(m.ScheduledActions, name)
+	return &autoscaling.DeleteScheduledActionOutput{}, nil
+}
+
+func (m *MockAutoscaling) DescribeScalingActivities(ctx context.Context, request *autoscaling.DescribeScalingActivitiesInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScalingActivitiesOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.V(2).Infof("Mock DescribeScalingActivities: %v", request)
+
+	response := &autoscaling.DescribeScalingActivitiesOutput{}
+	for _, activity := range m.ScalingActivities[aws.ToString(request.AutoScalingGroupName)] {
+		if request.MaxRecords != nil && int32(len(response.Activities)) >= *request.MaxRecords {
+			break
+		}
+		response.Activities = append(response.Activities, activity)
+	}
+	return response, nil
+}
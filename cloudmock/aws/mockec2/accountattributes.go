@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockec2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// defaultAccountAttributes are the attributes of a new AWS account
+var defaultAccountAttributes = map[string]string{
+	"vpc-max-elastic-ips": "5",
+}
+
+func (m *MockEC2) DescribeAccountAttributes(ctx context.Context, request *ec2.DescribeAccountAttributesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeAccountAttributesOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	attributes := make(map[string]string)
+	for k, v := range defaultAccountAttributes {
+		attributes[k] = v
+	}
+	for k, v := range m.AccountAttributes {
+		attributes[k] = v
+	}
+
+	var names []string
+	for _, name := range request.AttributeNames {
+		names = append(names, string(name))
+	}
+	if len(names) == 0 {
+		for name := range attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	response := &ec2.DescribeAccountAttributesOutput{}
+	for _, name := range names {
+		value, ok := attributes[name]
+		if !ok {
+			continue
+		}
+		response.AccountAttributes = append(response.AccountAttributes, ec2types.AccountAttribute{
+			AttributeName:   aws.String(name),
+			AttributeValues: []ec2types.AccountAttributeValue{{AttributeValue: aws.String(value)}},
+		})
+	}
+	return response, nil
+}
@@ -31,6 +31,9 @@ type MockEC2 struct {
 
 	mutex sync.Mutex
 
+	// AccountAttributes are the attributes of the account, by name; vpc-max-elastic-ips defaults to 5
+	AccountAttributes map[string]string
+
 	addressNumber int
 	Addresses     map[string]*ec2types.Address
 
@@ -62,6 +65,10 @@ type MockEC2 struct {
 
 	NatGateways map[string]*ec2types.NatGateway
 
+	ClientVpnEndpoints          map[string]*ec2types.ClientVpnEndpoint
+	ClientVpnTargetNetworks     map[string]*ec2types.TargetNetwork
+	ClientVpnAuthorizationRules []*ec2types.AuthorizationRule
+
 	idsMutex sync.Mutex
 	ids      map[string]*idAllocator
 }
@@ -110,6 +117,12 @@ func (m *MockEC2) All() map[string]interface{} {
 	for id, o := range m.NatGateways {
 		all[id] = o
 	}
+	for id, o := range m.ClientVpnEndpoints {
+		all[id] = o
+	}
+	for id, o := range m.ClientVpnTargetNetworks {
+		all[id] = o
+	}
 
 	return all
 }
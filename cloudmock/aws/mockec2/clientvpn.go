@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+)
+
+func (m *MockEC2) CreateClientVpnEndpoint(ctx context.Context, request *ec2.CreateClientVpnEndpointInput, optFns ...func(*ec2.Options)) (*ec2.CreateClientVpnEndpointOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("CreateClientVpnEndpoint: %v", request)
+
+	id := m.allocateId("cvpn-endpoint")
+	tags := tagSpecificationsToTags(request.TagSpecifications, ec2types.ResourceTypeClientVpnEndpoint)
+
+	endpoint := &ec2types.ClientVpnEndpoint{
+		ClientVpnEndpointId:  s(id),
+		ClientCidrBlock:      request.ClientCidrBlock,
+		ServerCertificateArn: request.ServerCertificateArn,
+		DnsServers:           request.DnsServers,
+		SecurityGroupIds:     request.SecurityGroupIds,
+		SplitTunnel:          request.SplitTunnel,
+		VpcId:                request.VpcId,
+		DnsName:              s("*." + id + ".prod.clientvpn.us-test-1.amazonaws.com"),
+		Status:               &ec2types.ClientVpnEndpointStatus{Code: ec2types.ClientVpnEndpointStatusCodePendingAssociate},
+	}
+	for _, auth := range request.AuthenticationOptions {
+		option := ec2types.ClientVpnAuthentication{Type: auth.Type}
+		if auth.MutualAuthentication != nil {
+			option.MutualAuthentication = &ec2types.CertificateAuthentication{
+				ClientRootCertificateChain: auth.MutualAuthentication.ClientRootCertificateChainArn,
+			}
+		}
+		endpoint.AuthenticationOptions = append(endpoint.AuthenticationOptions, option)
+	}
+
+	if m.ClientVpnEndpoints == nil {
+		m.ClientVpnEndpoints = make(map[string]*ec2types.ClientVpnEndpoint)
+	}
+	m.ClientVpnEndpoints[id] = endpoint
+
+	m.addTags(id, tags...)
+
+	response := &ec2.CreateClientVpnEndpointOutput{
+		ClientVpnEndpointId: endpoint.ClientVpnEndpointId,
+		DnsName:             endpoint.DnsName,
+		Status:              endpoint.Status,
+	}
+	return response, nil
+}
+
+func (m *MockEC2) DescribeClientVpnEndpoints(ctx context.Context, request *ec2.DescribeClientVpnEndpointsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeClientVpnEndpointsOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("DescribeClientVpnEndpoints: %v", request)
+
+	if len(request.Filters) != 0 {
+		return nil, fmt.Errorf("DescribeClientVpnEndpoints filters are not implemented")
+	}
+
+	var endpoints []ec2types.ClientVpnEndpoint
+	for id, endpoint := range m.ClientVpnEndpoints {
+		if len(request.ClientVpnEndpointIds) != 0 && !slices.Contains(request.ClientVpnEndpointIds, id) {
+			continue
+		}
+		copy := *endpoint
+		copy.Tags = m.getTags(ec2types.ResourceTypeClientVpnEndpoint, id)
+		endpoints = append(endpoints, copy)
+	}
+
+	response := &ec2.DescribeClientVpnEndpointsOutput{
+		ClientVpnEndpoints: endpoints,
+	}
+	return response, nil
+}
+
+func (m *MockEC2) ModifyClientVpnEndpoint(ctx context.Context, request *ec2.ModifyClientVpnEndpointInput, optFns ...func(*ec2.Options)) (*ec2.ModifyClientVpnEndpointOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("ModifyClientVpnEndpoint: %v", request)
+
+	id := aws.ToString(request.ClientVpnEndpointId)
+	endpoint := m.ClientVpnEndpoints[id]
+	if endpoint == nil {
+		return nil, fmt.Errorf("ClientVpnEndpoint %q not found", id)
+	}
+	if request.ServerCertificateArn != nil {
+		endpoint.ServerCertificateArn = request.ServerCertificateArn
+	}
+	if request.DnsServers != nil {
+		endpoint.DnsServers = request.DnsServers.CustomDnsServers
+	}
+	if request.SecurityGroupIds != nil {
+		endpoint.SecurityGroupIds = request.SecurityGroupIds
+	}
+	if request.SplitTunnel != nil {
+		endpoint.SplitTunnel = request.SplitTunnel
+	}
+	if request.VpcId != nil {
+		endpoint.VpcId = request.VpcId
+	}
+
+	return &ec2.ModifyClientVpnEndpointOutput{Return: aws.Bool(true)}, nil
+}
+
+func (m *MockEC2) DeleteClientVpnEndpoint(ctx context.Context, request *ec2.DeleteClientVpnEndpointInput, optFns ...func(*ec2.Options)) (*ec2.DeleteClientVpnEndpointOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("DeleteClientVpnEndpoint: %v", request)
+
+	id := aws.ToString(request.ClientVpnEndpointId)
+	if m.ClientVpnEndpoints[id] == nil {
+		return nil, fmt.Errorf("ClientVpnEndpoint %q not found", id)
+	}
+	for _, network := range m.ClientVpnTargetNetworks {
+		if aws.ToString(network.ClientVpnEndpointId) == id {
+			return nil, fmt.Errorf("ClientVpnEndpoint %q has associated target networks", id)
+		}
+	}
+	delete(m.ClientVpnEndpoints, id)
+
+	var rules []*ec2types.AuthorizationRule
+	for _, rule := range m.ClientVpnAuthorizationRules {
+		if aws.ToString(rule.ClientVpnEndpointId) != id {
+			rules = append(rules, rule)
+		}
+	}
+	m.ClientVpnAuthorizationRules = rules
+
+	return &ec2.DeleteClientVpnEndpointOutput{}, nil
+}
+
+func (m *MockEC2) AssociateClientVpnTargetNetwork(ctx context.Context, request *ec2.AssociateClientVpnTargetNetworkInput, optFns ...func(*ec2.Options)) (*ec2.AssociateClientVpnTargetNetworkOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("AssociateClientVpnTargetNetwork: %v", request)
+
+	endpoint := m.ClientVpnEndpoints[aws.ToString(request.ClientVpnEndpointId)]
+	if endpoint == nil {
+		return nil, fmt.Errorf("ClientVpnEndpoint %q not found", aws.ToString(request.ClientVpnEndpointId))
+	}
+
+	id := m.allocateId("cvpn-assoc")
+	network := &ec2types.TargetNetwork{
+		AssociationId:       s(id),
+		ClientVpnEndpointId: request.ClientVpnEndpointId,
+		TargetNetworkId:     request.SubnetId,
+		VpcId:               endpoint.VpcId,
+		Status:              &ec2types.AssociationStatus{Code: ec2types.AssociationStatusCodeAssociated},
+	}
+	if m.ClientVpnTargetNetworks == nil {
+		m.ClientVpnTargetNetworks = make(map[string]*ec2types.TargetNetwork)
+	}
+	m.ClientVpnTargetNetworks[id] = network
+	endpoint.Status = &ec2types.ClientVpnEndpointStatus{Code: ec2types.ClientVpnEndpointStatusCodeAvailable}
+
+	response := &ec2.AssociateClientVpnTargetNetworkOutput{
+		AssociationId: network.AssociationId,
+		Status:        network.Status,
+	}
+	return response, nil
+}
+
+func (m *MockEC2) DescribeClientVpnTargetNetworks(ctx context.Context, request *ec2.DescribeClientVpnTargetNetworksInput, optFns ...func(*ec2.Options)) (*ec2.DescribeClientVpnTargetNetworksOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("DescribeClientVpnTargetNetworks: %v", request)
+
+	var networks []ec2types.TargetNetwork
+	for id, network := range m.ClientVpnTargetNetworks {
+		if aws.ToString(network.ClientVpnEndpointId) != aws.ToString(request.ClientVpnEndpointId) {
+			continue
+		}
+		if len(request.AssociationIds) != 0 && !slices.Contains(request.AssociationIds, id) {
+			continue
+		}
+
+		allFiltersMatch := true
+		for _, filter := range request.Filters {
+			switch aws.ToString(filter.Name) {
+			case "association-id":
+				allFiltersMatch = allFiltersMatch && slices.Contains(filter.Values, id)
+			case "target-network-id":
+				allFiltersMatch = allFiltersMatch && slices.Contains(filter.Values, aws.ToString(network.TargetNetworkId))
+			case "vpc-id":
+				allFiltersMatch = allFiltersMatch && slices.Contains(filter.Values, aws.ToString(network.VpcId))
+			default:
+				return nil, fmt.Errorf("unknown filter name: %q", aws.ToString(filter.Name))
+			}
+		}
+		if !allFiltersMatch {
+			continue
+		}
+
+		networks = append(networks, *network)
+	}
+
+	response := &ec2.DescribeClientVpnTargetNetworksOutput{
+		ClientVpnTargetNetworks: networks,
+	}
+	return response, nil
+}
+
+func (m *MockEC2) DisassociateClientVpnTargetNetwork(ctx context.Context, request *ec2.DisassociateClientVpnTargetNetworkInput, optFns ...func(*ec2.Options)) (*ec2.DisassociateClientVpnTargetNetworkOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("DisassociateClientVpnTargetNetwork: %v", request)
+
+	id := aws.ToString(request.AssociationId)
+	network := m.ClientVpnTargetNetworks[id]
+	if network == nil || aws.ToString(network.ClientVpnEndpointId) != aws.ToString(request.ClientVpnEndpointId) {
+		return nil, fmt.Errorf("ClientVpnTargetNetwork %q not found", id)
+	}
+	delete(m.ClientVpnTargetNetworks, id)
+
+	response := &ec2.DisassociateClientVpnTargetNetworkOutput{
+		AssociationId: request.AssociationId,
+		Status:        &ec2types.AssociationStatus{Code: ec2types.AssociationStatusCodeDisassociating},
+	}
+	return response, nil
+}
+
+func (m *MockEC2) AuthorizeClientVpnIngress(ctx context.Context, request *ec2.AuthorizeClientVpnIngressInput, optFns ...func(*ec2.Options)) (*ec2.AuthorizeClientVpnIngressOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("AuthorizeClientVpnIngress: %v", request)
+
+	if m.ClientVpnEndpoints[aws.ToString(request.ClientVpnEndpointId)] == nil {
+		return nil, fmt.Errorf("ClientVpnEndpoint %q not found", aws.ToString(request.ClientVpnEndpointId))
+	}
+
+	rule := &ec2types.AuthorizationRule{
+		ClientVpnEndpointId: request.ClientVpnEndpointId,
+		DestinationCidr:     request.TargetNetworkCidr,
+		AccessAll:           request.AuthorizeAllGroups,
+		GroupId:             request.AccessGroupId,
+		Status:              &ec2types.ClientVpnAuthorizationRuleStatus{Code: ec2types.ClientVpnAuthorizationRuleStatusCodeActive},
+	}
+	m.ClientVpnAuthorizationRules = append(m.ClientVpnAuthorizationRules, rule)
+
+	return &ec2.AuthorizeClientVpnIngressOutput{Status: rule.Status}, nil
+}
+
+func (m *MockEC2) DescribeClientVpnAuthorizationRules(ctx context.Context, request *ec2.DescribeClientVpnAuthorizationRulesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeClientVpnAuthorizationRulesOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("DescribeClientVpnAuthorizationRules: %v", request)
+
+	if len(request.Filters) != 0 {
+		return nil, fmt.Errorf("DescribeClientVpnAuthorizationRules filters are not implemented")
+	}
+
+	var rules []ec2types.AuthorizationRule
+	for _, rule := range m.ClientVpnAuthorizationRules {
+		if aws.ToString(rule.ClientVpnEndpointId) == aws.ToString(request.ClientVpnEndpointId) {
+			rules = append(rules, *rule)
+		}
+	}
+
+	response := &ec2.DescribeClientVpnAuthorizationRulesOutput{
+		AuthorizationRules: rules,
+	}
+	return response, nil
+}
+
+func (m *MockEC2) RevokeClientVpnIngress(ctx context.Context, request *ec2.RevokeClientVpnIngressInput, optFns ...func(*ec2.Options)) (*ec2.RevokeClientVpnIngressOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	klog.Infof("RevokeClientVpnIngress: %v", request)
+
+	var rules []*ec2types.AuthorizationRule
+	found := false
+	for _, rule := range m.ClientVpnAuthorizationRules {
+		if aws.ToString(rule.ClientVpnEndpointId) == aws.ToString(request.ClientVpnEndpointId) && aws.ToString(rule.DestinationCidr) == aws.ToString(request.TargetNetworkCidr) {
+			found = true
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if !found {
+		return nil, fmt.Errorf("authorization rule for %q not found", aws.ToString(request.TargetNetworkCidr))
+	}
+	m.ClientVpnAuthorizationRules = rules
+
+	return &ec2.RevokeClientVpnIngressOutput{Status: &ec2types.ClientVpnAuthorizationRuleStatus{Code: ec2types.ClientVpnAuthorizationRuleStatusCodeRevoking}}, nil
+}
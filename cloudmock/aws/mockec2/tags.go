@@ -67,6 +67,8 @@ func (m *MockEC2) addTags(resourceId string, tags ...ec2types.Tag) {
 		resourceType = ec2types.ResourceTypeLaunchTemplate
 	} else if strings.HasPrefix(resourceId, "key-") {
 		resourceType = ec2types.ResourceTypeKeyPair
+	} else if strings.HasPrefix(resourceId, "cvpn-endpoint-") {
+		resourceType = ec2types.ResourceTypeClientVpnEndpoint
 	} else {
 		klog.Fatalf("Unknown resource-type in create tags: %v", resourceId)
 	}
@@ -169,6 +171,20 @@ func (m *MockEC2) DescribeTags(ctx context.Context, request *ec2.DescribeTagsInp
 					}
 				}
 
+			case "value":
+				for _, v := range filter.Values {
+					if v == *tag.Value {
+						match = true
+					}
+				}
+
+			case "resource-type":
+				for _, v := range filter.Values {
+					if v == string(tag.ResourceType) {
+						match = true
+					}
+				}
+
 			default:
 				return nil, fmt.Errorf("unknown filter name: %q", *filter.Name)
 			}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockelbv2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// defaultAccountLimits are the limits of a new AWS account
+var defaultAccountLimits = map[string]string{
+	"application-load-balancers": "50",
+	"network-load-balancers":     "50",
+	"target-groups":              "3000",
+}
+
+func (m *MockELBV2) DescribeAccountLimits(ctx context.Context, request *elbv2.DescribeAccountLimitsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeAccountLimitsOutput, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	limits := make(map[string]string)
+	for k, v := range defaultAccountLimits {
+		limits[k] = v
+	}
+	for k, v := range m.AccountLimits {
+		limits[k] = v
+	}
+
+	names := make([]string, 0, len(limits))
+	for name := range limits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	response := &elbv2.DescribeAccountLimitsOutput{}
+	for _, name := range names {
+		response.Limits = append(response.Limits, elbv2types.Limit{
+			Name: aws.String(name),
+			Max:  aws.String(limits[name]),
+		})
+	}
+	return response, nil
+}
@@ -39,6 +39,9 @@ type MockELBV2 struct {
 	listenerCount int
 	LBAttributes  map[string][]elbv2types.LoadBalancerAttribute
 
+	// AccountLimits are the limits of the account, by name; the AWS defaults are used for the others
+	AccountLimits map[string]string
+
 	Tags map[string]elbv2types.TagDescription
 }
 
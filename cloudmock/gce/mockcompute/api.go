@@ -24,8 +24,10 @@ import (
 
 // MockClient represents a mocked compute client.
 type MockClient struct {
-	projectClient *projectClient
-	zoneClient    *zoneClient
+	projectClient     *projectClient
+	regionClient      *regionClient
+	zoneClient        *zoneClient
+	machineTypeClient *machineTypeClient
 
 	networkClient          *networkClient
 	subnetworkClient       *subnetworkClient
@@ -38,6 +40,7 @@ type MockClient struct {
 	firewallClient         *firewallClient
 	routerClient           *routerClient
 
+	instanceClient             *instanceClient
 	instanceTemplateClient     *instanceTemplateClient
 	instanceGroupManagerClient *instanceGroupManagerClient
 	targetPoolClient           *targetPoolClient
@@ -50,8 +53,10 @@ var _ gce.ComputeClient = &MockClient{}
 // NewMockClient creates a new mock client.
 func NewMockClient(project string) *MockClient {
 	return &MockClient{
-		projectClient: newProjectClient(project),
-		zoneClient:    newZoneClient(project),
+		projectClient:     newProjectClient(project),
+		regionClient:      newRegionClient(project),
+		zoneClient:        newZoneClient(project),
+		machineTypeClient: &machineTypeClient{},
 
 		networkClient:          newNetworkClient(),
 		subnetworkClient:       newSubnetworkClient(),
@@ -64,6 +69,7 @@ func NewMockClient(project string) *MockClient {
 		firewallClient:         newFirewallClient(),
 		routerClient:           newRouterClient(),
 
+		instanceClient:             newInstanceClient(),
 		instanceTemplateClient:     newInstanceTemplateClient(),
 		instanceGroupManagerClient: newInstanceGroupManagerClient(),
 		targetPoolClient:           newTargetPoolClient(),
@@ -88,6 +94,7 @@ func (c *MockClient) AllResources() map[string]interface{} {
 		c.addressClient.All,
 		c.firewallClient.All,
 		c.routerClient.All,
+		c.instanceClient.All,
 		c.instanceTemplateClient.All,
 		c.instanceGroupManagerClient.All,
 		c.targetPoolClient.All,
@@ -109,14 +116,22 @@ func (c *MockClient) Projects() gce.ProjectClient {
 }
 
 func (c *MockClient) Regions() gce.RegionClient {
-	// Not implemented.
-	return nil
+	return c.regionClient
+}
+
+// SetQuota sets the limit and usage of a quota of a region
+func (c *MockClient) SetQuota(project, region, metric string, limit, usage float64) {
+	c.regionClient.SetQuota(project, region, metric, limit, usage)
 }
 
 func (c *MockClient) Zones() gce.ZoneClient {
 	return c.zoneClient
 }
 
+func (c *MockClient) MachineTypes() gce.MachineTypeClient {
+	return c.machineTypeClient
+}
+
 func (c *MockClient) Networks() gce.NetworkClient {
 	return c.networkClient
 }
@@ -158,8 +173,7 @@ func (c *MockClient) Routers() gce.RouterClient {
 }
 
 func (c *MockClient) Instances() gce.InstanceClient {
-	// Not implemented.
-	return nil
+	return c.instanceClient
 }
 
 func (c *MockClient) InstanceTemplates() gce.InstanceTemplateClient {
@@ -170,6 +184,11 @@ func (c *MockClient) InstanceGroupManagers() gce.InstanceGroupManagerClient {
 	return c.instanceGroupManagerClient
 }
 
+// SetManagedInstances sets the managed instances listed for an existing instanceGroupManager.
+func (c *MockClient) SetManagedInstances(project, zone, name string, instances []*compute.ManagedInstance) error {
+	return c.instanceGroupManagerClient.SetManagedInstances(project, zone, name, instances)
+}
+
 func (c *MockClient) TargetPools() gce.TargetPoolClient {
 	return c.targetPoolClient
 }
@@ -28,6 +28,8 @@ import (
 type instanceGroupManagerClient struct {
 	// instanceGroupManagers are instanceGroupManagers keyed by project, zone, and name.
 	instanceGroupManagers map[string]map[string]map[string]*compute.InstanceGroupManager
+	// managedInstances are the managed instances of the instanceGroupManagers, keyed by the self link of the instanceGroupManager.
+	managedInstances map[string][]*compute.ManagedInstance
 	sync.Mutex
 }
 
@@ -36,6 +38,7 @@ var _ gce.InstanceGroupManagerClient = &instanceGroupManagerClient{}
 func newInstanceGroupManagerClient() *instanceGroupManagerClient {
 	return &instanceGroupManagerClient{
 		instanceGroupManagers: map[string]map[string]map[string]*compute.InstanceGroupManager{},
+		managedInstances:      map[string][]*compute.ManagedInstance{},
 	}
 }
 
@@ -126,8 +129,25 @@ func (c *instanceGroupManagerClient) List(ctx context.Context, project, zone str
 }
 
 func (c *instanceGroupManagerClient) ListManagedInstances(ctx context.Context, project, zone, name string) ([]*compute.ManagedInstance, error) {
-	var instances []*compute.ManagedInstance
-	return instances, nil
+	igm, err := c.Get(project, zone, name)
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.managedInstances[igm.SelfLink], nil
+}
+
+// SetManagedInstances sets the managed instances listed for an existing instanceGroupManager.
+func (c *instanceGroupManagerClient) SetManagedInstances(project, zone, name string, instances []*compute.ManagedInstance) error {
+	igm, err := c.Get(project, zone, name)
+	if err != nil {
+		return err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.managedInstances[igm.SelfLink] = instances
+	return nil
 }
 
 func (c *instanceGroupManagerClient) RecreateInstances(project, zone, name, id string) (*compute.Operation, error) {
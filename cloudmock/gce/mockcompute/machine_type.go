@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockcompute
+
+import (
+	"strconv"
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// sharedCoreMachineTypes are the number of CPUs of the machine types whose name doesn't end with it
+var sharedCoreMachineTypes = map[string]int64{
+	"e2-micro":  2,
+	"e2-small":  2,
+	"e2-medium": 2,
+	"f1-micro":  1,
+	"g1-small":  1,
+}
+
+type machineTypeClient struct{}
+
+var _ gce.MachineTypeClient = &machineTypeClient{}
+
+// Get returns a machine type with the number of CPUs in its name, e.g. 4 for n2-standard-4
+func (c *machineTypeClient) Get(project, zone, name string) (*compute.MachineType, error) {
+	cpus, ok := sharedCoreMachineTypes[name]
+	if !ok {
+		n, err := strconv.ParseInt(name[strings.LastIndex(name, "-")+1:], 10, 64)
+		if err != nil {
+			return nil, notFoundError()
+		}
+		cpus = n
+	}
+	return &compute.MachineType{
+		Name:      name,
+		Zone:      zone,
+		GuestCpus: cpus,
+	}, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mockcompute
+
+import (
+	"context"
+	"sync"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+type regionClient struct {
+	// regions are regions keyed by project and region name.
+	regions map[string]map[string]*compute.Region
+	sync.Mutex
+}
+
+var _ gce.RegionClient = &regionClient{}
+
+func newRegionClient(project string) *regionClient {
+	return &regionClient{
+		regions: map[string]map[string]*compute.Region{
+			project: {
+				"us-test1": {
+					Name: "us-test1",
+					Quotas: []*compute.Quota{
+						{Metric: "CPUS", Limit: 24},
+						{Metric: "INSTANCES", Limit: 24},
+					},
+				},
+			},
+		},
+	}
+}
+
+// SetQuota sets the limit and usage of a quota of a region, adding the region if needed
+func (c *regionClient) SetQuota(project, region, metric string, limit, usage float64) {
+	c.Lock()
+	defer c.Unlock()
+	regions, ok := c.regions[project]
+	if !ok {
+		regions = map[string]*compute.Region{}
+		c.regions[project] = regions
+	}
+	r, ok := regions[region]
+	if !ok {
+		r = &compute.Region{Name: region}
+		regions[region] = r
+	}
+	for _, q := range r.Quotas {
+		if q.Metric == metric {
+			q.Limit = limit
+			q.Usage = usage
+			return
+		}
+	}
+	r.Quotas = append(r.Quotas, &compute.Quota{Metric: metric, Limit: limit, Usage: usage})
+}
+
+func (c *regionClient) Get(project, region string) (*compute.Region, error) {
+	c.Lock()
+	defer c.Unlock()
+	regions, ok := c.regions[project]
+	if !ok {
+		return nil, notFoundError()
+	}
+	r, ok := regions[region]
+	if !ok {
+		return nil, notFoundError()
+	}
+	return r, nil
+}
+
+func (c *regionClient) List(ctx context.Context, project string) ([]*compute.Region, error) {
+	c.Lock()
+	defer c.Unlock()
+	regions, ok := c.regions[project]
+	if !ok {
+		return nil, nil
+	}
+	var l []*compute.Region
+	for _, r := range regions {
+		l = append(l, r)
+	}
+	return l, nil
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package recorder records the HTTP interactions of tests with real cloud APIs into fixtures, and replays them,
+// so that the tests can run without cloud credentials.
+//
+// A Recorder is an http.RoundTripper, and also has the Do method of the HTTP clients of the AWS and Azure SDKs,
+// so it can be used as their transport:
+//
+//	aws.Config{HTTPClient: r}
+//	arm.ClientOptions{ClientOptions: policy.ClientOptions{Transport: r}}
+//	option.WithHTTPClient(r.HTTPClient())
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+// EnvRecord is the environment variable which makes tests record their interactions with the real cloud APIs,
+// instead of replaying the recordings
+const EnvRecord = "KOPS_CLOUDMOCK_RECORD"
+
+// Mode is whether a recorder records or replays the interactions
+type Mode string
+
+const (
+	// ModeReplay replays the recorded interactions, and fails requests which weren't recorded
+	ModeReplay Mode = "replay"
+	// ModeRecord sends the requests to the real API, and records the interactions
+	ModeRecord Mode = "record"
+)
+
+// ModeFromEnv returns ModeRecord if EnvRecord is set to true, and ModeReplay otherwise
+func ModeFromEnv() Mode {
+	if os.Getenv(EnvRecord) == "true" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// droppedResponseHeaders are the response headers which are not recorded; Retry-After would slow down replays
+var droppedResponseHeaders = []string{"Date", "Retry-After", "Set-Cookie"}
+
+// Cassette is a recording of the interactions with a cloud API, in the order they happened
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and its response
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request; its URL and body are normalized, so that requests match regardless of the order
+// of their parameters
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response
+type Response struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// Options configures a recorder
+type Options struct {
+	// Redactions replace sensitive values in the recordings, such as account or subscription IDs, with placeholders.
+	// Tests use the real values when recording and the placeholders when replaying.
+	Redactions map[string]string
+	// Transport sends the requests when recording; http.DefaultTransport is used if nil.
+	Transport http.RoundTripper
+}
+
+// Recorder records or replays the interactions with a cloud API
+type Recorder struct {
+	mode    Mode
+	path    string
+	options Options
+
+	mutex    sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a recorder of the interactions in a file. In ModeReplay, the file must exist;
+// in ModeRecord, it is overwritten by Close.
+func New(path string, mode Mode, options Options) (*Recorder, error) {
+	r := &Recorder{
+		mode:    mode,
+		path:    path,
+		options: options,
+	}
+	if r.options.Transport == nil {
+		r.options.Transport = http.DefaultTransport
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading recording %q (record it with %s=true): %w", path, EnvRecord, err)
+		}
+		if err := yaml.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("parsing recording %q: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Start returns a recorder of the interactions of a test in testdata/recordings/<name>.yaml, in the mode
+// set by EnvRecord. The recording is saved, or checked to have been fully replayed, when the test completes.
+func Start(t testing.TB, name string, options Options) *Recorder {
+	t.Helper()
+
+	r, err := New(filepath.Join("testdata", "recordings", name+".yaml"), ModeFromEnv(), options)
+	if err != nil {
+		t.Fatalf("error starting recorder: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := r.Close(); err != nil {
+			t.Errorf("error stopping recorder: %v", err)
+		}
+	})
+	return r
+}
+
+// Mode returns whether the recorder records or replays the interactions
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// HTTPClient returns an HTTP client using the recorder as its transport
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Do sends a request through the recorder
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	return r.RoundTrip(req)
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(b))
+		body = b
+	}
+
+	request := Request{
+		Method: req.Method,
+		URL:    normalizeURL(req.URL),
+		Body:   normalizeBody(req.Header.Get("Content-Type"), body),
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, request)
+	}
+	return r.replay(req, r.redact(request))
+}
+
+func (r *Recorder) record(req *http.Request, request Request) (*http.Response, error) {
+	resp, err := r.options.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := Response{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}
+	for k := range resp.Header {
+		response.Headers = addHeader(response.Headers, k, resp.Header.Get(k))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  r.redact(request),
+		Response: r.redactResponse(response),
+	})
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request != request {
+			continue
+		}
+		r.used[i] = true
+
+		resp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        make(http.Header),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}
+		for k, v := range interaction.Response.Headers {
+			resp.Header.Set(k, v)
+		}
+		return resp, nil
+	}
+	return nil, &replayError{path: r.path, request: request}
+}
+
+// replayError is the error of a request which wasn't recorded; it is not retriable, so that the SDKs fail fast
+type replayError struct {
+	path    string
+	request Request
+}
+
+func (e *replayError) Error() string {
+	msg := fmt.Sprintf("no recorded interaction in %q for %s %s", e.path, e.request.Method, e.request.URL)
+	if e.request.Body != "" {
+		msg += " with body " + e.request.Body
+	}
+	return msg + fmt.Sprintf(" (re-record it with %s=true)", EnvRecord)
+}
+
+// NonRetriable stops the retries of the Azure SDK
+func (e *replayError) NonRetriable() {}
+
+// Close saves the recording in ModeRecord, and fails if some of the recorded interactions weren't replayed
+// in ModeReplay.
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.mode == ModeRecord {
+		data, err := yaml.Marshal(&r.cassette)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(r.path, data, 0o644)
+	}
+
+	var unused []string
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction.Request.Method+" "+interaction.Request.URL)
+		}
+	}
+	if len(unused) > 0 {
+		return fmt.Errorf("recorded interactions in %q were not replayed: %s", r.path, strings.Join(unused, ", "))
+	}
+	return nil
+}
+
+func (r *Recorder) redact(request Request) Request {
+	request.URL = r.redactString(request.URL)
+	request.Body = r.redactString(request.Body)
+	return request
+}
+
+func (r *Recorder) redactResponse(response Response) Response {
+	headers := response.Headers
+	response.Headers = nil
+	for k, v := range headers {
+		response.Headers = addHeader(response.Headers, k, r.redactString(v))
+	}
+	response.Body = r.redactString(response.Body)
+	return response
+}
+
+func (r *Recorder) redactString(s string) string {
+	for value, placeholder := range r.options.Redactions {
+		if value != "" {
+			s = strings.ReplaceAll(s, value, placeholder)
+		}
+	}
+	return s
+}
+
+func addHeader(headers map[string]string, k, v string) map[string]string {
+	for _, dropped := range droppedResponseHeaders {
+		if http.CanonicalHeaderKey(k) == dropped {
+			return headers
+		}
+	}
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	headers[http.CanonicalHeaderKey(k)] = v
+	return headers
+}
+
+// normalizeURL returns a URL with its query parameters sorted
+func normalizeURL(u *url.URL) string {
+	normalized := *u
+	normalized.RawQuery = u.Query().Encode()
+	return normalized.String()
+}
+
+// normalizeBody returns a form body with its parameters sorted, or a JSON body with its keys sorted
+func normalizeBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return values.Encode()
+		}
+	case strings.Contains(contentType, "json"):
+		var v any
+		if err := json.Unmarshal(body, &v); err == nil {
+			if b, err := json.Marshal(v); err == nil {
+				return string(b)
+			}
+		}
+	}
+	return string(body)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp.StatusCode, string(body)
+}
+
+func TestRecordReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		calls++
+		body, _ := io.ReadAll(req.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		switch {
+		case req.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			w.Write(body)
+		case calls == 1:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"account":"123456789012","state":"available"}`))
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "recording.yaml")
+	options := Options{Redactions: map[string]string{"123456789012": "000000000000"}}
+
+	// Record the interactions with the server
+	{
+		r, err := New(path, ModeRecord, options)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		client := r.HTTPClient()
+		if status, _ := get(t, client, server.URL+"/resources/123456789012?b=2&a=1"); status != http.StatusNotFound {
+			t.Errorf("unexpected status %d", status)
+		}
+		resp, err := client.Post(server.URL+"/resources", "application/json", strings.NewReader(`{"name":"a","account":"123456789012"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if status, body := get(t, client, server.URL+"/resources/123456789012?b=2&a=1"); status != http.StatusOK || !strings.Contains(body, "123456789012") {
+			t.Errorf("unexpected response %d %s", status, body)
+		}
+		if err := r.Close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(string(data), "123456789012") {
+		t.Errorf("recording was not redacted:\n%s", data)
+	}
+	if strings.Contains(string(data), "Set-Cookie") {
+		t.Errorf("recording contains dropped headers:\n%s", data)
+	}
+
+	// Replay them, with the parameters in a different order and the placeholders
+	server.Close()
+	{
+		r, err := New(path, ModeReplay, options)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		client := r.HTTPClient()
+		if status, _ := get(t, client, server.URL+"/resources/000000000000?a=1&b=2"); status != http.StatusNotFound {
+			t.Errorf("unexpected status %d", status)
+		}
+		resp, err := client.Post(server.URL+"/resources", "application/json", strings.NewReader(`{"account":"000000000000","name":"a"}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusCreated {
+			t.Errorf("unexpected status %d", resp.StatusCode)
+		}
+		status, body := get(t, client, server.URL+"/resources/000000000000?a=1&b=2")
+		if status != http.StatusOK || body != `{"account":"000000000000","state":"available"}` {
+			t.Errorf("unexpected response %d %s", status, body)
+		}
+
+		if _, err := client.Get(server.URL + "/resources/000000000000?a=1&b=2"); err == nil || !strings.Contains(err.Error(), "no recorded interaction") {
+			t.Errorf("expected an error replaying an interaction twice, got %v", err)
+		}
+		if err := r.Close(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestReplayUnused(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.yaml")
+	recording := `interactions:
+- request:
+    method: GET
+    url: https://api.example.com/a
+  response:
+    statusCode: 200
+- request:
+    method: GET
+    url: https://api.example.com/b
+  response:
+    statusCode: 200
+`
+	if err := os.WriteFile(path, []byte(recording), 0o644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r, err := New(path, ModeReplay, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	get(t, r.HTTPClient(), "https://api.example.com/a")
+	if err := r.Close(); err == nil || !strings.Contains(err.Error(), "GET https://api.example.com/b") {
+		t.Errorf("expected an error for the unused interaction, got %v", err)
+	}
+
+	if _, err := New(filepath.Join(t.TempDir(), "missing.yaml"), ModeReplay, Options{}); err == nil || !strings.Contains(err.Error(), EnvRecord) {
+		t.Errorf("expected an error for a missing recording, got %v", err)
+	}
+}
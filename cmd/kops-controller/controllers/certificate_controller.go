@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/pkg/certinventory"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// certificateScanInterval is how often the certificates are scanned
+const certificateScanInterval = time.Hour
+
+// NewCertificateReconciler is the constructor for a CertificateReconciler
+func NewCertificateReconciler(mgr manager.Manager, nodeName string, opt *config.Options) (*CertificateReconciler, error) {
+	if nodeName == "" {
+		return nil, fmt.Errorf("must specify the node name")
+	}
+
+	coreClient, err := corev1client.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building corev1 client: %v", err)
+	}
+
+	r := &CertificateReconciler{
+		coreV1Client: coreClient,
+		recorder:     mgr.GetEventRecorderFor("kops-controller"),
+		nodeName:     nodeName,
+		options:      *opt.Certificates,
+	}
+	return r, nil
+}
+
+// CertificateReconciler periodically lists the certificates on the node it runs on.
+// It records the inventory as an annotation of the node for kops get certs,
+// publishes their expiry as metrics, and emits events for those about to expire.
+type CertificateReconciler struct {
+	// coreV1Client is a client-go client for patching the node
+	coreV1Client corev1client.CoreV1Interface
+
+	// recorder emits the events for expiring certificates
+	recorder record.EventRecorder
+
+	// nodeName is the name of the node we are running on
+	nodeName string
+
+	// options configures the scan
+	options config.CertificatesOptions
+}
+
+// SetupWithManager adds the reconciler to the manager
+func (r *CertificateReconciler) SetupWithManager(mgr manager.Manager) error {
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection is false, because each control plane node holds its own certificates
+func (r *CertificateReconciler) NeedLeaderElection() bool {
+	return false
+}
+
+// Start scans the certificates every certificateScanInterval, until the context is done
+func (r *CertificateReconciler) Start(ctx context.Context) error {
+	klog.Infof("certificates: scanning %v every %v", r.options.Paths, certificateScanInterval)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.scan(ctx, time.Now()); err != nil {
+			klog.Warningf("certificates: error scanning certificates: %v", err)
+		}
+	}, certificateScanInterval)
+
+	return nil
+}
+
+// scan runs a single scan of the certificates
+func (r *CertificateReconciler) scan(ctx context.Context, now time.Time) error {
+	certificates, err := certinventory.Scan(r.options.Paths)
+	if err != nil {
+		return err
+	}
+
+	expiring := certinventory.ExpiringWithin(certificates, now, r.options.ExpiryWindow.Duration)
+	recordCertificates(r.nodeName, certificates, expiring)
+
+	inventory, err := json.Marshal(certificates)
+	if err != nil {
+		return fmt.Errorf("error serializing certificates: %w", err)
+	}
+	annotation := string(inventory)
+	patch, err := json.Marshal(&nodePatch{
+		Metadata: &nodePatchMetadata{
+			Annotations: map[string]*string{certinventory.NodeAnnotation: &annotation},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error building node patch: %w", err)
+	}
+	if _, err := r.coreV1Client.Nodes().Patch(ctx, r.nodeName, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error recording certificates of node %q: %w", r.nodeName, err)
+	}
+
+	// Events are recorded against the node, as the kubelet does
+	ref := &corev1.ObjectReference{
+		Kind: "Node",
+		Name: r.nodeName,
+		UID:  types.UID(r.nodeName),
+	}
+	for _, certificate := range expiring {
+		r.recorder.Eventf(ref, corev1.EventTypeWarning, "CertificateExpiring", "certificate %q in %s expires at %s", certificate.Subject, certificate.Path, certificate.NotAfter.Format(time.RFC3339))
+	}
+
+	klog.V(2).Infof("certificates: found %d certificates on node %q, %d expiring within %v", len(certificates), r.nodeName, len(expiring), r.options.ExpiryWindow.Duration)
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/kops/pkg/certinventory"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	certificateExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_certificate_expiry_timestamp_seconds",
+			Help: "Time at which the certificate file expires. For a file holding several certificates, the earliest expiry.",
+		},
+		[]string{"node", "path"},
+	)
+
+	certificatesExpiring = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_certificates_expiring",
+			Help: "Number of certificates expiring within the configured window.",
+		},
+		[]string{"node"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(certificateExpiry, certificatesExpiring)
+}
+
+// recordCertificates publishes the expiry of the certificates found on a node
+func recordCertificates(nodeName string, certificates, expiring []certinventory.Certificate) {
+	certificateExpiry.Reset()
+	seen := make(map[string]bool)
+	for _, certificate := range certificates {
+		// The certificates are sorted by expiry within a file, so the first one is the earliest
+		if seen[certificate.Path] {
+			continue
+		}
+		seen[certificate.Path] = true
+		certificateExpiry.WithLabelValues(nodeName, certificate.Path).Set(float64(certificate.NotAfter.Unix()))
+	}
+	certificatesExpiring.WithLabelValues(nodeName).Set(float64(len(expiring)))
+}
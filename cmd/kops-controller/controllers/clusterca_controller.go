@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/pkg/pki"
+	"k8s.io/kops/upup/pkg/fi"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// clusterCASyncInterval is how often the published cluster CA is compared with the CA of the control plane
+const clusterCASyncInterval = 10 * time.Minute
+
+// NewClusterCAReconciler is the constructor for a ClusterCAReconciler
+func NewClusterCAReconciler(mgr manager.Manager, opt *config.Options) (*ClusterCAReconciler, error) {
+	if opt.Server == nil || opt.Server.CABasePath == "" {
+		return nil, fmt.Errorf("publishing the cluster CA requires the kops-controller server")
+	}
+
+	coreClient, err := corev1client.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building corev1 client: %w", err)
+	}
+
+	r := &ClusterCAReconciler{
+		coreV1Client: coreClient,
+		caBasePath:   opt.Server.CABasePath,
+		options:      *opt.ClusterCA,
+	}
+	return r, nil
+}
+
+// ClusterCAReconciler publishes the cluster CA of the control plane as a Secret,
+// backing the cert-manager ClusterIssuer for the certificates of the addons.
+// It follows the CA as it is rotated, since the CA files are updated as the control plane is.
+type ClusterCAReconciler struct {
+	// coreV1Client is a client-go client for writing the secret
+	coreV1Client *corev1client.CoreV1Client
+
+	// caBasePath is the directory holding the CA certificate and key files
+	caBasePath string
+
+	// options configures the published secret
+	options config.ClusterCAOptions
+}
+
+// SetupWithManager adds the reconciler to the manager
+func (r *ClusterCAReconciler) SetupWithManager(mgr manager.Manager) error {
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection ensures that only one kops-controller writes the secret
+func (r *ClusterCAReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// Start publishes the cluster CA every interval, until the context is done
+func (r *ClusterCAReconciler) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.reconcile(ctx); err != nil {
+			klog.Warningf("error publishing cluster CA: %v", err)
+		}
+	}, clusterCASyncInterval)
+	return nil
+}
+
+func (r *ClusterCAReconciler) reconcile(ctx context.Context) error {
+	data, err := readClusterCA(r.caBasePath)
+	if err != nil {
+		return err
+	}
+
+	secrets := r.coreV1Client.Secrets(r.options.Namespace)
+	existing, err := secrets.Get(ctx, r.options.SecretName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting secret %s/%s: %w", r.options.Namespace, r.options.SecretName, err)
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      r.options.SecretName,
+				Namespace: r.options.Namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/managed-by": "kops-controller",
+				},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating secret %s/%s: %w", r.options.Namespace, r.options.SecretName, err)
+		}
+		klog.Infof("published cluster CA to secret %s/%s", r.options.Namespace, r.options.SecretName)
+		return nil
+	}
+
+	if reflect.DeepEqual(existing.Data, data) {
+		return nil
+	}
+	existing.Data = data
+	if _, err := secrets.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating secret %s/%s: %w", r.options.Namespace, r.options.SecretName, err)
+	}
+	klog.Infof("updated cluster CA in secret %s/%s", r.options.Namespace, r.options.SecretName)
+	return nil
+}
+
+// readClusterCA reads the primary certificate and key of the cluster CA, in the format of a cert-manager CA issuer secret.
+// ca.crt holds all the trusted certificates of the CA, so that clients keep trusting certificates issued before a rotation.
+func readClusterCA(basePath string) (map[string][]byte, error) {
+	certBytes, err := os.ReadFile(filepath.Join(basePath, fi.CertificateIDCA+".crt"))
+	if err != nil {
+		return nil, fmt.Errorf("reading %q certificate: %w", fi.CertificateIDCA, err)
+	}
+	certificate, err := pki.ParsePEMCertificate(certBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q certificate: %w", fi.CertificateIDCA, err)
+	}
+	keyBytes, err := os.ReadFile(filepath.Join(basePath, fi.CertificateIDCA+".key"))
+	if err != nil {
+		return nil, fmt.Errorf("reading %q key: %w", fi.CertificateIDCA, err)
+	}
+	key, err := pki.ParsePEMPrivateKey(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q key: %w", fi.CertificateIDCA, err)
+	}
+
+	certString, err := certificate.AsString()
+	if err != nil {
+		return nil, err
+	}
+	keyString, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		corev1.TLSCertKey:       []byte(certString),
+		corev1.TLSPrivateKeyKey: []byte(keyString),
+		"ca.crt":                certBytes,
+	}, nil
+}
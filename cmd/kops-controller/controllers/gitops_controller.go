@@ -0,0 +1,457 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	api "k8s.io/kops/pkg/apis/kops"
+	apisutil "k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewGitOpsReconciler is the constructor for a GitOpsReconciler
+func NewGitOpsReconciler(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.Options) (*GitOpsReconciler, error) {
+	if opt.ConfigBase == "" {
+		return nil, fmt.Errorf("must specify configBase")
+	}
+	if opt.GitOps.Interval.Duration <= 0 {
+		return nil, fmt.Errorf("must specify a positive gitOps interval")
+	}
+
+	// The state store holds each cluster under <base>/<cluster name>
+	configBase := strings.TrimSuffix(opt.ConfigBase, "/")
+	if !strings.HasSuffix(configBase, "/"+opt.ClusterName) {
+		return nil, fmt.Errorf("configBase %q does not end with the cluster name %q", opt.ConfigBase, opt.ClusterName)
+	}
+	stateStore := strings.TrimSuffix(configBase, "/"+opt.ClusterName)
+	basePath, err := vfsContext.BuildVfsPath(stateStore)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse state store %q: %w", stateStore, err)
+	}
+
+	coreClient, err := corev1client.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building corev1 client: %w", err)
+	}
+
+	r := &GitOpsReconciler{
+		coreV1Client: coreClient,
+		vfsContext:   vfsContext,
+		clientset:    vfsclientset.NewVFSClientset(vfsContext, basePath),
+		clusterName:  opt.ClusterName,
+		options:      *opt.GitOps,
+	}
+	return r, nil
+}
+
+// GitOpsReconciler periodically compares the cloud resources and addons of the cluster with its desired configuration,
+// applying any changes in reconcile mode and otherwise reporting them.
+type GitOpsReconciler struct {
+	// coreV1Client is a client-go client for listing nodes
+	coreV1Client *corev1client.CoreV1Client
+
+	// vfsContext is used to read the source manifests
+	vfsContext *vfs.VFSContext
+
+	// clientset reads and writes the configuration of the cluster in the state store
+	clientset simple.Clientset
+
+	// clusterName is the name of the cluster we are running in
+	clusterName string
+
+	// options configures the reconciliation
+	options config.GitOpsOptions
+}
+
+// gitOpsDrift are the differences found between the cluster and its desired configuration
+type gitOpsDrift struct {
+	// specChanges are the objects whose source manifest differs from the state store
+	specChanges []string
+	// unmanaged are the instance groups in the state store which are not in the source manifests
+	unmanaged []string
+
+	creates   []string
+	updates   []string
+	deletions []string
+
+	// drifted are the existing cloud resources whose live attributes differ from the model
+	drifted []*fi.DriftedResource
+
+	// report is the dry-run report of the changes to the cloud resources
+	report string
+}
+
+func (d *gitOpsDrift) hasCloudChanges() bool {
+	return len(d.creates)+len(d.updates)+len(d.deletions) != 0
+}
+
+// SetupWithManager adds the reconciler to the manager
+func (r *GitOpsReconciler) SetupWithManager(mgr manager.Manager) error {
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection ensures that only one kops-controller reconciles the cluster
+func (r *GitOpsReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// Start reconciles the cluster every interval, until the context is done
+func (r *GitOpsReconciler) Start(ctx context.Context) error {
+	mode := "drift reporting"
+	if r.options.Reconcile {
+		mode = "reconciling"
+	}
+	klog.Infof("gitops: %s cluster %q every %v", mode, r.clusterName, r.options.Interval.Duration)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.reconcile(ctx); err != nil {
+			gitOpsReconciles.WithLabelValues("error").Inc()
+			klog.Warningf("gitops: error reconciling cluster %q: %v", r.clusterName, err)
+			return
+		}
+		gitOpsReconciles.WithLabelValues("success").Inc()
+		gitOpsLastSuccess.SetToCurrentTime()
+	}, r.options.Interval.Duration)
+
+	return nil
+}
+
+// reconcile runs a single pass, syncing the source manifests to the state store and then the state store to the cloud
+func (r *GitOpsReconciler) reconcile(ctx context.Context) error {
+	drift := &gitOpsDrift{}
+
+	if r.options.Source != "" {
+		if err := r.syncSource(ctx, drift); err != nil {
+			return err
+		}
+	}
+
+	cluster, err := r.clientset.GetCluster(ctx, r.clusterName)
+	if err != nil {
+		return fmt.Errorf("error reading cluster %q: %w", r.clusterName, err)
+	}
+
+	if err := r.update(ctx, cluster, true, drift); err != nil {
+		return err
+	}
+	recordGitOpsDrift(drift)
+
+	if !drift.hasCloudChanges() {
+		klog.V(2).Infof("gitops: cluster %q is up to date", r.clusterName)
+		return nil
+	}
+
+	if !r.options.Reconcile {
+		klog.Infof("gitops: cluster %q has drifted from its configuration:\n%s", r.clusterName, drift.report)
+		return nil
+	}
+
+	klog.Infof("gitops: applying changes to cluster %q:\n%s", r.clusterName, drift.report)
+
+	// The dry-run populates the cluster it was given, so start again from the state store
+	cluster, err = r.clientset.GetCluster(ctx, r.clusterName)
+	if err != nil {
+		return fmt.Errorf("error reading cluster %q: %w", r.clusterName, err)
+	}
+	if err := r.update(ctx, cluster, false, nil); err != nil {
+		return err
+	}
+	gitOpsChangesApplied.Add(float64(len(drift.creates) + len(drift.updates) + len(drift.deletions)))
+	klog.Infof("gitops: applied changes to cluster %q", r.clusterName)
+
+	return nil
+}
+
+// update runs the equivalent of "kops update cluster", recording the changes found in drift when dryRun is set
+func (r *GitOpsReconciler) update(ctx context.Context, cluster *api.Cluster, dryRun bool, drift *gitOpsDrift) error {
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	controlPlaneRunningVersion, err := r.controlPlaneRunningVersion(ctx, cluster.Spec.KubernetesVersion)
+	if err != nil {
+		klog.Warningf("gitops: error checking control plane running version, assuming no k8s upgrade in progress: %v", err)
+	}
+
+	var report bytes.Buffer
+	applyCmd := &cloudup.ApplyClusterCmd{
+		Cloud:                      cloud,
+		Clientset:                  r.clientset,
+		Cluster:                    cluster,
+		DryRun:                     dryRun,
+		TargetName:                 cloudup.TargetDirect,
+		DeletionProcessing:         fi.DeletionProcessingModeDeleteIfNotDeferrred,
+		ControlPlaneRunningVersion: controlPlaneRunningVersion,
+		DryRunOutput:               &report,
+	}
+	if dryRun {
+		applyCmd.TargetName = cloudup.TargetDryRun
+	}
+
+	if _, err := applyCmd.Run(ctx); err != nil {
+		return fmt.Errorf("error updating cluster %q: %w", cluster.Name, err)
+	}
+
+	if dryRun {
+		target := applyCmd.Target.(*fi.CloudupDryRunTarget)
+		creates, updates := target.Changes()
+		drift.creates = sortedTaskNames(creates)
+		drift.updates = sortedTaskNames(updates)
+		drift.deletions = target.Deletions()
+		sort.Strings(drift.deletions)
+		drift.report = report.String()
+		drift.drifted, err = target.Drift(applyCmd.TaskMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// controlPlaneRunningVersion returns the version of the control plane nodes, so that nodes are not upgraded past it
+func (r *GitOpsReconciler) controlPlaneRunningVersion(ctx context.Context, version string) (string, error) {
+	parsedVersion, err := apisutil.ParseKubernetesVersion(version)
+	if err != nil {
+		return version, fmt.Errorf("cannot parse kubernetes version %q: %w", version, err)
+	}
+	nodeList, err := r.coreV1Client.Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: "node-role.kubernetes.io/control-plane",
+	})
+	if err != nil {
+		return version, fmt.Errorf("cannot list nodes: %w", err)
+	}
+	for _, node := range nodeList.Items {
+		if apisutil.IsKubernetesGTE(node.Status.NodeInfo.KubeletVersion, *parsedVersion) {
+			version = node.Status.NodeInfo.KubeletVersion
+			parsedVersion, _ = apisutil.ParseKubernetesVersion(version)
+		}
+	}
+	return strings.TrimPrefix(version, "v"), nil
+}
+
+// syncSource compares the source manifests with the state store, writing any changes in reconcile mode
+func (r *GitOpsReconciler) syncSource(ctx context.Context, drift *gitOpsDrift) error {
+	data, err := r.vfsContext.ReadFile(r.options.Source)
+	if err != nil {
+		return fmt.Errorf("error reading source %q: %w", r.options.Source, err)
+	}
+	cluster, instanceGroups, err := parseGitOpsSource(r.clusterName, data)
+	if err != nil {
+		return fmt.Errorf("error parsing source %q: %w", r.options.Source, err)
+	}
+
+	existing, err := r.clientset.GetCluster(ctx, r.clusterName)
+	if err != nil {
+		return fmt.Errorf("error reading cluster %q: %w", r.clusterName, err)
+	}
+	existingInstanceGroups, err := r.clientset.InstanceGroupsFor(existing).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error reading instance groups of cluster %q: %w", r.clusterName, err)
+	}
+	changedCluster, createdInstanceGroups, updatedInstanceGroups, err := diffGitOpsSource(existing, existingInstanceGroups.Items, cluster, instanceGroups, drift)
+	if err != nil {
+		return err
+	}
+
+	if len(drift.unmanaged) != 0 {
+		klog.Warningf("gitops: %s of cluster %q not in source %q, not deleting", strings.Join(drift.unmanaged, ", "), r.clusterName, r.options.Source)
+	}
+	if len(drift.specChanges) == 0 {
+		return nil
+	}
+	if !r.options.Reconcile {
+		klog.Infof("gitops: %s of cluster %q differ from source %q", strings.Join(drift.specChanges, ", "), r.clusterName, r.options.Source)
+		return nil
+	}
+
+	klog.Infof("gitops: writing %s of cluster %q from source %q", strings.Join(drift.specChanges, ", "), r.clusterName, r.options.Source)
+	if changedCluster != nil {
+		cloud, err := cloudup.BuildCloud(changedCluster)
+		if err != nil {
+			return err
+		}
+		status, err := cloud.FindClusterStatus(changedCluster)
+		if err != nil {
+			return err
+		}
+		if _, err := r.clientset.UpdateCluster(ctx, changedCluster, status); err != nil {
+			return fmt.Errorf("error replacing cluster: %w", err)
+		}
+	}
+	for _, ig := range createdInstanceGroups {
+		if _, err := r.clientset.InstanceGroupsFor(existing).Create(ctx, ig, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error writing instanceGroup %q: %w", ig.Name, err)
+		}
+	}
+	for _, ig := range updatedInstanceGroups {
+		if _, err := r.clientset.InstanceGroupsFor(existing).Update(ctx, ig, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error writing instanceGroup %q: %w", ig.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// diffGitOpsSource compares the source manifests with the state store, recording the differences in drift.
+// It returns the cluster if its spec changed, and the instance groups to create and to update,
+// which must pass the same validation against the desired cluster as "kops edit instancegroup".
+// Instance groups missing from the source are only recorded as unmanaged, as reconciliation never deletes them.
+func diffGitOpsSource(existing *api.Cluster, existingInstanceGroups []api.InstanceGroup, cluster *api.Cluster, instanceGroups []*api.InstanceGroup, drift *gitOpsDrift) (*api.Cluster, []*api.InstanceGroup, []*api.InstanceGroup, error) {
+	existingByName := make(map[string]*api.InstanceGroup)
+	for i := range existingInstanceGroups {
+		ig := &existingInstanceGroups[i]
+		existingByName[ig.Name] = ig
+	}
+
+	var changedCluster *api.Cluster
+	if cluster != nil {
+		changed, err := specChanged(existing.Spec, cluster.Spec)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if changed {
+			drift.specChanges = append(drift.specChanges, "Cluster/"+cluster.Name)
+			changedCluster = cluster
+		}
+	}
+
+	var created, updated []*api.InstanceGroup
+	seen := make(map[string]bool)
+	for _, ig := range instanceGroups {
+		seen[ig.Name] = true
+		current := existingByName[ig.Name]
+		if current == nil {
+			created = append(created, ig)
+		} else {
+			changed, err := specChanged(current.Spec, ig.Spec)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if !changed {
+				continue
+			}
+			updated = append(updated, ig)
+		}
+		drift.specChanges = append(drift.specChanges, "InstanceGroup/"+ig.Name)
+	}
+	for name := range existingByName {
+		if !seen[name] && len(instanceGroups) != 0 {
+			drift.unmanaged = append(drift.unmanaged, "InstanceGroup/"+name)
+		}
+	}
+	sort.Strings(drift.unmanaged)
+
+	// Reject invalid instance groups before anything is written to the state store, rather than failing at reconciliation
+	desiredCluster := existing
+	if cluster != nil {
+		desiredCluster = cluster
+	}
+	for _, igs := range [][]*api.InstanceGroup{created, updated} {
+		for _, ig := range igs {
+			if err := validation.CrossValidateInstanceGroup(ig, desiredCluster, nil, false).ToAggregate(); err != nil {
+				return nil, nil, nil, fmt.Errorf("instanceGroup %q is invalid: %w", ig.Name, err)
+			}
+		}
+	}
+
+	return changedCluster, created, updated, nil
+}
+
+// parseGitOpsSource parses the Cluster and InstanceGroup manifests of the cluster
+func parseGitOpsSource(clusterName string, data []byte) (*api.Cluster, []*api.InstanceGroup, error) {
+	var cluster *api.Cluster
+	var instanceGroups []*api.InstanceGroup
+	names := make(map[string]bool)
+
+	for _, section := range text.SplitContentToSections(data) {
+		o, gvk, err := kopscodecs.Decode(section, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch v := o.(type) {
+		case *api.Cluster:
+			if v.Name != clusterName {
+				return nil, nil, fmt.Errorf("cluster %q is not this cluster %q", v.Name, clusterName)
+			}
+			if cluster != nil {
+				return nil, nil, fmt.Errorf("cluster %q is defined more than once", v.Name)
+			}
+			cluster = v
+		case *api.InstanceGroup:
+			if label := v.ObjectMeta.Labels[api.LabelClusterName]; label != clusterName {
+				return nil, nil, fmt.Errorf("instanceGroup %q must specify %q label with cluster name %q", v.Name, api.LabelClusterName, clusterName)
+			}
+			if names[v.Name] {
+				return nil, nil, fmt.Errorf("instanceGroup %q is defined more than once", v.Name)
+			}
+			names[v.Name] = true
+			instanceGroups = append(instanceGroups, v)
+		default:
+			return nil, nil, fmt.Errorf("unhandled kind %q; only Cluster and InstanceGroup objects can be reconciled", gvk)
+		}
+	}
+
+	if cluster == nil && len(instanceGroups) == 0 {
+		return nil, nil, fmt.Errorf("no Cluster or InstanceGroup objects found")
+	}
+
+	return cluster, instanceGroups, nil
+}
+
+// specChanged compares the serialized specs, so that nil and empty fields are equal
+func specChanged(current, desired interface{}) (bool, error) {
+	a, err := json.Marshal(current)
+	if err != nil {
+		return false, fmt.Errorf("error serializing spec: %w", err)
+	}
+	b, err := json.Marshal(desired)
+	if err != nil {
+		return false, fmt.Errorf("error serializing spec: %w", err)
+	}
+	return !bytes.Equal(a, b), nil
+}
+
+func sortedTaskNames(tasks map[string]fi.CloudupTask) []string {
+	var names []string
+	for name := range tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+const gitOpsTestCluster = `apiVersion: kops.k8s.io/v1alpha2
+kind: Cluster
+metadata:
+  name: dev.example.com
+spec:
+  kubernetesVersion: 1.33.0
+`
+
+const gitOpsTestNodes = `apiVersion: kops.k8s.io/v1alpha2
+kind: InstanceGroup
+metadata:
+  name: nodes
+  labels:
+    kops.k8s.io/cluster: dev.example.com
+spec:
+  role: Node
+  minSize: 2
+  maxSize: 2
+`
+
+func TestParseGitOpsSource(t *testing.T) {
+	grid := []struct {
+		Name                   string
+		Source                 string
+		ExpectedCluster        bool
+		ExpectedInstanceGroups []string
+		ExpectedError          bool
+	}{
+		{
+			Name:                   "cluster and instance groups",
+			Source:                 gitOpsTestCluster + "---\n" + gitOpsTestNodes,
+			ExpectedCluster:        true,
+			ExpectedInstanceGroups: []string{"nodes"},
+		},
+		{
+			Name:                   "instance groups only",
+			Source:                 gitOpsTestNodes,
+			ExpectedInstanceGroups: []string{"nodes"},
+		},
+		{
+			Name:          "other cluster",
+			Source:        `apiVersion: kops.k8s.io/v1alpha2` + "\nkind: Cluster\nmetadata:\n  name: prod.example.com\n",
+			ExpectedError: true,
+		},
+		{
+			Name:          "cluster defined twice",
+			Source:        gitOpsTestCluster + "---\n" + gitOpsTestCluster,
+			ExpectedError: true,
+		},
+		{
+			Name:          "instance group defined twice",
+			Source:        gitOpsTestNodes + "---\n" + gitOpsTestNodes,
+			ExpectedError: true,
+		},
+		{
+			Name:          "instance group without cluster label",
+			Source:        "apiVersion: kops.k8s.io/v1alpha2\nkind: InstanceGroup\nmetadata:\n  name: nodes\nspec:\n  role: Node\n",
+			ExpectedError: true,
+		},
+		{
+			Name:          "other kind",
+			Source:        "apiVersion: kops.k8s.io/v1alpha2\nkind: SSHCredential\nmetadata:\n  name: admin\n",
+			ExpectedError: true,
+		},
+		{
+			Name:          "empty",
+			Source:        "",
+			ExpectedError: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster, instanceGroups, err := parseGitOpsSource("dev.example.com", []byte(g.Source))
+			if g.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error parsing source")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error parsing source: %v", err)
+			}
+			if (cluster != nil) != g.ExpectedCluster {
+				t.Errorf("expected cluster %v, got %v", g.ExpectedCluster, cluster)
+			}
+			var names []string
+			for _, ig := range instanceGroups {
+				names = append(names, ig.Name)
+			}
+			if !reflect.DeepEqual(names, g.ExpectedInstanceGroups) {
+				t.Errorf("expected instance groups %v, got %v", g.ExpectedInstanceGroups, names)
+			}
+		})
+	}
+}
+
+func TestSpecChanged(t *testing.T) {
+	grid := []struct {
+		Name     string
+		Current  api.InstanceGroupSpec
+		Desired  api.InstanceGroupSpec
+		Expected bool
+	}{
+		{
+			Name:    "equal",
+			Current: api.InstanceGroupSpec{Role: api.InstanceGroupRoleNode, MachineType: "t3.medium"},
+			Desired: api.InstanceGroupSpec{Role: api.InstanceGroupRoleNode, MachineType: "t3.medium"},
+		},
+		{
+			Name:    "nil and empty",
+			Current: api.InstanceGroupSpec{Subnets: nil, NodeLabels: nil},
+			Desired: api.InstanceGroupSpec{Subnets: []string{}, NodeLabels: map[string]string{}},
+		},
+		{
+			Name:     "changed",
+			Current:  api.InstanceGroupSpec{MachineType: "t3.medium"},
+			Desired:  api.InstanceGroupSpec{MachineType: "t3.large"},
+			Expected: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			changed, err := specChanged(g.Current, g.Desired)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if changed != g.Expected {
+				t.Errorf("expected changed %v, got %v", g.Expected, changed)
+			}
+		})
+	}
+}
+
+func TestDiffGitOpsSource(t *testing.T) {
+	instanceGroup := func(name string, machineType string) api.InstanceGroup {
+		return api.InstanceGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       api.InstanceGroupSpec{Role: api.InstanceGroupRoleNode, MachineType: machineType},
+		}
+	}
+	existing := &api.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev.example.com"},
+		Spec:       api.ClusterSpec{KubernetesVersion: "1.33.0"},
+	}
+	existingInstanceGroups := []api.InstanceGroup{
+		instanceGroup("nodes-a", "t3.medium"),
+		instanceGroup("nodes-b", "t3.medium"),
+		instanceGroup("nodes-c", "t3.medium"),
+	}
+
+	grid := []struct {
+		Name                string
+		Cluster             *api.Cluster
+		InstanceGroups      []api.InstanceGroup
+		ExpectedCluster     bool
+		ExpectedCreated     []string
+		ExpectedUpdated     []string
+		ExpectedSpecChanges []string
+		ExpectedUnmanaged   []string
+		ExpectedError       bool
+	}{
+		{
+			Name:           "unchanged",
+			Cluster:        existing.DeepCopy(),
+			InstanceGroups: existingInstanceGroups,
+		},
+		{
+			Name: "changed cluster",
+			Cluster: &api.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev.example.com"},
+				Spec:       api.ClusterSpec{KubernetesVersion: "1.33.1"},
+			},
+			ExpectedCluster:     true,
+			ExpectedSpecChanges: []string{"Cluster/dev.example.com"},
+		},
+		{
+			Name: "changed, new and unmanaged instance groups",
+			InstanceGroups: []api.InstanceGroup{
+				instanceGroup("nodes-a", "t3.medium"),
+				instanceGroup("nodes-b", "t3.large"),
+				instanceGroup("nodes-d", "t3.medium"),
+			},
+			ExpectedCreated:     []string{"nodes-d"},
+			ExpectedUpdated:     []string{"nodes-b"},
+			ExpectedSpecChanges: []string{"InstanceGroup/nodes-b", "InstanceGroup/nodes-d"},
+			ExpectedUnmanaged:   []string{"InstanceGroup/nodes-c"},
+		},
+		{
+			Name: "instance group in unknown subnet",
+			InstanceGroups: []api.InstanceGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "nodes-d"},
+					Spec:       api.InstanceGroupSpec{Role: api.InstanceGroupRoleNode, MachineType: "t3.medium", Subnets: []string{"us-east-1z"}},
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "instance group with maxSize less than minSize",
+			InstanceGroups: []api.InstanceGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "nodes-a"},
+					Spec:       api.InstanceGroupSpec{Role: api.InstanceGroupRoleNode, MachineType: "t3.medium", MinSize: fi.PtrTo[int32](3), MaxSize: fi.PtrTo[int32](2)},
+				},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "no instance groups in source",
+			Cluster: &api.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev.example.com"},
+				Spec:       api.ClusterSpec{KubernetesVersion: "1.33.0"},
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			var instanceGroups []*api.InstanceGroup
+			for i := range g.InstanceGroups {
+				instanceGroups = append(instanceGroups, &g.InstanceGroups[i])
+			}
+
+			drift := &gitOpsDrift{}
+			cluster, created, updated, err := diffGitOpsSource(existing, existingInstanceGroups, g.Cluster, instanceGroups, drift)
+			if g.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (cluster != nil) != g.ExpectedCluster {
+				t.Errorf("expected changed cluster %v, got %v", g.ExpectedCluster, cluster)
+			}
+			if names := instanceGroupNames(created); !reflect.DeepEqual(names, g.ExpectedCreated) {
+				t.Errorf("expected created instance groups %v, got %v", g.ExpectedCreated, names)
+			}
+			if names := instanceGroupNames(updated); !reflect.DeepEqual(names, g.ExpectedUpdated) {
+				t.Errorf("expected updated instance groups %v, got %v", g.ExpectedUpdated, names)
+			}
+			if !reflect.DeepEqual(drift.specChanges, g.ExpectedSpecChanges) {
+				t.Errorf("expected spec changes %v, got %v", g.ExpectedSpecChanges, drift.specChanges)
+			}
+			if !reflect.DeepEqual(drift.unmanaged, g.ExpectedUnmanaged) {
+				t.Errorf("expected unmanaged %v, got %v", g.ExpectedUnmanaged, drift.unmanaged)
+			}
+		})
+	}
+}
+
+func instanceGroupNames(instanceGroups []*api.InstanceGroup) []string {
+	var names []string
+	for _, ig := range instanceGroups {
+		names = append(names, ig.Name)
+	}
+	return names
+}
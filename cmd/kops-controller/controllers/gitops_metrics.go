@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	gitOpsReconciles = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kops_controller_gitops_reconciles_total",
+			Help: "Number of reconciliations of the cluster with its desired configuration, partitioned by result.",
+		},
+		[]string{"result"},
+	)
+
+	gitOpsLastSuccess = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_gitops_last_success_timestamp_seconds",
+			Help: "Time of the last successful reconciliation of the cluster.",
+		},
+	)
+
+	gitOpsPendingChanges = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_gitops_pending_changes",
+			Help: "Number of cloud resources found to differ from the desired configuration at the last reconciliation, partitioned by the change needed.",
+		},
+		[]string{"change"},
+	)
+
+	driftedResources = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_drifted_resources",
+			Help: "Number of existing cloud resources whose live attributes differed from the model at the last reconciliation, partitioned by resource type.",
+		},
+		[]string{"task"},
+	)
+
+	gitOpsSpecDrift = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_gitops_spec_drift",
+			Help: "Number of Cluster and InstanceGroup objects whose source manifest differed from the state store at the last reconciliation.",
+		},
+	)
+
+	gitOpsChangesApplied = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "kops_controller_gitops_changes_applied_total",
+			Help: "Number of changes to cloud resources applied by reconciliation.",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(gitOpsReconciles, gitOpsLastSuccess, gitOpsPendingChanges, driftedResources, gitOpsSpecDrift, gitOpsChangesApplied)
+}
+
+// recordGitOpsDrift publishes the drift found by a reconciliation
+func recordGitOpsDrift(drift *gitOpsDrift) {
+	gitOpsPendingChanges.WithLabelValues("create").Set(float64(len(drift.creates)))
+	gitOpsPendingChanges.WithLabelValues("update").Set(float64(len(drift.updates)))
+	gitOpsPendingChanges.WithLabelValues("delete").Set(float64(len(drift.deletions)))
+	gitOpsSpecDrift.Set(float64(len(drift.specChanges)))
+
+	// Reset so that resource types which no longer drift are not reported
+	driftedResources.Reset()
+	for _, r := range drift.drifted {
+		driftedResources.WithLabelValues(r.Task).Inc()
+	}
+}
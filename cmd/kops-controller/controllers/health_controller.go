@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/validation"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewHealthReconciler is the constructor for a HealthReconciler
+func NewHealthReconciler(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.Options) (*HealthReconciler, error) {
+	if opt.ConfigBase == "" {
+		return nil, fmt.Errorf("must specify configBase")
+	}
+	if opt.Health.Interval.Duration <= 0 {
+		return nil, fmt.Errorf("must specify a positive health interval")
+	}
+
+	// The state store holds each cluster under <base>/<cluster name>
+	configBase := strings.TrimSuffix(opt.ConfigBase, "/")
+	if !strings.HasSuffix(configBase, "/"+opt.ClusterName) {
+		return nil, fmt.Errorf("configBase %q does not end with the cluster name %q", opt.ConfigBase, opt.ClusterName)
+	}
+	stateStore := strings.TrimSuffix(configBase, "/"+opt.ClusterName)
+	basePath, err := vfsContext.BuildVfsPath(stateStore)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse state store %q: %w", stateStore, err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	r := &HealthReconciler{
+		restConfig:  mgr.GetConfig(),
+		k8sClient:   k8sClient,
+		clientset:   vfsclientset.NewVFSClientset(vfsContext, basePath),
+		clusterName: opt.ClusterName,
+		options:     *opt.Health,
+	}
+	return r, nil
+}
+
+// HealthReconciler periodically validates the cluster, as kops validate cluster does,
+// and publishes the health score as metrics.
+type HealthReconciler struct {
+	// restConfig is the configuration for connecting to the API server
+	restConfig *rest.Config
+
+	// k8sClient is used by the validation to list nodes and pods
+	k8sClient kubernetes.Interface
+
+	// clientset reads the configuration of the cluster from the state store
+	clientset simple.Clientset
+
+	// clusterName is the name of the cluster we are running in
+	clusterName string
+
+	// options configures the health checks
+	options config.HealthOptions
+}
+
+// SetupWithManager adds the reconciler to the manager
+func (r *HealthReconciler) SetupWithManager(mgr manager.Manager) error {
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection ensures that only one kops-controller publishes the health score
+func (r *HealthReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// Start validates the cluster every interval, until the context is done
+func (r *HealthReconciler) Start(ctx context.Context) error {
+	klog.Infof("health: validating cluster %q every %v", r.clusterName, r.options.Interval.Duration)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		result, err := r.validate(ctx)
+		if err != nil {
+			healthValidations.WithLabelValues("error").Inc()
+			klog.Warningf("health: error validating cluster %q: %v", r.clusterName, err)
+			return
+		}
+		healthValidations.WithLabelValues("success").Inc()
+		recordHealth(result)
+	}, r.options.Interval.Duration)
+
+	return nil
+}
+
+// validate runs a single validation of the cluster
+func (r *HealthReconciler) validate(ctx context.Context) (*validation.ValidationCluster, error) {
+	cluster, err := r.clientset.GetCluster(ctx, r.clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cluster %q: %w", r.clusterName, err)
+	}
+
+	instanceGroups, err := r.clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error reading instance groups of cluster %q: %w", r.clusterName, err)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := validation.NewClusterValidator(cluster, cloud, instanceGroups, nil, nil, r.restConfig, r.k8sClient)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := validator.Validate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(2).Infof("health: cluster %q has health score %d with %d validation failures", r.clusterName, result.Health.Score, len(result.Failures))
+	return result, nil
+}
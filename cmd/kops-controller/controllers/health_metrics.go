@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/kops/pkg/validation"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	healthValidations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kops_controller_health_validations_total",
+			Help: "Number of validations of the cluster run to compute the health score, partitioned by result.",
+		},
+		[]string{"result"},
+	)
+
+	healthLastSuccess = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_health_last_success_timestamp_seconds",
+			Help: "Time of the last successful validation of the cluster.",
+		},
+	)
+
+	healthScore = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_health_score",
+			Help: "Health score of the cluster at the last validation, from 0 to 100.",
+		},
+	)
+
+	healthCategoryScore = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_health_category_score",
+			Help: "Health score of each category of checks at the last validation, from 0 to 100.",
+		},
+		[]string{"category"},
+	)
+
+	healthCategoryFailedChecks = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_health_category_failed_checks",
+			Help: "Number of failed checks of each category at the last validation.",
+		},
+		[]string{"category"},
+	)
+
+	healthValidationFailures = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "kops_controller_health_validation_failures",
+			Help: "Number of validation failures at the last validation, as reported by kops validate cluster.",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(healthValidations, healthLastSuccess, healthScore, healthCategoryScore, healthCategoryFailedChecks, healthValidationFailures)
+}
+
+// recordHealth publishes the health score of a validation
+func recordHealth(result *validation.ValidationCluster) {
+	healthLastSuccess.SetToCurrentTime()
+	healthValidationFailures.Set(float64(len(result.Failures)))
+	if result.Health == nil {
+		return
+	}
+	healthScore.Set(float64(result.Health.Score))
+	for _, category := range result.Health.Categories {
+		healthCategoryScore.WithLabelValues(string(category.Category)).Set(float64(category.Score))
+		healthCategoryFailedChecks.WithLabelValues(string(category.Category)).Set(float64(category.Failed))
+	}
+}
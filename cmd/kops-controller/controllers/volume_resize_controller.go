@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awslog"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewVolumeResizeReconciler is the constructor for a VolumeResizeReconciler
+func NewVolumeResizeReconciler(ctx context.Context, opt *config.Options) (*VolumeResizeReconciler, error) {
+	if opt.VolumeResize.Interval.Duration <= 0 {
+		return nil, fmt.Errorf("must specify a positive volume resize interval")
+	}
+
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, awslog.WithAWSLogger())
+	if err != nil {
+		return nil, fmt.Errorf("error loading default AWS config: %w", err)
+	}
+	resp, err := imds.NewFromConfig(awsConfig).GetRegion(ctx, &imds.GetRegionInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error querying ec2 metadata service (for region): %w", err)
+	}
+
+	cloud, err := awsup.NewAWSCloud(resp.Region, map[string]string{awsup.TagClusterName: opt.ClusterName})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &VolumeResizeReconciler{
+		cloud:       cloud,
+		clusterName: opt.ClusterName,
+		options:     *opt.VolumeResize,
+	}
+	return r, nil
+}
+
+// VolumeResizeReconciler periodically grows the EBS volumes of the instances whose launch template
+// only differs from the launch template of their autoscaling group by larger volumes.
+// The nodes grow their filesystems when they see the new size of their disks.
+type VolumeResizeReconciler struct {
+	// cloud is the AWS cloud of the cluster
+	cloud awsup.AWSCloud
+
+	// clusterName is the name of the cluster we are running in
+	clusterName string
+
+	// options configures the resizing of the volumes
+	options config.VolumeResizeOptions
+}
+
+// SetupWithManager adds the reconciler to the manager
+func (r *VolumeResizeReconciler) SetupWithManager(mgr manager.Manager) error {
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection ensures that only one kops-controller resizes the volumes
+func (r *VolumeResizeReconciler) NeedLeaderElection() bool {
+	return true
+}
+
+// Start resizes the volumes every interval, until the context is done
+func (r *VolumeResizeReconciler) Start(ctx context.Context) error {
+	klog.Infof("volume resize: checking the volumes of cluster %q every %v", r.clusterName, r.options.Interval.Duration)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		resized, err := awsup.ResizeVolumesInPlace(ctx, r.cloud)
+		if len(resized) != 0 {
+			klog.Infof("volume resize: resizing volumes %v of cluster %q", resized, r.clusterName)
+		}
+		if err != nil {
+			klog.Warningf("volume resize: error resizing the volumes of cluster %q: %v", r.clusterName, err)
+		}
+	}, r.options.Interval.Duration)
+
+	return nil
+}
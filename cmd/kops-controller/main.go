@@ -26,11 +26,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/klogr"
 	"k8s.io/kops/cmd/kops-controller/controllers"
 	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/cmd/kops-controller/pkg/probe"
 	"k8s.io/kops/cmd/kops-controller/pkg/server"
 	"k8s.io/kops/pkg/apis/kops/v1alpha2"
 	"k8s.io/kops/pkg/bootstrap"
@@ -73,13 +75,12 @@ func main() {
 
 	klog.InitFlags(nil)
 
-	// Disable metrics by default (avoid port conflicts, also risky because we are host network)
-	metricsAddress := ":0"
-	// flag.StringVar(&metricsAddr, "metrics-addr", metricsAddress, "The address the metric endpoint binds to.")
-
 	configPath := "/etc/kubernetes/kops-controller/config.yaml"
 	flag.StringVar(&configPath, "conf", configPath, "Location of yaml configuration file")
 
+	runProbes := false
+	flag.BoolVar(&runProbes, "probe", runProbes, "Run the connectivity probes of the node named by the NODE_NAME environment variable, instead of the controllers")
+
 	flag.Parse()
 
 	if configPath == "" {
@@ -100,6 +101,19 @@ func main() {
 		}
 	}
 
+	if runProbes {
+		if err := runConnectivityProbes(&opt); err != nil {
+			klog.Fatalf("error running connectivity probes: %v", err)
+		}
+		return
+	}
+
+	// Disable metrics by default (avoid port conflicts, also risky because we are host network)
+	metricsAddress := ":0"
+	if opt.MetricsAddress != "" {
+		metricsAddress = opt.MetricsAddress
+	}
+
 	ctrl.SetLogger(klogr.New())
 
 	scheme, err := buildScheme()
@@ -237,6 +251,31 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := addGitOpsController(mgr, vfsContext, &opt); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GitOpsController")
+		os.Exit(1)
+	}
+
+	if err := addClusterCAController(mgr, &opt); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterCAController")
+		os.Exit(1)
+	}
+
+	if err := addHealthController(mgr, vfsContext, &opt); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "HealthController")
+		os.Exit(1)
+	}
+
+	if err := addCertificateController(mgr, &opt); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CertificateController")
+		os.Exit(1)
+	}
+
+	if err := addVolumeResizeController(ctx, mgr, &opt); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VolumeResizeController")
+		os.Exit(1)
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
@@ -371,6 +410,112 @@ func addGossipController(mgr manager.Manager, opt *config.Options) error {
 	return nil
 }
 
+func addGitOpsController(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.Options) error {
+	if opt.GitOps == nil {
+		return nil
+	}
+
+	controller, err := controllers.NewGitOpsReconciler(mgr, vfsContext, opt)
+	if err != nil {
+		return err
+	}
+
+	if err := controller.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func addClusterCAController(mgr manager.Manager, opt *config.Options) error {
+	if opt.ClusterCA == nil {
+		return nil
+	}
+
+	controller, err := controllers.NewClusterCAReconciler(mgr, opt)
+	if err != nil {
+		return err
+	}
+
+	if err := controller.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func addHealthController(mgr manager.Manager, vfsContext *vfs.VFSContext, opt *config.Options) error {
+	if opt.Health == nil {
+		return nil
+	}
+
+	controller, err := controllers.NewHealthReconciler(mgr, vfsContext, opt)
+	if err != nil {
+		return err
+	}
+
+	if err := controller.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func addCertificateController(mgr manager.Manager, opt *config.Options) error {
+	if opt.Certificates == nil {
+		return nil
+	}
+
+	controller, err := controllers.NewCertificateReconciler(mgr, os.Getenv("NODE_NAME"), opt)
+	if err != nil {
+		return err
+	}
+
+	if err := controller.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func addVolumeResizeController(ctx context.Context, mgr manager.Manager, opt *config.Options) error {
+	if opt.VolumeResize == nil {
+		return nil
+	}
+
+	controller, err := controllers.NewVolumeResizeReconciler(ctx, opt)
+	if err != nil {
+		return err
+	}
+
+	if err := controller.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// runConnectivityProbes probes the endpoints the node depends on until the process is stopped.
+func runConnectivityProbes(opt *config.Options) error {
+	if opt.ConnectivityProbes == nil {
+		return fmt.Errorf("connectivity probes are not configured")
+	}
+
+	nodeName := os.Getenv("NODE_NAME")
+	if nodeName == "" {
+		return fmt.Errorf("NODE_NAME environment variable must be set")
+	}
+
+	coreClient, err := corev1client.NewForConfig(ctrl.GetConfigOrDie())
+	if err != nil {
+		return fmt.Errorf("error building corev1 client: %w", err)
+	}
+
+	prober := probe.NewProber(coreClient, nodeName, *opt.ConnectivityProbes)
+	prober.Run(ctrl.SetupSignalHandler())
+	return nil
+}
+
 // Reconciler is the interface for a standard Reconciler.
 type Reconciler interface {
 	SetupWithManager(mgr manager.Manager) error
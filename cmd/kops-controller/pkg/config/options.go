@@ -17,6 +17,7 @@ limitations under the License.
 package config
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kops/pkg/bootstrap/pkibootstrap"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
@@ -40,6 +41,28 @@ type Options struct {
 
 	// Discovery configures options relating to discovery, particularly for gossip mode.
 	Discovery *DiscoveryOptions `json:"discovery,omitempty"`
+
+	// MetricsAddress is the address on which the Prometheus metrics endpoint listens.
+	// Metrics are disabled if it is not set.
+	MetricsAddress string `json:"metricsAddress,omitempty"`
+
+	// GitOps configures the continuous reconciliation of the cluster with its desired configuration.
+	GitOps *GitOpsOptions `json:"gitOps,omitempty"`
+
+	// ClusterCA configures publishing the cluster CA for the cert-manager ClusterIssuer backed by it.
+	ClusterCA *ClusterCAOptions `json:"clusterCA,omitempty"`
+
+	// ConnectivityProbes configures the probes of the endpoints each node depends on, run by kops-controller --probe.
+	ConnectivityProbes *ConnectivityProbesOptions `json:"connectivityProbes,omitempty"`
+
+	// Health configures the periodic validation of the cluster, publishing its health score as metrics.
+	Health *HealthOptions `json:"health,omitempty"`
+
+	// Certificates configures the inventory of the certificates on each control plane node, and the alerts on their expiry.
+	Certificates *CertificatesOptions `json:"certificates,omitempty"`
+
+	// VolumeResize configures growing the volumes of the existing instances, when only their size changes.
+	VolumeResize *VolumeResizeOptions `json:"volumeResize,omitempty"`
 }
 
 func (o *Options) PopulateDefaults() {
@@ -83,3 +106,63 @@ type DiscoveryOptions struct {
 	// Enabled specifies whether support for discovery population is enabled.
 	Enabled bool `json:"enabled"`
 }
+
+// GitOpsOptions configures the continuous reconciliation of the cluster with its desired configuration.
+type GitOpsOptions struct {
+	// Reconcile applies any changes to the cloud resources and addons; otherwise the changes are only reported.
+	Reconcile bool `json:"reconcile,omitempty"`
+	// Interval is how often the cluster is reconciled.
+	Interval metav1.Duration `json:"interval"`
+	// Source is the location of the Cluster and InstanceGroup manifests, if the state store is not the desired configuration.
+	Source string `json:"source,omitempty"`
+}
+
+// ClusterCAOptions configures publishing the cluster CA as a Secret, for the cert-manager ClusterIssuer backed by it.
+type ClusterCAOptions struct {
+	// Namespace is the namespace of the Secret, which must be the cluster resource namespace of cert-manager.
+	Namespace string `json:"namespace"`
+	// SecretName is the name of the Secret.
+	SecretName string `json:"secretName"`
+}
+
+// HealthOptions configures the periodic validation of the cluster.
+type HealthOptions struct {
+	// Interval is how often the cluster is validated.
+	Interval metav1.Duration `json:"interval"`
+}
+
+// VolumeResizeOptions configures growing the volumes of the existing instances.
+type VolumeResizeOptions struct {
+	// Interval is how often the volumes are compared with the launch templates of their instances.
+	Interval metav1.Duration `json:"interval"`
+}
+
+// CertificatesOptions configures the inventory of the certificates on the node.
+type CertificatesOptions struct {
+	// ExpiryWindow is how long before its expiry a certificate is reported as expiring.
+	ExpiryWindow metav1.Duration `json:"expiryWindow"`
+	// Paths are the directories scanned for certificates.
+	Paths []string `json:"paths,omitempty"`
+}
+
+// ConnectivityProbesOptions configures the connectivity probes run on every node.
+type ConnectivityProbesOptions struct {
+	// Interval is how often the endpoints are probed.
+	Interval metav1.Duration `json:"interval"`
+	// Timeout is how long to wait for a connection to an endpoint.
+	Timeout metav1.Duration `json:"timeout"`
+	// Targets are the endpoints probed from the nodes.
+	Targets []ProbeTarget `json:"targets,omitempty"`
+	// EtcdPeerPorts are the ports probed on the other control plane nodes, from control plane nodes.
+	EtcdPeerPorts []int `json:"etcdPeerPorts,omitempty"`
+}
+
+// ProbeTarget is an endpoint probed from the nodes.
+type ProbeTarget struct {
+	// Name identifies the endpoint in the probe results.
+	Name string `json:"name"`
+	// Address is the host:port to connect to.
+	Address string `json:"address"`
+	// ControlPlaneOnly restricts the probe to control plane nodes.
+	ControlPlaneOnly bool `json:"controlPlaneOnly,omitempty"`
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package probe checks that a node can reach the endpoints it depends on,
+// and records the result as a condition of the node for kops validate cluster.
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+const (
+	controlPlaneLabel = "node-role.kubernetes.io/control-plane"
+
+	reasonSucceeded = "ProbesSucceeded"
+	reasonFailed    = "ProbesFailed"
+)
+
+// Prober probes the endpoints from the node it runs on.
+type Prober struct {
+	coreV1Client corev1client.CoreV1Interface
+	nodeName     string
+	options      config.ConnectivityProbesOptions
+
+	// dial opens a connection to an endpoint, and is replaced in tests
+	dial func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// NewProber is the constructor for a Prober running on the node nodeName.
+func NewProber(coreV1Client corev1client.CoreV1Interface, nodeName string, options config.ConnectivityProbesOptions) *Prober {
+	dialer := &net.Dialer{}
+	return &Prober{
+		coreV1Client: coreV1Client,
+		nodeName:     nodeName,
+		options:      options,
+		dial:         dialer.DialContext,
+	}
+}
+
+// Run probes the endpoints every interval, until the context is cancelled.
+func (p *Prober) Run(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.ProbeOnce(ctx); err != nil {
+			klog.Warningf("error running connectivity probes: %v", err)
+		}
+	}, p.options.Interval.Duration)
+}
+
+// target is an endpoint to probe
+type target struct {
+	name    string
+	address string
+}
+
+// ProbeOnce probes the endpoints and updates the condition of the node.
+func (p *Prober) ProbeOnce(ctx context.Context) error {
+	node, err := p.coreV1Client.Nodes().Get(ctx, p.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("getting node %q: %w", p.nodeName, err)
+	}
+
+	targets, err := p.buildTargets(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	failures := p.probe(ctx, targets)
+
+	condition := corev1.NodeCondition{
+		Type:              kops.NodeConditionConnectivity,
+		Status:            corev1.ConditionTrue,
+		Reason:            reasonSucceeded,
+		Message:           fmt.Sprintf("%d endpoints reachable", len(targets)),
+		LastHeartbeatTime: metav1.Now(),
+	}
+	if len(failures) != 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = reasonFailed
+		condition.Message = strings.Join(failures, "; ")
+	}
+	condition.LastTransitionTime = condition.LastHeartbeatTime
+	for _, existing := range node.Status.Conditions {
+		if existing.Type == condition.Type && existing.Status == condition.Status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []corev1.NodeCondition{condition},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := p.coreV1Client.Nodes().PatchStatus(ctx, p.nodeName, patch); err != nil {
+		return fmt.Errorf("updating condition of node %q: %w", p.nodeName, err)
+	}
+
+	klog.V(2).Infof("connectivity probes of node %q: %s", p.nodeName, condition.Message)
+	return nil
+}
+
+// buildTargets returns the endpoints to probe from the node.
+// Control plane nodes also probe the etcd peer ports of the other control plane nodes.
+func (p *Prober) buildTargets(ctx context.Context, node *corev1.Node) ([]target, error) {
+	_, isControlPlane := node.Labels[controlPlaneLabel]
+
+	var targets []target
+	for _, t := range p.options.Targets {
+		if t.ControlPlaneOnly && !isControlPlane {
+			continue
+		}
+		targets = append(targets, target{name: t.Name, address: t.Address})
+	}
+
+	if isControlPlane && len(p.options.EtcdPeerPorts) != 0 {
+		peers, err := p.coreV1Client.Nodes().List(ctx, metav1.ListOptions{LabelSelector: controlPlaneLabel})
+		if err != nil {
+			return nil, fmt.Errorf("listing control plane nodes: %w", err)
+		}
+		for _, peer := range peers.Items {
+			if peer.Name == node.Name {
+				continue
+			}
+			ip := internalIP(&peer)
+			if ip == "" {
+				continue
+			}
+			for _, port := range p.options.EtcdPeerPorts {
+				targets = append(targets, target{
+					name:    "etcd-peer " + peer.Name,
+					address: net.JoinHostPort(ip, strconv.Itoa(port)),
+				})
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+// probe connects to each of the targets in parallel, and returns the sorted list of failures.
+func (p *Prober) probe(ctx context.Context, targets []target) []string {
+	var mutex sync.Mutex
+	var failures []string
+
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t target) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, p.options.Timeout.Duration)
+			defer cancel()
+
+			start := time.Now()
+			conn, err := p.dial(ctx, "tcp", t.address)
+			if err != nil {
+				klog.V(2).Infof("probe of %s (%s) failed after %v: %v", t.name, t.address, time.Since(start), err)
+				mutex.Lock()
+				failures = append(failures, fmt.Sprintf("%s (%s): %v", t.name, t.address, err))
+				mutex.Unlock()
+				return
+			}
+			conn.Close()
+		}(t)
+	}
+	wg.Wait()
+
+	sort.Strings(failures)
+	return failures
+}
+
+// internalIP returns the first internal address of the node.
+func internalIP(node *corev1.Node) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			return address.Address
+		}
+	}
+	return ""
+}
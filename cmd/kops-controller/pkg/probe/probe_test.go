@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kops/cmd/kops-controller/pkg/config"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func testNode(name string, ip string, controlPlane bool) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{},
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: ip},
+			},
+		},
+	}
+	if controlPlane {
+		node.Labels[controlPlaneLabel] = ""
+	}
+	return node
+}
+
+func TestProbeOnce(t *testing.T) {
+	options := config.ConnectivityProbesOptions{
+		Interval: metav1.Duration{Duration: time.Minute},
+		Timeout:  metav1.Duration{Duration: time.Second},
+		Targets: []config.ProbeTarget{
+			{Name: "api", Address: "api.internal.example.com:443"},
+			{Name: "state-store", Address: "s3.us-east-1.amazonaws.com:443", ControlPlaneOnly: true},
+			{Name: "registry", Address: "registry.k8s.io:443"},
+		},
+		EtcdPeerPorts: []int{3996},
+	}
+
+	grid := []struct {
+		Name            string
+		NodeName        string
+		Unreachable     []string
+		ExpectedDialed  []string
+		ExpectedStatus  corev1.ConditionStatus
+		ExpectedMessage string
+	}{
+		{
+			Name:     "control plane",
+			NodeName: "control-plane-a",
+			ExpectedDialed: []string{
+				"10.0.0.2:3996",
+				"api.internal.example.com:443",
+				"registry.k8s.io:443",
+				"s3.us-east-1.amazonaws.com:443",
+			},
+			ExpectedStatus:  corev1.ConditionTrue,
+			ExpectedMessage: "4 endpoints reachable",
+		},
+		{
+			Name:        "node",
+			NodeName:    "node-a",
+			Unreachable: []string{"registry.k8s.io:443"},
+			ExpectedDialed: []string{
+				"api.internal.example.com:443",
+				"registry.k8s.io:443",
+			},
+			ExpectedStatus:  corev1.ConditionFalse,
+			ExpectedMessage: "registry (registry.k8s.io:443): connection refused",
+		},
+		{
+			Name:        "etcd peer",
+			NodeName:    "control-plane-b",
+			Unreachable: []string{"10.0.0.1:3996"},
+			ExpectedDialed: []string{
+				"10.0.0.1:3996",
+				"api.internal.example.com:443",
+				"registry.k8s.io:443",
+				"s3.us-east-1.amazonaws.com:443",
+			},
+			ExpectedStatus:  corev1.ConditionFalse,
+			ExpectedMessage: "etcd-peer control-plane-a (10.0.0.1:3996): connection refused",
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				testNode("control-plane-a", "10.0.0.1", true),
+				testNode("control-plane-b", "10.0.0.2", true),
+				testNode("node-a", "10.0.1.1", false),
+			)
+
+			dialed := make(chan string, 10)
+			prober := NewProber(client.CoreV1(), g.NodeName, options)
+			prober.dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+				dialed <- address
+				for _, unreachable := range g.Unreachable {
+					if address == unreachable {
+						return nil, fmt.Errorf("connection refused")
+					}
+				}
+				server, conn := net.Pipe()
+				server.Close()
+				return conn, nil
+			}
+
+			if err := prober.ProbeOnce(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			close(dialed)
+
+			var actualDialed []string
+			for address := range dialed {
+				actualDialed = append(actualDialed, address)
+			}
+			sort.Strings(actualDialed)
+			if fmt.Sprint(actualDialed) != fmt.Sprint(g.ExpectedDialed) {
+				t.Errorf("unexpected endpoints probed: %v, expected %v", actualDialed, g.ExpectedDialed)
+			}
+
+			node, err := client.CoreV1().Nodes().Get(context.Background(), g.NodeName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var condition *corev1.NodeCondition
+			for i := range node.Status.Conditions {
+				if node.Status.Conditions[i].Type == kops.NodeConditionConnectivity {
+					condition = &node.Status.Conditions[i]
+				}
+			}
+			if condition == nil {
+				t.Fatalf("condition %s not set on node", kops.NodeConditionConnectivity)
+			}
+			if condition.Status != g.ExpectedStatus {
+				t.Errorf("unexpected status %q, expected %q", condition.Status, g.ExpectedStatus)
+			}
+			if condition.Message != g.ExpectedMessage {
+				t.Errorf("unexpected message %q, expected %q", condition.Message, g.ExpectedMessage)
+			}
+		})
+	}
+}
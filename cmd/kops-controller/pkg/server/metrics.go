@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	bootstrapRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kops_controller_bootstrap_requests_total",
+			Help: "Number of node bootstrap requests handled, partitioned by HTTP status code.",
+		},
+		[]string{"code"},
+	)
+
+	bootstrapRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kops_controller_bootstrap_request_duration_seconds",
+			Help:    "Latency of node bootstrap requests, partitioned by HTTP status code.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+		},
+		[]string{"code"},
+	)
+)
+
+func init() {
+	// The controller-runtime registry is served by the manager's metrics endpoint.
+	metrics.Registry.MustRegister(bootstrapRequests, bootstrapRequestDuration)
+}
+
+// instrumentBootstrap records request counts and latencies for the bootstrap handler.
+func instrumentBootstrap(next http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerCounter(bootstrapRequests,
+		promhttp.InstrumentHandlerDuration(bootstrapRequestDuration, next))
+}
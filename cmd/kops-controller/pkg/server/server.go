@@ -120,7 +120,7 @@ func NewServer(vfsContext *vfs.VFSContext, opt *config.Options, verifier bootstr
 	s.challengeClient = challengeClient
 
 	r := http.NewServeMux()
-	r.Handle("/bootstrap", http.HandlerFunc(s.bootstrap))
+	r.Handle("/bootstrap", instrumentBootstrap(http.HandlerFunc(s.bootstrap)))
 	server.Handler = recovery(r)
 
 	return s, nil
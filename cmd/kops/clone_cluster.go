@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/commands"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	cloneClusterLong = templates.LongDesc(i18n.T(`
+	Create a new cluster with a copy of the configuration of an existing cluster.
+
+	The cluster spec and the instance groups are copied, with the name of the source cluster
+	replaced by the name of the new cluster in all the fields. When --region is set, the region
+	of the source cluster is replaced too, in the zones, subnets and instance group names; the shared
+	network and subnets of the source cluster are then not used by the new cluster.
+
+	The CAs, keys and secrets of the source cluster are not copied: the new cluster gets its own
+	when it is first updated. The SSH public keys are copied.
+
+	The new cluster is only created in the state store; run kops update cluster to create its resources.`))
+
+	cloneClusterExample = templates.Examples(i18n.T(`
+	# Create a staging copy of a production cluster in another region and DNS zone
+	kops clone cluster prod.example.com staging.example.org --region eu-west-1 --dns-zone example.org
+
+	# Review the configuration of the copy without creating it
+	kops clone cluster prod.example.com staging.example.com --dry-run -o yaml
+	`))
+
+	cloneClusterShort = i18n.T(`Create a new cluster with a copy of the configuration of a cluster.`)
+)
+
+type CloneClusterOptions struct {
+	SourceName string
+	commands.CloneClusterOptions
+
+	// CopyAddons copies the addon objects of the source cluster
+	CopyAddons bool
+
+	// DryRun prints the configuration of the new cluster instead of creating it
+	DryRun bool
+	// Output is the format of the configuration printed by DryRun
+	Output string
+}
+
+func NewCmdCloneCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &CloneClusterOptions{
+		Output: OutputYaml,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "cluster SOURCE TARGET",
+		Short:   cloneClusterShort,
+		Long:    cloneClusterLong,
+		Example: cloneClusterExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("must specify the names of the source and new clusters")
+			}
+			options.SourceName = args[0]
+			options.TargetName = args[1]
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return commandutils.CompleteClusterName(f, false, false)(cmd, args, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunCloneCluster(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Region, "region", options.Region, "Region of the new cluster, replacing the region of the source cluster")
+	cmd.Flags().StringVar(&options.DNSZone, "dns-zone", options.DNSZone, "DNS hosted zone of the new cluster")
+	cmd.Flags().BoolVar(&options.CopyAddons, "copy-addons", options.CopyAddons, "Copy the addon objects of the source cluster")
+	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "Print the configuration of the new cluster instead of creating it")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format for --dry-run. One of: yaml, json")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputYaml, OutputJSON}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func RunCloneCluster(ctx context.Context, f *util.Factory, out io.Writer, options *CloneClusterOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	source, err := clientset.GetCluster(ctx, options.SourceName)
+	if err != nil {
+		return err
+	}
+
+	if existing, err := clientset.GetCluster(ctx, options.TargetName); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if existing != nil {
+		return fmt.Errorf("cluster %q already exists", options.TargetName)
+	}
+
+	igList, err := clientset.InstanceGroupsFor(source).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var sourceIGs []*kopsapi.InstanceGroup
+	for i := range igList.Items {
+		sourceIGs = append(sourceIGs, &igList.Items[i])
+	}
+
+	cluster, instanceGroups, warnings, err := commands.CloneCluster(source, sourceIGs, &options.CloneClusterOptions)
+	if err != nil {
+		return err
+	}
+	configBase, err := clientset.ConfigBaseFor(cluster)
+	if err != nil {
+		return fmt.Errorf("error building ConfigBase for cluster: %v", err)
+	}
+	cluster.Spec.ConfigStore.Base = configBase.Path()
+	for _, warning := range warnings {
+		klog.Warningf("%s", warning)
+	}
+
+	var addons kubemanifest.ObjectList
+	if options.CopyAddons {
+		sourceAddons, err := clientset.AddonsFor(source).List(ctx)
+		if err != nil {
+			return fmt.Errorf("error reading the addons of cluster %q: %w", source.ObjectMeta.Name, err)
+		}
+		addons, err = commands.CloneAddons(source, sourceAddons, &options.CloneClusterOptions)
+		if err != nil {
+			return err
+		}
+	}
+
+	if options.DryRun {
+		var obj []runtime.Object
+		obj = append(obj, cluster)
+		for _, ig := range instanceGroups {
+			obj = append(obj, ig)
+		}
+		for _, o := range addons {
+			obj = append(obj, o.ToUnstructured())
+		}
+
+		switch options.Output {
+		case OutputYaml:
+			return fullOutputYAML(out, obj...)
+		case OutputJSON:
+			return fullOutputJSON(out, true, obj...)
+		default:
+			return fmt.Errorf("unsupported output type %q", options.Output)
+		}
+	}
+
+	if err := registry.CreateClusterConfig(ctx, clientset, cluster, instanceGroups, addons); err != nil {
+		return fmt.Errorf("error writing the configuration of cluster %q: %w", cluster.ObjectMeta.Name, err)
+	}
+
+	if err := copySSHPublicKeys(ctx, clientset, source, cluster); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Created cluster %q from cluster %q\n", cluster.ObjectMeta.Name, source.ObjectMeta.Name)
+	fmt.Fprintf(out, "Review the configuration with kops edit cluster --name %s, then create the cluster with kops update cluster --name %s --yes\n", cluster.ObjectMeta.Name, cluster.ObjectMeta.Name)
+	return nil
+}
+
+// copySSHPublicKeys copies the SSH public keys of a cluster to another.
+// They are not secret, and are needed to access the instances of the new cluster.
+func copySSHPublicKeys(ctx context.Context, clientset simple.Clientset, source *kopsapi.Cluster, target *kopsapi.Cluster) error {
+	sourceSSHCredentialStore, err := clientset.SSHCredentialStore(source)
+	if err != nil {
+		return err
+	}
+	sshCredentials, err := sourceSSHCredentialStore.FindSSHPublicKeys()
+	if err != nil {
+		return fmt.Errorf("error reading the SSH public keys of cluster %q: %w", source.ObjectMeta.Name, err)
+	}
+	if len(sshCredentials) == 0 {
+		return nil
+	}
+
+	sshCredentialStore, err := clientset.SSHCredentialStore(target)
+	if err != nil {
+		return err
+	}
+	for _, sshCredential := range sshCredentials {
+		if err := sshCredentialStore.AddSSHPublicKey(ctx, []byte(sshCredential.Spec.PublicKey)); err != nil {
+			return fmt.Errorf("error adding SSH public key: %w", err)
+		}
+	}
+	return nil
+}
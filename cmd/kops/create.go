@@ -24,11 +24,14 @@ import (
 
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple/dryrun"
+	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kopscodecs"
 	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
@@ -39,6 +42,8 @@ import (
 
 type CreateOptions struct {
 	Filenames []string
+	// DryRun previews the resources which would be created
+	DryRun commandutils.DryRunStrategy
 }
 
 var (
@@ -57,6 +62,9 @@ var (
 
 	# Create an instancegroup based on the YAML passed into stdin.
 	cat instancegroup.yaml | kops create -f -
+
+	# Show the changes to the state store, without making them.
+	kops create -f my-cluster.yaml --dry-run=server
 	`))
 
 	createShort = i18n.T("Create a resource by command line, filename or stdin.")
@@ -78,6 +86,7 @@ func NewCmdCreate(f *util.Factory, out io.Writer) *cobra.Command {
 
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename to use to create the resource")
 	cmd.MarkFlagRequired("filename")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 	cmd.RegisterFlagCompletionFunc("filename", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{"yaml", "json"}, cobra.ShellCompDirectiveFilterFileExt
 	})
@@ -93,7 +102,7 @@ func NewCmdCreate(f *util.Factory, out io.Writer) *cobra.Command {
 }
 
 func RunCreate(ctx context.Context, f *util.Factory, out io.Writer, c *CreateOptions) error {
-	clientset, err := f.KopsClient()
+	clientset, err := dryRunClientset(f, c.DryRun)
 	if err != nil {
 		return err
 	}
@@ -129,6 +138,15 @@ func RunCreate(ctx context.Context, f *util.Factory, out io.Writer, c *CreateOpt
 				return fmt.Errorf("error parsing file %q: %v", f, err)
 			}
 
+			if c.DryRun == commandutils.DryRunClient {
+				accessor, err := meta.Accessor(o)
+				if err != nil {
+					return fmt.Errorf("unhandled kind %q in %s", gvk, f)
+				}
+				printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionCreate, Kind: gvk.Kind, Name: accessor.GetName()}, c.DryRun)
+				continue
+			}
+
 			switch v := o.(type) {
 			case *kopsapi.Cluster:
 				cloud, err := cloudup.BuildCloud(v)
@@ -212,6 +230,10 @@ func RunCreate(ctx context.Context, f *util.Factory, out io.Writer, c *CreateOpt
 		}
 	}
 
+	if c.DryRun == commandutils.DryRunClient {
+		return nil
+	}
+
 	// Because not all addons support labels, we can only support one cluster here.
 	// A single cluster per create is probably a good idea anyway.
 	if len(addons) != 0 {
@@ -230,6 +252,10 @@ func RunCreate(ctx context.Context, f *util.Factory, out io.Writer, c *CreateOpt
 		}
 	}
 
+	if c.DryRun == commandutils.DryRunServer {
+		return printDryRunChanges(out, clientset)
+	}
+
 	{
 		// If there is a value in this sb, this should mean that we have something to deploy
 		// so let's advise the user how to engage the cloud provider and deploy
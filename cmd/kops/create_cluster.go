@@ -95,8 +95,8 @@ type CreateClusterOptions struct {
 
 	OpenstackNetworkID string
 
-	// DryRun mode output a cluster manifest of Output type.
-	DryRun bool
+	// DryRun mode output a cluster manifest of Output type, or the changes to the state store for a server-side dry run.
+	DryRun commandutils.DryRunStrategy
 	// Output type during a DryRun
 	Output string
 
@@ -206,7 +206,7 @@ func NewCmdCreateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Specify --yes to immediately create the cluster")
-	cmd.Flags().Var(&options.Target, "target", fmt.Sprintf("Valid targets: %q, %q. Set this flag to %q if you want kOps to generate terraform", cloudup.TargetDirect, cloudup.TargetTerraform, cloudup.TargetTerraform))
+	cmd.Flags().Var(&options.Target, "target", fmt.Sprintf("Valid targets: %q, %q, %q, %q. Set this flag to %q if you want kOps to generate terraform", cloudup.TargetDirect, cloudup.TargetTerraform, cloudup.TargetPulumi, cloudup.TargetCrossplane, cloudup.TargetTerraform))
 	cmd.RegisterFlagCompletionFunc("target", completeCreateClusterTarget(options))
 
 	// Configuration / state location
@@ -405,8 +405,8 @@ func NewCmdCreateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	})
 
 	// DryRun mode that will print YAML or JSON
-	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "If true, only print the object that would be sent, without sending it. This flag can be used to create a cluster YAML or JSON manifest.")
-	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of json or yaml. Used with the --dry-run flag.")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of json or yaml. Used with the --dry-run=client flag.")
 	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{"json", "yaml"}, cobra.ShellCompDirectiveNoFileComp
 	})
@@ -494,7 +494,7 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 		targetName = cloudup.TargetDryRun
 	}
 
-	if c.DryRun && c.Output == "" {
+	if c.DryRun == commandutils.DryRunClient && c.Output == "" {
 		return fmt.Errorf("unable to execute --dry-run without setting --output")
 	}
 
@@ -503,12 +503,16 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 	if c.OutDir == "" {
 		if c.Target == cloudup.TargetTerraform {
 			c.OutDir = "out/terraform"
+		} else if c.Target == cloudup.TargetPulumi {
+			c.OutDir = "out/pulumi"
+		} else if c.Target == cloudup.TargetCrossplane {
+			c.OutDir = "out/crossplane"
 		} else {
 			c.OutDir = "out"
 		}
 	}
 
-	clientset, err := f.KopsClient()
+	clientset, err := dryRunClientset(f, c.DryRun)
 	if err != nil {
 		return err
 	}
@@ -695,7 +699,7 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 		}
 	}
 
-	if c.DryRun {
+	if c.DryRun == commandutils.DryRunClient {
 		var obj []runtime.Object
 		obj = append(obj, cluster)
 
@@ -792,6 +796,10 @@ func RunCreateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Cr
 		}
 	}
 
+	if c.DryRun == commandutils.DryRunServer {
+		return printDryRunChanges(out, clientset)
+	}
+
 	// Can we actually get to this if??
 	if targetName != "" {
 		if isDryrun {
@@ -1022,6 +1030,16 @@ func completeCreateClusterTarget(options *CreateClusterOptions) func(cmd *cobra.
 				completions = append(completions, cloudup.TargetTerraform)
 			}
 		}
+		for _, cp := range cloudup.PulumiCloudProviders {
+			if options.CloudProvider == string(cp) {
+				completions = append(completions, cloudup.TargetPulumi)
+			}
+		}
+		for _, cp := range cloudup.CrossplaneCloudProviders {
+			if options.CloudProvider == string(cp) {
+				completions = append(completions, cloudup.TargetCrossplane)
+			}
+		}
 		return toStringSlice(completions), cobra.ShellCompDirectiveNoFileComp
 	}
 }
@@ -34,7 +34,6 @@ import (
 	kopsapi "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/validation"
 	"k8s.io/kops/pkg/commands/commandutils"
-	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/kopscodecs"
 	"k8s.io/kops/pkg/try"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
@@ -49,7 +48,7 @@ type CreateInstanceGroupOptions struct {
 	Role              string
 	Subnets           []string
 	// DryRun mode output an ig manifest of Output type.
-	DryRun bool
+	DryRun commandutils.DryRunStrategy
 	// Output type during a DryRun
 	Output string
 	// Edit will launch an editor when creating an instance group
@@ -123,11 +122,10 @@ func NewCmdCreateInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
 		},
 	}
 
+	// The apiserver role also needs the Large control plane profile or the APIServerNodes feature flag,
+	// which is checked against the cluster when the instance group is created
 	allRoles := make([]string, 0, len(kopsapi.AllInstanceGroupRoles))
 	for _, r := range kopsapi.AllInstanceGroupRoles {
-		if r == kopsapi.InstanceGroupRoleAPIServer && !featureflag.APIServerNodes.Enabled() {
-			continue
-		}
 		allRoles = append(allRoles, r.ToLowerString())
 	}
 
@@ -138,8 +136,8 @@ func NewCmdCreateInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVar(&options.Subnets, "subnet", options.Subnets, "Subnet in which to create instance group. One of Availability Zone like eu-west-1a or a comma-separated list of multiple Availability Zones.")
 	cmd.RegisterFlagCompletionFunc("subnet", completeClusterSubnet(f, &options.Subnets))
 	// DryRun mode that will print YAML or JSON
-	cmd.Flags().BoolVar(&options.DryRun, "dry-run", options.DryRun, "Only print the object that would be created, without created it. This flag can be used to create an instance group YAML or JSON manifest.")
-	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of json or yaml")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of json or yaml. Used with the --dry-run=client flag.")
 	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{"json", "yaml"}, cobra.ShellCompDirectiveNoFileComp
 	})
@@ -154,7 +152,7 @@ func RunCreateInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer,
 		return fmt.Errorf("error getting cluster: %q: %v", options.ClusterName, err)
 	}
 
-	clientset, err := f.KopsClient()
+	clientset, err := dryRunClientset(f, options.DryRun)
 	if err != nil {
 		return err
 	}
@@ -204,7 +202,7 @@ func RunCreateInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer,
 		ig.Spec.NodeLabels["cloud.google.com/metadata-proxy-ready"] = "true"
 	}
 
-	if options.DryRun {
+	if options.DryRun == commandutils.DryRunClient {
 
 		if options.Output == "" {
 			return fmt.Errorf("must set output flag; yaml or json")
@@ -271,6 +269,11 @@ func RunCreateInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer,
 	if err != nil {
 		return fmt.Errorf("error storing InstanceGroup: %v", err)
 	}
+	printInstanceGroupWarnings(out, ig, cluster)
+
+	if options.DryRun == commandutils.DryRunServer {
+		return printDryRunChanges(out, clientset)
+	}
 
 	return nil
 }
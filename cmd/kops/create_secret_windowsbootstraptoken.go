@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/tokens"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	createSecretWindowsBootstrapTokenLong = templates.LongDesc(i18n.T(`
+	Generate a bootstrap token for Windows nodes and store it in the state store.
+	Windows nodes do not run nodeup, and use this token to request their kubelet
+	client certificate from the API server.
+
+	Replacing the token with --force requires the Windows instance groups to be
+	updated and rolled, as the token is part of their user data.`))
+
+	createSecretWindowsBootstrapTokenExample = templates.Examples(i18n.T(`
+	# Generate the bootstrap token of the Windows nodes.
+	kops create secret windowsbootstraptoken \
+		--name k8s-cluster.example.com --state s3://my-state-store
+
+	# Replace the bootstrap token of the Windows nodes.
+	kops create secret windowsbootstraptoken --force \
+		--name k8s-cluster.example.com --state s3://my-state-store
+	`))
+
+	createSecretWindowsBootstrapTokenShort = i18n.T(`Generate a bootstrap token for Windows nodes.`)
+)
+
+type CreateSecretWindowsBootstrapTokenOptions struct {
+	ClusterName string
+	Force       bool
+}
+
+func NewCmdCreateSecretWindowsBootstrapToken(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &CreateSecretWindowsBootstrapTokenOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "windowsbootstraptoken [CLUSTER]",
+		Short:             createSecretWindowsBootstrapTokenShort,
+		Long:              createSecretWindowsBootstrapTokenLong,
+		Example:           createSecretWindowsBootstrapTokenExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunCreateSecretWindowsBootstrapToken(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Force, "force", options.Force, "Force replace the secret if it already exists")
+
+	return cmd
+}
+
+func RunCreateSecretWindowsBootstrapToken(ctx context.Context, f commandutils.Factory, out io.Writer, options *CreateSecretWindowsBootstrapTokenOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		return err
+	}
+
+	token, err := tokens.GenerateBootstrapToken()
+	if err != nil {
+		return err
+	}
+
+	secret := &fi.Secret{
+		Data: []byte(token),
+	}
+
+	if !options.Force {
+		_, created, err := secretStore.GetOrCreateSecret(ctx, tokens.WindowsBootstrapTokenSecretName, secret)
+		if err != nil {
+			return fmt.Errorf("error adding Windows bootstrap token secret: %v", err)
+		}
+		if !created {
+			return fmt.Errorf("failed to create the Windows bootstrap token secret as it already exists. Pass the `--force` flag to replace an existing secret")
+		}
+	} else {
+		_, err := secretStore.ReplaceSecret(tokens.WindowsBootstrapTokenSecretName, secret)
+		if err != nil {
+			return fmt.Errorf("updating Windows bootstrap token secret: %v", err)
+		}
+	}
+
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kopscodecs"
 	"k8s.io/kops/util/pkg/text"
 	"k8s.io/kubectl/pkg/util/i18n"
@@ -35,6 +36,8 @@ import (
 type DeleteOptions struct {
 	Filenames []string
 	Yes       bool
+	// DryRun previews the deletions
+	DryRun commandutils.DryRunStrategy
 }
 
 var (
@@ -44,6 +47,9 @@ var (
 
 		# Delete a cluster using a pasted manifest file from stdin.
 		pbpaste | kops delete -f -
+
+		# Show what would be deleted, without deleting it
+		kops delete -f my-cluster.yaml --dry-run=server
 	`))
 
 	deleteShort = i18n.T("Delete clusters, instancegroups, instances, and secrets.")
@@ -65,6 +71,7 @@ func NewCmdDelete(f *util.Factory, out io.Writer) *cobra.Command {
 
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename to use to delete the resource")
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Specify --yes to immediately delete the resource")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 	cmd.MarkFlagRequired("filename")
 
 	// create subcommands
@@ -108,6 +115,7 @@ func RunDelete(ctx context.Context, factory *util.Factory, out io.Writer, d *Del
 				options := &DeleteClusterOptions{
 					ClusterName: v.ObjectMeta.Name,
 					Yes:         d.Yes,
+					DryRun:      d.DryRun,
 				}
 				err = RunDeleteCluster(ctx, factory, out, options)
 				if err != nil {
@@ -119,6 +127,7 @@ func RunDelete(ctx context.Context, factory *util.Factory, out io.Writer, d *Del
 					GroupName:   v.ObjectMeta.Name,
 					ClusterName: v.ObjectMeta.Labels[kopsapi.LabelClusterName],
 					Yes:         d.Yes,
+					DryRun:      d.DryRun,
 				}
 
 				// If the cluster has been already deleted we cannot delete the ig
@@ -134,6 +143,7 @@ func RunDelete(ctx context.Context, factory *util.Factory, out io.Writer, d *Del
 			case *kopsapi.SSHCredential:
 				options := &DeleteSSHPublicKeyOptions{
 					ClusterName: v.ObjectMeta.Labels[kopsapi.LabelClusterName],
+					DryRun:      d.DryRun,
 				}
 
 				err = RunDeleteSSHPublicKey(ctx, factory, out, options)
@@ -20,13 +20,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple/dryrun"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kubeconfig"
 	"k8s.io/kops/pkg/resources"
@@ -35,6 +38,7 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kops/util/pkg/ui"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 )
@@ -45,9 +49,14 @@ type DeleteClusterOptions struct {
 	External    bool
 	Unregister  bool
 	ClusterName string
-	wait        time.Duration
-	count       int
-	interval    time.Duration
+	// ConfirmName is the name of the cluster, confirming the deletion without prompting for it
+	ConfirmName string
+	// DryRun previews the deletion
+	DryRun commandutils.DryRunStrategy
+
+	wait     time.Duration
+	count    int
+	interval time.Duration
 }
 
 func (o *DeleteClusterOptions) InitDefaults() {
@@ -60,6 +69,10 @@ var (
 	deleteClusterLong = templates.LongDesc(i18n.T(`
 	Deletes a Kubernetes cluster and all associated resources.  Resources include instancegroups,
 	secrets, and the state store.  There is no "UNDO" for this command.
+
+	When run in a terminal, the name of the cluster must be typed to confirm the deletion,
+	unless it is passed with --confirm-name. Without a terminal, --confirm-name is required. Clusters with spec.deletionProtection set cannot be deleted
+	until it is unset with kops edit cluster --unset spec.deletionProtection.
 	`))
 
 	deleteClusterExample = templates.Examples(i18n.T(`
@@ -67,6 +80,16 @@ var (
 	# The --yes option runs the command immediately.
 	kops delete cluster --name=k8s.cluster.site --yes
 
+	# Delete a cluster without prompting for its name, as required without a terminal.
+	kops delete cluster --name=k8s.cluster.site --yes --confirm-name=k8s.cluster.site
+
+	# Remove the deletion protection of a cluster, then delete it.
+	kops edit cluster k8s.cluster.site --unset spec.deletionProtection
+	kops delete cluster --name=k8s.cluster.site --yes
+
+	# Show the cloud resources and the state store entries which would be deleted.
+	kops delete cluster --name=k8s.cluster.site --dry-run=server
+
 	`))
 
 	deleteClusterShort = i18n.T("Delete a cluster.")
@@ -89,6 +112,8 @@ func NewCmdDeleteCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Specify --yes to delete the cluster")
+	cmd.Flags().StringVar(&options.ConfirmName, "confirm-name", options.ConfirmName, "Name of the cluster, confirming the deletion without prompting for it")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 	cmd.Flags().BoolVar(&options.Unregister, "unregister", options.Unregister, "Don't delete cloud resources, just unregister the cluster")
 	cmd.Flags().BoolVar(&options.External, "external", options.External, "Delete an external cluster")
 
@@ -108,6 +133,11 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 		return fmt.Errorf("--name is required (for safety)")
 	}
 
+	if options.DryRun == commandutils.DryRunClient {
+		printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionDelete, Kind: "Cluster", Name: clusterName}, options.DryRun)
+		return nil
+	}
+
 	var cloud fi.Cloud
 	var cluster *kopsapi.Cluster
 	var err error
@@ -128,8 +158,13 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 		if err != nil {
 			return err
 		}
+		if cluster.Spec.DeletionProtection {
+			return fmt.Errorf("cluster %q has deletion protection enabled; run \"kops edit cluster %s --unset spec.deletionProtection\" to allow its deletion", clusterName, clusterName)
+		}
 	}
 
+	confirmed := false
+
 	wouldDeleteCloudResources := false
 
 	if !options.Unregister {
@@ -179,20 +214,41 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 				return err
 			}
 
-			if !options.Yes {
+			if !options.Yes && !options.DryRun.Enabled() {
 				fmt.Fprintf(out, "\nMust specify --yes to delete cluster\n")
 				return nil
 			}
 
 			fmt.Fprintf(out, "\n")
 
-			err = resourceops.DeleteResources(cloud, clusterResources, options.count, options.interval, options.wait)
-			if err != nil {
-				return err
+			if !options.DryRun.Enabled() {
+				if err := confirmClusterName(out, clusterName, options.ConfirmName, "delete"); err != nil {
+					return err
+				}
+				confirmed = true
+
+				err = resourceops.DeleteResources(cloud, clusterResources, options.count, options.interval, options.wait)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
 
+	if options.DryRun.Enabled() {
+		if options.External {
+			return nil
+		}
+		clientset, err := dryRunClientset(f, options.DryRun)
+		if err != nil {
+			return err
+		}
+		if err := clientset.DeleteCluster(ctx, cluster); err != nil {
+			return fmt.Errorf("error removing cluster from state store: %v", err)
+		}
+		return printDryRunChanges(out, clientset)
+	}
+
 	if !options.External {
 		if !options.Yes {
 			if wouldDeleteCloudResources {
@@ -202,6 +258,11 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 			}
 			return nil
 		}
+		if !confirmed {
+			if err := confirmClusterName(out, clusterName, options.ConfirmName, "unregister"); err != nil {
+				return err
+			}
+		}
 		clientset, err := f.KopsClient()
 		if err != nil {
 			return err
@@ -223,6 +284,39 @@ func RunDeleteCluster(ctx context.Context, f *util.Factory, out io.Writer, optio
 	return nil
 }
 
+// confirmClusterName guards a destructive command against acting on the wrong cluster, for example
+// because KOPS_STATE_STORE points to another environment. The name of the cluster must match
+// --confirm-name if set or, when running in a terminal, be typed at the prompt.
+// Without a terminal, --confirm-name is required.
+func confirmClusterName(out io.Writer, clusterName string, confirmName string, action string) error {
+	return confirmClusterNameInteractive(out, clusterName, confirmName, action, term.IsTerminal(int(os.Stdin.Fd())))
+}
+
+func confirmClusterNameInteractive(out io.Writer, clusterName string, confirmName string, action string, interactive bool) error {
+	if confirmName != "" {
+		if confirmName != clusterName {
+			return fmt.Errorf("--confirm-name %q does not match the cluster %q", confirmName, clusterName)
+		}
+		return nil
+	}
+	if !interactive {
+		return fmt.Errorf("not running in a terminal; pass --confirm-name=%s to %s the cluster", clusterName, action)
+	}
+
+	confirmed, err := ui.GetConfirmName(&ui.ConfirmNameArgs{
+		Out:     out,
+		Message: fmt.Sprintf("This will %s the cluster %q.", action, clusterName),
+		Name:    clusterName,
+	})
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return fmt.Errorf("the typed name does not match the cluster %q; not proceeding", clusterName)
+	}
+	return nil
+}
+
 func completeRegion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	// TODO call into cloud provider(s) to get list of valid regions
 	return nil, cobra.ShellCompDirectiveNoFileComp
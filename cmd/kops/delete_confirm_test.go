@@ -66,3 +66,46 @@ func TestConfirmation(t *testing.T) {
 		t.Fatal("Confirmation should have been approved.")
 	}
 }
+
+func TestConfirmName(t *testing.T) {
+	var out bytes.Buffer
+	c := &ui.ConfirmNameArgs{
+		Message: "This will delete the cluster \"k8s.cluster.site\".",
+		Name:    "k8s.cluster.site",
+		Out:     &out,
+		TestVal: "other.cluster.site",
+	}
+
+	answer, err := ui.GetConfirmName(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Type the name \"k8s.cluster.site\" to confirm") {
+		t.Fatal("Prompt not in output")
+	}
+	if answer {
+		t.Fatal("Confirmation should have been denied for another name.")
+	}
+
+	c.TestVal = " k8s.cluster.site \n"
+	answer, err = ui.GetConfirmName(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !answer {
+		t.Fatal("Confirmation should have been approved.")
+	}
+}
+
+func TestConfirmClusterName(t *testing.T) {
+	var out bytes.Buffer
+	if err := confirmClusterName(&out, "k8s.cluster.site", "k8s.cluster.site", "delete"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := confirmClusterName(&out, "k8s.cluster.site", "other.cluster.site", "delete"); err == nil {
+		t.Fatal("expected an error for a mismatched --confirm-name")
+	}
+	if err := confirmClusterNameInteractive(&out, "k8s.cluster.site", "", "delete", false); err == nil {
+		t.Fatal("expected an error without --confirm-name and without a terminal")
+	}
+}
@@ -26,6 +26,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple/dryrun"
+	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/instancegroups"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/util/pkg/ui"
@@ -45,6 +47,9 @@ var (
 		# The --yes option runs the command immediately.
 		# Note that the cloud resources will be deleted immediately, without running "kops update cluster"
 		kops delete ig --name=k8s-cluster.example.com node-example --yes
+
+		# Show the changes to the state store, without making them.
+		kops delete ig --name=k8s-cluster.example.com node-example --dry-run=server
 		`))
 
 	deleteInstanceGroupShort = i18n.T(`Delete instance group.`)
@@ -54,6 +59,8 @@ type DeleteInstanceGroupOptions struct {
 	Yes         bool
 	ClusterName string
 	GroupName   string
+	// DryRun previews the deletion
+	DryRun commandutils.DryRunStrategy
 }
 
 func NewCmdDeleteInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
@@ -88,7 +95,7 @@ func NewCmdDeleteInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
-			if !options.Yes {
+			if !options.Yes && !options.DryRun.Enabled() {
 				message := fmt.Sprintf("Do you really want to delete instance group %q? This action cannot be undone.", options.GroupName)
 
 				c := &ui.ConfirmArgs{
@@ -114,6 +121,7 @@ func NewCmdDeleteInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Specify --yes to immediately delete the instance group")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 
 	return cmd
 }
@@ -127,12 +135,17 @@ func RunDeleteInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer,
 		return fmt.Errorf("GroupName is required")
 	}
 
+	if options.DryRun == commandutils.DryRunClient {
+		printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionDelete, Kind: "InstanceGroup", Name: groupName}, options.DryRun)
+		return nil
+	}
+
 	cluster, err := GetCluster(ctx, f, options.ClusterName)
 	if err != nil {
 		return err
 	}
 
-	clientset, err := f.KopsClient()
+	clientset, err := dryRunClientset(f, options.DryRun)
 	if err != nil {
 		return err
 	}
@@ -166,6 +179,13 @@ func RunDeleteInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer,
 		}
 	}
 
+	if options.DryRun == commandutils.DryRunServer {
+		if err := clientset.InstanceGroupsFor(cluster).Delete(ctx, groupName, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		return printDryRunChanges(out, clientset)
+	}
+
 	if !options.Yes {
 		fmt.Fprintf(out, "\nMust specify --yes to delete instancegroup\n")
 		return nil
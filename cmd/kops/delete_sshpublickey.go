@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/client/simple/dryrun"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
@@ -40,6 +41,8 @@ var (
 
 type DeleteSSHPublicKeyOptions struct {
 	ClusterName string
+	// DryRun previews the deletion
+	DryRun commandutils.DryRunStrategy
 }
 
 func NewCmdDeleteSSHPublicKey(f *util.Factory, out io.Writer) *cobra.Command {
@@ -58,11 +61,18 @@ func NewCmdDeleteSSHPublicKey(f *util.Factory, out io.Writer) *cobra.Command {
 		},
 	}
 
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
+
 	return cmd
 }
 
 func RunDeleteSSHPublicKey(ctx context.Context, f *util.Factory, out io.Writer, options *DeleteSSHPublicKeyOptions) error {
-	clientset, err := f.KopsClient()
+	if options.DryRun == commandutils.DryRunClient {
+		printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionDelete, Kind: "SSHPublicKey", Name: "admin"}, options.DryRun)
+		return nil
+	}
+
+	clientset, err := dryRunClientset(f, options.DryRun)
 	if err != nil {
 		return err
 	}
@@ -81,5 +91,9 @@ func RunDeleteSSHPublicKey(ctx context.Context, f *util.Factory, out io.Writer,
 		return fmt.Errorf("error deleting SSH public key: %v", err)
 	}
 
+	if options.DryRun == commandutils.DryRunServer {
+		return printDryRunChanges(out, clientset)
+	}
+
 	return nil
 }
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/dryrun"
+	"k8s.io/kops/pkg/commands/commandutils"
+)
+
+// dryRunClientset returns the clientset to run a command with.
+// For a server-side dry run, the writes to the state store are recorded instead of being made.
+func dryRunClientset(f *util.Factory, strategy commandutils.DryRunStrategy) (simple.Clientset, error) {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return nil, err
+	}
+	if strategy == commandutils.DryRunServer {
+		return dryrun.NewClientset(clientset), nil
+	}
+	return clientset, nil
+}
+
+// printDryRunChange prints a change which would be made, followed by its diff if any.
+func printDryRunChange(out io.Writer, change *dryrun.Change, strategy commandutils.DryRunStrategy) {
+	action := string(change.Action) + "d"
+	fmt.Fprintf(out, "%s/%s %s (%s dry run)\n", strings.ToLower(change.Kind), change.Name, action, strategy)
+	if change.Diff != "" {
+		for _, line := range strings.Split(strings.TrimRight(change.Diff, "\n"), "\n") {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
+}
+
+// printDryRunChanges prints the changes recorded by a server-side dry run.
+func printDryRunChanges(out io.Writer, clientset simple.Clientset) error {
+	recorder, ok := clientset.(*dryrun.Clientset)
+	if !ok {
+		return fmt.Errorf("clientset %T does not record changes", clientset)
+	}
+
+	changes := recorder.Changes()
+	if len(changes) == 0 {
+		fmt.Fprintf(out, "No changes (%s dry run)\n", commandutils.DryRunServer)
+		return nil
+	}
+	for _, change := range changes {
+		printDryRunChange(out, change, commandutils.DryRunServer)
+	}
+	return nil
+}
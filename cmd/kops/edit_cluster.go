@@ -32,6 +32,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops/validation"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/dryrun"
 	"k8s.io/kops/pkg/commands"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/edit"
@@ -51,6 +52,8 @@ type EditClusterOptions struct {
 	Sets []string
 	// Unsets allows unsetting values directly in the spec.
 	Unsets []string
+	// DryRun previews the changes made with Sets and Unsets
+	DryRun commandutils.DryRunStrategy
 }
 
 var (
@@ -69,6 +72,9 @@ var (
 
 	# Set cluster spec values.
 	kops edit cluster testcluster.k8s.local --set spec.kubernetesVersion=1.28.4
+
+	# Show the change of a value, validated against the state store, without making it.
+	kops edit cluster testcluster.k8s.local --set spec.kubernetesVersion=1.28.4 --dry-run=server
 	`))
 )
 
@@ -95,6 +101,7 @@ func NewCmdEditCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.RegisterFlagCompletionFunc("unset", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 
 	return cmd
 }
@@ -110,7 +117,11 @@ func RunEditCluster(ctx context.Context, f *util.Factory, out io.Writer, options
 		return err
 	}
 
-	clientset, err := f.KopsClient()
+	if options.DryRun.Enabled() && len(options.Unsets)+len(options.Sets) == 0 {
+		return fmt.Errorf("--dry-run requires --set or --unset")
+	}
+
+	clientset, err := dryRunClientset(f, options.DryRun)
 	if err != nil {
 		return err
 	}
@@ -129,6 +140,15 @@ func RunEditCluster(ctx context.Context, f *util.Factory, out io.Writer, options
 			return err
 		}
 
+		if options.DryRun == commandutils.DryRunClient {
+			d, err := dryrun.ObjectDiff(oldCluster, newCluster)
+			if err != nil {
+				return err
+			}
+			printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionUpdate, Kind: "Cluster", Name: newCluster.Name, Diff: d}, options.DryRun)
+			return nil
+		}
+
 		failure, err := updateCluster(ctx, clientset, oldCluster, newCluster, instanceGroups)
 		if err != nil {
 			return err
@@ -136,6 +156,10 @@ func RunEditCluster(ctx context.Context, f *util.Factory, out io.Writer, options
 		if failure != "" {
 			return fmt.Errorf("%s", failure)
 		}
+		printClusterWarnings(out, newCluster)
+		if options.DryRun == commandutils.DryRunServer {
+			return printDryRunChanges(out, clientset)
+		}
 		return nil
 	}
 
@@ -248,6 +272,7 @@ func RunEditCluster(ctx context.Context, f *util.Factory, out io.Writer, options
 			continue
 		}
 
+		printClusterWarnings(out, newCluster)
 		return nil
 	}
 }
@@ -33,6 +33,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops/validation"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/dryrun"
 	"k8s.io/kops/pkg/commands"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/edit"
@@ -58,6 +59,9 @@ var (
 	editInstancegroupExample = templates.Examples(i18n.T(`
 	# Edit an instancegroup desired configuration.
 	kops edit instancegroup --name k8s-cluster.example.com nodes --state=s3://my-state-store
+
+	# Show the change of a value, validated against the state store, without making it.
+	kops edit instancegroup --name k8s-cluster.example.com nodes --set spec.maxSize=5 --dry-run=server
 	`))
 
 	editInstancegroupShort = i18n.T(`Edit instancegroup.`)
@@ -71,6 +75,8 @@ type EditInstanceGroupOptions struct {
 	Sets []string
 	// Unsets allows unsetting values directly in the spec.
 	Unsets []string
+	// DryRun previews the changes made with Sets and Unsets
+	DryRun commandutils.DryRunStrategy
 }
 
 func NewCmdEditInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
@@ -115,6 +121,7 @@ func NewCmdEditInstanceGroup(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.RegisterFlagCompletionFunc("unset", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 
 	return cmd
 }
@@ -127,7 +134,11 @@ func RunEditInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer, o
 		return err
 	}
 
-	clientset, err := f.KopsClient()
+	if options.DryRun.Enabled() && len(options.Unsets)+len(options.Sets) == 0 {
+		return fmt.Errorf("--dry-run requires --set or --unset")
+	}
+
+	clientset, err := dryRunClientset(f, options.DryRun)
 	if err != nil {
 		return err
 	}
@@ -154,6 +165,15 @@ func RunEditInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer, o
 			return err
 		}
 
+		if options.DryRun == commandutils.DryRunClient {
+			d, err := dryrun.ObjectDiff(oldGroup, newGroup)
+			if err != nil {
+				return err
+			}
+			printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionUpdate, Kind: "InstanceGroup", Name: newGroup.Name, Diff: d}, options.DryRun)
+			return nil
+		}
+
 		failure, err := updateInstanceGroup(ctx, clientset, channel, cluster, newGroup)
 		if err != nil {
 			return err
@@ -161,6 +181,10 @@ func RunEditInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer, o
 		if failure != "" {
 			return fmt.Errorf("%s", failure)
 		}
+		printInstanceGroupWarnings(out, newGroup, cluster)
+		if options.DryRun == commandutils.DryRunServer {
+			return printDryRunChanges(out, clientset)
+		}
 		return nil
 	}
 
@@ -273,6 +297,7 @@ func RunEditInstanceGroup(ctx context.Context, f *util.Factory, out io.Writer, o
 			continue
 		}
 
+		printInstanceGroupWarnings(out, newGroup, cluster)
 		return nil
 	}
 }
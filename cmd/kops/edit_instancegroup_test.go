@@ -25,6 +25,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kopscodecs"
 	"k8s.io/kops/pkg/testutils"
 	"k8s.io/kops/pkg/testutils/golden"
@@ -88,3 +89,62 @@ func TestEditInstanceGroup(t *testing.T) {
 
 	golden.AssertMatchesFile(t, actualYAML, "test/edit_instance_group.yaml")
 }
+
+func TestEditInstanceGroupDryRun(t *testing.T) {
+	t.Setenv("SKIP_REGION_CHECK", "1")
+
+	clusterName := "test.k8s.io"
+
+	cluster := testutils.BuildMinimalCluster(clusterName)
+	nodes := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+
+	testutils.NewIntegrationTestHarness(t).SetupMockAWS()
+
+	ctx := context.Background()
+
+	factoryOptions := &util.FactoryOptions{}
+	factoryOptions.RegistryPath = "memfs://tests"
+
+	factory := util.NewFactory(factoryOptions)
+	clientSet, err := factory.KopsClient()
+	if err != nil {
+		t.Fatalf("could not create clientset: %v", err)
+	}
+
+	cluster, err = clientSet.CreateCluster(ctx, cluster)
+	if err != nil {
+		t.Fatalf("could not create cluster: %v", err)
+	}
+	_, err = clientSet.InstanceGroupsFor(cluster).Create(ctx, &nodes, v1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("could not create instance group: %v", err)
+	}
+
+	for _, dryRun := range []commandutils.DryRunStrategy{commandutils.DryRunClient, commandutils.DryRunServer} {
+		t.Run(string(dryRun), func(t *testing.T) {
+			var stdout bytes.Buffer
+			editOptions := &EditInstanceGroupOptions{
+				ClusterName: clusterName,
+				GroupName:   "nodes",
+				Sets:        []string{"spec.maxSize=10"},
+				DryRun:      dryRun,
+			}
+			if err := RunEditInstanceGroup(ctx, factory, &stdout, editOptions); err != nil {
+				t.Fatalf("could not edit instance group: %v", err)
+			}
+
+			output := stdout.String()
+			if !strings.HasPrefix(output, "instancegroup/nodes updated ("+string(dryRun)+" dry run)\n") || !strings.Contains(output, "  +   maxSize: 10\n") {
+				t.Errorf("unexpected output:\n%s", output)
+			}
+
+			storedIG, err := clientSet.InstanceGroupsFor(cluster).Get(ctx, "nodes", v1.GetOptions{})
+			if err != nil {
+				t.Fatalf("could not get instance group: %v", err)
+			}
+			if storedIG.Spec.MaxSize != nil {
+				t.Errorf("instance group was updated in a dry run")
+			}
+		})
+	}
+}
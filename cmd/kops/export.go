@@ -34,6 +34,7 @@ func NewCmdExport(f *util.Factory, out io.Writer) *cobra.Command {
 
 	// create subcommands
 	cmd.AddCommand(NewCmdExportKubeconfig(f, out))
+	cmd.AddCommand(NewCmdExportVPN(f, out))
 
 	return cmd
 }
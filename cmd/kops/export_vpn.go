@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/model/awsmodel"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	exportVPNLong = templates.LongDesc(i18n.T(`
+	Export the OpenVPN client configuration of the VPN of a cluster.
+	The client certificate and key, issued by the client certificate authority of the VPN,
+	can be embedded in the configuration.
+	`))
+
+	exportVPNExample = templates.Examples(i18n.T(`
+	# export the client configuration, with an embedded client certificate and key
+	kops export vpn k8s-cluster.example.com --client-certificate client.crt --client-key client.key --output cluster.ovpn
+	`))
+
+	exportVPNShort = i18n.T(`Export the client configuration of the VPN.`)
+)
+
+type ExportVPNOptions struct {
+	ClusterName       string
+	ClientCertificate string
+	ClientKey         string
+	Output            string
+}
+
+func NewCmdExportVPN(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ExportVPNOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "vpn [CLUSTER]",
+		Short:             exportVPNShort,
+		Long:              exportVPNLong,
+		Example:           exportVPNExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunExportVPN(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.ClientCertificate, "client-certificate", options.ClientCertificate, "Filename of the PEM encoded client certificate to embed in the configuration")
+	cmd.Flags().StringVar(&options.ClientKey, "client-key", options.ClientKey, "Filename of the PEM encoded client key to embed in the configuration")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Filename of the configuration to write, instead of stdout")
+
+	return cmd
+}
+
+func RunExportVPN(ctx context.Context, f *util.Factory, out io.Writer, options *ExportVPNOptions) error {
+	if (options.ClientCertificate == "") != (options.ClientKey == "") {
+		return fmt.Errorf("--client-certificate and --client-key must be used together")
+	}
+
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	topology := cluster.Spec.Networking.Topology
+	if topology == nil || topology.VPN == nil {
+		return fmt.Errorf("cluster %q does not have a VPN", cluster.ObjectMeta.Name)
+	}
+	if topology.VPN.Type != kopsapi.VPNTypeAWSClientVPN {
+		return fmt.Errorf("exporting the configuration of a VPN of type %q is not supported", topology.VPN.Type)
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	awsCloud := cloud.(awsup.AWSCloud)
+
+	endpoint, err := awstasks.FindClientVPNEndpoint(ctx, awsCloud, awsmodel.VPNName(cluster.ObjectMeta.Name))
+	if err != nil {
+		return err
+	}
+	if endpoint == nil {
+		return fmt.Errorf("VPN of cluster %q not found; has the cluster been updated?", cluster.ObjectMeta.Name)
+	}
+
+	response, err := awsCloud.EC2().ExportClientVpnClientConfiguration(ctx, &ec2.ExportClientVpnClientConfigurationInput{
+		ClientVpnEndpointId: endpoint.ClientVpnEndpointId,
+	})
+	if err != nil {
+		return fmt.Errorf("error exporting the client configuration of the VPN: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.TrimRight(*response.ClientConfiguration, "\n"))
+	b.WriteString("\n")
+	if options.ClientCertificate != "" {
+		for _, embed := range []struct{ tag, file string }{
+			{"cert", options.ClientCertificate},
+			{"key", options.ClientKey},
+		} {
+			data, err := os.ReadFile(embed.file)
+			if err != nil {
+				return fmt.Errorf("error reading %q: %w", embed.file, err)
+			}
+			fmt.Fprintf(&b, "<%s>\n%s\n</%s>\n", embed.tag, strings.TrimSpace(string(data)), embed.tag)
+		}
+	}
+
+	if options.Output == "" {
+		_, err := io.WriteString(out, b.String())
+		return err
+	}
+	if err := os.WriteFile(options.Output, []byte(b.String()), 0o600); err != nil {
+		return fmt.Errorf("error writing %q: %w", options.Output, err)
+	}
+	fmt.Fprintf(out, "Wrote the VPN configuration to %q\n", options.Output)
+	return nil
+}
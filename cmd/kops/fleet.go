@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"sigs.k8s.io/yaml"
+)
+
+var fleetShort = i18n.T(`Operate on many clusters at once.`)
+
+const (
+	// FleetStatusSucceeded is the status of a cluster whose operation succeeded
+	FleetStatusSucceeded = "Succeeded"
+	// FleetStatusFailed is the status of a cluster whose operation failed
+	FleetStatusFailed = "Failed"
+)
+
+func NewCmdFleet(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fleet",
+		Short: fleetShort,
+	}
+
+	cmd.AddCommand(NewCmdFleetApply(f, out))
+	cmd.AddCommand(NewCmdFleetValidate(f, out))
+
+	return cmd
+}
+
+// FleetOptions are the options shared by the fleet commands
+type FleetOptions struct {
+	// Parallelism is the maximum number of clusters operated on at the same time
+	Parallelism int
+	// Output is the format of the summary
+	Output string
+	// LogDir is a directory to write the output of the operation on each cluster to
+	LogDir string
+}
+
+func (o *FleetOptions) InitDefaults() {
+	o.Parallelism = 4
+	o.Output = OutputTable
+}
+
+func (o *FleetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&o.Parallelism, "parallelism", o.Parallelism, "Maximum number of clusters to operate on at the same time")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", o.Output, "Output format of the summary. One of json|yaml|table.")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputJSON, OutputYaml, OutputTable}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().StringVar(&o.LogDir, "log-dir", o.LogDir, "Directory to write the output of each cluster to, as <cluster>.log")
+	cmd.MarkFlagDirname("log-dir")
+}
+
+// FleetClusterResult is the outcome of an operation on one cluster of the fleet
+type FleetClusterResult struct {
+	// Name is the name of the cluster
+	Name string `json:"name"`
+	// Status is either Succeeded or Failed
+	Status string `json:"status"`
+	// Action describes what was done to the cluster
+	Action string `json:"action,omitempty"`
+	// Message describes the failure, if any
+	Message string `json:"message,omitempty"`
+	// Duration is how long the operation took
+	Duration string `json:"duration"`
+	// Nodes is the number of nodes found when validating the cluster
+	Nodes *int `json:"nodes,omitempty"`
+	// Failures is the number of validation failures
+	Failures *int `json:"failures,omitempty"`
+	// LogFile is the file the output of the operation was written to
+	LogFile string `json:"logFile,omitempty"`
+}
+
+// FleetSummary is the machine-readable summary of a fleet operation
+type FleetSummary struct {
+	// Operation is the fleet command that was run
+	Operation string `json:"operation"`
+	// DryRun is true if no changes were made
+	DryRun bool `json:"dryRun,omitempty"`
+	// Succeeded is the number of clusters whose operation succeeded
+	Succeeded int `json:"succeeded"`
+	// Failed is the number of clusters whose operation failed
+	Failed int `json:"failed"`
+	// Clusters are the results of each cluster, in the order the clusters were given
+	Clusters []*FleetClusterResult `json:"clusters"`
+}
+
+// fleetOperation operates on a single cluster, writing its detailed output to out
+type fleetOperation func(ctx context.Context, clusterName string, out io.Writer) (*FleetClusterResult, error)
+
+// runFleet runs the operation for each cluster, with at most options.Parallelism running at the same time.
+// A line of progress is written to progress as each cluster completes; a failure does not stop the other clusters.
+func runFleet(ctx context.Context, progress io.Writer, options *FleetOptions, operation string, clusterNames []string, fn fleetOperation) (*FleetSummary, error) {
+	if options.Parallelism < 1 {
+		return nil, fmt.Errorf("--parallelism must be at least 1")
+	}
+	switch options.Output {
+	case OutputTable, OutputYaml, OutputJSON:
+	default:
+		return nil, fmt.Errorf("unknown output format: %q", options.Output)
+	}
+	if options.LogDir != "" {
+		if err := os.MkdirAll(options.LogDir, 0o755); err != nil {
+			return nil, fmt.Errorf("error creating log directory %q: %w", options.LogDir, err)
+		}
+	}
+
+	summary := &FleetSummary{
+		Operation: operation,
+		Clusters:  make([]*FleetClusterResult, len(clusterNames)),
+	}
+
+	var mutex sync.Mutex
+	completed := 0
+
+	var g errgroup.Group
+	g.SetLimit(options.Parallelism)
+	for i, clusterName := range clusterNames {
+		g.Go(func() error {
+			start := time.Now()
+			var buf bytes.Buffer
+			result, err := fn(ctx, clusterName, &buf)
+			if result == nil {
+				result = &FleetClusterResult{}
+			}
+			result.Name = clusterName
+			result.Duration = time.Since(start).Round(time.Second).String()
+			result.Status = FleetStatusSucceeded
+			if err != nil {
+				result.Status = FleetStatusFailed
+				result.Message = err.Error()
+			}
+			if options.LogDir != "" {
+				result.LogFile = filepath.Join(options.LogDir, clusterName+".log")
+				if err := os.WriteFile(result.LogFile, buf.Bytes(), 0o644); err != nil {
+					return fmt.Errorf("error writing log for cluster %q: %w", clusterName, err)
+				}
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			summary.Clusters[i] = result
+			completed++
+			if result.Status == FleetStatusFailed {
+				fmt.Fprintf(progress, "[%d/%d] %s: %s after %s: %s\n", completed, len(clusterNames), clusterName, result.Status, result.Duration, result.Message)
+			} else {
+				fmt.Fprintf(progress, "[%d/%d] %s: %s after %s\n", completed, len(clusterNames), clusterName, result.Status, result.Duration)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, result := range summary.Clusters {
+		if result.Status == FleetStatusFailed {
+			summary.Failed++
+		} else {
+			summary.Succeeded++
+		}
+	}
+
+	return summary, nil
+}
+
+// fleetProgressWriter returns where progress is written; machine-readable output is kept free of it
+func fleetProgressWriter(out io.Writer, output string) io.Writer {
+	if output == OutputTable {
+		return out
+	}
+	return os.Stderr
+}
+
+// printFleetSummary writes the summary in the requested format, returning an error if any cluster failed
+func printFleetSummary(out io.Writer, output string, summary *FleetSummary) error {
+	switch output {
+	case OutputTable:
+		t := &tables.Table{}
+		t.AddColumn("NAME", func(r *FleetClusterResult) string {
+			return r.Name
+		})
+		t.AddColumn("STATUS", func(r *FleetClusterResult) string {
+			return r.Status
+		})
+		t.AddColumn("ACTION", func(r *FleetClusterResult) string {
+			return r.Action
+		})
+		t.AddColumn("NODES", func(r *FleetClusterResult) string {
+			if r.Nodes == nil {
+				return ""
+			}
+			return fmt.Sprintf("%d", *r.Nodes)
+		})
+		t.AddColumn("FAILURES", func(r *FleetClusterResult) string {
+			if r.Failures == nil {
+				return ""
+			}
+			return fmt.Sprintf("%d", *r.Failures)
+		})
+		t.AddColumn("DURATION", func(r *FleetClusterResult) string {
+			return r.Duration
+		})
+		t.AddColumn("MESSAGE", func(r *FleetClusterResult) string {
+			return r.Message
+		})
+
+		columns := []string{"NAME", "STATUS", "ACTION", "DURATION", "MESSAGE"}
+		if summary.Operation == "validate" {
+			columns = []string{"NAME", "STATUS", "NODES", "FAILURES", "DURATION", "MESSAGE"}
+		}
+		fmt.Fprintf(out, "\n")
+		if err := t.Render(summary.Clusters, out, columns...); err != nil {
+			return fmt.Errorf("error rendering summary: %w", err)
+		}
+		fmt.Fprintf(out, "\n%d succeeded, %d failed\n", summary.Succeeded, summary.Failed)
+	case OutputYaml:
+		y, err := yaml.Marshal(summary)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %w", err)
+		}
+		if _, err := out.Write(y); err != nil {
+			return fmt.Errorf("error writing to output: %w", err)
+		}
+	case OutputJSON:
+		j, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %w", err)
+		}
+		if _, err := fmt.Fprintf(out, "%s\n", j); err != nil {
+			return fmt.Errorf("error writing to output: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown output format: %q", output)
+	}
+
+	if summary.Failed != 0 {
+		return fmt.Errorf("%s failed for %d of %d clusters", summary.Operation, summary.Failed, len(summary.Clusters))
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/utils"
+	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	fleetApplyLong = templates.LongDesc(i18n.T(`
+	Apply the desired configuration of many clusters, read from files or directories of manifests.
+
+	The manifests of each cluster, its Cluster and InstanceGroup objects, are created or replaced
+	in the state store and the cloud resources are then updated, as "kops replace --force" followed
+	by "kops update cluster --yes" would. Clusters are applied in parallel, independently of each other;
+	a failure in one cluster does not stop the others. Without --yes, the manifests are only checked
+	and the clusters that would be created or updated are listed.
+	`))
+
+	fleetApplyExample = templates.Examples(i18n.T(`
+	# Preview applying all the clusters in a directory
+	kops fleet apply -f clusters/
+
+	# Apply the clusters, eight at a time, keeping the output of each cluster
+	kops fleet apply -f clusters/ --parallelism 8 --log-dir logs --yes
+
+	# Apply the clusters and write a machine-readable summary
+	kops fleet apply -f clusters/ --yes -o json > summary.json
+	`))
+
+	fleetApplyShort = i18n.T(`Apply the configuration of many clusters.`)
+)
+
+type FleetApplyOptions struct {
+	FleetOptions
+
+	// Filenames are the files or directories of manifests to apply
+	Filenames []string
+	// Yes applies the changes; otherwise they are only previewed
+	Yes bool
+	// AllowKopsDowngrade allows an older version of kOps to update the clusters
+	AllowKopsDowngrade bool
+}
+
+func (o *FleetApplyOptions) InitDefaults() {
+	o.FleetOptions.InitDefaults()
+}
+
+func NewCmdFleetApply(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &FleetApplyOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:               "apply {-f FILENAME}...",
+		Short:             fleetApplyShort,
+		Long:              fleetApplyLong,
+		Example:           fleetApplyExample,
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunFleetApply(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Files or directories of cluster manifests to apply")
+	cmd.MarkFlagRequired("filename")
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Apply the changes, without --yes the clusters to be applied are only listed")
+	cmd.Flags().BoolVar(&options.AllowKopsDowngrade, "allow-kops-downgrade", options.AllowKopsDowngrade, "Allow an older version of kOps to update the clusters than last used")
+	options.FleetOptions.AddFlags(cmd)
+
+	return cmd
+}
+
+// fleetClusterManifests are the objects read for a single cluster
+type fleetClusterManifests struct {
+	cluster        *kopsapi.Cluster
+	instanceGroups []*kopsapi.InstanceGroup
+	// sources are the files each object was read from, for error messages
+	sources map[*kopsapi.InstanceGroup]string
+	source  string
+}
+
+// readFleetManifests reads and groups the manifests by cluster, checking them before anything is applied
+func readFleetManifests(filenames []string) (map[string]*fleetClusterManifests, error) {
+	manifests := make(map[string]*fleetClusterManifests)
+	get := func(clusterName string) *fleetClusterManifests {
+		m := manifests[clusterName]
+		if m == nil {
+			m = &fleetClusterManifests{sources: make(map[*kopsapi.InstanceGroup]string)}
+			manifests[clusterName] = m
+		}
+		return m
+	}
+
+	for _, x := range filenames {
+		files, err := expandFiles(utils.ExpandPath(x))
+		if err != nil {
+			return nil, fmt.Errorf("error reading %q: %w", x, err)
+		}
+		for _, file := range files {
+			contents, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("error reading file %q: %w", file, err)
+			}
+			for _, section := range text.SplitContentToSections(contents) {
+				o, gvk, err := kopscodecs.Decode(section, nil)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing file %q: %w", file, err)
+				}
+
+				switch v := o.(type) {
+				case *kopsapi.Cluster:
+					m := get(v.Name)
+					if m.cluster != nil {
+						return nil, fmt.Errorf("cluster %q is defined in both %q and %q", v.Name, m.source, file)
+					}
+					m.cluster = v
+					m.source = file
+				case *kopsapi.InstanceGroup:
+					clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+					if clusterName == "" {
+						return nil, fmt.Errorf("instanceGroup %q in %q must specify %q label with cluster name", v.Name, file, kopsapi.LabelClusterName)
+					}
+					m := get(clusterName)
+					for _, ig := range m.instanceGroups {
+						if ig.Name == v.Name {
+							return nil, fmt.Errorf("instanceGroup %q of cluster %q is defined in both %q and %q", v.Name, clusterName, m.sources[ig], file)
+						}
+					}
+					m.instanceGroups = append(m.instanceGroups, v)
+					m.sources[v] = file
+				default:
+					return nil, fmt.Errorf("unhandled kind %q in %q; only Cluster and InstanceGroup objects can be applied to a fleet", gvk, file)
+				}
+			}
+		}
+	}
+
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("no clusters found in %v", filenames)
+	}
+
+	return manifests, nil
+}
+
+// RunFleetApply applies the manifests of each cluster
+func RunFleetApply(ctx context.Context, f *util.Factory, out io.Writer, options *FleetApplyOptions) error {
+	manifests, err := readFleetManifests(options.Filenames)
+	if err != nil {
+		return err
+	}
+
+	// The clientset is shared by all the clusters, so create it before they start
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+	vfsContext := f.VFSContext()
+
+	var clusterNames []string
+	for clusterName := range manifests {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	apply := func(ctx context.Context, clusterName string, out io.Writer) (*FleetClusterResult, error) {
+		m := manifests[clusterName]
+		result := &FleetClusterResult{}
+
+		existing, err := clientset.GetCluster(ctx, clusterName)
+		if err != nil && !errors.IsNotFound(err) {
+			return result, fmt.Errorf("error fetching cluster %q: %w", clusterName, err)
+		}
+		if existing == nil && m.cluster == nil {
+			return result, fmt.Errorf("cluster %q of the instanceGroups was not found in the state store or the manifests", clusterName)
+		}
+		result.Action = "Update"
+		if existing == nil {
+			result.Action = "Create"
+		}
+		if !options.Yes {
+			return result, nil
+		}
+
+		if m.cluster != nil {
+			if err := replaceResource(ctx, clientset, vfsContext, m.cluster, nil, m.source, true); err != nil {
+				return result, err
+			}
+		}
+		for _, ig := range m.instanceGroups {
+			if err := replaceResource(ctx, clientset, vfsContext, ig, nil, m.sources[ig], true); err != nil {
+				return result, err
+			}
+		}
+
+		opt := &CoreUpdateClusterOptions{}
+		opt.InitDefaults()
+		opt.ClusterName = clusterName
+		opt.Yes = true
+		opt.Target = cloudup.TargetDirect
+		opt.AllowKopsDowngrade = options.AllowKopsDowngrade
+		if _, err := RunCoreUpdateCluster(ctx, f, out, opt); err != nil {
+			return result, err
+		}
+
+		return result, nil
+	}
+
+	progress := fleetProgressWriter(out, options.Output)
+	if options.Yes {
+		fmt.Fprintf(progress, "Applying %d clusters, %d at a time\n", len(clusterNames), options.Parallelism)
+	}
+	summary, err := runFleet(ctx, progress, &options.FleetOptions, "apply", clusterNames, apply)
+	if err != nil {
+		return err
+	}
+	summary.DryRun = !options.Yes
+
+	if err := printFleetSummary(out, options.Output, summary); err != nil {
+		return err
+	}
+	if !options.Yes && options.Output == OutputTable {
+		fmt.Fprintf(out, "\nMust specify --yes to apply changes\n")
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRunFleet(t *testing.T) {
+	options := &FleetOptions{}
+	options.InitDefaults()
+	options.Parallelism = 2
+	options.Output = OutputJSON
+	options.LogDir = t.TempDir()
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	fn := func(ctx context.Context, clusterName string, out io.Writer) (*FleetClusterResult, error) {
+		mutex.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(out, "output of %s\n", clusterName)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		if clusterName == "b.example.com" {
+			return nil, fmt.Errorf("something went wrong")
+		}
+		return &FleetClusterResult{Action: "Update"}, nil
+	}
+
+	clusterNames := []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com"}
+	var progress bytes.Buffer
+	summary, err := runFleet(context.Background(), &progress, options, "apply", clusterNames, fn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if maxRunning > options.Parallelism {
+		t.Errorf("expected at most %d clusters at a time, got %d", options.Parallelism, maxRunning)
+	}
+	if summary.Succeeded != 4 || summary.Failed != 1 {
+		t.Errorf("expected 4 succeeded and 1 failed, got %d and %d", summary.Succeeded, summary.Failed)
+	}
+	for i, result := range summary.Clusters {
+		if result.Name != clusterNames[i] {
+			t.Errorf("expected results in the order of the clusters, got %q at %d", result.Name, i)
+		}
+	}
+	if failed := summary.Clusters[1]; failed.Status != FleetStatusFailed || failed.Message != "something went wrong" {
+		t.Errorf("unexpected result for the failed cluster: %+v", failed)
+	}
+	if lines := strings.Count(progress.String(), "\n"); lines != len(clusterNames) {
+		t.Errorf("expected a line of progress for each cluster, got %q", progress.String())
+	}
+	b, err := os.ReadFile(filepath.Join(options.LogDir, "c.example.com.log"))
+	if err != nil || string(b) != "output of c.example.com\n" {
+		t.Errorf("expected the output of the cluster to be logged, got %q: %v", b, err)
+	}
+
+	var out bytes.Buffer
+	err = printFleetSummary(&out, OutputJSON, summary)
+	if err == nil || err.Error() != "apply failed for 1 of 5 clusters" {
+		t.Errorf("expected the failure to be reported, got %v", err)
+	}
+	decoded := &FleetSummary{}
+	if err := json.Unmarshal(out.Bytes(), decoded); err != nil {
+		t.Fatalf("expected the summary to be json: %v", err)
+	}
+	if decoded.Operation != "apply" || len(decoded.Clusters) != len(clusterNames) {
+		t.Errorf("unexpected summary: %s", out.String())
+	}
+}
+
+func TestReadFleetManifests(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("dev/cluster.yaml", `apiVersion: kops.k8s.io/v1alpha2
+kind: Cluster
+metadata:
+  name: dev.example.com
+---
+apiVersion: kops.k8s.io/v1alpha2
+kind: InstanceGroup
+metadata:
+  name: nodes
+  labels:
+    kops.k8s.io/cluster: dev.example.com
+`)
+	writeFile("prod/nodes.yaml", `apiVersion: kops.k8s.io/v1alpha2
+kind: InstanceGroup
+metadata:
+  name: nodes
+  labels:
+    kops.k8s.io/cluster: prod.example.com
+`)
+
+	manifests, err := readFleetManifests([]string{dir})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("expected manifests for 2 clusters, got %d", len(manifests))
+	}
+	if dev := manifests["dev.example.com"]; dev.cluster == nil || len(dev.instanceGroups) != 1 {
+		t.Errorf("expected the cluster and instance group of dev.example.com, got %+v", dev)
+	}
+	if prod := manifests["prod.example.com"]; prod.cluster != nil || len(prod.instanceGroups) != 1 {
+		t.Errorf("expected only the instance group of prod.example.com, got %+v", prod)
+	}
+
+	writeFile("prod/duplicate.yaml", `apiVersion: kops.k8s.io/v1alpha2
+kind: InstanceGroup
+metadata:
+  name: nodes
+  labels:
+    kops.k8s.io/cluster: prod.example.com
+`)
+	if _, err := readFleetManifests([]string{dir}); err == nil || !strings.Contains(err.Error(), "is defined in both") {
+		t.Errorf("expected an error for the duplicate instance group, got %v", err)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	fleetValidateLong = templates.LongDesc(i18n.T(`
+	Validate many clusters in parallel, as "kops validate cluster" would for each of them.
+
+	All the clusters in the state store are validated unless cluster names are given.
+	The command exits non-zero if any of the clusters failed validation.
+	`))
+
+	fleetValidateExample = templates.Examples(i18n.T(`
+	# Validate all the clusters in the state store
+	kops fleet validate
+
+	# Validate some of the clusters, waiting up to 10 minutes for each of them to become ready
+	kops fleet validate dev.example.com prod.example.com --wait 10m
+
+	# Write a machine-readable summary, keeping the validation output of each cluster
+	kops fleet validate -o yaml --log-dir logs
+	`))
+
+	fleetValidateShort = i18n.T(`Validate many clusters.`)
+)
+
+type FleetValidateOptions struct {
+	FleetOptions
+
+	// ClusterNames are the clusters to validate; if empty, all clusters are validated
+	ClusterNames []string
+
+	wait     time.Duration
+	count    int
+	interval time.Duration
+}
+
+func (o *FleetValidateOptions) InitDefaults() {
+	o.FleetOptions.InitDefaults()
+	o.interval = 10 * time.Second
+}
+
+func NewCmdFleetValidate(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &FleetValidateOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:     "validate [CLUSTER]...",
+		Short:   fleetValidateShort,
+		Long:    fleetValidateLong,
+		Example: fleetValidateExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				if rootCommand.clusterName != "" {
+					return fmt.Errorf("cannot mix --name for cluster with positional arguments")
+				}
+				options.ClusterNames = append(options.ClusterNames, args...)
+			} else if rootCommand.clusterName != "" {
+				options.ClusterNames = append(options.ClusterNames, rootCommand.clusterName)
+			}
+
+			return nil
+		},
+		ValidArgsFunction: commandutils.CompleteClusterName(f, false, true),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunFleetValidate(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().DurationVar(&options.wait, "wait", options.wait, "Amount of time to wait for each cluster to become ready")
+	cmd.Flags().IntVar(&options.count, "count", options.count, "Number of consecutive successful validations required")
+	cmd.Flags().DurationVar(&options.interval, "interval", options.interval, "Time in duration to wait between validation attempts")
+	options.FleetOptions.AddFlags(cmd)
+
+	return cmd
+}
+
+// RunFleetValidate validates each of the clusters
+func RunFleetValidate(ctx context.Context, f *util.Factory, out io.Writer, options *FleetValidateOptions) error {
+	// The clientset is shared by all the clusters, so create it before they start
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	clusterNames := options.ClusterNames
+	if len(clusterNames) == 0 {
+		list, err := clientset.ListClusters(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing clusters: %w", err)
+		}
+		for _, cluster := range list.Items {
+			clusterNames = append(clusterNames, cluster.Name)
+		}
+		if len(clusterNames) == 0 {
+			return fmt.Errorf("no clusters found in the state store")
+		}
+	}
+
+	validate := func(ctx context.Context, clusterName string, out io.Writer) (*FleetClusterResult, error) {
+		opt := &ValidateClusterOptions{}
+		opt.InitDefaults()
+		opt.ClusterName = clusterName
+		opt.wait = options.wait
+		opt.count = options.count
+		opt.interval = options.interval
+
+		result := &FleetClusterResult{}
+		validation, err := RunValidateCluster(ctx, f, out, opt)
+		if validation != nil {
+			nodes := len(validation.Nodes)
+			failures := len(validation.Failures)
+			result.Nodes = &nodes
+			result.Failures = &failures
+			if err != nil && failures != 0 {
+				err = fmt.Errorf("%s: %s", validation.Failures[0].Kind, validation.Failures[0].Message)
+				if failures > 1 {
+					err = fmt.Errorf("%w (and %d more failures)", err, failures-1)
+				}
+			}
+		}
+		return result, err
+	}
+
+	progress := fleetProgressWriter(out, options.Output)
+	fmt.Fprintf(progress, "Validating %d clusters, %d at a time\n", len(clusterNames), options.Parallelism)
+	summary, err := runFleet(ctx, progress, &options.FleetOptions, "validate", clusterNames, validate)
+	if err != nil {
+		return err
+	}
+
+	return printFleetSummary(out, options.Output, summary)
+}
@@ -21,8 +21,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/pkg/commands"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/pretty"
 	"k8s.io/kubectl/pkg/util/i18n"
@@ -34,6 +40,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/architectures"
 )
 
 var (
@@ -42,7 +49,15 @@ var (
 	(original) and download (local repository) locations.
 
 	When invoked with the ` + pretty.Bash("--copy") + ` flag, will copy each asset from the
-	canonical to the download location.`))
+	canonical to the download location.
+
+	The digests of the images are looked up in their registries. When invoked with the
+	` + pretty.Bash("--pin-digests") + ` flag, the images are pinned to these digests in the cluster spec,
+	so that the cluster runs the images by digest after the next ` + pretty.Bash("kops update cluster") + `.
+
+	When invoked with the ` + pretty.Bash("--check-architectures") + ` flag, the architectures of the images are
+	also looked up, and the command fails if an image does not support the architecture of an instance group,
+	such as an amd64-only image in a cluster with arm64 nodes.`))
 
 	getAssetsExample = templates.Examples(i18n.T(`
 	# Display all assets.
@@ -50,6 +65,15 @@ var (
 
 	# Copy assets to the local repositories configured in the cluster spec.
 	kops get assets --copy 
+
+	# Display the software bill of materials of the cluster as JSON.
+	kops get assets -o json
+
+	# Pin the images of the cluster to their current digests.
+	kops get assets --pin-digests
+
+	# Check that the images support the architectures of all the instance groups.
+	kops get assets --check-architectures
 	`))
 
 	getAssetsShort = i18n.T(`Display assets for cluster.`)
@@ -58,11 +82,20 @@ var (
 type GetAssetsOptions struct {
 	*GetOptions
 	Copy bool
+	// ResolveDigests looks up the digests of the images that are not pinned.
+	ResolveDigests bool
+	// PinDigests pins the images to their digests in the cluster spec.
+	PinDigests bool
+	// CheckArchitectures checks that the images support the architectures of the instance groups.
+	CheckArchitectures bool
 }
 
 type Image struct {
 	Canonical string `json:"canonical"`
 	Download  string `json:"download"`
+	Digest    string `json:"digest,omitempty"`
+	// Architectures are the linux architectures of the image, when checked.
+	Architectures []string `json:"architectures,omitempty"`
 }
 
 type File struct {
@@ -95,7 +128,12 @@ func NewCmdGetAssets(f *util.Factory, out io.Writer, getOptions *GetOptions) *co
 		},
 	}
 
+	options.ResolveDigests = true
+
 	cmd.Flags().BoolVar(&options.Copy, "copy", options.Copy, "copy assets to local repository")
+	cmd.Flags().BoolVar(&options.ResolveDigests, "resolve-digests", options.ResolveDigests, "look up the digests of the images in their registries")
+	cmd.Flags().BoolVar(&options.PinDigests, "pin-digests", options.PinDigests, "pin the images to their digests in the cluster spec")
+	cmd.Flags().BoolVar(&options.CheckArchitectures, "check-architectures", options.CheckArchitectures, "check that the images support the architectures of the instance groups")
 
 	return cmd
 }
@@ -122,6 +160,7 @@ func RunGetAssets(ctx context.Context, f *util.Factory, out io.Writer, options *
 		image := Image{
 			Canonical: imageAsset.CanonicalLocation,
 			Download:  imageAsset.DownloadLocation,
+			Digest:    imageAsset.Digest,
 		}
 		if !seen[image.Canonical] {
 			result.Images = append(result.Images, &image)
@@ -129,6 +168,20 @@ func RunGetAssets(ctx context.Context, f *util.Factory, out io.Writer, options *
 		}
 	}
 
+	if options.ResolveDigests || options.PinDigests {
+		for _, image := range result.Images {
+			if image.Digest != "" {
+				continue
+			}
+			digest, err := assets.ResolveImageDigest(image.Canonical)
+			if err != nil {
+				klog.Warningf("failed to look up the digest of image %q: %v", image.Canonical, err)
+				continue
+			}
+			image.Digest = digest
+		}
+	}
+
 	seen = map[string]bool{}
 	for _, fileAsset := range updateClusterResults.FileAssets {
 		file := File{
@@ -149,12 +202,28 @@ func RunGetAssets(ctx context.Context, f *util.Factory, out io.Writer, options *
 		}
 	}
 
+	if options.PinDigests {
+		if err := pinImageDigests(ctx, f, options.ClusterName, result.Images); err != nil {
+			return err
+		}
+	}
+
+	var unsupported []string
+	if options.CheckArchitectures {
+		unsupported, err = checkImageArchitectures(ctx, f, updateClusterResults.Cluster, result.Images)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch options.Output {
 	case OutputTable:
-		if err = imageOutputTable(result.Images, out); err != nil {
+		if err = imageOutputTable(result.Images, out, options.CheckArchitectures); err != nil {
+			return err
+		}
+		if err := fileOutputTable(result.Files, out); err != nil {
 			return err
 		}
-		return fileOutputTable(result.Files, out)
 	case OutputYaml:
 		y, err := yaml.Marshal(result)
 		if err != nil {
@@ -175,10 +244,113 @@ func RunGetAssets(ctx context.Context, f *util.Factory, out io.Writer, options *
 		return fmt.Errorf("unsupported output format: %q", options.Output)
 	}
 
+	if len(unsupported) != 0 {
+		return fmt.Errorf("images do not support the architectures of the cluster:\n  %s", strings.Join(unsupported, "\n  "))
+	}
+
 	return nil
 }
 
-func imageOutputTable(images []*Image, out io.Writer) error {
+// checkImageArchitectures looks up the architectures of the images, and describes the images
+// which do not support the architecture of an instance group.
+func checkImageArchitectures(ctx context.Context, f *util.Factory, cluster *kops.Cluster, images []*Image) ([]string, error) {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return nil, err
+	}
+	instanceGroups, err := commands.ReadAllInstanceGroups(ctx, clientset, cluster)
+	if err != nil {
+		return nil, err
+	}
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// The instance groups using each architecture
+	used := make(map[string][]string)
+	for _, ig := range instanceGroups {
+		seen := make(map[architectures.Architecture]bool)
+		// Spotinst uses the machine type field to keep a "," separated list of instance types
+		for _, machineType := range strings.Split(ig.Spec.MachineType, ",") {
+			arch, err := cloudup.MachineArchitecture(cloud, machineType)
+			if err != nil {
+				return nil, fmt.Errorf("unable to determine machine architecture for InstanceGroup %q: %w", ig.Name, err)
+			}
+			if !seen[arch] {
+				seen[arch] = true
+				used[string(arch)] = append(used[string(arch)], ig.Name)
+			}
+		}
+	}
+
+	var unsupported []string
+	for _, image := range images {
+		location := image.Download
+		if image.Digest != "" && !strings.Contains(location, "@") {
+			location += "@" + image.Digest
+		}
+		imageArchitectures, err := assets.ResolveImageArchitectures(location)
+		if err != nil {
+			klog.Warningf("failed to look up the architectures of image %q: %v", image.Canonical, err)
+			continue
+		}
+		image.Architectures = imageArchitectures
+
+		supported := sets.New(imageArchitectures...)
+		for _, arch := range sets.List(sets.KeySet(used)) {
+			if !supported.Has(arch) {
+				unsupported = append(unsupported, fmt.Sprintf("image %q does not support architecture %s, used by instance groups %s", image.Canonical, arch, strings.Join(used[arch], ",")))
+			}
+		}
+	}
+	return unsupported, nil
+}
+
+// pinImageDigests records the digests of the images in the cluster spec, replacing the digests pinned before
+func pinImageDigests(ctx context.Context, f *util.Factory, clusterName string, images []*Image) error {
+	imageDigests := make(map[string]string)
+	for _, image := range images {
+		if image.Digest == "" {
+			return fmt.Errorf("cannot pin image %q, as its digest is unknown", image.Canonical)
+		}
+		if strings.Contains(image.Canonical, "@") {
+			continue
+		}
+		imageDigests[image.Canonical] = image.Digest
+	}
+
+	cluster, err := GetCluster(ctx, f, clusterName)
+	if err != nil {
+		return err
+	}
+	if cluster.Spec.Assets != nil && reflect.DeepEqual(cluster.Spec.Assets.ImageDigests, imageDigests) {
+		klog.Infof("the images are already pinned to their digests")
+		return nil
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+	instanceGroups, err := commands.ReadAllInstanceGroups(ctx, clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	if cluster.Spec.Assets == nil {
+		cluster.Spec.Assets = &kops.AssetsSpec{}
+	}
+	cluster.Spec.Assets.ImageDigests = imageDigests
+	if err := commands.UpdateCluster(ctx, clientset, cluster, instanceGroups); err != nil {
+		return err
+	}
+	klog.Infof("pinned %d images to their digests; run kops update cluster to apply the changes", len(imageDigests))
+
+	return nil
+}
+
+func imageOutputTable(images []*Image, out io.Writer, showArchitectures bool) error {
 	fmt.Println("")
 	t := &tables.Table{}
 	t.AddColumn("CANONICAL", func(i *Image) string {
@@ -187,8 +359,18 @@ func imageOutputTable(images []*Image, out io.Writer) error {
 	t.AddColumn("DOWNLOAD", func(i *Image) string {
 		return i.Download
 	})
+	t.AddColumn("DIGEST", func(i *Image) string {
+		return i.Digest
+	})
+
+	t.AddColumn("ARCHITECTURES", func(i *Image) string {
+		return strings.Join(i.Architectures, ",")
+	})
 
-	columns := []string{"CANONICAL", "DOWNLOAD"}
+	columns := []string{"CANONICAL", "DOWNLOAD", "DIGEST"}
+	if showArchitectures {
+		columns = append(columns, "ARCHITECTURES")
+	}
 	return t.Render(images, out, columns...)
 }
 
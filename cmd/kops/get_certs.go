@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/certinventory"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	getCertsLong = templates.LongDesc(i18n.T(`
+	List the certificates managed by kOps, with their expiry dates.
+
+	The certificates of the keystore, such as the CAs and the service-account keys,
+	are read from the state store. The certificates issued on the control plane nodes,
+	such as those of the API server and of etcd, are read from the inventory recorded
+	by kops-controller when spec.monitoring.certificateExpiry is set.`))
+
+	getCertsExample = templates.Examples(i18n.T(`
+	# List all the certificates of the cluster.
+	kops get certs
+
+	# List the certificates expiring within the next 30 days.
+	kops get certs --expiring-within 720h`))
+
+	getCertsShort = i18n.T(`Get the certificates of the cluster and their expiry.`)
+)
+
+type GetCertsOptions struct {
+	*GetOptions
+	// ExpiringWithin only lists the certificates expiring within this duration, if set.
+	ExpiringWithin time.Duration
+}
+
+func NewCmdGetCerts(f *util.Factory, out io.Writer, getOptions *GetOptions) *cobra.Command {
+	options := &GetCertsOptions{
+		GetOptions: getOptions,
+	}
+	cmd := &cobra.Command{
+		Use:     "certs",
+		Aliases: []string{"cert", "certificates"},
+		Short:   getCertsShort,
+		Long:    getCertsLong,
+		Example: getCertsExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			options.ClusterName = rootCommand.ClusterName(true)
+			if options.ClusterName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			return cobra.NoArgs(cmd, args)
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunGetCerts(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().DurationVar(&options.ExpiringWithin, "expiring-within", options.ExpiringWithin, "Only list the certificates expiring within this duration")
+
+	return cmd
+}
+
+const (
+	certSourceKeystore = "keystore"
+	certSourceNode     = "node"
+)
+
+type certItem struct {
+	// Name is the keyset of a keystore certificate, or the file of a node certificate.
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Source    string    `json:"source"`
+	Node      string    `json:"node,omitempty"`
+	Subject   string    `json:"subject,omitempty"`
+	Issuer    string    `json:"issuer,omitempty"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+}
+
+func RunGetCerts(ctx context.Context, f *util.Factory, out io.Writer, options *GetCertsOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(ctx, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	keyStore, err := clientset.KeyStore(cluster)
+	if err != nil {
+		return err
+	}
+
+	keypairs, err := listKeypairs(keyStore, nil, false)
+	if err != nil {
+		return err
+	}
+	items := keystoreCertItems(keypairs)
+
+	restConfig, err := f.RESTConfig(cluster)
+	if err != nil {
+		return err
+	}
+	httpClient, err := f.HTTPClient(cluster)
+	if err != nil {
+		return err
+	}
+	k8sClient, err := kubernetes.NewForConfigAndClient(restConfig, httpClient)
+	if err != nil {
+		return fmt.Errorf("building kubernetes client: %w", err)
+	}
+
+	nodeList, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		klog.Warningf("cannot list the certificates of the nodes. Kubernetes API unavailable: %v", err)
+	} else {
+		nodeItems, err := nodeCertItems(nodeList.Items)
+		if err != nil {
+			return err
+		}
+		items = append(items, nodeItems...)
+	}
+
+	now := time.Now()
+	if options.ExpiringWithin != 0 {
+		var expiring []*certItem
+		for _, item := range items {
+			if item.NotAfter.Before(now.Add(options.ExpiringWithin)) {
+				expiring = append(expiring, item)
+			}
+		}
+		items = expiring
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].NotAfter.Before(items[j].NotAfter)
+	})
+
+	if len(items) == 0 {
+		return fmt.Errorf("no certificates found")
+	}
+	switch options.Output {
+
+	case OutputTable:
+		t := &tables.Table{}
+		t.AddColumn("NAME", func(i *certItem) string {
+			return i.Name
+		})
+		t.AddColumn("TYPE", func(i *certItem) string {
+			return i.Type
+		})
+		t.AddColumn("NODE", func(i *certItem) string {
+			return i.Node
+		})
+		t.AddColumn("EXPIRES", func(i *certItem) string {
+			return i.NotAfter.Local().Format("2006-01-02")
+		})
+		t.AddColumn("REMAINING", func(i *certItem) string {
+			remaining := i.NotAfter.Sub(now)
+			if remaining <= 0 {
+				return "expired"
+			}
+			return duration.HumanDuration(remaining)
+		})
+		return t.Render(items, out, "NAME", "TYPE", "NODE", "EXPIRES", "REMAINING")
+
+	case OutputYaml:
+		y, err := yaml.Marshal(items)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+		if _, err := out.Write(y); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+	case OutputJSON:
+		j, err := json.Marshal(items)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+		if _, err := out.Write(j); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown output format: %q", options.Output)
+	}
+
+	return nil
+}
+
+// keystoreCertItems returns the certificates of the trusted keypairs of the keystore.
+func keystoreCertItems(keypairs []*keypairItem) []*certItem {
+	var items []*certItem
+	for _, keypair := range keypairs {
+		if keypair.NotAfter == nil {
+			continue
+		}
+		item := &certItem{
+			Name:     keypair.Name,
+			Type:     "certificate",
+			Source:   certSourceKeystore,
+			Subject:  keypair.Subject,
+			Issuer:   keypair.Issuer,
+			NotAfter: *keypair.NotAfter,
+		}
+		if keypair.NotBefore != nil {
+			item.NotBefore = *keypair.NotBefore
+		}
+		switch {
+		case keypair.Name == "service-account":
+			item.Type = "service-account"
+		case keypair.IsCA:
+			item.Type = "ca"
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// nodeCertItems returns the certificates recorded by kops-controller on the nodes.
+func nodeCertItems(nodes []v1.Node) ([]*certItem, error) {
+	var items []*certItem
+	for i := range nodes {
+		node := &nodes[i]
+		annotation, found := node.Annotations[certinventory.NodeAnnotation]
+		if !found {
+			continue
+		}
+		var certificates []certinventory.Certificate
+		if err := json.Unmarshal([]byte(annotation), &certificates); err != nil {
+			return nil, fmt.Errorf("parsing certificates of node %q: %w", node.Name, err)
+		}
+		for _, certificate := range certificates {
+			item := &certItem{
+				Name:      certificate.Path,
+				Type:      "certificate",
+				Source:    certSourceNode,
+				Node:      node.Name,
+				Subject:   certificate.Subject,
+				Issuer:    certificate.Issuer,
+				NotBefore: certificate.NotBefore,
+				NotAfter:  certificate.NotAfter,
+			}
+			switch {
+			case certificate.IsCA:
+				item.Type = "ca"
+			case strings.Contains(certificate.Path, "etcd"):
+				item.Type = "etcd"
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
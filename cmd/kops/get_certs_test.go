@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/certinventory"
+)
+
+func TestKeystoreCertItems(t *testing.T) {
+	notAfter := time.Date(2036, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := keystoreCertItems([]*keypairItem{
+		{Name: "kubernetes-ca", IsCA: true, NotAfter: &notAfter},
+		{Name: "service-account", IsCA: true, NotAfter: &notAfter},
+		{Name: "kubelet", NotAfter: &notAfter},
+		{Name: "no-certificate"},
+	})
+
+	var actual []string
+	for _, item := range items {
+		assert.Equal(t, certSourceKeystore, item.Source)
+		actual = append(actual, item.Name+" "+item.Type)
+	}
+	assert.Equal(t, []string{"kubernetes-ca ca", "service-account service-account", "kubelet certificate"}, actual)
+}
+
+func TestNodeCertItems(t *testing.T) {
+	notAfter := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "control-plane-a",
+				Annotations: map[string]string{
+					certinventory.NodeAnnotation: `[` +
+						`{"path":"/srv/kubernetes/kube-apiserver/server.crt","subject":"CN=kubernetes-master","notBefore":"2026-01-01T00:00:00Z","notAfter":"2027-01-01T00:00:00Z"},` +
+						`{"path":"/etc/kubernetes/pki/etcd-manager-main/etcd-clients-ca.crt","isCA":true,"notBefore":"2026-01-01T00:00:00Z","notAfter":"2027-01-01T00:00:00Z"},` +
+						`{"path":"/etc/kubernetes/pki/etcd-manager-main/etcd-manager-server.crt","notBefore":"2026-01-01T00:00:00Z","notAfter":"2027-01-01T00:00:00Z"}` +
+						`]`,
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+		},
+	}
+
+	items, err := nodeCertItems(nodes)
+	require.NoError(t, err)
+
+	var actual []string
+	for _, item := range items {
+		assert.Equal(t, certSourceNode, item.Source)
+		assert.Equal(t, "control-plane-a", item.Node)
+		assert.Equal(t, notAfter, item.NotAfter)
+		actual = append(actual, item.Name+" "+item.Type)
+	}
+	assert.Equal(t, []string{
+		"/srv/kubernetes/kube-apiserver/server.crt certificate",
+		"/etc/kubernetes/pki/etcd-manager-main/etcd-clients-ca.crt ca",
+		"/etc/kubernetes/pki/etcd-manager-main/etcd-manager-server.crt etcd",
+	}, actual)
+
+	nodes[1].Annotations = map[string]string{certinventory.NodeAnnotation: "not json"}
+	_, err = nodeCertItems(nodes)
+	assert.Error(t, err)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/snapshot"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	getSnapshotsExample = templates.Examples(i18n.T(`
+	# List the snapshots of a cluster.
+	kops get snapshots --name k8s-cluster.example.com`))
+
+	getSnapshotsShort = i18n.T(`Get the snapshots of a cluster.`)
+)
+
+type GetSnapshotsOptions struct {
+	*GetOptions
+}
+
+func NewCmdGetSnapshots(f *util.Factory, out io.Writer, getOptions *GetOptions) *cobra.Command {
+	options := &GetSnapshotsOptions{
+		GetOptions: getOptions,
+	}
+	cmd := &cobra.Command{
+		Use:     "snapshots",
+		Aliases: []string{"snapshot"},
+		Short:   getSnapshotsShort,
+		Example: getSnapshotsExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			options.ClusterName = rootCommand.ClusterName(true)
+			if options.ClusterName == "" {
+				return fmt.Errorf("--name is required")
+			}
+			return cobra.NoArgs(cmd, args)
+		},
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunGetSnapshots(cmd.Context(), f, out, options)
+		},
+	}
+
+	return cmd
+}
+
+func RunGetSnapshots(ctx context.Context, f *util.Factory, out io.Writer, options *GetSnapshotsOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(ctx, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	store, err := snapshot.NewStore(clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 && options.Output == OutputTable {
+		return fmt.Errorf("no snapshots found")
+	}
+	switch options.Output {
+
+	case OutputTable:
+		t := &tables.Table{}
+		t.AddColumn("NAME", func(s *snapshot.Snapshot) string {
+			return s.Name
+		})
+		t.AddColumn("CREATED", func(s *snapshot.Snapshot) string {
+			return s.CreationTimestamp.Local().Format("2006-01-02 15:04:05")
+		})
+		t.AddColumn("KOPS VERSION", func(s *snapshot.Snapshot) string {
+			return s.KopsVersion
+		})
+		t.AddColumn("KUBERNETES VERSION", func(s *snapshot.Snapshot) string {
+			return s.KubernetesVersion
+		})
+		t.AddColumn("ETCD BACKUPS", func(s *snapshot.Snapshot) string {
+			var backups []string
+			for _, backup := range s.EtcdBackups {
+				backups = append(backups, backup.EtcdCluster+"="+backup.Backup)
+			}
+			return strings.Join(backups, ",")
+		})
+		return t.Render(snapshots, out, "NAME", "CREATED", "KOPS VERSION", "KUBERNETES VERSION", "ETCD BACKUPS")
+
+	case OutputYaml:
+		y, err := yaml.Marshal(snapshots)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+		if _, err := out.Write(y); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+	case OutputJSON:
+		j, err := json.Marshal(snapshots)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+		if _, err := out.Write(j); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown output format: %q", options.Output)
+	}
+
+	return nil
+}
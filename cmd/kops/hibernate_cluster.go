@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/dryrun"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/hibernation"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	hibernateClusterLong = templates.LongDesc(i18n.T(`
+	Hibernate a cluster, to stop paying for its instances while it isn't used.
+
+	The instance groups other than the control plane are scaled to zero. On AWS, the control plane
+	instances are also stopped, and the processes of their autoscaling groups which would replace them
+	are suspended; on other clouds, the control plane keeps running. Instance groups managed by Karpenter
+	are not hibernated.
+
+	The previous sizes are kept in annotations of the instance groups, and restored by kops resume cluster.
+	Without --yes, the changes to the instance groups are only previewed.
+
+	To hibernate and resume the nodes of a cluster on a schedule, set spec.hibernation.`))
+
+	hibernateClusterExample = templates.Examples(i18n.T(`
+	# Hibernate a cluster
+	kops hibernate cluster k8s-cluster.example.com --yes
+
+	# Resume it
+	kops resume cluster k8s-cluster.example.com --yes
+	`))
+
+	hibernateClusterShort = i18n.T("Hibernate a cluster.")
+)
+
+type HibernateClusterOptions struct {
+	ClusterName string
+	// Yes hibernates the cluster, instead of previewing it
+	Yes bool
+}
+
+func NewCmdHibernateCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &HibernateClusterOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "cluster [CLUSTER]",
+		Short:             hibernateClusterShort,
+		Long:              hibernateClusterLong,
+		Example:           hibernateClusterExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunHibernateCluster(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Hibernate the cluster, without --yes the changes are only previewed")
+
+	return cmd
+}
+
+func RunHibernateCluster(ctx context.Context, f *util.Factory, out io.Writer, options *HibernateClusterOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	instanceGroups, err := listInstanceGroups(ctx, clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	hibernated, err := hibernation.Hibernate(cluster, instanceGroups)
+	if err != nil {
+		return err
+	}
+
+	if !options.Yes {
+		if err := previewInstanceGroups(ctx, out, clientset, cluster, hibernated); err != nil {
+			return err
+		}
+		if !hibernation.StopsControlPlane(cluster) {
+			fmt.Fprintf(out, "\nThe control plane keeps running on %s\n", cluster.GetCloudProvider())
+		}
+		fmt.Fprintf(out, "\nMust specify --yes to hibernate the cluster\n")
+		return nil
+	}
+
+	if err := checkNoPendingChanges(ctx, f, cluster.Name); err != nil {
+		return err
+	}
+	if err := applyHibernation(ctx, f, out, clientset, cluster, instanceGroups, hibernated); err != nil {
+		return err
+	}
+
+	if hibernation.StopsControlPlane(cluster) {
+		cloud, err := cloudup.BuildCloud(cluster)
+		if err != nil {
+			return err
+		}
+		stopped, err := hibernation.StopControlPlane(ctx, cloud, cluster, instanceGroups)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Stopped %d control plane instances\n", len(stopped))
+	} else {
+		klog.Warningf("the control plane of cluster %q keeps running, as stopping it is not supported on %s", cluster.Name, cluster.GetCloudProvider())
+	}
+
+	fmt.Fprintf(out, "\nHibernated cluster %q; resume it with: kops resume cluster --name %s --yes\n", cluster.Name, cluster.Name)
+	return nil
+}
+
+// listInstanceGroups returns the instance groups of a cluster.
+func listInstanceGroups(ctx context.Context, clientset simple.Clientset, cluster *kopsapi.Cluster) ([]*kopsapi.InstanceGroup, error) {
+	list, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instance groups: %w", err)
+	}
+	var instanceGroups []*kopsapi.InstanceGroup
+	for i := range list.Items {
+		instanceGroups = append(instanceGroups, &list.Items[i])
+	}
+	return instanceGroups, nil
+}
+
+// updateInstanceGroups writes the specs of instance groups.
+func updateInstanceGroups(ctx context.Context, clientset simple.Clientset, cluster *kopsapi.Cluster, instanceGroups []*kopsapi.InstanceGroup) error {
+	for _, ig := range instanceGroups {
+		if _, err := clientset.InstanceGroupsFor(cluster).Update(ctx, ig, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error updating instance group %q: %w", ig.Name, err)
+		}
+	}
+	return nil
+}
+
+// previewInstanceGroups prints the changes which writing the specs of instance groups would make.
+func previewInstanceGroups(ctx context.Context, out io.Writer, clientset simple.Clientset, cluster *kopsapi.Cluster, instanceGroups []*kopsapi.InstanceGroup) error {
+	target := dryrun.NewClientset(clientset)
+	if err := updateInstanceGroups(ctx, target, cluster, instanceGroups); err != nil {
+		return err
+	}
+	return printDryRunChanges(out, target)
+}
+
+// applyHibernation writes the specs of the instance groups of a hibernated or resumed cluster and applies them to the cloud.
+// Only the tasks of those instance groups are applied, and their previous specs are restored if applying them fails.
+// The cluster must have been checked for other pending changes with checkNoPendingChanges.
+func applyHibernation(ctx context.Context, f *util.Factory, out io.Writer, clientset simple.Clientset, cluster *kopsapi.Cluster, previous []*kopsapi.InstanceGroup, changed []*kopsapi.InstanceGroup) error {
+	if err := updateInstanceGroups(ctx, clientset, cluster, changed); err != nil {
+		return err
+	}
+
+	opt := &CoreUpdateClusterOptions{}
+	opt.InitDefaults()
+	opt.ClusterName = cluster.Name
+	opt.Yes = true
+	for _, ig := range changed {
+		opt.InstanceGroups = append(opt.InstanceGroups, ig.Name)
+	}
+	if _, err := RunCoreUpdateCluster(ctx, f, out, opt); err != nil {
+		var restore []*kopsapi.InstanceGroup
+		for _, ig := range previous {
+			if slices.Contains(opt.InstanceGroups, ig.Name) {
+				restore = append(restore, ig)
+			}
+		}
+		if restoreErr := updateInstanceGroups(ctx, clientset, cluster, restore); restoreErr != nil {
+			return fmt.Errorf("%w; restoring the instance groups also failed: %v", err, restoreErr)
+		}
+		return fmt.Errorf("%w; the instance groups were restored", err)
+	}
+	return nil
+}
+
+// checkNoPendingChanges returns an error if kops update cluster has changes to apply to the cluster other than
+// to the sizes and processes of its autoscaling groups, which hibernation changes.
+func checkNoPendingChanges(ctx context.Context, f *util.Factory, clusterName string) error {
+	opt := &CoreUpdateClusterOptions{}
+	opt.InitDefaults()
+	opt.ClusterName = clusterName
+	opt.Target = cloudup.TargetDryRun
+	opt.DryRunOutput = io.Discard
+	results, err := RunCoreUpdateCluster(ctx, f, io.Discard, opt)
+	if err != nil {
+		return err
+	}
+
+	target, ok := results.Target.(*fi.CloudupDryRunTarget)
+	if !ok {
+		return fmt.Errorf("unexpected target type %T", results.Target)
+	}
+	pending := sets.New[string](target.Deletions()...)
+	creates, updates := target.Changes()
+	for name := range creates {
+		pending.Insert(name)
+	}
+	for name := range updates {
+		pending.Insert(name)
+	}
+	pending.Delete("AutoscalingGroup")
+	if pending.Len() != 0 {
+		return fmt.Errorf("cluster %q has pending changes to %s; apply them with kops update cluster --yes first", clusterName, strings.Join(sets.List(pending), ", "))
+	}
+	return nil
+}
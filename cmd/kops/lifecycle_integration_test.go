@@ -334,6 +334,7 @@ func runLifecycleTest(h *testutils.IntegrationTestHarness, o *LifecycleTestOptio
 		options := &DeleteClusterOptions{}
 		options.Yes = true
 		options.ClusterName = o.ClusterName
+		options.ConfirmName = o.ClusterName
 		if err := RunDeleteCluster(ctx, factory, &stdout, options); err != nil {
 			t.Fatalf("error running delete cluster %q: %v", o.ClusterName, err)
 		}
@@ -434,6 +435,7 @@ func runLifecycleTestOpenstack(o *LifecycleTestOptions) {
 		options := &DeleteClusterOptions{}
 		options.Yes = true
 		options.ClusterName = o.ClusterName
+		options.ConfirmName = o.ClusterName
 		if err := RunDeleteCluster(ctx, factory, &stdout, options); err != nil {
 			t.Fatalf("error running delete cluster %q: %v", o.ClusterName, err)
 		}
@@ -487,6 +489,7 @@ func runLifecycleTestGCE(o *LifecycleTestOptions) {
 		options := &DeleteClusterOptions{}
 		options.Yes = true
 		options.ClusterName = o.ClusterName
+		options.ConfirmName = o.ClusterName
 		if err := RunDeleteCluster(ctx, factory, &stdout, options); err != nil {
 			t.Fatalf("error running delete cluster %q: %v", o.ClusterName, err)
 		}
@@ -509,15 +512,22 @@ func updateEnsureNoChanges(ctx context.Context, t *testing.T, factory *util.Fact
 	options.InitDefaults()
 	options.RunTasksOptions.MaxTaskDuration = 10 * time.Second
 	options.Yes = true
+	options.Output = OutputJSON
 
 	// We don't test it here, and it adds a dependency on kubectl
 	options.CreateKubecfg = false
 	options.ClusterName = clusterName
 
-	_, err := RunUpdateCluster(ctx, factory, &stdout, options)
+	results, err := RunUpdateCluster(ctx, factory, &stdout, options)
 	if err != nil {
 		t.Fatalf("error running update cluster %q: %v", clusterName, err)
 	}
+	if results.Report == nil || results.Report.Error != "" || len(results.Report.Tasks) == 0 {
+		t.Fatalf("unexpected update cluster result: %+v", results.Report)
+	}
+	if results.Report.Created+results.Report.Updated+results.Report.Unchanged == 0 {
+		t.Fatalf("update cluster result did not record the action of any task")
+	}
 
 	// Now perform another dryrun update and ensure no changes are reported
 
@@ -530,7 +540,7 @@ func updateEnsureNoChanges(ctx context.Context, t *testing.T, factory *util.Fact
 	options.CreateKubecfg = false
 	options.ClusterName = clusterName
 
-	results, err := RunUpdateCluster(ctx, factory, &stdout, options)
+	results, err = RunUpdateCluster(ctx, factory, &stdout, options)
 	if err != nil {
 		t.Fatalf("error running update cluster %q: %v", clusterName, err)
 	}
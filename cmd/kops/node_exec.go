@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	nodeExecLong = templates.LongDesc(i18n.T(`
+	Run a command on an instance using the cloud provider's remote command API:
+	SSM Run Command on AWS, Run Command on Azure and OS Login on GCP.
+
+	This allows clusters to run with SSH closed to the outside world. On AWS
+	the instances must run the SSM agent and their IAM role must allow it to
+	register. On GCP, OS Login must be enabled for the project or the instances,
+	and the public key for --private-key is added to your OS Login profile.
+
+	The output is returned once the command completes. SSM truncates the output
+	to 24000 characters, and Azure Run Command to 4096 bytes.`))
+
+	nodeExecExample = templates.Examples(i18n.T(`
+	# Show the kubelet logs of an instance.
+	kops node exec i-0a5ed581b862d3425 --name k8s-cluster.example.com -- journalctl -u kubelet --no-pager -n 100
+	`))
+
+	nodeExecShort = i18n.T(`Run a command on an instance.`)
+)
+
+type NodeExecOptions struct {
+	ClusterName string
+	InstanceID  string
+	Command     string
+	PrivateKey  string
+}
+
+func NewCmdNodeExec(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &NodeExecOptions{
+		PrivateKey: "~/.ssh/id_rsa",
+	}
+
+	cmd := &cobra.Command{
+		Use:     "exec INSTANCE -- COMMAND [args...]",
+		Short:   nodeExecShort,
+		Long:    nodeExecLong,
+		Example: nodeExecExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			options.ClusterName = rootCommand.ClusterName(true)
+			if options.ClusterName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			dash := cmd.ArgsLenAtDash()
+			if dash != 1 || len(args) < 2 {
+				return fmt.Errorf("must specify the ID of one instance, then -- and the command to run")
+			}
+			options.InstanceID = args[0]
+			options.Command = strings.Join(args[1:], " ")
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunNodeExec(cmd.Context(), f, out, os.Stderr, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.PrivateKey, "private-key", options.PrivateKey, "File containing the SSH private key to use with OS Login on GCP")
+
+	return cmd
+}
+
+func RunNodeExec(ctx context.Context, f *util.Factory, stdout io.Writer, stderr io.Writer, options *NodeExecOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	transport, err := buildNodeTransport(cloud, cluster, options.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	return transport.Exec(ctx, options.InstanceID, options.Command, stdout, stderr)
+}
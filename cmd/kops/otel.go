@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -33,6 +34,7 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 
 	"k8s.io/kops/pkg/otel/otlptracefile"
+	"k8s.io/kops/pkg/otel/otlptracehttp"
 )
 
 // setupOTelSDK bootstraps the OpenTelemetry pipeline.
@@ -110,7 +112,7 @@ func newTraceProvider(ctx context.Context, res *resource.Resource) (*trace.Trace
 		}
 	}
 	if dest == "" {
-		return nil, nil
+		return newOTLPHTTPTraceProvider(ctx, res)
 	}
 
 	// If we are writing to a directory, construct a (likely) unique name
@@ -143,3 +145,63 @@ func newTraceProvider(ctx context.Context, res *resource.Resource) (*trace.Trace
 	)
 	return traceProvider, nil
 }
+
+// newOTLPHTTPTraceProvider builds a trace provider that sends spans to an OTLP/HTTP collector,
+// if one is configured using the standard OTEL_EXPORTER_OTLP_* environment variables.
+func newOTLPHTTPTraceProvider(ctx context.Context, res *resource.Resource) (*trace.TracerProvider, error) {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	if endpoint == "" {
+		// Per the spec, the signal path is only appended to the generic endpoint.
+		if base := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); base != "" {
+			endpoint = strings.TrimSuffix(base, "/") + "/v1/traces"
+		}
+	}
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	headers, err := parseOTLPHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing OTEL_EXPORTER_OTLP_HEADERS: %w", err)
+	}
+	tracesHeaders, err := parseOTLPHeaders(os.Getenv("OTEL_EXPORTER_OTLP_TRACES_HEADERS"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing OTEL_EXPORTER_OTLP_TRACES_HEADERS: %w", err)
+	}
+	for k, v := range tracesHeaders {
+		headers[k] = v
+	}
+
+	traceExporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpoint(endpoint), otlptracehttp.WithHeaders(headers))
+	if err != nil {
+		return nil, err
+	}
+
+	traceProvider := trace.NewTracerProvider(
+		trace.WithBatcher(traceExporter),
+		trace.WithResource(res),
+	)
+	return traceProvider, nil
+}
+
+// parseOTLPHeaders parses the W3C-baggage-style header list used by OTEL_EXPORTER_OTLP_HEADERS,
+// for example "api-key=secret,tenant=kops".
+func parseOTLPHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		k, v, found := strings.Cut(kv, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid header %q, expected key=value", kv)
+		}
+		value, err := url.QueryUnescape(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for header %q: %w", k, err)
+		}
+		headers[strings.TrimSpace(k)] = value
+	}
+	return headers, nil
+}
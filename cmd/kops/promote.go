@@ -33,6 +33,7 @@ func NewCmdPromote(f *util.Factory, out io.Writer) *cobra.Command {
 	}
 
 	// create subcommands
+	cmd.AddCommand(NewCmdPromoteImage(f, out))
 	cmd.AddCommand(NewCmdPromoteKeypair(f, out))
 
 	return cmd
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/imagechannel"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	promoteImageLong = templates.LongDesc(i18n.T(`
+	Promote the latest image of the image channel of instance groups.
+
+	Instance groups with an imageChannel are pinned to the latest image of their channel
+	when the cluster is first updated. The pin is only advanced to the latest image of
+	the channel by this command; kops update cluster warns when a newer image is available.
+
+	The new image is used by the instances launched after the next kops update cluster,
+	and kops rolling-update cluster replaces the existing instances.`))
+
+	promoteImageExample = templates.Examples(i18n.T(`
+	# Promote the latest image of the channel of the nodes instance group
+	kops promote image --instance-group nodes \
+		--name k8s-cluster.example.com --state s3://my-state-store
+
+	# Promote the latest images of all the instance groups with an image channel
+	kops promote image --name k8s-cluster.example.com --state s3://my-state-store
+	`))
+
+	promoteImageShort = i18n.T(`Promote the latest image of the image channel of instance groups.`)
+)
+
+type PromoteImageOptions struct {
+	ClusterName string
+	// InstanceGroups are the instance groups whose image is promoted, defaults to all those with an image channel
+	InstanceGroups []string
+}
+
+// NewCmdPromoteImage returns a promote image command.
+func NewCmdPromoteImage(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &PromoteImageOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "image",
+		Short:   promoteImageShort,
+		Long:    promoteImageLong,
+		Example: promoteImageExample,
+		Args: func(cmd *cobra.Command, args []string) error {
+			options.ClusterName = rootCommand.ClusterName(true)
+
+			if options.ClusterName == "" {
+				return fmt.Errorf("--name is required")
+			}
+
+			if len(args) != 0 {
+				return fmt.Errorf("unexpected arguments, use --instance-group to select the instance groups")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunPromoteImage(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&options.InstanceGroups, "instance-group", options.InstanceGroups, "Instance groups whose image to promote (defaults to all those with an image channel)")
+	cmd.RegisterFlagCompletionFunc("instance-group", completeInstanceGroup(f, &options.InstanceGroups, nil))
+
+	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		switch name {
+		case "ig", "instance-groups":
+			name = "instance-group"
+		}
+		return pflag.NormalizedName(name)
+	})
+
+	return cmd
+}
+
+// RunPromoteImage pins instance groups to the latest image of their image channel.
+func RunPromoteImage(ctx context.Context, f *util.Factory, out io.Writer, options *PromoteImageOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return fmt.Errorf("getting cluster: %q: %v", options.ClusterName, err)
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return fmt.Errorf("getting clientset: %v", err)
+	}
+
+	instanceGroups, err := listInstanceGroups(ctx, clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	var selected []*kopsapi.InstanceGroup
+	for _, ig := range instanceGroups {
+		if len(options.InstanceGroups) != 0 && !slices.Contains(options.InstanceGroups, ig.Name) {
+			continue
+		}
+		if ig.Spec.ImageChannel == "" {
+			if len(options.InstanceGroups) != 0 {
+				return fmt.Errorf("instance group %q has no image channel", ig.Name)
+			}
+			continue
+		}
+		selected = append(selected, ig)
+	}
+	for _, name := range options.InstanceGroups {
+		if !slices.ContainsFunc(selected, func(ig *kopsapi.InstanceGroup) bool { return ig.Name == name }) {
+			return fmt.Errorf("instance group %q not found", name)
+		}
+	}
+	if len(selected) == 0 {
+		fmt.Fprintf(out, "No instance groups with an image channel\n")
+		return nil
+	}
+
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+
+	var promoted []*kopsapi.InstanceGroup
+	for _, ig := range selected {
+		latest, err := cloudup.LatestChannelImage(cloud, ig)
+		if err != nil {
+			return err
+		}
+
+		pinned := imagechannel.Pinned(ig)
+		if pinned == latest {
+			fmt.Fprintf(out, "Instance group %s is already pinned to the latest image %s of channel %s\n", ig.Name, latest, ig.Spec.ImageChannel)
+			continue
+		}
+
+		imagechannel.Pin(ig, latest)
+		if pinned == "" {
+			fmt.Fprintf(out, "Pinned instance group %s to image %s of channel %s\n", ig.Name, latest, ig.Spec.ImageChannel)
+		} else {
+			fmt.Fprintf(out, "Promoted image of instance group %s from %s to %s\n", ig.Name, pinned, latest)
+		}
+		promoted = append(promoted, ig)
+	}
+
+	if err := updateInstanceGroups(ctx, clientset, cluster, promoted); err != nil {
+		return err
+	}
+
+	if len(promoted) != 0 {
+		fmt.Fprintf(out, "\nApply the new images with: kops update cluster --name %s --yes\n", cluster.Name)
+		fmt.Fprintf(out, "then replace the instances with: kops rolling-update cluster --name %s --yes\n", cluster.Name)
+	}
+
+	return nil
+}
@@ -86,6 +86,7 @@ func NewCmdReconcileCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	// cmd.Flags().BoolVar(&options.Internal, "internal", options.Internal, "Use the cluster's internal DNS name. Implies --create-kube-config")
 
 	cmd.Flags().BoolVar(&options.AllowKopsDowngrade, "allow-kops-downgrade", options.AllowKopsDowngrade, "Allow an older version of kOps to update the cluster than last used")
+	cmd.Flags().BoolVar(&options.SkipQuotaCheck, "skip-quota-check", options.SkipQuotaCheck, "Skip checking that the quotas of the cloud account have room for the cluster before creating its resources")
 
 	// These flags from the update command are not obviously needed by reconcile, though we can add them if needed:
 	//
@@ -119,6 +120,12 @@ func RunReconcileCluster(ctx context.Context, f *util.Factory, out io.Writer, c
 	if c.Target == cloudup.TargetTerraform {
 		return fmt.Errorf("reconcile is not supported with terraform")
 	}
+	if c.Target == cloudup.TargetPulumi {
+		return fmt.Errorf("reconcile is not supported with pulumi")
+	}
+	if c.Target == cloudup.TargetCrossplane {
+		return fmt.Errorf("reconcile is not supported with crossplane")
+	}
 
 	if !c.Yes {
 		// A reconcile without --yes is the same as a dry run
@@ -23,13 +23,20 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/cmd/kops/util"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/dryrun"
+	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kopscodecs"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kops/util/pkg/vfs"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
 )
@@ -47,6 +54,9 @@ var (
 
 		# Note, if the resource does not exist the command will error, use --force to provision resource
 		kops replace -f my-cluster.yaml --force
+
+		# Show the changes to the state store, without making them
+		kops replace -f my-cluster.yaml --dry-run=server
 		`))
 
 	replaceShort = i18n.T(`Replace cluster resources.`)
@@ -58,6 +68,8 @@ type ReplaceOptions struct {
 	Filenames []string
 	// Force causes any missing rescources to be created.
 	Force bool
+	// DryRun previews the resources which would be replaced
+	DryRun commandutils.DryRunStrategy
 }
 
 // NewCmdReplace returns a new replace command
@@ -78,13 +90,14 @@ func NewCmdReplace(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "A list of one or more files separated by a comma.")
 	cmd.MarkFlagRequired("filename")
 	cmd.Flags().BoolVarP(&options.Force, "force", "", false, "Force any changes, which will also create any non-existing resource")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 
 	return cmd
 }
 
 // RunReplace processes the replace command
 func RunReplace(ctx context.Context, f *util.Factory, out io.Writer, c *ReplaceOptions) error {
-	clientset, err := f.KopsClient()
+	clientset, err := dryRunClientset(f, c.DryRun)
 	if err != nil {
 		return err
 	}
@@ -112,117 +125,139 @@ func RunReplace(ctx context.Context, f *util.Factory, out io.Writer, c *ReplaceO
 				return fmt.Errorf("error parsing file %q: %v", f, err)
 			}
 
-			switch v := o.(type) {
-			case *kopsapi.Cluster:
-				{
-					// Retrieve the current status of the cluster.  This will eventually be part of the cluster object.
-					cloud, err := cloudup.BuildCloud(v)
-					if err != nil {
-						return err
-					}
-					status, err := cloud.FindClusterStatus(v)
-					if err != nil {
-						return err
-					}
-
-					// Check if the cluster exists already
-					clusterName := v.Name
-					cluster, err := clientset.GetCluster(ctx, clusterName)
-					if err != nil {
-						if errors.IsNotFound(err) {
-							cluster = nil
-						} else {
-							return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
-						}
-					}
-					if cluster == nil {
-						if !c.Force {
-							return fmt.Errorf("cluster %v does not exist (try adding --force flag)", clusterName)
-						}
-
-						err = cloudup.PerformAssignments(v, vfsContext, cloud)
-						if err != nil {
-							return fmt.Errorf("error populating configuration: %w", err)
-						}
-
-						_, err = clientset.CreateCluster(ctx, v)
-						if err != nil {
-							return fmt.Errorf("error creating cluster: %v", err)
-						}
-					} else {
-						_, err = clientset.UpdateCluster(ctx, v, status)
-						if err != nil {
-							return fmt.Errorf("error replacing cluster: %v", err)
-						}
-					}
+			if c.DryRun == commandutils.DryRunClient {
+				accessor, err := meta.Accessor(o)
+				if err != nil {
+					return fmt.Errorf("unhandled kind %q in %q", gvk, f)
 				}
+				printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionUpdate, Kind: gvk.Kind, Name: accessor.GetName()}, c.DryRun)
+				continue
+			}
 
-			case *kopsapi.InstanceGroup:
-				clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
-				if clusterName == "" {
-					return fmt.Errorf("must specify %q label with cluster name to replace instanceGroup", kopsapi.LabelClusterName)
-				}
-				cluster, err := clientset.GetCluster(ctx, clusterName)
-				if err != nil {
-					if errors.IsNotFound(err) {
-						return fmt.Errorf("cluster %q not found", clusterName)
-					}
+			if err := replaceResource(ctx, clientset, vfsContext, o, gvk, f, c.Force); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.DryRun == commandutils.DryRunServer {
+		return printDryRunChanges(out, clientset)
+	}
+
+	return nil
+}
+
+// replaceResource replaces a single decoded resource read from source, creating it if force is set
+func replaceResource(ctx context.Context, clientset simple.Clientset, vfsContext *vfs.VFSContext, o runtime.Object, gvk *schema.GroupVersionKind, source string, force bool) error {
+	switch v := o.(type) {
+	case *kopsapi.Cluster:
+		{
+			// Retrieve the current status of the cluster.  This will eventually be part of the cluster object.
+			cloud, err := cloudup.BuildCloud(v)
+			if err != nil {
+				return err
+			}
+			status, err := cloud.FindClusterStatus(v)
+			if err != nil {
+				return err
+			}
+
+			// Check if the cluster exists already
+			clusterName := v.Name
+			cluster, err := clientset.GetCluster(ctx, clusterName)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					cluster = nil
+				} else {
 					return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
 				}
-				// check if the instancegroup exists already
-				igName := v.ObjectMeta.Name
-				ig, err := clientset.InstanceGroupsFor(cluster).Get(ctx, igName, metav1.GetOptions{})
-				if err != nil {
-					if errors.IsNotFound(err) {
-						if !c.Force {
-							return fmt.Errorf("instanceGroup: %v does not exist (try adding --force flag)", igName)
-						}
-					} else {
-						return fmt.Errorf("unable to check for instanceGroup: %v", err)
-					}
-				}
-				switch ig {
-				case nil:
-					klog.Infof("instanceGroup: %v was not found, creating resource now", igName)
-					_, err = clientset.InstanceGroupsFor(cluster).Create(ctx, v, metav1.CreateOptions{})
-					if err != nil {
-						return fmt.Errorf("error creating instanceGroup: %v", err)
-					}
-				default:
-					_, err = clientset.InstanceGroupsFor(cluster).Update(ctx, v, metav1.UpdateOptions{})
-					if err != nil {
-						return fmt.Errorf("error replacing instanceGroup: %v", err)
-					}
-				}
-			case *kopsapi.SSHCredential:
-				clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
-				if clusterName == "" {
-					return fmt.Errorf("must specify %q label with cluster name to replace SSHCredential", kopsapi.LabelClusterName)
-				}
-				if v.Spec.PublicKey == "" {
-					return fmt.Errorf("spec.PublicKey is required")
+			}
+			if cluster == nil {
+				if !force {
+					return fmt.Errorf("cluster %v does not exist (try adding --force flag)", clusterName)
 				}
 
-				cluster, err := clientset.GetCluster(ctx, clusterName)
+				err = cloudup.PerformAssignments(v, vfsContext, cloud)
 				if err != nil {
-					return err
+					return fmt.Errorf("error populating configuration: %w", err)
 				}
 
-				sshCredentialStore, err := clientset.SSHCredentialStore(cluster)
+				_, err = clientset.CreateCluster(ctx, v)
 				if err != nil {
-					return err
+					return fmt.Errorf("error creating cluster: %v", err)
 				}
-
-				sshKeyArr := []byte(v.Spec.PublicKey)
-				err = sshCredentialStore.AddSSHPublicKey(ctx, sshKeyArr)
+			} else {
+				_, err = clientset.UpdateCluster(ctx, v, status)
 				if err != nil {
-					return fmt.Errorf("error replacing SSHCredential: %v", err)
+					return fmt.Errorf("error replacing cluster: %v", err)
+				}
+			}
+		}
+
+	case *kopsapi.InstanceGroup:
+		clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+		if clusterName == "" {
+			return fmt.Errorf("must specify %q label with cluster name to replace instanceGroup", kopsapi.LabelClusterName)
+		}
+		cluster, err := clientset.GetCluster(ctx, clusterName)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("cluster %q not found", clusterName)
+			}
+			return fmt.Errorf("error fetching cluster %q: %v", clusterName, err)
+		}
+		// check if the instancegroup exists already
+		igName := v.ObjectMeta.Name
+		ig, err := clientset.InstanceGroupsFor(cluster).Get(ctx, igName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				if !force {
+					return fmt.Errorf("instanceGroup: %v does not exist (try adding --force flag)", igName)
 				}
-			default:
-				klog.V(2).Infof("Type of object was %T", v)
-				return fmt.Errorf("unhandled kind %q in %q", gvk, f)
+			} else {
+				return fmt.Errorf("unable to check for instanceGroup: %v", err)
+			}
+		}
+		switch ig {
+		case nil:
+			klog.Infof("instanceGroup: %v was not found, creating resource now", igName)
+			_, err = clientset.InstanceGroupsFor(cluster).Create(ctx, v, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("error creating instanceGroup: %v", err)
+			}
+		default:
+			_, err = clientset.InstanceGroupsFor(cluster).Update(ctx, v, metav1.UpdateOptions{})
+			if err != nil {
+				return fmt.Errorf("error replacing instanceGroup: %v", err)
 			}
 		}
+	case *kopsapi.SSHCredential:
+		clusterName := v.ObjectMeta.Labels[kopsapi.LabelClusterName]
+		if clusterName == "" {
+			return fmt.Errorf("must specify %q label with cluster name to replace SSHCredential", kopsapi.LabelClusterName)
+		}
+		if v.Spec.PublicKey == "" {
+			return fmt.Errorf("spec.PublicKey is required")
+		}
+
+		cluster, err := clientset.GetCluster(ctx, clusterName)
+		if err != nil {
+			return err
+		}
+
+		sshCredentialStore, err := clientset.SSHCredentialStore(cluster)
+		if err != nil {
+			return err
+		}
+
+		sshKeyArr := []byte(v.Spec.PublicKey)
+		err = sshCredentialStore.AddSSHPublicKey(ctx, sshKeyArr)
+		if err != nil {
+			return fmt.Errorf("error replacing SSHCredential: %v", err)
+		}
+	default:
+		klog.V(2).Infof("Type of object was %T", v)
+		return fmt.Errorf("unhandled kind %q in %q", gvk, source)
 	}
 
 	return nil
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/hibernation"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	resumeClusterLong = templates.LongDesc(i18n.T(`
+	Resume a cluster hibernated with kops hibernate cluster.
+
+	The stopped control plane instances are started, then the instance groups are restored to their
+	sizes before hibernation. Without --yes, the changes to the instance groups are only previewed.`))
+
+	resumeClusterExample = templates.Examples(i18n.T(`
+	# Resume a cluster, and wait for it to be ready
+	kops resume cluster k8s-cluster.example.com --yes
+	kops validate cluster --name k8s-cluster.example.com --wait 10m
+	`))
+
+	resumeClusterShort = i18n.T("Resume a hibernated cluster.")
+)
+
+type ResumeClusterOptions struct {
+	ClusterName string
+	// Yes resumes the cluster, instead of previewing it
+	Yes bool
+	// Timeout is how long to wait for the control plane instances to be running
+	Timeout time.Duration
+}
+
+func (o *ResumeClusterOptions) InitDefaults() {
+	o.Timeout = 10 * time.Minute
+}
+
+func NewCmdResumeCluster(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ResumeClusterOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:               "cluster [CLUSTER]",
+		Short:             resumeClusterShort,
+		Long:              resumeClusterLong,
+		Example:           resumeClusterExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunResumeCluster(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Resume the cluster, without --yes the changes are only previewed")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", options.Timeout, "Maximum time to wait for the control plane instances to be running")
+
+	return cmd
+}
+
+func RunResumeCluster(ctx context.Context, f *util.Factory, out io.Writer, options *ResumeClusterOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	instanceGroups, err := listInstanceGroups(ctx, clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	resumed, err := hibernation.Resume(cluster, instanceGroups)
+	if err != nil {
+		return err
+	}
+
+	if !options.Yes {
+		if err := previewInstanceGroups(ctx, out, clientset, cluster, resumed); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\nMust specify --yes to resume the cluster\n")
+		return nil
+	}
+
+	if err := checkNoPendingChanges(ctx, f, cluster.Name); err != nil {
+		return err
+	}
+
+	// The control plane is started before the processes of its autoscaling groups are resumed,
+	// so that its stopped instances are not replaced.
+	if hibernation.StopsControlPlane(cluster) {
+		cloud, err := cloudup.BuildCloud(cluster)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Starting the control plane instances\n")
+		started, err := hibernation.StartControlPlane(ctx, cloud, cluster, instanceGroups, options.Timeout)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Started %d control plane instances\n", len(started))
+	}
+
+	if err := applyHibernation(ctx, f, out, clientset, cluster, instanceGroups, resumed); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nResumed cluster %q; wait for it to be ready with: kops validate cluster --name %s --wait 10m\n", cluster.Name, cluster.Name)
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/instancegroups"
 	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/pkg/notifications"
 	"k8s.io/kops/pkg/pretty"
 	"k8s.io/kops/pkg/validation"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
@@ -60,6 +62,10 @@ var (
 
 	If the cluster is in a broken state and cannot be validated, rolling-update will get stuck and eventually 
 	fail; you can force the update to proceed with the --cloudonly flag, which will skip validation.
+	When run in a terminal, --cloudonly requires typing the name of the cluster to confirm the update,
+	unless it is passed with --confirm-name. Without a terminal, --confirm-name is required.
+
+	With --dry-run=server, the instances which need to be updated are listed without replacing them, even with --yes.
 
 	Note: terraform users will need to run all of the following commands from the same directory
 	` + pretty.Bash("kops update cluster --target=terraform") + ` then ` + pretty.Bash("terraform plan") + ` then
@@ -99,6 +105,10 @@ type RollingUpdateOptions struct {
 	Yes       bool
 	Force     bool
 	CloudOnly bool
+	// ConfirmName is the name of the cluster, confirming a --cloudonly rolling update without prompting for it
+	ConfirmName string
+	// DryRun previews the instances which would be replaced, even with Yes
+	DryRun commandutils.DryRunStrategy
 
 	// The following two variables are when kOps is validating a cluster
 	// during a rolling update.
@@ -197,6 +207,8 @@ func NewCmdRollingUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Perform rolling update immediately; without --yes rolling-update executes a dry-run")
 	cmd.Flags().BoolVar(&options.Force, "force", options.Force, "Force rolling update, even if no changes")
 	cmd.Flags().BoolVar(&options.CloudOnly, "cloudonly", options.CloudOnly, "Perform rolling update without validating cluster status (will cause downtime)")
+	cmd.Flags().StringVar(&options.ConfirmName, "confirm-name", options.ConfirmName, "Name of the cluster, confirming a --cloudonly rolling update without prompting for it")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
 
 	cmd.Flags().DurationVar(&options.Admin, "admin", options.Admin, "a cluster admin user credential with the specified lifetime")
 	cmd.Flags().DurationVar(&options.ValidationTimeout, "validation-timeout", options.ValidationTimeout, "Maximum time to wait for a cluster to validate")
@@ -233,6 +245,13 @@ func NewCmdRollingUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 }
 
 func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, options *RollingUpdateOptions) error {
+	ctx, span := tracer.Start(ctx, "RunRollingUpdateCluster")
+	defer span.End()
+
+	if options.DryRun == commandutils.DryRunClient {
+		return fmt.Errorf("--dry-run=client is not supported, because the instances to replace are found in the cloud; use --dry-run=server")
+	}
+
 	f.CreateKubecfgOptions = options.CreateKubecfgOptions
 	clientset, err := f.KopsClient()
 	if err != nil {
@@ -447,11 +466,22 @@ func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer
 		return nil
 	}
 
+	if options.DryRun.Enabled() {
+		fmt.Fprintf(out, "\nThe instances marked NEEDUPDATE would be replaced (%s dry run).\n", options.DryRun)
+		return nil
+	}
+
 	if !options.Yes {
 		fmt.Printf("\nMust specify --yes to rolling-update.\n")
 		return nil
 	}
 
+	if options.CloudOnly {
+		if err := confirmClusterName(out, options.ClusterName, options.ConfirmName, "replace the instances without validation, causing downtime in"); err != nil {
+			return err
+		}
+	}
+
 	var clusterValidator validation.ClusterValidator
 	if !options.CloudOnly {
 		restConfig, err := f.RESTConfig(cluster)
@@ -466,7 +496,30 @@ func RunRollingUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer
 	}
 	d.ClusterValidator = clusterValidator
 
-	return d.RollingUpdate(ctx, groups, list)
+	err = d.RollingUpdate(ctx, groups, list)
+	notifyRollingUpdate(ctx, cluster, groups, err)
+	return err
+}
+
+// notifyRollingUpdate posts the outcome of a rolling update to the notification sinks of the cluster.
+func notifyRollingUpdate(ctx context.Context, cluster *kopsapi.Cluster, groups map[string]*cloudinstances.CloudInstanceGroup, err error) {
+	message := "Rolling update completed"
+	if err != nil {
+		message = "Rolling update failed"
+	}
+	event := notifications.NewEvent(cluster, kopsapi.NotificationEventTypeRollingUpdate, message, err)
+
+	var names []string
+	for name, group := range groups {
+		if len(group.NeedUpdate) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) != 0 {
+		sort.Strings(names)
+		event.Details = map[string]string{"instanceGroups": strings.Join(names, ",")}
+	}
+	notifications.Notify(ctx, cluster, event)
 }
 
 func completeInstanceGroup(f commandutils.Factory, selectedInstanceGroups *[]string, selectedInstanceGroupRoles *[]string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+)
+
+var snapshotShort = i18n.T(`Take and restore named snapshots of a cluster.`)
+
+func NewCmdSnapshot(f *util.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: snapshotShort,
+	}
+
+	cmd.AddCommand(NewCmdSnapshotCreate(f, out))
+	cmd.AddCommand(NewCmdSnapshotRestore(f, out))
+
+	return cmd
+}
+
+// snapshotArgs reads the name of the snapshot from the arguments, and the name of the cluster from --name.
+func snapshotArgs(clusterName *string, snapshotName *string) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		*clusterName = rootCommand.ClusterName(true)
+		if *clusterName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if len(args) != 1 {
+			return fmt.Errorf("must specify the name of the snapshot")
+		}
+		*snapshotName = args[0]
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/snapshot"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	snapshotCreateLong = templates.LongDesc(i18n.T(`
+	Take a named snapshot of a cluster, stored with the cluster in the state store.
+
+	The snapshot records the cluster and instance group specs and the versions of the addons,
+	and copies the latest backup of each etcd cluster taken by etcd-manager, so that it is kept
+	after etcd-manager removes it from the backup store. etcd-manager takes a backup every 15 minutes;
+	the snapshot warns if the latest backup is older than an hour.
+
+	The snapshot can be restored with kops snapshot restore.`))
+
+	snapshotCreateExample = templates.Examples(i18n.T(`
+	# Take a snapshot before upgrading a cluster
+	kops snapshot create before-1-34 --name k8s-cluster.example.com
+
+	# List the snapshots of a cluster
+	kops get snapshots --name k8s-cluster.example.com`))
+
+	snapshotCreateShort = i18n.T(`Take a named snapshot of a cluster.`)
+)
+
+// snapshotBackupMaxAge is the age of an etcd backup above which kops snapshot create warns that it may miss recent changes
+const snapshotBackupMaxAge = time.Hour
+
+type SnapshotCreateOptions struct {
+	ClusterName  string
+	SnapshotName string
+}
+
+func NewCmdSnapshotCreate(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &SnapshotCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "create SNAPSHOT",
+		Short:             snapshotCreateShort,
+		Long:              snapshotCreateLong,
+		Example:           snapshotCreateExample,
+		Args:              snapshotArgs(&options.ClusterName, &options.SnapshotName),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunSnapshotCreate(cmd.Context(), f, out, options)
+		},
+	}
+
+	return cmd
+}
+
+func RunSnapshotCreate(ctx context.Context, f *util.Factory, out io.Writer, options *SnapshotCreateOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(ctx, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	store, err := snapshot.NewStore(clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	s, err := store.Create(ctx, options.SnapshotName)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, backup := range s.EtcdBackups {
+		if backup.Timestamp != nil && now.Sub(backup.Timestamp.Time) > snapshotBackupMaxAge {
+			klog.Warningf("the latest backup of etcd cluster %q was taken %s ago; the snapshot does not include the changes since", backup.EtcdCluster, duration.HumanDuration(now.Sub(backup.Timestamp.Time)))
+		}
+	}
+
+	fmt.Fprintf(out, "Created snapshot %q of cluster %q\n", s.Name, s.ClusterName)
+	for _, backup := range s.EtcdBackups {
+		fmt.Fprintf(out, "  etcd cluster %s: backup %s\n", backup.EtcdCluster, backup.Backup)
+	}
+	return nil
+}
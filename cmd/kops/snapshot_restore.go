@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/dryrun"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/snapshot"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	snapshotRestoreLong = templates.LongDesc(i18n.T(`
+	Restore a cluster to a snapshot taken with kops snapshot create.
+
+	The cluster and instance group specs of the snapshot replace the current specs in the state store.
+	Instance groups created since the snapshot are kept, and must be deleted with kops delete instancegroup.
+	Unless --skip-etcd is set, etcd-manager is also instructed to restore the etcd backups of the snapshot;
+	this replaces all the data of the cluster, and requires typing the name of the cluster to confirm
+	unless it is passed with --confirm-name, which is required without a terminal.
+
+	Without --yes, the changes are only previewed. After restoring, apply the specs with kops update cluster,
+	and restart etcd-manager by rolling the control plane so that it restores the backups.`))
+
+	snapshotRestoreExample = templates.Examples(i18n.T(`
+	# Preview restoring a snapshot
+	kops snapshot restore before-1-34 --name k8s-cluster.example.com
+
+	# Restore a snapshot, including the etcd data
+	kops snapshot restore before-1-34 --name k8s-cluster.example.com --yes
+	kops update cluster --name k8s-cluster.example.com --yes
+	kops rolling-update cluster --name k8s-cluster.example.com --instance-group-roles=control-plane --cloudonly --force --yes`))
+
+	snapshotRestoreShort = i18n.T(`Restore a cluster to a snapshot.`)
+)
+
+type SnapshotRestoreOptions struct {
+	ClusterName  string
+	SnapshotName string
+	// Yes restores the snapshot, instead of previewing it
+	Yes bool
+	// SkipEtcd restores only the specs, keeping the current etcd data
+	SkipEtcd bool
+	// ConfirmName is the name of the cluster, confirming the restore of the etcd data without prompting for it
+	ConfirmName string
+	// DryRun previews the restore
+	DryRun commandutils.DryRunStrategy
+}
+
+func NewCmdSnapshotRestore(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &SnapshotRestoreOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "restore SNAPSHOT",
+		Short:             snapshotRestoreShort,
+		Long:              snapshotRestoreLong,
+		Example:           snapshotRestoreExample,
+		Args:              snapshotArgs(&options.ClusterName, &options.SnapshotName),
+		ValidArgsFunction: completeSnapshotName(f),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunSnapshotRestore(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Restore the snapshot")
+	cmd.Flags().BoolVar(&options.SkipEtcd, "skip-etcd", options.SkipEtcd, "Restore only the cluster and instance group specs, keeping the current etcd data")
+	cmd.Flags().StringVar(&options.ConfirmName, "confirm-name", options.ConfirmName, "Name of the cluster, confirming the restore of the etcd data without prompting for it")
+	commandutils.AddDryRunFlag(cmd.Flags(), &options.DryRun)
+
+	return cmd
+}
+
+func RunSnapshotRestore(ctx context.Context, f *util.Factory, out io.Writer, options *SnapshotRestoreOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(ctx, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	store, err := snapshot.NewStore(clientset, cluster)
+	if err != nil {
+		return err
+	}
+
+	s, err := store.Get(ctx, options.SnapshotName)
+	if err != nil {
+		return err
+	}
+	if s.KopsVersion != kops.Version {
+		klog.Warningf("snapshot %q was taken with kops %s, and is restored with kops %s", s.Name, s.KopsVersion, kops.Version)
+	}
+
+	snapshotCluster, snapshotIGs, err := store.Specs(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	restored := cluster.DeepCopy()
+	restored.Spec = snapshotCluster.Spec
+
+	preview := !options.Yes || options.DryRun.Enabled()
+	if preview && options.DryRun == commandutils.DryRunClient {
+		printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionUpdate, Kind: "Cluster", Name: cluster.Name}, options.DryRun)
+		for _, ig := range snapshotIGs {
+			printDryRunChange(out, &dryrun.Change{Action: dryrun.ActionUpdate, Kind: "InstanceGroup", Name: ig.Name}, options.DryRun)
+		}
+	} else {
+		target := clientset
+		if preview {
+			target = dryrun.NewClientset(clientset)
+		} else if !options.SkipEtcd {
+			if err := confirmClusterName(out, cluster.Name, options.ConfirmName, "restore the etcd data of"); err != nil {
+				return err
+			}
+		}
+
+		if err := restoreSnapshotSpecs(ctx, target, restored, snapshotIGs); err != nil {
+			return err
+		}
+		if preview {
+			if err := printDryRunChanges(out, target); err != nil {
+				return err
+			}
+		}
+	}
+
+	current, err := store.Addons(ctx)
+	if err != nil {
+		return err
+	}
+	currentVersions := make(map[string]string)
+	for _, addon := range current {
+		currentVersions[addon.Name] = addon.Version
+	}
+	for _, addon := range s.Addons {
+		if v, found := currentVersions[addon.Name]; found && v != addon.Version {
+			fmt.Fprintf(out, "addon %s will be changed from version %s to %s by kops update cluster\n", addon.Name, v, addon.Version)
+		}
+	}
+
+	if !options.SkipEtcd {
+		for _, backup := range s.EtcdBackups {
+			fmt.Fprintf(out, "etcd cluster %s will be restored from backup %s\n", backup.EtcdCluster, backup.Backup)
+		}
+	}
+
+	if preview {
+		if !options.Yes && !options.DryRun.Enabled() {
+			fmt.Fprintf(out, "\nMust specify --yes to restore the snapshot\n")
+		}
+		return nil
+	}
+
+	if !options.SkipEtcd {
+		if err := store.RestoreEtcd(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "\nRestored snapshot %q of cluster %q. Next steps:\n", s.Name, cluster.Name)
+	fmt.Fprintf(out, " * apply the restored specs: kops update cluster --name %s --yes\n", cluster.Name)
+	if !options.SkipEtcd {
+		fmt.Fprintf(out, " * restart etcd-manager to restore the etcd backups: kops rolling-update cluster --name %s --instance-group-roles=control-plane --cloudonly --force --yes\n", cluster.Name)
+	}
+	return nil
+}
+
+// restoreSnapshotSpecs writes the cluster and instance group specs of a snapshot.
+// Instance groups which are not part of the snapshot are kept.
+func restoreSnapshotSpecs(ctx context.Context, clientset simple.Clientset, cluster *kopsapi.Cluster, instanceGroups []*kopsapi.InstanceGroup) error {
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return err
+	}
+	status, err := cloud.FindClusterStatus(cluster)
+	if err != nil {
+		return err
+	}
+	if _, err := clientset.UpdateCluster(ctx, cluster, status); err != nil {
+		return fmt.Errorf("error restoring cluster: %w", err)
+	}
+
+	igClient := clientset.InstanceGroupsFor(cluster)
+	existing, err := igClient.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing instance groups: %w", err)
+	}
+	inSnapshot := make(map[string]bool)
+	for _, ig := range instanceGroups {
+		inSnapshot[ig.Name] = true
+
+		current, err := igClient.Get(ctx, ig.Name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("error reading instance group %q: %w", ig.Name, err)
+		}
+		if current == nil || errors.IsNotFound(err) {
+			restored := ig.DeepCopy()
+			restored.ResourceVersion = ""
+			if _, err := igClient.Create(ctx, restored, metav1.CreateOptions{}); err != nil {
+				return fmt.Errorf("error restoring instance group %q: %w", ig.Name, err)
+			}
+			continue
+		}
+		restored := current.DeepCopy()
+		restored.Spec = ig.Spec
+		if _, err := igClient.Update(ctx, restored, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error restoring instance group %q: %w", ig.Name, err)
+		}
+	}
+	for i := range existing.Items {
+		if name := existing.Items[i].Name; !inSnapshot[name] {
+			klog.Warningf("instance group %q is not part of the snapshot; delete it with kops delete instancegroup %s --name %s", name, name, cluster.Name)
+		}
+	}
+	return nil
+}
+
+// completeSnapshotName completes the names of the snapshots of the cluster.
+func completeSnapshotName(f *util.Factory) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		commandutils.ConfigureKlogForCompletion()
+		ctx := cmd.Context()
+
+		cluster, clientSet, completions, directive := GetClusterForCompletion(ctx, f, nil)
+		if cluster == nil {
+			return completions, directive
+		}
+		store, err := snapshot.NewStore(clientSet, cluster)
+		if err != nil {
+			return commandutils.CompletionError("building snapshot store", err)
+		}
+		snapshots, err := store.List(ctx)
+		if err != nil {
+			return commandutils.CompletionError("listing snapshots", err)
+		}
+		var names []string
+		for _, s := range snapshots {
+			names = append(names, s.Name)
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/pki"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+// defaultSSHCertificateMaxTTL is the longest validity of an SSH certificate, unless sshCertificateAuthority.maxTTL is set.
+const defaultSSHCertificateMaxTTL = 24 * time.Hour
+
+var (
+	sshIssueLong = templates.LongDesc(i18n.T(`
+	Issue a short-lived SSH certificate for a public key, signed by the
+	cluster's SSH certificate authority.
+
+	The certificate identifies the user in the logs of the instances, and
+	allows logging in as the principals set in sshCertificateAuthority.principals
+	or with --principal. It is written next to the public key, where ssh
+	finds it automatically.`))
+
+	sshIssueExample = templates.Examples(i18n.T(`
+	# Issue a certificate for alice, valid for one hour.
+	kops ssh issue k8s-cluster.example.com --user alice --ttl 1h -i ~/.ssh/id_ed25519.pub
+	ssh ubuntu@node.example.com
+	`))
+
+	sshIssueShort = i18n.T(`Issue a short-lived SSH certificate.`)
+)
+
+type SSHIssueOptions struct {
+	ClusterName   string
+	User          string
+	TTL           time.Duration
+	Principals    []string
+	PublicKeyPath string
+	Output        string
+}
+
+func NewCmdSSHIssue(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &SSHIssueOptions{
+		TTL: time.Hour,
+	}
+
+	cmd := &cobra.Command{
+		Use:               "issue [CLUSTER]",
+		Short:             sshIssueShort,
+		Long:              sshIssueLong,
+		Example:           sshIssueExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunSSHIssue(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.User, "user", options.User, "Identity of the user, logged by the instances")
+	cmd.MarkFlagRequired("user")
+	cmd.Flags().DurationVar(&options.TTL, "ttl", options.TTL, "Validity of the certificate")
+	cmd.Flags().StringSliceVar(&options.Principals, "principal", options.Principals, "User to allow logging in as, instead of sshCertificateAuthority.principals")
+	cmd.Flags().StringVarP(&options.PublicKeyPath, "ssh-public-key", "i", options.PublicKeyPath, "Path to the SSH public key to certify")
+	cmd.MarkFlagRequired("ssh-public-key")
+	cmd.RegisterFlagCompletionFunc("ssh-public-key", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"pub"}, cobra.ShellCompDirectiveFilterFileExt
+	})
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Path to write the certificate to. Defaults to the public key path with a -cert.pub suffix")
+
+	return cmd
+}
+
+func RunSSHIssue(ctx context.Context, f *util.Factory, out io.Writer, options *SSHIssueOptions) error {
+	cluster, err := GetCluster(ctx, f, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	ca := cluster.Spec.SSHCertificateAuthority
+	if ca == nil {
+		return fmt.Errorf("cluster %q does not have an SSH certificate authority; set spec.sshCertificateAuthority", cluster.ObjectMeta.Name)
+	}
+
+	principals := options.Principals
+	if len(principals) == 0 {
+		principals = ca.Principals
+	}
+	if len(principals) == 0 {
+		return fmt.Errorf("no principals to issue the certificate for; use --principal or set spec.sshCertificateAuthority.principals")
+	}
+
+	maxTTL := defaultSSHCertificateMaxTTL
+	if ca.MaxTTL != nil {
+		maxTTL = ca.MaxTTL.Duration
+	}
+	if options.TTL > maxTTL {
+		return fmt.Errorf("--ttl %v is longer than the maximum of %v", options.TTL, maxTTL)
+	}
+
+	publicKey, err := os.ReadFile(options.PublicKeyPath)
+	if err != nil {
+		return fmt.Errorf("error reading SSH public key %v: %v", options.PublicKeyPath, err)
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	keyStore, err := clientset.KeyStore(cluster)
+	if err != nil {
+		return err
+	}
+
+	_, caPrivateKey, err := fi.NewPKIKeystoreAdapter(keyStore).FindPrimaryKeypair(ctx, "ssh-ca")
+	if err != nil {
+		return fmt.Errorf("error reading the ssh-ca keypair: %w", err)
+	}
+	if caPrivateKey == nil {
+		return fmt.Errorf("the ssh-ca keypair was not found; run kops update cluster --yes to create it")
+	}
+
+	certificate, err := pki.IssueSSHUserCertificate(&pki.SSHUserCertificateRequest{
+		PublicKey:  string(publicKey),
+		KeyID:      options.User,
+		Principals: principals,
+		TTL:        options.TTL,
+	}, caPrivateKey)
+	if err != nil {
+		return err
+	}
+
+	output := options.Output
+	if output == "" {
+		output = strings.TrimSuffix(options.PublicKeyPath, ".pub") + "-cert.pub"
+	}
+	if err := os.WriteFile(output, []byte(certificate), 0o644); err != nil {
+		return fmt.Errorf("error writing SSH certificate: %w", err)
+	}
+
+	fmt.Fprintf(out, "Wrote SSH certificate for %s, valid for %v as %s, to %s\n", options.User, options.TTL, strings.Join(principals, ", "), output)
+	return nil
+}
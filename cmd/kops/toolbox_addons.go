@@ -20,11 +20,12 @@ import (
 	"io"
 
 	channelscmd "k8s.io/kops/channels/pkg/cmd"
+	"k8s.io/kops/cmd/kops/util"
 
 	"github.com/spf13/cobra"
 )
 
-func NewCmdToolboxAddons(out io.Writer) *cobra.Command {
+func NewCmdToolboxAddons(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:           "addons",
 		Short:         "Manage addons",
@@ -32,7 +33,7 @@ func NewCmdToolboxAddons(out io.Writer) *cobra.Command {
 		SilenceUsage:  true,
 	}
 
-	f := channelscmd.NewChannelsFactory()
+	channelsFactory := channelscmd.NewChannelsFactory()
 
 	// create subcommands
 	cmd.AddCommand(&cobra.Command{
@@ -41,7 +42,7 @@ func NewCmdToolboxAddons(out io.Writer) *cobra.Command {
 		Example: "kops toolbox addons apply s3://<state_store>/<cluster_name>/addons/bootstrap-channel.yaml",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
-			return channelscmd.RunApplyChannel(ctx, f, out, &channelscmd.ApplyChannelOptions{}, args)
+			return channelscmd.RunApplyChannel(ctx, channelsFactory, out, &channelscmd.ApplyChannelOptions{}, args)
 		},
 	})
 	cmd.AddCommand(&cobra.Command{
@@ -49,9 +50,10 @@ func NewCmdToolboxAddons(out io.Writer) *cobra.Command {
 		Short: "Lists installed addons",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
-			return channelscmd.RunGetAddons(ctx, f, out, &channelscmd.GetAddonsOptions{})
+			return channelscmd.RunGetAddons(ctx, channelsFactory, out, &channelscmd.GetAddonsOptions{})
 		},
 	})
+	cmd.AddCommand(NewCmdToolboxAddonsRender(f, out))
 
 	return cmd
 }
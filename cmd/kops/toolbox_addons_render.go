@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/upup/pkg/fi/fitasks"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	toolboxAddonsRenderLong = templates.LongDesc(i18n.T(`
+	Render the addon manifests of a cluster to a local directory, without applying them.
+
+	The manifests are rendered exactly as kops update cluster would write them to the state store,
+	including the bootstrap channel, so that they can be reviewed or committed before the channels
+	applier applies them to the cluster. The layout of the directory matches the addons directory of the state store.`))
+
+	toolboxAddonsRenderExample = templates.Examples(i18n.T(`
+	# Render the addon manifests of a cluster
+	kops toolbox addons render k8s-cluster.example.com --out addons/
+
+	# Compare them with the manifests currently in the state store
+	aws s3 sync s3://example-state-store/k8s-cluster.example.com/addons/ current/
+	diff -r current/ addons/`))
+
+	toolboxAddonsRenderShort = i18n.T(`Render the addon manifests of a cluster to a local directory`)
+)
+
+// addonsLocationPrefix is the prefix of the location of the addon manifests in the state store
+const addonsLocationPrefix = "addons/"
+
+type ToolboxAddonsRenderOptions struct {
+	ClusterName string
+	// OutDir is the directory the manifests are written to
+	OutDir string
+}
+
+func NewCmdToolboxAddonsRender(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxAddonsRenderOptions{}
+
+	cmd := &cobra.Command{
+		Use:               "render [CLUSTER]",
+		Short:             toolboxAddonsRenderShort,
+		Long:              toolboxAddonsRenderLong,
+		Example:           toolboxAddonsRenderExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxAddonsRender(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.OutDir, "out", options.OutDir, "Directory to write the addon manifests to")
+	cmd.MarkFlagRequired("out")
+	cmd.MarkFlagDirname("out")
+
+	return cmd
+}
+
+func RunToolboxAddonsRender(ctx context.Context, f *util.Factory, out io.Writer, options *ToolboxAddonsRenderOptions) error {
+	if options.OutDir == "" {
+		return fmt.Errorf("--out is required")
+	}
+
+	// As for kops get assets, the model is built without inspecting the cloud resources
+	updateClusterResults, err := RunUpdateCluster(ctx, f, out, &UpdateClusterOptions{
+		CoreUpdateClusterOptions: CoreUpdateClusterOptions{
+			Target:      cloudup.TargetDryRun,
+			GetAssets:   true,
+			ClusterName: options.ClusterName,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	written, err := writeAddonManifests(updateClusterResults.TaskMap, options.OutDir)
+	if err != nil {
+		return err
+	}
+	if len(written) == 0 {
+		return fmt.Errorf("no addon manifests found for cluster %q", options.ClusterName)
+	}
+
+	for _, p := range written {
+		fmt.Fprintf(out, "wrote %s\n", p)
+	}
+	return nil
+}
+
+// writeAddonManifests writes the contents of the managed files in the addons directory of the
+// state store below outDir, and returns the paths written in order.
+func writeAddonManifests(taskMap map[string]fi.CloudupTask, outDir string) ([]string, error) {
+	var written []string
+	for _, task := range taskMap {
+		managedFile, ok := task.(*fitasks.ManagedFile)
+		if !ok || managedFile.Location == nil || managedFile.Contents == nil {
+			continue
+		}
+		location := fi.ValueOf(managedFile.Location)
+		if !strings.HasPrefix(location, addonsLocationPrefix) {
+			continue
+		}
+
+		contents, err := fi.ResourceAsBytes(managedFile.Contents)
+		if err != nil {
+			return nil, fmt.Errorf("rendering %s: %w", location, err)
+		}
+		if len(contents) != 0 && contents[len(contents)-1] != '\n' {
+			contents = append(contents, '\n')
+		}
+
+		p := filepath.Join(outDir, filepath.FromSlash(strings.TrimPrefix(location, addonsLocationPrefix)))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			return nil, fmt.Errorf("creating directory for %s: %w", p, err)
+		}
+		if err := os.WriteFile(p, contents, 0o644); err != nil {
+			return nil, fmt.Errorf("writing %s: %w", p, err)
+		}
+		written = append(written, p)
+	}
+
+	sort.Strings(written)
+	return written, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/fitasks"
+)
+
+func TestWriteAddonManifests(t *testing.T) {
+	managedFile := func(location string, contents string) *fitasks.ManagedFile {
+		return &fitasks.ManagedFile{
+			Name:     fi.PtrTo(location),
+			Location: fi.PtrTo(location),
+			Contents: fi.NewStringResource(contents),
+		}
+	}
+
+	taskMap := map[string]fi.CloudupTask{
+		"bootstrap":       managedFile("addons/bootstrap-channel.yaml", "kind: Addons"),
+		"kops-controller": managedFile("addons/kops-controller.addons.k8s.io/k8s-1.16.yaml", "kind: DaemonSet\n"),
+		"cluster-spec":    managedFile("cluster-completed.spec", "kind: Cluster"),
+		"keypair":         &fitasks.Keypair{Name: fi.PtrTo("kubernetes-ca")},
+	}
+
+	dir := t.TempDir()
+	written, err := writeAddonManifests(taskMap, dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "bootstrap-channel.yaml"),
+		filepath.Join(dir, "kops-controller.addons.k8s.io", "k8s-1.16.yaml"),
+	}, written)
+
+	contents, err := os.ReadFile(filepath.Join(dir, "bootstrap-channel.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: Addons\n", string(contents))
+
+	contents, err = os.ReadFile(filepath.Join(dir, "kops-controller.addons.k8s.io", "k8s-1.16.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: DaemonSet\n", string(contents))
+}
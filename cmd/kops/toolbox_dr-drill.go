@@ -0,0 +1,440 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/registry"
+	"k8s.io/kops/pkg/commands"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/pkg/snapshot"
+	"k8s.io/kops/upup/pkg/fi/cloudup"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kops/util/pkg/vfs"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	toolboxDRDrillLong = templates.LongDesc(i18n.T(`
+	Verify that the etcd backups of a cluster can be restored.
+
+	A temporary cluster is created from the configuration of the cluster, with only its control plane,
+	in a network of its own. It restores the latest backup of each etcd cluster. Once its API server is healthy,
+	the objects of the restored cluster are counted and compared with those of the cluster, then the temporary
+	cluster is deleted.
+
+	The drill passes if the API server of the temporary cluster becomes healthy within --timeout, and it has at least
+	--min-object-ratio of the objects of each kind of the cluster. The objects of the cluster are read with the
+	current kubeconfig; if they can't be read, only the health of the API server is checked.
+
+	Without --yes, the drill is only previewed.`))
+
+	toolboxDRDrillExample = templates.Examples(i18n.T(`
+	# Preview a disaster recovery drill
+	kops toolbox dr-drill --name k8s-cluster.example.com
+
+	# Run a disaster recovery drill, printing the results as JSON
+	kops toolbox dr-drill --name k8s-cluster.example.com --yes -o json
+	`))
+
+	toolboxDRDrillShort = i18n.T(`Restore the etcd backups of a cluster into a temporary cluster, to verify them`)
+)
+
+type ToolboxDRDrillOptions struct {
+	ClusterName string
+	// Yes runs the drill, instead of previewing it
+	Yes bool
+	// Timeout is how long to wait for the API server of the temporary cluster to be healthy
+	Timeout time.Duration
+	// MinObjectRatio is the fraction of the objects of each kind of the cluster which must be restored
+	MinObjectRatio float64
+	// Keep keeps the temporary cluster after the drill, for investigation
+	Keep   bool
+	Output string
+}
+
+func (o *ToolboxDRDrillOptions) InitDefaults() {
+	o.Timeout = 30 * time.Minute
+	o.MinObjectRatio = 0.9
+	o.Output = OutputTable
+}
+
+// drDrillReport is the result of a disaster recovery drill.
+type drDrillReport struct {
+	ClusterName      string                        `json:"clusterName"`
+	DrillClusterName string                        `json:"drillClusterName"`
+	EtcdBackups      []snapshot.EtcdBackup         `json:"etcdBackups"`
+	APIHealthy       bool                          `json:"apiHealthy"`
+	Objects          []commands.DRDrillObjectCount `json:"objects,omitempty"`
+	Duration         metav1.Duration               `json:"duration"`
+	Passed           bool                          `json:"passed"`
+	Error            string                        `json:"error,omitempty"`
+}
+
+func NewCmdToolboxDRDrill(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxDRDrillOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:               "dr-drill [CLUSTER]",
+		Short:             toolboxDRDrillShort,
+		Long:              toolboxDRDrillLong,
+		Example:           toolboxDRDrillExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxDRDrill(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Run the drill")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", options.Timeout, "Maximum time to wait for the API server of the temporary cluster to be healthy")
+	cmd.Flags().Float64Var(&options.MinObjectRatio, "min-object-ratio", options.MinObjectRatio, "Fraction of the objects of each kind of the cluster which must be restored")
+	cmd.Flags().BoolVar(&options.Keep, "keep", options.Keep, "Keep the temporary cluster after the drill")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format of the results. One of table, yaml or json")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputTable, OutputJSON, OutputYaml}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func RunToolboxDRDrill(ctx context.Context, f *util.Factory, out io.Writer, options *ToolboxDRDrillOptions) error {
+	if options.MinObjectRatio < 0 || options.MinObjectRatio > 1 {
+		return fmt.Errorf("--min-object-ratio must be between 0 and 1")
+	}
+	switch options.Output {
+	case OutputTable, OutputJSON, OutputYaml:
+	default:
+		return fmt.Errorf("unsupported output type %q", options.Output)
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(ctx, options.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	igList, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var igs []*kopsapi.InstanceGroup
+	for i := range igList.Items {
+		igs = append(igs, &igList.Items[i])
+	}
+
+	start := time.Now()
+	drillName := commands.DRDrillClusterName(cluster.Name, start)
+	drill, drillIGs, warnings, err := commands.BuildDRDrillCluster(cluster, igs, drillName)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		klog.V(2).Infof("%s", warning)
+	}
+	configBase, err := clientset.ConfigBaseFor(drill)
+	if err != nil {
+		return fmt.Errorf("error building ConfigBase for cluster: %v", err)
+	}
+	drill.Spec.ConfigStore.Base = configBase.Path()
+
+	report := &drDrillReport{
+		ClusterName:      cluster.Name,
+		DrillClusterName: drillName,
+	}
+	backupStores := make(map[string]vfs.Path)
+	drillBackupStores := make(map[string]vfs.Path)
+	for i := range drill.Spec.EtcdClusters {
+		etcdCluster := &drill.Spec.EtcdClusters[i]
+		backupStore, err := etcdBackupStore(f, cluster, etcdCluster.Name)
+		if err != nil {
+			return err
+		}
+		backup, err := snapshot.LatestEtcdBackup(ctx, backupStore)
+		if err != nil {
+			return err
+		}
+		if backup == "" {
+			return fmt.Errorf("no backup of etcd cluster %q found in %s", etcdCluster.Name, backupStore)
+		}
+
+		drillBackupStore := configBase.Join("backups", "etcd", etcdCluster.Name)
+		if drillBackupStore.Path() == backupStore.Path() {
+			return fmt.Errorf("the backup store of etcd cluster %q is below the config base of the drill", etcdCluster.Name)
+		}
+		if etcdCluster.Backups == nil {
+			etcdCluster.Backups = &kopsapi.EtcdBackupSpec{}
+		}
+		etcdCluster.Backups.BackupStore = drillBackupStore.Path()
+
+		backupStores[etcdCluster.Name] = backupStore
+		drillBackupStores[etcdCluster.Name] = drillBackupStore
+		report.EtcdBackups = append(report.EtcdBackups, snapshot.EtcdBackup{EtcdCluster: etcdCluster.Name, Backup: backup})
+	}
+
+	if !options.Yes {
+		fmt.Fprintf(out, "Cluster %q will be created, with instance groups:\n", drillName)
+		for _, ig := range drillIGs {
+			fmt.Fprintf(out, "  %s\n", ig.Name)
+		}
+		for _, backup := range report.EtcdBackups {
+			fmt.Fprintf(out, "etcd cluster %s will be restored from backup %s\n", backup.EtcdCluster, backup.Backup)
+		}
+		fmt.Fprintf(out, "\nMust specify --yes to run the drill\n")
+		return nil
+	}
+
+	if err := registry.CreateClusterConfig(ctx, clientset, drill, drillIGs, nil); err != nil {
+		return fmt.Errorf("error writing the configuration of cluster %q: %w", drillName, err)
+	}
+	if err := copySSHPublicKeys(ctx, clientset, cluster, drill); err != nil {
+		return err
+	}
+
+	// The output of creating and deleting the temporary cluster is kept apart from the results
+	progress := out
+	if options.Output != OutputTable {
+		progress = os.Stderr
+	}
+
+	drillErr := runDRDrill(ctx, f, progress, options, cluster, drill, backupStores, drillBackupStores, report)
+
+	if options.Keep {
+		klog.Infof("keeping cluster %q; delete it with kops delete cluster --name %s --yes", drillName, drillName)
+	} else {
+		deleteOptions := &DeleteClusterOptions{}
+		deleteOptions.InitDefaults()
+		deleteOptions.ClusterName = drillName
+		deleteOptions.ConfirmName = drillName
+		deleteOptions.Yes = true
+		if err := RunDeleteCluster(ctx, f, progress, deleteOptions); err != nil {
+			klog.Errorf("error deleting cluster %q: %v", drillName, err)
+			if drillErr == nil {
+				drillErr = fmt.Errorf("error deleting cluster %q: %w", drillName, err)
+			}
+		}
+	}
+
+	report.Duration = metav1.Duration{Duration: time.Since(start).Round(time.Second)}
+	if drillErr != nil {
+		report.Error = drillErr.Error()
+	}
+	report.Passed = drillErr == nil
+	if err := printDRDrillReport(out, report, options.Output); err != nil {
+		return err
+	}
+	if drillErr != nil {
+		return fmt.Errorf("disaster recovery drill of cluster %q failed: %w", cluster.Name, drillErr)
+	}
+	return nil
+}
+
+// runDRDrill restores the etcd backups into the temporary cluster, creates it and checks its API server and objects.
+func runDRDrill(ctx context.Context, f *util.Factory, out io.Writer, options *ToolboxDRDrillOptions, cluster *kopsapi.Cluster, drill *kopsapi.Cluster, backupStores map[string]vfs.Path, drillBackupStores map[string]vfs.Path, report *drDrillReport) error {
+	for _, backup := range report.EtcdBackups {
+		backupStore := backupStores[backup.EtcdCluster]
+		drillBackupStore := drillBackupStores[backup.EtcdCluster]
+		klog.Infof("copying backup %q of etcd cluster %q to %s", backup.Backup, backup.EtcdCluster, drillBackupStore)
+		if err := snapshot.CopyEtcdBackup(ctx, backupStore, drillBackupStore, backup.Backup); err != nil {
+			return fmt.Errorf("error copying backup %q of etcd cluster %q: %w", backup.Backup, backup.EtcdCluster, err)
+		}
+		clusterSpec, err := snapshot.ReadEtcdClusterSpec(ctx, backupStore)
+		if err != nil {
+			return fmt.Errorf("error reading the spec of etcd cluster %q: %w", backup.EtcdCluster, err)
+		}
+		if err := snapshot.RequestEtcdRestore(ctx, drillBackupStore, clusterSpec, backup.Backup); err != nil {
+			return fmt.Errorf("error writing restore command for etcd cluster %q: %w", backup.EtcdCluster, err)
+		}
+	}
+
+	updateOptions := &UpdateClusterOptions{}
+	updateOptions.InitDefaults()
+	updateOptions.ClusterName = drill.Name
+	updateOptions.Yes = true
+	updateOptions.CreateKubecfg = false
+	if _, err := RunUpdateCluster(ctx, f, out, updateOptions); err != nil {
+		return fmt.Errorf("error creating cluster %q: %w", drill.Name, err)
+	}
+
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+	drill, err = clientset.GetCluster(ctx, drill.Name)
+	if err != nil {
+		return err
+	}
+	restConfig, err := drDrillRESTConfig(ctx, f, drill)
+	if err != nil {
+		return err
+	}
+	drillClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error building kube client for cluster %q: %w", drill.Name, err)
+	}
+
+	klog.Infof("waiting up to %v for the API server of cluster %q to be healthy", options.Timeout, drill.Name)
+	err = wait.PollUntilContextTimeout(ctx, 15*time.Second, options.Timeout, true, func(ctx context.Context) (bool, error) {
+		if _, err := drillClient.Discovery().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+			klog.V(2).Infof("API server of cluster %q is not healthy yet: %v", drill.Name, err)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("the API server of cluster %q did not become healthy within %v", drill.Name, options.Timeout)
+	}
+	report.APIHealthy = true
+
+	restored, err := commands.CountDRDrillObjects(ctx, drillClient)
+	if err != nil {
+		return err
+	}
+	var source map[string]int
+	if sourceConfig, err := f.RESTConfig(cluster); err != nil {
+		klog.Warningf("cannot read the objects of cluster %q: %v", cluster.Name, err)
+	} else if sourceClient, err := kubernetes.NewForConfig(sourceConfig); err != nil {
+		klog.Warningf("cannot read the objects of cluster %q: %v", cluster.Name, err)
+	} else if source, err = commands.CountDRDrillObjects(ctx, sourceClient); err != nil {
+		klog.Warningf("cannot read the objects of cluster %q: %v", cluster.Name, err)
+		source = nil
+	}
+
+	report.Objects = commands.CompareDRDrillObjects(source, restored, options.MinObjectRatio)
+	if failures := commands.DRDrillFailures(report.Objects); failures != "" {
+		return fmt.Errorf("not enough objects were restored: %s", failures)
+	}
+	return nil
+}
+
+// drDrillRESTConfig returns the configuration of an admin client of the temporary cluster, without writing it to the kubeconfig.
+func drDrillRESTConfig(ctx context.Context, f *util.Factory, cluster *kopsapi.Cluster) (*rest.Config, error) {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return nil, err
+	}
+	keyStore, err := clientset.KeyStore(cluster)
+	if err != nil {
+		return nil, err
+	}
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		return nil, err
+	}
+	cloud, err := cloudup.BuildCloud(cluster)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := kubeconfig.BuildKubecfg(ctx, cluster, keyStore, secretStore, cloud, kubeconfig.CreateKubecfgOptions{Admin: time.Hour}, f.KopsStateStore())
+	if err != nil {
+		return nil, err
+	}
+	return conf.ToRESTConfig()
+}
+
+// etcdBackupStore returns the backup store of an etcd cluster of a cluster.
+func etcdBackupStore(f *util.Factory, cluster *kopsapi.Cluster, etcdClusterName string) (vfs.Path, error) {
+	for _, etcdCluster := range cluster.Spec.EtcdClusters {
+		if etcdCluster.Name != etcdClusterName {
+			continue
+		}
+		if etcdCluster.Backups == nil || etcdCluster.Backups.BackupStore == "" {
+			return nil, fmt.Errorf("etcd cluster %q does not have a backupStore", etcdClusterName)
+		}
+		return f.VFSContext().BuildVfsPath(etcdCluster.Backups.BackupStore)
+	}
+	return nil, fmt.Errorf("etcd cluster %q not found in cluster %q", etcdClusterName, cluster.Name)
+}
+
+func printDRDrillReport(out io.Writer, report *drDrillReport, output string) error {
+	switch output {
+	case OutputYaml:
+		y, err := yaml.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+		_, err = out.Write(y)
+		return err
+	case OutputJSON:
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+		_, err = fmt.Fprintf(out, "%s\n", j)
+		return err
+	}
+
+	fmt.Fprintf(out, "\n")
+	if len(report.Objects) != 0 {
+		t := &tables.Table{}
+		t.AddColumn("KIND", func(c commands.DRDrillObjectCount) string {
+			return c.Kind
+		})
+		t.AddColumn("SOURCE", func(c commands.DRDrillObjectCount) string {
+			if c.Source == nil {
+				return "-"
+			}
+			return strconv.Itoa(*c.Source)
+		})
+		t.AddColumn("RESTORED", func(c commands.DRDrillObjectCount) string {
+			return strconv.Itoa(c.Restored)
+		})
+		t.AddColumn("OK", func(c commands.DRDrillObjectCount) string {
+			return strconv.FormatBool(c.OK)
+		})
+		if err := t.Render(report.Objects, out, "KIND", "SOURCE", "RESTORED", "OK"); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\n")
+	}
+
+	result := "passed"
+	if !report.Passed {
+		result = "failed"
+	}
+	fmt.Fprintf(out, "Disaster recovery drill of cluster %q %s in %v\n", report.ClusterName, result, report.Duration.Duration)
+	for _, backup := range report.EtcdBackups {
+		fmt.Fprintf(out, "  etcd cluster %s: backup %s\n", backup.EtcdCluster, backup.Backup)
+	}
+	if report.Error != "" {
+		fmt.Fprintf(out, "  error: %s\n", report.Error)
+	}
+	return nil
+}
@@ -39,8 +39,10 @@ import (
 	"k8s.io/kops/pkg/apis/kops/util"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/dump"
+	"k8s.io/kops/pkg/nodeexec"
 	"k8s.io/kops/pkg/resources"
 	resourceops "k8s.io/kops/pkg/resources/ops"
+	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
@@ -53,6 +55,9 @@ var (
 	toolboxDumpExample = templates.Examples(i18n.T(`
 	# Dump cluster information
 	kops toolbox dump --name k8s-cluster.example.com
+
+	# Collect node logs without SSH, using SSM Run Command, Azure Run Command or GCP OS Login
+	kops toolbox dump --name k8s-cluster.example.com --dir /tmp/dump --transport cloud
 	`))
 
 	toolboxDumpShort = i18n.T(`Dump cluster information`)
@@ -60,6 +65,13 @@ var (
 	k8sResources = os.Getenv("KOPS_TOOLBOX_DUMP_K8S_RESOURCES")
 )
 
+const (
+	// TransportSSH connects to instances over SSH, through the bastion if there is one
+	TransportSSH = "ssh"
+	// TransportCloud runs commands on instances using the cloud provider's remote command API
+	TransportCloud = "cloud"
+)
+
 type ToolboxDumpOptions struct {
 	Output string
 
@@ -68,6 +80,7 @@ type ToolboxDumpOptions struct {
 	Dir          string
 	PrivateKey   string
 	SSHUser      string
+	Transport    string
 	MaxNodes     int
 	K8sResources bool
 
@@ -79,6 +92,7 @@ func (o *ToolboxDumpOptions) InitDefaults() {
 	o.Output = OutputYaml
 	o.PrivateKey = "~/.ssh/id_rsa"
 	o.SSHUser = "ubuntu"
+	o.Transport = TransportSSH
 	o.MaxNodes = 500
 	o.K8sResources = k8sResources != ""
 	o.CloudResources = true
@@ -113,6 +127,10 @@ func NewCmdToolboxDump(f commandutils.Factory, out io.Writer) *cobra.Command {
 	cmd.Flags().StringVar(&options.PrivateKey, "private-key", options.PrivateKey, "File containing private key to use for SSH access to instances")
 	cmd.Flags().StringVar(&options.SSHUser, "ssh-user", options.SSHUser, "The remote user for SSH access to instances")
 	cmd.RegisterFlagCompletionFunc("ssh-user", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&options.Transport, "transport", options.Transport, "How to connect to instances when collecting logs.  One of ssh or cloud")
+	cmd.RegisterFlagCompletionFunc("transport", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{TransportSSH, TransportCloud}, cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd
 }
@@ -151,23 +169,8 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 	}
 
 	if options.Dir != "" {
-		privateKeyPath := options.PrivateKey
-		if strings.HasPrefix(privateKeyPath, "~/") {
-			privateKeyPath = filepath.Join(os.Getenv("HOME"), privateKeyPath[2:])
-		}
-		key, err := os.ReadFile(privateKeyPath)
-		if err != nil {
-			return fmt.Errorf("reading private key %q: %v", privateKeyPath, err)
-		}
-
-		parsedKey, err := ssh.ParseRawPrivateKey(key)
-		if err != nil {
-			return fmt.Errorf("parsing private key %q: %v", privateKeyPath, err)
-		}
-
-		signer, err := ssh.NewSignerFromKey(parsedKey)
-		if err != nil {
-			return fmt.Errorf("creating signer for private key %q: %v", privateKeyPath, err)
+		if options.Transport != TransportSSH && options.Transport != TransportCloud {
+			return fmt.Errorf("unknown transport %q, must be one of %s or %s", options.Transport, TransportSSH, TransportCloud)
 		}
 
 		contextName := cluster.ObjectMeta.Name
@@ -200,66 +203,16 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 			klog.Warningf("not limiting number of nodes dumped: %v", err)
 		}
 
-		sshConfig := &ssh.ClientConfig{
-			Config: ssh.Config{},
-			User:   options.SSHUser,
-			Auth: []ssh.AuthMethod{
-				ssh.PublicKeys(signer),
-			},
-			HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		}
-
-		klog.Infof("will SSH using username %q", sshConfig.User)
-		klog.Infof("ssh auth methods %v", sshConfig.Auth)
-
-		keyRing := agent.NewKeyring()
-		defer func(keyRing agent.Agent) {
-			_ = keyRing.RemoveAll()
-		}(keyRing)
-		err = keyRing.Add(agent.AddedKey{
-			PrivateKey: parsedKey,
-		})
-		if err != nil {
-			return fmt.Errorf("adding key to SSH agent: %w", err)
-		}
-
-		// look for a bastion instance and use it if exists
-		// Prefer a bastion load balancer if exists
-		bastionAddress := ""
-		if cloudResources != nil {
-			for _, lb := range cloudResources.LoadBalancers {
-				if strings.Contains(lb.Name, "bastion") && lb.DNSName != "" {
-					bastionAddress = lb.DNSName
-				}
+		if options.Transport == TransportCloud {
+			if err := dumpNodesWithCloudTransport(ctx, cloud, cluster, cloudResources, nodes, options); err != nil {
+				return err
 			}
-			if bastionAddress == "" {
-				for _, instance := range cloudResources.Instances {
-					if strings.Contains(instance.Name, "bastion") {
-						bastionAddress = instance.PublicAddresses[0]
-					}
-				}
-			}
-		}
-		dumper := dump.NewLogDumper(bastionAddress, sshConfig, keyRing, options.Dir)
-
-		var additionalIPs []string
-		var additionalPrivateIPs []string
-		if cloudResources != nil {
-			for _, instance := range cloudResources.Instances {
-				if len(instance.PublicAddresses) != 0 {
-					additionalIPs = append(additionalIPs, instance.PublicAddresses[0])
-				} else if len(instance.PrivateAddresses) != 0 {
-					additionalPrivateIPs = append(additionalPrivateIPs, instance.PrivateAddresses[0])
-				} else {
-					klog.Warningf("no IP for instance %q", instance.Name)
-				}
+		} else {
+			if err := dumpNodesWithSSH(ctx, cloudResources, nodes, options); err != nil {
+				return err
 			}
 		}
 
-		if err := dumper.DumpAllNodes(ctx, nodes, options.MaxNodes, additionalIPs, additionalPrivateIPs); err != nil {
-			klog.Warningf("error dumping nodes: %v", err)
-		}
-
 		if kubeConfig != nil && options.K8sResources {
 			dumper, err := dump.NewResourceDumper(kubeConfig, options.Output, options.Dir)
 			if err != nil {
@@ -310,6 +263,133 @@ func RunToolboxDump(ctx context.Context, f commandutils.Factory, out io.Writer,
 	return nil
 }
 
+// dumpNodesWithSSH collects logs from the nodes over SSH, through the bastion if there is one.
+func dumpNodesWithSSH(ctx context.Context, cloudResources *resources.Dump, nodes corev1.NodeList, options *ToolboxDumpOptions) error {
+	parsedKey, signer, err := loadSSHPrivateKey(options.PrivateKey)
+	if err != nil {
+		return err
+	}
+
+	sshConfig := &ssh.ClientConfig{
+		Config: ssh.Config{},
+		User:   options.SSHUser,
+		Auth: []ssh.AuthMethod{
+			ssh.PublicKeys(signer),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+
+	klog.Infof("will SSH using username %q", sshConfig.User)
+	klog.Infof("ssh auth methods %v", sshConfig.Auth)
+
+	keyRing := agent.NewKeyring()
+	defer func(keyRing agent.Agent) {
+		_ = keyRing.RemoveAll()
+	}(keyRing)
+	err = keyRing.Add(agent.AddedKey{
+		PrivateKey: parsedKey,
+	})
+	if err != nil {
+		return fmt.Errorf("adding key to SSH agent: %w", err)
+	}
+
+	// look for a bastion instance and use it if exists
+	// Prefer a bastion load balancer if exists
+	bastionAddress := ""
+	if cloudResources != nil {
+		for _, lb := range cloudResources.LoadBalancers {
+			if strings.Contains(lb.Name, "bastion") && lb.DNSName != "" {
+				bastionAddress = lb.DNSName
+			}
+		}
+		if bastionAddress == "" {
+			for _, instance := range cloudResources.Instances {
+				if strings.Contains(instance.Name, "bastion") {
+					bastionAddress = instance.PublicAddresses[0]
+				}
+			}
+		}
+	}
+	dumper := dump.NewLogDumper(bastionAddress, sshConfig, keyRing, options.Dir)
+
+	var additionalIPs []string
+	var additionalPrivateIPs []string
+	if cloudResources != nil {
+		for _, instance := range cloudResources.Instances {
+			if len(instance.PublicAddresses) != 0 {
+				additionalIPs = append(additionalIPs, instance.PublicAddresses[0])
+			} else if len(instance.PrivateAddresses) != 0 {
+				additionalPrivateIPs = append(additionalPrivateIPs, instance.PrivateAddresses[0])
+			} else {
+				klog.Warningf("no IP for instance %q", instance.Name)
+			}
+		}
+	}
+
+	if err := dumper.DumpAllNodes(ctx, nodes, options.MaxNodes, additionalIPs, additionalPrivateIPs); err != nil {
+		klog.Warningf("error dumping nodes: %v", err)
+	}
+	return nil
+}
+
+// dumpNodesWithCloudTransport collects logs from the nodes using the cloud provider's remote command API,
+// so that no SSH access to the instances is needed.
+func dumpNodesWithCloudTransport(ctx context.Context, cloud fi.Cloud, cluster *kops.Cluster, cloudResources *resources.Dump, nodes corev1.NodeList, options *ToolboxDumpOptions) error {
+	transport, err := buildNodeTransport(cloud, cluster, options.PrivateKey)
+	if err != nil {
+		return err
+	}
+	dumper := dump.NewLogDumperForTransport(transport, options.Dir)
+
+	var instanceIDs []string
+	if cloudResources != nil {
+		for _, instance := range cloudResources.Instances {
+			instanceIDs = append(instanceIDs, instance.Name)
+		}
+	}
+
+	if err := dumper.DumpAllNodes(ctx, nodes, options.MaxNodes, instanceIDs, nil); err != nil {
+		klog.Warningf("error dumping nodes: %v", err)
+	}
+	return nil
+}
+
+// buildNodeTransport returns the transport for running commands on the instances of the cluster.
+// The private key is only needed on GCP, where OS Login still connects over SSH.
+func buildNodeTransport(cloud fi.Cloud, cluster *kops.Cluster, privateKeyPath string) (nodeexec.Transport, error) {
+	var transportOptions nodeexec.Options
+	if cluster.GetCloudProvider() == kops.CloudProviderGCE {
+		_, signer, err := loadSSHPrivateKey(privateKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		transportOptions.Signer = signer
+	}
+	return nodeexec.NewTransport(cloud, cluster, transportOptions)
+}
+
+// loadSSHPrivateKey reads and parses the SSH private key, expanding a leading ~/ to the home directory.
+func loadSSHPrivateKey(privateKeyPath string) (interface{}, ssh.Signer, error) {
+	if strings.HasPrefix(privateKeyPath, "~/") {
+		privateKeyPath = filepath.Join(os.Getenv("HOME"), privateKeyPath[2:])
+	}
+	key, err := os.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading private key %q: %v", privateKeyPath, err)
+	}
+
+	parsedKey, err := ssh.ParseRawPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing private key %q: %v", privateKeyPath, err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(parsedKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating signer for private key %q: %v", privateKeyPath, err)
+	}
+	return parsedKey, signer, nil
+}
+
 func truncateNodeList(nodes *corev1.NodeList, max int) error {
 	if max < 0 {
 		return errors.New("--max-nodes must be greater than zero")
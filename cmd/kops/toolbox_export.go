@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/clusterapi"
+	"k8s.io/kops/pkg/commands/commandutils"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+)
+
+var (
+	toolboxExportShort = i18n.T(`Export a cluster to other formats`)
+
+	toolboxExportCAPILong = templates.LongDesc(i18n.T(`
+	Converts the cluster and its instance groups to Cluster API manifests, as a migration path to Cluster API.
+
+	The manifests use the infrastructure provider for the cluster's cloud (CAPA for AWS, CAPG for GCE
+	and CAPZ for Azure), with the kubeadm bootstrap and control plane providers.
+	Networking, instance groups, node labels, taints and machine settings are mapped where Cluster API
+	has an equivalent. Settings that cannot be mapped, such as addons, etcd configuration and hooks,
+	are listed as comments at the top of the output and must be migrated manually.`))
+
+	toolboxExportCAPIExample = templates.Examples(i18n.T(`
+	# Export a cluster to Cluster API manifests
+	kops toolbox export capi --name k8s-cluster.example.com > capi.yaml
+
+	# Export a cluster into a specific namespace
+	kops toolbox export capi --name k8s-cluster.example.com --namespace clusters --output capi.yaml
+	`))
+
+	toolboxExportCAPIShort = i18n.T(`Export a cluster to Cluster API manifests`)
+)
+
+func NewCmdToolboxExport(f commandutils.Factory, out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: toolboxExportShort,
+	}
+
+	cmd.AddCommand(NewCmdToolboxExportCAPI(f, out))
+
+	return cmd
+}
+
+type ToolboxExportCAPIOptions struct {
+	ClusterName string
+
+	// Namespace is the namespace of the generated Cluster API objects
+	Namespace string
+
+	// Output is the file to write the manifests to; if empty they are written to stdout
+	Output string
+}
+
+func (o *ToolboxExportCAPIOptions) InitDefaults() {
+	o.Namespace = "default"
+}
+
+func NewCmdToolboxExportCAPI(f commandutils.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxExportCAPIOptions{}
+	options.InitDefaults()
+
+	cmd := &cobra.Command{
+		Use:               "capi [CLUSTER]",
+		Short:             toolboxExportCAPIShort,
+		Long:              toolboxExportCAPILong,
+		Example:           toolboxExportCAPIExample,
+		Args:              rootCommand.clusterNameArgs(&options.ClusterName),
+		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxExportCAPI(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Namespace, "namespace", options.Namespace, "Namespace of the generated Cluster API objects")
+	cmd.RegisterFlagCompletionFunc("namespace", cobra.NoFileCompletions)
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "File to write the manifests to; defaults to stdout")
+
+	return cmd
+}
+
+func RunToolboxExportCAPI(ctx context.Context, f commandutils.Factory, out io.Writer, options *ToolboxExportCAPIOptions) error {
+	clientset, err := f.KopsClient()
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clientset.GetCluster(ctx, options.ClusterName)
+	if err != nil {
+		return err
+	}
+	if cluster == nil {
+		return fmt.Errorf("cluster not found %q", options.ClusterName)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var instanceGroups []*kops.InstanceGroup
+	for i := range list.Items {
+		instanceGroups = append(instanceGroups, &list.Items[i])
+	}
+
+	export, err := clusterapi.ExportCluster(cluster, instanceGroups, options.Namespace)
+	if err != nil {
+		return err
+	}
+	b, err := export.ToYAML()
+	if err != nil {
+		return err
+	}
+
+	if options.Output != "" {
+		if err := os.WriteFile(options.Output, b, 0o644); err != nil {
+			return fmt.Errorf("error writing manifests to %q: %w", options.Output, err)
+		}
+		fmt.Fprintf(out, "Cluster API manifests written to %s\n", options.Output)
+		if len(export.Unmapped) != 0 {
+			fmt.Fprintf(out, "%d kOps settings could not be mapped; they are listed at the top of the file\n", len(export.Unmapped))
+		}
+		return nil
+	}
+
+	_, err = out.Write(b)
+	return err
+}
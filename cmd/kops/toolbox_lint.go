@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/util/pkg/tables"
+	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kops/util/pkg/vfs"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	toolboxLintLong = templates.LongDesc(i18n.T(`
+	Check cluster and instance group manifests, without reading the state store or the cloud.
+
+	Errors are the settings kops create and kops replace would reject, such as settings which are invalid
+	for the Kubernetes version of the cluster or which conflict with each other. Warnings are the settings
+	which are accepted, but are deprecated, have no effect, or are likely mistakes; most come with a suggested replacement.
+
+	The command fails if it finds any errors, or any warnings with --fail-on-warnings, so it can be used
+	as a pre-commit hook in repositories holding cluster manifests.`))
+
+	toolboxLintExample = templates.Examples(i18n.T(`
+	# Check a cluster manifest
+	kops toolbox lint -f cluster.yaml
+
+	# Check the manifests rendered from templates, failing on warnings too
+	kops toolbox template --values values.yaml --template templates | kops toolbox lint -f - --fail-on-warnings
+	`))
+
+	toolboxLintShort = i18n.T(`Check cluster manifests for errors and deprecated settings`)
+)
+
+type ToolboxLintOptions struct {
+	// Filenames are the files holding the manifests, or - for stdin
+	Filenames []string
+	// FailOnWarnings fails the command on warnings as well as errors
+	FailOnWarnings bool
+	Output         string
+}
+
+const (
+	lintSeverityError   = "error"
+	lintSeverityWarning = "warning"
+)
+
+// lintFinding is a problem found in a manifest.
+type lintFinding struct {
+	Severity   string `json:"severity"`
+	Source     string `json:"source"`
+	Object     string `json:"object"`
+	Field      string `json:"field,omitempty"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+func NewCmdToolboxLint(f *util.Factory, out io.Writer) *cobra.Command {
+	options := &ToolboxLintOptions{
+		Output: OutputTable,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "lint {-f FILENAME}...",
+		Short:   toolboxLintShort,
+		Long:    toolboxLintLong,
+		Example: toolboxLintExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunToolboxLint(cmd.Context(), f, out, options)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Files holding the manifests to check, or - for stdin")
+	cmd.MarkFlagRequired("filename")
+	cmd.RegisterFlagCompletionFunc("filename", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"yaml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+	})
+	cmd.Flags().BoolVar(&options.FailOnWarnings, "fail-on-warnings", options.FailOnWarnings, "Fail if any warnings are found, as well as errors")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of table, yaml or json")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputTable, OutputJSON, OutputYaml}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func RunToolboxLint(ctx context.Context, f *util.Factory, out io.Writer, options *ToolboxLintOptions) error {
+	vfsContext := f.VFSContext()
+
+	type instanceGroupSource struct {
+		source string
+		ig     *kopsapi.InstanceGroup
+	}
+	clusters := make(map[string]*kopsapi.Cluster)
+	var clusterNames []string
+	clusterSources := make(map[string]string)
+	var instanceGroups []instanceGroupSource
+
+	for _, filename := range options.Filenames {
+		var contents []byte
+		var err error
+		if filename == "-" {
+			contents, err = ConsumeStdin()
+			if err != nil {
+				return err
+			}
+		} else {
+			contents, err = vfsContext.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("error reading file %q: %v", filename, err)
+			}
+		}
+
+		for _, section := range text.SplitContentToSections(contents) {
+			o, _, err := kopscodecs.Decode(section, nil)
+			if err != nil {
+				return fmt.Errorf("error parsing file %q: %v", filename, err)
+			}
+			switch v := o.(type) {
+			case *kopsapi.Cluster:
+				if _, found := clusters[v.Name]; !found {
+					clusterNames = append(clusterNames, v.Name)
+				}
+				clusters[v.Name] = v
+				clusterSources[v.Name] = filename
+			case *kopsapi.InstanceGroup:
+				instanceGroups = append(instanceGroups, instanceGroupSource{source: filename, ig: v})
+			}
+		}
+	}
+
+	var findings []*lintFinding
+	for _, name := range clusterNames {
+		findings = append(findings, lintCluster(clusterSources[name], clusters[name], vfsContext)...)
+	}
+	for _, i := range instanceGroups {
+		findings = append(findings, lintInstanceGroup(i.source, i.ig, clusters[i.ig.ObjectMeta.Labels[kopsapi.LabelClusterName]])...)
+	}
+
+	if err := printLintFindings(out, findings, options.Output); err != nil {
+		return err
+	}
+
+	errors, warnings := 0, 0
+	for _, finding := range findings {
+		if finding.Severity == lintSeverityError {
+			errors++
+		} else {
+			warnings++
+		}
+	}
+	if errors != 0 || (warnings != 0 && options.FailOnWarnings) {
+		return fmt.Errorf("found %d errors and %d warnings", errors, warnings)
+	}
+	return nil
+}
+
+// lintCluster returns the validation errors and the warnings of a cluster.
+func lintCluster(source string, cluster *kopsapi.Cluster, vfsContext *vfs.VFSContext) []*lintFinding {
+	object := "Cluster/" + cluster.Name
+
+	var findings []*lintFinding
+	for _, err := range validation.ValidateCluster(cluster, false, vfsContext) {
+		findings = append(findings, &lintFinding{Severity: lintSeverityError, Source: source, Object: object, Field: err.Field, Message: err.ErrorBody()})
+	}
+	for _, w := range validation.LintCluster(cluster) {
+		findings = append(findings, &lintFinding{Severity: lintSeverityWarning, Source: source, Object: object, Field: w.Field, Message: w.Message, Suggestion: w.Suggestion})
+	}
+	return findings
+}
+
+// lintInstanceGroup returns the validation errors and the warnings of an instance group.
+// The instance group is checked against its cluster if the cluster is among the manifests.
+func lintInstanceGroup(source string, ig *kopsapi.InstanceGroup, cluster *kopsapi.Cluster) []*lintFinding {
+	object := "InstanceGroup/" + ig.Name
+
+	var findings []*lintFinding
+	var errs []*lintFinding
+	if cluster != nil {
+		for _, err := range validation.CrossValidateInstanceGroup(ig, cluster, nil, false) {
+			errs = append(errs, &lintFinding{Severity: lintSeverityError, Source: source, Object: object, Field: err.Field, Message: err.ErrorBody()})
+		}
+	} else {
+		for _, err := range validation.ValidateInstanceGroup(ig, nil, false) {
+			errs = append(errs, &lintFinding{Severity: lintSeverityError, Source: source, Object: object, Field: err.Field, Message: err.ErrorBody()})
+		}
+		findings = append(findings, &lintFinding{
+			Severity:   lintSeverityWarning,
+			Source:     source,
+			Object:     object,
+			Field:      "metadata.labels[" + kopsapi.LabelClusterName + "]",
+			Message:    "the cluster of the instance group is not among the manifests, so the instance group is not checked against it",
+			Suggestion: "pass the cluster manifest with -f",
+		})
+		cluster = &kopsapi.Cluster{}
+	}
+	findings = append(errs, findings...)
+
+	for _, w := range validation.LintInstanceGroup(ig, cluster) {
+		findings = append(findings, &lintFinding{Severity: lintSeverityWarning, Source: source, Object: object, Field: w.Field, Message: w.Message, Suggestion: w.Suggestion})
+	}
+	return findings
+}
+
+// printClusterWarnings prints the warnings about the settings of a cluster which is being written.
+func printClusterWarnings(out io.Writer, cluster *kopsapi.Cluster) {
+	printLintWarnings(out, "Cluster/"+cluster.Name, validation.LintCluster(cluster))
+}
+
+// printInstanceGroupWarnings prints the warnings about the settings of an instance group which is being written.
+func printInstanceGroupWarnings(out io.Writer, ig *kopsapi.InstanceGroup, cluster *kopsapi.Cluster) {
+	printLintWarnings(out, "InstanceGroup/"+ig.Name, validation.LintInstanceGroup(ig, cluster))
+}
+
+func printLintWarnings(out io.Writer, object string, warnings []*validation.Warning) {
+	for _, w := range warnings {
+		fmt.Fprintf(out, "Warning: %s %s: %s", object, w.Field, w.Message)
+		if w.Suggestion != "" {
+			fmt.Fprintf(out, "; %s", w.Suggestion)
+		}
+		fmt.Fprintln(out)
+	}
+}
+
+func printLintFindings(out io.Writer, findings []*lintFinding, output string) error {
+	switch output {
+	case OutputTable:
+		if len(findings) == 0 {
+			fmt.Fprintf(out, "No problems found\n")
+			return nil
+		}
+		t := &tables.Table{}
+		t.AddColumn("SEVERITY", func(f *lintFinding) string {
+			return f.Severity
+		})
+		t.AddColumn("SOURCE", func(f *lintFinding) string {
+			return f.Source
+		})
+		t.AddColumn("OBJECT", func(f *lintFinding) string {
+			return f.Object
+		})
+		t.AddColumn("FIELD", func(f *lintFinding) string {
+			return f.Field
+		})
+		t.AddColumn("MESSAGE", func(f *lintFinding) string {
+			return f.Message
+		})
+		t.AddColumn("SUGGESTION", func(f *lintFinding) string {
+			return f.Suggestion
+		})
+		return t.Render(findings, out, "SEVERITY", "OBJECT", "FIELD", "MESSAGE", "SUGGESTION")
+
+	case OutputYaml:
+		y, err := yaml.Marshal(findings)
+		if err != nil {
+			return fmt.Errorf("unable to marshal YAML: %v", err)
+		}
+		if _, err := out.Write(y); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+	case OutputJSON:
+		j, err := json.Marshal(findings)
+		if err != nil {
+			return fmt.Errorf("unable to marshal JSON: %v", err)
+		}
+		if _, err := out.Write(j); err != nil {
+			return fmt.Errorf("error writing to output: %v", err)
+		}
+
+	default:
+		return fmt.Errorf("unknown output format: %q", output)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kops/cmd/kops/util"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/testutils"
+)
+
+func TestToolboxLint(t *testing.T) {
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+	cluster.Spec.KubeAPIServer = &kopsapi.KubeAPIServerConfig{Address: "0.0.0.0"}
+	nodes := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	nodes.ObjectMeta.Labels = map[string]string{kopsapi.LabelClusterName: cluster.Name}
+
+	var manifests []string
+	for _, o := range []runtime.Object{cluster, &nodes} {
+		y, err := kopscodecs.ToVersionedYaml(o)
+		if err != nil {
+			t.Fatalf("error serializing manifest: %v", err)
+		}
+		manifests = append(manifests, string(y))
+	}
+	filename := filepath.Join(t.TempDir(), "cluster.yaml")
+	if err := os.WriteFile(filename, []byte(strings.Join(manifests, "\n---\n")), 0o644); err != nil {
+		t.Fatalf("error writing manifest: %v", err)
+	}
+
+	factory := util.NewFactory(&util.FactoryOptions{RegistryPath: "memfs://tests"})
+
+	var stdout bytes.Buffer
+	options := &ToolboxLintOptions{Filenames: []string{filename}, Output: OutputTable}
+	if err := RunToolboxLint(context.Background(), factory, &stdout, options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "spec.kubeAPIServer.address") {
+		t.Errorf("expected a warning for spec.kubeAPIServer.address, got:\n%s", stdout.String())
+	}
+
+	options.FailOnWarnings = true
+	err := RunToolboxLint(context.Background(), factory, &bytes.Buffer{}, options)
+	if err == nil || err.Error() != "found 0 errors and 1 warnings" {
+		t.Errorf("unexpected error with --fail-on-warnings: %v", err)
+	}
+}
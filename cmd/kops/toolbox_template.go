@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -34,6 +35,7 @@ import (
 	helmvalues "helm.sh/helm/v3/pkg/cli/values"
 
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/diff"
 	"k8s.io/kops/pkg/try"
 	"k8s.io/kops/pkg/util/templater"
 	"k8s.io/kops/upup/pkg/fi/utils"
@@ -52,6 +54,14 @@ var (
 		--snippets file_or_directory --snippets=another.dir \
 		--template file_or_directory --template=directory  \
 		--output cluster.yaml
+
+	# Render a fleet of clusters listed under "clusters" in the values, validating the values of each
+	kops toolbox template --values fleet.yaml --values-schema schema.yaml \
+		--for-each clusters --template templates --out clusters.yaml
+
+	# Check the rendered fleet against the snapshots committed to the repository
+	kops toolbox template --values fleet.yaml --for-each clusters --template templates \
+		--snapshot-dir snapshots
 	`))
 
 	toolboxTemplatingShort = i18n.T(`Generate cluster.yaml from template`)
@@ -69,6 +79,15 @@ type ToolboxTemplateOptions struct {
 	values        []string
 	stringValues  []string
 	channel       string
+
+	// valuesSchema is the path to a schema the values must match
+	valuesSchema string
+	// forEach is the key of a list of values; the templates are rendered once for each element
+	forEach string
+	// snapshotDir is a directory of expected output to compare the rendered templates against
+	snapshotDir string
+	// updateSnapshots overwrites the snapshots with the rendered templates
+	updateSnapshots bool
 }
 
 // NewCmdToolboxTemplate returns a new templating command.
@@ -109,6 +128,15 @@ func NewCmdToolboxTemplate(f commandutils.Factory, out io.Writer) *cobra.Command
 	cmd.RegisterFlagCompletionFunc("config-value", cobra.NoFileCompletions)
 	cmd.Flags().BoolVar(&options.failOnMissing, "fail-on-missing", true, "Fail on referencing unset variables in templates")
 	cmd.Flags().BoolVar(&options.formatYAML, "format-yaml", false, "Attempt to format the generated yaml content before output")
+	cmd.Flags().StringVar(&options.valuesSchema, "values-schema", options.valuesSchema, "Path to a schema the values must match; unknown and missing required values are rejected")
+	cmd.RegisterFlagCompletionFunc("values-schema", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"yaml", "json"}, cobra.ShellCompDirectiveFilterFileExt
+	})
+	cmd.Flags().StringVar(&options.forEach, "for-each", options.forEach, "Render the templates once for each element of the list under this values key, merging the element over the other values")
+	cmd.RegisterFlagCompletionFunc("for-each", cobra.NoFileCompletions)
+	cmd.Flags().StringVar(&options.snapshotDir, "snapshot-dir", options.snapshotDir, "Compare the rendered output of each cluster against the snapshots in this directory instead of writing it")
+	cmd.MarkFlagDirname("snapshot-dir")
+	cmd.Flags().BoolVar(&options.updateSnapshots, "update-snapshots", false, "Overwrite the snapshots in --snapshot-dir with the rendered output")
 
 	return cmd
 }
@@ -121,16 +149,11 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 		return err
 	}
 
-	// @step: set clusterName from template's values or cli flag
-	value, ok := context["clusterName"].(string)
-	if ok {
-		options.ClusterName = value
-	} else {
-		context["clusterName"] = options.ClusterName
-	}
-
 	// @check if we are just rendering the config value
 	if options.configValue != "" {
+		if _, found := context["clusterName"]; !found {
+			context["clusterName"] = options.ClusterName
+		}
 		v, found := context[options.configValue]
 		switch found {
 		case true:
@@ -141,29 +164,80 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 		return nil
 	}
 
-	// @step: expand the list of templates into a list of files to render
+	var schema *templater.ValuesSchema
+	if options.valuesSchema != "" {
+		content, err := os.ReadFile(utils.ExpandPath(options.valuesSchema))
+		if err != nil {
+			return fmt.Errorf("unable to read values schema: %s, error: %w", options.valuesSchema, err)
+		}
+		if schema, err = templater.ParseValuesSchema(content); err != nil {
+			return fmt.Errorf("invalid values schema: %s, error: %w", options.valuesSchema, err)
+		}
+	}
+
+	// @step: build a context for each cluster, validating the values of each one
+	contexts, err := expandTemplateContexts(context, options.forEach)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for i, x := range contexts {
+		name := "cluster"
+		if options.forEach != "" {
+			name = fmt.Sprintf("%s-%d", options.forEach, i)
+		}
+		if schema != nil {
+			if err := schema.Validate(x); err != nil {
+				if options.forEach == "" {
+					return err
+				}
+				return fmt.Errorf("%s[%d]: %w", options.forEach, i, err)
+			}
+		}
+
+		// @step: set clusterName from template's values or cli flag
+		if value, ok := x["clusterName"].(string); ok {
+			name = value
+		} else {
+			x["clusterName"] = options.ClusterName
+			if options.ClusterName != "" && options.forEach == "" {
+				name = options.ClusterName
+			}
+		}
+		names = append(names, name)
+	}
+
+	// @step: expand the list of templates into a list of files to render; partials are only used as snippets
 	var templates []string
+	snippets := make(map[string]string)
 	for _, x := range options.templatePath {
-		list, err := expandFiles(utils.ExpandPath(x))
+		root := utils.ExpandPath(x)
+		list, err := expandFiles(root)
 		if err != nil {
 			return fmt.Errorf("unable to expand the template: %s, error: %s", x, err)
 		}
-		templates = append(templates, list...)
+		for _, j := range list {
+			if !strings.HasPrefix(path.Base(j), "_") {
+				templates = append(templates, j)
+				continue
+			}
+			if err := loadSnippet(snippets, root, j); err != nil {
+				return err
+			}
+		}
 	}
 
-	snippets := make(map[string]string)
 	for _, x := range options.snippetsPath {
-		list, err := expandFiles(utils.ExpandPath(x))
+		root := utils.ExpandPath(x)
+		list, err := expandFiles(root)
 		if err != nil {
 			return fmt.Errorf("unable to expand the snippets: %s, error: %s", x, err)
 		}
 
 		for _, j := range list {
-			content, err := os.ReadFile(j)
-			if err != nil {
-				return fmt.Errorf("unable to read snippet: %s, error: %s", j, err)
+			if err := loadSnippet(snippets, root, j); err != nil {
+				return err
 			}
-			snippets[path.Base(j)] = string(content)
 		}
 	}
 
@@ -172,18 +246,62 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 		return fmt.Errorf("error loading channel %q: %v", options.channel, err)
 	}
 
-	// @step: render each of the templates, splitting on the documents
+	// @step: render the templates for each of the clusters
 	r := templater.NewTemplater(channel)
+	var outputs []string
+	for i, x := range contexts {
+		content, err := renderTemplates(r, templates, snippets, x, options)
+		if err != nil {
+			if options.forEach == "" {
+				return err
+			}
+			return fmt.Errorf("%s: %w", names[i], err)
+		}
+		outputs = append(outputs, content)
+	}
+
+	if options.snapshotDir != "" {
+		return compareSnapshots(out, utils.ExpandPath(options.snapshotDir), names, outputs, options.updateSnapshots)
+	}
+
+	// join the output of each cluster into a single stream of documents
+	for i := range outputs {
+		if i < len(outputs)-1 && outputs[i] != "" && !strings.HasSuffix(outputs[i], "\n") {
+			outputs[i] += "\n"
+		}
+	}
+	content := strings.Join(outputs, "---\n")
+
+	iowriter := out
+	// @check if we are writing to a file rather than stdout
+	if options.outputPath != "" {
+		w, err := os.OpenFile(utils.ExpandPath(options.outputPath), os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0o660)
+		if err != nil {
+			return fmt.Errorf("unable to open file: %s, error: %v", options.outputPath, err)
+		}
+		defer try.CloseFile(w)
+		iowriter = w
+	}
+
+	if _, err := iowriter.Write([]byte(content)); err != nil {
+		return fmt.Errorf("unable to write template: %s", err)
+	}
+
+	return nil
+}
+
+// renderTemplates renders each of the templates with the context, splitting on the documents
+func renderTemplates(r *templater.Templater, templates []string, snippets map[string]string, context map[string]interface{}, options *ToolboxTemplateOptions) (string, error) {
 	var documents []string
 	for _, x := range templates {
 		content, err := os.ReadFile(x)
 		if err != nil {
-			return fmt.Errorf("unable to read template: %s, error: %s", x, err)
+			return "", fmt.Errorf("unable to read template: %s, error: %s", x, err)
 		}
 
 		rendered, err := r.Render(string(content), context, snippets, options.failOnMissing)
 		if err != nil {
-			return fmt.Errorf("unable to render template: %s, error: %s", x, err)
+			return "", fmt.Errorf("unable to render template: %s, error: %s", x, err)
 		}
 		// @check if the content is zero ignore it
 		if len(rendered) <= 0 {
@@ -198,34 +316,126 @@ func RunToolBoxTemplate(f commandutils.Factory, out io.Writer, options *ToolboxT
 		for _, x := range strings.Split(rendered, "---\n") {
 			var data map[string]interface{}
 			if err := yaml.Unmarshal([]byte(x), &data); err != nil {
-				return fmt.Errorf("unable to unmarshall content from template: %s, error: %s", x, err)
+				return "", fmt.Errorf("unable to unmarshall content from template: %s, error: %s", x, err)
 			}
 			if len(data) <= 0 {
 				continue
 			}
 			formatted, err := yaml.Marshal(&data)
 			if err != nil {
-				return fmt.Errorf("unable to marhshal formatted content to yaml: %s", err)
+				return "", fmt.Errorf("unable to marhshal formatted content to yaml: %s", err)
 			}
 			documents = append(documents, string(formatted))
 		}
 	}
 	// join in harmony all the YAML documents back together
-	content := strings.Join(documents, "---\n")
+	return strings.Join(documents, "---\n"), nil
+}
 
-	iowriter := out
-	// @check if we are writing to a file rather than stdout
-	if options.outputPath != "" {
-		w, err := os.OpenFile(utils.ExpandPath(options.outputPath), os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0o660)
+// loadSnippet reads a snippet, making it available by both its basename and its path relative to the root
+func loadSnippet(snippets map[string]string, root, filename string) error {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("unable to read snippet: %s, error: %s", filename, err)
+	}
+	snippets[path.Base(filename)] = string(content)
+	if rel, err := filepath.Rel(root, filename); err == nil && rel != "." {
+		snippets[filepath.ToSlash(rel)] = string(content)
+	}
+
+	return nil
+}
+
+// expandTemplateContexts returns a context for each element of the list under the forEach key,
+// each being the element merged over the rest of the values; without a key the values are used as is
+func expandTemplateContexts(values map[string]interface{}, forEach string) ([]map[string]interface{}, error) {
+	if forEach == "" {
+		return []map[string]interface{}{values}, nil
+	}
+
+	list, ok := values[forEach].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("value %q used by --for-each must be a list, got %T", forEach, values[forEach])
+	}
+	base := make(map[string]interface{})
+	for k, v := range values {
+		if k != forEach {
+			base[k] = v
+		}
+	}
+
+	var contexts []map[string]interface{}
+	for i, x := range list {
+		item, ok := x.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("element %d of %q must be an object, got %T", i, forEach, x)
+		}
+		contexts = append(contexts, mergeValues(base, item))
+	}
+
+	return contexts, nil
+}
+
+// mergeValues deep merges the overlay over a copy of the base values
+func mergeValues(base, overlay map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for k, v := range base {
+		if m, ok := v.(map[string]interface{}); ok {
+			v = mergeValues(m, nil)
+		}
+		merged[k] = v
+	}
+	for k, v := range overlay {
+		if m, ok := v.(map[string]interface{}); ok {
+			existing, _ := merged[k].(map[string]interface{})
+			v = mergeValues(existing, m)
+		}
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// compareSnapshots checks the rendered output of each cluster against the snapshot in the directory,
+// or updates the snapshots when requested
+func compareSnapshots(out io.Writer, dir string, names []string, outputs []string, update bool) error {
+	if update {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("unable to create snapshot directory: %s, error: %w", dir, err)
+		}
+	}
+
+	var mismatched []string
+	for i, name := range names {
+		filename := filepath.Join(dir, name+".yaml")
+		if update {
+			if err := os.WriteFile(filename, []byte(outputs[i]), 0o644); err != nil {
+				return fmt.Errorf("unable to write snapshot: %s, error: %w", filename, err)
+			}
+			fmt.Fprintf(out, "Updated snapshot %s\n", filename)
+			continue
+		}
+
+		expected, err := os.ReadFile(filename)
 		if err != nil {
-			return fmt.Errorf("unable to open file: %s, error: %v", options.outputPath, err)
+			if errors.Is(err, os.ErrNotExist) {
+				fmt.Fprintf(out, "Snapshot %s does not exist\n", filename)
+				mismatched = append(mismatched, name)
+				continue
+			}
+			return fmt.Errorf("unable to read snapshot: %s, error: %w", filename, err)
+		}
+		if string(expected) != outputs[i] {
+			fmt.Fprintf(out, "Snapshot %s differs from the rendered output:\n%s\n", filename, diff.FormatDiff(string(expected), outputs[i]))
+			mismatched = append(mismatched, name)
 		}
-		defer try.CloseFile(w)
-		iowriter = w
 	}
 
-	if _, err := iowriter.Write([]byte(content)); err != nil {
-		return fmt.Errorf("unable to write template: %s", err)
+	if len(mismatched) != 0 {
+		return fmt.Errorf("rendered output does not match the snapshots for %s; run with --update-snapshots to accept the changes", strings.Join(mismatched, ", "))
+	}
+	if !update {
+		fmt.Fprintf(out, "Rendered output matches the snapshots of %d cluster(s)\n", len(names))
 	}
 
 	return nil
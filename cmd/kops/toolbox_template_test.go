@@ -17,6 +17,11 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -26,3 +31,68 @@ func TestNewTemplateContext(t *testing.T) {
 		t.Errorf("Got %v, expected baz", context["foo"])
 	}
 }
+
+func TestExpandTemplateContexts(t *testing.T) {
+	values := map[string]interface{}{
+		"dnsZone": "example.com",
+		"defaults": map[string]interface{}{
+			"machineType": "m5.large",
+			"minSize":     1,
+		},
+		"clusters": []interface{}{
+			map[string]interface{}{"clusterName": "dev"},
+			map[string]interface{}{"clusterName": "prod", "defaults": map[string]interface{}{"minSize": 3}},
+		},
+	}
+
+	contexts, err := expandTemplateContexts(values, "clusters")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []map[string]interface{}{
+		{
+			"clusterName": "dev",
+			"dnsZone":     "example.com",
+			"defaults":    map[string]interface{}{"machineType": "m5.large", "minSize": 1},
+		},
+		{
+			"clusterName": "prod",
+			"dnsZone":     "example.com",
+			"defaults":    map[string]interface{}{"machineType": "m5.large", "minSize": 3},
+		},
+	}
+	if !reflect.DeepEqual(contexts, expected) {
+		t.Errorf("unexpected contexts: got %v, expected %v", contexts, expected)
+	}
+	if values["defaults"].(map[string]interface{})["minSize"] != 1 {
+		t.Errorf("expected the values not to be modified by the merge")
+	}
+
+	if _, err := expandTemplateContexts(values, "dnsZone"); err == nil {
+		t.Errorf("expected an error expanding a value which is not a list")
+	}
+}
+
+func TestCompareSnapshots(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	names := []string{"dev", "prod"}
+	outputs := []string{"name: dev\n", "name: prod\n"}
+
+	var out bytes.Buffer
+	if err := compareSnapshots(&out, dir, names, outputs, true); err != nil {
+		t.Fatalf("unexpected error updating snapshots: %v", err)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "prod.yaml"))
+	if err != nil || string(b) != "name: prod\n" {
+		t.Fatalf("expected the snapshot to be written, got %q: %v", b, err)
+	}
+
+	if err := compareSnapshots(&out, dir, names, outputs, false); err != nil {
+		t.Errorf("unexpected error comparing matching snapshots: %v", err)
+	}
+
+	err = compareSnapshots(&out, dir, append(names, "test"), []string{"name: dev\n", "name: production\n", "name: test\n"}, false)
+	if err == nil || !strings.Contains(err.Error(), "prod, test") {
+		t.Errorf("expected the changed and missing snapshots to be reported, got %v", err)
+	}
+}
@@ -19,10 +19,12 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -37,12 +39,14 @@ import (
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/commands/commandutils"
 	"k8s.io/kops/pkg/kubeconfig"
+	"k8s.io/kops/pkg/notifications"
 	"k8s.io/kops/pkg/predicates"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 	"k8s.io/kops/upup/pkg/fi/utils"
 	"k8s.io/kubectl/pkg/util/i18n"
 	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
 )
 
 var (
@@ -57,6 +61,12 @@ var (
 	updateClusterExample = templates.Examples(i18n.T(`
 	# After the cluster has been edited or upgraded, update the cloud resources with:
 	kops update cluster k8s-cluster.example.com --state=s3://my-state-store --yes
+
+	# List the cloud resources which have been modified outside of kOps
+	kops update cluster k8s-cluster.example.com --drift-only
+
+	# Apply the changes, and record what was changed as JSON
+	kops update cluster k8s-cluster.example.com --yes -o json > update-result.json
 	`))
 
 	updateClusterShort = i18n.T("Update a cluster.")
@@ -68,6 +78,9 @@ type UpdateClusterOptions struct {
 	// Reconcile is true if we should reconcile the cluster by rolling the control plane and nodes sequentially
 	Reconcile bool
 
+	// Output is the format of the result document printed in place of the human-readable output; one of json or yaml
+	Output string
+
 	kubeconfig.CreateKubecfgOptions
 	CoreUpdateClusterOptions
 }
@@ -85,6 +98,10 @@ type CoreUpdateClusterOptions struct {
 	SSHPublicKey       string
 	RunTasksOptions    fi.RunTasksOptions
 	AllowKopsDowngrade bool
+	// SkipQuotaCheck skips checking that the quotas of the cloud account have room for the cluster
+	SkipQuotaCheck bool
+	// ShowProgress is true if we should print a live display of task progress while applying changes
+	ShowProgress bool
 	// Bypasses kubelet vs control plane version skew checks,
 	// which by default prevent non-control plane instancegroups
 	// from being updated to a version greater than the control plane
@@ -113,6 +130,12 @@ type CoreUpdateClusterOptions struct {
 	// The goal is that the cluster can keep running even during more disruptive
 	// infrastructure changes.
 	Prune bool
+
+	// DriftOnly reports the existing cloud resources whose live attributes differ from the model, without changing anything
+	DriftOnly bool
+
+	// DryRunOutput is where the report of a dry-run is written, defaults to stdout
+	DryRunOutput io.Writer
 }
 
 func (o *UpdateClusterOptions) InitDefaults() {
@@ -160,7 +183,7 @@ func NewCmdUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	}
 
 	cmd.Flags().BoolVarP(&options.Yes, "yes", "y", options.Yes, "Create cloud resources, without --yes update is in dry run mode")
-	cmd.Flags().Var(&options.Target, "target", fmt.Sprintf("Target - %q, %q", cloudup.TargetDirect, cloudup.TargetTerraform))
+	cmd.Flags().Var(&options.Target, "target", fmt.Sprintf("Target - %q, %q, %q, %q", cloudup.TargetDirect, cloudup.TargetTerraform, cloudup.TargetPulumi, cloudup.TargetCrossplane))
 	cmd.RegisterFlagCompletionFunc("target", completeUpdateClusterTarget(f, &options.CoreUpdateClusterOptions))
 	cmd.Flags().StringVar(&options.SSHPublicKey, "ssh-public-key", options.SSHPublicKey, "SSH public key to use (deprecated: use kops create secret instead)")
 	cmd.Flags().StringVar(&options.OutDir, "out", options.OutDir, "Path to write any local output")
@@ -172,6 +195,7 @@ func NewCmdUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	cmd.RegisterFlagCompletionFunc("user", completeKubecfgUser)
 	cmd.Flags().BoolVar(&options.Internal, "internal", options.Internal, "Use the cluster's internal DNS name. Implies --create-kube-config")
 	cmd.Flags().BoolVar(&options.AllowKopsDowngrade, "allow-kops-downgrade", options.AllowKopsDowngrade, "Allow an older version of kOps to update the cluster than last used")
+	cmd.Flags().BoolVar(&options.SkipQuotaCheck, "skip-quota-check", options.SkipQuotaCheck, "Skip checking that the quotas of the cloud account have room for the cluster before creating its resources")
 	cmd.Flags().StringSliceVar(&options.InstanceGroups, "instance-group", options.InstanceGroups, "Instance groups to update (defaults to all if not specified)")
 	cmd.RegisterFlagCompletionFunc("instance-group", completeInstanceGroup(f, &options.InstanceGroups, &options.InstanceGroupRoles))
 	cmd.Flags().StringSliceVar(&options.InstanceGroupRoles, "instance-group-roles", options.InstanceGroupRoles, "Instance group roles to update ("+strings.Join(allRoles, ",")+")")
@@ -187,7 +211,14 @@ func NewCmdUpdateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 	viper.BindEnv("lifecycle-overrides", "KOPS_LIFECYCLE_OVERRIDES")
 	cmd.RegisterFlagCompletionFunc("lifecycle-overrides", completeLifecycleOverrides)
 
+	cmd.Flags().IntVar(&options.RunTasksOptions.MaxConcurrency, "max-concurrency", options.RunTasksOptions.MaxConcurrency, "Maximum number of tasks to run in parallel (0 for no limit)")
+	cmd.Flags().BoolVar(&options.ShowProgress, "progress", options.ShowProgress, "Show a live display of task progress while applying changes")
 	cmd.Flags().BoolVar(&options.Prune, "prune", options.Prune, "Delete old revisions of cloud resources that were needed during an upgrade")
+	cmd.Flags().BoolVar(&options.DriftOnly, "drift-only", options.DriftOnly, "Only list the existing cloud resources whose live attributes differ from the cluster configuration, without changing anything")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", options.Output, "Print a result document of the tasks run and the resources changed, instead of the human-readable output. One of json or yaml. Requires --yes")
+	cmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{OutputJSON, OutputYaml}, cobra.ShellCompDirectiveNoFileComp
+	})
 	cmd.Flags().BoolVar(&options.IgnoreKubeletVersionSkew, "ignore-kubelet-version-skew", options.IgnoreKubeletVersionSkew, "Setting this to true will force updating the kubernetes version on all instance groups, regardles of which control plane version is running")
 
 	return cmd
@@ -206,6 +237,30 @@ type UpdateClusterResults struct {
 	FileAssets []*assets.FileAsset
 	// Cluster is the cluster spec (output).
 	Cluster *kops.Cluster
+	// Drift are the existing cloud resources which differ from the model, when DriftOnly is set (output).
+	Drift []*fi.DriftedResource
+	// Report is the result document, when Output is set (output).
+	Report *UpdateClusterReport
+}
+
+// UpdateClusterReport is the result document of kops update cluster --yes --output, recording what was changed.
+type UpdateClusterReport struct {
+	// Cluster is the name of the cluster.
+	Cluster string `json:"cluster"`
+	// StartTime is when the update started.
+	StartTime metav1.Time `json:"startTime"`
+	// DurationSeconds is how long the update took.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Created, Updated and Unchanged count the tasks by their action.
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	// Tasks are the tasks which ran, with the resources they changed.
+	Tasks []*fi.TaskReport `json:"tasks,omitempty"`
+	// Warnings are the warnings raised by the tasks.
+	Warnings []string `json:"warnings,omitempty"`
+	// Error is the error which stopped the update, if any.
+	Error string `json:"error,omitempty"`
 }
 
 func RunCoreUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *CoreUpdateClusterOptions) (*UpdateClusterResults, error) {
@@ -217,6 +272,9 @@ func RunCoreUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c
 }
 
 func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *UpdateClusterOptions) (*UpdateClusterResults, error) {
+	ctx, span := tracer.Start(ctx, "RunUpdateCluster")
+	defer span.End()
+
 	results := &UpdateClusterResults{}
 
 	isDryrun := false
@@ -241,6 +299,28 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 		c.CreateKubecfg = true
 	}
 
+	if c.Output != "" {
+		if c.Output != OutputJSON && c.Output != OutputYaml {
+			return nil, fmt.Errorf("unknown output format: %q", c.Output)
+		}
+		if !c.Yes || c.Target != cloudup.TargetDirect || c.DriftOnly {
+			return nil, fmt.Errorf("--output reports the changes applied to the cloud, and requires --yes with --target=%s", cloudup.TargetDirect)
+		}
+		if c.ShowProgress {
+			return nil, fmt.Errorf("--output cannot be used with --progress")
+		}
+	}
+
+	if c.DriftOnly {
+		if c.Yes {
+			return nil, fmt.Errorf("--drift-only does not make changes and cannot be used with --yes")
+		}
+		if c.Target != cloudup.TargetDirect && c.Target != cloudup.TargetDryRun {
+			return nil, fmt.Errorf("--drift-only compares the live cloud resources and cannot be used with --target=%s", c.Target)
+		}
+		c.CreateKubecfg = false
+	}
+
 	// direct requires --yes (others do not, because they don't do anything!)
 	if c.Target == cloudup.TargetDirect {
 		if !c.Yes {
@@ -256,6 +336,10 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 	if c.OutDir == "" {
 		if c.Target == cloudup.TargetTerraform {
 			c.OutDir = "out/terraform"
+		} else if c.Target == cloudup.TargetPulumi {
+			c.OutDir = "out/pulumi"
+		} else if c.Target == cloudup.TargetCrossplane {
+			c.OutDir = "out/crossplane"
 		} else {
 			c.OutDir = "out"
 		}
@@ -361,12 +445,26 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 			klog.V(2).Infof("successfully checked control plane running version: %v", minControlPlaneRunningVersion)
 		}
 	}
+	if c.ShowProgress && !isDryrun {
+		c.RunTasksOptions.Progress = out
+	}
+	var report *UpdateClusterReport
+	if c.Output != "" {
+		report = &UpdateClusterReport{
+			Cluster:   cluster.ObjectMeta.Name,
+			StartTime: metav1.Now(),
+		}
+		c.RunTasksOptions.Report = fi.NewApplyReport()
+		results.Report = report
+	}
+
 	applyCmd := &cloudup.ApplyClusterCmd{
 		Cloud:                      cloud,
 		Clientset:                  clientset,
 		Cluster:                    cluster,
 		DryRun:                     isDryrun,
 		AllowKopsDowngrade:         c.AllowKopsDowngrade,
+		SkipQuotaCheck:             c.SkipQuotaCheck,
 		RunTasksOptions:            &c.RunTasksOptions,
 		OutDir:                     c.OutDir,
 		InstanceGroupFilter:        predicates.AllOf(instanceGroupFilters...),
@@ -377,8 +475,24 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 		DeletionProcessing:         deletionProcessing,
 		ControlPlaneRunningVersion: minControlPlaneRunningVersion,
 	}
+	if c.DryRunOutput != nil {
+		applyCmd.DryRunOutput = c.DryRunOutput
+	}
+	if c.DriftOnly {
+		// The full report includes changes which are not drift, such as resources still to be created
+		applyCmd.DryRunOutput = io.Discard
+	}
 
 	applyResults, err := applyCmd.Run(ctx)
+	if !isDryrun && targetName == cloudup.TargetDirect {
+		notifyApply(ctx, cluster, c, err)
+	}
+	if report != nil {
+		report.complete(c.RunTasksOptions.Report, err)
+		if printErr := printUpdateClusterReport(out, report, c.Output); printErr != nil {
+			return results, printErr
+		}
+	}
 	if err != nil {
 		return results, err
 	}
@@ -389,6 +503,16 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 	results.FileAssets = applyResults.AssetBuilder.FileAssets
 	results.Cluster = cluster
 
+	if c.DriftOnly {
+		target := applyCmd.Target.(*fi.CloudupDryRunTarget)
+		results.Drift, err = target.Drift(applyCmd.TaskMap)
+		if err != nil {
+			return results, err
+		}
+		printDriftReport(out, results.Drift)
+		return results, nil
+	}
+
 	if isDryrun && !c.GetAssets {
 		target := applyCmd.Target.(*fi.CloudupDryRunTarget)
 		if target.HasChanges() {
@@ -433,7 +557,7 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 		}
 	}
 
-	if !isDryrun {
+	if !isDryrun && report == nil {
 		sb := new(bytes.Buffer)
 
 		if c.Target == cloudup.TargetTerraform {
@@ -447,6 +571,27 @@ func RunUpdateCluster(ctx context.Context, f *util.Factory, out io.Writer, c *Up
 				fmt.Fprintf(sb, "   terraform apply\n")
 				fmt.Fprintf(sb, "\n")
 			}
+		} else if c.Target == cloudup.TargetPulumi {
+			fmt.Fprintf(sb, "\n")
+			fmt.Fprintf(sb, "Pulumi output has been placed into %s\n", c.OutDir)
+
+			if firstRun {
+				fmt.Fprintf(sb, "Run these commands to apply the configuration:\n")
+				fmt.Fprintf(sb, "   cd %s\n", c.OutDir)
+				fmt.Fprintf(sb, "   pulumi preview\n")
+				fmt.Fprintf(sb, "   pulumi up\n")
+				fmt.Fprintf(sb, "\n")
+			}
+		} else if c.Target == cloudup.TargetCrossplane {
+			fmt.Fprintf(sb, "\n")
+			fmt.Fprintf(sb, "Crossplane managed resources have been placed into %s\n", c.OutDir)
+
+			if firstRun {
+				fmt.Fprintf(sb, "Run these commands against your Crossplane control plane to apply the configuration:\n")
+				fmt.Fprintf(sb, "   cd %s\n", c.OutDir)
+				fmt.Fprintf(sb, "   kubectl apply -f crossplane.yaml\n")
+				fmt.Fprintf(sb, "\n")
+			}
 		} else if firstRun {
 			fmt.Fprintf(sb, "\n")
 			fmt.Fprintf(sb, "Cluster is starting.  It should be ready in a few minutes.\n")
@@ -553,6 +698,8 @@ func completeUpdateClusterTarget(f commandutils.Factory, options *CoreUpdateClus
 				cloudup.TargetDirect,
 				cloudup.TargetDryRun,
 				cloudup.TargetTerraform,
+				cloudup.TargetPulumi,
+				cloudup.TargetCrossplane,
 			}), directive
 		}
 
@@ -565,6 +712,16 @@ func completeUpdateClusterTarget(f commandutils.Factory, options *CoreUpdateClus
 				completions = append(completions, cloudup.TargetTerraform)
 			}
 		}
+		for _, cp := range cloudup.PulumiCloudProviders {
+			if cluster.GetCloudProvider() == cp {
+				completions = append(completions, cloudup.TargetPulumi)
+			}
+		}
+		for _, cp := range cloudup.CrossplaneCloudProviders {
+			if cluster.GetCloudProvider() == cp {
+				completions = append(completions, cloudup.TargetCrossplane)
+			}
+		}
 		return toStringSlice(completions), cobra.ShellCompDirectiveNoFileComp
 	}
 }
@@ -623,7 +780,70 @@ func matchInstanceGroupRoles(roles []string) predicates.Predicate[*kops.Instance
 	}
 }
 
+// complete fills in the report from the tasks which ran, and the error which stopped the update.
+func (r *UpdateClusterReport) complete(applyReport *fi.ApplyReport, err error) {
+	r.DurationSeconds = time.Since(r.StartTime.Time).Seconds()
+	r.Tasks = applyReport.Tasks()
+	r.Warnings = applyReport.Warnings()
+	for _, task := range r.Tasks {
+		switch task.Action {
+		case fi.TaskActionCreated:
+			r.Created++
+		case fi.TaskActionUpdated:
+			r.Updated++
+		case fi.TaskActionUnchanged:
+			r.Unchanged++
+		}
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+// printUpdateClusterReport writes the result document in the output format.
+func printUpdateClusterReport(out io.Writer, report *UpdateClusterReport, output string) error {
+	var b []byte
+	var err error
+	switch output {
+	case OutputYaml:
+		b, err = yaml.Marshal(report)
+	case OutputJSON:
+		b, err = json.MarshalIndent(report, "", "  ")
+		b = append(b, '\n')
+	default:
+		return fmt.Errorf("unknown output format: %q", output)
+	}
+	if err != nil {
+		return fmt.Errorf("error marshaling update result: %w", err)
+	}
+	if _, err := out.Write(b); err != nil {
+		return fmt.Errorf("error writing to output: %w", err)
+	}
+	return nil
+}
+
 // checkControlPlaneRunningVersion returns the minimum control plane running version
+// printDriftReport lists the resources which differ from the model and the attributes which differ
+func printDriftReport(out io.Writer, drift []*fi.DriftedResource) {
+	if len(drift) == 0 {
+		fmt.Fprintf(out, "No drift found: the existing cloud resources match the cluster configuration\n")
+		return
+	}
+
+	fmt.Fprintf(out, "Drift found in %d resources:\n", len(drift))
+	for _, r := range drift {
+		fmt.Fprintf(out, "  %s/%s\n", r.Task, r.Name)
+		for _, field := range r.Fields {
+			lines := strings.Split(field.Description, "\n")
+			fmt.Fprintf(out, "  \t%-20s\t%s\n", field.Name, lines[0])
+			for _, line := range lines[1:] {
+				fmt.Fprintf(out, "  \t%-20s\t%s\n", "", line)
+			}
+		}
+		fmt.Fprintf(out, "\n")
+	}
+}
+
 func checkControlPlaneRunningVersion(ctx context.Context, clusterName string, version string) (string, error) {
 	configLoadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
@@ -657,3 +877,25 @@ func checkControlPlaneRunningVersion(ctx context.Context, clusterName string, ve
 	}
 	return strings.TrimPrefix(version, "v"), nil
 }
+
+// notifyApply posts the outcome of applying the changes to the cloud to the notification sinks of the cluster.
+func notifyApply(ctx context.Context, cluster *kops.Cluster, c *UpdateClusterOptions, err error) {
+	message := "Applied the changes to the cloud"
+	if err != nil {
+		message = "Applying the changes to the cloud"
+	}
+	event := notifications.NewEvent(cluster, kops.NotificationEventTypeApply, message, err)
+	event.Details = make(map[string]string)
+	if c.Phase != "" {
+		event.Details["phase"] = c.Phase
+	}
+	if len(c.InstanceGroups) != 0 {
+		event.Details["instanceGroups"] = strings.Join(c.InstanceGroups, ",")
+	} else if len(c.InstanceGroupRoles) != 0 {
+		event.Details["instanceGroupRoles"] = strings.Join(c.InstanceGroupRoles, ",")
+	}
+	if c.Prune {
+		event.Details["prune"] = "true"
+	}
+	notifications.Notify(ctx, cluster, event)
+}
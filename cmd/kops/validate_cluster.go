@@ -38,6 +38,7 @@ import (
 	"k8s.io/kops/cmd/kops/util"
 	"k8s.io/kops/pkg/apis/kops"
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/notifications"
 	"k8s.io/kops/pkg/validation"
 	"k8s.io/kops/util/pkg/tables"
 	"sigs.k8s.io/yaml"
@@ -95,6 +96,7 @@ func NewCmdValidateCluster(f *util.Factory, out io.Writer) *cobra.Command {
 		ValidArgsFunction: commandutils.CompleteClusterName(f, true, false),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			result, err := RunValidateCluster(cmd.Context(), f, out, options)
+			notifyValidation(cmd.Context(), f, options.ClusterName, result, err)
 			if err != nil {
 				return fmt.Errorf("validation failed: %v", err)
 			}
@@ -240,7 +242,7 @@ func RunValidateCluster(ctx context.Context, f *util.Factory, out io.Writer, opt
 				time.Sleep(options.interval)
 				continue
 			} else {
-				return nil, fmt.Errorf("cluster not yet healthy")
+				return result, fmt.Errorf("cluster not yet healthy")
 			}
 		}
 	}
@@ -293,6 +295,52 @@ func validateClusterOutputTable(result *validation.ValidationCluster, cluster *k
 		}
 	}
 
+	if result.Health != nil {
+		healthTable := &tables.Table{}
+		healthTable.AddColumn("CATEGORY", func(c *validation.HealthCategoryScore) string {
+			return string(c.Category)
+		})
+		healthTable.AddColumn("SCORE", func(c *validation.HealthCategoryScore) int {
+			return c.Score
+		})
+		healthTable.AddColumn("CHECKS", func(c *validation.HealthCategoryScore) int {
+			return c.Checks
+		})
+		healthTable.AddColumn("FAILED", func(c *validation.HealthCategoryScore) int {
+			return c.Failed
+		})
+
+		fmt.Fprintf(out, "\nHEALTH SCORE %d\n", result.Health.Score)
+		if err := healthTable.Render(result.Health.Categories, out, "CATEGORY", "SCORE", "CHECKS", "FAILED"); err != nil {
+			return fmt.Errorf("cannot render health for %q: %w", cluster.Name, err)
+		}
+
+		type healthWarning struct {
+			category validation.HealthCategory
+			message  string
+		}
+		var warnings []*healthWarning
+		for _, category := range result.Health.Categories {
+			for _, message := range category.Warnings {
+				warnings = append(warnings, &healthWarning{category: category.Category, message: message})
+			}
+		}
+		if len(warnings) != 0 {
+			warningsTable := &tables.Table{}
+			warningsTable.AddColumn("CATEGORY", func(w *healthWarning) string {
+				return string(w.category)
+			})
+			warningsTable.AddColumn("MESSAGE", func(w *healthWarning) string {
+				return w.message
+			})
+
+			fmt.Fprintln(out, "\nHEALTH WARNINGS")
+			if err := warningsTable.Render(warnings, out, "CATEGORY", "MESSAGE"); err != nil {
+				return fmt.Errorf("error rendering health warnings table: %v", err)
+			}
+		}
+	}
+
 	if len(result.Failures) != 0 {
 		failuresTable := &tables.Table{}
 		failuresTable.AddColumn("KIND", func(e *validation.ValidationError) string {
@@ -317,3 +365,25 @@ func validateClusterOutputTable(result *validation.ValidationCluster, cluster *k
 
 	return nil
 }
+
+// notifyValidation posts the result of validating the cluster to its notification sinks.
+func notifyValidation(ctx context.Context, f *util.Factory, clusterName string, result *validation.ValidationCluster, err error) {
+	cluster, getErr := GetCluster(ctx, f, clusterName)
+	if getErr != nil {
+		return
+	}
+
+	var event *notifications.Event
+	if err != nil {
+		event = notifications.NewEvent(cluster, kops.NotificationEventTypeValidation, "Validation failed", err)
+	} else if len(result.Failures) != 0 {
+		event = notifications.NewEvent(cluster, kops.NotificationEventTypeValidation, "Validation failed", fmt.Errorf("%d failures", len(result.Failures)))
+		event.Details = make(map[string]string)
+		for _, failure := range result.Failures {
+			event.Details[failure.Kind+"/"+failure.Name] = failure.Message
+		}
+	} else {
+		event = notifications.NewEvent(cluster, kops.NotificationEventTypeValidation, "Cluster is ready", nil)
+	}
+	notifications.Notify(ctx, cluster, event)
+}
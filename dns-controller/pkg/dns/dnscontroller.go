@@ -117,13 +117,16 @@ func (c *DNSController) Run() {
 
 func (c *DNSController) runWatcher(stopCh <-chan struct{}) {
 	for {
+		start := time.Now()
 		err := c.runOnce()
+		dnsUpdateDuration.Observe(time.Since(start).Seconds())
 		if c.StopRequested() {
 			klog.Infof("exiting dns controller loop")
 			return
 		}
 
 		if err != nil {
+			dnsUpdateErrors.Inc()
 			// Increment the update failure counter
 			failures := atomic.AddUint64(&c.failCount, 1)
 			// Avoid overflowing the exponential backoff interval
@@ -324,6 +327,7 @@ func (c *DNSController) runOnce() error {
 		klog.V(2).Infof("Applying DNS changeset for zone %s", key)
 		if err := changeset.Apply(ctx); err != nil {
 			klog.Warningf("error applying DNS changeset for zone %s: %v", key, err)
+			dnsChangesetErrors.WithLabelValues(key).Inc()
 			errors = append(errors, fmt.Errorf("error applying DNS changeset for zone %s: %v", key, err))
 		}
 	}
@@ -367,6 +371,7 @@ func (c *DNSController) RemoveRecordsImmediate(records []Record) error {
 		klog.V(2).Infof("Applying DNS changeset for zone %s", key)
 		if err := changeset.Apply(ctx); err != nil {
 			klog.Warningf("error applying DNS changeset for zone %s: %v", key, err)
+			dnsChangesetErrors.WithLabelValues(key).Inc()
 			errors = append(errors, fmt.Errorf("error applying DNS changeset for zone %s: %v", key, err))
 		}
 	}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dnsUpdateDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "dns_controller_update_duration_seconds",
+			Help:    "Time taken to apply the desired DNS state to the DNS backend.",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 12),
+		},
+	)
+
+	dnsUpdateErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dns_controller_update_errors_total",
+			Help: "Number of failed attempts to apply the desired DNS state.",
+		},
+	)
+
+	dnsChangesetErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dns_controller_changeset_errors_total",
+			Help: "Number of DNS changesets that failed to apply, partitioned by zone.",
+		},
+		[]string{"zone"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(dnsUpdateDuration, dnsUpdateErrors, dnsChangesetErrors)
+}
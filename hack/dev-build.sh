@@ -111,6 +111,7 @@ echo "Deleting cluster ${CLUSTER_NAME}. Elle est finie."
 
 kops delete cluster \
   --name $CLUSTER_NAME \
+  --confirm-name $CLUSTER_NAME \
   --state $KOPS_STATE_STORE \
   -v $VERBOSITY \
   --yes
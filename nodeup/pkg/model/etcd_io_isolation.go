@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/systemd"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+const (
+	etcdIOSchedulerService = "kops-etcd-io-scheduler.service"
+	etcdIOSchedulerTimer   = "kops-etcd-io-scheduler.timer"
+	etcdIOSchedulerScript  = "/opt/kops/bin/etcd-io-scheduler"
+)
+
+// EtcdIOIsolationBuilder isolates the IO of the etcd volumes from the other workloads of control plane instances.
+type EtcdIOIsolationBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &EtcdIOIsolationBuilder{}
+
+// Build is responsible for configuring the IO scheduler of the etcd volumes and the io.max limits of the root disk
+func (b *EtcdIOIsolationBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	isolation := b.NodeupConfig.EtcdIOIsolation
+	if isolation == nil || !b.IsMaster {
+		return nil
+	}
+
+	if isolation.Scheduler != "" {
+		b.buildScheduler(c, isolation.Scheduler)
+	}
+
+	// The etcd volumes are mounted by etcd-manager at /mnt/master-*, so limiting the pods and containerd on the
+	// root disk leaves etcd the full bandwidth of its volumes.
+	var limits []string
+	if isolation.MaxReadBandwidth != nil {
+		limits = append(limits, "IOReadBandwidthMax=/ "+strconv.FormatInt(isolation.MaxReadBandwidth.Value(), 10))
+	}
+	if isolation.MaxWriteBandwidth != nil {
+		limits = append(limits, "IOWriteBandwidthMax=/ "+strconv.FormatInt(isolation.MaxWriteBandwidth.Value(), 10))
+	}
+	if isolation.MaxReadIOPS != nil {
+		limits = append(limits, "IOReadIOPSMax=/ "+strconv.FormatInt(*isolation.MaxReadIOPS, 10))
+	}
+	if isolation.MaxWriteIOPS != nil {
+		limits = append(limits, "IOWriteIOPSMax=/ "+strconv.FormatInt(*isolation.MaxWriteIOPS, 10))
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+
+	for _, unit := range []struct {
+		name    string
+		section string
+	}{
+		{name: "kubepods.slice", section: "Slice"},
+		{name: "containerd.service", section: "Service"},
+	} {
+		lines := append([]string{"# Built by kOps - do not edit", "[" + unit.section + "]", "IOAccounting=yes"}, limits...)
+		c.AddTask(&nodetasks.File{
+			Path:            "/etc/systemd/system/" + unit.name + ".d/20-kops-io.conf",
+			Contents:        fi.NewStringResource(strings.Join(lines, "\n") + "\n"),
+			Type:            nodetasks.FileType_File,
+			Mode:            s("0644"),
+			OnChangeExecute: [][]string{{"systemctl", "daemon-reload"}},
+		})
+	}
+
+	return nil
+}
+
+// buildScheduler sets the IO scheduler of the disks of the etcd volumes.
+// The volumes are only mounted once etcd-manager is running, so the scheduler is set periodically by a timer.
+func (b *EtcdIOIsolationBuilder) buildScheduler(c *fi.NodeupModelBuilderContext, scheduler string) {
+	script := `#!/bin/bash
+# Built by kOps - do not edit
+
+# Sets the IO scheduler of the disks of the etcd volumes mounted by etcd-manager.
+set -o nounset
+set -o pipefail
+
+scheduler="$1"
+for mountpoint in $(findmnt --noheadings --list --output TARGET | grep '^/mnt/master-'); do
+  majmin=$(findmnt --noheadings --output MAJ:MIN --mountpoint "${mountpoint}" | tr -d ' ')
+  device=$(readlink -f "/sys/dev/block/${majmin}")
+  if [[ -f "${device}/partition" ]]; then
+    device=$(dirname "${device}")
+  fi
+  if ! grep -q "\[${scheduler}\]" "${device}/queue/scheduler"; then
+    echo "setting the IO scheduler of $(basename "${device}") to ${scheduler}"
+    echo "${scheduler}" > "${device}/queue/scheduler"
+  fi
+done
+`
+	c.AddTask(&nodetasks.File{
+		Path:     etcdIOSchedulerScript,
+		Contents: fi.NewStringResource(script),
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0755"),
+	})
+
+	manifest := &systemd.Manifest{}
+	manifest.Set("Unit", "Description", "Set the IO scheduler of the etcd volumes")
+	manifest.Set("Unit", "Documentation", "https://github.com/kubernetes/kops")
+	manifest.Set("Service", "Type", "oneshot")
+	manifest.Set("Service", "ExecStart", etcdIOSchedulerScript+" "+scheduler)
+
+	manifestString := manifest.Render()
+	klog.V(8).Infof("Built service manifest %q\n%s", etcdIOSchedulerService, manifestString)
+
+	service := &nodetasks.Service{
+		Name:       etcdIOSchedulerService,
+		Definition: s(manifestString),
+		Running:    fi.PtrTo(false),
+	}
+	service.InitDefaults()
+	c.AddTask(service)
+
+	timer := &systemd.Manifest{}
+	timer.Set("Unit", "Description", "Set the IO scheduler of the etcd volumes every minute")
+	timer.Set("Timer", "OnBootSec", "1min")
+	timer.Set("Timer", "OnUnitActiveSec", "1min")
+
+	timerService := &nodetasks.Service{
+		Name:       etcdIOSchedulerTimer,
+		Definition: s(timer.Render()),
+	}
+	timerService.InitDefaults()
+	c.AddTask(timerService)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/systemd"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+const (
+	growFilesystemsService = "kops-grow-filesystems.service"
+	growFilesystemsScript  = "/opt/kops/bin/grow-filesystems"
+)
+
+// GrowFilesystemsBuilder grows the partitions and filesystems of the node when kops-controller resizes its volumes.
+type GrowFilesystemsBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &GrowFilesystemsBuilder{}
+
+// Build is responsible for growing the filesystems when their disk is resized
+func (b *GrowFilesystemsBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if len(b.NodeupConfig.GrowFilesystems) == 0 {
+		return nil
+	}
+
+	script := `#!/bin/bash
+# Built by kOps - do not edit
+
+# Grows the partitions and filesystems mounted at the given paths to the size of their disks.
+set -o nounset
+set -o pipefail
+
+for mountpoint in "$@"; do
+  majmin=$(findmnt --noheadings --output MAJ:MIN --mountpoint "${mountpoint}" | tr -d ' ')
+  if [[ -z "${majmin}" ]]; then
+    echo "${mountpoint} is not mounted"
+    continue
+  fi
+  fstype=$(findmnt --noheadings --output FSTYPE --mountpoint "${mountpoint}")
+  device=$(readlink -f "/sys/dev/block/${majmin}")
+  if [[ -f "${device}/partition" ]]; then
+    # growpart exits with 1 when the partition already fills the disk
+    growpart "/dev/$(basename "$(dirname "${device}")")" "$(cat "${device}/partition")" || true
+  fi
+  case "${fstype}" in
+    ext4)
+      resize2fs "/dev/$(basename "${device}")"
+      ;;
+    xfs)
+      xfs_growfs "${mountpoint}"
+      ;;
+    *)
+      echo "not growing ${fstype} filesystem mounted at ${mountpoint}"
+      ;;
+  esac
+done
+`
+	c.AddTask(&nodetasks.File{
+		Path:     growFilesystemsScript,
+		Contents: fi.NewStringResource(script),
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0755"),
+	})
+
+	manifest := &systemd.Manifest{}
+	manifest.Set("Unit", "Description", "Grow the filesystems to the size of their disks")
+	manifest.Set("Unit", "Documentation", "https://github.com/kubernetes/kops")
+	manifest.Set("Service", "Type", "oneshot")
+	manifest.Set("Service", "ExecStart", growFilesystemsScript+" "+strings.Join(b.NodeupConfig.GrowFilesystems, " "))
+
+	manifestString := manifest.Render()
+	klog.V(8).Infof("Built service manifest %q\n%s", growFilesystemsService, manifestString)
+
+	// The service is started by udev, whenever the size of a disk changes
+	service := &nodetasks.Service{
+		Name:       growFilesystemsService,
+		Definition: s(manifestString),
+		Running:    fi.PtrTo(false),
+	}
+	service.InitDefaults()
+	c.AddTask(service)
+
+	rules := `# Built by kOps - do not edit
+ACTION=="change", SUBSYSTEM=="block", ENV{DEVTYPE}=="disk", TAG+="systemd", ENV{SYSTEMD_WANTS}+="` + growFilesystemsService + `"
+`
+	c.AddTask(&nodetasks.File{
+		Path:            "/etc/udev/rules.d/90-kops-grow-filesystems.rules",
+		Contents:        fi.NewStringResource(rules),
+		Type:            nodetasks.FileType_File,
+		Mode:            s("0644"),
+		OnChangeExecute: [][]string{{"udevadm", "control", "--reload-rules"}},
+	})
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+const journaldConfigPath = "/etc/systemd/journald.conf.d/90-kops.conf"
+
+// JournaldBuilder configures the limits of the systemd journal.
+type JournaldBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &JournaldBuilder{}
+
+// Build is responsible for configuring journald
+func (b *JournaldBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	journald := b.NodeupConfig.Journald
+	if journald == nil {
+		return nil
+	}
+
+	conf := []string{"# Managed by kOps", "[Journal]"}
+	if journald.SystemMaxUse != "" {
+		conf = append(conf, "SystemMaxUse="+journald.SystemMaxUse)
+	}
+	if journald.RateLimitInterval != nil {
+		conf = append(conf, "RateLimitIntervalSec="+systemdTimeSpan(journald.RateLimitInterval.Duration))
+	}
+	if journald.RateLimitBurst != nil {
+		conf = append(conf, "RateLimitBurst="+strconv.Itoa(int(*journald.RateLimitBurst)))
+	}
+
+	c.AddTask(&nodetasks.File{
+		Path:            journaldConfigPath,
+		Contents:        fi.NewStringResource(strings.Join(conf, "\n") + "\n"),
+		Type:            nodetasks.FileType_File,
+		Mode:            fi.PtrTo("0644"),
+		OnChangeExecute: [][]string{{"systemctl", "restart", "systemd-journald.service"}},
+	})
+
+	return nil
+}
+
+// systemdTimeSpan formats a duration as a systemd time span
+func systemdTimeSpan(d time.Duration) string {
+	if d%time.Second == 0 {
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
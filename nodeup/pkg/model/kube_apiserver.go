@@ -29,6 +29,7 @@ import (
 	"k8s.io/kops/pkg/kubeconfig"
 	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/model/components"
+	"k8s.io/kops/pkg/model/components/kubeapiserver"
 	"k8s.io/kops/pkg/tokens"
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/pkg/wellknownusers"
@@ -82,6 +83,10 @@ func (b *KubeAPIServerBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 		return err
 	}
 
+	if err := b.writeAdmissionConfig(c, &kubeAPIServer); err != nil {
+		return err
+	}
+
 	if b.NodeupConfig.APIServerConfig.EncryptionConfigSecretHash != "" {
 		encryptionConfigPath := fi.PtrTo(filepath.Join(pathSrvKAPI, "encryptionconfig.yaml"))
 
@@ -209,6 +214,13 @@ func (b *KubeAPIServerBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 		}
 	}
 
+	// If we're using konnectivity-server, we need to set up its certificates and the egress selector configuration
+	if b.findKonnectivityManifest() != nil {
+		if err := b.addKonnectivitySidecarTasks(c); err != nil {
+			return err
+		}
+	}
+
 	c.AddTask(&nodetasks.File{
 		Path:        "/var/log/kube-apiserver.log",
 		Contents:    fi.NewStringResource(""),
@@ -577,6 +589,11 @@ func (b *KubeAPIServerBuilder) buildPod(ctx context.Context, kubeAPIServer *kops
 		flags = append(flags, fmt.Sprintf("--cloud-config=%s", InTreeCloudConfigFilePath))
 	}
 
+	useKonnectivity := b.findKonnectivityManifest() != nil
+	if useKonnectivity {
+		flags = append(flags, "--egress-selector-config-file="+b.egressSelectorConfigPath())
+	}
+
 	pod := &v1.Pod{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -784,6 +801,10 @@ func (b *KubeAPIServerBuilder) buildPod(ctx context.Context, kubeAPIServer *kops
 		}
 	}
 
+	if useKonnectivity {
+		kubemanifest.AddHostPathMapping(pod, container, "konnectivity-server-socket", kubeapiserver.KonnectivityServerSocketDir, kubemanifest.WithReadWrite())
+	}
+
 	pod.Spec.Containers = append(pod.Spec.Containers, *container)
 
 	kubemanifest.MarkPodAsCritical(pod)
@@ -797,6 +818,12 @@ func (b *KubeAPIServerBuilder) buildPod(ctx context.Context, kubeAPIServer *kops
 		}
 	}
 
+	if useKonnectivity {
+		if err := b.addKonnectivitySidecar(ctx, pod); err != nil {
+			return nil, err
+		}
+	}
+
 	return pod, nil
 }
 
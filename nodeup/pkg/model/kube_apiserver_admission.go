@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+// admissionConfiguration is the AdmissionConfiguration of kube-apiserver, configuring the PodSecurity plugin
+type admissionConfiguration struct {
+	APIVersion string                         `json:"apiVersion"`
+	Kind       string                         `json:"kind"`
+	Plugins    []admissionPluginConfiguration `json:"plugins"`
+}
+
+type admissionPluginConfiguration struct {
+	Name          string                   `json:"name"`
+	Configuration podSecurityConfiguration `json:"configuration"`
+}
+
+type podSecurityConfiguration struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Defaults   podSecurityDefaults   `json:"defaults"`
+	Exemptions podSecurityExemptions `json:"exemptions"`
+}
+
+type podSecurityDefaults struct {
+	Enforce        kops.PodSecurityLevel `json:"enforce"`
+	EnforceVersion string                `json:"enforce-version"`
+	Audit          kops.PodSecurityLevel `json:"audit"`
+	AuditVersion   string                `json:"audit-version"`
+	Warn           kops.PodSecurityLevel `json:"warn"`
+	WarnVersion    string                `json:"warn-version"`
+}
+
+type podSecurityExemptions struct {
+	Usernames      []string `json:"usernames,omitempty"`
+	RuntimeClasses []string `json:"runtimeClasses,omitempty"`
+	Namespaces     []string `json:"namespaces,omitempty"`
+}
+
+// writeAdmissionConfig writes the admission configuration file of kube-apiserver, if Pod Security admission is configured
+func (b *KubeAPIServerBuilder) writeAdmissionConfig(c *fi.NodeupModelBuilderContext, kubeAPIServer *kops.KubeAPIServerConfig) error {
+	podSecurity := b.NodeupConfig.APIServerConfig.PodSecurity
+	if podSecurity == nil {
+		return nil
+	}
+
+	level := func(l kops.PodSecurityLevel) kops.PodSecurityLevel {
+		if l == "" {
+			return kops.PodSecurityLevelPrivileged
+		}
+		return l
+	}
+	version := podSecurity.Version
+	if version == "" {
+		version = "latest"
+	}
+
+	config := admissionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "AdmissionConfiguration",
+		Plugins: []admissionPluginConfiguration{
+			{
+				Name: "PodSecurity",
+				Configuration: podSecurityConfiguration{
+					APIVersion: "pod-security.admission.config.k8s.io/v1",
+					Kind:       "PodSecurityConfiguration",
+					Defaults: podSecurityDefaults{
+						Enforce:        level(podSecurity.Enforce),
+						EnforceVersion: version,
+						Audit:          level(podSecurity.Audit),
+						AuditVersion:   version,
+						Warn:           level(podSecurity.Warn),
+						WarnVersion:    version,
+					},
+					Exemptions: podSecurityExemptions{
+						Usernames:      podSecurity.ExemptUsernames,
+						RuntimeClasses: podSecurity.ExemptRuntimeClasses,
+						Namespaces:     podSecurity.ExemptNamespaces,
+					},
+				},
+			},
+		},
+	}
+
+	manifest, err := kops.ToRawYaml(config)
+	if err != nil {
+		return fmt.Errorf("error marshaling admission config to yaml: %w", err)
+	}
+
+	path := filepath.Join(b.PathSrvKubernetes(), "kube-apiserver", "admission-configuration.yaml")
+	c.AddTask(&nodetasks.File{
+		Path:     path,
+		Contents: fi.NewBytesResource(manifest),
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0600"),
+	})
+	kubeAPIServer.AdmissionControlConfigFile = path
+
+	return nil
+}
@@ -38,16 +38,21 @@ func (b *KubeAPIServerBuilder) addHealthcheckSidecar(ctx context.Context, pod *c
 		return nil
 	}
 
+	return b.addSidecar(ctx, "kube-apiserver-healthcheck", manifest, pod)
+}
+
+// addSidecar merges the partial pod of a static manifest into the kube-apiserver pod
+func (b *KubeAPIServerBuilder) addSidecar(ctx context.Context, name string, manifest *nodeup.StaticManifest, pod *corev1.Pod) error {
 	p := b.ConfigBase.Join(manifest.Path)
 
 	data, err := p.ReadFile(ctx)
 	if err != nil {
-		return fmt.Errorf("error reading kube-apiserver-healthcheck manifest %s: %w", p, err)
+		return fmt.Errorf("error reading %s manifest %s: %w", name, p, err)
 	}
 
 	sidecar := &corev1.Pod{}
 	if err := yaml.Unmarshal(data, sidecar); err != nil {
-		return fmt.Errorf("error parsing kube-apiserver-healthcheck manifest %s: %w", p, err)
+		return fmt.Errorf("error parsing %s manifest %s: %w", name, p, err)
 	}
 
 	// Quick-and-dirty merge of the fields we care about
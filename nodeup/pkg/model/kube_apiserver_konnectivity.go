@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"context"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/kops/pkg/apis/nodeup"
+	"k8s.io/kops/pkg/model/components/kubeapiserver"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+)
+
+// egressSelectorConfiguration sends the traffic of kube-apiserver to the cluster through konnectivity-server
+const egressSelectorConfiguration = `apiVersion: apiserver.k8s.io/v1beta1
+kind: EgressSelectorConfiguration
+egressSelections:
+- name: cluster
+  connection:
+    proxyProtocol: GRPC
+    transport:
+      uds:
+        udsName: ` + kubeapiserver.KonnectivityServerSocket + `
+`
+
+func (b *KubeAPIServerBuilder) findKonnectivityManifest() *nodeup.StaticManifest {
+	return b.findStaticManifest("konnectivity-server")
+}
+
+func (b *KubeAPIServerBuilder) addKonnectivitySidecar(ctx context.Context, pod *corev1.Pod) error {
+	manifest := b.findKonnectivityManifest()
+	if manifest == nil {
+		return nil
+	}
+
+	return b.addSidecar(ctx, "konnectivity-server", manifest, pod)
+}
+
+// egressSelectorConfigPath is the path of the EgressSelectorConfiguration of kube-apiserver
+func (b *KubeAPIServerBuilder) egressSelectorConfigPath() string {
+	return filepath.Join(b.PathSrvKubernetes(), "kube-apiserver", "egress-selector-configuration.yaml")
+}
+
+func (b *KubeAPIServerBuilder) addKonnectivitySidecarTasks(c *fi.NodeupModelBuilderContext) error {
+	c.AddTask(&nodetasks.File{
+		Path:     b.egressSelectorConfigPath(),
+		Contents: fi.NewStringResource(egressSelectorConfiguration),
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0600"),
+	})
+
+	c.AddTask(&nodetasks.File{
+		Path: kubeapiserver.KonnectivityServerSocketDir,
+		Type: nodetasks.FileType_Directory,
+		Mode: s("0700"),
+	})
+
+	// konnectivity-agent connects through the internal API name
+	issueCert := &nodetasks.IssueCert{
+		Name:           "konnectivity-server",
+		Signer:         fi.CertificateIDCA,
+		KeypairID:      b.NodeupConfig.KeypairIDs[fi.CertificateIDCA],
+		Type:           "server",
+		Subject:        nodetasks.PKIXName{CommonName: "konnectivity-server"},
+		AlternateNames: []string{b.APIInternalName()},
+	}
+	c.AddTask(issueCert)
+	if err := issueCert.AddFileTasks(c, kubeapiserver.KonnectivityServerDir, "server", "", nil); err != nil {
+		return err
+	}
+
+	// konnectivity-server authenticates the agents with TokenReviews
+	kubeconfig := b.BuildIssuedKubeconfig("konnectivity-server", nodetasks.PKIXName{CommonName: kubeapiserver.KonnectivityServerAudience}, c)
+	c.AddTask(&nodetasks.File{
+		Path:     filepath.Join(kubeapiserver.KonnectivityServerDir, "kubeconfig"),
+		Contents: kubeconfig,
+		Type:     nodetasks.FileType_File,
+		Mode:     s("0400"),
+	})
+
+	return nil
+}
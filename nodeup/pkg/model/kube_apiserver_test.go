@@ -169,6 +169,13 @@ func TestOIDCConfigAPIServerBuilder(t *testing.T) {
 	})
 }
 
+func TestPodSecurityAPIServerBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/podsecurity", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
+
 func TestDeddicatedAPIServerBuilder(t *testing.T) {
 	RunGoldenTest(t, "tests/golden/dedicated-apiserver", "kube-apiserver", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
 		builder := KubeAPIServerBuilder{NodeupModelContext: nodeupModelContext}
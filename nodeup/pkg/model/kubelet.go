@@ -689,6 +689,16 @@ func (b *KubeletBuilder) buildKubeletConfigSpec(ctx context.Context) (*kops.Kube
 		c.MaxPods = fi.PtrTo(int32(maxPods))
 	}
 
+	if c.ReservedResourcesMode == kops.KubeletReservedResourcesModeAuto {
+		memoryBytes, cpus, err := instanceCapacity()
+		if err != nil {
+			return nil, err
+		}
+		if err := applyAutoReservedResources(&c, memoryBytes, cpus); err != nil {
+			return nil, err
+		}
+	}
+
 	if c.VolumePluginDirectory == "" {
 		switch b.Distribution {
 		case distributions.DistributionContainerOS:
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+const (
+	mebibyte = 1024 * 1024
+	gibibyte = 1024 * mebibyte
+
+	// minEvictionMemoryAvailable is the lowest memory.available hard eviction threshold, the kubelet default
+	minEvictionMemoryAvailable = 100 * mebibyte
+)
+
+// reservationTier reserves a fraction of the capacity up to a size
+type reservationTier struct {
+	size     float64
+	fraction float64
+}
+
+// memoryReservationTiers are the GKE tiers of memory reserved for the Kubernetes daemons:
+// 25% of the first 4GiB, 20% of the next 4GiB, 10% of the next 8GiB, 6% of the next 112GiB and 2% of the rest.
+var memoryReservationTiers = []reservationTier{
+	{size: 4 * gibibyte, fraction: 0.25},
+	{size: 4 * gibibyte, fraction: 0.20},
+	{size: 8 * gibibyte, fraction: 0.10},
+	{size: 112 * gibibyte, fraction: 0.06},
+	{size: math.Inf(1), fraction: 0.02},
+}
+
+// cpuReservationTiers are the GKE tiers of CPU reserved for the Kubernetes daemons:
+// 6% of the first core, 1% of the next core, 0.5% of the next 2 cores and 0.25% of the rest.
+var cpuReservationTiers = []reservationTier{
+	{size: 1000, fraction: 0.06},
+	{size: 1000, fraction: 0.01},
+	{size: 2000, fraction: 0.005},
+	{size: math.Inf(1), fraction: 0.0025},
+}
+
+func reserve(capacity float64, tiers []reservationTier) float64 {
+	reserved := 0.0
+	for _, tier := range tiers {
+		if capacity <= 0 {
+			break
+		}
+		reserved += math.Min(capacity, tier.size) * tier.fraction
+		capacity -= tier.size
+	}
+	return reserved
+}
+
+// computeReservedResources returns the CPU and memory to reserve for the Kubernetes daemons, and the
+// memory.available hard eviction threshold, for an instance with the given memory and number of CPUs.
+func computeReservedResources(memoryBytes int64, cpus int) (cpu, memory, evictionMemoryAvailable resource.Quantity) {
+	cpu = *resource.NewMilliQuantity(int64(math.Round(reserve(float64(cpus)*1000, cpuReservationTiers))), resource.DecimalSI)
+
+	// Machines with less than 1GiB of memory get a fixed reservation
+	memoryReserved := int64(255 * mebibyte)
+	if memoryBytes >= gibibyte {
+		memoryReserved = int64(reserve(float64(memoryBytes), memoryReservationTiers))
+	}
+	memory = *resource.NewQuantity(memoryReserved/mebibyte*mebibyte, resource.BinarySI)
+
+	// 1% of the memory, so that pods are evicted before the kernel has to reclaim memory on large instances
+	evictionBytes := max(memoryBytes/100/mebibyte*mebibyte, minEvictionMemoryAvailable)
+	evictionMemoryAvailable = *resource.NewQuantity(evictionBytes, resource.BinarySI)
+
+	return cpu, memory, evictionMemoryAvailable
+}
+
+// applyAutoReservedResources sets the kubeReserved CPU and memory and the memory.available hard eviction threshold
+// from the capacity of the instance. Reservations that are set explicitly are kept, and the eviction threshold is
+// only raised.
+func applyAutoReservedResources(c *kops.KubeletConfigSpec, memoryBytes int64, cpus int) error {
+	cpu, memory, evictionMemoryAvailable := computeReservedResources(memoryBytes, cpus)
+
+	kubeReserved := make(map[string]string)
+	for k, v := range c.KubeReserved {
+		kubeReserved[k] = v
+	}
+	if _, found := kubeReserved["cpu"]; !found {
+		kubeReserved["cpu"] = cpu.String()
+	}
+	if _, found := kubeReserved["memory"]; !found {
+		kubeReserved["memory"] = memory.String()
+	}
+	c.KubeReserved = kubeReserved
+
+	var evictionHard []string
+	found := false
+	for _, threshold := range strings.Split(fi.ValueOf(c.EvictionHard), ",") {
+		if threshold == "" {
+			continue
+		}
+		value, isMemoryAvailable := strings.CutPrefix(threshold, "memory.available<")
+		if isMemoryAvailable {
+			found = true
+			// Percentages are relative to the capacity, we keep them as they are
+			if !strings.HasSuffix(value, "%") {
+				q, err := resource.ParseQuantity(value)
+				if err != nil {
+					return fmt.Errorf("error parsing hard eviction threshold %q: %w", threshold, err)
+				}
+				if q.Cmp(evictionMemoryAvailable) < 0 {
+					threshold = "memory.available<" + evictionMemoryAvailable.String()
+				}
+			}
+		}
+		evictionHard = append(evictionHard, threshold)
+	}
+	if !found {
+		evictionHard = append(evictionHard, "memory.available<"+evictionMemoryAvailable.String())
+	}
+	c.EvictionHard = fi.PtrTo(strings.Join(evictionHard, ","))
+
+	klog.Infof("reserving cpu=%s,memory=%s for the kubernetes daemons, with hard eviction thresholds %s", c.KubeReserved["cpu"], c.KubeReserved["memory"], *c.EvictionHard)
+	return nil
+}
+
+// instanceCapacity returns the memory in bytes and the number of CPUs of the instance
+func instanceCapacity() (int64, int, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, 0, fmt.Errorf("error reading memory information: %w", err)
+	}
+	defer f.Close()
+
+	memoryBytes, err := parseMemTotal(f)
+	if err != nil {
+		return 0, 0, err
+	}
+	return memoryBytes, runtime.NumCPU(), nil
+}
+
+// parseMemTotal returns the total memory in bytes from the contents of /proc/meminfo
+func parseMemTotal(r io.Reader) (int64, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "MemTotal:" || fields[2] != "kB" {
+			continue
+		}
+		kb, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("error parsing MemTotal %q: %w", fields[1], err)
+		}
+		return kb * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("error reading memory information: %w", err)
+	}
+	return 0, fmt.Errorf("MemTotal not found in memory information")
+}
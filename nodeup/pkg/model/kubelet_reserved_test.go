@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestComputeReservedResources(t *testing.T) {
+	grid := []struct {
+		memoryBytes      int64
+		cpus             int
+		expectedCPU      string
+		expectedMemory   string
+		expectedEviction string
+	}{
+		{memoryBytes: 512 * mebibyte, cpus: 1, expectedCPU: "60m", expectedMemory: "255Mi", expectedEviction: "100Mi"},
+		{memoryBytes: 2 * gibibyte, cpus: 2, expectedCPU: "70m", expectedMemory: "512Mi", expectedEviction: "100Mi"},
+		{memoryBytes: 8 * gibibyte, cpus: 2, expectedCPU: "70m", expectedMemory: "1843Mi", expectedEviction: "100Mi"},
+		{memoryBytes: 16 * gibibyte, cpus: 4, expectedCPU: "80m", expectedMemory: "2662Mi", expectedEviction: "163Mi"},
+		{memoryBytes: 64 * gibibyte, cpus: 16, expectedCPU: "110m", expectedMemory: "5611Mi", expectedEviction: "655Mi"},
+		{memoryBytes: 256 * gibibyte, cpus: 64, expectedCPU: "230m", expectedMemory: "12165Mi", expectedEviction: "2621Mi"},
+	}
+	for _, g := range grid {
+		cpu, memory, eviction := computeReservedResources(g.memoryBytes, g.cpus)
+		if cpu.String() != g.expectedCPU || memory.String() != g.expectedMemory || eviction.String() != g.expectedEviction {
+			t.Errorf("unexpected reservation for %d bytes and %d cpus: cpu=%s memory=%s eviction=%s", g.memoryBytes, g.cpus, cpu.String(), memory.String(), eviction.String())
+		}
+	}
+}
+
+func TestApplyAutoReservedResources(t *testing.T) {
+	grid := []struct {
+		kubeReserved         map[string]string
+		evictionHard         string
+		expectedKubeReserved map[string]string
+		expectedEvictionHard string
+	}{
+		{
+			evictionHard:         "memory.available<100Mi,nodefs.available<10%",
+			expectedKubeReserved: map[string]string{"cpu": "80m", "memory": "2662Mi"},
+			expectedEvictionHard: "memory.available<163Mi,nodefs.available<10%",
+		},
+		{
+			kubeReserved:         map[string]string{"memory": "1Gi", "ephemeral-storage": "1Gi"},
+			evictionHard:         "memory.available<500Mi",
+			expectedKubeReserved: map[string]string{"cpu": "80m", "memory": "1Gi", "ephemeral-storage": "1Gi"},
+			expectedEvictionHard: "memory.available<500Mi",
+		},
+		{
+			evictionHard:         "nodefs.available<10%",
+			expectedKubeReserved: map[string]string{"cpu": "80m", "memory": "2662Mi"},
+			expectedEvictionHard: "nodefs.available<10%,memory.available<163Mi",
+		},
+		{
+			evictionHard:         "memory.available<1%",
+			expectedKubeReserved: map[string]string{"cpu": "80m", "memory": "2662Mi"},
+			expectedEvictionHard: "memory.available<1%",
+		},
+	}
+	for _, g := range grid {
+		c := &kops.KubeletConfigSpec{
+			KubeReserved: g.kubeReserved,
+			EvictionHard: fi.PtrTo(g.evictionHard),
+		}
+		if err := applyAutoReservedResources(c, 16*gibibyte, 4); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(c.KubeReserved, g.expectedKubeReserved) {
+			t.Errorf("expected kubeReserved %v, got %v", g.expectedKubeReserved, c.KubeReserved)
+		}
+		if fi.ValueOf(c.EvictionHard) != g.expectedEvictionHard {
+			t.Errorf("expected evictionHard %q, got %q", g.expectedEvictionHard, fi.ValueOf(c.EvictionHard))
+		}
+	}
+}
+
+func TestParseMemTotal(t *testing.T) {
+	meminfo := `MemTotal:       16314668 kB
+MemFree:         1202376 kB
+MemAvailable:   10513080 kB
+`
+	memoryBytes, err := parseMemTotal(strings.NewReader(meminfo))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if memoryBytes != 16314668*1024 {
+		t.Errorf("unexpected memory %d", memoryBytes)
+	}
+
+	if _, err := parseMemTotal(strings.NewReader("MemFree: 1202376 kB\n")); err == nil {
+		t.Errorf("expected error without MemTotal")
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package model
 
 import (
+	"strconv"
+	"strings"
+
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi"
@@ -40,11 +43,22 @@ func (b *NTPBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 		return nil
 	}
 
+	ntpServers := b.NodeupConfig.NTPServers
+
 	switch b.Distribution {
 	case distributions.DistributionContainerOS:
+		if len(ntpServers) != 0 {
+			klog.Warningf("Detected ContainerOS; won't configure NTP servers %v", ntpServers)
+		}
 		klog.Infof("Detected ContainerOS; won't install ntp")
 		return nil
 	case distributions.DistributionFlatcar:
+		// Flatcar synchronizes the clock with systemd-timesyncd, which we only configure for custom servers
+		if len(ntpServers) != 0 {
+			c.AddTask(b.buildTimesyncdConf("/etc/systemd/timesyncd.conf", ntpServers))
+			c.AddTask((&nodetasks.Service{Name: "systemd-timesyncd"}).InitDefaults())
+			return nil
+		}
 		klog.Infof("Detected Flatcar; won't install ntp")
 		return nil
 	}
@@ -58,22 +72,25 @@ func (b *NTPBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 	default:
 		ntpHost = ""
 	}
+	if len(ntpServers) == 0 && ntpHost != "" {
+		ntpServers = []string{ntpHost}
+	}
 
 	if !b.RunningOnGCE() && !b.RunningOnAzure() && b.Distribution.IsUbuntu() && b.Distribution.Version() <= 20.04 {
-		if ntpHost != "" {
-			c.AddTask(b.buildTimesyncdConf("/etc/systemd/timesyncd.conf", ntpHost))
+		if len(ntpServers) != 0 {
+			c.AddTask(b.buildTimesyncdConf("/etc/systemd/timesyncd.conf", ntpServers))
 		}
 		c.AddTask((&nodetasks.Service{Name: "systemd-timesyncd"}).InitDefaults())
 	} else if b.Distribution.IsDebianFamily() {
 		c.AddTask(&nodetasks.Package{Name: "chrony"})
-		if ntpHost != "" {
-			c.AddTask(b.buildChronydConf("/etc/chrony/chrony.conf", ntpHost))
+		if len(ntpServers) != 0 {
+			c.AddTask(b.buildChronydConf("/etc/chrony/chrony.conf", ntpServers))
 		}
 		c.AddTask((&nodetasks.Service{Name: "chrony"}).InitDefaults())
 	} else if b.Distribution.IsRHELFamily() {
 		c.AddTask(&nodetasks.Package{Name: "chrony"})
-		if ntpHost != "" {
-			c.AddTask(b.buildChronydConf("/etc/chrony.conf", ntpHost))
+		if len(ntpServers) != 0 {
+			c.AddTask(b.buildChronydConf("/etc/chrony.conf", ntpServers))
 		}
 		c.AddTask((&nodetasks.Service{Name: "chronyd"}).InitDefaults())
 	} else {
@@ -84,14 +101,34 @@ func (b *NTPBuilder) Build(c *fi.NodeupModelBuilderContext) error {
 	return nil
 }
 
-func (b *NTPBuilder) buildChronydConf(path string, host string) *nodetasks.File {
+func (b *NTPBuilder) buildChronydConf(path string, servers []string) *nodetasks.File {
+	var sources strings.Builder
+	if len(b.NodeupConfig.NTPServers) == 0 {
+		// The NTP service of the cloud provider
+		sources.WriteString("pool " + servers[0] + " prefer iburst\n")
+	} else {
+		for _, server := range servers {
+			sources.WriteString("server " + server + " iburst\n")
+		}
+	}
+
+	makeStepThreshold := "1.0"
+	makeStepLimit := "3"
+	if makeStep := b.NodeupConfig.NTPMakeStep; makeStep != nil {
+		if makeStep.Threshold != nil {
+			makeStepThreshold = strconv.FormatFloat(makeStep.Threshold.Seconds(), 'f', -1, 64)
+		}
+		if makeStep.Limit != nil {
+			makeStepLimit = strconv.Itoa(int(*makeStep.Limit))
+		}
+	}
+
 	conf := `# Built by kOps - do NOT edit
 
-pool ` + host + ` prefer iburst
-driftfile /var/lib/chrony/drift
+` + sources.String() + `driftfile /var/lib/chrony/drift
 leapsectz right/UTC
 logdir /var/log/chrony
-makestep 1.0 3
+makestep ` + makeStepThreshold + ` ` + makeStepLimit + `
 maxupdateskew 100.0
 rtcsync
 `
@@ -103,11 +140,16 @@ rtcsync
 	}
 }
 
-func (b *NTPBuilder) buildTimesyncdConf(path string, host string) *nodetasks.File {
+func (b *NTPBuilder) buildTimesyncdConf(path string, servers []string) *nodetasks.File {
+	// systemd-timesyncd steps the clock when the offset is large, makestep has no equivalent
+	if b.NodeupConfig.NTPMakeStep != nil {
+		klog.Warningf("makeStep is not supported by systemd-timesyncd, ignoring")
+	}
+
 	conf := `# Built by Kops - do NOT edit
 
 [Time]
-NTP=` + host + `
+NTP=` + strings.Join(servers, " ") + `
 `
 	return &nodetasks.File{
 		Path:     path,
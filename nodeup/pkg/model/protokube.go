@@ -28,6 +28,7 @@ import (
 	"k8s.io/kops/pkg/flagbuilder"
 	"k8s.io/kops/pkg/rbac"
 	"k8s.io/kops/pkg/systemd"
+	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/scaleway"
 	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
@@ -170,6 +171,9 @@ type ProtokubeFlags struct {
 	GossipProtocolSecondary *string `json:"gossip-protocol-secondary" flag:"gossip-protocol-secondary" flag-include-empty:"true"`
 	GossipListenSecondary   *string `json:"gossip-listen-secondary" flag:"gossip-listen-secondary"`
 	GossipSecretSecondary   *string `json:"gossip-secret-secondary" flag:"gossip-secret-secondary"`
+
+	// MetricsListen is the address on which protokube serves Prometheus metrics.
+	MetricsListen *string `json:"metricsListen,omitempty" flag:"metrics-listen"`
 }
 
 // ProtokubeFlags is responsible for building the command line flags for protokube
@@ -224,6 +228,10 @@ func (t *ProtokubeBuilder) ProtokubeFlags() (*ProtokubeFlags, error) {
 		f.DNSInternalSuffix = fi.PtrTo(".internal." + t.NodeupConfig.ClusterName)
 	}
 
+	if t.NodeupConfig.ComponentMetrics {
+		f.MetricsListen = fi.PtrTo(fmt.Sprintf(":%d", wellknownports.ProtokubeMetrics))
+	}
+
 	f.BootstrapMasterNodeLabels = true
 
 	nodeName, err := t.NodeName()
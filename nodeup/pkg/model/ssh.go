@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/nodeup/nodetasks"
+	"k8s.io/kops/util/pkg/distributions"
+)
+
+const (
+	sshCertificateAuthoritiesPath = "/etc/ssh/kops-ssh-ca.pub"
+	sshAuthorizedKeysDir          = "/etc/ssh/kops-authorized-keys"
+)
+
+// SSHBuilder configures sshd to trust the SSH certificate authority and the additional authorized keys.
+type SSHBuilder struct {
+	*NodeupModelContext
+}
+
+var _ fi.NodeupModelBuilder = &SSHBuilder{}
+
+// Build is responsible for configuring sshd
+func (b *SSHBuilder) Build(c *fi.NodeupModelBuilderContext) error {
+	if len(b.NodeupConfig.SSHCertificateAuthorities) == 0 && len(b.NodeupConfig.SSHAuthorizedKeys) == 0 {
+		return nil
+	}
+
+	if b.Distribution == distributions.DistributionContainerOS {
+		klog.Infof("Detected ContainerOS; won't configure sshd")
+		return nil
+	}
+
+	// sshd uses the first value it reads for each setting, and includes sshd_config.d before the distribution's settings
+	sshdConfig := []string{"# Managed by kOps"}
+
+	if len(b.NodeupConfig.SSHCertificateAuthorities) != 0 {
+		c.AddTask(&nodetasks.File{
+			Path:     sshCertificateAuthoritiesPath,
+			Contents: fi.NewStringResource(strings.Join(b.NodeupConfig.SSHCertificateAuthorities, "\n") + "\n"),
+			Type:     nodetasks.FileType_File,
+			Mode:     fi.PtrTo("0644"),
+		})
+		sshdConfig = append(sshdConfig, "TrustedUserCAKeys "+sshCertificateAuthoritiesPath)
+	}
+
+	if len(b.NodeupConfig.SSHAuthorizedKeys) != 0 {
+		authorizedKeys := make(map[string][]string)
+		for _, keys := range b.NodeupConfig.SSHAuthorizedKeys {
+			authorizedKeys[keys.User] = append(authorizedKeys[keys.User], keys.PublicKeys...)
+		}
+		for user, keys := range authorizedKeys {
+			c.AddTask(&nodetasks.File{
+				Path:     filepath.Join(sshAuthorizedKeysDir, user),
+				Contents: fi.NewStringResource(strings.Join(keys, "\n") + "\n"),
+				Type:     nodetasks.FileType_File,
+				Mode:     fi.PtrTo("0644"),
+			})
+		}
+		sshdConfig = append(sshdConfig, "AuthorizedKeysFile .ssh/authorized_keys .ssh/authorized_keys2 "+sshAuthorizedKeysDir+"/%u")
+	}
+
+	c.AddTask(&nodetasks.File{
+		Path:            "/etc/ssh/sshd_config.d/40-kops.conf",
+		Contents:        fi.NewStringResource(strings.Join(sshdConfig, "\n") + "\n"),
+		Type:            nodetasks.FileType_File,
+		Mode:            fi.PtrTo("0644"),
+		OnChangeExecute: [][]string{{"systemctl", "try-reload-or-restart", "sshd.service"}},
+	})
+
+	return nil
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"testing"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestSSHBuilder(t *testing.T) {
+	RunGoldenTest(t, "tests/golden/ssh", "ssh", func(nodeupModelContext *NodeupModelContext, target *fi.NodeupModelBuilderContext) error {
+		// The CA public keys are added from the "ssh-ca" keyset by the nodeup config builder
+		nodeupModelContext.NodeupConfig.SSHCertificateAuthorities = []string{
+			"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMh7gwcG+FH+X5PmV4D4X8HxWZe3uXh7KZd7JZ1Zl5pA",
+		}
+		builder := SSHBuilder{NodeupModelContext: nodeupModelContext}
+		return builder.Build(target)
+	})
+}
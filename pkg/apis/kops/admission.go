@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kops
+
+// AdmissionSpec configures the admission of the objects sent to the Kubernetes API.
+type AdmissionSpec struct {
+	// PodSecurity sets the defaults of the Pod Security admission controller.
+	PodSecurity *PodSecurityAdmissionSpec `json:"podSecurity,omitempty"`
+	// ValidatingAdmissionPolicies is a YAML manifest of ValidatingAdmissionPolicy and ValidatingAdmissionPolicyBinding objects,
+	// which are installed and kept up to date as a managed addon.
+	ValidatingAdmissionPolicies string `json:"validatingAdmissionPolicies,omitempty"`
+}
+
+// PodSecurityAdmissionSpec sets the Pod Security Standards applied to the namespaces
+// that do not have the pod-security.kubernetes.io labels.
+type PodSecurityAdmissionSpec struct {
+	// Enforce is the level whose violations are rejected (privileged, baseline, restricted).
+	// Default: privileged
+	Enforce PodSecurityLevel `json:"enforce,omitempty"`
+	// Audit is the level whose violations are recorded in the audit log (privileged, baseline, restricted).
+	// Default: privileged
+	Audit PodSecurityLevel `json:"audit,omitempty"`
+	// Warn is the level whose violations are returned as warnings to the client (privileged, baseline, restricted).
+	// Default: privileged
+	Warn PodSecurityLevel `json:"warn,omitempty"`
+	// Version is the Kubernetes version of the Pod Security Standards, such as v1.30.
+	// Default: latest
+	Version string `json:"version,omitempty"`
+	// ExemptNamespaces are the namespaces whose pods are not checked.
+	ExemptNamespaces []string `json:"exemptNamespaces,omitempty"`
+	// ExemptUsernames are the users whose requests are not checked.
+	ExemptUsernames []string `json:"exemptUsernames,omitempty"`
+	// ExemptRuntimeClasses are the RuntimeClasses whose pods are not checked.
+	ExemptRuntimeClasses []string `json:"exemptRuntimeClasses,omitempty"`
+}
+
+// PodSecurityLevel is a level of the Pod Security Standards
+type PodSecurityLevel string
+
+const (
+	PodSecurityLevelPrivileged PodSecurityLevel = "privileged"
+	PodSecurityLevelBaseline   PodSecurityLevel = "baseline"
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+)
+
+var SupportedPodSecurityLevels = []PodSecurityLevel{
+	PodSecurityLevelPrivileged,
+	PodSecurityLevelBaseline,
+	PodSecurityLevelRestricted,
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package kops
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -243,8 +245,21 @@ type KubeletConfigSpec struct {
 	// MemorySwapBehavior defines how swap is used by container workloads.
 	// Supported values: LimitedSwap, "UnlimitedSwap.
 	MemorySwapBehavior string `json:"memorySwapBehavior,omitempty"`
+	// ReservedResourcesMode is how the resources reserved for the system and Kubernetes daemons are determined.
+	// Supported values: Static, Auto.
+	// In Auto mode, kubeReserved and the memory.available hard eviction threshold are computed
+	// from the memory and CPU of the instance, unless they are set explicitly.
+	// Default: Static
+	ReservedResourcesMode string `json:"reservedResourcesMode,omitempty"`
 }
 
+const (
+	// KubeletReservedResourcesModeStatic uses the configured kubeReserved, systemReserved and evictionHard
+	KubeletReservedResourcesModeStatic = "Static"
+	// KubeletReservedResourcesModeAuto computes the reserved resources from the capacity of the instance
+	KubeletReservedResourcesModeAuto = "Auto"
+)
+
 // KubeProxyConfig defines the configuration for a proxy
 type KubeProxyConfig struct {
 	Image string `json:"image,omitempty"`
@@ -749,9 +764,15 @@ type CloudControllerManagerConfig struct {
 	UseServiceAccountCredentials *bool `json:"useServiceAccountCredentials,omitempty" flag:"use-service-account-credentials"`
 	// EnableLeaderMigration enables controller leader migration.
 	EnableLeaderMigration *bool `json:"enableLeaderMigration,omitempty" flag:"enable-leader-migration"`
-	// CPURequest of NodeTerminationHandler container.
+	// CPURequest of CloudControllerManager container.
 	// Default: 200m
 	CPURequest *resource.Quantity `json:"cpuRequest,omitempty"`
+	// CPULimit of CloudControllerManager container.
+	CPULimit *resource.Quantity `json:"cpuLimit,omitempty"`
+	// MemoryRequest of CloudControllerManager container.
+	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
+	// MemoryLimit of CloudControllerManager container.
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
 	// NodeStatusUpdateFrequency is the duration between node status updates. (default: 5m)
 	NodeStatusUpdateFrequency *metav1.Duration `json:"nodeStatusUpdateFrequency,omitempty" flag:"node-status-update-frequency"`
 	// ConcurrentNodeSyncs is the number of workers concurrently synchronizing nodes. (default: 1)
@@ -814,6 +835,66 @@ type KubeSchedulerConfig struct {
 	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
 	// MemoryLimit, memory limit compute resource for scheduler e.g. "30Mi"
 	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
+	// PercentageOfNodesToScore is the percentage of all nodes that, once found feasible for running a pod,
+	// make kube-scheduler stop searching for more feasible nodes.
+	PercentageOfNodesToScore *int32 `json:"percentageOfNodesToScore,omitempty" config:"percentageOfNodesToScore,omitempty"`
+	// Profiles are the scheduling profiles of kube-scheduler, written to its configuration file.
+	// Pods select a profile by its scheduler name.
+	Profiles []KubeSchedulerProfile `json:"profiles,omitempty"`
+}
+
+// KubeSchedulerProfile is a scheduling profile of kube-scheduler
+type KubeSchedulerProfile struct {
+	// SchedulerName is the name of the profile. Default: default-scheduler
+	SchedulerName string `json:"schedulerName,omitempty"`
+	// PercentageOfNodesToScore overrides the percentage of nodes to score for the profile.
+	PercentageOfNodesToScore *int32 `json:"percentageOfNodesToScore,omitempty"`
+	// Plugins enables and disables plugins at the extension points of the profile.
+	Plugins *KubeSchedulerPlugins `json:"plugins,omitempty"`
+	// PluginConfig holds the arguments of the plugins of the profile.
+	PluginConfig []KubeSchedulerPluginConfig `json:"pluginConfig,omitempty"`
+}
+
+// KubeSchedulerPlugins are the plugins enabled and disabled at each extension point of a scheduling profile
+type KubeSchedulerPlugins struct {
+	PreEnqueue *KubeSchedulerPluginSet `json:"preEnqueue,omitempty"`
+	QueueSort  *KubeSchedulerPluginSet `json:"queueSort,omitempty"`
+	PreFilter  *KubeSchedulerPluginSet `json:"preFilter,omitempty"`
+	Filter     *KubeSchedulerPluginSet `json:"filter,omitempty"`
+	PostFilter *KubeSchedulerPluginSet `json:"postFilter,omitempty"`
+	PreScore   *KubeSchedulerPluginSet `json:"preScore,omitempty"`
+	Score      *KubeSchedulerPluginSet `json:"score,omitempty"`
+	Reserve    *KubeSchedulerPluginSet `json:"reserve,omitempty"`
+	Permit     *KubeSchedulerPluginSet `json:"permit,omitempty"`
+	PreBind    *KubeSchedulerPluginSet `json:"preBind,omitempty"`
+	Bind       *KubeSchedulerPluginSet `json:"bind,omitempty"`
+	PostBind   *KubeSchedulerPluginSet `json:"postBind,omitempty"`
+	// MultiPoint enables and disables plugins at all the extension points they implement.
+	MultiPoint *KubeSchedulerPluginSet `json:"multiPoint,omitempty"`
+}
+
+// KubeSchedulerPluginSet lists the plugins enabled and disabled at an extension point
+type KubeSchedulerPluginSet struct {
+	// Enabled are the plugins enabled in addition to the default plugins.
+	Enabled []KubeSchedulerPlugin `json:"enabled,omitempty"`
+	// Disabled are the default plugins which are disabled. "*" disables all the default plugins.
+	Disabled []KubeSchedulerPlugin `json:"disabled,omitempty"`
+}
+
+// KubeSchedulerPlugin identifies a kube-scheduler plugin
+type KubeSchedulerPlugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name,omitempty"`
+	// Weight is the weight of the plugin, only used by score plugins.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// KubeSchedulerPluginConfig holds the arguments of a kube-scheduler plugin
+type KubeSchedulerPluginConfig struct {
+	// Name is the name of the plugin.
+	Name string `json:"name,omitempty"`
+	// Args are the arguments of the plugin, in YAML, such as the scoringStrategy of NodeResourcesFit.
+	Args string `json:"args,omitempty"`
 }
 
 // LeaderElectionConfiguration defines the configuration of leader election
@@ -973,15 +1054,53 @@ type EBSCSIDriverSpec struct {
 	// Default: -
 	VolumeAttachLimit *int `json:"volumeAttachLimit,omitempty"`
 
+	// ReservedVolumeAttachments is the number of volume attachments reserved for volumes not managed by the driver,
+	// such as the root volume, when the limit is approximated from the instance type.
+	// Default: -
+	ReservedVolumeAttachments *int `json:"reservedVolumeAttachments,omitempty"`
+
 	// PodAnnotations are the annotations added to AWS EBS CSI node and controller Pods.
 	// Default: none
 	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+
+	// DefaultStorageClass configures the default StorageClass of the driver.
+	DefaultStorageClass *CSIStorageClassSpec `json:"defaultStorageClass,omitempty"`
+	// SidecarResources sets the resources of the sidecar containers of the driver.
+	SidecarResources *CSISidecarResources `json:"sidecarResources,omitempty"`
 }
 
 // PDCSIDriver is the config for the GCP PD CSI driver
 type PDCSIDriver struct {
 	// Enabled enables the GCP PD CSI driver
 	Enabled *bool `json:"enabled,omitempty"`
+	// DefaultStorageClass configures the default StorageClass of the driver.
+	DefaultStorageClass *CSIStorageClassSpec `json:"defaultStorageClass,omitempty"`
+	// SidecarResources sets the resources of the sidecar containers of the driver.
+	SidecarResources *CSISidecarResources `json:"sidecarResources,omitempty"`
+}
+
+// CSIStorageClassSpec configures the default StorageClass of a CSI driver
+type CSIStorageClassSpec struct {
+	// Type is the type of the volumes, such as gp3 on AWS or pd-balanced on GCE.
+	// Default: gp3 on AWS, pd-balanced on GCE
+	Type string `json:"type,omitempty"`
+	// KMSKeyID is the KMS key used to encrypt the volumes.
+	// Default: the default key of the cloud provider
+	KMSKeyID string `json:"kmsKeyID,omitempty"`
+	// Zones restricts the volumes to the given zones.
+	// Default: all zones
+	Zones []string `json:"zones,omitempty"`
+}
+
+// CSISidecarResources are the resources of the sidecar containers of a CSI driver,
+// such as the provisioner, attacher, resizer, snapshotter, node driver registrar and liveness probe.
+type CSISidecarResources struct {
+	// CPURequest of each sidecar container.
+	CPURequest *resource.Quantity `json:"cpuRequest,omitempty"`
+	// MemoryRequest of each sidecar container.
+	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
+	// MemoryLimit of each sidecar container.
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
 }
 
 // SnapshotControllerConfig is the config for the CSI Snapshot Controller
@@ -992,6 +1111,30 @@ type SnapshotControllerConfig struct {
 	InstallDefaultClass bool `json:"installDefaultClass,omitempty"`
 }
 
+// VerticalPodAutoscalerConfig defines the Vertical Pod Autoscaler configuration.
+type VerticalPodAutoscalerConfig struct {
+	// Enabled enables the Vertical Pod Autoscaler.
+	// Requires that metrics server is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+	// RecommenderOnly only runs the recommender, which publishes the recommended resources in the status of
+	// VerticalPodAutoscaler objects without applying them. Otherwise the updater and admission controller,
+	// which require that cert manager is enabled, apply the recommendations to pods.
+	// Default: false
+	RecommenderOnly *bool `json:"recommenderOnly,omitempty"`
+}
+
+// KonnectivityConfig configures the Konnectivity service (apiserver network proxy).
+type KonnectivityConfig struct {
+	// Enabled runs konnectivity-server alongside kube-apiserver and konnectivity-agent on every node.
+	// kube-apiserver then reaches nodes, pods and services through the tunnels opened by the agents,
+	// so the control plane does not need direct connectivity to the nodes.
+	Enabled *bool `json:"enabled,omitempty"`
+	// ServerImage is the container image of konnectivity-server.
+	ServerImage string `json:"serverImage,omitempty"`
+	// AgentImage is the container image of konnectivity-agent.
+	AgentImage string `json:"agentImage,omitempty"`
+}
+
 // NodeTerminationHandlerSpec determines the node termination handler configuration.
 type NodeTerminationHandlerSpec struct {
 	// DeleteSQSMsgIfNodeNotFound makes node termination handler delete the SQS Message from the SQS Queue if the targeted node is not found.
@@ -1093,6 +1236,8 @@ type ClusterAutoscalerConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
 	// Expander determines the strategy for which instance group gets expanded.
 	// Supported values: least-waste, most-pods, random, price, priority.
+	// Several expanders can be chained as a comma-separated list, such as "priority,least-waste";
+	// each expander is used to break ties between the instance groups selected by the previous one.
 	// The price expander is only supported on GCE.
 	// By default, kOps will generate the priority expander ConfigMap based on the `autoscale` and `autoscalePriority` fields in the InstanceGroup specs.
 	// Default: least-waste
@@ -1156,6 +1301,22 @@ type ClusterAutoscalerConfig struct {
 	// CustomPriorityExpanderConfig overides the priority-expander ConfigMap with the provided configuration. Any InstanceGroup configuration will be ignored if this is set.
 	// This could be useful in order to use regex on priorities configuration
 	CustomPriorityExpanderConfig map[string][]string `json:"customPriorityExpanderConfig,omitempty"`
+	// BalancingLabels are the node labels compared to decide whether node groups are similar, instead of their resources and labels.
+	// Only used when balanceSimilarNodeGroups is enabled.
+	BalancingLabels []string `json:"balancingLabels,omitempty"`
+	// BalancingIgnoreLabels are node labels ignored when deciding whether node groups are similar.
+	// Only used when balanceSimilarNodeGroups is enabled.
+	BalancingIgnoreLabels []string `json:"balancingIgnoreLabels,omitempty"`
+}
+
+// UsesExpander returns true if the named expander is part of the expander chain
+func (c *ClusterAutoscalerConfig) UsesExpander(name string) bool {
+	for _, expander := range strings.Split(c.Expander, ",") {
+		if expander == name {
+			return true
+		}
+	}
+	return false
 }
 
 // MetricsServerConfig determines the metrics server configuration.
@@ -1198,6 +1359,11 @@ type CertManagerConfig struct {
 
 	// FeatureGates is a list of experimental features that can be enabled or disabled.
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// UseClusterCA backs the cert-manager issuers of the addons managed by kOps with the cluster CA,
+	// instead of with a CA created for each addon, for their webhook, metrics and aggregated API certificates.
+	// Default: false
+	UseClusterCA *bool `json:"useClusterCA,omitempty"`
 }
 
 // LoadBalancerControllerSpec determines the AWS LB controller configuration.
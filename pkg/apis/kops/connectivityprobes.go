@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kops
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// ConnectivityProbesSpec configures kops-controller to probe, from every node, the endpoints the node depends on:
+// the API load balancer, the etcd peers of control plane nodes, the state store and the container registry.
+type ConnectivityProbesSpec struct {
+	// Interval is how often the endpoints are probed.
+	// Default: 1m
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Timeout is how long to wait for a connection to an endpoint.
+	// Default: 5s
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// AdditionalTargets are further endpoints, in the form host:port, probed from every node.
+	AdditionalTargets []string `json:"additionalTargets,omitempty"`
+}
+
+// NodeConditionConnectivity is the type of the node condition holding the result of the connectivity probes.
+const NodeConditionConnectivity = "KopsConnectivity"
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kops
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// GitOpsSpec configures kops-controller to continuously reconcile the cluster with its desired configuration.
+type GitOpsSpec struct {
+	// Mode is either Reconcile, to apply any changes to the cloud resources and addons,
+	// or DriftReport, to only report the changes that would be made.
+	// Default: DriftReport
+	Mode GitOpsMode `json:"mode,omitempty"`
+	// Interval is how often the cluster is reconciled.
+	// Default: 10m
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Source is the location of the Cluster and InstanceGroup manifests of the cluster, such as the raw URL
+	// of a file at a Git ref or an object in a bucket. In Reconcile mode the manifests are written to the
+	// state store before the cluster is reconciled. If not set, the state store is the desired configuration.
+	Source string `json:"source,omitempty"`
+	// GrantPermissions grants the control-plane nodes the AWS IAM permissions kops-controller needs to compare the cloud
+	// resources with the desired configuration, and in Reconcile mode to manage them as "kops update cluster --yes" does.
+	GrantPermissions bool `json:"grantPermissions,omitempty"`
+}
+
+// GitOpsMode is how kops-controller acts on changes to the cluster
+type GitOpsMode string
+
+const (
+	// GitOpsModeReconcile applies any changes to the cloud resources and addons
+	GitOpsModeReconcile GitOpsMode = "Reconcile"
+	// GitOpsModeDriftReport only reports the changes that would be made
+	GitOpsModeDriftReport GitOpsMode = "DriftReport"
+)
+
+// ReconcileEnabled returns true if kops-controller should apply changes to the cluster.
+func (g *GitOpsSpec) ReconcileEnabled() bool {
+	return g != nil && g.Mode == GitOpsModeReconcile
+}
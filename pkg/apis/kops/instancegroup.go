@@ -85,6 +85,16 @@ const (
 // SupportedFilesystems is a list of supported filesystems to format as
 var SupportedFilesystems = []string{BtfsFilesystem, Ext4Filesystem, XFSFilesystem}
 
+// InstanceGroupOperatingSystem is the operating system of the nodes of an InstanceGroup.
+type InstanceGroupOperatingSystem string
+
+const (
+	// InstanceGroupOperatingSystemLinux is the default operating system.
+	InstanceGroupOperatingSystemLinux InstanceGroupOperatingSystem = "Linux"
+	// InstanceGroupOperatingSystemWindows is the Windows operating system, for worker nodes only.
+	InstanceGroupOperatingSystemWindows InstanceGroupOperatingSystem = "Windows"
+)
+
 type InstanceManager string
 
 const (
@@ -98,8 +108,13 @@ type InstanceGroupSpec struct {
 	Manager InstanceManager `json:"manager,omitempty"`
 	// Role determines the role of instances in this instance group.
 	Role InstanceGroupRole `json:"role,omitempty"`
+	// OperatingSystem is the operating system of the instances: Linux (default) or Windows.
+	OperatingSystem InstanceGroupOperatingSystem `json:"operatingSystem,omitempty"`
 	// Image is the instance (ami etc) we should use
 	Image string `json:"image,omitempty"`
+	// ImageChannel is the image channel, such as ubuntu-24.04-stable, whose latest image is pinned at update time
+	// and advanced by kops promote image. It cannot be set together with image.
+	ImageChannel string `json:"imageChannel,omitempty"`
 	// MinSize is the minimum size of the pool
 	MinSize *int32 `json:"minSize,omitempty"`
 	// MaxSize is the maximum size of the pool
@@ -139,12 +154,17 @@ type InstanceGroupSpec struct {
 	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
 	// FileAssets is a collection of file assets for this instance group
 	FileAssets []FileAssetSpec `json:"fileAssets,omitempty"`
+	// SSHAuthorizedKeys are additional SSH public keys that can log in to the instances.
+	SSHAuthorizedKeys []SSHAuthorizedKeysSpec `json:"sshAuthorizedKeys,omitempty"`
 	// Describes the tenancy of this instance group. Can be either default or dedicated. Currently only applies to AWS.
 	Tenancy string `json:"tenancy,omitempty"`
 	// Kubelet overrides kubelet config from the ClusterSpec
 	Kubelet *KubeletConfigSpec `json:"kubelet,omitempty"`
 	// Taints indicates the kubernetes taints for nodes in this instance group
 	Taints []string `json:"taints,omitempty"`
+	// ArchitectureTaint taints the nodes with kubernetes.io/arch=<architecture>:NoSchedule, so that only the pods
+	// tolerating the architecture of the instance group are scheduled on its nodes.
+	ArchitectureTaint bool `json:"architectureTaint,omitempty"`
 	// MixedInstancesPolicy defined a optional backing of an AWS ASG by a EC2 Fleet (AWS Only)
 	MixedInstancesPolicy *MixedInstancesPolicySpec `json:"mixedInstancesPolicy,omitempty"`
 	// CapacityRebalance makes ASGs proactively replace spot instances when the ASG receives a rebalance recommendation (AWS Only).
@@ -167,6 +187,10 @@ type InstanceGroupSpec struct {
 	// specified, each parameter must follow the form variable=value, the way
 	// it would appear in sysctl.conf.
 	SysctlParameters []string `json:"sysctlParameters,omitempty"`
+	// Journald configures the limits of the systemd journal on the instances.
+	Journald *JournaldSpec `json:"journald,omitempty"`
+	// EtcdIOIsolation isolates the IO of the etcd volumes of the instances from their other workloads (control plane only).
+	EtcdIOIsolation *EtcdIOIsolationSpec `json:"etcdIOIsolation,omitempty"`
 	// RollingUpdate defines the rolling-update behavior
 	RollingUpdate *RollingUpdate `json:"rollingUpdate,omitempty"`
 	// InstanceInterruptionBehavior defines if a spot instance should be terminated, hibernated,
@@ -198,6 +222,64 @@ type InstanceGroupSpec struct {
 	//   'STANDARD': (default) standard provisioning with user controlled run time, no discounts
 	//   'SPOT': heavily discounted, no guaranteed run time.
 	GCPProvisioningModel *string `json:"gcpProvisioningModel,omitempty"`
+	// ClusterAutoscaler overrides the cluster autoscaler configuration for this instance group. Only supported on AWS.
+	ClusterAutoscaler *InstanceGroupClusterAutoscalerSpec `json:"clusterAutoscaler,omitempty"`
+	// PreferSpot runs the instances on spot (AWS) or preemptible (GCE) capacity, falling back to on-demand capacity
+	// when kOps finds that no spot capacity is available for the group.
+	// Control plane instance groups additionally require the UnsafeControlPlaneOnSpot feature flag.
+	PreferSpot *bool `json:"preferSpot,omitempty"`
+}
+
+// SSHAuthorizedKeysSpec is a set of SSH public keys that can log in as a user.
+type SSHAuthorizedKeysSpec struct {
+	// User is the user that the keys can log in as.
+	User string `json:"user"`
+	// PublicKeys are the SSH public keys, in authorized_keys format.
+	PublicKeys []string `json:"publicKeys"`
+}
+
+// JournaldSpec configures the limits of the systemd journal, so that noisy services cannot fill the root disk.
+type JournaldSpec struct {
+	// SystemMaxUse is the maximum disk space used by the persistent journal, for example 1G.
+	SystemMaxUse string `json:"systemMaxUse,omitempty"`
+	// RateLimitInterval is the interval over which the messages logged by each service are rate limited.
+	RateLimitInterval *metav1.Duration `json:"rateLimitInterval,omitempty"`
+	// RateLimitBurst is the number of messages a service can log during RateLimitInterval before further messages are dropped.
+	RateLimitBurst *int32 `json:"rateLimitBurst,omitempty"`
+}
+
+// EtcdIOIsolationSpec configures the isolation of the IO of the etcd volumes of control plane instances,
+// so that the other workloads of the instances cannot starve etcd of disk bandwidth.
+type EtcdIOIsolationSpec struct {
+	// Scheduler is the IO scheduler of the disks of the etcd volumes: none, mq-deadline, bfq or kyber.
+	// The scheduler is left unchanged if not set.
+	Scheduler string `json:"scheduler,omitempty"`
+	// MaxReadBandwidth limits the bytes per second read by the pods and by containerd from the root disk.
+	MaxReadBandwidth *resource.Quantity `json:"maxReadBandwidth,omitempty"`
+	// MaxWriteBandwidth limits the bytes per second written by the pods and by containerd to the root disk.
+	MaxWriteBandwidth *resource.Quantity `json:"maxWriteBandwidth,omitempty"`
+	// MaxReadIOPS limits the read operations per second of the pods and of containerd on the root disk.
+	MaxReadIOPS *int64 `json:"maxReadIOPS,omitempty"`
+	// MaxWriteIOPS limits the write operations per second of the pods and of containerd on the root disk.
+	MaxWriteIOPS *int64 `json:"maxWriteIOPS,omitempty"`
+}
+
+// InstanceGroupClusterAutoscalerSpec overrides the cluster autoscaler configuration for an instance group.
+// The settings are rendered as tags of the autoscaling group, so are only supported on AWS.
+type InstanceGroupClusterAutoscalerSpec struct {
+	// ScaleDownDisabled prevents the cluster autoscaler from removing nodes of the instance group.
+	// Default: false
+	ScaleDownDisabled *bool `json:"scaleDownDisabled,omitempty"`
+	// ScaleDownUtilizationThreshold overrides the utilization threshold for scale-down of the instance group's nodes.
+	ScaleDownUtilizationThreshold *string `json:"scaleDownUtilizationThreshold,omitempty"`
+	// ScaleDownUnneededTime overrides how long a node of the instance group should be unneeded before it is eligible for scale down.
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty"`
+	// ScaleDownUnreadyTime overrides how long an unready node of the instance group should be unneeded before it is eligible for scale down.
+	ScaleDownUnreadyTime *metav1.Duration `json:"scaleDownUnreadyTime,omitempty"`
+	// MaxNodeProvisionTime overrides how long the cluster autoscaler waits for a node of the instance group to join the cluster.
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty"`
+	// IgnoreDaemonSetsUtilization overrides whether DaemonSet pods are ignored when calculating the utilization of the instance group's nodes.
+	IgnoreDaemonSetsUtilization *bool `json:"ignoreDaemonSetsUtilization,omitempty"`
 }
 
 const (
@@ -374,6 +456,11 @@ func (g *InstanceGroup) IsBastion() bool {
 	}
 }
 
+// IsWindows checks if the instances of the instanceGroup run Windows
+func (g *InstanceGroup) IsWindows() bool {
+	return g.Spec.OperatingSystem == InstanceGroupOperatingSystemWindows
+}
+
 func (g *InstanceGroup) AddInstanceGroupNodeLabel() {
 	if g.Spec.NodeLabels == nil {
 		g.Spec.NodeLabels = make(map[string]string)
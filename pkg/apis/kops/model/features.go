@@ -18,6 +18,7 @@ package model
 
 import (
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/featureflag"
 )
 
 // UseChallengeCallback is true if we should use a callback challenge during node provisioning with kops-controller.
@@ -52,6 +53,34 @@ func UseKopsControllerForNodeConfig(cluster *kops.Cluster) bool {
 	return true
 }
 
+// CanUseAPIServerNodes is true if the cluster can run kube-apiserver on instance groups with the APIServer role.
+// Without the APIServerNodes feature flag, this needs the Large control plane profile on AWS with DNS.
+func CanUseAPIServerNodes(cluster *kops.Cluster) bool {
+	if featureflag.APIServerNodes.Enabled() {
+		return true
+	}
+	return cluster.Spec.ControlPlaneProfile == kops.ControlPlaneProfileLarge &&
+		cluster.GetCloudProvider() == kops.CloudProviderAWS &&
+		!cluster.UsesNoneDNS()
+}
+
+// UseAPIServerNodes is true if kube-apiserver runs on instance groups with the APIServer role,
+// so that etcd and kops-controller must be reachable from outside the control plane nodes.
+func UseAPIServerNodes(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) bool {
+	if featureflag.APIServerNodes.Enabled() {
+		return true
+	}
+	if !CanUseAPIServerNodes(cluster) {
+		return false
+	}
+	for _, ig := range instanceGroups {
+		if ig.IsAPIServerOnly() {
+			return true
+		}
+	}
+	return false
+}
+
 // UseCiliumEtcd is true if we are using the Cilium etcd cluster.
 func UseCiliumEtcd(cluster *kops.Cluster) bool {
 	if cluster.Spec.Networking.Cilium == nil {
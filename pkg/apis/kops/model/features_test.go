@@ -80,3 +80,88 @@ func TestUseCiliumEtcd(t *testing.T) {
 		}
 	}
 }
+
+func TestUseAPIServerNodes(t *testing.T) {
+	apiServerIG := &kops.InstanceGroup{Spec: kops.InstanceGroupSpec{Role: kops.InstanceGroupRoleAPIServer}}
+	nodeIG := &kops.InstanceGroup{Spec: kops.InstanceGroupSpec{Role: kops.InstanceGroupRoleNode}}
+
+	for _, tc := range []struct {
+		name           string
+		cluster        *kops.Cluster
+		instanceGroups []*kops.InstanceGroup
+		canUse         bool
+		use            bool
+	}{
+		{
+			name: "large aws with apiserver group",
+			cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider:       kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+					ControlPlaneProfile: kops.ControlPlaneProfileLarge,
+				},
+			},
+			instanceGroups: []*kops.InstanceGroup{nodeIG, apiServerIG},
+			canUse:         true,
+			use:            true,
+		},
+		{
+			name: "large aws without apiserver group",
+			cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider:       kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+					ControlPlaneProfile: kops.ControlPlaneProfileLarge,
+				},
+			},
+			instanceGroups: []*kops.InstanceGroup{nodeIG},
+			canUse:         true,
+			use:            false,
+		},
+		{
+			name: "large aws with none dns",
+			cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider:       kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+					ControlPlaneProfile: kops.ControlPlaneProfileLarge,
+					Networking: kops.NetworkingSpec{
+						Topology: &kops.TopologySpec{DNS: kops.DNSTypeNone},
+					},
+				},
+			},
+			instanceGroups: []*kops.InstanceGroup{nodeIG, apiServerIG},
+			canUse:         false,
+			use:            false,
+		},
+		{
+			name: "large gce",
+			cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider:       kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+					ControlPlaneProfile: kops.ControlPlaneProfileLarge,
+				},
+			},
+			instanceGroups: []*kops.InstanceGroup{nodeIG, apiServerIG},
+			canUse:         false,
+			use:            false,
+		},
+		{
+			name: "default aws",
+			cluster: &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				},
+			},
+			instanceGroups: []*kops.InstanceGroup{nodeIG, apiServerIG},
+			canUse:         false,
+			use:            false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := CanUseAPIServerNodes(tc.cluster); actual != tc.canUse {
+				t.Errorf("CanUseAPIServerNodes: expected %v, but got %v", tc.canUse, actual)
+			}
+			if actual := UseAPIServerNodes(tc.cluster, tc.instanceGroups); actual != tc.use {
+				t.Errorf("UseAPIServerNodes: expected %v, but got %v", tc.use, actual)
+			}
+		})
+	}
+}
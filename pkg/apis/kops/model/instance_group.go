@@ -34,6 +34,9 @@ type InstanceGroup interface {
 	// InstallCNIAssets returns true if CNI network plugins need to be installed
 	InstallCNIAssets() bool
 
+	// IsWindows returns true if the instances of the instance group run Windows
+	IsWindows() bool
+
 	// RawClusterSpec returns the cluster spec for the instance group.
 	// If possible, prefer abstracted methods over accessing this data directly.
 	RawClusterSpec() *kops.ClusterSpec
@@ -74,6 +77,10 @@ func (m *instanceGroupModel) InstallCNIAssets() bool {
 	return m.cluster.InstallCNIAssets()
 }
 
+func (m *instanceGroupModel) IsWindows() bool {
+	return m.ig.IsWindows()
+}
+
 func (m *instanceGroupModel) RawClusterSpec() *kops.ClusterSpec {
 	return &m.cluster.Spec
 }
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kops
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// MonitoringSpec configures the observability of the components managed by kOps.
+type MonitoringSpec struct {
+	// Metrics enables the Prometheus metrics endpoints of kops-controller, dns-controller and protokube.
+	// Default: false
+	Metrics *bool `json:"metrics,omitempty"`
+	// ServiceMonitors creates prometheus-operator ServiceMonitor objects for the kops-controller and dns-controller metrics endpoints.
+	// Requires the ServiceMonitor CRD to be installed in the cluster.
+	// Default: false
+	ServiceMonitors *bool `json:"serviceMonitors,omitempty"`
+	// Health publishes the health score of the cluster, as computed by kops validate cluster, as kops-controller metrics.
+	// Requires metrics to be enabled.
+	// Default: false
+	Health *bool `json:"health,omitempty"`
+	// HealthInterval is how often kops-controller computes the health score.
+	// Default: 5m
+	HealthInterval *metav1.Duration `json:"healthInterval,omitempty"`
+	// CertificateExpiry enables kops-controller to report the certificates of the control plane nodes which are about to expire.
+	CertificateExpiry *CertificateExpirySpec `json:"certificateExpiry,omitempty"`
+}
+
+// CertificateExpirySpec configures the reporting of certificates which are about to expire.
+type CertificateExpirySpec struct {
+	// Window is how long before its expiry a certificate is reported, with a Warning event on the node.
+	// Default: 720h
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+// MetricsEnabled returns true if the metrics endpoints of the components managed by kOps are enabled.
+func (m *MonitoringSpec) MetricsEnabled() bool {
+	return m != nil && m.Metrics != nil && *m.Metrics
+}
+
+// ServiceMonitorsEnabled returns true if ServiceMonitor objects should be created for the metrics endpoints.
+func (m *MonitoringSpec) ServiceMonitorsEnabled() bool {
+	return m.MetricsEnabled() && m.ServiceMonitors != nil && *m.ServiceMonitors
+}
+
+// HealthEnabled returns true if kops-controller should publish the health score of the cluster.
+func (m *MonitoringSpec) HealthEnabled() bool {
+	return m.MetricsEnabled() && m.Health != nil && *m.Health
+}
+
+// CertificateExpiryEnabled returns true if kops-controller should report certificates which are about to expire.
+func (m *MonitoringSpec) CertificateExpiryEnabled() bool {
+	return m != nil && m.CertificateExpiry != nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kops
+
+// NotificationsSpec configures the posting of events of the kops operations on the cluster to external sinks,
+// such as chat channels, so that teams see the changes to the infrastructure.
+type NotificationsSpec struct {
+	// Sinks are the destinations the events are posted to.
+	Sinks []NotificationSinkSpec `json:"sinks,omitempty"`
+}
+
+// NotificationSinkSpec is a destination events are posted to.
+type NotificationSinkSpec struct {
+	// Name identifies the sink in logs and errors.
+	Name string `json:"name,omitempty"`
+	// Type is the kind of the sink: Slack, SNS, EventBridge or Webhook.
+	Type NotificationSinkType `json:"type,omitempty"`
+	// URL is the URL of the Slack incoming webhook, or of the webhook the JSON events are posted to.
+	URL string `json:"url,omitempty"`
+	// TopicARN is the ARN of the SNS topic the JSON events are published to.
+	TopicARN string `json:"topicARN,omitempty"`
+	// EventBusARN is the ARN of the EventBridge event bus the events are put on.
+	EventBusARN string `json:"eventBusARN,omitempty"`
+	// Events are the types of the events posted to the sink: Apply, RollingUpdate or Validation.
+	// Default: all of them
+	Events []NotificationEventType `json:"events,omitempty"`
+}
+
+// NotificationSinkType is the kind of a notification sink
+type NotificationSinkType string
+
+const (
+	// NotificationSinkTypeSlack posts a message to a Slack incoming webhook
+	NotificationSinkTypeSlack NotificationSinkType = "Slack"
+	// NotificationSinkTypeSNS publishes the JSON event to an SNS topic
+	NotificationSinkTypeSNS NotificationSinkType = "SNS"
+	// NotificationSinkTypeEventBridge puts the event on an EventBridge event bus
+	NotificationSinkTypeEventBridge NotificationSinkType = "EventBridge"
+	// NotificationSinkTypeWebhook posts the JSON event to a URL
+	NotificationSinkTypeWebhook NotificationSinkType = "Webhook"
+)
+
+// NotificationEventType is the type of the kops operation an event is about
+type NotificationEventType string
+
+const (
+	// NotificationEventTypeApply is sent when kops update cluster --yes applies changes to the cloud
+	NotificationEventTypeApply NotificationEventType = "Apply"
+	// NotificationEventTypeRollingUpdate is sent when kops rolling-update cluster --yes replaces instances
+	NotificationEventTypeRollingUpdate NotificationEventType = "RollingUpdate"
+	// NotificationEventTypeValidation is sent with the result of kops validate cluster
+	NotificationEventTypeValidation NotificationEventType = "Validation"
+)
+
+// SupportedNotificationSinkTypes are the kinds of notification sinks
+var SupportedNotificationSinkTypes = []NotificationSinkType{
+	NotificationSinkTypeSlack,
+	NotificationSinkTypeSNS,
+	NotificationSinkTypeEventBridge,
+	NotificationSinkTypeWebhook,
+}
+
+// SupportedNotificationEventTypes are the types of the events posted to notification sinks
+var SupportedNotificationEventTypes = []NotificationEventType{
+	NotificationEventTypeApply,
+	NotificationEventTypeRollingUpdate,
+	NotificationEventTypeValidation,
+}
+
+// Subscribes returns whether events of a type are posted to the sink.
+func (s *NotificationSinkSpec) Subscribes(eventType NotificationEventType) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, t := range s.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -40,6 +40,10 @@ type TopologySpec struct {
 	// the "jump server".
 	Bastion *BastionSpec `json:"bastion,omitempty"`
 
+	// VPN provides a managed VPN entry point into the network of the cluster,
+	// so private clusters can be reached without a bastion.
+	VPN *VPNSpec `json:"vpn,omitempty"`
+
 	// DNS specifies the environment for hosted DNS zones. (Public, Private, None)
 	DNS DNSType `json:"dns,omitempty"`
 }
@@ -16,12 +16,21 @@ limitations under the License.
 
 package v1alpha2
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 type BastionSpec struct {
 	PublicName string `json:"bastionPublicName,omitempty"`
 	// IdleTimeoutSeconds is unused
 	// +k8s:conversion-gen=false
 	IdleTimeoutSeconds *int64                   `json:"idleTimeoutSeconds,omitempty"`
 	LoadBalancer       *BastionLoadBalancerSpec `json:"loadBalancer,omitempty"`
+	// Managed replaces the bastion instances with a cloud service providing access to the instances.
+	Managed *ManagedBastionSpec `json:"managed,omitempty"`
+	// Access restricts which users can log in to the bastion instances, and from which addresses.
+	// When empty, any user can log in from the addresses allowed by sshAccess.
+	Access []BastionAccessSpec `json:"access,omitempty"`
+	// SessionIdleTimeout closes SSH sessions through the bastion instances after a period without traffic.
+	SessionIdleTimeout *metav1.Duration `json:"sessionIdleTimeout,omitempty"`
 }
 
 type BastionLoadBalancerSpec struct {
@@ -31,3 +40,32 @@ type BastionLoadBalancerSpec struct {
 	// Type of load balancer to create, it can be Public or Internal.
 	Type LoadBalancerType `json:"type,omitempty"`
 }
+
+// ManagedBastionType is a cloud service providing access to the instances in place of bastion instances.
+type ManagedBastionType string
+
+const (
+	// ManagedBastionTypeSSM uses AWS Systems Manager Session Manager.
+	ManagedBastionTypeSSM ManagedBastionType = "SSM"
+	// ManagedBastionTypeIAP uses GCP Identity-Aware Proxy TCP forwarding.
+	ManagedBastionTypeIAP ManagedBastionType = "IAP"
+	// ManagedBastionTypeAzureBastion uses Azure Bastion.
+	ManagedBastionTypeAzureBastion ManagedBastionType = "AzureBastion"
+)
+
+// ManagedBastionSpec configures access to the instances through a cloud service.
+type ManagedBastionSpec struct {
+	// Type of the service: SSM, IAP or AzureBastion.
+	Type ManagedBastionType `json:"type,omitempty"`
+	// SubnetCIDR is the address range of the AzureBastionSubnet, which is allowed SSH access to the instances.
+	// Only used with AzureBastion.
+	SubnetCIDR string `json:"subnetCIDR,omitempty"`
+}
+
+// BastionAccessSpec allows users to log in to the bastion instances from a set of addresses.
+type BastionAccessSpec struct {
+	// Users that may log in. "*" matches any user.
+	Users []string `json:"users,omitempty"`
+	// CIDRs the users may connect from. When empty, the users may connect from any address allowed by sshAccess.
+	CIDRs []string `json:"cidrs,omitempty"`
+}
@@ -141,6 +141,8 @@ type ClusterSpec struct {
 	EgressProxy *EgressProxySpec `json:"egressProxy,omitempty"`
 	// SSHKeyName specifies a preexisting SSH key to use
 	SSHKeyName *string `json:"sshKeyName,omitempty"`
+	// SSHCertificateAuthority configures the instances to trust SSH certificates issued with kops ssh issue.
+	SSHCertificateAuthority *SSHCertificateAuthoritySpec `json:"sshCertificateAuthority,omitempty"`
 	// KubernetesAPIAccess determines the permitted access to the API endpoints (master HTTPS)
 	// Currently only a single CIDR is supported (though a richer grammar could be added in future)
 	// +k8s:conversion-gen=false
@@ -254,6 +256,42 @@ type ClusterSpec struct {
 	// PodIdentityWebhook determines the EKS Pod Identity Webhook configuration.
 	// +k8s:conversion-gen=false
 	PodIdentityWebhook *PodIdentityWebhookSpec `json:"podIdentityWebhook,omitempty"`
+	// Monitoring configures the metrics endpoints of the components managed by kOps.
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+	// GitOps configures kops-controller to continuously reconcile the cluster with its desired configuration.
+	GitOps *GitOpsSpec `json:"gitOps,omitempty"`
+	// ConnectivityProbes configures probes, run on every node, of the endpoints the node depends on.
+	// Failed probes are reported by kops validate cluster.
+	ConnectivityProbes *ConnectivityProbesSpec `json:"connectivityProbes,omitempty"`
+	// DeletionProtection makes kops delete cluster refuse to delete the cluster until it is unset.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+	// Hibernation scales the instance groups of the cluster, other than the control plane, to zero and back on a schedule.
+	Hibernation *HibernationSpec `json:"hibernation,omitempty"`
+	// Notifications configures the posting of events of the kops operations on the cluster to external sinks.
+	Notifications *NotificationsSpec `json:"notifications,omitempty"`
+	// AddonResources configures the compute resources of the addons managed by kOps.
+	AddonResources *AddonResourcesSpec `json:"addonResources,omitempty"`
+	// VerticalPodAutoscaler defines the Vertical Pod Autoscaler configuration.
+	VerticalPodAutoscaler *VerticalPodAutoscalerConfig `json:"verticalPodAutoscaler,omitempty"`
+	// AdoptAddons lists the names of the addons managed by kOps, such as coredns.addons.k8s.io,
+	// whose objects installed outside of kOps should be taken over.
+	AdoptAddons []string `json:"adoptAddons,omitempty"`
+	// FeatureGates are the Kubernetes feature gates set on kube-apiserver, kube-controller-manager, kube-scheduler, kube-proxy and kubelet.
+	// Feature gates set in the configuration of a component take precedence.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// APIRuntimeConfig enables (true) or disables (false) API groups, versions and resources of kube-apiserver, as with --runtime-config.
+	// Entries of kubeAPIServer.runtimeConfig take precedence.
+	APIRuntimeConfig map[string]bool `json:"apiRuntimeConfig,omitempty"`
+	// Konnectivity configures the Konnectivity service, through which kube-apiserver reaches the nodes.
+	Konnectivity *KonnectivityConfig `json:"konnectivity,omitempty"`
+	// ControlPlaneProfile tunes the control plane for the size of the cluster (Default, Large).
+	// The Large profile raises the kube-apiserver and etcd limits and load-balances the API
+	// across the dedicated APIServer instance groups, if any.
+	ControlPlaneProfile ControlPlaneProfile `json:"controlPlaneProfile,omitempty"`
+	// NamespaceDefaults declares baseline LimitRanges, ResourceQuotas and PriorityClasses that are maintained by the channels addon manager.
+	NamespaceDefaults *NamespaceDefaultsSpec `json:"namespaceDefaults,omitempty"`
+	// Admission configures Pod Security admission and the ValidatingAdmissionPolicies of the cluster.
+	Admission *AdmissionSpec `json:"admission,omitempty"`
 }
 
 // PodIdentityWebhookSpec configures an EKS Pod Identity Webhook.
@@ -330,6 +368,35 @@ type NodeAuthorizerSpec struct {
 type AddonSpec struct {
 	// Manifest is a path to the manifest that defines the addon
 	Manifest string `json:"manifest,omitempty"`
+	// Chart is a Helm chart that defines the addon, installed instead of a manifest
+	Chart *HelmChartSpec `json:"chart,omitempty"`
+}
+
+// HelmChartSpec defines a Helm chart installed as an addon
+type HelmChartSpec struct {
+	// Name is the name of the addon and of the Helm release. Defaults to the name of the chart.
+	Name string `json:"name,omitempty"`
+	// Repository is the URL of the chart repository, either an https:// chart repository or an oci:// registry.
+	Repository string `json:"repository,omitempty"`
+	// Chart is the name of the chart in the repository.
+	Chart string `json:"chart,omitempty"`
+	// Version is the version of the chart.
+	Version string `json:"version,omitempty"`
+	// Namespace is the namespace the chart is installed into. Default: kube-system
+	Namespace string `json:"namespace,omitempty"`
+	// Values are the values of the chart, in YAML.
+	Values string `json:"values,omitempty"`
+	// ValuesFrom reads additional values from Secrets in the namespace of the chart,
+	// merged in order over Values.
+	ValuesFrom []HelmValuesSource `json:"valuesFrom,omitempty"`
+}
+
+// HelmValuesSource references values of a Helm chart stored in a Secret
+type HelmValuesSource struct {
+	// SecretName is the name of the Secret.
+	SecretName string `json:"secretName,omitempty"`
+	// Key is the key of the Secret holding the values. Default: values.yaml
+	Key string `json:"key,omitempty"`
 }
 
 // FileAssetSpec defines the structure for a file asset
@@ -356,6 +423,29 @@ type AssetsSpec struct {
 	FileRepository *string `json:"fileRepository,omitempty"`
 	// ContainerProxy is a url for a pull-through proxy of a docker registry
 	ContainerProxy *string `json:"containerProxy,omitempty"`
+	// ImageDigests pins images, by their canonical reference, to a digest such as sha256:0123...
+	// The images are run by digest, and can be pinned with kops get assets --pin-digests.
+	ImageDigests map[string]string `json:"imageDigests,omitempty"`
+	// SignatureVerification requires file assets and images to be signed by one of the trusted keys.
+	SignatureVerification *AssetSignatureVerificationSpec `json:"signatureVerification,omitempty"`
+}
+
+// AssetSignatureVerificationSpec configures verification of the signatures of downloaded assets.
+type AssetSignatureVerificationSpec struct {
+	// PublicKeys are the PEM-encoded public keys trusted to sign assets, as generated by cosign generate-key-pair.
+	PublicKeys []string `json:"publicKeys,omitempty"`
+	// SignedChecksums is the url of a SHA256SUMS file listing the hashes of the file assets,
+	// signed with a detached signature at the same url with a .sig suffix.
+	// If not set, each file asset must have a detached signature at its url with a .sig suffix.
+	SignedChecksums *string `json:"signedChecksums,omitempty"`
+}
+
+// SSHCertificateAuthoritySpec configures an SSH certificate authority, maintained by kOps as the "ssh-ca" keypair.
+type SSHCertificateAuthoritySpec struct {
+	// Principals are the users that issued certificates can log in as, unless overridden when issuing a certificate.
+	Principals []string `json:"principals,omitempty"`
+	// MaxTTL is the longest validity of an issued certificate. Defaults to 24h.
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
 }
 
 // IAMSpec adds control over the IAM security policies applied to resources
@@ -472,6 +562,60 @@ func (s *APISpec) IsEmpty() bool {
 
 type DNSAccessSpec struct{}
 
+// ControlPlaneProfile string describes the sizing profiles of the control plane
+type ControlPlaneProfile string
+
+const (
+	ControlPlaneProfileDefault ControlPlaneProfile = "Default"
+	ControlPlaneProfileLarge   ControlPlaneProfile = "Large"
+)
+
+// NamespaceDefaultsSpec declares the policy objects that every cluster is created with.
+type NamespaceDefaultsSpec struct {
+	// LimitRanges are the LimitRanges to create.
+	LimitRanges []NamespaceLimitRangeSpec `json:"limitRanges,omitempty"`
+	// ResourceQuotas are the ResourceQuotas to create.
+	ResourceQuotas []NamespaceResourceQuotaSpec `json:"resourceQuotas,omitempty"`
+	// PriorityClasses are the PriorityClasses to create.
+	PriorityClasses []PriorityClassSpec `json:"priorityClasses,omitempty"`
+}
+
+// NamespaceLimitRangeSpec is a LimitRange created in each of the listed namespaces.
+type NamespaceLimitRangeSpec struct {
+	// Name is the name of the LimitRange.
+	Name string `json:"name"`
+	// Namespaces are the namespaces the LimitRange is created in. Missing namespaces are created.
+	Namespaces []string `json:"namespaces"`
+	// Limits are the limits enforced by the LimitRange.
+	Limits []corev1.LimitRangeItem `json:"limits"`
+}
+
+// NamespaceResourceQuotaSpec is a ResourceQuota created in each of the listed namespaces.
+type NamespaceResourceQuotaSpec struct {
+	// Name is the name of the ResourceQuota.
+	Name string `json:"name"`
+	// Namespaces are the namespaces the ResourceQuota is created in. Missing namespaces are created.
+	Namespaces []string `json:"namespaces"`
+	// Hard is the set of enforced hard limits for each named resource.
+	Hard corev1.ResourceList `json:"hard"`
+	// Scopes restricts the quota to the objects matching all of the scopes.
+	Scopes []corev1.ResourceQuotaScope `json:"scopes,omitempty"`
+}
+
+// PriorityClassSpec is a PriorityClass.
+type PriorityClassSpec struct {
+	// Name is the name of the PriorityClass.
+	Name string `json:"name"`
+	// Value is the priority of the pods using the PriorityClass.
+	Value int32 `json:"value"`
+	// GlobalDefault makes the PriorityClass the default for pods without a priorityClassName.
+	GlobalDefault bool `json:"globalDefault,omitempty"`
+	// PreemptionPolicy is the policy for preempting pods with lower priority (Never, PreemptLowerPriority).
+	PreemptionPolicy *corev1.PreemptionPolicy `json:"preemptionPolicy,omitempty"`
+	// Description describes when the PriorityClass should be used.
+	Description string `json:"description,omitempty"`
+}
+
 // LoadBalancerType string describes LoadBalancer types (public, internal)
 type LoadBalancerType string
 
@@ -665,6 +809,18 @@ type EtcdClusterSpec struct {
 	MemoryRequest *resource.Quantity `json:"memoryRequest,omitempty"`
 	// CPURequest specifies the cpu requests of each etcd container in the cluster.
 	CPURequest *resource.Quantity `json:"cpuRequest,omitempty"`
+	// MemoryLimit specifies the memory limit of each etcd container in the cluster.
+	MemoryLimit *resource.Quantity `json:"memoryLimit,omitempty"`
+	// CPULimit specifies the cpu limit of each etcd container in the cluster.
+	CPULimit *resource.Quantity `json:"cpuLimit,omitempty"`
+	// QuotaBackendBytes is the size of the etcd database at which etcd raises a NOSPACE alarm and stops accepting writes.
+	// Defaults to the etcd default of 2Gi.
+	QuotaBackendBytes *resource.Quantity `json:"quotaBackendBytes,omitempty"`
+	// AutoCompactionMode is the mode of the automatic compaction of the history of the keys: periodic or revision.
+	AutoCompactionMode string `json:"autoCompactionMode,omitempty"`
+	// AutoCompactionRetention is the history kept by the automatic compaction: a duration such as 1h in periodic mode,
+	// or a number of revisions in revision mode.
+	AutoCompactionRetention string `json:"autoCompactionRetention,omitempty"`
 }
 
 // EtcdBackupSpec describes how we want to do backups of etcd
@@ -790,10 +946,19 @@ type TerraformSpec struct {
 	ProviderExtraConfig map[string]string `json:"providerExtraConfig,omitempty"`
 	// FilesProviderExtraConfig contains key/value pairs to add to the terraform provider block used for managed files
 	FilesProviderExtraConfig map[string]string `json:"filesProviderExtraConfig,omitempty"`
+	// ProviderVersions overrides the version constraints of the terraform providers, keyed by provider name.
+	// For example {"aws": "= 5.80.0"} pins the AWS provider to an exact version.
+	ProviderVersions map[string]string `json:"providerVersions,omitempty"`
+	// InstanceGroupModules writes the cloud resources for each instance group into a separate terraform module.
+	InstanceGroupModules *bool `json:"instanceGroupModules,omitempty"`
+	// ImportExistingResources generates terraform import blocks for cloud resources that already exist,
+	// so that a cluster created with the direct target can be adopted by terraform. Requires terraform 1.5 or later.
+	ImportExistingResources *bool `json:"importExistingResources,omitempty"`
 }
 
 func (t *TerraformSpec) IsEmpty() bool {
-	return len(t.ProviderExtraConfig) == 0 && len(t.FilesProviderExtraConfig) == 0
+	return len(t.ProviderExtraConfig) == 0 && len(t.FilesProviderExtraConfig) == 0 && len(t.ProviderVersions) == 0 &&
+		t.InstanceGroupModules == nil && t.ImportExistingResources == nil
 }
 
 // EnvVar represents an environment variable present in a Container.
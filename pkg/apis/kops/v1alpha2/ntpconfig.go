@@ -16,9 +16,26 @@ limitations under the License.
 
 package v1alpha2
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // NTPConfig is the configuration for NTP.
 type NTPConfig struct {
 	// Managed controls if the NTP configuration is managed by kOps.
 	// The NTP configuration task is skipped if this is set to false.
 	Managed *bool `json:"managed,omitempty"`
+	// Servers are the NTP servers to synchronize the clock with, instead of the NTP service of the cloud provider.
+	Servers []string `json:"servers,omitempty"`
+	// MakeStep configures when chrony steps the clock instead of slowly correcting it.
+	MakeStep *NTPMakeStepSpec `json:"makeStep,omitempty"`
+}
+
+// NTPMakeStepSpec configures the stepping of the clock by chrony.
+type NTPMakeStepSpec struct {
+	// Threshold is the offset of the clock above which it is stepped.
+	// Default: 1s
+	Threshold *metav1.Duration `json:"threshold,omitempty"`
+	// Limit is the number of clock updates since chrony started during which the clock can be stepped.
+	// The clock can be stepped at any time when this is set to -1.
+	// Default: 3
+	Limit *int32 `json:"limit,omitempty"`
 }
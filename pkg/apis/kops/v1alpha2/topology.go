@@ -37,6 +37,10 @@ type TopologySpec struct {
 	// as the "jump server".
 	Bastion *BastionSpec `json:"bastion,omitempty"`
 
+	// VPN provides a managed VPN entry point into the network of the cluster,
+	// so private clusters can be reached without a bastion.
+	VPN *VPNSpec `json:"vpn,omitempty"`
+
 	DNS DNSType `json:"-"`
 
 	// DNS configures options relating to DNS, in particular whether we use a public or a private hosted zone
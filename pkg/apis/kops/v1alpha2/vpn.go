@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+// VPNType is a managed VPN service giving clients access to the network of the cluster.
+type VPNType string
+
+const (
+	// VPNTypeAWSClientVPN uses AWS Client VPN.
+	VPNTypeAWSClientVPN VPNType = "AWSClientVPN"
+)
+
+// VPNSpec configures a managed VPN entry point into the network of the cluster,
+// giving clients access to the private API endpoint and instances without a bastion.
+type VPNSpec struct {
+	// Type of the VPN service: AWSClientVPN.
+	Type VPNType `json:"type,omitempty"`
+	// ClientCIDR is the address range assigned to the VPN clients.
+	// It must not overlap the network of the cluster.
+	ClientCIDR string `json:"clientCIDR,omitempty"`
+	// ServerCertificate is the ARN of the ACM certificate presented by the VPN server.
+	ServerCertificate string `json:"serverCertificate,omitempty"`
+	// ClientCertificateAuthority is the ARN of the ACM certificate of the CA issuing the client certificates.
+	// Defaults to the server certificate, for when the same CA issues the server and client certificates.
+	ClientCertificateAuthority string `json:"clientCertificateAuthority,omitempty"`
+	// Subnets are the names of the subnets the VPN is associated with, at most one per zone.
+	// Defaults to one private subnet in each zone.
+	Subnets []string `json:"subnets,omitempty"`
+}
@@ -94,6 +94,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AddonResourceOverride)(nil), (*kops.AddonResourceOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AddonResourceOverride_To_kops_AddonResourceOverride(a.(*AddonResourceOverride), b.(*kops.AddonResourceOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AddonResourceOverride)(nil), (*AddonResourceOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AddonResourceOverride_To_v1alpha2_AddonResourceOverride(a.(*kops.AddonResourceOverride), b.(*AddonResourceOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddonResourcesSpec)(nil), (*kops.AddonResourcesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AddonResourcesSpec_To_kops_AddonResourcesSpec(a.(*AddonResourcesSpec), b.(*kops.AddonResourcesSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AddonResourcesSpec)(nil), (*AddonResourcesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AddonResourcesSpec_To_v1alpha2_AddonResourcesSpec(a.(*kops.AddonResourcesSpec), b.(*AddonResourcesSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AddonSpec)(nil), (*kops.AddonSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AddonSpec_To_kops_AddonSpec(a.(*AddonSpec), b.(*kops.AddonSpec), scope)
 	}); err != nil {
@@ -104,6 +124,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdmissionSpec)(nil), (*kops.AdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AdmissionSpec_To_kops_AdmissionSpec(a.(*AdmissionSpec), b.(*kops.AdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AdmissionSpec)(nil), (*AdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AdmissionSpec_To_v1alpha2_AdmissionSpec(a.(*kops.AdmissionSpec), b.(*AdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AlwaysAllowAuthorizationSpec)(nil), (*kops.AlwaysAllowAuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(a.(*AlwaysAllowAuthorizationSpec), b.(*kops.AlwaysAllowAuthorizationSpec), scope)
 	}); err != nil {
@@ -124,6 +154,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AssetSignatureVerificationSpec)(nil), (*kops.AssetSignatureVerificationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(a.(*AssetSignatureVerificationSpec), b.(*kops.AssetSignatureVerificationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AssetSignatureVerificationSpec)(nil), (*AssetSignatureVerificationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AssetSignatureVerificationSpec_To_v1alpha2_AssetSignatureVerificationSpec(a.(*kops.AssetSignatureVerificationSpec), b.(*AssetSignatureVerificationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AssetsSpec)(nil), (*kops.AssetsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(a.(*AssetsSpec), b.(*kops.AssetsSpec), scope)
 	}); err != nil {
@@ -164,6 +204,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionAccessSpec)(nil), (*kops.BastionAccessSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_BastionAccessSpec_To_kops_BastionAccessSpec(a.(*BastionAccessSpec), b.(*kops.BastionAccessSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.BastionAccessSpec)(nil), (*BastionAccessSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_BastionAccessSpec_To_v1alpha2_BastionAccessSpec(a.(*kops.BastionAccessSpec), b.(*BastionAccessSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionLoadBalancerSpec)(nil), (*kops.BastionLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_BastionLoadBalancerSpec_To_kops_BastionLoadBalancerSpec(a.(*BastionLoadBalancerSpec), b.(*kops.BastionLoadBalancerSpec), scope)
 	}); err != nil {
@@ -194,6 +244,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CSISidecarResources)(nil), (*kops.CSISidecarResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources(a.(*CSISidecarResources), b.(*kops.CSISidecarResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CSISidecarResources)(nil), (*CSISidecarResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources(a.(*kops.CSISidecarResources), b.(*CSISidecarResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIStorageClassSpec)(nil), (*kops.CSIStorageClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(a.(*CSIStorageClassSpec), b.(*kops.CSIStorageClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CSIStorageClassSpec)(nil), (*CSIStorageClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec(a.(*kops.CSIStorageClassSpec), b.(*CSIStorageClassSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CalicoNetworkingSpec)(nil), (*kops.CalicoNetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CalicoNetworkingSpec_To_kops_CalicoNetworkingSpec(a.(*CalicoNetworkingSpec), b.(*kops.CalicoNetworkingSpec), scope)
 	}); err != nil {
@@ -214,6 +284,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateExpirySpec)(nil), (*kops.CertificateExpirySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificateExpirySpec_To_kops_CertificateExpirySpec(a.(*CertificateExpirySpec), b.(*kops.CertificateExpirySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CertificateExpirySpec)(nil), (*CertificateExpirySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CertificateExpirySpec_To_v1alpha2_CertificateExpirySpec(a.(*kops.CertificateExpirySpec), b.(*CertificateExpirySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CiliumGatewayAPISpec)(nil), (*kops.CiliumGatewayAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_CiliumGatewayAPISpec_To_kops_CiliumGatewayAPISpec(a.(*CiliumGatewayAPISpec), b.(*kops.CiliumGatewayAPISpec), scope)
 	}); err != nil {
@@ -304,6 +384,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityProbesSpec)(nil), (*kops.ConnectivityProbesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(a.(*ConnectivityProbesSpec), b.(*kops.ConnectivityProbesSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ConnectivityProbesSpec)(nil), (*ConnectivityProbesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ConnectivityProbesSpec_To_v1alpha2_ConnectivityProbesSpec(a.(*kops.ConnectivityProbesSpec), b.(*ConnectivityProbesSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ContainerdConfig)(nil), (*kops.ContainerdConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ContainerdConfig_To_kops_ContainerdConfig(a.(*ContainerdConfig), b.(*kops.ContainerdConfig), scope)
 	}); err != nil {
@@ -414,6 +504,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdIOIsolationSpec)(nil), (*kops.EtcdIOIsolationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(a.(*EtcdIOIsolationSpec), b.(*kops.EtcdIOIsolationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.EtcdIOIsolationSpec)(nil), (*EtcdIOIsolationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_EtcdIOIsolationSpec_To_v1alpha2_EtcdIOIsolationSpec(a.(*kops.EtcdIOIsolationSpec), b.(*EtcdIOIsolationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EtcdManagerSpec)(nil), (*kops.EtcdManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_EtcdManagerSpec_To_kops_EtcdManagerSpec(a.(*EtcdManagerSpec), b.(*kops.EtcdManagerSpec), scope)
 	}); err != nil {
@@ -484,6 +584,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GitOpsSpec)(nil), (*kops.GitOpsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_GitOpsSpec_To_kops_GitOpsSpec(a.(*GitOpsSpec), b.(*kops.GitOpsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.GitOpsSpec)(nil), (*GitOpsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_GitOpsSpec_To_v1alpha2_GitOpsSpec(a.(*kops.GitOpsSpec), b.(*GitOpsSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GossipConfig)(nil), (*kops.GossipConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_GossipConfig_To_kops_GossipConfig(a.(*GossipConfig), b.(*kops.GossipConfig), scope)
 	}); err != nil {
@@ -514,6 +624,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmChartSpec)(nil), (*kops.HelmChartSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(a.(*HelmChartSpec), b.(*kops.HelmChartSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HelmChartSpec)(nil), (*HelmChartSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(a.(*kops.HelmChartSpec), b.(*HelmChartSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HelmValuesSource)(nil), (*kops.HelmValuesSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HelmValuesSource_To_kops_HelmValuesSource(a.(*HelmValuesSource), b.(*kops.HelmValuesSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HelmValuesSource)(nil), (*HelmValuesSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HelmValuesSource_To_v1alpha2_HelmValuesSource(a.(*kops.HelmValuesSource), b.(*HelmValuesSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HibernationSpec)(nil), (*kops.HibernationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HibernationSpec_To_kops_HibernationSpec(a.(*HibernationSpec), b.(*kops.HibernationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HibernationSpec)(nil), (*HibernationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HibernationSpec_To_v1alpha2_HibernationSpec(a.(*kops.HibernationSpec), b.(*HibernationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HubbleSpec)(nil), (*kops.HubbleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_HubbleSpec_To_kops_HubbleSpec(a.(*HubbleSpec), b.(*kops.HubbleSpec), scope)
 	}); err != nil {
@@ -554,6 +694,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstanceGroupClusterAutoscalerSpec)(nil), (*kops.InstanceGroupClusterAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(a.(*InstanceGroupClusterAutoscalerSpec), b.(*kops.InstanceGroupClusterAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.InstanceGroupClusterAutoscalerSpec)(nil), (*InstanceGroupClusterAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(a.(*kops.InstanceGroupClusterAutoscalerSpec), b.(*InstanceGroupClusterAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstanceGroupList)(nil), (*kops.InstanceGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_InstanceGroupList_To_kops_InstanceGroupList(a.(*InstanceGroupList), b.(*kops.InstanceGroupList), scope)
 	}); err != nil {
@@ -584,6 +734,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JournaldSpec)(nil), (*kops.JournaldSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_JournaldSpec_To_kops_JournaldSpec(a.(*JournaldSpec), b.(*kops.JournaldSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.JournaldSpec)(nil), (*JournaldSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_JournaldSpec_To_v1alpha2_JournaldSpec(a.(*kops.JournaldSpec), b.(*JournaldSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KarpenterConfig)(nil), (*kops.KarpenterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_KarpenterConfig_To_kops_KarpenterConfig(a.(*KarpenterConfig), b.(*kops.KarpenterConfig), scope)
 	}); err != nil {
@@ -654,6 +814,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KonnectivityConfig)(nil), (*kops.KonnectivityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KonnectivityConfig_To_kops_KonnectivityConfig(a.(*KonnectivityConfig), b.(*kops.KonnectivityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KonnectivityConfig)(nil), (*KonnectivityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KonnectivityConfig_To_v1alpha2_KonnectivityConfig(a.(*kops.KonnectivityConfig), b.(*KonnectivityConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KopeioAuthenticationSpec)(nil), (*kops.KopeioAuthenticationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_KopeioAuthenticationSpec_To_kops_KopeioAuthenticationSpec(a.(*KopeioAuthenticationSpec), b.(*kops.KopeioAuthenticationSpec), scope)
 	}); err != nil {
@@ -724,6 +894,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPlugin)(nil), (*kops.KubeSchedulerPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(a.(*KubeSchedulerPlugin), b.(*kops.KubeSchedulerPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPlugin)(nil), (*KubeSchedulerPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin(a.(*kops.KubeSchedulerPlugin), b.(*KubeSchedulerPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPluginConfig)(nil), (*kops.KubeSchedulerPluginConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(a.(*KubeSchedulerPluginConfig), b.(*kops.KubeSchedulerPluginConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPluginConfig)(nil), (*KubeSchedulerPluginConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPluginConfig_To_v1alpha2_KubeSchedulerPluginConfig(a.(*kops.KubeSchedulerPluginConfig), b.(*KubeSchedulerPluginConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPluginSet)(nil), (*kops.KubeSchedulerPluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(a.(*KubeSchedulerPluginSet), b.(*kops.KubeSchedulerPluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPluginSet)(nil), (*KubeSchedulerPluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(a.(*kops.KubeSchedulerPluginSet), b.(*KubeSchedulerPluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPlugins)(nil), (*kops.KubeSchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(a.(*KubeSchedulerPlugins), b.(*kops.KubeSchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPlugins)(nil), (*KubeSchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPlugins_To_v1alpha2_KubeSchedulerPlugins(a.(*kops.KubeSchedulerPlugins), b.(*KubeSchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerProfile)(nil), (*kops.KubeSchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(a.(*KubeSchedulerProfile), b.(*kops.KubeSchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerProfile)(nil), (*KubeSchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerProfile_To_v1alpha2_KubeSchedulerProfile(a.(*kops.KubeSchedulerProfile), b.(*KubeSchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeletConfigSpec)(nil), (*kops.KubeletConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_KubeletConfigSpec_To_kops_KubeletConfigSpec(a.(*KubeletConfigSpec), b.(*kops.KubeletConfigSpec), scope)
 	}); err != nil {
@@ -814,6 +1034,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedBastionSpec)(nil), (*kops.ManagedBastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ManagedBastionSpec_To_kops_ManagedBastionSpec(a.(*ManagedBastionSpec), b.(*kops.ManagedBastionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ManagedBastionSpec)(nil), (*ManagedBastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ManagedBastionSpec_To_v1alpha2_ManagedBastionSpec(a.(*kops.ManagedBastionSpec), b.(*ManagedBastionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MetricsServerConfig)(nil), (*kops.MetricsServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_MetricsServerConfig_To_kops_MetricsServerConfig(a.(*MetricsServerConfig), b.(*kops.MetricsServerConfig), scope)
 	}); err != nil {
@@ -844,6 +1074,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringSpec)(nil), (*kops.MonitoringSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MonitoringSpec_To_kops_MonitoringSpec(a.(*MonitoringSpec), b.(*kops.MonitoringSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.MonitoringSpec)(nil), (*MonitoringSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_MonitoringSpec_To_v1alpha2_MonitoringSpec(a.(*kops.MonitoringSpec), b.(*MonitoringSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NRIConfig)(nil), (*kops.NRIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_NRIConfig_To_kops_NRIConfig(a.(*NRIConfig), b.(*kops.NRIConfig), scope)
 	}); err != nil {
@@ -864,6 +1104,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NTPMakeStepSpec)(nil), (*kops.NTPMakeStepSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(a.(*NTPMakeStepSpec), b.(*kops.NTPMakeStepSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NTPMakeStepSpec)(nil), (*NTPMakeStepSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NTPMakeStepSpec_To_v1alpha2_NTPMakeStepSpec(a.(*kops.NTPMakeStepSpec), b.(*NTPMakeStepSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaultsSpec)(nil), (*kops.NamespaceDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(a.(*NamespaceDefaultsSpec), b.(*kops.NamespaceDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NamespaceDefaultsSpec)(nil), (*NamespaceDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NamespaceDefaultsSpec_To_v1alpha2_NamespaceDefaultsSpec(a.(*kops.NamespaceDefaultsSpec), b.(*NamespaceDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceLimitRangeSpec)(nil), (*kops.NamespaceLimitRangeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(a.(*NamespaceLimitRangeSpec), b.(*kops.NamespaceLimitRangeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NamespaceLimitRangeSpec)(nil), (*NamespaceLimitRangeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NamespaceLimitRangeSpec_To_v1alpha2_NamespaceLimitRangeSpec(a.(*kops.NamespaceLimitRangeSpec), b.(*NamespaceLimitRangeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceResourceQuotaSpec)(nil), (*kops.NamespaceResourceQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(a.(*NamespaceResourceQuotaSpec), b.(*kops.NamespaceResourceQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NamespaceResourceQuotaSpec)(nil), (*NamespaceResourceQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha2_NamespaceResourceQuotaSpec(a.(*kops.NamespaceResourceQuotaSpec), b.(*NamespaceResourceQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkingSpec)(nil), (*kops.NetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_NetworkingSpec_To_kops_NetworkingSpec(a.(*NetworkingSpec), b.(*kops.NetworkingSpec), scope)
 	}); err != nil {
@@ -924,6 +1204,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkSpec)(nil), (*kops.NotificationSinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NotificationSinkSpec_To_kops_NotificationSinkSpec(a.(*NotificationSinkSpec), b.(*kops.NotificationSinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NotificationSinkSpec)(nil), (*NotificationSinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NotificationSinkSpec_To_v1alpha2_NotificationSinkSpec(a.(*kops.NotificationSinkSpec), b.(*NotificationSinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationsSpec)(nil), (*kops.NotificationsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NotificationsSpec_To_kops_NotificationsSpec(a.(*NotificationsSpec), b.(*kops.NotificationsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NotificationsSpec)(nil), (*NotificationsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NotificationsSpec_To_v1alpha2_NotificationsSpec(a.(*kops.NotificationsSpec), b.(*NotificationsSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NvidiaGPUConfig)(nil), (*kops.NvidiaGPUConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_NvidiaGPUConfig_To_kops_NvidiaGPUConfig(a.(*NvidiaGPUConfig), b.(*kops.NvidiaGPUConfig), scope)
 	}); err != nil {
@@ -1034,6 +1334,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityAdmissionSpec)(nil), (*kops.PodSecurityAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(a.(*PodSecurityAdmissionSpec), b.(*kops.PodSecurityAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PodSecurityAdmissionSpec)(nil), (*PodSecurityAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(a.(*kops.PodSecurityAdmissionSpec), b.(*PodSecurityAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PriorityClassSpec)(nil), (*kops.PriorityClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_PriorityClassSpec_To_kops_PriorityClassSpec(a.(*PriorityClassSpec), b.(*kops.PriorityClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PriorityClassSpec)(nil), (*PriorityClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PriorityClassSpec_To_v1alpha2_PriorityClassSpec(a.(*kops.PriorityClassSpec), b.(*PriorityClassSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RBACAuthorizationSpec)(nil), (*kops.RBACAuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec(a.(*RBACAuthorizationSpec), b.(*kops.RBACAuthorizationSpec), scope)
 	}); err != nil {
@@ -1084,6 +1404,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SSHAuthorizedKeysSpec)(nil), (*kops.SSHAuthorizedKeysSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(a.(*SSHAuthorizedKeysSpec), b.(*kops.SSHAuthorizedKeysSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.SSHAuthorizedKeysSpec)(nil), (*SSHAuthorizedKeysSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha2_SSHAuthorizedKeysSpec(a.(*kops.SSHAuthorizedKeysSpec), b.(*SSHAuthorizedKeysSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCertificateAuthoritySpec)(nil), (*kops.SSHCertificateAuthoritySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(a.(*SSHCertificateAuthoritySpec), b.(*kops.SSHCertificateAuthoritySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.SSHCertificateAuthoritySpec)(nil), (*SSHCertificateAuthoritySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha2_SSHCertificateAuthoritySpec(a.(*kops.SSHCertificateAuthoritySpec), b.(*SSHCertificateAuthoritySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHCredential)(nil), (*kops.SSHCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_SSHCredential_To_kops_SSHCredential(a.(*SSHCredential), b.(*kops.SSHCredential), scope)
 	}); err != nil {
@@ -1174,6 +1514,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VPNSpec)(nil), (*kops.VPNSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VPNSpec_To_kops_VPNSpec(a.(*VPNSpec), b.(*kops.VPNSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.VPNSpec)(nil), (*VPNSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_VPNSpec_To_v1alpha2_VPNSpec(a.(*kops.VPNSpec), b.(*VPNSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscalerConfig)(nil), (*kops.VerticalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(a.(*VerticalPodAutoscalerConfig), b.(*kops.VerticalPodAutoscalerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.VerticalPodAutoscalerConfig)(nil), (*VerticalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(a.(*kops.VerticalPodAutoscalerConfig), b.(*VerticalPodAutoscalerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VolumeMountSpec)(nil), (*kops.VolumeMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VolumeMountSpec_To_kops_VolumeMountSpec(a.(*VolumeMountSpec), b.(*kops.VolumeMountSpec), scope)
 	}); err != nil {
@@ -1493,58 +1853,182 @@ func Convert_kops_AccessLogSpec_To_v1alpha2_AccessLogSpec(in *kops.AccessLogSpec
 	return autoConvert_kops_AccessLogSpec_To_v1alpha2_AccessLogSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
-	out.Manifest = in.Manifest
-	return nil
-}
-
-// Convert_v1alpha2_AddonSpec_To_kops_AddonSpec is an autogenerated conversion function.
-func Convert_v1alpha2_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AddonSpec_To_kops_AddonSpec(in, out, s)
-}
-
-func autoConvert_kops_AddonSpec_To_v1alpha2_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	out.Manifest = in.Manifest
+func autoConvert_v1alpha2_AddonResourceOverride_To_kops_AddonResourceOverride(in *AddonResourceOverride, out *kops.AddonResourceOverride, s conversion.Scope) error {
+	out.Addon = in.Addon
+	out.Name = in.Name
+	out.Container = in.Container
+	out.Resources = in.Resources
 	return nil
 }
 
-// Convert_kops_AddonSpec_To_v1alpha2_AddonSpec is an autogenerated conversion function.
-func Convert_kops_AddonSpec_To_v1alpha2_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	return autoConvert_kops_AddonSpec_To_v1alpha2_AddonSpec(in, out, s)
+// Convert_v1alpha2_AddonResourceOverride_To_kops_AddonResourceOverride is an autogenerated conversion function.
+func Convert_v1alpha2_AddonResourceOverride_To_kops_AddonResourceOverride(in *AddonResourceOverride, out *kops.AddonResourceOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AddonResourceOverride_To_kops_AddonResourceOverride(in, out, s)
 }
 
-func autoConvert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+func autoConvert_kops_AddonResourceOverride_To_v1alpha2_AddonResourceOverride(in *kops.AddonResourceOverride, out *AddonResourceOverride, s conversion.Scope) error {
+	out.Addon = in.Addon
+	out.Name = in.Name
+	out.Container = in.Container
+	out.Resources = in.Resources
 	return nil
 }
 
-// Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
-func Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in, out, s)
+// Convert_kops_AddonResourceOverride_To_v1alpha2_AddonResourceOverride is an autogenerated conversion function.
+func Convert_kops_AddonResourceOverride_To_v1alpha2_AddonResourceOverride(in *kops.AddonResourceOverride, out *AddonResourceOverride, s conversion.Scope) error {
+	return autoConvert_kops_AddonResourceOverride_To_v1alpha2_AddonResourceOverride(in, out, s)
 }
 
-func autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+func autoConvert_v1alpha2_AddonResourcesSpec_To_kops_AddonResourcesSpec(in *AddonResourcesSpec, out *kops.AddonResourcesSpec, s conversion.Scope) error {
+	out.Autosize = in.Autosize
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]kops.AddonResourceOverride, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_AddonResourceOverride_To_kops_AddonResourceOverride(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Overrides = nil
+	}
 	return nil
 }
 
-// Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
-func Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
-	return autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in, out, s)
+// Convert_v1alpha2_AddonResourcesSpec_To_kops_AddonResourcesSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AddonResourcesSpec_To_kops_AddonResourcesSpec(in *AddonResourcesSpec, out *kops.AddonResourcesSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AddonResourcesSpec_To_kops_AddonResourcesSpec(in, out, s)
 }
 
-func autoConvert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
-	out.Image = in.Image
-	out.InitImage = in.InitImage
-	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]kops.EnvVar, len(*in))
+func autoConvert_kops_AddonResourcesSpec_To_v1alpha2_AddonResourcesSpec(in *kops.AddonResourcesSpec, out *AddonResourcesSpec, s conversion.Scope) error {
+	out.Autosize = in.Autosize
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]AddonResourceOverride, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha2_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_kops_AddonResourceOverride_To_v1alpha2_AddonResourceOverride(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Env = nil
+		out.Overrides = nil
+	}
+	return nil
+}
+
+// Convert_kops_AddonResourcesSpec_To_v1alpha2_AddonResourcesSpec is an autogenerated conversion function.
+func Convert_kops_AddonResourcesSpec_To_v1alpha2_AddonResourcesSpec(in *kops.AddonResourcesSpec, out *AddonResourcesSpec, s conversion.Scope) error {
+	return autoConvert_kops_AddonResourcesSpec_To_v1alpha2_AddonResourcesSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
+	out.Manifest = in.Manifest
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(kops.HelmChartSpec)
+		if err := Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Chart = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AddonSpec_To_kops_AddonSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AddonSpec_To_kops_AddonSpec(in, out, s)
+}
+
+func autoConvert_kops_AddonSpec_To_v1alpha2_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	out.Manifest = in.Manifest
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(HelmChartSpec)
+		if err := Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Chart = nil
+	}
+	return nil
+}
+
+// Convert_kops_AddonSpec_To_v1alpha2_AddonSpec is an autogenerated conversion function.
+func Convert_kops_AddonSpec_To_v1alpha2_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	return autoConvert_kops_AddonSpec_To_v1alpha2_AddonSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AdmissionSpec_To_kops_AdmissionSpec(in *AdmissionSpec, out *kops.AdmissionSpec, s conversion.Scope) error {
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(kops.PodSecurityAdmissionSpec)
+		if err := Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurity = nil
+	}
+	out.ValidatingAdmissionPolicies = in.ValidatingAdmissionPolicies
+	return nil
+}
+
+// Convert_v1alpha2_AdmissionSpec_To_kops_AdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AdmissionSpec_To_kops_AdmissionSpec(in *AdmissionSpec, out *kops.AdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AdmissionSpec_To_kops_AdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_AdmissionSpec_To_v1alpha2_AdmissionSpec(in *kops.AdmissionSpec, out *AdmissionSpec, s conversion.Scope) error {
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityAdmissionSpec)
+		if err := Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurity = nil
+	}
+	out.ValidatingAdmissionPolicies = in.ValidatingAdmissionPolicies
+	return nil
+}
+
+// Convert_kops_AdmissionSpec_To_v1alpha2_AdmissionSpec is an autogenerated conversion function.
+func Convert_kops_AdmissionSpec_To_v1alpha2_AdmissionSpec(in *kops.AdmissionSpec, out *AdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_AdmissionSpec_To_v1alpha2_AdmissionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in, out, s)
+}
+
+func autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
+func Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha2_AlwaysAllowAuthorizationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
+	out.Image = in.Image
+	out.InitImage = in.InitImage
+	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]kops.EnvVar, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
 	}
 	return nil
 }
@@ -1577,10 +2061,42 @@ func Convert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in
 	return autoConvert_kops_AmazonVPCNetworkingSpec_To_v1alpha2_AmazonVPCNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(in *AssetSignatureVerificationSpec, out *kops.AssetSignatureVerificationSpec, s conversion.Scope) error {
+	out.PublicKeys = in.PublicKeys
+	out.SignedChecksums = in.SignedChecksums
+	return nil
+}
+
+// Convert_v1alpha2_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(in *AssetSignatureVerificationSpec, out *kops.AssetSignatureVerificationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(in, out, s)
+}
+
+func autoConvert_kops_AssetSignatureVerificationSpec_To_v1alpha2_AssetSignatureVerificationSpec(in *kops.AssetSignatureVerificationSpec, out *AssetSignatureVerificationSpec, s conversion.Scope) error {
+	out.PublicKeys = in.PublicKeys
+	out.SignedChecksums = in.SignedChecksums
+	return nil
+}
+
+// Convert_kops_AssetSignatureVerificationSpec_To_v1alpha2_AssetSignatureVerificationSpec is an autogenerated conversion function.
+func Convert_kops_AssetSignatureVerificationSpec_To_v1alpha2_AssetSignatureVerificationSpec(in *kops.AssetSignatureVerificationSpec, out *AssetSignatureVerificationSpec, s conversion.Scope) error {
+	return autoConvert_kops_AssetSignatureVerificationSpec_To_v1alpha2_AssetSignatureVerificationSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_AssetsSpec_To_kops_AssetsSpec(in *AssetsSpec, out *kops.AssetsSpec, s conversion.Scope) error {
 	out.ContainerRegistry = in.ContainerRegistry
 	out.FileRepository = in.FileRepository
 	out.ContainerProxy = in.ContainerProxy
+	out.ImageDigests = in.ImageDigests
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(kops.AssetSignatureVerificationSpec)
+		if err := Convert_v1alpha2_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureVerification = nil
+	}
 	return nil
 }
 
@@ -1593,6 +2109,16 @@ func autoConvert_kops_AssetsSpec_To_v1alpha2_AssetsSpec(in *kops.AssetsSpec, out
 	out.ContainerRegistry = in.ContainerRegistry
 	out.FileRepository = in.FileRepository
 	out.ContainerProxy = in.ContainerProxy
+	out.ImageDigests = in.ImageDigests
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(AssetSignatureVerificationSpec)
+		if err := Convert_kops_AssetSignatureVerificationSpec_To_v1alpha2_AssetSignatureVerificationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureVerification = nil
+	}
 	return nil
 }
 
@@ -1741,6 +2267,28 @@ func Convert_kops_AzureSpec_To_v1alpha2_AzureSpec(in *kops.AzureSpec, out *Azure
 	return autoConvert_kops_AzureSpec_To_v1alpha2_AzureSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_BastionAccessSpec_To_kops_BastionAccessSpec(in *BastionAccessSpec, out *kops.BastionAccessSpec, s conversion.Scope) error {
+	out.Users = in.Users
+	out.CIDRs = in.CIDRs
+	return nil
+}
+
+// Convert_v1alpha2_BastionAccessSpec_To_kops_BastionAccessSpec is an autogenerated conversion function.
+func Convert_v1alpha2_BastionAccessSpec_To_kops_BastionAccessSpec(in *BastionAccessSpec, out *kops.BastionAccessSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_BastionAccessSpec_To_kops_BastionAccessSpec(in, out, s)
+}
+
+func autoConvert_kops_BastionAccessSpec_To_v1alpha2_BastionAccessSpec(in *kops.BastionAccessSpec, out *BastionAccessSpec, s conversion.Scope) error {
+	out.Users = in.Users
+	out.CIDRs = in.CIDRs
+	return nil
+}
+
+// Convert_kops_BastionAccessSpec_To_v1alpha2_BastionAccessSpec is an autogenerated conversion function.
+func Convert_kops_BastionAccessSpec_To_v1alpha2_BastionAccessSpec(in *kops.BastionAccessSpec, out *BastionAccessSpec, s conversion.Scope) error {
+	return autoConvert_kops_BastionAccessSpec_To_v1alpha2_BastionAccessSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_BastionLoadBalancerSpec_To_kops_BastionLoadBalancerSpec(in *BastionLoadBalancerSpec, out *kops.BastionLoadBalancerSpec, s conversion.Scope) error {
 	// INFO: in.AdditionalSecurityGroups opted out of conversion generation
 	out.Type = kops.LoadBalancerType(in.Type)
@@ -1774,6 +2322,27 @@ func autoConvert_v1alpha2_BastionSpec_To_kops_BastionSpec(in *BastionSpec, out *
 	} else {
 		out.LoadBalancer = nil
 	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(kops.ManagedBastionSpec)
+		if err := Convert_v1alpha2_ManagedBastionSpec_To_kops_ManagedBastionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Managed = nil
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]kops.BastionAccessSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_BastionAccessSpec_To_kops_BastionAccessSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Access = nil
+	}
+	out.SessionIdleTimeout = in.SessionIdleTimeout
 	return nil
 }
 
@@ -1793,6 +2362,27 @@ func autoConvert_kops_BastionSpec_To_v1alpha2_BastionSpec(in *kops.BastionSpec,
 	} else {
 		out.LoadBalancer = nil
 	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(ManagedBastionSpec)
+		if err := Convert_kops_ManagedBastionSpec_To_v1alpha2_ManagedBastionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Managed = nil
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]BastionAccessSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_BastionAccessSpec_To_v1alpha2_BastionAccessSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Access = nil
+	}
+	out.SessionIdleTimeout = in.SessionIdleTimeout
 	return nil
 }
 
@@ -1821,6 +2411,54 @@ func Convert_kops_CNINetworkingSpec_To_v1alpha2_CNINetworkingSpec(in *kops.CNINe
 	return autoConvert_kops_CNINetworkingSpec_To_v1alpha2_CNINetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources(in *CSISidecarResources, out *kops.CSISidecarResources, s conversion.Scope) error {
+	out.CPURequest = in.CPURequest
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
+	return nil
+}
+
+// Convert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources is an autogenerated conversion function.
+func Convert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources(in *CSISidecarResources, out *kops.CSISidecarResources, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources(in, out, s)
+}
+
+func autoConvert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources(in *kops.CSISidecarResources, out *CSISidecarResources, s conversion.Scope) error {
+	out.CPURequest = in.CPURequest
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
+	return nil
+}
+
+// Convert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources is an autogenerated conversion function.
+func Convert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources(in *kops.CSISidecarResources, out *CSISidecarResources, s conversion.Scope) error {
+	return autoConvert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources(in, out, s)
+}
+
+func autoConvert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(in *CSIStorageClassSpec, out *kops.CSIStorageClassSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.KMSKeyID = in.KMSKeyID
+	out.Zones = in.Zones
+	return nil
+}
+
+// Convert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec is an autogenerated conversion function.
+func Convert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(in *CSIStorageClassSpec, out *kops.CSIStorageClassSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(in, out, s)
+}
+
+func autoConvert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec(in *kops.CSIStorageClassSpec, out *CSIStorageClassSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.KMSKeyID = in.KMSKeyID
+	out.Zones = in.Zones
+	return nil
+}
+
+// Convert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec is an autogenerated conversion function.
+func Convert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec(in *kops.CSIStorageClassSpec, out *CSIStorageClassSpec, s conversion.Scope) error {
+	return autoConvert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_CalicoNetworkingSpec_To_kops_CalicoNetworkingSpec(in *CalicoNetworkingSpec, out *kops.CalicoNetworkingSpec, s conversion.Scope) error {
 	out.Registry = in.Registry
 	out.Version = in.Version
@@ -1939,6 +2577,7 @@ func autoConvert_v1alpha2_CertManagerConfig_To_kops_CertManagerConfig(in *CertMa
 	out.Nameservers = in.Nameservers
 	out.HostedZoneIDs = in.HostedZoneIDs
 	out.FeatureGates = in.FeatureGates
+	out.UseClusterCA = in.UseClusterCA
 	return nil
 }
 
@@ -1955,6 +2594,7 @@ func autoConvert_kops_CertManagerConfig_To_v1alpha2_CertManagerConfig(in *kops.C
 	out.Nameservers = in.Nameservers
 	out.HostedZoneIDs = in.HostedZoneIDs
 	out.FeatureGates = in.FeatureGates
+	out.UseClusterCA = in.UseClusterCA
 	return nil
 }
 
@@ -1963,6 +2603,26 @@ func Convert_kops_CertManagerConfig_To_v1alpha2_CertManagerConfig(in *kops.CertM
 	return autoConvert_kops_CertManagerConfig_To_v1alpha2_CertManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_CertificateExpirySpec_To_kops_CertificateExpirySpec(in *CertificateExpirySpec, out *kops.CertificateExpirySpec, s conversion.Scope) error {
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_v1alpha2_CertificateExpirySpec_To_kops_CertificateExpirySpec is an autogenerated conversion function.
+func Convert_v1alpha2_CertificateExpirySpec_To_kops_CertificateExpirySpec(in *CertificateExpirySpec, out *kops.CertificateExpirySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificateExpirySpec_To_kops_CertificateExpirySpec(in, out, s)
+}
+
+func autoConvert_kops_CertificateExpirySpec_To_v1alpha2_CertificateExpirySpec(in *kops.CertificateExpirySpec, out *CertificateExpirySpec, s conversion.Scope) error {
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_kops_CertificateExpirySpec_To_v1alpha2_CertificateExpirySpec is an autogenerated conversion function.
+func Convert_kops_CertificateExpirySpec_To_v1alpha2_CertificateExpirySpec(in *kops.CertificateExpirySpec, out *CertificateExpirySpec, s conversion.Scope) error {
+	return autoConvert_kops_CertificateExpirySpec_To_v1alpha2_CertificateExpirySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_CiliumGatewayAPISpec_To_kops_CiliumGatewayAPISpec(in *CiliumGatewayAPISpec, out *kops.CiliumGatewayAPISpec, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EnableSecretsSync = in.EnableSecretsSync
@@ -2259,6 +2919,7 @@ func autoConvert_v1alpha2_CloudConfiguration_To_kops_CloudConfiguration(in *Clou
 	// INFO: in.GCEUseStartupScript opted out of conversion generation
 	// INFO: in.DisableSecurityGroupIngress opted out of conversion generation
 	// INFO: in.ElbSecurityGroup opted out of conversion generation
+	// INFO: in.ResizeVolumesInPlace opted out of conversion generation
 	// INFO: in.VSphereUsername opted out of conversion generation
 	// INFO: in.VSpherePassword opted out of conversion generation
 	// INFO: in.VSphereServer opted out of conversion generation
@@ -2314,6 +2975,9 @@ func autoConvert_v1alpha2_CloudControllerManagerConfig_To_kops_CloudControllerMa
 	out.UseServiceAccountCredentials = in.UseServiceAccountCredentials
 	out.EnableLeaderMigration = in.EnableLeaderMigration
 	out.CPURequest = in.CPURequest
+	out.CPULimit = in.CPULimit
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
 	out.NodeStatusUpdateFrequency = in.NodeStatusUpdateFrequency
 	out.ConcurrentNodeSyncs = in.ConcurrentNodeSyncs
 	return nil
@@ -2348,6 +3012,9 @@ func autoConvert_kops_CloudControllerManagerConfig_To_v1alpha2_CloudControllerMa
 	out.UseServiceAccountCredentials = in.UseServiceAccountCredentials
 	out.EnableLeaderMigration = in.EnableLeaderMigration
 	out.CPURequest = in.CPURequest
+	out.CPULimit = in.CPULimit
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
 	out.NodeStatusUpdateFrequency = in.NodeStatusUpdateFrequency
 	out.ConcurrentNodeSyncs = in.ConcurrentNodeSyncs
 	return nil
@@ -2407,6 +3074,8 @@ func autoConvert_v1alpha2_ClusterAutoscalerConfig_To_kops_ClusterAutoscalerConfi
 	out.PodAnnotations = in.PodAnnotations
 	out.CreatePriorityExpenderConfig = in.CreatePriorityExpenderConfig
 	out.CustomPriorityExpanderConfig = in.CustomPriorityExpanderConfig
+	out.BalancingLabels = in.BalancingLabels
+	out.BalancingIgnoreLabels = in.BalancingIgnoreLabels
 	return nil
 }
 
@@ -2438,6 +3107,8 @@ func autoConvert_kops_ClusterAutoscalerConfig_To_v1alpha2_ClusterAutoscalerConfi
 	out.PodAnnotations = in.PodAnnotations
 	out.CreatePriorityExpenderConfig = in.CreatePriorityExpenderConfig
 	out.CustomPriorityExpanderConfig = in.CustomPriorityExpanderConfig
+	out.BalancingLabels = in.BalancingLabels
+	out.BalancingIgnoreLabels = in.BalancingIgnoreLabels
 	return nil
 }
 
@@ -2845,6 +3516,110 @@ func autoConvert_v1alpha2_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 		out.Karpenter = nil
 	}
 	// INFO: in.PodIdentityWebhook opted out of conversion generation
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(kops.MonitoringSpec)
+		if err := Convert_v1alpha2_MonitoringSpec_To_kops_MonitoringSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Monitoring = nil
+	}
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(kops.GitOpsSpec)
+		if err := Convert_v1alpha2_GitOpsSpec_To_kops_GitOpsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitOps = nil
+	}
+	if in.AddonResources != nil {
+		in, out := &in.AddonResources, &out.AddonResources
+		*out = new(kops.AddonResourcesSpec)
+		if err := Convert_v1alpha2_AddonResourcesSpec_To_kops_AddonResourcesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AddonResources = nil
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(kops.VerticalPodAutoscalerConfig)
+		if err := Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VerticalPodAutoscaler = nil
+	}
+	out.AdoptAddons = in.AdoptAddons
+	out.FeatureGates = in.FeatureGates
+	out.APIRuntimeConfig = in.APIRuntimeConfig
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(kops.KonnectivityConfig)
+		if err := Convert_v1alpha2_KonnectivityConfig_To_kops_KonnectivityConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Konnectivity = nil
+	}
+	out.ControlPlaneProfile = kops.ControlPlaneProfile(in.ControlPlaneProfile)
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(kops.NamespaceDefaultsSpec)
+		if err := Convert_v1alpha2_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NamespaceDefaults = nil
+	}
+	if in.Admission != nil {
+		in, out := &in.Admission, &out.Admission
+		*out = new(kops.AdmissionSpec)
+		if err := Convert_v1alpha2_AdmissionSpec_To_kops_AdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Admission = nil
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(kops.SSHCertificateAuthoritySpec)
+		if err := Convert_v1alpha2_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SSHCertificateAuthority = nil
+	}
+	if in.ConnectivityProbes != nil {
+		in, out := &in.ConnectivityProbes, &out.ConnectivityProbes
+		*out = new(kops.ConnectivityProbesSpec)
+		if err := Convert_v1alpha2_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConnectivityProbes = nil
+	}
+	out.DeletionProtection = in.DeletionProtection
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(kops.HibernationSpec)
+		if err := Convert_v1alpha2_HibernationSpec_To_kops_HibernationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hibernation = nil
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(kops.NotificationsSpec)
+		if err := Convert_v1alpha2_NotificationsSpec_To_kops_NotificationsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Notifications = nil
+	}
 	return nil
 }
 
@@ -3178,41 +3953,145 @@ func autoConvert_kops_ClusterSpec_To_v1alpha2_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.Karpenter = nil
 	}
-	return nil
-}
-
-func autoConvert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Zone = in.Zone
-	out.Region = in.Region
-	out.CIDR = in.CIDR
-	out.IPv6CIDR = in.IPv6CIDR
-	out.ID = in.ID
-	out.Egress = in.Egress
-	out.Type = kops.SubnetType(in.Type)
-	out.PublicIP = in.PublicIP
-	if in.AdditionalRoutes != nil {
-		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
-		*out = make([]kops.RouteSpec, len(*in))
-		for i := range *in {
-			if err := Convert_v1alpha2_RouteSpec_To_kops_RouteSpec(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringSpec)
+		if err := Convert_kops_MonitoringSpec_To_v1alpha2_MonitoringSpec(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.AdditionalRoutes = nil
+		out.Monitoring = nil
 	}
-	return nil
-}
-
-// Convert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec is an autogenerated conversion function.
-func Convert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in, out, s)
-}
-
-func autoConvert_kops_ClusterSubnetSpec_To_v1alpha2_ClusterSubnetSpec(in *kops.ClusterSubnetSpec, out *ClusterSubnetSpec, s conversion.Scope) error {
-	out.Name = in.Name
-	out.CIDR = in.CIDR
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(GitOpsSpec)
+		if err := Convert_kops_GitOpsSpec_To_v1alpha2_GitOpsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitOps = nil
+	}
+	if in.AddonResources != nil {
+		in, out := &in.AddonResources, &out.AddonResources
+		*out = new(AddonResourcesSpec)
+		if err := Convert_kops_AddonResourcesSpec_To_v1alpha2_AddonResourcesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AddonResources = nil
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscalerConfig)
+		if err := Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VerticalPodAutoscaler = nil
+	}
+	out.AdoptAddons = in.AdoptAddons
+	out.FeatureGates = in.FeatureGates
+	out.APIRuntimeConfig = in.APIRuntimeConfig
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(KonnectivityConfig)
+		if err := Convert_kops_KonnectivityConfig_To_v1alpha2_KonnectivityConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Konnectivity = nil
+	}
+	out.ControlPlaneProfile = ControlPlaneProfile(in.ControlPlaneProfile)
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(NamespaceDefaultsSpec)
+		if err := Convert_kops_NamespaceDefaultsSpec_To_v1alpha2_NamespaceDefaultsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NamespaceDefaults = nil
+	}
+	if in.Admission != nil {
+		in, out := &in.Admission, &out.Admission
+		*out = new(AdmissionSpec)
+		if err := Convert_kops_AdmissionSpec_To_v1alpha2_AdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Admission = nil
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(SSHCertificateAuthoritySpec)
+		if err := Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha2_SSHCertificateAuthoritySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SSHCertificateAuthority = nil
+	}
+	if in.ConnectivityProbes != nil {
+		in, out := &in.ConnectivityProbes, &out.ConnectivityProbes
+		*out = new(ConnectivityProbesSpec)
+		if err := Convert_kops_ConnectivityProbesSpec_To_v1alpha2_ConnectivityProbesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConnectivityProbes = nil
+	}
+	out.DeletionProtection = in.DeletionProtection
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationSpec)
+		if err := Convert_kops_HibernationSpec_To_v1alpha2_HibernationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hibernation = nil
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsSpec)
+		if err := Convert_kops_NotificationsSpec_To_v1alpha2_NotificationsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Notifications = nil
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zone = in.Zone
+	out.Region = in.Region
+	out.CIDR = in.CIDR
+	out.IPv6CIDR = in.IPv6CIDR
+	out.ID = in.ID
+	out.Egress = in.Egress
+	out.Type = kops.SubnetType(in.Type)
+	out.PublicIP = in.PublicIP
+	if in.AdditionalRoutes != nil {
+		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
+		*out = make([]kops.RouteSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_RouteSpec_To_kops_RouteSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalRoutes = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in, out, s)
+}
+
+func autoConvert_kops_ClusterSubnetSpec_To_v1alpha2_ClusterSubnetSpec(in *kops.ClusterSubnetSpec, out *ClusterSubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
 	out.IPv6CIDR = in.IPv6CIDR
 	out.Zone = in.Zone
 	out.Region = in.Region
@@ -3239,6 +4118,30 @@ func Convert_kops_ClusterSubnetSpec_To_v1alpha2_ClusterSubnetSpec(in *kops.Clust
 	return autoConvert_kops_ClusterSubnetSpec_To_v1alpha2_ClusterSubnetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(in *ConnectivityProbesSpec, out *kops.ConnectivityProbesSpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.Timeout = in.Timeout
+	out.AdditionalTargets = in.AdditionalTargets
+	return nil
+}
+
+// Convert_v1alpha2_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(in *ConnectivityProbesSpec, out *kops.ConnectivityProbesSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(in, out, s)
+}
+
+func autoConvert_kops_ConnectivityProbesSpec_To_v1alpha2_ConnectivityProbesSpec(in *kops.ConnectivityProbesSpec, out *ConnectivityProbesSpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.Timeout = in.Timeout
+	out.AdditionalTargets = in.AdditionalTargets
+	return nil
+}
+
+// Convert_kops_ConnectivityProbesSpec_To_v1alpha2_ConnectivityProbesSpec is an autogenerated conversion function.
+func Convert_kops_ConnectivityProbesSpec_To_v1alpha2_ConnectivityProbesSpec(in *kops.ConnectivityProbesSpec, out *ConnectivityProbesSpec, s conversion.Scope) error {
+	return autoConvert_kops_ConnectivityProbesSpec_To_v1alpha2_ConnectivityProbesSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_ContainerdConfig_To_kops_ContainerdConfig(in *ContainerdConfig, out *kops.ContainerdConfig, s conversion.Scope) error {
 	out.Address = in.Address
 	out.ConfigAdditions = in.ConfigAdditions
@@ -3567,7 +4470,26 @@ func autoConvert_v1alpha2_EBSCSIDriverSpec_To_kops_EBSCSIDriverSpec(in *EBSCSIDr
 	out.KubeAPIBurst = in.KubeAPIBurst
 	out.HostNetwork = in.HostNetwork
 	out.VolumeAttachLimit = in.VolumeAttachLimit
+	out.ReservedVolumeAttachments = in.ReservedVolumeAttachments
 	out.PodAnnotations = in.PodAnnotations
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(kops.CSIStorageClassSpec)
+		if err := Convert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(kops.CSISidecarResources)
+		if err := Convert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -3584,7 +4506,26 @@ func autoConvert_kops_EBSCSIDriverSpec_To_v1alpha2_EBSCSIDriverSpec(in *kops.EBS
 	out.KubeAPIBurst = in.KubeAPIBurst
 	out.HostNetwork = in.HostNetwork
 	out.VolumeAttachLimit = in.VolumeAttachLimit
+	out.ReservedVolumeAttachments = in.ReservedVolumeAttachments
 	out.PodAnnotations = in.PodAnnotations
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(CSIStorageClassSpec)
+		if err := Convert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(CSISidecarResources)
+		if err := Convert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -3703,6 +4644,11 @@ func autoConvert_v1alpha2_EtcdClusterSpec_To_kops_EtcdClusterSpec(in *EtcdCluste
 	}
 	out.MemoryRequest = in.MemoryRequest
 	out.CPURequest = in.CPURequest
+	out.MemoryLimit = in.MemoryLimit
+	out.CPULimit = in.CPULimit
+	out.QuotaBackendBytes = in.QuotaBackendBytes
+	out.AutoCompactionMode = in.AutoCompactionMode
+	out.AutoCompactionRetention = in.AutoCompactionRetention
 	return nil
 }
 
@@ -3749,6 +4695,11 @@ func autoConvert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in *kops.EtcdC
 	}
 	out.MemoryRequest = in.MemoryRequest
 	out.CPURequest = in.CPURequest
+	out.MemoryLimit = in.MemoryLimit
+	out.CPULimit = in.CPULimit
+	out.QuotaBackendBytes = in.QuotaBackendBytes
+	out.AutoCompactionMode = in.AutoCompactionMode
+	out.AutoCompactionRetention = in.AutoCompactionRetention
 	return nil
 }
 
@@ -3757,6 +4708,34 @@ func Convert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in *kops.EtcdClust
 	return autoConvert_kops_EtcdClusterSpec_To_v1alpha2_EtcdClusterSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(in *EtcdIOIsolationSpec, out *kops.EtcdIOIsolationSpec, s conversion.Scope) error {
+	out.Scheduler = in.Scheduler
+	out.MaxReadBandwidth = in.MaxReadBandwidth
+	out.MaxWriteBandwidth = in.MaxWriteBandwidth
+	out.MaxReadIOPS = in.MaxReadIOPS
+	out.MaxWriteIOPS = in.MaxWriteIOPS
+	return nil
+}
+
+// Convert_v1alpha2_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(in *EtcdIOIsolationSpec, out *kops.EtcdIOIsolationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(in, out, s)
+}
+
+func autoConvert_kops_EtcdIOIsolationSpec_To_v1alpha2_EtcdIOIsolationSpec(in *kops.EtcdIOIsolationSpec, out *EtcdIOIsolationSpec, s conversion.Scope) error {
+	out.Scheduler = in.Scheduler
+	out.MaxReadBandwidth = in.MaxReadBandwidth
+	out.MaxWriteBandwidth = in.MaxWriteBandwidth
+	out.MaxReadIOPS = in.MaxReadIOPS
+	out.MaxWriteIOPS = in.MaxWriteIOPS
+	return nil
+}
+
+// Convert_kops_EtcdIOIsolationSpec_To_v1alpha2_EtcdIOIsolationSpec is an autogenerated conversion function.
+func Convert_kops_EtcdIOIsolationSpec_To_v1alpha2_EtcdIOIsolationSpec(in *kops.EtcdIOIsolationSpec, out *EtcdIOIsolationSpec, s conversion.Scope) error {
+	return autoConvert_kops_EtcdIOIsolationSpec_To_v1alpha2_EtcdIOIsolationSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_EtcdManagerSpec_To_kops_EtcdManagerSpec(in *EtcdManagerSpec, out *kops.EtcdManagerSpec, s conversion.Scope) error {
 	out.Image = in.Image
 	if in.Env != nil {
@@ -3987,6 +4966,32 @@ func Convert_kops_GCPNetworkingSpec_To_v1alpha2_GCPNetworkingSpec(in *kops.GCPNe
 	return autoConvert_kops_GCPNetworkingSpec_To_v1alpha2_GCPNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_GitOpsSpec_To_kops_GitOpsSpec(in *GitOpsSpec, out *kops.GitOpsSpec, s conversion.Scope) error {
+	out.Mode = kops.GitOpsMode(in.Mode)
+	out.Interval = in.Interval
+	out.Source = in.Source
+	out.GrantPermissions = in.GrantPermissions
+	return nil
+}
+
+// Convert_v1alpha2_GitOpsSpec_To_kops_GitOpsSpec is an autogenerated conversion function.
+func Convert_v1alpha2_GitOpsSpec_To_kops_GitOpsSpec(in *GitOpsSpec, out *kops.GitOpsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_GitOpsSpec_To_kops_GitOpsSpec(in, out, s)
+}
+
+func autoConvert_kops_GitOpsSpec_To_v1alpha2_GitOpsSpec(in *kops.GitOpsSpec, out *GitOpsSpec, s conversion.Scope) error {
+	out.Mode = GitOpsMode(in.Mode)
+	out.Interval = in.Interval
+	out.Source = in.Source
+	out.GrantPermissions = in.GrantPermissions
+	return nil
+}
+
+// Convert_kops_GitOpsSpec_To_v1alpha2_GitOpsSpec is an autogenerated conversion function.
+func Convert_kops_GitOpsSpec_To_v1alpha2_GitOpsSpec(in *kops.GitOpsSpec, out *GitOpsSpec, s conversion.Scope) error {
+	return autoConvert_kops_GitOpsSpec_To_v1alpha2_GitOpsSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_GossipConfig_To_kops_GossipConfig(in *GossipConfig, out *kops.GossipConfig, s conversion.Scope) error {
 	out.Protocol = in.Protocol
 	out.Listen = in.Listen
@@ -4075,6 +5080,104 @@ func Convert_kops_HTTPProxy_To_v1alpha2_HTTPProxy(in *kops.HTTPProxy, out *HTTPP
 	return autoConvert_kops_HTTPProxy_To_v1alpha2_HTTPProxy(in, out, s)
 }
 
+func autoConvert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(in *HelmChartSpec, out *kops.HelmChartSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Repository = in.Repository
+	out.Chart = in.Chart
+	out.Version = in.Version
+	out.Namespace = in.Namespace
+	out.Values = in.Values
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]kops.HelmValuesSource, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_HelmValuesSource_To_kops_HelmValuesSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ValuesFrom = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec is an autogenerated conversion function.
+func Convert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(in *HelmChartSpec, out *kops.HelmChartSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HelmChartSpec_To_kops_HelmChartSpec(in, out, s)
+}
+
+func autoConvert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(in *kops.HelmChartSpec, out *HelmChartSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Repository = in.Repository
+	out.Chart = in.Chart
+	out.Version = in.Version
+	out.Namespace = in.Namespace
+	out.Values = in.Values
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]HelmValuesSource, len(*in))
+		for i := range *in {
+			if err := Convert_kops_HelmValuesSource_To_v1alpha2_HelmValuesSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ValuesFrom = nil
+	}
+	return nil
+}
+
+// Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec is an autogenerated conversion function.
+func Convert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(in *kops.HelmChartSpec, out *HelmChartSpec, s conversion.Scope) error {
+	return autoConvert_kops_HelmChartSpec_To_v1alpha2_HelmChartSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_HelmValuesSource_To_kops_HelmValuesSource(in *HelmValuesSource, out *kops.HelmValuesSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha2_HelmValuesSource_To_kops_HelmValuesSource is an autogenerated conversion function.
+func Convert_v1alpha2_HelmValuesSource_To_kops_HelmValuesSource(in *HelmValuesSource, out *kops.HelmValuesSource, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HelmValuesSource_To_kops_HelmValuesSource(in, out, s)
+}
+
+func autoConvert_kops_HelmValuesSource_To_v1alpha2_HelmValuesSource(in *kops.HelmValuesSource, out *HelmValuesSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_kops_HelmValuesSource_To_v1alpha2_HelmValuesSource is an autogenerated conversion function.
+func Convert_kops_HelmValuesSource_To_v1alpha2_HelmValuesSource(in *kops.HelmValuesSource, out *HelmValuesSource, s conversion.Scope) error {
+	return autoConvert_kops_HelmValuesSource_To_v1alpha2_HelmValuesSource(in, out, s)
+}
+
+func autoConvert_v1alpha2_HibernationSpec_To_kops_HibernationSpec(in *HibernationSpec, out *kops.HibernationSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.ResumeSchedule = in.ResumeSchedule
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha2_HibernationSpec_To_kops_HibernationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_HibernationSpec_To_kops_HibernationSpec(in *HibernationSpec, out *kops.HibernationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HibernationSpec_To_kops_HibernationSpec(in, out, s)
+}
+
+func autoConvert_kops_HibernationSpec_To_v1alpha2_HibernationSpec(in *kops.HibernationSpec, out *HibernationSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.ResumeSchedule = in.ResumeSchedule
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_kops_HibernationSpec_To_v1alpha2_HibernationSpec is an autogenerated conversion function.
+func Convert_kops_HibernationSpec_To_v1alpha2_HibernationSpec(in *kops.HibernationSpec, out *HibernationSpec, s conversion.Scope) error {
+	return autoConvert_kops_HibernationSpec_To_v1alpha2_HibernationSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_HookSpec_To_kops_HookSpec(in *HookSpec, out *kops.HookSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Enabled = in.Enabled
@@ -4247,6 +5350,36 @@ func Convert_kops_InstanceGroup_To_v1alpha2_InstanceGroup(in *kops.InstanceGroup
 	return autoConvert_kops_InstanceGroup_To_v1alpha2_InstanceGroup(in, out, s)
 }
 
+func autoConvert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in *InstanceGroupClusterAutoscalerSpec, out *kops.InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	out.ScaleDownDisabled = in.ScaleDownDisabled
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	out.ScaleDownUnneededTime = in.ScaleDownUnneededTime
+	out.ScaleDownUnreadyTime = in.ScaleDownUnreadyTime
+	out.MaxNodeProvisionTime = in.MaxNodeProvisionTime
+	out.IgnoreDaemonSetsUtilization = in.IgnoreDaemonSetsUtilization
+	return nil
+}
+
+// Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec is an autogenerated conversion function.
+func Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in *InstanceGroupClusterAutoscalerSpec, out *kops.InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(in *kops.InstanceGroupClusterAutoscalerSpec, out *InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	out.ScaleDownDisabled = in.ScaleDownDisabled
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	out.ScaleDownUnneededTime = in.ScaleDownUnneededTime
+	out.ScaleDownUnreadyTime = in.ScaleDownUnreadyTime
+	out.MaxNodeProvisionTime = in.MaxNodeProvisionTime
+	out.IgnoreDaemonSetsUtilization = in.IgnoreDaemonSetsUtilization
+	return nil
+}
+
+// Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec is an autogenerated conversion function.
+func Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(in *kops.InstanceGroupClusterAutoscalerSpec, out *InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_InstanceGroupList_To_kops_InstanceGroupList(in *InstanceGroupList, out *kops.InstanceGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4292,7 +5425,9 @@ func Convert_kops_InstanceGroupList_To_v1alpha2_InstanceGroupList(in *kops.Insta
 func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *InstanceGroupSpec, out *kops.InstanceGroupSpec, s conversion.Scope) error {
 	out.Manager = kops.InstanceManager(in.Manager)
 	out.Role = kops.InstanceGroupRole(in.Role)
+	out.OperatingSystem = kops.InstanceGroupOperatingSystem(in.OperatingSystem)
 	out.Image = in.Image
+	out.ImageChannel = in.ImageChannel
 	out.MinSize = in.MinSize
 	out.MaxSize = in.MaxSize
 	out.Autoscale = in.Autoscale
@@ -4371,6 +5506,7 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	out.ArchitectureTaint = in.ArchitectureTaint
 	if in.MixedInstancesPolicy != nil {
 		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
 		*out = new(kops.MixedInstancesPolicySpec)
@@ -4417,6 +5553,24 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	out.SecurityGroupOverride = in.SecurityGroupOverride
 	out.InstanceProtection = in.InstanceProtection
 	out.SysctlParameters = in.SysctlParameters
+	if in.Journald != nil {
+		in, out := &in.Journald, &out.Journald
+		*out = new(kops.JournaldSpec)
+		if err := Convert_v1alpha2_JournaldSpec_To_kops_JournaldSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Journald = nil
+	}
+	if in.EtcdIOIsolation != nil {
+		in, out := &in.EtcdIOIsolation, &out.EtcdIOIsolation
+		*out = new(kops.EtcdIOIsolationSpec)
+		if err := Convert_v1alpha2_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EtcdIOIsolation = nil
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(kops.RollingUpdate)
@@ -4470,13 +5624,36 @@ func autoConvert_v1alpha2_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	}
 	out.MaxInstanceLifetime = in.MaxInstanceLifetime
 	out.GCPProvisioningModel = in.GCPProvisioningModel
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(kops.InstanceGroupClusterAutoscalerSpec)
+		if err := Convert_v1alpha2_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterAutoscaler = nil
+	}
+	out.PreferSpot = in.PreferSpot
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]kops.SSHAuthorizedKeysSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.SSHAuthorizedKeys = nil
+	}
 	return nil
 }
 
 func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.InstanceGroupSpec, out *InstanceGroupSpec, s conversion.Scope) error {
 	out.Manager = InstanceManager(in.Manager)
 	out.Role = InstanceGroupRole(in.Role)
+	out.OperatingSystem = InstanceGroupOperatingSystem(in.OperatingSystem)
 	out.Image = in.Image
+	out.ImageChannel = in.ImageChannel
 	out.MinSize = in.MinSize
 	out.MaxSize = in.MaxSize
 	out.Autoscale = in.Autoscale
@@ -4547,6 +5724,7 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	out.ArchitectureTaint = in.ArchitectureTaint
 	if in.MixedInstancesPolicy != nil {
 		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
 		*out = new(MixedInstancesPolicySpec)
@@ -4593,6 +5771,24 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 	out.SecurityGroupOverride = in.SecurityGroupOverride
 	out.InstanceProtection = in.InstanceProtection
 	out.SysctlParameters = in.SysctlParameters
+	if in.Journald != nil {
+		in, out := &in.Journald, &out.Journald
+		*out = new(JournaldSpec)
+		if err := Convert_kops_JournaldSpec_To_v1alpha2_JournaldSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Journald = nil
+	}
+	if in.EtcdIOIsolation != nil {
+		in, out := &in.EtcdIOIsolation, &out.EtcdIOIsolation
+		*out = new(EtcdIOIsolationSpec)
+		if err := Convert_kops_EtcdIOIsolationSpec_To_v1alpha2_EtcdIOIsolationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EtcdIOIsolation = nil
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(RollingUpdate)
@@ -4646,6 +5842,27 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha2_InstanceGroupSpec(in *kops.I
 	}
 	out.MaxInstanceLifetime = in.MaxInstanceLifetime
 	out.GCPProvisioningModel = in.GCPProvisioningModel
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(InstanceGroupClusterAutoscalerSpec)
+		if err := Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha2_InstanceGroupClusterAutoscalerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterAutoscaler = nil
+	}
+	out.PreferSpot = in.PreferSpot
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]SSHAuthorizedKeysSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha2_SSHAuthorizedKeysSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.SSHAuthorizedKeys = nil
+	}
 	return nil
 }
 
@@ -4725,6 +5942,30 @@ func Convert_kops_InstanceRequirementsSpec_To_v1alpha2_InstanceRequirementsSpec(
 	return autoConvert_kops_InstanceRequirementsSpec_To_v1alpha2_InstanceRequirementsSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_JournaldSpec_To_kops_JournaldSpec(in *JournaldSpec, out *kops.JournaldSpec, s conversion.Scope) error {
+	out.SystemMaxUse = in.SystemMaxUse
+	out.RateLimitInterval = in.RateLimitInterval
+	out.RateLimitBurst = in.RateLimitBurst
+	return nil
+}
+
+// Convert_v1alpha2_JournaldSpec_To_kops_JournaldSpec is an autogenerated conversion function.
+func Convert_v1alpha2_JournaldSpec_To_kops_JournaldSpec(in *JournaldSpec, out *kops.JournaldSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_JournaldSpec_To_kops_JournaldSpec(in, out, s)
+}
+
+func autoConvert_kops_JournaldSpec_To_v1alpha2_JournaldSpec(in *kops.JournaldSpec, out *JournaldSpec, s conversion.Scope) error {
+	out.SystemMaxUse = in.SystemMaxUse
+	out.RateLimitInterval = in.RateLimitInterval
+	out.RateLimitBurst = in.RateLimitBurst
+	return nil
+}
+
+// Convert_kops_JournaldSpec_To_v1alpha2_JournaldSpec is an autogenerated conversion function.
+func Convert_kops_JournaldSpec_To_v1alpha2_JournaldSpec(in *kops.JournaldSpec, out *JournaldSpec, s conversion.Scope) error {
+	return autoConvert_kops_JournaldSpec_To_v1alpha2_JournaldSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_KarpenterConfig_To_kops_KarpenterConfig(in *KarpenterConfig, out *kops.KarpenterConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.LogEncoding = in.LogEncoding
@@ -4977,6 +6218,30 @@ func Convert_kops_KindnetNetworkingSpec_To_v1alpha2_KindnetNetworkingSpec(in *ko
 	return autoConvert_kops_KindnetNetworkingSpec_To_v1alpha2_KindnetNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_KonnectivityConfig_To_kops_KonnectivityConfig(in *KonnectivityConfig, out *kops.KonnectivityConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ServerImage = in.ServerImage
+	out.AgentImage = in.AgentImage
+	return nil
+}
+
+// Convert_v1alpha2_KonnectivityConfig_To_kops_KonnectivityConfig is an autogenerated conversion function.
+func Convert_v1alpha2_KonnectivityConfig_To_kops_KonnectivityConfig(in *KonnectivityConfig, out *kops.KonnectivityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KonnectivityConfig_To_kops_KonnectivityConfig(in, out, s)
+}
+
+func autoConvert_kops_KonnectivityConfig_To_v1alpha2_KonnectivityConfig(in *kops.KonnectivityConfig, out *KonnectivityConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ServerImage = in.ServerImage
+	out.AgentImage = in.AgentImage
+	return nil
+}
+
+// Convert_kops_KonnectivityConfig_To_v1alpha2_KonnectivityConfig is an autogenerated conversion function.
+func Convert_kops_KonnectivityConfig_To_v1alpha2_KonnectivityConfig(in *kops.KonnectivityConfig, out *KonnectivityConfig, s conversion.Scope) error {
+	return autoConvert_kops_KonnectivityConfig_To_v1alpha2_KonnectivityConfig(in, out, s)
+}
+
 func autoConvert_v1alpha2_KopeioAuthenticationSpec_To_kops_KopeioAuthenticationSpec(in *KopeioAuthenticationSpec, out *kops.KopeioAuthenticationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -5567,6 +6832,18 @@ func autoConvert_v1alpha2_KubeSchedulerConfig_To_kops_KubeSchedulerConfig(in *Ku
 	out.CPULimit = in.CPULimit
 	out.MemoryRequest = in.MemoryRequest
 	out.MemoryLimit = in.MemoryLimit
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]kops.KubeSchedulerProfile, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Profiles = nil
+	}
 	return nil
 }
 
@@ -5607,6 +6884,18 @@ func autoConvert_kops_KubeSchedulerConfig_To_v1alpha2_KubeSchedulerConfig(in *ko
 	out.CPULimit = in.CPULimit
 	out.MemoryRequest = in.MemoryRequest
 	out.MemoryLimit = in.MemoryLimit
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]KubeSchedulerProfile, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerProfile_To_v1alpha2_KubeSchedulerProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Profiles = nil
+	}
 	return nil
 }
 
@@ -5615,6 +6904,426 @@ func Convert_kops_KubeSchedulerConfig_To_v1alpha2_KubeSchedulerConfig(in *kops.K
 	return autoConvert_kops_KubeSchedulerConfig_To_v1alpha2_KubeSchedulerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(in *KubeSchedulerPlugin, out *kops.KubeSchedulerPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin is an autogenerated conversion function.
+func Convert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(in *KubeSchedulerPlugin, out *kops.KubeSchedulerPlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin(in *kops.KubeSchedulerPlugin, out *KubeSchedulerPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin(in *kops.KubeSchedulerPlugin, out *KubeSchedulerPlugin, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin(in, out, s)
+}
+
+func autoConvert_v1alpha2_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(in *KubeSchedulerPluginConfig, out *kops.KubeSchedulerPluginConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Args = in.Args
+	return nil
+}
+
+// Convert_v1alpha2_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig is an autogenerated conversion function.
+func Convert_v1alpha2_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(in *KubeSchedulerPluginConfig, out *kops.KubeSchedulerPluginConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPluginConfig_To_v1alpha2_KubeSchedulerPluginConfig(in *kops.KubeSchedulerPluginConfig, out *KubeSchedulerPluginConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Args = in.Args
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPluginConfig_To_v1alpha2_KubeSchedulerPluginConfig is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPluginConfig_To_v1alpha2_KubeSchedulerPluginConfig(in *kops.KubeSchedulerPluginConfig, out *KubeSchedulerPluginConfig, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPluginConfig_To_v1alpha2_KubeSchedulerPluginConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(in *KubeSchedulerPluginSet, out *kops.KubeSchedulerPluginSet, s conversion.Scope) error {
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]kops.KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Enabled = nil
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]kops.KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Disabled = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet is an autogenerated conversion function.
+func Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(in *KubeSchedulerPluginSet, out *kops.KubeSchedulerPluginSet, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(in *kops.KubeSchedulerPluginSet, out *KubeSchedulerPluginSet, s conversion.Scope) error {
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Enabled = nil
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerPlugin_To_v1alpha2_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Disabled = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(in *kops.KubeSchedulerPluginSet, out *KubeSchedulerPluginSet, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(in, out, s)
+}
+
+func autoConvert_v1alpha2_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(in *KubeSchedulerPlugins, out *kops.KubeSchedulerPlugins, s conversion.Scope) error {
+	if in.PreEnqueue != nil {
+		in, out := &in.PreEnqueue, &out.PreEnqueue
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreEnqueue = nil
+	}
+	if in.QueueSort != nil {
+		in, out := &in.QueueSort, &out.QueueSort
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.QueueSort = nil
+	}
+	if in.PreFilter != nil {
+		in, out := &in.PreFilter, &out.PreFilter
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreFilter = nil
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	if in.PostFilter != nil {
+		in, out := &in.PostFilter, &out.PostFilter
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostFilter = nil
+	}
+	if in.PreScore != nil {
+		in, out := &in.PreScore, &out.PreScore
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreScore = nil
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Score = nil
+	}
+	if in.Reserve != nil {
+		in, out := &in.Reserve, &out.Reserve
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reserve = nil
+	}
+	if in.Permit != nil {
+		in, out := &in.Permit, &out.Permit
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Permit = nil
+	}
+	if in.PreBind != nil {
+		in, out := &in.PreBind, &out.PreBind
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreBind = nil
+	}
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Bind = nil
+	}
+	if in.PostBind != nil {
+		in, out := &in.PostBind, &out.PostBind
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostBind = nil
+	}
+	if in.MultiPoint != nil {
+		in, out := &in.MultiPoint, &out.MultiPoint
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha2_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MultiPoint = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins is an autogenerated conversion function.
+func Convert_v1alpha2_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(in *KubeSchedulerPlugins, out *kops.KubeSchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPlugins_To_v1alpha2_KubeSchedulerPlugins(in *kops.KubeSchedulerPlugins, out *KubeSchedulerPlugins, s conversion.Scope) error {
+	if in.PreEnqueue != nil {
+		in, out := &in.PreEnqueue, &out.PreEnqueue
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreEnqueue = nil
+	}
+	if in.QueueSort != nil {
+		in, out := &in.QueueSort, &out.QueueSort
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.QueueSort = nil
+	}
+	if in.PreFilter != nil {
+		in, out := &in.PreFilter, &out.PreFilter
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreFilter = nil
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	if in.PostFilter != nil {
+		in, out := &in.PostFilter, &out.PostFilter
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostFilter = nil
+	}
+	if in.PreScore != nil {
+		in, out := &in.PreScore, &out.PreScore
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreScore = nil
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Score = nil
+	}
+	if in.Reserve != nil {
+		in, out := &in.Reserve, &out.Reserve
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reserve = nil
+	}
+	if in.Permit != nil {
+		in, out := &in.Permit, &out.Permit
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Permit = nil
+	}
+	if in.PreBind != nil {
+		in, out := &in.PreBind, &out.PreBind
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreBind = nil
+	}
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Bind = nil
+	}
+	if in.PostBind != nil {
+		in, out := &in.PostBind, &out.PostBind
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostBind = nil
+	}
+	if in.MultiPoint != nil {
+		in, out := &in.MultiPoint, &out.MultiPoint
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha2_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MultiPoint = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPlugins_To_v1alpha2_KubeSchedulerPlugins is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPlugins_To_v1alpha2_KubeSchedulerPlugins(in *kops.KubeSchedulerPlugins, out *KubeSchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPlugins_To_v1alpha2_KubeSchedulerPlugins(in, out, s)
+}
+
+func autoConvert_v1alpha2_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(in *KubeSchedulerProfile, out *kops.KubeSchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(kops.KubeSchedulerPlugins)
+		if err := Convert_v1alpha2_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugins = nil
+	}
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = make([]kops.KubeSchedulerPluginConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PluginConfig = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_KubeSchedulerProfile_To_kops_KubeSchedulerProfile is an autogenerated conversion function.
+func Convert_v1alpha2_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(in *KubeSchedulerProfile, out *kops.KubeSchedulerProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha2_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerProfile_To_v1alpha2_KubeSchedulerProfile(in *kops.KubeSchedulerProfile, out *KubeSchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(KubeSchedulerPlugins)
+		if err := Convert_kops_KubeSchedulerPlugins_To_v1alpha2_KubeSchedulerPlugins(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugins = nil
+	}
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = make([]KubeSchedulerPluginConfig, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerPluginConfig_To_v1alpha2_KubeSchedulerPluginConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PluginConfig = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeSchedulerProfile_To_v1alpha2_KubeSchedulerProfile is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerProfile_To_v1alpha2_KubeSchedulerProfile(in *kops.KubeSchedulerProfile, out *KubeSchedulerProfile, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerProfile_To_v1alpha2_KubeSchedulerProfile(in, out, s)
+}
+
 func autoConvert_v1alpha2_KubeletConfigSpec_To_kops_KubeletConfigSpec(in *KubeletConfigSpec, out *kops.KubeletConfigSpec, s conversion.Scope) error {
 	out.APIServers = in.APIServers
 	out.AnonymousAuth = in.AnonymousAuth
@@ -5711,6 +7420,7 @@ func autoConvert_v1alpha2_KubeletConfigSpec_To_kops_KubeletConfigSpec(in *Kubele
 	out.ShutdownGracePeriod = in.ShutdownGracePeriod
 	out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
 	out.MemorySwapBehavior = in.MemorySwapBehavior
+	out.ReservedResourcesMode = in.ReservedResourcesMode
 	return nil
 }
 
@@ -5815,6 +7525,7 @@ func autoConvert_kops_KubeletConfigSpec_To_v1alpha2_KubeletConfigSpec(in *kops.K
 	out.ShutdownGracePeriod = in.ShutdownGracePeriod
 	out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
 	out.MemorySwapBehavior = in.MemorySwapBehavior
+	out.ReservedResourcesMode = in.ReservedResourcesMode
 	return nil
 }
 
@@ -6061,6 +7772,28 @@ func Convert_kops_LyftVPCNetworkingSpec_To_v1alpha2_LyftVPCNetworkingSpec(in *ko
 	return autoConvert_kops_LyftVPCNetworkingSpec_To_v1alpha2_LyftVPCNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_ManagedBastionSpec_To_kops_ManagedBastionSpec(in *ManagedBastionSpec, out *kops.ManagedBastionSpec, s conversion.Scope) error {
+	out.Type = kops.ManagedBastionType(in.Type)
+	out.SubnetCIDR = in.SubnetCIDR
+	return nil
+}
+
+// Convert_v1alpha2_ManagedBastionSpec_To_kops_ManagedBastionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_ManagedBastionSpec_To_kops_ManagedBastionSpec(in *ManagedBastionSpec, out *kops.ManagedBastionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ManagedBastionSpec_To_kops_ManagedBastionSpec(in, out, s)
+}
+
+func autoConvert_kops_ManagedBastionSpec_To_v1alpha2_ManagedBastionSpec(in *kops.ManagedBastionSpec, out *ManagedBastionSpec, s conversion.Scope) error {
+	out.Type = ManagedBastionType(in.Type)
+	out.SubnetCIDR = in.SubnetCIDR
+	return nil
+}
+
+// Convert_kops_ManagedBastionSpec_To_v1alpha2_ManagedBastionSpec is an autogenerated conversion function.
+func Convert_kops_ManagedBastionSpec_To_v1alpha2_ManagedBastionSpec(in *kops.ManagedBastionSpec, out *ManagedBastionSpec, s conversion.Scope) error {
+	return autoConvert_kops_ManagedBastionSpec_To_v1alpha2_ManagedBastionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_MetricsServerConfig_To_kops_MetricsServerConfig(in *MetricsServerConfig, out *kops.MetricsServerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Image = in.Image
@@ -6155,6 +7888,50 @@ func Convert_kops_MixedInstancesPolicySpec_To_v1alpha2_MixedInstancesPolicySpec(
 	return autoConvert_kops_MixedInstancesPolicySpec_To_v1alpha2_MixedInstancesPolicySpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_MonitoringSpec_To_kops_MonitoringSpec(in *MonitoringSpec, out *kops.MonitoringSpec, s conversion.Scope) error {
+	out.Metrics = in.Metrics
+	out.ServiceMonitors = in.ServiceMonitors
+	out.Health = in.Health
+	out.HealthInterval = in.HealthInterval
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(kops.CertificateExpirySpec)
+		if err := Convert_v1alpha2_CertificateExpirySpec_To_kops_CertificateExpirySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CertificateExpiry = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_MonitoringSpec_To_kops_MonitoringSpec is an autogenerated conversion function.
+func Convert_v1alpha2_MonitoringSpec_To_kops_MonitoringSpec(in *MonitoringSpec, out *kops.MonitoringSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MonitoringSpec_To_kops_MonitoringSpec(in, out, s)
+}
+
+func autoConvert_kops_MonitoringSpec_To_v1alpha2_MonitoringSpec(in *kops.MonitoringSpec, out *MonitoringSpec, s conversion.Scope) error {
+	out.Metrics = in.Metrics
+	out.ServiceMonitors = in.ServiceMonitors
+	out.Health = in.Health
+	out.HealthInterval = in.HealthInterval
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(CertificateExpirySpec)
+		if err := Convert_kops_CertificateExpirySpec_To_v1alpha2_CertificateExpirySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CertificateExpiry = nil
+	}
+	return nil
+}
+
+// Convert_kops_MonitoringSpec_To_v1alpha2_MonitoringSpec is an autogenerated conversion function.
+func Convert_kops_MonitoringSpec_To_v1alpha2_MonitoringSpec(in *kops.MonitoringSpec, out *MonitoringSpec, s conversion.Scope) error {
+	return autoConvert_kops_MonitoringSpec_To_v1alpha2_MonitoringSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_NRIConfig_To_kops_NRIConfig(in *NRIConfig, out *kops.NRIConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.PluginRegistrationTimeout = in.PluginRegistrationTimeout
@@ -6181,6 +7958,16 @@ func Convert_kops_NRIConfig_To_v1alpha2_NRIConfig(in *kops.NRIConfig, out *NRICo
 
 func autoConvert_v1alpha2_NTPConfig_To_kops_NTPConfig(in *NTPConfig, out *kops.NTPConfig, s conversion.Scope) error {
 	out.Managed = in.Managed
+	out.Servers = in.Servers
+	if in.MakeStep != nil {
+		in, out := &in.MakeStep, &out.MakeStep
+		*out = new(kops.NTPMakeStepSpec)
+		if err := Convert_v1alpha2_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MakeStep = nil
+	}
 	return nil
 }
 
@@ -6191,6 +7978,16 @@ func Convert_v1alpha2_NTPConfig_To_kops_NTPConfig(in *NTPConfig, out *kops.NTPCo
 
 func autoConvert_kops_NTPConfig_To_v1alpha2_NTPConfig(in *kops.NTPConfig, out *NTPConfig, s conversion.Scope) error {
 	out.Managed = in.Managed
+	out.Servers = in.Servers
+	if in.MakeStep != nil {
+		in, out := &in.MakeStep, &out.MakeStep
+		*out = new(NTPMakeStepSpec)
+		if err := Convert_kops_NTPMakeStepSpec_To_v1alpha2_NTPMakeStepSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MakeStep = nil
+	}
 	return nil
 }
 
@@ -6199,6 +7996,162 @@ func Convert_kops_NTPConfig_To_v1alpha2_NTPConfig(in *kops.NTPConfig, out *NTPCo
 	return autoConvert_kops_NTPConfig_To_v1alpha2_NTPConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(in *NTPMakeStepSpec, out *kops.NTPMakeStepSpec, s conversion.Scope) error {
+	out.Threshold = in.Threshold
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_v1alpha2_NTPMakeStepSpec_To_kops_NTPMakeStepSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(in *NTPMakeStepSpec, out *kops.NTPMakeStepSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(in, out, s)
+}
+
+func autoConvert_kops_NTPMakeStepSpec_To_v1alpha2_NTPMakeStepSpec(in *kops.NTPMakeStepSpec, out *NTPMakeStepSpec, s conversion.Scope) error {
+	out.Threshold = in.Threshold
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_kops_NTPMakeStepSpec_To_v1alpha2_NTPMakeStepSpec is an autogenerated conversion function.
+func Convert_kops_NTPMakeStepSpec_To_v1alpha2_NTPMakeStepSpec(in *kops.NTPMakeStepSpec, out *NTPMakeStepSpec, s conversion.Scope) error {
+	return autoConvert_kops_NTPMakeStepSpec_To_v1alpha2_NTPMakeStepSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(in *NamespaceDefaultsSpec, out *kops.NamespaceDefaultsSpec, s conversion.Scope) error {
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]kops.NamespaceLimitRangeSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LimitRanges = nil
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]kops.NamespaceResourceQuotaSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceQuotas = nil
+	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]kops.PriorityClassSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_PriorityClassSpec_To_kops_PriorityClassSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PriorityClasses = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(in *NamespaceDefaultsSpec, out *kops.NamespaceDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(in, out, s)
+}
+
+func autoConvert_kops_NamespaceDefaultsSpec_To_v1alpha2_NamespaceDefaultsSpec(in *kops.NamespaceDefaultsSpec, out *NamespaceDefaultsSpec, s conversion.Scope) error {
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]NamespaceLimitRangeSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_NamespaceLimitRangeSpec_To_v1alpha2_NamespaceLimitRangeSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LimitRanges = nil
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]NamespaceResourceQuotaSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha2_NamespaceResourceQuotaSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceQuotas = nil
+	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]PriorityClassSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_PriorityClassSpec_To_v1alpha2_PriorityClassSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PriorityClasses = nil
+	}
+	return nil
+}
+
+// Convert_kops_NamespaceDefaultsSpec_To_v1alpha2_NamespaceDefaultsSpec is an autogenerated conversion function.
+func Convert_kops_NamespaceDefaultsSpec_To_v1alpha2_NamespaceDefaultsSpec(in *kops.NamespaceDefaultsSpec, out *NamespaceDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_kops_NamespaceDefaultsSpec_To_v1alpha2_NamespaceDefaultsSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(in *NamespaceLimitRangeSpec, out *kops.NamespaceLimitRangeSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Limits = in.Limits
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(in *NamespaceLimitRangeSpec, out *kops.NamespaceLimitRangeSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(in, out, s)
+}
+
+func autoConvert_kops_NamespaceLimitRangeSpec_To_v1alpha2_NamespaceLimitRangeSpec(in *kops.NamespaceLimitRangeSpec, out *NamespaceLimitRangeSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Limits = in.Limits
+	return nil
+}
+
+// Convert_kops_NamespaceLimitRangeSpec_To_v1alpha2_NamespaceLimitRangeSpec is an autogenerated conversion function.
+func Convert_kops_NamespaceLimitRangeSpec_To_v1alpha2_NamespaceLimitRangeSpec(in *kops.NamespaceLimitRangeSpec, out *NamespaceLimitRangeSpec, s conversion.Scope) error {
+	return autoConvert_kops_NamespaceLimitRangeSpec_To_v1alpha2_NamespaceLimitRangeSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(in *NamespaceResourceQuotaSpec, out *kops.NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Hard = in.Hard
+	out.Scopes = in.Scopes
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(in *NamespaceResourceQuotaSpec, out *kops.NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(in, out, s)
+}
+
+func autoConvert_kops_NamespaceResourceQuotaSpec_To_v1alpha2_NamespaceResourceQuotaSpec(in *kops.NamespaceResourceQuotaSpec, out *NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Hard = in.Hard
+	out.Scopes = in.Scopes
+	return nil
+}
+
+// Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha2_NamespaceResourceQuotaSpec is an autogenerated conversion function.
+func Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha2_NamespaceResourceQuotaSpec(in *kops.NamespaceResourceQuotaSpec, out *NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	return autoConvert_kops_NamespaceResourceQuotaSpec_To_v1alpha2_NamespaceResourceQuotaSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_NetworkingSpec_To_kops_NetworkingSpec(in *NetworkingSpec, out *kops.NetworkingSpec, s conversion.Scope) error {
 	out.NetworkID = in.NetworkID
 	out.NetworkCIDR = in.NetworkCIDR
@@ -6764,6 +8717,92 @@ func autoConvert_kops_NodeTerminationHandlerSpec_To_v1alpha2_NodeTerminationHand
 	return nil
 }
 
+func autoConvert_v1alpha2_NotificationSinkSpec_To_kops_NotificationSinkSpec(in *NotificationSinkSpec, out *kops.NotificationSinkSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = kops.NotificationSinkType(in.Type)
+	out.URL = in.URL
+	out.TopicARN = in.TopicARN
+	out.EventBusARN = in.EventBusARN
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]kops.NotificationEventType, len(*in))
+		for i := range *in {
+			(*out)[i] = kops.NotificationEventType((*in)[i])
+		}
+	} else {
+		out.Events = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NotificationSinkSpec_To_kops_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NotificationSinkSpec_To_kops_NotificationSinkSpec(in *NotificationSinkSpec, out *kops.NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NotificationSinkSpec_To_kops_NotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_kops_NotificationSinkSpec_To_v1alpha2_NotificationSinkSpec(in *kops.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = NotificationSinkType(in.Type)
+	out.URL = in.URL
+	out.TopicARN = in.TopicARN
+	out.EventBusARN = in.EventBusARN
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEventType, len(*in))
+		for i := range *in {
+			(*out)[i] = NotificationEventType((*in)[i])
+		}
+	} else {
+		out.Events = nil
+	}
+	return nil
+}
+
+// Convert_kops_NotificationSinkSpec_To_v1alpha2_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_kops_NotificationSinkSpec_To_v1alpha2_NotificationSinkSpec(in *kops.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_kops_NotificationSinkSpec_To_v1alpha2_NotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NotificationsSpec_To_kops_NotificationsSpec(in *NotificationsSpec, out *kops.NotificationsSpec, s conversion.Scope) error {
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]kops.NotificationSinkSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_NotificationSinkSpec_To_kops_NotificationSinkSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Sinks = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NotificationsSpec_To_kops_NotificationsSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NotificationsSpec_To_kops_NotificationsSpec(in *NotificationsSpec, out *kops.NotificationsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NotificationsSpec_To_kops_NotificationsSpec(in, out, s)
+}
+
+func autoConvert_kops_NotificationsSpec_To_v1alpha2_NotificationsSpec(in *kops.NotificationsSpec, out *NotificationsSpec, s conversion.Scope) error {
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]NotificationSinkSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_NotificationSinkSpec_To_v1alpha2_NotificationSinkSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Sinks = nil
+	}
+	return nil
+}
+
+// Convert_kops_NotificationsSpec_To_v1alpha2_NotificationsSpec is an autogenerated conversion function.
+func Convert_kops_NotificationsSpec_To_v1alpha2_NotificationsSpec(in *kops.NotificationsSpec, out *NotificationsSpec, s conversion.Scope) error {
+	return autoConvert_kops_NotificationsSpec_To_v1alpha2_NotificationsSpec(in, out, s)
+}
+
 // Convert_kops_NodeTerminationHandlerSpec_To_v1alpha2_NodeTerminationHandlerSpec is an autogenerated conversion function.
 func Convert_kops_NodeTerminationHandlerSpec_To_v1alpha2_NodeTerminationHandlerSpec(in *kops.NodeTerminationHandlerSpec, out *NodeTerminationHandlerSpec, s conversion.Scope) error {
 	return autoConvert_kops_NodeTerminationHandlerSpec_To_v1alpha2_NodeTerminationHandlerSpec(in, out, s)
@@ -7113,6 +9152,24 @@ func Convert_kops_OpenstackSpec_To_v1alpha2_OpenstackSpec(in *kops.OpenstackSpec
 
 func autoConvert_v1alpha2_PDCSIDriver_To_kops_PDCSIDriver(in *PDCSIDriver, out *kops.PDCSIDriver, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(kops.CSIStorageClassSpec)
+		if err := Convert_v1alpha2_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(kops.CSISidecarResources)
+		if err := Convert_v1alpha2_CSISidecarResources_To_kops_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -7123,6 +9180,24 @@ func Convert_v1alpha2_PDCSIDriver_To_kops_PDCSIDriver(in *PDCSIDriver, out *kops
 
 func autoConvert_kops_PDCSIDriver_To_v1alpha2_PDCSIDriver(in *kops.PDCSIDriver, out *PDCSIDriver, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(CSIStorageClassSpec)
+		if err := Convert_kops_CSIStorageClassSpec_To_v1alpha2_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(CSISidecarResources)
+		if err := Convert_kops_CSISidecarResources_To_v1alpha2_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -7179,6 +9254,66 @@ func Convert_kops_PodIdentityWebhookSpec_To_v1alpha2_PodIdentityWebhookSpec(in *
 	return autoConvert_kops_PodIdentityWebhookSpec_To_v1alpha2_PodIdentityWebhookSpec(in, out, s)
 }
 
+func autoConvert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in *PodSecurityAdmissionSpec, out *kops.PodSecurityAdmissionSpec, s conversion.Scope) error {
+	out.Enforce = kops.PodSecurityLevel(in.Enforce)
+	out.Audit = kops.PodSecurityLevel(in.Audit)
+	out.Warn = kops.PodSecurityLevel(in.Warn)
+	out.Version = in.Version
+	out.ExemptNamespaces = in.ExemptNamespaces
+	out.ExemptUsernames = in.ExemptUsernames
+	out.ExemptRuntimeClasses = in.ExemptRuntimeClasses
+	return nil
+}
+
+// Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in *PodSecurityAdmissionSpec, out *kops.PodSecurityAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(in *kops.PodSecurityAdmissionSpec, out *PodSecurityAdmissionSpec, s conversion.Scope) error {
+	out.Enforce = PodSecurityLevel(in.Enforce)
+	out.Audit = PodSecurityLevel(in.Audit)
+	out.Warn = PodSecurityLevel(in.Warn)
+	out.Version = in.Version
+	out.ExemptNamespaces = in.ExemptNamespaces
+	out.ExemptUsernames = in.ExemptUsernames
+	out.ExemptRuntimeClasses = in.ExemptRuntimeClasses
+	return nil
+}
+
+// Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec is an autogenerated conversion function.
+func Convert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(in *kops.PodSecurityAdmissionSpec, out *PodSecurityAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_PodSecurityAdmissionSpec_To_v1alpha2_PodSecurityAdmissionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_PriorityClassSpec_To_kops_PriorityClassSpec(in *PriorityClassSpec, out *kops.PriorityClassSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.PreemptionPolicy = in.PreemptionPolicy
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha2_PriorityClassSpec_To_kops_PriorityClassSpec is an autogenerated conversion function.
+func Convert_v1alpha2_PriorityClassSpec_To_kops_PriorityClassSpec(in *PriorityClassSpec, out *kops.PriorityClassSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_PriorityClassSpec_To_kops_PriorityClassSpec(in, out, s)
+}
+
+func autoConvert_kops_PriorityClassSpec_To_v1alpha2_PriorityClassSpec(in *kops.PriorityClassSpec, out *PriorityClassSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.PreemptionPolicy = in.PreemptionPolicy
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_kops_PriorityClassSpec_To_v1alpha2_PriorityClassSpec is an autogenerated conversion function.
+func Convert_kops_PriorityClassSpec_To_v1alpha2_PriorityClassSpec(in *kops.PriorityClassSpec, out *PriorityClassSpec, s conversion.Scope) error {
+	return autoConvert_kops_PriorityClassSpec_To_v1alpha2_PriorityClassSpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec(in *RBACAuthorizationSpec, out *kops.RBACAuthorizationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -7303,6 +9438,50 @@ func Convert_kops_Runc_To_v1alpha2_Runc(in *kops.Runc, out *Runc, s conversion.S
 	return autoConvert_kops_Runc_To_v1alpha2_Runc(in, out, s)
 }
 
+func autoConvert_v1alpha2_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(in *SSHAuthorizedKeysSpec, out *kops.SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	out.User = in.User
+	out.PublicKeys = in.PublicKeys
+	return nil
+}
+
+// Convert_v1alpha2_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec is an autogenerated conversion function.
+func Convert_v1alpha2_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(in *SSHAuthorizedKeysSpec, out *kops.SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(in, out, s)
+}
+
+func autoConvert_kops_SSHAuthorizedKeysSpec_To_v1alpha2_SSHAuthorizedKeysSpec(in *kops.SSHAuthorizedKeysSpec, out *SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	out.User = in.User
+	out.PublicKeys = in.PublicKeys
+	return nil
+}
+
+// Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha2_SSHAuthorizedKeysSpec is an autogenerated conversion function.
+func Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha2_SSHAuthorizedKeysSpec(in *kops.SSHAuthorizedKeysSpec, out *SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	return autoConvert_kops_SSHAuthorizedKeysSpec_To_v1alpha2_SSHAuthorizedKeysSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(in *SSHCertificateAuthoritySpec, out *kops.SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	out.Principals = in.Principals
+	out.MaxTTL = in.MaxTTL
+	return nil
+}
+
+// Convert_v1alpha2_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec is an autogenerated conversion function.
+func Convert_v1alpha2_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(in *SSHCertificateAuthoritySpec, out *kops.SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(in, out, s)
+}
+
+func autoConvert_kops_SSHCertificateAuthoritySpec_To_v1alpha2_SSHCertificateAuthoritySpec(in *kops.SSHCertificateAuthoritySpec, out *SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	out.Principals = in.Principals
+	out.MaxTTL = in.MaxTTL
+	return nil
+}
+
+// Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha2_SSHCertificateAuthoritySpec is an autogenerated conversion function.
+func Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha2_SSHCertificateAuthoritySpec(in *kops.SSHCertificateAuthoritySpec, out *SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	return autoConvert_kops_SSHCertificateAuthoritySpec_To_v1alpha2_SSHCertificateAuthoritySpec(in, out, s)
+}
+
 func autoConvert_v1alpha2_SSHCredential_To_kops_SSHCredential(in *SSHCredential, out *kops.SSHCredential, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_SSHCredentialSpec_To_kops_SSHCredentialSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -7516,6 +9695,9 @@ func Convert_kops_TargetSpec_To_v1alpha2_TargetSpec(in *kops.TargetSpec, out *Ta
 func autoConvert_v1alpha2_TerraformSpec_To_kops_TerraformSpec(in *TerraformSpec, out *kops.TerraformSpec, s conversion.Scope) error {
 	out.ProviderExtraConfig = in.ProviderExtraConfig
 	out.FilesProviderExtraConfig = in.FilesProviderExtraConfig
+	out.ProviderVersions = in.ProviderVersions
+	out.InstanceGroupModules = in.InstanceGroupModules
+	out.ImportExistingResources = in.ImportExistingResources
 	return nil
 }
 
@@ -7527,6 +9709,9 @@ func Convert_v1alpha2_TerraformSpec_To_kops_TerraformSpec(in *TerraformSpec, out
 func autoConvert_kops_TerraformSpec_To_v1alpha2_TerraformSpec(in *kops.TerraformSpec, out *TerraformSpec, s conversion.Scope) error {
 	out.ProviderExtraConfig = in.ProviderExtraConfig
 	out.FilesProviderExtraConfig = in.FilesProviderExtraConfig
+	out.ProviderVersions = in.ProviderVersions
+	out.InstanceGroupModules = in.InstanceGroupModules
+	out.ImportExistingResources = in.ImportExistingResources
 	return nil
 }
 
@@ -7547,6 +9732,15 @@ func autoConvert_v1alpha2_TopologySpec_To_kops_TopologySpec(in *TopologySpec, ou
 	} else {
 		out.Bastion = nil
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(kops.VPNSpec)
+		if err := Convert_v1alpha2_VPNSpec_To_kops_VPNSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VPN = nil
+	}
 	out.DNS = kops.DNSType(in.DNS)
 	// INFO: in.LegacyDNS opted out of conversion generation
 	return nil
@@ -7562,6 +9756,15 @@ func autoConvert_kops_TopologySpec_To_v1alpha2_TopologySpec(in *kops.TopologySpe
 	} else {
 		out.Bastion = nil
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(VPNSpec)
+		if err := Convert_kops_VPNSpec_To_v1alpha2_VPNSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VPN = nil
+	}
 	out.DNS = DNSType(in.DNS)
 	return nil
 }
@@ -7590,6 +9793,56 @@ func Convert_kops_UserData_To_v1alpha2_UserData(in *kops.UserData, out *UserData
 	return autoConvert_kops_UserData_To_v1alpha2_UserData(in, out, s)
 }
 
+func autoConvert_v1alpha2_VPNSpec_To_kops_VPNSpec(in *VPNSpec, out *kops.VPNSpec, s conversion.Scope) error {
+	out.Type = kops.VPNType(in.Type)
+	out.ClientCIDR = in.ClientCIDR
+	out.ServerCertificate = in.ServerCertificate
+	out.ClientCertificateAuthority = in.ClientCertificateAuthority
+	out.Subnets = in.Subnets
+	return nil
+}
+
+// Convert_v1alpha2_VPNSpec_To_kops_VPNSpec is an autogenerated conversion function.
+func Convert_v1alpha2_VPNSpec_To_kops_VPNSpec(in *VPNSpec, out *kops.VPNSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VPNSpec_To_kops_VPNSpec(in, out, s)
+}
+
+func autoConvert_kops_VPNSpec_To_v1alpha2_VPNSpec(in *kops.VPNSpec, out *VPNSpec, s conversion.Scope) error {
+	out.Type = VPNType(in.Type)
+	out.ClientCIDR = in.ClientCIDR
+	out.ServerCertificate = in.ServerCertificate
+	out.ClientCertificateAuthority = in.ClientCertificateAuthority
+	out.Subnets = in.Subnets
+	return nil
+}
+
+// Convert_kops_VPNSpec_To_v1alpha2_VPNSpec is an autogenerated conversion function.
+func Convert_kops_VPNSpec_To_v1alpha2_VPNSpec(in *kops.VPNSpec, out *VPNSpec, s conversion.Scope) error {
+	return autoConvert_kops_VPNSpec_To_v1alpha2_VPNSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in *VerticalPodAutoscalerConfig, out *kops.VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RecommenderOnly = in.RecommenderOnly
+	return nil
+}
+
+// Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in *VerticalPodAutoscalerConfig, out *kops.VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in, out, s)
+}
+
+func autoConvert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(in *kops.VerticalPodAutoscalerConfig, out *VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RecommenderOnly = in.RecommenderOnly
+	return nil
+}
+
+// Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(in *kops.VerticalPodAutoscalerConfig, out *VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_kops_VerticalPodAutoscalerConfig_To_v1alpha2_VerticalPodAutoscalerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha2_VolumeMountSpec_To_kops_VolumeMountSpec(in *VolumeMountSpec, out *kops.VolumeMountSpec, s conversion.Scope) error {
 	out.Device = in.Device
 	out.Filesystem = in.Filesystem
@@ -192,9 +192,59 @@ func (in *AccessLogSpec) DeepCopy() *AccessLogSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonResourceOverride) DeepCopyInto(out *AddonResourceOverride) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonResourceOverride.
+func (in *AddonResourceOverride) DeepCopy() *AddonResourceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonResourceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonResourcesSpec) DeepCopyInto(out *AddonResourcesSpec) {
+	*out = *in
+	if in.Autosize != nil {
+		in, out := &in.Autosize, &out.Autosize
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]AddonResourceOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonResourcesSpec.
+func (in *AddonResourcesSpec) DeepCopy() *AddonResourcesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonResourcesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonSpec) DeepCopyInto(out *AddonSpec) {
 	*out = *in
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(HelmChartSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -208,6 +258,27 @@ func (in *AddonSpec) DeepCopy() *AddonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionSpec) DeepCopyInto(out *AdmissionSpec) {
+	*out = *in
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityAdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionSpec.
+func (in *AdmissionSpec) DeepCopy() *AdmissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlwaysAllowAuthorizationSpec) DeepCopyInto(out *AlwaysAllowAuthorizationSpec) {
 	*out = *in
@@ -245,6 +316,32 @@ func (in *AmazonVPCNetworkingSpec) DeepCopy() *AmazonVPCNetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssetSignatureVerificationSpec) DeepCopyInto(out *AssetSignatureVerificationSpec) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignedChecksums != nil {
+		in, out := &in.SignedChecksums, &out.SignedChecksums
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssetSignatureVerificationSpec.
+func (in *AssetSignatureVerificationSpec) DeepCopy() *AssetSignatureVerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AssetSignatureVerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AssetsSpec) DeepCopyInto(out *AssetsSpec) {
 	*out = *in
@@ -263,6 +360,18 @@ func (in *AssetsSpec) DeepCopyInto(out *AssetsSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ImageDigests != nil {
+		in, out := &in.ImageDigests, &out.ImageDigests
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(AssetSignatureVerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -349,6 +458,32 @@ func (in *AzureSpec) DeepCopy() *AzureSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionAccessSpec) DeepCopyInto(out *BastionAccessSpec) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionAccessSpec.
+func (in *BastionAccessSpec) DeepCopy() *BastionAccessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionAccessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionLoadBalancerSpec) DeepCopyInto(out *BastionLoadBalancerSpec) {
 	*out = *in
@@ -383,6 +518,23 @@ func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
 		*out = new(BastionLoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(ManagedBastionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]BastionAccessSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SessionIdleTimeout != nil {
+		in, out := &in.SessionIdleTimeout, &out.SessionIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -412,6 +564,58 @@ func (in *CNINetworkingSpec) DeepCopy() *CNINetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSISidecarResources) DeepCopyInto(out *CSISidecarResources) {
+	*out = *in
+	if in.CPURequest != nil {
+		in, out := &in.CPURequest, &out.CPURequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSISidecarResources.
+func (in *CSISidecarResources) DeepCopy() *CSISidecarResources {
+	if in == nil {
+		return nil
+	}
+	out := new(CSISidecarResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIStorageClassSpec) DeepCopyInto(out *CSIStorageClassSpec) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIStorageClassSpec.
+func (in *CSIStorageClassSpec) DeepCopy() *CSIStorageClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIStorageClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalicoNetworkingSpec) DeepCopyInto(out *CalicoNetworkingSpec) {
 	*out = *in
@@ -514,6 +718,11 @@ func (in *CertManagerConfig) DeepCopyInto(out *CertManagerConfig) {
 			(*out)[key] = val
 		}
 	}
+	if in.UseClusterCA != nil {
+		in, out := &in.UseClusterCA, &out.UseClusterCA
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -527,6 +736,27 @@ func (in *CertManagerConfig) DeepCopy() *CertManagerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateExpirySpec) DeepCopyInto(out *CertificateExpirySpec) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExpirySpec.
+func (in *CertificateExpirySpec) DeepCopy() *CertificateExpirySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateExpirySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CiliumGatewayAPISpec) DeepCopyInto(out *CiliumGatewayAPISpec) {
 	*out = *in
@@ -792,6 +1022,11 @@ func (in *CloudConfiguration) DeepCopyInto(out *CloudConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResizeVolumesInPlace != nil {
+		in, out := &in.ResizeVolumesInPlace, &out.ResizeVolumesInPlace
+		*out = new(bool)
+		**out = **in
+	}
 	if in.VSphereUsername != nil {
 		in, out := &in.VSphereUsername, &out.VSphereUsername
 		*out = new(string)
@@ -918,6 +1153,21 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.CPULimit != nil {
+		in, out := &in.CPULimit, &out.CPULimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryRequest != nil {
+		in, out := &in.MemoryRequest, &out.MemoryRequest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.NodeStatusUpdateFrequency != nil {
 		in, out := &in.NodeStatusUpdateFrequency, &out.NodeStatusUpdateFrequency
 		*out = new(v1.Duration)
@@ -1083,6 +1333,16 @@ func (in *ClusterAutoscalerConfig) DeepCopyInto(out *ClusterAutoscalerConfig) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.BalancingLabels != nil {
+		in, out := &in.BalancingLabels, &out.BalancingLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BalancingIgnoreLabels != nil {
+		in, out := &in.BalancingIgnoreLabels, &out.BalancingIgnoreLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1135,7 +1395,9 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	if in.Addons != nil {
 		in, out := &in.Addons, &out.Addons
 		*out = make([]AddonSpec, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	out.ConfigStore = in.ConfigStore
 	in.CloudProvider.DeepCopyInto(&out.CloudProvider)
@@ -1448,6 +1710,80 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(PodIdentityWebhookSpec)
 		**out = **in
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(GitOpsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddonResources != nil {
+		in, out := &in.AddonResources, &out.AddonResources
+		*out = new(AddonResourcesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscalerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdoptAddons != nil {
+		in, out := &in.AdoptAddons, &out.AdoptAddons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.APIRuntimeConfig != nil {
+		in, out := &in.APIRuntimeConfig, &out.APIRuntimeConfig
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(KonnectivityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(NamespaceDefaultsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Admission != nil {
+		in, out := &in.Admission, &out.Admission
+		*out = new(AdmissionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(SSHCertificateAuthoritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectivityProbes != nil {
+		in, out := &in.ConnectivityProbes, &out.ConnectivityProbes
+		*out = new(ConnectivityProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationSpec)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1482,6 +1818,37 @@ func (in *ClusterSubnetSpec) DeepCopy() *ClusterSubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityProbesSpec) DeepCopyInto(out *ConnectivityProbesSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AdditionalTargets != nil {
+		in, out := &in.AdditionalTargets, &out.AdditionalTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityProbesSpec.
+func (in *ConnectivityProbesSpec) DeepCopy() *ConnectivityProbesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityProbesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
 	*out = *in
@@ -1899,13 +2266,28 @@ func (in *EBSCSIDriverSpec) DeepCopyInto(out *EBSCSIDriverSpec) {
 		*out = new(int)
 		**out = **in
 	}
-	if in.PodAnnotations != nil {
+	if in.ReservedVolumeAttachments != nil {
+		in, out := &in.ReservedVolumeAttachments, &out.ReservedVolumeAttachments
+		*out = new(int)
+		**out = **in
+	}
+	if in.PodAnnotations != nil {
 		in, out := &in.PodAnnotations, &out.PodAnnotations
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(CSIStorageClassSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(CSISidecarResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2008,6 +2390,21 @@ func (in *EtcdClusterSpec) DeepCopyInto(out *EtcdClusterSpec) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MemoryLimit != nil {
+		in, out := &in.MemoryLimit, &out.MemoryLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CPULimit != nil {
+		in, out := &in.CPULimit, &out.CPULimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.QuotaBackendBytes != nil {
+		in, out := &in.QuotaBackendBytes, &out.QuotaBackendBytes
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -2021,6 +2418,42 @@ func (in *EtcdClusterSpec) DeepCopy() *EtcdClusterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdIOIsolationSpec) DeepCopyInto(out *EtcdIOIsolationSpec) {
+	*out = *in
+	if in.MaxReadBandwidth != nil {
+		in, out := &in.MaxReadBandwidth, &out.MaxReadBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxWriteBandwidth != nil {
+		in, out := &in.MaxWriteBandwidth, &out.MaxWriteBandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxReadIOPS != nil {
+		in, out := &in.MaxReadIOPS, &out.MaxReadIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxWriteIOPS != nil {
+		in, out := &in.MaxWriteIOPS, &out.MaxWriteIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdIOIsolationSpec.
+func (in *EtcdIOIsolationSpec) DeepCopy() *EtcdIOIsolationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdIOIsolationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EtcdManagerSpec) DeepCopyInto(out *EtcdManagerSpec) {
 	*out = *in
@@ -2241,6 +2674,27 @@ func (in *GCPNetworkingSpec) DeepCopy() *GCPNetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitOpsSpec) DeepCopyInto(out *GitOpsSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitOpsSpec.
+func (in *GitOpsSpec) DeepCopy() *GitOpsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitOpsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GossipConfig) DeepCopyInto(out *GossipConfig) {
 	*out = *in
@@ -2324,6 +2778,59 @@ func (in *HTTPProxy) DeepCopy() *HTTPProxy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartSpec) DeepCopyInto(out *HelmChartSpec) {
+	*out = *in
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]HelmValuesSource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartSpec.
+func (in *HelmChartSpec) DeepCopy() *HelmChartSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesSource) DeepCopyInto(out *HelmValuesSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesSource.
+func (in *HelmValuesSource) DeepCopy() *HelmValuesSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationSpec) DeepCopyInto(out *HibernationSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationSpec.
+func (in *HibernationSpec) DeepCopy() *HibernationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HookSpec) DeepCopyInto(out *HookSpec) {
 	*out = *in
@@ -2548,6 +3055,52 @@ func (in *InstanceGroup) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceGroupClusterAutoscalerSpec) DeepCopyInto(out *InstanceGroupClusterAutoscalerSpec) {
+	*out = *in
+	if in.ScaleDownDisabled != nil {
+		in, out := &in.ScaleDownDisabled, &out.ScaleDownDisabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScaleDownUtilizationThreshold != nil {
+		in, out := &in.ScaleDownUtilizationThreshold, &out.ScaleDownUtilizationThreshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScaleDownUnreadyTime != nil {
+		in, out := &in.ScaleDownUnreadyTime, &out.ScaleDownUnreadyTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxNodeProvisionTime != nil {
+		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.IgnoreDaemonSetsUtilization != nil {
+		in, out := &in.IgnoreDaemonSetsUtilization, &out.IgnoreDaemonSetsUtilization
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceGroupClusterAutoscalerSpec.
+func (in *InstanceGroupClusterAutoscalerSpec) DeepCopy() *InstanceGroupClusterAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceGroupClusterAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceGroupList) DeepCopyInto(out *InstanceGroupList) {
 	*out = *in
@@ -2783,6 +3336,16 @@ func (in *InstanceGroupSpec) DeepCopyInto(out *InstanceGroupSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Journald != nil {
+		in, out := &in.Journald, &out.Journald
+		*out = new(JournaldSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdIOIsolation != nil {
+		in, out := &in.EtcdIOIsolation, &out.EtcdIOIsolation
+		*out = new(EtcdIOIsolationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(RollingUpdate)
@@ -2838,6 +3401,23 @@ func (in *InstanceGroupSpec) DeepCopyInto(out *InstanceGroupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(InstanceGroupClusterAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreferSpot != nil {
+		in, out := &in.PreferSpot, &out.PreferSpot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]SSHAuthorizedKeysSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2903,6 +3483,32 @@ func (in *InstanceRequirementsSpec) DeepCopy() *InstanceRequirementsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JournaldSpec) DeepCopyInto(out *JournaldSpec) {
+	*out = *in
+	if in.RateLimitInterval != nil {
+		in, out := &in.RateLimitInterval, &out.RateLimitInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RateLimitBurst != nil {
+		in, out := &in.RateLimitBurst, &out.RateLimitBurst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JournaldSpec.
+func (in *JournaldSpec) DeepCopy() *JournaldSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JournaldSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KarpenterConfig) DeepCopyInto(out *KarpenterConfig) {
 	*out = *in
@@ -3129,6 +3735,27 @@ func (in *KindnetNetworkingSpec) DeepCopy() *KindnetNetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KonnectivityConfig) DeepCopyInto(out *KonnectivityConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KonnectivityConfig.
+func (in *KonnectivityConfig) DeepCopy() *KonnectivityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KonnectivityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KopeioAuthenticationSpec) DeepCopyInto(out *KopeioAuthenticationSpec) {
 	*out = *in
@@ -4062,6 +4689,18 @@ func (in *KubeSchedulerConfig) DeepCopyInto(out *KubeSchedulerConfig) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.PercentageOfNodesToScore != nil {
+		in, out := &in.PercentageOfNodesToScore, &out.PercentageOfNodesToScore
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]KubeSchedulerProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4076,39 +4715,218 @@ func (in *KubeSchedulerConfig) DeepCopy() *KubeSchedulerConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfigSpec) DeepCopyInto(out *KubeletConfigSpec) {
+func (in *KubeSchedulerPlugin) DeepCopyInto(out *KubeSchedulerPlugin) {
 	*out = *in
-	if in.AnonymousAuth != nil {
-		in, out := &in.AnonymousAuth, &out.AnonymousAuth
-		*out = new(bool)
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
 		**out = **in
 	}
-	if in.TLSCipherSuites != nil {
-		in, out := &in.TLSCipherSuites, &out.TLSCipherSuites
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerPlugin.
+func (in *KubeSchedulerPlugin) DeepCopy() *KubeSchedulerPlugin {
+	if in == nil {
+		return nil
 	}
-	if in.RequireKubeconfig != nil {
-		in, out := &in.RequireKubeconfig, &out.RequireKubeconfig
-		*out = new(bool)
-		**out = **in
+	out := new(KubeSchedulerPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerPluginConfig) DeepCopyInto(out *KubeSchedulerPluginConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerPluginConfig.
+func (in *KubeSchedulerPluginConfig) DeepCopy() *KubeSchedulerPluginConfig {
+	if in == nil {
+		return nil
 	}
-	if in.LogLevel != nil {
-		in, out := &in.LogLevel, &out.LogLevel
-		*out = new(int32)
-		**out = **in
+	out := new(KubeSchedulerPluginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerPluginSet) DeepCopyInto(out *KubeSchedulerPluginSet) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.SeccompDefault != nil {
-		in, out := &in.SeccompDefault, &out.SeccompDefault
-		*out = new(bool)
-		**out = **in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.SeccompProfileRoot != nil {
-		in, out := &in.SeccompProfileRoot, &out.SeccompProfileRoot
-		*out = new(string)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerPluginSet.
+func (in *KubeSchedulerPluginSet) DeepCopy() *KubeSchedulerPluginSet {
+	if in == nil {
+		return nil
 	}
-	if in.AllowPrivileged != nil {
+	out := new(KubeSchedulerPluginSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerPlugins) DeepCopyInto(out *KubeSchedulerPlugins) {
+	*out = *in
+	if in.PreEnqueue != nil {
+		in, out := &in.PreEnqueue, &out.PreEnqueue
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueueSort != nil {
+		in, out := &in.QueueSort, &out.QueueSort
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreFilter != nil {
+		in, out := &in.PreFilter, &out.PreFilter
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostFilter != nil {
+		in, out := &in.PostFilter, &out.PostFilter
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreScore != nil {
+		in, out := &in.PreScore, &out.PreScore
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reserve != nil {
+		in, out := &in.Reserve, &out.Reserve
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Permit != nil {
+		in, out := &in.Permit, &out.Permit
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreBind != nil {
+		in, out := &in.PreBind, &out.PreBind
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostBind != nil {
+		in, out := &in.PostBind, &out.PostBind
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MultiPoint != nil {
+		in, out := &in.MultiPoint, &out.MultiPoint
+		*out = new(KubeSchedulerPluginSet)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerPlugins.
+func (in *KubeSchedulerPlugins) DeepCopy() *KubeSchedulerPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSchedulerPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeSchedulerProfile) DeepCopyInto(out *KubeSchedulerProfile) {
+	*out = *in
+	if in.PercentageOfNodesToScore != nil {
+		in, out := &in.PercentageOfNodesToScore, &out.PercentageOfNodesToScore
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(KubeSchedulerPlugins)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = make([]KubeSchedulerPluginConfig, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeSchedulerProfile.
+func (in *KubeSchedulerProfile) DeepCopy() *KubeSchedulerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeSchedulerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigSpec) DeepCopyInto(out *KubeletConfigSpec) {
+	*out = *in
+	if in.AnonymousAuth != nil {
+		in, out := &in.AnonymousAuth, &out.AnonymousAuth
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSCipherSuites != nil {
+		in, out := &in.TLSCipherSuites, &out.TLSCipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequireKubeconfig != nil {
+		in, out := &in.RequireKubeconfig, &out.RequireKubeconfig
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SeccompDefault != nil {
+		in, out := &in.SeccompDefault, &out.SeccompDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SeccompProfileRoot != nil {
+		in, out := &in.SeccompProfileRoot, &out.SeccompProfileRoot
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowPrivileged != nil {
 		in, out := &in.AllowPrivileged, &out.AllowPrivileged
 		*out = new(bool)
 		**out = **in
@@ -4631,6 +5449,22 @@ func (in *LyftVPCNetworkingSpec) DeepCopy() *LyftVPCNetworkingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedBastionSpec) DeepCopyInto(out *ManagedBastionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedBastionSpec.
+func (in *ManagedBastionSpec) DeepCopy() *ManagedBastionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedBastionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsServerConfig) DeepCopyInto(out *MetricsServerConfig) {
 	*out = *in
@@ -4739,6 +5573,47 @@ func (in *MixedInstancesPolicySpec) DeepCopy() *MixedInstancesPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringSpec) DeepCopyInto(out *MonitoringSpec) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServiceMonitors != nil {
+		in, out := &in.ServiceMonitors, &out.ServiceMonitors
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HealthInterval != nil {
+		in, out := &in.HealthInterval, &out.HealthInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(CertificateExpirySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringSpec.
+func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NRIConfig) DeepCopyInto(out *NRIConfig) {
 	*out = *in
@@ -4778,6 +5653,16 @@ func (in *NTPConfig) DeepCopyInto(out *NTPConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MakeStep != nil {
+		in, out := &in.MakeStep, &out.MakeStep
+		*out = new(NTPMakeStepSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4791,6 +5676,130 @@ func (in *NTPConfig) DeepCopy() *NTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NTPMakeStepSpec) DeepCopyInto(out *NTPMakeStepSpec) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTPMakeStepSpec.
+func (in *NTPMakeStepSpec) DeepCopy() *NTPMakeStepSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NTPMakeStepSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaultsSpec) DeepCopyInto(out *NamespaceDefaultsSpec) {
+	*out = *in
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]NamespaceLimitRangeSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]NamespaceResourceQuotaSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]PriorityClassSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaultsSpec.
+func (in *NamespaceDefaultsSpec) DeepCopy() *NamespaceDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceLimitRangeSpec) DeepCopyInto(out *NamespaceLimitRangeSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]corev1.LimitRangeItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceLimitRangeSpec.
+func (in *NamespaceLimitRangeSpec) DeepCopy() *NamespaceLimitRangeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceLimitRangeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceResourceQuotaSpec) DeepCopyInto(out *NamespaceResourceQuotaSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]corev1.ResourceQuotaScope, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceQuotaSpec.
+func (in *NamespaceResourceQuotaSpec) DeepCopy() *NamespaceResourceQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceResourceQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkingSpec) DeepCopyInto(out *NetworkingSpec) {
 	*out = *in
@@ -5176,6 +6185,50 @@ func (in *NodeTerminationHandlerSpec) DeepCopy() *NodeTerminationHandlerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSinkSpec) DeepCopyInto(out *NotificationSinkSpec) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEventType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSinkSpec.
+func (in *NotificationSinkSpec) DeepCopy() *NotificationSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsSpec) DeepCopyInto(out *NotificationsSpec) {
+	*out = *in
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]NotificationSinkSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsSpec.
+func (in *NotificationsSpec) DeepCopy() *NotificationsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NvidiaGPUConfig) DeepCopyInto(out *NvidiaGPUConfig) {
 	*out = *in
@@ -5536,6 +6589,16 @@ func (in *PDCSIDriver) DeepCopyInto(out *PDCSIDriver) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(CSIStorageClassSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(CSISidecarResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5601,6 +6664,58 @@ func (in *PodIdentityWebhookSpec) DeepCopy() *PodIdentityWebhookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSecurityAdmissionSpec) DeepCopyInto(out *PodSecurityAdmissionSpec) {
+	*out = *in
+	if in.ExemptNamespaces != nil {
+		in, out := &in.ExemptNamespaces, &out.ExemptNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptUsernames != nil {
+		in, out := &in.ExemptUsernames, &out.ExemptUsernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptRuntimeClasses != nil {
+		in, out := &in.ExemptRuntimeClasses, &out.ExemptRuntimeClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSecurityAdmissionSpec.
+func (in *PodSecurityAdmissionSpec) DeepCopy() *PodSecurityAdmissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSecurityAdmissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityClassSpec) DeepCopyInto(out *PriorityClassSpec) {
+	*out = *in
+	if in.PreemptionPolicy != nil {
+		in, out := &in.PreemptionPolicy, &out.PreemptionPolicy
+		*out = new(corev1.PreemptionPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityClassSpec.
+func (in *PriorityClassSpec) DeepCopy() *PriorityClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RBACAuthorizationSpec) DeepCopyInto(out *RBACAuthorizationSpec) {
 	*out = *in
@@ -5706,6 +6821,53 @@ func (in *Runc) DeepCopy() *Runc {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHAuthorizedKeysSpec) DeepCopyInto(out *SSHAuthorizedKeysSpec) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHAuthorizedKeysSpec.
+func (in *SSHAuthorizedKeysSpec) DeepCopy() *SSHAuthorizedKeysSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHAuthorizedKeysSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateAuthoritySpec) DeepCopyInto(out *SSHCertificateAuthoritySpec) {
+	*out = *in
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateAuthoritySpec.
+func (in *SSHCertificateAuthoritySpec) DeepCopy() *SSHCertificateAuthoritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateAuthoritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHCredential) DeepCopyInto(out *SSHCredential) {
 	*out = *in
@@ -5883,6 +7045,23 @@ func (in *TerraformSpec) DeepCopyInto(out *TerraformSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ProviderVersions != nil {
+		in, out := &in.ProviderVersions, &out.ProviderVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.InstanceGroupModules != nil {
+		in, out := &in.InstanceGroupModules, &out.InstanceGroupModules
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImportExistingResources != nil {
+		in, out := &in.ImportExistingResources, &out.ImportExistingResources
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -5904,6 +7083,11 @@ func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 		*out = new(BastionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(VPNSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LegacyDNS != nil {
 		in, out := &in.LegacyDNS, &out.LegacyDNS
 		*out = new(DNSSpec)
@@ -5938,6 +7122,53 @@ func (in *UserData) DeepCopy() *UserData {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPNSpec) DeepCopyInto(out *VPNSpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPNSpec.
+func (in *VPNSpec) DeepCopy() *VPNSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPNSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalPodAutoscalerConfig) DeepCopyInto(out *VerticalPodAutoscalerConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecommenderOnly != nil {
+		in, out := &in.RecommenderOnly, &out.RecommenderOnly
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalPodAutoscalerConfig.
+func (in *VerticalPodAutoscalerConfig) DeepCopy() *VerticalPodAutoscalerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalPodAutoscalerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMountSpec) DeepCopyInto(out *VolumeMountSpec) {
 	*out = *in
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import corev1 "k8s.io/api/core/v1"
+
+// AddonResourcesSpec configures the compute resources of the addons managed by kOps.
+type AddonResourcesSpec struct {
+	// Autosize scales the resources of CoreDNS and of the CNI and CSI controllers with the maximum number of nodes of the cluster.
+	// Default: false
+	Autosize *bool `json:"autosize,omitempty"`
+	// Overrides set the resources of containers of the addons managed by kOps.
+	// They take precedence over the defaults and over autosizing.
+	Overrides []AddonResourceOverride `json:"overrides,omitempty"`
+}
+
+// AddonResourceOverride sets the resources of a container of an addon managed by kOps.
+type AddonResourceOverride struct {
+	// Addon is the name of the addon, such as coredns.addons.k8s.io.
+	Addon string `json:"addon"`
+	// Name is the name of the Deployment or DaemonSet of the addon.
+	Name string `json:"name"`
+	// Container is the name of the container.
+	Container string `json:"container"`
+	// Resources are the requests and limits of the container.
+	// Only the resources listed are changed.
+	Resources corev1.ResourceRequirements `json:"resources"`
+}
@@ -0,0 +1,30 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+// HibernationSpec configures the scheduled hibernation of a cluster:
+// its instance groups, other than the control plane, are scaled to zero and back on a schedule.
+// Only supported on AWS, where it is implemented with scheduled actions of the autoscaling groups.
+type HibernationSpec struct {
+	// Schedule is when the instance groups are scaled to zero, as a cron expression such as "0 20 * * 1-5".
+	Schedule string `json:"schedule,omitempty"`
+	// ResumeSchedule is when the instance groups are scaled back to their sizes, as a cron expression such as "0 7 * * 1-5".
+	ResumeSchedule string `json:"resumeSchedule,omitempty"`
+	// TimeZone is the IANA time zone of the schedules, such as Europe/Paris.
+	// Default: UTC
+	TimeZone string `json:"timeZone,omitempty"`
+}
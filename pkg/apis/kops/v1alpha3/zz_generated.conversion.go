@@ -104,6 +104,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AddonResourceOverride)(nil), (*kops.AddonResourceOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AddonResourceOverride_To_kops_AddonResourceOverride(a.(*AddonResourceOverride), b.(*kops.AddonResourceOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AddonResourceOverride)(nil), (*AddonResourceOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AddonResourceOverride_To_v1alpha3_AddonResourceOverride(a.(*kops.AddonResourceOverride), b.(*AddonResourceOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddonResourcesSpec)(nil), (*kops.AddonResourcesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AddonResourcesSpec_To_kops_AddonResourcesSpec(a.(*AddonResourcesSpec), b.(*kops.AddonResourcesSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AddonResourcesSpec)(nil), (*AddonResourcesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AddonResourcesSpec_To_v1alpha3_AddonResourcesSpec(a.(*kops.AddonResourcesSpec), b.(*AddonResourcesSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AddonSpec)(nil), (*kops.AddonSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_AddonSpec_To_kops_AddonSpec(a.(*AddonSpec), b.(*kops.AddonSpec), scope)
 	}); err != nil {
@@ -114,6 +134,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AdmissionSpec)(nil), (*kops.AdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AdmissionSpec_To_kops_AdmissionSpec(a.(*AdmissionSpec), b.(*kops.AdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AdmissionSpec)(nil), (*AdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AdmissionSpec_To_v1alpha3_AdmissionSpec(a.(*kops.AdmissionSpec), b.(*AdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AlwaysAllowAuthorizationSpec)(nil), (*kops.AlwaysAllowAuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(a.(*AlwaysAllowAuthorizationSpec), b.(*kops.AlwaysAllowAuthorizationSpec), scope)
 	}); err != nil {
@@ -134,6 +164,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AssetSignatureVerificationSpec)(nil), (*kops.AssetSignatureVerificationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(a.(*AssetSignatureVerificationSpec), b.(*kops.AssetSignatureVerificationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.AssetSignatureVerificationSpec)(nil), (*AssetSignatureVerificationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_AssetSignatureVerificationSpec_To_v1alpha3_AssetSignatureVerificationSpec(a.(*kops.AssetSignatureVerificationSpec), b.(*AssetSignatureVerificationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AssetsSpec)(nil), (*kops.AssetsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_AssetsSpec_To_kops_AssetsSpec(a.(*AssetsSpec), b.(*kops.AssetsSpec), scope)
 	}); err != nil {
@@ -174,6 +214,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionAccessSpec)(nil), (*kops.BastionAccessSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_BastionAccessSpec_To_kops_BastionAccessSpec(a.(*BastionAccessSpec), b.(*kops.BastionAccessSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.BastionAccessSpec)(nil), (*BastionAccessSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_BastionAccessSpec_To_v1alpha3_BastionAccessSpec(a.(*kops.BastionAccessSpec), b.(*BastionAccessSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionLoadBalancerSpec)(nil), (*kops.BastionLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_BastionLoadBalancerSpec_To_kops_BastionLoadBalancerSpec(a.(*BastionLoadBalancerSpec), b.(*kops.BastionLoadBalancerSpec), scope)
 	}); err != nil {
@@ -204,6 +254,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CSISidecarResources)(nil), (*kops.CSISidecarResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources(a.(*CSISidecarResources), b.(*kops.CSISidecarResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CSISidecarResources)(nil), (*CSISidecarResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources(a.(*kops.CSISidecarResources), b.(*CSISidecarResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIStorageClassSpec)(nil), (*kops.CSIStorageClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(a.(*CSIStorageClassSpec), b.(*kops.CSIStorageClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CSIStorageClassSpec)(nil), (*CSIStorageClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec(a.(*kops.CSIStorageClassSpec), b.(*CSIStorageClassSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CalicoNetworkingSpec)(nil), (*kops.CalicoNetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CalicoNetworkingSpec_To_kops_CalicoNetworkingSpec(a.(*CalicoNetworkingSpec), b.(*kops.CalicoNetworkingSpec), scope)
 	}); err != nil {
@@ -234,6 +304,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateExpirySpec)(nil), (*kops.CertificateExpirySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CertificateExpirySpec_To_kops_CertificateExpirySpec(a.(*CertificateExpirySpec), b.(*kops.CertificateExpirySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.CertificateExpirySpec)(nil), (*CertificateExpirySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_CertificateExpirySpec_To_v1alpha3_CertificateExpirySpec(a.(*kops.CertificateExpirySpec), b.(*CertificateExpirySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CiliumGatewayAPISpec)(nil), (*kops.CiliumGatewayAPISpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CiliumGatewayAPISpec_To_kops_CiliumGatewayAPISpec(a.(*CiliumGatewayAPISpec), b.(*kops.CiliumGatewayAPISpec), scope)
 	}); err != nil {
@@ -354,6 +434,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityProbesSpec)(nil), (*kops.ConnectivityProbesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(a.(*ConnectivityProbesSpec), b.(*kops.ConnectivityProbesSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ConnectivityProbesSpec)(nil), (*ConnectivityProbesSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ConnectivityProbesSpec_To_v1alpha3_ConnectivityProbesSpec(a.(*kops.ConnectivityProbesSpec), b.(*ConnectivityProbesSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ContainerdConfig)(nil), (*kops.ContainerdConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ContainerdConfig_To_kops_ContainerdConfig(a.(*ContainerdConfig), b.(*kops.ContainerdConfig), scope)
 	}); err != nil {
@@ -474,6 +564,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdIOIsolationSpec)(nil), (*kops.EtcdIOIsolationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(a.(*EtcdIOIsolationSpec), b.(*kops.EtcdIOIsolationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.EtcdIOIsolationSpec)(nil), (*EtcdIOIsolationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_EtcdIOIsolationSpec_To_v1alpha3_EtcdIOIsolationSpec(a.(*kops.EtcdIOIsolationSpec), b.(*EtcdIOIsolationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EtcdManagerSpec)(nil), (*kops.EtcdManagerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_EtcdManagerSpec_To_kops_EtcdManagerSpec(a.(*EtcdManagerSpec), b.(*kops.EtcdManagerSpec), scope)
 	}); err != nil {
@@ -564,6 +664,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GitOpsSpec)(nil), (*kops.GitOpsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_GitOpsSpec_To_kops_GitOpsSpec(a.(*GitOpsSpec), b.(*kops.GitOpsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.GitOpsSpec)(nil), (*GitOpsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_GitOpsSpec_To_v1alpha3_GitOpsSpec(a.(*kops.GitOpsSpec), b.(*GitOpsSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GossipConfig)(nil), (*kops.GossipConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_GossipConfig_To_kops_GossipConfig(a.(*GossipConfig), b.(*kops.GossipConfig), scope)
 	}); err != nil {
@@ -594,6 +704,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmChartSpec)(nil), (*kops.HelmChartSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_HelmChartSpec_To_kops_HelmChartSpec(a.(*HelmChartSpec), b.(*kops.HelmChartSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HelmChartSpec)(nil), (*HelmChartSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HelmChartSpec_To_v1alpha3_HelmChartSpec(a.(*kops.HelmChartSpec), b.(*HelmChartSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HelmValuesSource)(nil), (*kops.HelmValuesSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_HelmValuesSource_To_kops_HelmValuesSource(a.(*HelmValuesSource), b.(*kops.HelmValuesSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HelmValuesSource)(nil), (*HelmValuesSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HelmValuesSource_To_v1alpha3_HelmValuesSource(a.(*kops.HelmValuesSource), b.(*HelmValuesSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HibernationSpec)(nil), (*kops.HibernationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_HibernationSpec_To_kops_HibernationSpec(a.(*HibernationSpec), b.(*kops.HibernationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.HibernationSpec)(nil), (*HibernationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_HibernationSpec_To_v1alpha3_HibernationSpec(a.(*kops.HibernationSpec), b.(*HibernationSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HetznerSpec)(nil), (*kops.HetznerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_HetznerSpec_To_kops_HetznerSpec(a.(*HetznerSpec), b.(*kops.HetznerSpec), scope)
 	}); err != nil {
@@ -654,6 +794,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstanceGroupClusterAutoscalerSpec)(nil), (*kops.InstanceGroupClusterAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(a.(*InstanceGroupClusterAutoscalerSpec), b.(*kops.InstanceGroupClusterAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.InstanceGroupClusterAutoscalerSpec)(nil), (*InstanceGroupClusterAutoscalerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha3_InstanceGroupClusterAutoscalerSpec(a.(*kops.InstanceGroupClusterAutoscalerSpec), b.(*InstanceGroupClusterAutoscalerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstanceGroupList)(nil), (*kops.InstanceGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_InstanceGroupList_To_kops_InstanceGroupList(a.(*InstanceGroupList), b.(*kops.InstanceGroupList), scope)
 	}); err != nil {
@@ -704,6 +854,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*JournaldSpec)(nil), (*kops.JournaldSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_JournaldSpec_To_kops_JournaldSpec(a.(*JournaldSpec), b.(*kops.JournaldSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.JournaldSpec)(nil), (*JournaldSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_JournaldSpec_To_v1alpha3_JournaldSpec(a.(*kops.JournaldSpec), b.(*JournaldSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KarpenterConfig)(nil), (*kops.KarpenterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_KarpenterConfig_To_kops_KarpenterConfig(a.(*KarpenterConfig), b.(*kops.KarpenterConfig), scope)
 	}); err != nil {
@@ -774,6 +934,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KonnectivityConfig)(nil), (*kops.KonnectivityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_KonnectivityConfig_To_kops_KonnectivityConfig(a.(*KonnectivityConfig), b.(*kops.KonnectivityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KonnectivityConfig)(nil), (*KonnectivityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KonnectivityConfig_To_v1alpha3_KonnectivityConfig(a.(*kops.KonnectivityConfig), b.(*KonnectivityConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KopeioAuthenticationSpec)(nil), (*kops.KopeioAuthenticationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_KopeioAuthenticationSpec_To_kops_KopeioAuthenticationSpec(a.(*KopeioAuthenticationSpec), b.(*kops.KopeioAuthenticationSpec), scope)
 	}); err != nil {
@@ -844,6 +1014,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPlugin)(nil), (*kops.KubeSchedulerPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(a.(*KubeSchedulerPlugin), b.(*kops.KubeSchedulerPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPlugin)(nil), (*KubeSchedulerPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin(a.(*kops.KubeSchedulerPlugin), b.(*KubeSchedulerPlugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPluginConfig)(nil), (*kops.KubeSchedulerPluginConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(a.(*KubeSchedulerPluginConfig), b.(*kops.KubeSchedulerPluginConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPluginConfig)(nil), (*KubeSchedulerPluginConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPluginConfig_To_v1alpha3_KubeSchedulerPluginConfig(a.(*kops.KubeSchedulerPluginConfig), b.(*KubeSchedulerPluginConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPluginSet)(nil), (*kops.KubeSchedulerPluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(a.(*KubeSchedulerPluginSet), b.(*kops.KubeSchedulerPluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPluginSet)(nil), (*KubeSchedulerPluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(a.(*kops.KubeSchedulerPluginSet), b.(*KubeSchedulerPluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerPlugins)(nil), (*kops.KubeSchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(a.(*KubeSchedulerPlugins), b.(*kops.KubeSchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerPlugins)(nil), (*KubeSchedulerPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerPlugins_To_v1alpha3_KubeSchedulerPlugins(a.(*kops.KubeSchedulerPlugins), b.(*KubeSchedulerPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeSchedulerProfile)(nil), (*kops.KubeSchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(a.(*KubeSchedulerProfile), b.(*kops.KubeSchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.KubeSchedulerProfile)(nil), (*KubeSchedulerProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_KubeSchedulerProfile_To_v1alpha3_KubeSchedulerProfile(a.(*kops.KubeSchedulerProfile), b.(*KubeSchedulerProfile), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeletConfigSpec)(nil), (*kops.KubeletConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_KubeletConfigSpec_To_kops_KubeletConfigSpec(a.(*KubeletConfigSpec), b.(*kops.KubeletConfigSpec), scope)
 	}); err != nil {
@@ -924,6 +1144,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedBastionSpec)(nil), (*kops.ManagedBastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ManagedBastionSpec_To_kops_ManagedBastionSpec(a.(*ManagedBastionSpec), b.(*kops.ManagedBastionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.ManagedBastionSpec)(nil), (*ManagedBastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_ManagedBastionSpec_To_v1alpha3_ManagedBastionSpec(a.(*kops.ManagedBastionSpec), b.(*ManagedBastionSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MetricsServerConfig)(nil), (*kops.MetricsServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_MetricsServerConfig_To_kops_MetricsServerConfig(a.(*MetricsServerConfig), b.(*kops.MetricsServerConfig), scope)
 	}); err != nil {
@@ -954,6 +1184,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MonitoringSpec)(nil), (*kops.MonitoringSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_MonitoringSpec_To_kops_MonitoringSpec(a.(*MonitoringSpec), b.(*kops.MonitoringSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.MonitoringSpec)(nil), (*MonitoringSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_MonitoringSpec_To_v1alpha3_MonitoringSpec(a.(*kops.MonitoringSpec), b.(*MonitoringSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NRIConfig)(nil), (*kops.NRIConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_NRIConfig_To_kops_NRIConfig(a.(*NRIConfig), b.(*kops.NRIConfig), scope)
 	}); err != nil {
@@ -974,6 +1214,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NTPMakeStepSpec)(nil), (*kops.NTPMakeStepSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(a.(*NTPMakeStepSpec), b.(*kops.NTPMakeStepSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NTPMakeStepSpec)(nil), (*NTPMakeStepSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NTPMakeStepSpec_To_v1alpha3_NTPMakeStepSpec(a.(*kops.NTPMakeStepSpec), b.(*NTPMakeStepSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaultsSpec)(nil), (*kops.NamespaceDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(a.(*NamespaceDefaultsSpec), b.(*kops.NamespaceDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NamespaceDefaultsSpec)(nil), (*NamespaceDefaultsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NamespaceDefaultsSpec_To_v1alpha3_NamespaceDefaultsSpec(a.(*kops.NamespaceDefaultsSpec), b.(*NamespaceDefaultsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceLimitRangeSpec)(nil), (*kops.NamespaceLimitRangeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(a.(*NamespaceLimitRangeSpec), b.(*kops.NamespaceLimitRangeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NamespaceLimitRangeSpec)(nil), (*NamespaceLimitRangeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NamespaceLimitRangeSpec_To_v1alpha3_NamespaceLimitRangeSpec(a.(*kops.NamespaceLimitRangeSpec), b.(*NamespaceLimitRangeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceResourceQuotaSpec)(nil), (*kops.NamespaceResourceQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(a.(*NamespaceResourceQuotaSpec), b.(*kops.NamespaceResourceQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NamespaceResourceQuotaSpec)(nil), (*NamespaceResourceQuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha3_NamespaceResourceQuotaSpec(a.(*kops.NamespaceResourceQuotaSpec), b.(*NamespaceResourceQuotaSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkingSpec)(nil), (*kops.NetworkingSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_NetworkingSpec_To_kops_NetworkingSpec(a.(*NetworkingSpec), b.(*kops.NetworkingSpec), scope)
 	}); err != nil {
@@ -1014,6 +1294,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSinkSpec)(nil), (*kops.NotificationSinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NotificationSinkSpec_To_kops_NotificationSinkSpec(a.(*NotificationSinkSpec), b.(*kops.NotificationSinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NotificationSinkSpec)(nil), (*NotificationSinkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NotificationSinkSpec_To_v1alpha3_NotificationSinkSpec(a.(*kops.NotificationSinkSpec), b.(*NotificationSinkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationsSpec)(nil), (*kops.NotificationsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_NotificationsSpec_To_kops_NotificationsSpec(a.(*NotificationsSpec), b.(*kops.NotificationsSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.NotificationsSpec)(nil), (*NotificationsSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_NotificationsSpec_To_v1alpha3_NotificationsSpec(a.(*kops.NotificationsSpec), b.(*NotificationsSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NvidiaGPUConfig)(nil), (*kops.NvidiaGPUConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_NvidiaGPUConfig_To_kops_NvidiaGPUConfig(a.(*NvidiaGPUConfig), b.(*kops.NvidiaGPUConfig), scope)
 	}); err != nil {
@@ -1134,6 +1434,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodSecurityAdmissionSpec)(nil), (*kops.PodSecurityAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(a.(*PodSecurityAdmissionSpec), b.(*kops.PodSecurityAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PodSecurityAdmissionSpec)(nil), (*PodSecurityAdmissionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PodSecurityAdmissionSpec_To_v1alpha3_PodSecurityAdmissionSpec(a.(*kops.PodSecurityAdmissionSpec), b.(*PodSecurityAdmissionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PriorityClassSpec)(nil), (*kops.PriorityClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_PriorityClassSpec_To_kops_PriorityClassSpec(a.(*PriorityClassSpec), b.(*kops.PriorityClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.PriorityClassSpec)(nil), (*PriorityClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_PriorityClassSpec_To_v1alpha3_PriorityClassSpec(a.(*kops.PriorityClassSpec), b.(*PriorityClassSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RBACAuthorizationSpec)(nil), (*kops.RBACAuthorizationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec(a.(*RBACAuthorizationSpec), b.(*kops.RBACAuthorizationSpec), scope)
 	}); err != nil {
@@ -1174,6 +1494,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SSHAuthorizedKeysSpec)(nil), (*kops.SSHAuthorizedKeysSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(a.(*SSHAuthorizedKeysSpec), b.(*kops.SSHAuthorizedKeysSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.SSHAuthorizedKeysSpec)(nil), (*SSHAuthorizedKeysSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha3_SSHAuthorizedKeysSpec(a.(*kops.SSHAuthorizedKeysSpec), b.(*SSHAuthorizedKeysSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SSHCertificateAuthoritySpec)(nil), (*kops.SSHCertificateAuthoritySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(a.(*SSHCertificateAuthoritySpec), b.(*kops.SSHCertificateAuthoritySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.SSHCertificateAuthoritySpec)(nil), (*SSHCertificateAuthoritySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha3_SSHCertificateAuthoritySpec(a.(*kops.SSHCertificateAuthoritySpec), b.(*SSHCertificateAuthoritySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHCredential)(nil), (*kops.SSHCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_SSHCredential_To_kops_SSHCredential(a.(*SSHCredential), b.(*kops.SSHCredential), scope)
 	}); err != nil {
@@ -1284,6 +1624,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VPNSpec)(nil), (*kops.VPNSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VPNSpec_To_kops_VPNSpec(a.(*VPNSpec), b.(*kops.VPNSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.VPNSpec)(nil), (*VPNSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_VPNSpec_To_v1alpha3_VPNSpec(a.(*kops.VPNSpec), b.(*VPNSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscalerConfig)(nil), (*kops.VerticalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(a.(*VerticalPodAutoscalerConfig), b.(*kops.VerticalPodAutoscalerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*kops.VerticalPodAutoscalerConfig)(nil), (*VerticalPodAutoscalerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha3_VerticalPodAutoscalerConfig(a.(*kops.VerticalPodAutoscalerConfig), b.(*VerticalPodAutoscalerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VolumeMountSpec)(nil), (*kops.VolumeMountSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_VolumeMountSpec_To_kops_VolumeMountSpec(a.(*VolumeMountSpec), b.(*kops.VolumeMountSpec), scope)
 	}); err != nil {
@@ -1536,6 +1896,7 @@ func autoConvert_v1alpha3_AWSSpec_To_kops_AWSSpec(in *AWSSpec, out *kops.AWSSpec
 	out.NodeIPFamilies = in.NodeIPFamilies
 	out.DisableSecurityGroupIngress = in.DisableSecurityGroupIngress
 	out.ElbSecurityGroup = in.ElbSecurityGroup
+	out.ResizeVolumesInPlace = in.ResizeVolumesInPlace
 	out.SpotinstProduct = in.SpotinstProduct
 	out.SpotinstOrientation = in.SpotinstOrientation
 	out.BinariesLocation = in.BinariesLocation
@@ -1596,6 +1957,7 @@ func autoConvert_kops_AWSSpec_To_v1alpha3_AWSSpec(in *kops.AWSSpec, out *AWSSpec
 	out.NodeIPFamilies = in.NodeIPFamilies
 	out.DisableSecurityGroupIngress = in.DisableSecurityGroupIngress
 	out.ElbSecurityGroup = in.ElbSecurityGroup
+	out.ResizeVolumesInPlace = in.ResizeVolumesInPlace
 	out.SpotinstProduct = in.SpotinstProduct
 	out.SpotinstOrientation = in.SpotinstOrientation
 	out.BinariesLocation = in.BinariesLocation
@@ -1653,58 +2015,182 @@ func Convert_kops_AccessLogSpec_To_v1alpha3_AccessLogSpec(in *kops.AccessLogSpec
 	return autoConvert_kops_AccessLogSpec_To_v1alpha3_AccessLogSpec(in, out, s)
 }
 
-func autoConvert_v1alpha3_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
-	out.Manifest = in.Manifest
-	return nil
-}
-
-// Convert_v1alpha3_AddonSpec_To_kops_AddonSpec is an autogenerated conversion function.
-func Convert_v1alpha3_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_AddonSpec_To_kops_AddonSpec(in, out, s)
-}
-
-func autoConvert_kops_AddonSpec_To_v1alpha3_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	out.Manifest = in.Manifest
+func autoConvert_v1alpha3_AddonResourceOverride_To_kops_AddonResourceOverride(in *AddonResourceOverride, out *kops.AddonResourceOverride, s conversion.Scope) error {
+	out.Addon = in.Addon
+	out.Name = in.Name
+	out.Container = in.Container
+	out.Resources = in.Resources
 	return nil
 }
 
-// Convert_kops_AddonSpec_To_v1alpha3_AddonSpec is an autogenerated conversion function.
-func Convert_kops_AddonSpec_To_v1alpha3_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	return autoConvert_kops_AddonSpec_To_v1alpha3_AddonSpec(in, out, s)
+// Convert_v1alpha3_AddonResourceOverride_To_kops_AddonResourceOverride is an autogenerated conversion function.
+func Convert_v1alpha3_AddonResourceOverride_To_kops_AddonResourceOverride(in *AddonResourceOverride, out *kops.AddonResourceOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AddonResourceOverride_To_kops_AddonResourceOverride(in, out, s)
 }
 
-func autoConvert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+func autoConvert_kops_AddonResourceOverride_To_v1alpha3_AddonResourceOverride(in *kops.AddonResourceOverride, out *AddonResourceOverride, s conversion.Scope) error {
+	out.Addon = in.Addon
+	out.Name = in.Name
+	out.Container = in.Container
+	out.Resources = in.Resources
 	return nil
 }
 
-// Convert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
-func Convert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in, out, s)
+// Convert_kops_AddonResourceOverride_To_v1alpha3_AddonResourceOverride is an autogenerated conversion function.
+func Convert_kops_AddonResourceOverride_To_v1alpha3_AddonResourceOverride(in *kops.AddonResourceOverride, out *AddonResourceOverride, s conversion.Scope) error {
+	return autoConvert_kops_AddonResourceOverride_To_v1alpha3_AddonResourceOverride(in, out, s)
 }
 
-func autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+func autoConvert_v1alpha3_AddonResourcesSpec_To_kops_AddonResourcesSpec(in *AddonResourcesSpec, out *kops.AddonResourcesSpec, s conversion.Scope) error {
+	out.Autosize = in.Autosize
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]kops.AddonResourceOverride, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_AddonResourceOverride_To_kops_AddonResourceOverride(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Overrides = nil
+	}
 	return nil
 }
 
-// Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
-func Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
-	return autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec(in, out, s)
+// Convert_v1alpha3_AddonResourcesSpec_To_kops_AddonResourcesSpec is an autogenerated conversion function.
+func Convert_v1alpha3_AddonResourcesSpec_To_kops_AddonResourcesSpec(in *AddonResourcesSpec, out *kops.AddonResourcesSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AddonResourcesSpec_To_kops_AddonResourcesSpec(in, out, s)
 }
 
-func autoConvert_v1alpha3_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
-	out.Image = in.Image
-	out.InitImage = in.InitImage
-	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]kops.EnvVar, len(*in))
+func autoConvert_kops_AddonResourcesSpec_To_v1alpha3_AddonResourcesSpec(in *kops.AddonResourcesSpec, out *AddonResourcesSpec, s conversion.Scope) error {
+	out.Autosize = in.Autosize
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]AddonResourceOverride, len(*in))
 		for i := range *in {
-			if err := Convert_v1alpha3_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+			if err := Convert_kops_AddonResourceOverride_To_v1alpha3_AddonResourceOverride(&(*in)[i], &(*out)[i], s); err != nil {
 				return err
 			}
 		}
 	} else {
-		out.Env = nil
+		out.Overrides = nil
+	}
+	return nil
+}
+
+// Convert_kops_AddonResourcesSpec_To_v1alpha3_AddonResourcesSpec is an autogenerated conversion function.
+func Convert_kops_AddonResourcesSpec_To_v1alpha3_AddonResourcesSpec(in *kops.AddonResourcesSpec, out *AddonResourcesSpec, s conversion.Scope) error {
+	return autoConvert_kops_AddonResourcesSpec_To_v1alpha3_AddonResourcesSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
+	out.Manifest = in.Manifest
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(kops.HelmChartSpec)
+		if err := Convert_v1alpha3_HelmChartSpec_To_kops_HelmChartSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Chart = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_AddonSpec_To_kops_AddonSpec is an autogenerated conversion function.
+func Convert_v1alpha3_AddonSpec_To_kops_AddonSpec(in *AddonSpec, out *kops.AddonSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AddonSpec_To_kops_AddonSpec(in, out, s)
+}
+
+func autoConvert_kops_AddonSpec_To_v1alpha3_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	out.Manifest = in.Manifest
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = new(HelmChartSpec)
+		if err := Convert_kops_HelmChartSpec_To_v1alpha3_HelmChartSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Chart = nil
+	}
+	return nil
+}
+
+// Convert_kops_AddonSpec_To_v1alpha3_AddonSpec is an autogenerated conversion function.
+func Convert_kops_AddonSpec_To_v1alpha3_AddonSpec(in *kops.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	return autoConvert_kops_AddonSpec_To_v1alpha3_AddonSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_AdmissionSpec_To_kops_AdmissionSpec(in *AdmissionSpec, out *kops.AdmissionSpec, s conversion.Scope) error {
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(kops.PodSecurityAdmissionSpec)
+		if err := Convert_v1alpha3_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurity = nil
+	}
+	out.ValidatingAdmissionPolicies = in.ValidatingAdmissionPolicies
+	return nil
+}
+
+// Convert_v1alpha3_AdmissionSpec_To_kops_AdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha3_AdmissionSpec_To_kops_AdmissionSpec(in *AdmissionSpec, out *kops.AdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AdmissionSpec_To_kops_AdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_AdmissionSpec_To_v1alpha3_AdmissionSpec(in *kops.AdmissionSpec, out *AdmissionSpec, s conversion.Scope) error {
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(PodSecurityAdmissionSpec)
+		if err := Convert_kops_PodSecurityAdmissionSpec_To_v1alpha3_PodSecurityAdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PodSecurity = nil
+	}
+	out.ValidatingAdmissionPolicies = in.ValidatingAdmissionPolicies
+	return nil
+}
+
+// Convert_kops_AdmissionSpec_To_v1alpha3_AdmissionSpec is an autogenerated conversion function.
+func Convert_kops_AdmissionSpec_To_v1alpha3_AdmissionSpec(in *kops.AdmissionSpec, out *AdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_AdmissionSpec_To_v1alpha3_AdmissionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
+func Convert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in *AlwaysAllowAuthorizationSpec, out *kops.AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AlwaysAllowAuthorizationSpec_To_kops_AlwaysAllowAuthorizationSpec(in, out, s)
+}
+
+func autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec is an autogenerated conversion function.
+func Convert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec(in *kops.AlwaysAllowAuthorizationSpec, out *AlwaysAllowAuthorizationSpec, s conversion.Scope) error {
+	return autoConvert_kops_AlwaysAllowAuthorizationSpec_To_v1alpha3_AlwaysAllowAuthorizationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_AmazonVPCNetworkingSpec_To_kops_AmazonVPCNetworkingSpec(in *AmazonVPCNetworkingSpec, out *kops.AmazonVPCNetworkingSpec, s conversion.Scope) error {
+	out.Image = in.Image
+	out.InitImage = in.InitImage
+	out.NetworkPolicyAgentImage = in.NetworkPolicyAgentImage
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]kops.EnvVar, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_EnvVar_To_kops_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Env = nil
 	}
 	return nil
 }
@@ -1737,10 +2223,42 @@ func Convert_kops_AmazonVPCNetworkingSpec_To_v1alpha3_AmazonVPCNetworkingSpec(in
 	return autoConvert_kops_AmazonVPCNetworkingSpec_To_v1alpha3_AmazonVPCNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(in *AssetSignatureVerificationSpec, out *kops.AssetSignatureVerificationSpec, s conversion.Scope) error {
+	out.PublicKeys = in.PublicKeys
+	out.SignedChecksums = in.SignedChecksums
+	return nil
+}
+
+// Convert_v1alpha3_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec is an autogenerated conversion function.
+func Convert_v1alpha3_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(in *AssetSignatureVerificationSpec, out *kops.AssetSignatureVerificationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(in, out, s)
+}
+
+func autoConvert_kops_AssetSignatureVerificationSpec_To_v1alpha3_AssetSignatureVerificationSpec(in *kops.AssetSignatureVerificationSpec, out *AssetSignatureVerificationSpec, s conversion.Scope) error {
+	out.PublicKeys = in.PublicKeys
+	out.SignedChecksums = in.SignedChecksums
+	return nil
+}
+
+// Convert_kops_AssetSignatureVerificationSpec_To_v1alpha3_AssetSignatureVerificationSpec is an autogenerated conversion function.
+func Convert_kops_AssetSignatureVerificationSpec_To_v1alpha3_AssetSignatureVerificationSpec(in *kops.AssetSignatureVerificationSpec, out *AssetSignatureVerificationSpec, s conversion.Scope) error {
+	return autoConvert_kops_AssetSignatureVerificationSpec_To_v1alpha3_AssetSignatureVerificationSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_AssetsSpec_To_kops_AssetsSpec(in *AssetsSpec, out *kops.AssetsSpec, s conversion.Scope) error {
 	out.ContainerRegistry = in.ContainerRegistry
 	out.FileRepository = in.FileRepository
 	out.ContainerProxy = in.ContainerProxy
+	out.ImageDigests = in.ImageDigests
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(kops.AssetSignatureVerificationSpec)
+		if err := Convert_v1alpha3_AssetSignatureVerificationSpec_To_kops_AssetSignatureVerificationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureVerification = nil
+	}
 	return nil
 }
 
@@ -1753,6 +2271,16 @@ func autoConvert_kops_AssetsSpec_To_v1alpha3_AssetsSpec(in *kops.AssetsSpec, out
 	out.ContainerRegistry = in.ContainerRegistry
 	out.FileRepository = in.FileRepository
 	out.ContainerProxy = in.ContainerProxy
+	out.ImageDigests = in.ImageDigests
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(AssetSignatureVerificationSpec)
+		if err := Convert_kops_AssetSignatureVerificationSpec_To_v1alpha3_AssetSignatureVerificationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SignatureVerification = nil
+	}
 	return nil
 }
 
@@ -1917,6 +2445,28 @@ func Convert_kops_AzureSpec_To_v1alpha3_AzureSpec(in *kops.AzureSpec, out *Azure
 	return autoConvert_kops_AzureSpec_To_v1alpha3_AzureSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_BastionAccessSpec_To_kops_BastionAccessSpec(in *BastionAccessSpec, out *kops.BastionAccessSpec, s conversion.Scope) error {
+	out.Users = in.Users
+	out.CIDRs = in.CIDRs
+	return nil
+}
+
+// Convert_v1alpha3_BastionAccessSpec_To_kops_BastionAccessSpec is an autogenerated conversion function.
+func Convert_v1alpha3_BastionAccessSpec_To_kops_BastionAccessSpec(in *BastionAccessSpec, out *kops.BastionAccessSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_BastionAccessSpec_To_kops_BastionAccessSpec(in, out, s)
+}
+
+func autoConvert_kops_BastionAccessSpec_To_v1alpha3_BastionAccessSpec(in *kops.BastionAccessSpec, out *BastionAccessSpec, s conversion.Scope) error {
+	out.Users = in.Users
+	out.CIDRs = in.CIDRs
+	return nil
+}
+
+// Convert_kops_BastionAccessSpec_To_v1alpha3_BastionAccessSpec is an autogenerated conversion function.
+func Convert_kops_BastionAccessSpec_To_v1alpha3_BastionAccessSpec(in *kops.BastionAccessSpec, out *BastionAccessSpec, s conversion.Scope) error {
+	return autoConvert_kops_BastionAccessSpec_To_v1alpha3_BastionAccessSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_BastionLoadBalancerSpec_To_kops_BastionLoadBalancerSpec(in *BastionLoadBalancerSpec, out *kops.BastionLoadBalancerSpec, s conversion.Scope) error {
 	out.Type = kops.LoadBalancerType(in.Type)
 	return nil
@@ -1948,6 +2498,27 @@ func autoConvert_v1alpha3_BastionSpec_To_kops_BastionSpec(in *BastionSpec, out *
 	} else {
 		out.LoadBalancer = nil
 	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(kops.ManagedBastionSpec)
+		if err := Convert_v1alpha3_ManagedBastionSpec_To_kops_ManagedBastionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Managed = nil
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]kops.BastionAccessSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_BastionAccessSpec_To_kops_BastionAccessSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Access = nil
+	}
+	out.SessionIdleTimeout = in.SessionIdleTimeout
 	return nil
 }
 
@@ -1967,6 +2538,27 @@ func autoConvert_kops_BastionSpec_To_v1alpha3_BastionSpec(in *kops.BastionSpec,
 	} else {
 		out.LoadBalancer = nil
 	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(ManagedBastionSpec)
+		if err := Convert_kops_ManagedBastionSpec_To_v1alpha3_ManagedBastionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Managed = nil
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = make([]BastionAccessSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_BastionAccessSpec_To_v1alpha3_BastionAccessSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Access = nil
+	}
+	out.SessionIdleTimeout = in.SessionIdleTimeout
 	return nil
 }
 
@@ -1995,6 +2587,54 @@ func Convert_kops_CNINetworkingSpec_To_v1alpha3_CNINetworkingSpec(in *kops.CNINe
 	return autoConvert_kops_CNINetworkingSpec_To_v1alpha3_CNINetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources(in *CSISidecarResources, out *kops.CSISidecarResources, s conversion.Scope) error {
+	out.CPURequest = in.CPURequest
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
+	return nil
+}
+
+// Convert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources is an autogenerated conversion function.
+func Convert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources(in *CSISidecarResources, out *kops.CSISidecarResources, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources(in, out, s)
+}
+
+func autoConvert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources(in *kops.CSISidecarResources, out *CSISidecarResources, s conversion.Scope) error {
+	out.CPURequest = in.CPURequest
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
+	return nil
+}
+
+// Convert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources is an autogenerated conversion function.
+func Convert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources(in *kops.CSISidecarResources, out *CSISidecarResources, s conversion.Scope) error {
+	return autoConvert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources(in, out, s)
+}
+
+func autoConvert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(in *CSIStorageClassSpec, out *kops.CSIStorageClassSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.KMSKeyID = in.KMSKeyID
+	out.Zones = in.Zones
+	return nil
+}
+
+// Convert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec is an autogenerated conversion function.
+func Convert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(in *CSIStorageClassSpec, out *kops.CSIStorageClassSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(in, out, s)
+}
+
+func autoConvert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec(in *kops.CSIStorageClassSpec, out *CSIStorageClassSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.KMSKeyID = in.KMSKeyID
+	out.Zones = in.Zones
+	return nil
+}
+
+// Convert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec is an autogenerated conversion function.
+func Convert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec(in *kops.CSIStorageClassSpec, out *CSIStorageClassSpec, s conversion.Scope) error {
+	return autoConvert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_CalicoNetworkingSpec_To_kops_CalicoNetworkingSpec(in *CalicoNetworkingSpec, out *kops.CalicoNetworkingSpec, s conversion.Scope) error {
 	out.Registry = in.Registry
 	out.Version = in.Version
@@ -2121,6 +2761,7 @@ func autoConvert_v1alpha3_CertManagerConfig_To_kops_CertManagerConfig(in *CertMa
 	out.Nameservers = in.Nameservers
 	out.HostedZoneIDs = in.HostedZoneIDs
 	out.FeatureGates = in.FeatureGates
+	out.UseClusterCA = in.UseClusterCA
 	return nil
 }
 
@@ -2137,6 +2778,7 @@ func autoConvert_kops_CertManagerConfig_To_v1alpha3_CertManagerConfig(in *kops.C
 	out.Nameservers = in.Nameservers
 	out.HostedZoneIDs = in.HostedZoneIDs
 	out.FeatureGates = in.FeatureGates
+	out.UseClusterCA = in.UseClusterCA
 	return nil
 }
 
@@ -2145,6 +2787,26 @@ func Convert_kops_CertManagerConfig_To_v1alpha3_CertManagerConfig(in *kops.CertM
 	return autoConvert_kops_CertManagerConfig_To_v1alpha3_CertManagerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_CertificateExpirySpec_To_kops_CertificateExpirySpec(in *CertificateExpirySpec, out *kops.CertificateExpirySpec, s conversion.Scope) error {
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_v1alpha3_CertificateExpirySpec_To_kops_CertificateExpirySpec is an autogenerated conversion function.
+func Convert_v1alpha3_CertificateExpirySpec_To_kops_CertificateExpirySpec(in *CertificateExpirySpec, out *kops.CertificateExpirySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CertificateExpirySpec_To_kops_CertificateExpirySpec(in, out, s)
+}
+
+func autoConvert_kops_CertificateExpirySpec_To_v1alpha3_CertificateExpirySpec(in *kops.CertificateExpirySpec, out *CertificateExpirySpec, s conversion.Scope) error {
+	out.Window = in.Window
+	return nil
+}
+
+// Convert_kops_CertificateExpirySpec_To_v1alpha3_CertificateExpirySpec is an autogenerated conversion function.
+func Convert_kops_CertificateExpirySpec_To_v1alpha3_CertificateExpirySpec(in *kops.CertificateExpirySpec, out *CertificateExpirySpec, s conversion.Scope) error {
+	return autoConvert_kops_CertificateExpirySpec_To_v1alpha3_CertificateExpirySpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_CiliumGatewayAPISpec_To_kops_CiliumGatewayAPISpec(in *CiliumGatewayAPISpec, out *kops.CiliumGatewayAPISpec, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EnableSecretsSync = in.EnableSecretsSync
@@ -2415,6 +3077,9 @@ func autoConvert_v1alpha3_CloudControllerManagerConfig_To_kops_CloudControllerMa
 	out.UseServiceAccountCredentials = in.UseServiceAccountCredentials
 	out.EnableLeaderMigration = in.EnableLeaderMigration
 	out.CPURequest = in.CPURequest
+	out.CPULimit = in.CPULimit
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
 	out.NodeStatusUpdateFrequency = in.NodeStatusUpdateFrequency
 	out.ConcurrentNodeSyncs = in.ConcurrentNodeSyncs
 	return nil
@@ -2449,6 +3114,9 @@ func autoConvert_kops_CloudControllerManagerConfig_To_v1alpha3_CloudControllerMa
 	out.UseServiceAccountCredentials = in.UseServiceAccountCredentials
 	out.EnableLeaderMigration = in.EnableLeaderMigration
 	out.CPURequest = in.CPURequest
+	out.CPULimit = in.CPULimit
+	out.MemoryRequest = in.MemoryRequest
+	out.MemoryLimit = in.MemoryLimit
 	out.NodeStatusUpdateFrequency = in.NodeStatusUpdateFrequency
 	out.ConcurrentNodeSyncs = in.ConcurrentNodeSyncs
 	return nil
@@ -2652,6 +3320,8 @@ func autoConvert_v1alpha3_ClusterAutoscalerConfig_To_kops_ClusterAutoscalerConfi
 	out.PodAnnotations = in.PodAnnotations
 	out.CreatePriorityExpenderConfig = in.CreatePriorityExpenderConfig
 	out.CustomPriorityExpanderConfig = in.CustomPriorityExpanderConfig
+	out.BalancingLabels = in.BalancingLabels
+	out.BalancingIgnoreLabels = in.BalancingIgnoreLabels
 	return nil
 }
 
@@ -2683,6 +3353,8 @@ func autoConvert_kops_ClusterAutoscalerConfig_To_v1alpha3_ClusterAutoscalerConfi
 	out.PodAnnotations = in.PodAnnotations
 	out.CreatePriorityExpenderConfig = in.CreatePriorityExpenderConfig
 	out.CustomPriorityExpanderConfig = in.CustomPriorityExpanderConfig
+	out.BalancingLabels = in.BalancingLabels
+	out.BalancingIgnoreLabels = in.BalancingIgnoreLabels
 	return nil
 }
 
@@ -3059,6 +3731,110 @@ func autoConvert_v1alpha3_ClusterSpec_To_kops_ClusterSpec(in *ClusterSpec, out *
 	} else {
 		out.Karpenter = nil
 	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(kops.MonitoringSpec)
+		if err := Convert_v1alpha3_MonitoringSpec_To_kops_MonitoringSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Monitoring = nil
+	}
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(kops.GitOpsSpec)
+		if err := Convert_v1alpha3_GitOpsSpec_To_kops_GitOpsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitOps = nil
+	}
+	if in.AddonResources != nil {
+		in, out := &in.AddonResources, &out.AddonResources
+		*out = new(kops.AddonResourcesSpec)
+		if err := Convert_v1alpha3_AddonResourcesSpec_To_kops_AddonResourcesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AddonResources = nil
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(kops.VerticalPodAutoscalerConfig)
+		if err := Convert_v1alpha3_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VerticalPodAutoscaler = nil
+	}
+	out.AdoptAddons = in.AdoptAddons
+	out.FeatureGates = in.FeatureGates
+	out.APIRuntimeConfig = in.APIRuntimeConfig
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(kops.KonnectivityConfig)
+		if err := Convert_v1alpha3_KonnectivityConfig_To_kops_KonnectivityConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Konnectivity = nil
+	}
+	out.ControlPlaneProfile = kops.ControlPlaneProfile(in.ControlPlaneProfile)
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(kops.NamespaceDefaultsSpec)
+		if err := Convert_v1alpha3_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NamespaceDefaults = nil
+	}
+	if in.Admission != nil {
+		in, out := &in.Admission, &out.Admission
+		*out = new(kops.AdmissionSpec)
+		if err := Convert_v1alpha3_AdmissionSpec_To_kops_AdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Admission = nil
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(kops.SSHCertificateAuthoritySpec)
+		if err := Convert_v1alpha3_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SSHCertificateAuthority = nil
+	}
+	if in.ConnectivityProbes != nil {
+		in, out := &in.ConnectivityProbes, &out.ConnectivityProbes
+		*out = new(kops.ConnectivityProbesSpec)
+		if err := Convert_v1alpha3_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConnectivityProbes = nil
+	}
+	out.DeletionProtection = in.DeletionProtection
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(kops.HibernationSpec)
+		if err := Convert_v1alpha3_HibernationSpec_To_kops_HibernationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hibernation = nil
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(kops.NotificationsSpec)
+		if err := Convert_v1alpha3_NotificationsSpec_To_kops_NotificationsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Notifications = nil
+	}
 	return nil
 }
 
@@ -3393,41 +4169,145 @@ func autoConvert_kops_ClusterSpec_To_v1alpha3_ClusterSpec(in *kops.ClusterSpec,
 	} else {
 		out.Karpenter = nil
 	}
-	return nil
-}
-
-// Convert_kops_ClusterSpec_To_v1alpha3_ClusterSpec is an autogenerated conversion function.
-func Convert_kops_ClusterSpec_To_v1alpha3_ClusterSpec(in *kops.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
-	return autoConvert_kops_ClusterSpec_To_v1alpha3_ClusterSpec(in, out, s)
-}
-
-func autoConvert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Zone = in.Zone
-	out.Region = in.Region
-	out.CIDR = in.CIDR
-	out.IPv6CIDR = in.IPv6CIDR
-	out.ID = in.ID
-	out.Egress = in.Egress
-	out.Type = kops.SubnetType(in.Type)
-	out.PublicIP = in.PublicIP
-	if in.AdditionalRoutes != nil {
-		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
-		*out = make([]kops.RouteSpec, len(*in))
-		for i := range *in {
-			if err := Convert_v1alpha3_RouteSpec_To_kops_RouteSpec(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(MonitoringSpec)
+		if err := Convert_kops_MonitoringSpec_To_v1alpha3_MonitoringSpec(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.AdditionalRoutes = nil
+		out.Monitoring = nil
 	}
-	return nil
-}
-
-// Convert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec is an autogenerated conversion function.
-func Convert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in, out, s)
+	if in.GitOps != nil {
+		in, out := &in.GitOps, &out.GitOps
+		*out = new(GitOpsSpec)
+		if err := Convert_kops_GitOpsSpec_To_v1alpha3_GitOpsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitOps = nil
+	}
+	if in.AddonResources != nil {
+		in, out := &in.AddonResources, &out.AddonResources
+		*out = new(AddonResourcesSpec)
+		if err := Convert_kops_AddonResourcesSpec_To_v1alpha3_AddonResourcesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AddonResources = nil
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(VerticalPodAutoscalerConfig)
+		if err := Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha3_VerticalPodAutoscalerConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VerticalPodAutoscaler = nil
+	}
+	out.AdoptAddons = in.AdoptAddons
+	out.FeatureGates = in.FeatureGates
+	out.APIRuntimeConfig = in.APIRuntimeConfig
+	if in.Konnectivity != nil {
+		in, out := &in.Konnectivity, &out.Konnectivity
+		*out = new(KonnectivityConfig)
+		if err := Convert_kops_KonnectivityConfig_To_v1alpha3_KonnectivityConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Konnectivity = nil
+	}
+	out.ControlPlaneProfile = ControlPlaneProfile(in.ControlPlaneProfile)
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(NamespaceDefaultsSpec)
+		if err := Convert_kops_NamespaceDefaultsSpec_To_v1alpha3_NamespaceDefaultsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NamespaceDefaults = nil
+	}
+	if in.Admission != nil {
+		in, out := &in.Admission, &out.Admission
+		*out = new(AdmissionSpec)
+		if err := Convert_kops_AdmissionSpec_To_v1alpha3_AdmissionSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Admission = nil
+	}
+	if in.SSHCertificateAuthority != nil {
+		in, out := &in.SSHCertificateAuthority, &out.SSHCertificateAuthority
+		*out = new(SSHCertificateAuthoritySpec)
+		if err := Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha3_SSHCertificateAuthoritySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SSHCertificateAuthority = nil
+	}
+	if in.ConnectivityProbes != nil {
+		in, out := &in.ConnectivityProbes, &out.ConnectivityProbes
+		*out = new(ConnectivityProbesSpec)
+		if err := Convert_kops_ConnectivityProbesSpec_To_v1alpha3_ConnectivityProbesSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConnectivityProbes = nil
+	}
+	out.DeletionProtection = in.DeletionProtection
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(HibernationSpec)
+		if err := Convert_kops_HibernationSpec_To_v1alpha3_HibernationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Hibernation = nil
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsSpec)
+		if err := Convert_kops_NotificationsSpec_To_v1alpha3_NotificationsSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Notifications = nil
+	}
+	return nil
+}
+
+// Convert_kops_ClusterSpec_To_v1alpha3_ClusterSpec is an autogenerated conversion function.
+func Convert_kops_ClusterSpec_To_v1alpha3_ClusterSpec(in *kops.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
+	return autoConvert_kops_ClusterSpec_To_v1alpha3_ClusterSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zone = in.Zone
+	out.Region = in.Region
+	out.CIDR = in.CIDR
+	out.IPv6CIDR = in.IPv6CIDR
+	out.ID = in.ID
+	out.Egress = in.Egress
+	out.Type = kops.SubnetType(in.Type)
+	out.PublicIP = in.PublicIP
+	if in.AdditionalRoutes != nil {
+		in, out := &in.AdditionalRoutes, &out.AdditionalRoutes
+		*out = make([]kops.RouteSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_RouteSpec_To_kops_RouteSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalRoutes = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec is an autogenerated conversion function.
+func Convert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in *ClusterSubnetSpec, out *kops.ClusterSubnetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ClusterSubnetSpec_To_kops_ClusterSubnetSpec(in, out, s)
 }
 
 func autoConvert_kops_ClusterSubnetSpec_To_v1alpha3_ClusterSubnetSpec(in *kops.ClusterSubnetSpec, out *ClusterSubnetSpec, s conversion.Scope) error {
@@ -3483,6 +4363,30 @@ func Convert_kops_ConfigStoreSpec_To_v1alpha3_ConfigStoreSpec(in *kops.ConfigSto
 	return autoConvert_kops_ConfigStoreSpec_To_v1alpha3_ConfigStoreSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(in *ConnectivityProbesSpec, out *kops.ConnectivityProbesSpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.Timeout = in.Timeout
+	out.AdditionalTargets = in.AdditionalTargets
+	return nil
+}
+
+// Convert_v1alpha3_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec is an autogenerated conversion function.
+func Convert_v1alpha3_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(in *ConnectivityProbesSpec, out *kops.ConnectivityProbesSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ConnectivityProbesSpec_To_kops_ConnectivityProbesSpec(in, out, s)
+}
+
+func autoConvert_kops_ConnectivityProbesSpec_To_v1alpha3_ConnectivityProbesSpec(in *kops.ConnectivityProbesSpec, out *ConnectivityProbesSpec, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.Timeout = in.Timeout
+	out.AdditionalTargets = in.AdditionalTargets
+	return nil
+}
+
+// Convert_kops_ConnectivityProbesSpec_To_v1alpha3_ConnectivityProbesSpec is an autogenerated conversion function.
+func Convert_kops_ConnectivityProbesSpec_To_v1alpha3_ConnectivityProbesSpec(in *kops.ConnectivityProbesSpec, out *ConnectivityProbesSpec, s conversion.Scope) error {
+	return autoConvert_kops_ConnectivityProbesSpec_To_v1alpha3_ConnectivityProbesSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_ContainerdConfig_To_kops_ContainerdConfig(in *ContainerdConfig, out *kops.ContainerdConfig, s conversion.Scope) error {
 	out.Address = in.Address
 	out.ConfigAdditions = in.ConfigAdditions
@@ -3829,7 +4733,26 @@ func autoConvert_v1alpha3_EBSCSIDriverSpec_To_kops_EBSCSIDriverSpec(in *EBSCSIDr
 	out.KubeAPIBurst = in.KubeAPIBurst
 	out.HostNetwork = in.HostNetwork
 	out.VolumeAttachLimit = in.VolumeAttachLimit
+	out.ReservedVolumeAttachments = in.ReservedVolumeAttachments
 	out.PodAnnotations = in.PodAnnotations
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(kops.CSIStorageClassSpec)
+		if err := Convert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(kops.CSISidecarResources)
+		if err := Convert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -3846,7 +4769,26 @@ func autoConvert_kops_EBSCSIDriverSpec_To_v1alpha3_EBSCSIDriverSpec(in *kops.EBS
 	out.KubeAPIBurst = in.KubeAPIBurst
 	out.HostNetwork = in.HostNetwork
 	out.VolumeAttachLimit = in.VolumeAttachLimit
+	out.ReservedVolumeAttachments = in.ReservedVolumeAttachments
 	out.PodAnnotations = in.PodAnnotations
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(CSIStorageClassSpec)
+		if err := Convert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(CSISidecarResources)
+		if err := Convert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -3963,6 +4905,11 @@ func autoConvert_v1alpha3_EtcdClusterSpec_To_kops_EtcdClusterSpec(in *EtcdCluste
 	}
 	out.MemoryRequest = in.MemoryRequest
 	out.CPURequest = in.CPURequest
+	out.MemoryLimit = in.MemoryLimit
+	out.CPULimit = in.CPULimit
+	out.QuotaBackendBytes = in.QuotaBackendBytes
+	out.AutoCompactionMode = in.AutoCompactionMode
+	out.AutoCompactionRetention = in.AutoCompactionRetention
 	return nil
 }
 
@@ -4009,6 +4956,11 @@ func autoConvert_kops_EtcdClusterSpec_To_v1alpha3_EtcdClusterSpec(in *kops.EtcdC
 	}
 	out.MemoryRequest = in.MemoryRequest
 	out.CPURequest = in.CPURequest
+	out.MemoryLimit = in.MemoryLimit
+	out.CPULimit = in.CPULimit
+	out.QuotaBackendBytes = in.QuotaBackendBytes
+	out.AutoCompactionMode = in.AutoCompactionMode
+	out.AutoCompactionRetention = in.AutoCompactionRetention
 	return nil
 }
 
@@ -4017,6 +4969,34 @@ func Convert_kops_EtcdClusterSpec_To_v1alpha3_EtcdClusterSpec(in *kops.EtcdClust
 	return autoConvert_kops_EtcdClusterSpec_To_v1alpha3_EtcdClusterSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(in *EtcdIOIsolationSpec, out *kops.EtcdIOIsolationSpec, s conversion.Scope) error {
+	out.Scheduler = in.Scheduler
+	out.MaxReadBandwidth = in.MaxReadBandwidth
+	out.MaxWriteBandwidth = in.MaxWriteBandwidth
+	out.MaxReadIOPS = in.MaxReadIOPS
+	out.MaxWriteIOPS = in.MaxWriteIOPS
+	return nil
+}
+
+// Convert_v1alpha3_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec is an autogenerated conversion function.
+func Convert_v1alpha3_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(in *EtcdIOIsolationSpec, out *kops.EtcdIOIsolationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(in, out, s)
+}
+
+func autoConvert_kops_EtcdIOIsolationSpec_To_v1alpha3_EtcdIOIsolationSpec(in *kops.EtcdIOIsolationSpec, out *EtcdIOIsolationSpec, s conversion.Scope) error {
+	out.Scheduler = in.Scheduler
+	out.MaxReadBandwidth = in.MaxReadBandwidth
+	out.MaxWriteBandwidth = in.MaxWriteBandwidth
+	out.MaxReadIOPS = in.MaxReadIOPS
+	out.MaxWriteIOPS = in.MaxWriteIOPS
+	return nil
+}
+
+// Convert_kops_EtcdIOIsolationSpec_To_v1alpha3_EtcdIOIsolationSpec is an autogenerated conversion function.
+func Convert_kops_EtcdIOIsolationSpec_To_v1alpha3_EtcdIOIsolationSpec(in *kops.EtcdIOIsolationSpec, out *EtcdIOIsolationSpec, s conversion.Scope) error {
+	return autoConvert_kops_EtcdIOIsolationSpec_To_v1alpha3_EtcdIOIsolationSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_EtcdManagerSpec_To_kops_EtcdManagerSpec(in *EtcdManagerSpec, out *kops.EtcdManagerSpec, s conversion.Scope) error {
 	out.Image = in.Image
 	if in.Env != nil {
@@ -4305,6 +5285,32 @@ func Convert_kops_GCPNetworkingSpec_To_v1alpha3_GCPNetworkingSpec(in *kops.GCPNe
 	return autoConvert_kops_GCPNetworkingSpec_To_v1alpha3_GCPNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_GitOpsSpec_To_kops_GitOpsSpec(in *GitOpsSpec, out *kops.GitOpsSpec, s conversion.Scope) error {
+	out.Mode = kops.GitOpsMode(in.Mode)
+	out.Interval = in.Interval
+	out.Source = in.Source
+	out.GrantPermissions = in.GrantPermissions
+	return nil
+}
+
+// Convert_v1alpha3_GitOpsSpec_To_kops_GitOpsSpec is an autogenerated conversion function.
+func Convert_v1alpha3_GitOpsSpec_To_kops_GitOpsSpec(in *GitOpsSpec, out *kops.GitOpsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_GitOpsSpec_To_kops_GitOpsSpec(in, out, s)
+}
+
+func autoConvert_kops_GitOpsSpec_To_v1alpha3_GitOpsSpec(in *kops.GitOpsSpec, out *GitOpsSpec, s conversion.Scope) error {
+	out.Mode = GitOpsMode(in.Mode)
+	out.Interval = in.Interval
+	out.Source = in.Source
+	out.GrantPermissions = in.GrantPermissions
+	return nil
+}
+
+// Convert_kops_GitOpsSpec_To_v1alpha3_GitOpsSpec is an autogenerated conversion function.
+func Convert_kops_GitOpsSpec_To_v1alpha3_GitOpsSpec(in *kops.GitOpsSpec, out *GitOpsSpec, s conversion.Scope) error {
+	return autoConvert_kops_GitOpsSpec_To_v1alpha3_GitOpsSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_GossipConfig_To_kops_GossipConfig(in *GossipConfig, out *kops.GossipConfig, s conversion.Scope) error {
 	out.Protocol = in.Protocol
 	out.Listen = in.Listen
@@ -4393,6 +5399,104 @@ func Convert_kops_HTTPProxy_To_v1alpha3_HTTPProxy(in *kops.HTTPProxy, out *HTTPP
 	return autoConvert_kops_HTTPProxy_To_v1alpha3_HTTPProxy(in, out, s)
 }
 
+func autoConvert_v1alpha3_HelmChartSpec_To_kops_HelmChartSpec(in *HelmChartSpec, out *kops.HelmChartSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Repository = in.Repository
+	out.Chart = in.Chart
+	out.Version = in.Version
+	out.Namespace = in.Namespace
+	out.Values = in.Values
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]kops.HelmValuesSource, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_HelmValuesSource_To_kops_HelmValuesSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ValuesFrom = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_HelmChartSpec_To_kops_HelmChartSpec is an autogenerated conversion function.
+func Convert_v1alpha3_HelmChartSpec_To_kops_HelmChartSpec(in *HelmChartSpec, out *kops.HelmChartSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_HelmChartSpec_To_kops_HelmChartSpec(in, out, s)
+}
+
+func autoConvert_kops_HelmChartSpec_To_v1alpha3_HelmChartSpec(in *kops.HelmChartSpec, out *HelmChartSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Repository = in.Repository
+	out.Chart = in.Chart
+	out.Version = in.Version
+	out.Namespace = in.Namespace
+	out.Values = in.Values
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]HelmValuesSource, len(*in))
+		for i := range *in {
+			if err := Convert_kops_HelmValuesSource_To_v1alpha3_HelmValuesSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ValuesFrom = nil
+	}
+	return nil
+}
+
+// Convert_kops_HelmChartSpec_To_v1alpha3_HelmChartSpec is an autogenerated conversion function.
+func Convert_kops_HelmChartSpec_To_v1alpha3_HelmChartSpec(in *kops.HelmChartSpec, out *HelmChartSpec, s conversion.Scope) error {
+	return autoConvert_kops_HelmChartSpec_To_v1alpha3_HelmChartSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_HelmValuesSource_To_kops_HelmValuesSource(in *HelmValuesSource, out *kops.HelmValuesSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha3_HelmValuesSource_To_kops_HelmValuesSource is an autogenerated conversion function.
+func Convert_v1alpha3_HelmValuesSource_To_kops_HelmValuesSource(in *HelmValuesSource, out *kops.HelmValuesSource, s conversion.Scope) error {
+	return autoConvert_v1alpha3_HelmValuesSource_To_kops_HelmValuesSource(in, out, s)
+}
+
+func autoConvert_kops_HelmValuesSource_To_v1alpha3_HelmValuesSource(in *kops.HelmValuesSource, out *HelmValuesSource, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_kops_HelmValuesSource_To_v1alpha3_HelmValuesSource is an autogenerated conversion function.
+func Convert_kops_HelmValuesSource_To_v1alpha3_HelmValuesSource(in *kops.HelmValuesSource, out *HelmValuesSource, s conversion.Scope) error {
+	return autoConvert_kops_HelmValuesSource_To_v1alpha3_HelmValuesSource(in, out, s)
+}
+
+func autoConvert_v1alpha3_HibernationSpec_To_kops_HibernationSpec(in *HibernationSpec, out *kops.HibernationSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.ResumeSchedule = in.ResumeSchedule
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha3_HibernationSpec_To_kops_HibernationSpec is an autogenerated conversion function.
+func Convert_v1alpha3_HibernationSpec_To_kops_HibernationSpec(in *HibernationSpec, out *kops.HibernationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_HibernationSpec_To_kops_HibernationSpec(in, out, s)
+}
+
+func autoConvert_kops_HibernationSpec_To_v1alpha3_HibernationSpec(in *kops.HibernationSpec, out *HibernationSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.ResumeSchedule = in.ResumeSchedule
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_kops_HibernationSpec_To_v1alpha3_HibernationSpec is an autogenerated conversion function.
+func Convert_kops_HibernationSpec_To_v1alpha3_HibernationSpec(in *kops.HibernationSpec, out *HibernationSpec, s conversion.Scope) error {
+	return autoConvert_kops_HibernationSpec_To_v1alpha3_HibernationSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_HetznerSpec_To_kops_HetznerSpec(in *HetznerSpec, out *kops.HetznerSpec, s conversion.Scope) error {
 	return nil
 }
@@ -4593,6 +5697,36 @@ func Convert_kops_InstanceGroup_To_v1alpha3_InstanceGroup(in *kops.InstanceGroup
 	return autoConvert_kops_InstanceGroup_To_v1alpha3_InstanceGroup(in, out, s)
 }
 
+func autoConvert_v1alpha3_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in *InstanceGroupClusterAutoscalerSpec, out *kops.InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	out.ScaleDownDisabled = in.ScaleDownDisabled
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	out.ScaleDownUnneededTime = in.ScaleDownUnneededTime
+	out.ScaleDownUnreadyTime = in.ScaleDownUnreadyTime
+	out.MaxNodeProvisionTime = in.MaxNodeProvisionTime
+	out.IgnoreDaemonSetsUtilization = in.IgnoreDaemonSetsUtilization
+	return nil
+}
+
+// Convert_v1alpha3_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec is an autogenerated conversion function.
+func Convert_v1alpha3_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in *InstanceGroupClusterAutoscalerSpec, out *kops.InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(in, out, s)
+}
+
+func autoConvert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha3_InstanceGroupClusterAutoscalerSpec(in *kops.InstanceGroupClusterAutoscalerSpec, out *InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	out.ScaleDownDisabled = in.ScaleDownDisabled
+	out.ScaleDownUtilizationThreshold = in.ScaleDownUtilizationThreshold
+	out.ScaleDownUnneededTime = in.ScaleDownUnneededTime
+	out.ScaleDownUnreadyTime = in.ScaleDownUnreadyTime
+	out.MaxNodeProvisionTime = in.MaxNodeProvisionTime
+	out.IgnoreDaemonSetsUtilization = in.IgnoreDaemonSetsUtilization
+	return nil
+}
+
+// Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha3_InstanceGroupClusterAutoscalerSpec is an autogenerated conversion function.
+func Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha3_InstanceGroupClusterAutoscalerSpec(in *kops.InstanceGroupClusterAutoscalerSpec, out *InstanceGroupClusterAutoscalerSpec, s conversion.Scope) error {
+	return autoConvert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha3_InstanceGroupClusterAutoscalerSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_InstanceGroupList_To_kops_InstanceGroupList(in *InstanceGroupList, out *kops.InstanceGroupList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4638,7 +5772,9 @@ func Convert_kops_InstanceGroupList_To_v1alpha3_InstanceGroupList(in *kops.Insta
 func autoConvert_v1alpha3_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *InstanceGroupSpec, out *kops.InstanceGroupSpec, s conversion.Scope) error {
 	out.Manager = kops.InstanceManager(in.Manager)
 	out.Role = kops.InstanceGroupRole(in.Role)
+	out.OperatingSystem = kops.InstanceGroupOperatingSystem(in.OperatingSystem)
 	out.Image = in.Image
+	out.ImageChannel = in.ImageChannel
 	out.MinSize = in.MinSize
 	out.MaxSize = in.MaxSize
 	out.Autoscale = in.Autoscale
@@ -4717,6 +5853,7 @@ func autoConvert_v1alpha3_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	out.ArchitectureTaint = in.ArchitectureTaint
 	if in.MixedInstancesPolicy != nil {
 		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
 		*out = new(kops.MixedInstancesPolicySpec)
@@ -4763,6 +5900,24 @@ func autoConvert_v1alpha3_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	out.SecurityGroupOverride = in.SecurityGroupOverride
 	out.InstanceProtection = in.InstanceProtection
 	out.SysctlParameters = in.SysctlParameters
+	if in.Journald != nil {
+		in, out := &in.Journald, &out.Journald
+		*out = new(kops.JournaldSpec)
+		if err := Convert_v1alpha3_JournaldSpec_To_kops_JournaldSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Journald = nil
+	}
+	if in.EtcdIOIsolation != nil {
+		in, out := &in.EtcdIOIsolation, &out.EtcdIOIsolation
+		*out = new(kops.EtcdIOIsolationSpec)
+		if err := Convert_v1alpha3_EtcdIOIsolationSpec_To_kops_EtcdIOIsolationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EtcdIOIsolation = nil
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(kops.RollingUpdate)
@@ -4816,6 +5971,27 @@ func autoConvert_v1alpha3_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *Instan
 	}
 	out.MaxInstanceLifetime = in.MaxInstanceLifetime
 	out.GCPProvisioningModel = in.GCPProvisioningModel
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(kops.InstanceGroupClusterAutoscalerSpec)
+		if err := Convert_v1alpha3_InstanceGroupClusterAutoscalerSpec_To_kops_InstanceGroupClusterAutoscalerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterAutoscaler = nil
+	}
+	out.PreferSpot = in.PreferSpot
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]kops.SSHAuthorizedKeysSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.SSHAuthorizedKeys = nil
+	}
 	return nil
 }
 
@@ -4827,7 +6003,9 @@ func Convert_v1alpha3_InstanceGroupSpec_To_kops_InstanceGroupSpec(in *InstanceGr
 func autoConvert_kops_InstanceGroupSpec_To_v1alpha3_InstanceGroupSpec(in *kops.InstanceGroupSpec, out *InstanceGroupSpec, s conversion.Scope) error {
 	out.Manager = InstanceManager(in.Manager)
 	out.Role = InstanceGroupRole(in.Role)
+	out.OperatingSystem = InstanceGroupOperatingSystem(in.OperatingSystem)
 	out.Image = in.Image
+	out.ImageChannel = in.ImageChannel
 	out.MinSize = in.MinSize
 	out.MaxSize = in.MaxSize
 	out.Autoscale = in.Autoscale
@@ -4906,6 +6084,7 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha3_InstanceGroupSpec(in *kops.I
 		out.Kubelet = nil
 	}
 	out.Taints = in.Taints
+	out.ArchitectureTaint = in.ArchitectureTaint
 	if in.MixedInstancesPolicy != nil {
 		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
 		*out = new(MixedInstancesPolicySpec)
@@ -4952,6 +6131,24 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha3_InstanceGroupSpec(in *kops.I
 	out.SecurityGroupOverride = in.SecurityGroupOverride
 	out.InstanceProtection = in.InstanceProtection
 	out.SysctlParameters = in.SysctlParameters
+	if in.Journald != nil {
+		in, out := &in.Journald, &out.Journald
+		*out = new(JournaldSpec)
+		if err := Convert_kops_JournaldSpec_To_v1alpha3_JournaldSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Journald = nil
+	}
+	if in.EtcdIOIsolation != nil {
+		in, out := &in.EtcdIOIsolation, &out.EtcdIOIsolation
+		*out = new(EtcdIOIsolationSpec)
+		if err := Convert_kops_EtcdIOIsolationSpec_To_v1alpha3_EtcdIOIsolationSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EtcdIOIsolation = nil
+	}
 	if in.RollingUpdate != nil {
 		in, out := &in.RollingUpdate, &out.RollingUpdate
 		*out = new(RollingUpdate)
@@ -5005,6 +6202,27 @@ func autoConvert_kops_InstanceGroupSpec_To_v1alpha3_InstanceGroupSpec(in *kops.I
 	}
 	out.MaxInstanceLifetime = in.MaxInstanceLifetime
 	out.GCPProvisioningModel = in.GCPProvisioningModel
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(InstanceGroupClusterAutoscalerSpec)
+		if err := Convert_kops_InstanceGroupClusterAutoscalerSpec_To_v1alpha3_InstanceGroupClusterAutoscalerSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClusterAutoscaler = nil
+	}
+	out.PreferSpot = in.PreferSpot
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]SSHAuthorizedKeysSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha3_SSHAuthorizedKeysSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.SSHAuthorizedKeys = nil
+	}
 	return nil
 }
 
@@ -5121,6 +6339,30 @@ func Convert_kops_InstanceRootVolumeSpec_To_v1alpha3_InstanceRootVolumeSpec(in *
 	return autoConvert_kops_InstanceRootVolumeSpec_To_v1alpha3_InstanceRootVolumeSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_JournaldSpec_To_kops_JournaldSpec(in *JournaldSpec, out *kops.JournaldSpec, s conversion.Scope) error {
+	out.SystemMaxUse = in.SystemMaxUse
+	out.RateLimitInterval = in.RateLimitInterval
+	out.RateLimitBurst = in.RateLimitBurst
+	return nil
+}
+
+// Convert_v1alpha3_JournaldSpec_To_kops_JournaldSpec is an autogenerated conversion function.
+func Convert_v1alpha3_JournaldSpec_To_kops_JournaldSpec(in *JournaldSpec, out *kops.JournaldSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_JournaldSpec_To_kops_JournaldSpec(in, out, s)
+}
+
+func autoConvert_kops_JournaldSpec_To_v1alpha3_JournaldSpec(in *kops.JournaldSpec, out *JournaldSpec, s conversion.Scope) error {
+	out.SystemMaxUse = in.SystemMaxUse
+	out.RateLimitInterval = in.RateLimitInterval
+	out.RateLimitBurst = in.RateLimitBurst
+	return nil
+}
+
+// Convert_kops_JournaldSpec_To_v1alpha3_JournaldSpec is an autogenerated conversion function.
+func Convert_kops_JournaldSpec_To_v1alpha3_JournaldSpec(in *kops.JournaldSpec, out *JournaldSpec, s conversion.Scope) error {
+	return autoConvert_kops_JournaldSpec_To_v1alpha3_JournaldSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_KarpenterConfig_To_kops_KarpenterConfig(in *KarpenterConfig, out *kops.KarpenterConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.LogEncoding = in.LogEncoding
@@ -5373,6 +6615,30 @@ func Convert_kops_KindnetNetworkingSpec_To_v1alpha3_KindnetNetworkingSpec(in *ko
 	return autoConvert_kops_KindnetNetworkingSpec_To_v1alpha3_KindnetNetworkingSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_KonnectivityConfig_To_kops_KonnectivityConfig(in *KonnectivityConfig, out *kops.KonnectivityConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ServerImage = in.ServerImage
+	out.AgentImage = in.AgentImage
+	return nil
+}
+
+// Convert_v1alpha3_KonnectivityConfig_To_kops_KonnectivityConfig is an autogenerated conversion function.
+func Convert_v1alpha3_KonnectivityConfig_To_kops_KonnectivityConfig(in *KonnectivityConfig, out *kops.KonnectivityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha3_KonnectivityConfig_To_kops_KonnectivityConfig(in, out, s)
+}
+
+func autoConvert_kops_KonnectivityConfig_To_v1alpha3_KonnectivityConfig(in *kops.KonnectivityConfig, out *KonnectivityConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ServerImage = in.ServerImage
+	out.AgentImage = in.AgentImage
+	return nil
+}
+
+// Convert_kops_KonnectivityConfig_To_v1alpha3_KonnectivityConfig is an autogenerated conversion function.
+func Convert_kops_KonnectivityConfig_To_v1alpha3_KonnectivityConfig(in *kops.KonnectivityConfig, out *KonnectivityConfig, s conversion.Scope) error {
+	return autoConvert_kops_KonnectivityConfig_To_v1alpha3_KonnectivityConfig(in, out, s)
+}
+
 func autoConvert_v1alpha3_KopeioAuthenticationSpec_To_kops_KopeioAuthenticationSpec(in *KopeioAuthenticationSpec, out *kops.KopeioAuthenticationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -5961,6 +7227,18 @@ func autoConvert_v1alpha3_KubeSchedulerConfig_To_kops_KubeSchedulerConfig(in *Ku
 	out.CPULimit = in.CPULimit
 	out.MemoryRequest = in.MemoryRequest
 	out.MemoryLimit = in.MemoryLimit
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]kops.KubeSchedulerProfile, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Profiles = nil
+	}
 	return nil
 }
 
@@ -6001,6 +7279,18 @@ func autoConvert_kops_KubeSchedulerConfig_To_v1alpha3_KubeSchedulerConfig(in *ko
 	out.CPULimit = in.CPULimit
 	out.MemoryRequest = in.MemoryRequest
 	out.MemoryLimit = in.MemoryLimit
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]KubeSchedulerProfile, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerProfile_To_v1alpha3_KubeSchedulerProfile(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Profiles = nil
+	}
 	return nil
 }
 
@@ -6009,6 +7299,426 @@ func Convert_kops_KubeSchedulerConfig_To_v1alpha3_KubeSchedulerConfig(in *kops.K
 	return autoConvert_kops_KubeSchedulerConfig_To_v1alpha3_KubeSchedulerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(in *KubeSchedulerPlugin, out *kops.KubeSchedulerPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin is an autogenerated conversion function.
+func Convert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(in *KubeSchedulerPlugin, out *kops.KubeSchedulerPlugin, s conversion.Scope) error {
+	return autoConvert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin(in *kops.KubeSchedulerPlugin, out *KubeSchedulerPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin(in *kops.KubeSchedulerPlugin, out *KubeSchedulerPlugin, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin(in, out, s)
+}
+
+func autoConvert_v1alpha3_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(in *KubeSchedulerPluginConfig, out *kops.KubeSchedulerPluginConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Args = in.Args
+	return nil
+}
+
+// Convert_v1alpha3_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig is an autogenerated conversion function.
+func Convert_v1alpha3_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(in *KubeSchedulerPluginConfig, out *kops.KubeSchedulerPluginConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha3_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPluginConfig_To_v1alpha3_KubeSchedulerPluginConfig(in *kops.KubeSchedulerPluginConfig, out *KubeSchedulerPluginConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Args = in.Args
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPluginConfig_To_v1alpha3_KubeSchedulerPluginConfig is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPluginConfig_To_v1alpha3_KubeSchedulerPluginConfig(in *kops.KubeSchedulerPluginConfig, out *KubeSchedulerPluginConfig, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPluginConfig_To_v1alpha3_KubeSchedulerPluginConfig(in, out, s)
+}
+
+func autoConvert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(in *KubeSchedulerPluginSet, out *kops.KubeSchedulerPluginSet, s conversion.Scope) error {
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]kops.KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Enabled = nil
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]kops.KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_KubeSchedulerPlugin_To_kops_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Disabled = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet is an autogenerated conversion function.
+func Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(in *KubeSchedulerPluginSet, out *kops.KubeSchedulerPluginSet, s conversion.Scope) error {
+	return autoConvert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(in *kops.KubeSchedulerPluginSet, out *KubeSchedulerPluginSet, s conversion.Scope) error {
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Enabled = nil
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]KubeSchedulerPlugin, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerPlugin_To_v1alpha3_KubeSchedulerPlugin(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Disabled = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(in *kops.KubeSchedulerPluginSet, out *KubeSchedulerPluginSet, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(in, out, s)
+}
+
+func autoConvert_v1alpha3_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(in *KubeSchedulerPlugins, out *kops.KubeSchedulerPlugins, s conversion.Scope) error {
+	if in.PreEnqueue != nil {
+		in, out := &in.PreEnqueue, &out.PreEnqueue
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreEnqueue = nil
+	}
+	if in.QueueSort != nil {
+		in, out := &in.QueueSort, &out.QueueSort
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.QueueSort = nil
+	}
+	if in.PreFilter != nil {
+		in, out := &in.PreFilter, &out.PreFilter
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreFilter = nil
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	if in.PostFilter != nil {
+		in, out := &in.PostFilter, &out.PostFilter
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostFilter = nil
+	}
+	if in.PreScore != nil {
+		in, out := &in.PreScore, &out.PreScore
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreScore = nil
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Score = nil
+	}
+	if in.Reserve != nil {
+		in, out := &in.Reserve, &out.Reserve
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reserve = nil
+	}
+	if in.Permit != nil {
+		in, out := &in.Permit, &out.Permit
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Permit = nil
+	}
+	if in.PreBind != nil {
+		in, out := &in.PreBind, &out.PreBind
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreBind = nil
+	}
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Bind = nil
+	}
+	if in.PostBind != nil {
+		in, out := &in.PostBind, &out.PostBind
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostBind = nil
+	}
+	if in.MultiPoint != nil {
+		in, out := &in.MultiPoint, &out.MultiPoint
+		*out = new(kops.KubeSchedulerPluginSet)
+		if err := Convert_v1alpha3_KubeSchedulerPluginSet_To_kops_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MultiPoint = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins is an autogenerated conversion function.
+func Convert_v1alpha3_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(in *KubeSchedulerPlugins, out *kops.KubeSchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_v1alpha3_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerPlugins_To_v1alpha3_KubeSchedulerPlugins(in *kops.KubeSchedulerPlugins, out *KubeSchedulerPlugins, s conversion.Scope) error {
+	if in.PreEnqueue != nil {
+		in, out := &in.PreEnqueue, &out.PreEnqueue
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreEnqueue = nil
+	}
+	if in.QueueSort != nil {
+		in, out := &in.QueueSort, &out.QueueSort
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.QueueSort = nil
+	}
+	if in.PreFilter != nil {
+		in, out := &in.PreFilter, &out.PreFilter
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreFilter = nil
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	if in.PostFilter != nil {
+		in, out := &in.PostFilter, &out.PostFilter
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostFilter = nil
+	}
+	if in.PreScore != nil {
+		in, out := &in.PreScore, &out.PreScore
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreScore = nil
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Score = nil
+	}
+	if in.Reserve != nil {
+		in, out := &in.Reserve, &out.Reserve
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Reserve = nil
+	}
+	if in.Permit != nil {
+		in, out := &in.Permit, &out.Permit
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Permit = nil
+	}
+	if in.PreBind != nil {
+		in, out := &in.PreBind, &out.PreBind
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PreBind = nil
+	}
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Bind = nil
+	}
+	if in.PostBind != nil {
+		in, out := &in.PostBind, &out.PostBind
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostBind = nil
+	}
+	if in.MultiPoint != nil {
+		in, out := &in.MultiPoint, &out.MultiPoint
+		*out = new(KubeSchedulerPluginSet)
+		if err := Convert_kops_KubeSchedulerPluginSet_To_v1alpha3_KubeSchedulerPluginSet(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MultiPoint = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeSchedulerPlugins_To_v1alpha3_KubeSchedulerPlugins is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerPlugins_To_v1alpha3_KubeSchedulerPlugins(in *kops.KubeSchedulerPlugins, out *KubeSchedulerPlugins, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerPlugins_To_v1alpha3_KubeSchedulerPlugins(in, out, s)
+}
+
+func autoConvert_v1alpha3_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(in *KubeSchedulerProfile, out *kops.KubeSchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(kops.KubeSchedulerPlugins)
+		if err := Convert_v1alpha3_KubeSchedulerPlugins_To_kops_KubeSchedulerPlugins(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugins = nil
+	}
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = make([]kops.KubeSchedulerPluginConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_KubeSchedulerPluginConfig_To_kops_KubeSchedulerPluginConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PluginConfig = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_KubeSchedulerProfile_To_kops_KubeSchedulerProfile is an autogenerated conversion function.
+func Convert_v1alpha3_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(in *KubeSchedulerProfile, out *kops.KubeSchedulerProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha3_KubeSchedulerProfile_To_kops_KubeSchedulerProfile(in, out, s)
+}
+
+func autoConvert_kops_KubeSchedulerProfile_To_v1alpha3_KubeSchedulerProfile(in *kops.KubeSchedulerProfile, out *KubeSchedulerProfile, s conversion.Scope) error {
+	out.SchedulerName = in.SchedulerName
+	out.PercentageOfNodesToScore = in.PercentageOfNodesToScore
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(KubeSchedulerPlugins)
+		if err := Convert_kops_KubeSchedulerPlugins_To_v1alpha3_KubeSchedulerPlugins(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugins = nil
+	}
+	if in.PluginConfig != nil {
+		in, out := &in.PluginConfig, &out.PluginConfig
+		*out = make([]KubeSchedulerPluginConfig, len(*in))
+		for i := range *in {
+			if err := Convert_kops_KubeSchedulerPluginConfig_To_v1alpha3_KubeSchedulerPluginConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PluginConfig = nil
+	}
+	return nil
+}
+
+// Convert_kops_KubeSchedulerProfile_To_v1alpha3_KubeSchedulerProfile is an autogenerated conversion function.
+func Convert_kops_KubeSchedulerProfile_To_v1alpha3_KubeSchedulerProfile(in *kops.KubeSchedulerProfile, out *KubeSchedulerProfile, s conversion.Scope) error {
+	return autoConvert_kops_KubeSchedulerProfile_To_v1alpha3_KubeSchedulerProfile(in, out, s)
+}
+
 func autoConvert_v1alpha3_KubeletConfigSpec_To_kops_KubeletConfigSpec(in *KubeletConfigSpec, out *kops.KubeletConfigSpec, s conversion.Scope) error {
 	out.APIServers = in.APIServers
 	out.AnonymousAuth = in.AnonymousAuth
@@ -6105,6 +7815,7 @@ func autoConvert_v1alpha3_KubeletConfigSpec_To_kops_KubeletConfigSpec(in *Kubele
 	out.ShutdownGracePeriod = in.ShutdownGracePeriod
 	out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
 	out.MemorySwapBehavior = in.MemorySwapBehavior
+	out.ReservedResourcesMode = in.ReservedResourcesMode
 	return nil
 }
 
@@ -6209,6 +7920,7 @@ func autoConvert_kops_KubeletConfigSpec_To_v1alpha3_KubeletConfigSpec(in *kops.K
 	out.ShutdownGracePeriod = in.ShutdownGracePeriod
 	out.ShutdownGracePeriodCriticalPods = in.ShutdownGracePeriodCriticalPods
 	out.MemorySwapBehavior = in.MemorySwapBehavior
+	out.ReservedResourcesMode = in.ReservedResourcesMode
 	return nil
 }
 
@@ -6435,6 +8147,28 @@ func Convert_kops_LoadBalancerSubnetSpec_To_v1alpha3_LoadBalancerSubnetSpec(in *
 	return autoConvert_kops_LoadBalancerSubnetSpec_To_v1alpha3_LoadBalancerSubnetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_ManagedBastionSpec_To_kops_ManagedBastionSpec(in *ManagedBastionSpec, out *kops.ManagedBastionSpec, s conversion.Scope) error {
+	out.Type = kops.ManagedBastionType(in.Type)
+	out.SubnetCIDR = in.SubnetCIDR
+	return nil
+}
+
+// Convert_v1alpha3_ManagedBastionSpec_To_kops_ManagedBastionSpec is an autogenerated conversion function.
+func Convert_v1alpha3_ManagedBastionSpec_To_kops_ManagedBastionSpec(in *ManagedBastionSpec, out *kops.ManagedBastionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ManagedBastionSpec_To_kops_ManagedBastionSpec(in, out, s)
+}
+
+func autoConvert_kops_ManagedBastionSpec_To_v1alpha3_ManagedBastionSpec(in *kops.ManagedBastionSpec, out *ManagedBastionSpec, s conversion.Scope) error {
+	out.Type = ManagedBastionType(in.Type)
+	out.SubnetCIDR = in.SubnetCIDR
+	return nil
+}
+
+// Convert_kops_ManagedBastionSpec_To_v1alpha3_ManagedBastionSpec is an autogenerated conversion function.
+func Convert_kops_ManagedBastionSpec_To_v1alpha3_ManagedBastionSpec(in *kops.ManagedBastionSpec, out *ManagedBastionSpec, s conversion.Scope) error {
+	return autoConvert_kops_ManagedBastionSpec_To_v1alpha3_ManagedBastionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_MetricsServerConfig_To_kops_MetricsServerConfig(in *MetricsServerConfig, out *kops.MetricsServerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Image = in.Image
@@ -6529,6 +8263,50 @@ func Convert_kops_MixedInstancesPolicySpec_To_v1alpha3_MixedInstancesPolicySpec(
 	return autoConvert_kops_MixedInstancesPolicySpec_To_v1alpha3_MixedInstancesPolicySpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_MonitoringSpec_To_kops_MonitoringSpec(in *MonitoringSpec, out *kops.MonitoringSpec, s conversion.Scope) error {
+	out.Metrics = in.Metrics
+	out.ServiceMonitors = in.ServiceMonitors
+	out.Health = in.Health
+	out.HealthInterval = in.HealthInterval
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(kops.CertificateExpirySpec)
+		if err := Convert_v1alpha3_CertificateExpirySpec_To_kops_CertificateExpirySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CertificateExpiry = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_MonitoringSpec_To_kops_MonitoringSpec is an autogenerated conversion function.
+func Convert_v1alpha3_MonitoringSpec_To_kops_MonitoringSpec(in *MonitoringSpec, out *kops.MonitoringSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_MonitoringSpec_To_kops_MonitoringSpec(in, out, s)
+}
+
+func autoConvert_kops_MonitoringSpec_To_v1alpha3_MonitoringSpec(in *kops.MonitoringSpec, out *MonitoringSpec, s conversion.Scope) error {
+	out.Metrics = in.Metrics
+	out.ServiceMonitors = in.ServiceMonitors
+	out.Health = in.Health
+	out.HealthInterval = in.HealthInterval
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = new(CertificateExpirySpec)
+		if err := Convert_kops_CertificateExpirySpec_To_v1alpha3_CertificateExpirySpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CertificateExpiry = nil
+	}
+	return nil
+}
+
+// Convert_kops_MonitoringSpec_To_v1alpha3_MonitoringSpec is an autogenerated conversion function.
+func Convert_kops_MonitoringSpec_To_v1alpha3_MonitoringSpec(in *kops.MonitoringSpec, out *MonitoringSpec, s conversion.Scope) error {
+	return autoConvert_kops_MonitoringSpec_To_v1alpha3_MonitoringSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_NRIConfig_To_kops_NRIConfig(in *NRIConfig, out *kops.NRIConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.PluginRegistrationTimeout = in.PluginRegistrationTimeout
@@ -6555,6 +8333,16 @@ func Convert_kops_NRIConfig_To_v1alpha3_NRIConfig(in *kops.NRIConfig, out *NRICo
 
 func autoConvert_v1alpha3_NTPConfig_To_kops_NTPConfig(in *NTPConfig, out *kops.NTPConfig, s conversion.Scope) error {
 	out.Managed = in.Managed
+	out.Servers = in.Servers
+	if in.MakeStep != nil {
+		in, out := &in.MakeStep, &out.MakeStep
+		*out = new(kops.NTPMakeStepSpec)
+		if err := Convert_v1alpha3_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MakeStep = nil
+	}
 	return nil
 }
 
@@ -6565,6 +8353,16 @@ func Convert_v1alpha3_NTPConfig_To_kops_NTPConfig(in *NTPConfig, out *kops.NTPCo
 
 func autoConvert_kops_NTPConfig_To_v1alpha3_NTPConfig(in *kops.NTPConfig, out *NTPConfig, s conversion.Scope) error {
 	out.Managed = in.Managed
+	out.Servers = in.Servers
+	if in.MakeStep != nil {
+		in, out := &in.MakeStep, &out.MakeStep
+		*out = new(NTPMakeStepSpec)
+		if err := Convert_kops_NTPMakeStepSpec_To_v1alpha3_NTPMakeStepSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MakeStep = nil
+	}
 	return nil
 }
 
@@ -6573,6 +8371,162 @@ func Convert_kops_NTPConfig_To_v1alpha3_NTPConfig(in *kops.NTPConfig, out *NTPCo
 	return autoConvert_kops_NTPConfig_To_v1alpha3_NTPConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(in *NTPMakeStepSpec, out *kops.NTPMakeStepSpec, s conversion.Scope) error {
+	out.Threshold = in.Threshold
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_v1alpha3_NTPMakeStepSpec_To_kops_NTPMakeStepSpec is an autogenerated conversion function.
+func Convert_v1alpha3_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(in *NTPMakeStepSpec, out *kops.NTPMakeStepSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_NTPMakeStepSpec_To_kops_NTPMakeStepSpec(in, out, s)
+}
+
+func autoConvert_kops_NTPMakeStepSpec_To_v1alpha3_NTPMakeStepSpec(in *kops.NTPMakeStepSpec, out *NTPMakeStepSpec, s conversion.Scope) error {
+	out.Threshold = in.Threshold
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_kops_NTPMakeStepSpec_To_v1alpha3_NTPMakeStepSpec is an autogenerated conversion function.
+func Convert_kops_NTPMakeStepSpec_To_v1alpha3_NTPMakeStepSpec(in *kops.NTPMakeStepSpec, out *NTPMakeStepSpec, s conversion.Scope) error {
+	return autoConvert_kops_NTPMakeStepSpec_To_v1alpha3_NTPMakeStepSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(in *NamespaceDefaultsSpec, out *kops.NamespaceDefaultsSpec, s conversion.Scope) error {
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]kops.NamespaceLimitRangeSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LimitRanges = nil
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]kops.NamespaceResourceQuotaSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceQuotas = nil
+	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]kops.PriorityClassSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_PriorityClassSpec_To_kops_PriorityClassSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PriorityClasses = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec is an autogenerated conversion function.
+func Convert_v1alpha3_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(in *NamespaceDefaultsSpec, out *kops.NamespaceDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_NamespaceDefaultsSpec_To_kops_NamespaceDefaultsSpec(in, out, s)
+}
+
+func autoConvert_kops_NamespaceDefaultsSpec_To_v1alpha3_NamespaceDefaultsSpec(in *kops.NamespaceDefaultsSpec, out *NamespaceDefaultsSpec, s conversion.Scope) error {
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]NamespaceLimitRangeSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_NamespaceLimitRangeSpec_To_v1alpha3_NamespaceLimitRangeSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LimitRanges = nil
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]NamespaceResourceQuotaSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha3_NamespaceResourceQuotaSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ResourceQuotas = nil
+	}
+	if in.PriorityClasses != nil {
+		in, out := &in.PriorityClasses, &out.PriorityClasses
+		*out = make([]PriorityClassSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_PriorityClassSpec_To_v1alpha3_PriorityClassSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PriorityClasses = nil
+	}
+	return nil
+}
+
+// Convert_kops_NamespaceDefaultsSpec_To_v1alpha3_NamespaceDefaultsSpec is an autogenerated conversion function.
+func Convert_kops_NamespaceDefaultsSpec_To_v1alpha3_NamespaceDefaultsSpec(in *kops.NamespaceDefaultsSpec, out *NamespaceDefaultsSpec, s conversion.Scope) error {
+	return autoConvert_kops_NamespaceDefaultsSpec_To_v1alpha3_NamespaceDefaultsSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(in *NamespaceLimitRangeSpec, out *kops.NamespaceLimitRangeSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Limits = in.Limits
+	return nil
+}
+
+// Convert_v1alpha3_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec is an autogenerated conversion function.
+func Convert_v1alpha3_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(in *NamespaceLimitRangeSpec, out *kops.NamespaceLimitRangeSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_NamespaceLimitRangeSpec_To_kops_NamespaceLimitRangeSpec(in, out, s)
+}
+
+func autoConvert_kops_NamespaceLimitRangeSpec_To_v1alpha3_NamespaceLimitRangeSpec(in *kops.NamespaceLimitRangeSpec, out *NamespaceLimitRangeSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Limits = in.Limits
+	return nil
+}
+
+// Convert_kops_NamespaceLimitRangeSpec_To_v1alpha3_NamespaceLimitRangeSpec is an autogenerated conversion function.
+func Convert_kops_NamespaceLimitRangeSpec_To_v1alpha3_NamespaceLimitRangeSpec(in *kops.NamespaceLimitRangeSpec, out *NamespaceLimitRangeSpec, s conversion.Scope) error {
+	return autoConvert_kops_NamespaceLimitRangeSpec_To_v1alpha3_NamespaceLimitRangeSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(in *NamespaceResourceQuotaSpec, out *kops.NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Hard = in.Hard
+	out.Scopes = in.Scopes
+	return nil
+}
+
+// Convert_v1alpha3_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec is an autogenerated conversion function.
+func Convert_v1alpha3_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(in *NamespaceResourceQuotaSpec, out *kops.NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_NamespaceResourceQuotaSpec_To_kops_NamespaceResourceQuotaSpec(in, out, s)
+}
+
+func autoConvert_kops_NamespaceResourceQuotaSpec_To_v1alpha3_NamespaceResourceQuotaSpec(in *kops.NamespaceResourceQuotaSpec, out *NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaces = in.Namespaces
+	out.Hard = in.Hard
+	out.Scopes = in.Scopes
+	return nil
+}
+
+// Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha3_NamespaceResourceQuotaSpec is an autogenerated conversion function.
+func Convert_kops_NamespaceResourceQuotaSpec_To_v1alpha3_NamespaceResourceQuotaSpec(in *kops.NamespaceResourceQuotaSpec, out *NamespaceResourceQuotaSpec, s conversion.Scope) error {
+	return autoConvert_kops_NamespaceResourceQuotaSpec_To_v1alpha3_NamespaceResourceQuotaSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_NetworkingSpec_To_kops_NetworkingSpec(in *NetworkingSpec, out *kops.NetworkingSpec, s conversion.Scope) error {
 	out.NetworkID = in.NetworkID
 	out.NetworkCIDR = in.NetworkCIDR
@@ -7020,6 +8974,92 @@ func autoConvert_kops_NodeTerminationHandlerSpec_To_v1alpha3_NodeTerminationHand
 	return nil
 }
 
+func autoConvert_v1alpha3_NotificationSinkSpec_To_kops_NotificationSinkSpec(in *NotificationSinkSpec, out *kops.NotificationSinkSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = kops.NotificationSinkType(in.Type)
+	out.URL = in.URL
+	out.TopicARN = in.TopicARN
+	out.EventBusARN = in.EventBusARN
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]kops.NotificationEventType, len(*in))
+		for i := range *in {
+			(*out)[i] = kops.NotificationEventType((*in)[i])
+		}
+	} else {
+		out.Events = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_NotificationSinkSpec_To_kops_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_v1alpha3_NotificationSinkSpec_To_kops_NotificationSinkSpec(in *NotificationSinkSpec, out *kops.NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_NotificationSinkSpec_To_kops_NotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_kops_NotificationSinkSpec_To_v1alpha3_NotificationSinkSpec(in *kops.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = NotificationSinkType(in.Type)
+	out.URL = in.URL
+	out.TopicARN = in.TopicARN
+	out.EventBusARN = in.EventBusARN
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEventType, len(*in))
+		for i := range *in {
+			(*out)[i] = NotificationEventType((*in)[i])
+		}
+	} else {
+		out.Events = nil
+	}
+	return nil
+}
+
+// Convert_kops_NotificationSinkSpec_To_v1alpha3_NotificationSinkSpec is an autogenerated conversion function.
+func Convert_kops_NotificationSinkSpec_To_v1alpha3_NotificationSinkSpec(in *kops.NotificationSinkSpec, out *NotificationSinkSpec, s conversion.Scope) error {
+	return autoConvert_kops_NotificationSinkSpec_To_v1alpha3_NotificationSinkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_NotificationsSpec_To_kops_NotificationsSpec(in *NotificationsSpec, out *kops.NotificationsSpec, s conversion.Scope) error {
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]kops.NotificationSinkSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_NotificationSinkSpec_To_kops_NotificationSinkSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Sinks = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_NotificationsSpec_To_kops_NotificationsSpec is an autogenerated conversion function.
+func Convert_v1alpha3_NotificationsSpec_To_kops_NotificationsSpec(in *NotificationsSpec, out *kops.NotificationsSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_NotificationsSpec_To_kops_NotificationsSpec(in, out, s)
+}
+
+func autoConvert_kops_NotificationsSpec_To_v1alpha3_NotificationsSpec(in *kops.NotificationsSpec, out *NotificationsSpec, s conversion.Scope) error {
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]NotificationSinkSpec, len(*in))
+		for i := range *in {
+			if err := Convert_kops_NotificationSinkSpec_To_v1alpha3_NotificationSinkSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Sinks = nil
+	}
+	return nil
+}
+
+// Convert_kops_NotificationsSpec_To_v1alpha3_NotificationsSpec is an autogenerated conversion function.
+func Convert_kops_NotificationsSpec_To_v1alpha3_NotificationsSpec(in *kops.NotificationsSpec, out *NotificationsSpec, s conversion.Scope) error {
+	return autoConvert_kops_NotificationsSpec_To_v1alpha3_NotificationsSpec(in, out, s)
+}
+
 // Convert_kops_NodeTerminationHandlerSpec_To_v1alpha3_NodeTerminationHandlerSpec is an autogenerated conversion function.
 func Convert_kops_NodeTerminationHandlerSpec_To_v1alpha3_NodeTerminationHandlerSpec(in *kops.NodeTerminationHandlerSpec, out *NodeTerminationHandlerSpec, s conversion.Scope) error {
 	return autoConvert_kops_NodeTerminationHandlerSpec_To_v1alpha3_NodeTerminationHandlerSpec(in, out, s)
@@ -7401,6 +9441,24 @@ func Convert_kops_OpenstackSpec_To_v1alpha3_OpenstackSpec(in *kops.OpenstackSpec
 
 func autoConvert_v1alpha3_PDCSIDriver_To_kops_PDCSIDriver(in *PDCSIDriver, out *kops.PDCSIDriver, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(kops.CSIStorageClassSpec)
+		if err := Convert_v1alpha3_CSIStorageClassSpec_To_kops_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(kops.CSISidecarResources)
+		if err := Convert_v1alpha3_CSISidecarResources_To_kops_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -7411,6 +9469,24 @@ func Convert_v1alpha3_PDCSIDriver_To_kops_PDCSIDriver(in *PDCSIDriver, out *kops
 
 func autoConvert_kops_PDCSIDriver_To_v1alpha3_PDCSIDriver(in *kops.PDCSIDriver, out *PDCSIDriver, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	if in.DefaultStorageClass != nil {
+		in, out := &in.DefaultStorageClass, &out.DefaultStorageClass
+		*out = new(CSIStorageClassSpec)
+		if err := Convert_kops_CSIStorageClassSpec_To_v1alpha3_CSIStorageClassSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DefaultStorageClass = nil
+	}
+	if in.SidecarResources != nil {
+		in, out := &in.SidecarResources, &out.SidecarResources
+		*out = new(CSISidecarResources)
+		if err := Convert_kops_CSISidecarResources_To_v1alpha3_CSISidecarResources(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SidecarResources = nil
+	}
 	return nil
 }
 
@@ -7467,6 +9543,66 @@ func Convert_kops_PodIdentityWebhookSpec_To_v1alpha3_PodIdentityWebhookSpec(in *
 	return autoConvert_kops_PodIdentityWebhookSpec_To_v1alpha3_PodIdentityWebhookSpec(in, out, s)
 }
 
+func autoConvert_v1alpha3_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in *PodSecurityAdmissionSpec, out *kops.PodSecurityAdmissionSpec, s conversion.Scope) error {
+	out.Enforce = kops.PodSecurityLevel(in.Enforce)
+	out.Audit = kops.PodSecurityLevel(in.Audit)
+	out.Warn = kops.PodSecurityLevel(in.Warn)
+	out.Version = in.Version
+	out.ExemptNamespaces = in.ExemptNamespaces
+	out.ExemptUsernames = in.ExemptUsernames
+	out.ExemptRuntimeClasses = in.ExemptRuntimeClasses
+	return nil
+}
+
+// Convert_v1alpha3_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec is an autogenerated conversion function.
+func Convert_v1alpha3_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in *PodSecurityAdmissionSpec, out *kops.PodSecurityAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_PodSecurityAdmissionSpec_To_kops_PodSecurityAdmissionSpec(in, out, s)
+}
+
+func autoConvert_kops_PodSecurityAdmissionSpec_To_v1alpha3_PodSecurityAdmissionSpec(in *kops.PodSecurityAdmissionSpec, out *PodSecurityAdmissionSpec, s conversion.Scope) error {
+	out.Enforce = PodSecurityLevel(in.Enforce)
+	out.Audit = PodSecurityLevel(in.Audit)
+	out.Warn = PodSecurityLevel(in.Warn)
+	out.Version = in.Version
+	out.ExemptNamespaces = in.ExemptNamespaces
+	out.ExemptUsernames = in.ExemptUsernames
+	out.ExemptRuntimeClasses = in.ExemptRuntimeClasses
+	return nil
+}
+
+// Convert_kops_PodSecurityAdmissionSpec_To_v1alpha3_PodSecurityAdmissionSpec is an autogenerated conversion function.
+func Convert_kops_PodSecurityAdmissionSpec_To_v1alpha3_PodSecurityAdmissionSpec(in *kops.PodSecurityAdmissionSpec, out *PodSecurityAdmissionSpec, s conversion.Scope) error {
+	return autoConvert_kops_PodSecurityAdmissionSpec_To_v1alpha3_PodSecurityAdmissionSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_PriorityClassSpec_To_kops_PriorityClassSpec(in *PriorityClassSpec, out *kops.PriorityClassSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.PreemptionPolicy = in.PreemptionPolicy
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha3_PriorityClassSpec_To_kops_PriorityClassSpec is an autogenerated conversion function.
+func Convert_v1alpha3_PriorityClassSpec_To_kops_PriorityClassSpec(in *PriorityClassSpec, out *kops.PriorityClassSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_PriorityClassSpec_To_kops_PriorityClassSpec(in, out, s)
+}
+
+func autoConvert_kops_PriorityClassSpec_To_v1alpha3_PriorityClassSpec(in *kops.PriorityClassSpec, out *PriorityClassSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.PreemptionPolicy = in.PreemptionPolicy
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_kops_PriorityClassSpec_To_v1alpha3_PriorityClassSpec is an autogenerated conversion function.
+func Convert_kops_PriorityClassSpec_To_v1alpha3_PriorityClassSpec(in *kops.PriorityClassSpec, out *PriorityClassSpec, s conversion.Scope) error {
+	return autoConvert_kops_PriorityClassSpec_To_v1alpha3_PriorityClassSpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_RBACAuthorizationSpec_To_kops_RBACAuthorizationSpec(in *RBACAuthorizationSpec, out *kops.RBACAuthorizationSpec, s conversion.Scope) error {
 	return nil
 }
@@ -7569,6 +9705,50 @@ func Convert_kops_Runc_To_v1alpha3_Runc(in *kops.Runc, out *Runc, s conversion.S
 	return autoConvert_kops_Runc_To_v1alpha3_Runc(in, out, s)
 }
 
+func autoConvert_v1alpha3_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(in *SSHAuthorizedKeysSpec, out *kops.SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	out.User = in.User
+	out.PublicKeys = in.PublicKeys
+	return nil
+}
+
+// Convert_v1alpha3_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec is an autogenerated conversion function.
+func Convert_v1alpha3_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(in *SSHAuthorizedKeysSpec, out *kops.SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SSHAuthorizedKeysSpec_To_kops_SSHAuthorizedKeysSpec(in, out, s)
+}
+
+func autoConvert_kops_SSHAuthorizedKeysSpec_To_v1alpha3_SSHAuthorizedKeysSpec(in *kops.SSHAuthorizedKeysSpec, out *SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	out.User = in.User
+	out.PublicKeys = in.PublicKeys
+	return nil
+}
+
+// Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha3_SSHAuthorizedKeysSpec is an autogenerated conversion function.
+func Convert_kops_SSHAuthorizedKeysSpec_To_v1alpha3_SSHAuthorizedKeysSpec(in *kops.SSHAuthorizedKeysSpec, out *SSHAuthorizedKeysSpec, s conversion.Scope) error {
+	return autoConvert_kops_SSHAuthorizedKeysSpec_To_v1alpha3_SSHAuthorizedKeysSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(in *SSHCertificateAuthoritySpec, out *kops.SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	out.Principals = in.Principals
+	out.MaxTTL = in.MaxTTL
+	return nil
+}
+
+// Convert_v1alpha3_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec is an autogenerated conversion function.
+func Convert_v1alpha3_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(in *SSHCertificateAuthoritySpec, out *kops.SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_SSHCertificateAuthoritySpec_To_kops_SSHCertificateAuthoritySpec(in, out, s)
+}
+
+func autoConvert_kops_SSHCertificateAuthoritySpec_To_v1alpha3_SSHCertificateAuthoritySpec(in *kops.SSHCertificateAuthoritySpec, out *SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	out.Principals = in.Principals
+	out.MaxTTL = in.MaxTTL
+	return nil
+}
+
+// Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha3_SSHCertificateAuthoritySpec is an autogenerated conversion function.
+func Convert_kops_SSHCertificateAuthoritySpec_To_v1alpha3_SSHCertificateAuthoritySpec(in *kops.SSHCertificateAuthoritySpec, out *SSHCertificateAuthoritySpec, s conversion.Scope) error {
+	return autoConvert_kops_SSHCertificateAuthoritySpec_To_v1alpha3_SSHCertificateAuthoritySpec(in, out, s)
+}
+
 func autoConvert_v1alpha3_SSHCredential_To_kops_SSHCredential(in *SSHCredential, out *kops.SSHCredential, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_SSHCredentialSpec_To_kops_SSHCredentialSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -7800,6 +9980,9 @@ func Convert_kops_TargetSpec_To_v1alpha3_TargetSpec(in *kops.TargetSpec, out *Ta
 func autoConvert_v1alpha3_TerraformSpec_To_kops_TerraformSpec(in *TerraformSpec, out *kops.TerraformSpec, s conversion.Scope) error {
 	out.ProviderExtraConfig = in.ProviderExtraConfig
 	out.FilesProviderExtraConfig = in.FilesProviderExtraConfig
+	out.ProviderVersions = in.ProviderVersions
+	out.InstanceGroupModules = in.InstanceGroupModules
+	out.ImportExistingResources = in.ImportExistingResources
 	return nil
 }
 
@@ -7811,6 +9994,9 @@ func Convert_v1alpha3_TerraformSpec_To_kops_TerraformSpec(in *TerraformSpec, out
 func autoConvert_kops_TerraformSpec_To_v1alpha3_TerraformSpec(in *kops.TerraformSpec, out *TerraformSpec, s conversion.Scope) error {
 	out.ProviderExtraConfig = in.ProviderExtraConfig
 	out.FilesProviderExtraConfig = in.FilesProviderExtraConfig
+	out.ProviderVersions = in.ProviderVersions
+	out.InstanceGroupModules = in.InstanceGroupModules
+	out.ImportExistingResources = in.ImportExistingResources
 	return nil
 }
 
@@ -7829,6 +10015,15 @@ func autoConvert_v1alpha3_TopologySpec_To_kops_TopologySpec(in *TopologySpec, ou
 	} else {
 		out.Bastion = nil
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(kops.VPNSpec)
+		if err := Convert_v1alpha3_VPNSpec_To_kops_VPNSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VPN = nil
+	}
 	out.DNS = kops.DNSType(in.DNS)
 	return nil
 }
@@ -7848,6 +10043,15 @@ func autoConvert_kops_TopologySpec_To_v1alpha3_TopologySpec(in *kops.TopologySpe
 	} else {
 		out.Bastion = nil
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(VPNSpec)
+		if err := Convert_kops_VPNSpec_To_v1alpha3_VPNSpec(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VPN = nil
+	}
 	out.DNS = DNSType(in.DNS)
 	return nil
 }
@@ -7881,6 +10085,56 @@ func Convert_kops_UserData_To_v1alpha3_UserData(in *kops.UserData, out *UserData
 	return autoConvert_kops_UserData_To_v1alpha3_UserData(in, out, s)
 }
 
+func autoConvert_v1alpha3_VPNSpec_To_kops_VPNSpec(in *VPNSpec, out *kops.VPNSpec, s conversion.Scope) error {
+	out.Type = kops.VPNType(in.Type)
+	out.ClientCIDR = in.ClientCIDR
+	out.ServerCertificate = in.ServerCertificate
+	out.ClientCertificateAuthority = in.ClientCertificateAuthority
+	out.Subnets = in.Subnets
+	return nil
+}
+
+// Convert_v1alpha3_VPNSpec_To_kops_VPNSpec is an autogenerated conversion function.
+func Convert_v1alpha3_VPNSpec_To_kops_VPNSpec(in *VPNSpec, out *kops.VPNSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha3_VPNSpec_To_kops_VPNSpec(in, out, s)
+}
+
+func autoConvert_kops_VPNSpec_To_v1alpha3_VPNSpec(in *kops.VPNSpec, out *VPNSpec, s conversion.Scope) error {
+	out.Type = VPNType(in.Type)
+	out.ClientCIDR = in.ClientCIDR
+	out.ServerCertificate = in.ServerCertificate
+	out.ClientCertificateAuthority = in.ClientCertificateAuthority
+	out.Subnets = in.Subnets
+	return nil
+}
+
+// Convert_kops_VPNSpec_To_v1alpha3_VPNSpec is an autogenerated conversion function.
+func Convert_kops_VPNSpec_To_v1alpha3_VPNSpec(in *kops.VPNSpec, out *VPNSpec, s conversion.Scope) error {
+	return autoConvert_kops_VPNSpec_To_v1alpha3_VPNSpec(in, out, s)
+}
+
+func autoConvert_v1alpha3_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in *VerticalPodAutoscalerConfig, out *kops.VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RecommenderOnly = in.RecommenderOnly
+	return nil
+}
+
+// Convert_v1alpha3_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_v1alpha3_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in *VerticalPodAutoscalerConfig, out *kops.VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha3_VerticalPodAutoscalerConfig_To_kops_VerticalPodAutoscalerConfig(in, out, s)
+}
+
+func autoConvert_kops_VerticalPodAutoscalerConfig_To_v1alpha3_VerticalPodAutoscalerConfig(in *kops.VerticalPodAutoscalerConfig, out *VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.RecommenderOnly = in.RecommenderOnly
+	return nil
+}
+
+// Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha3_VerticalPodAutoscalerConfig is an autogenerated conversion function.
+func Convert_kops_VerticalPodAutoscalerConfig_To_v1alpha3_VerticalPodAutoscalerConfig(in *kops.VerticalPodAutoscalerConfig, out *VerticalPodAutoscalerConfig, s conversion.Scope) error {
+	return autoConvert_kops_VerticalPodAutoscalerConfig_To_v1alpha3_VerticalPodAutoscalerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha3_VolumeMountSpec_To_kops_VolumeMountSpec(in *VolumeMountSpec, out *kops.VolumeMountSpec, s conversion.Scope) error {
 	out.Device = in.Device
 	out.Filesystem = in.Filesystem
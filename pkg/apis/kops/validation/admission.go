@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kubemanifest"
+)
+
+var podSecurityVersionRegexp = regexp.MustCompile(`^v1\.(0|[1-9][0-9]*)$`)
+
+func validateAdmission(c *kops.Cluster, spec *kops.AdmissionSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.PodSecurity != nil {
+		allErrs = append(allErrs, validatePodSecurityAdmission(c, spec.PodSecurity, fldPath.Child("podSecurity"))...)
+	}
+	if spec.ValidatingAdmissionPolicies != "" {
+		allErrs = append(allErrs, validateValidatingAdmissionPolicies(c, spec.ValidatingAdmissionPolicies, fldPath.Child("validatingAdmissionPolicies"))...)
+	}
+	return allErrs
+}
+
+func validatePodSecurityAdmission(c *kops.Cluster, spec *kops.PodSecurityAdmissionSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	// kOps writes the admission configuration file of kube-apiserver
+	if c.Spec.KubeAPIServer != nil && c.Spec.KubeAPIServer.AdmissionControlConfigFile != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "podSecurity cannot be used together with kubeAPIServer.admissionControlConfigFile"))
+	}
+
+	if spec.Enforce != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("enforce"), &spec.Enforce, kops.SupportedPodSecurityLevels)...)
+	}
+	if spec.Audit != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("audit"), &spec.Audit, kops.SupportedPodSecurityLevels)...)
+	}
+	if spec.Warn != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("warn"), &spec.Warn, kops.SupportedPodSecurityLevels)...)
+	}
+
+	if spec.Version != "" && spec.Version != "latest" {
+		if !podSecurityVersionRegexp.MatchString(spec.Version) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("version"), spec.Version, "must be latest or a Kubernetes minor version such as v1.30"))
+		} else if version := clusterKubernetesVersion(c); version != nil && semver.MustParse(spec.Version[1:]+".0").GT(*version) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("version"), spec.Version, fmt.Sprintf("must not be newer than Kubernetes %s", c.Spec.KubernetesVersion)))
+		}
+	}
+
+	for i, namespace := range spec.ExemptNamespaces {
+		for _, msg := range utilvalidation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("exemptNamespaces").Index(i), namespace, msg))
+		}
+	}
+	for i, username := range spec.ExemptUsernames {
+		if username == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("exemptUsernames").Index(i), ""))
+		}
+	}
+	for i, runtimeClass := range spec.ExemptRuntimeClasses {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(runtimeClass) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("exemptRuntimeClasses").Index(i), runtimeClass, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateValidatingAdmissionPolicies(c *kops.Cluster, manifest string, fldPath *field.Path) (allErrs field.ErrorList) {
+	objects, err := kubemanifest.LoadObjectsFrom([]byte(manifest))
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, manifest, fmt.Sprintf("must be a YAML manifest: %v", err)))
+	}
+	if len(objects) == 0 {
+		return append(allErrs, field.Required(fldPath, "the manifest has no objects"))
+	}
+
+	version := clusterKubernetesVersion(c)
+	for i, object := range objects {
+		path := fldPath.Index(i)
+		gv, err := schema.ParseGroupVersion(object.APIVersion())
+		if err != nil || gv.Group != "admissionregistration.k8s.io" || (object.Kind() != "ValidatingAdmissionPolicy" && object.Kind() != "ValidatingAdmissionPolicyBinding") {
+			allErrs = append(allErrs, field.Forbidden(path, fmt.Sprintf("only admissionregistration.k8s.io ValidatingAdmissionPolicy and ValidatingAdmissionPolicyBinding objects are allowed, not %s %s", object.APIVersion(), object.Kind())))
+			continue
+		}
+		if object.GetName() == "" {
+			allErrs = append(allErrs, field.Required(path.Child("metadata", "name"), ""))
+		}
+
+		switch gv.Version {
+		case "v1":
+			if version != nil && !isVersionGTE(version, "1.30") {
+				allErrs = append(allErrs, field.Forbidden(path.Child("apiVersion"), fmt.Sprintf("%s is served since Kubernetes 1.30", gv)))
+			}
+		case "v1beta1":
+			if version != nil && !isVersionGTE(version, "1.28") {
+				allErrs = append(allErrs, field.Forbidden(path.Child("apiVersion"), fmt.Sprintf("%s is served since Kubernetes 1.28", gv)))
+			} else if !runtimeConfigEnables(&c.Spec, gv.String()) {
+				allErrs = append(allErrs, field.Forbidden(path.Child("apiVersion"), fmt.Sprintf("%s must be enabled in apiRuntimeConfig", gv)))
+			} else if !isVersionGTE(version, "1.30") && !featureGateEnabled(&c.Spec, "ValidatingAdmissionPolicy") {
+				allErrs = append(allErrs, field.Forbidden(path.Child("apiVersion"), "the ValidatingAdmissionPolicy feature gate must be enabled before Kubernetes 1.30"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(path.Child("apiVersion"), object.APIVersion(), []string{"admissionregistration.k8s.io/v1", "admissionregistration.k8s.io/v1beta1"}))
+		}
+	}
+	return allErrs
+}
+
+// runtimeConfigEnables returns true if the runtime config of kube-apiserver enables the group version,
+// looking at the group version before the api/beta and api/all switches
+func runtimeConfigEnables(spec *kops.ClusterSpec, groupVersion string) bool {
+	for _, key := range []string{groupVersion, "api/beta", "api/all"} {
+		if spec.KubeAPIServer != nil {
+			if value, found := spec.KubeAPIServer.RuntimeConfig[key]; found {
+				return value == "true"
+			}
+		}
+		if value, found := spec.APIRuntimeConfig[key]; found {
+			return value
+		}
+	}
+	return false
+}
+
+// featureGateEnabled returns true if the feature gate is enabled on kube-apiserver
+func featureGateEnabled(spec *kops.ClusterSpec, name string) bool {
+	if spec.KubeAPIServer != nil {
+		if value, found := spec.KubeAPIServer.FeatureGates[name]; found {
+			return value == "true"
+		}
+	}
+	return spec.FeatureGates[name]
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func Test_Validate_PodSecurityAdmission(t *testing.T) {
+	grid := []struct {
+		KubeAPIServer  *kops.KubeAPIServerConfig
+		Input          kops.PodSecurityAdmissionSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.PodSecurityAdmissionSpec{
+				Enforce:          kops.PodSecurityLevelBaseline,
+				Audit:            kops.PodSecurityLevelRestricted,
+				Warn:             kops.PodSecurityLevelRestricted,
+				Version:          "v1.30",
+				ExemptNamespaces: []string{"kube-system"},
+			},
+		},
+		{
+			Input: kops.PodSecurityAdmissionSpec{
+				Enforce: "strict",
+				Version: "1.30",
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::admission.podSecurity.enforce",
+				"Invalid value::admission.podSecurity.version",
+			},
+		},
+		{
+			Input: kops.PodSecurityAdmissionSpec{
+				Version:          "v1.31",
+				ExemptNamespaces: []string{"Kube_System"},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::admission.podSecurity.version",
+				"Invalid value::admission.podSecurity.exemptNamespaces[0]",
+			},
+		},
+		{
+			KubeAPIServer: &kops.KubeAPIServerConfig{AdmissionControlConfigFile: "/etc/kubernetes/admission.yaml"},
+			Input: kops.PodSecurityAdmissionSpec{
+				Enforce: kops.PodSecurityLevelRestricted,
+			},
+			ExpectedErrors: []string{"Forbidden::admission.podSecurity"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				KubernetesVersion: "1.30.0",
+				KubeAPIServer:     g.KubeAPIServer,
+			},
+		}
+		errs := validatePodSecurityAdmission(cluster, &g.Input, field.NewPath("admission", "podSecurity"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_ValidatingAdmissionPolicies(t *testing.T) {
+	policyV1 := `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: replica-limit
+spec:
+  matchConstraints:
+    resourceRules:
+    - apiGroups: ["apps"]
+      apiVersions: ["v1"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["deployments"]
+  validations:
+  - expression: "object.spec.replicas <= 50"
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: replica-limit
+spec:
+  policyName: replica-limit
+  validationActions: ["Deny"]
+`
+	policyV1beta1 := `
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: replica-limit
+`
+
+	grid := []struct {
+		KubernetesVersion string
+		APIRuntimeConfig  map[string]bool
+		FeatureGates      map[string]bool
+		Input             string
+		ExpectedErrors    []string
+	}{
+		{
+			KubernetesVersion: "1.30.0",
+			Input:             policyV1,
+		},
+		{
+			KubernetesVersion: "1.29.0",
+			Input:             policyV1,
+			ExpectedErrors: []string{
+				"Forbidden::admission.validatingAdmissionPolicies[0].apiVersion",
+				"Forbidden::admission.validatingAdmissionPolicies[1].apiVersion",
+			},
+		},
+		{
+			KubernetesVersion: "1.29.0",
+			APIRuntimeConfig:  map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+			FeatureGates:      map[string]bool{"ValidatingAdmissionPolicy": true},
+			Input:             policyV1beta1,
+		},
+		{
+			KubernetesVersion: "1.29.0",
+			APIRuntimeConfig:  map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+			Input:             policyV1beta1,
+			ExpectedErrors:    []string{"Forbidden::admission.validatingAdmissionPolicies[0].apiVersion"},
+		},
+		{
+			KubernetesVersion: "1.31.0",
+			Input:             policyV1beta1,
+			ExpectedErrors:    []string{"Forbidden::admission.validatingAdmissionPolicies[0].apiVersion"},
+		},
+		{
+			KubernetesVersion: "1.30.0",
+			Input: `
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: webhook
+---
+apiVersion: admissionregistration.k8s.io/v1alpha1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: replica-limit
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+`,
+			ExpectedErrors: []string{
+				"Forbidden::admission.validatingAdmissionPolicies[0]",
+				"Unsupported value::admission.validatingAdmissionPolicies[1].apiVersion",
+				"Required value::admission.validatingAdmissionPolicies[2].metadata.name",
+			},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				KubernetesVersion: g.KubernetesVersion,
+				APIRuntimeConfig:  g.APIRuntimeConfig,
+				FeatureGates:      g.FeatureGates,
+			},
+		}
+		errs := validateValidatingAdmissionPolicies(cluster, g.Input, field.NewPath("admission", "validatingAdmissionPolicies"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awspartition"
 )
 
 func awsValidateCluster(c *kops.Cluster, strict bool) field.ErrorList {
@@ -61,16 +62,87 @@ func awsValidateCluster(c *kops.Cluster, strict bool) field.ErrorList {
 		allErrs = append(allErrs, awsValidateIAMAuthenticator(field.NewPath("spec", "authentication", "aws"), c.Spec.Authentication.AWS)...)
 	}
 
+	allErrs = append(allErrs, awsValidateClusterPartition(c)...)
+
+	return allErrs
+}
+
+// awsValidateClusterPartition checks that the ARNs of the cluster spec are in the partition of its region,
+// as resources can't be shared between partitions
+func awsValidateClusterPartition(c *kops.Cluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	region, err := awsup.FindRegion(c)
+	if err != nil || region == "" {
+		return allErrs
+	}
+	partition := awspartition.ForRegion(region)
+
+	if c.Spec.API.LoadBalancer != nil {
+		allErrs = append(allErrs, awsValidateARNPartition(field.NewPath("spec", "api", "loadBalancer", "sslCertificate"), c.Spec.API.LoadBalancer.SSLCertificate, partition)...)
+	}
+	for role, policies := range c.Spec.ExternalPolicies {
+		for _, policy := range policies {
+			allErrs = append(allErrs, awsValidateARNPartition(field.NewPath("spec", "externalPolicies").Child(role), policy, partition)...)
+		}
+	}
+	if c.Spec.Authentication != nil && c.Spec.Authentication.AWS != nil {
+		for i, mapping := range c.Spec.Authentication.AWS.IdentityMappings {
+			allErrs = append(allErrs, awsValidateARNPartition(field.NewPath("spec", "authentication", "aws", "identityMappings").Index(i).Child("arn"), mapping.ARN, partition)...)
+		}
+	}
+
+	return allErrs
+}
+
+// awsValidateARNPartition checks that an ARN isn't in another known partition; values which aren't valid ARNs are left
+// to the other validations, and unknown partitions, such as those of test clouds, are accepted
+func awsValidateARNPartition(fieldPath *field.Path, value string, partition *awspartition.Partition) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	parsedARN, err := arn.Parse(value)
+	if err != nil {
+		return allErrs
+	}
+	if parsedARN.Partition != partition.ID && awspartition.ForID(parsedARN.Partition) != nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath, value, fmt.Sprintf("ARN must be in the %q partition of the cluster's region", partition.ID)))
+	}
 	return allErrs
 }
 
 func awsValidateEBSCSIDriver(cluster *kops.Cluster) (allErrs field.ErrorList) {
 	c := cluster.Spec
 
-	fldPath := field.NewPath("spec", "cloudProvider", "aws", "ebsCSIDriver", "enabled")
-	if c.CloudProvider.AWS.EBSCSIDriver != nil && c.CloudProvider.AWS.EBSCSIDriver.Enabled != nil && !*c.CloudProvider.AWS.EBSCSIDriver.Enabled {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "must not be disabled"))
+	driver := c.CloudProvider.AWS.EBSCSIDriver
+	if driver == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "cloudProvider", "aws", "ebsCSIDriver")
+	if driver.Enabled != nil && !*driver.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "must not be disabled"))
+	}
+
+	if driver.ReservedVolumeAttachments != nil {
+		if driver.VolumeAttachLimit != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("reservedVolumeAttachments"), "reservedVolumeAttachments cannot be used together with volumeAttachLimit"))
+		} else if *driver.ReservedVolumeAttachments < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("reservedVolumeAttachments"), *driver.ReservedVolumeAttachments, "must not be negative"))
+		}
 	}
+
+	if driver.DefaultStorageClass != nil {
+		zones := sets.NewString()
+		for _, subnet := range c.Networking.Subnets {
+			zones.Insert(subnet.Zone)
+		}
+		for i, zone := range driver.DefaultStorageClass.Zones {
+			if !zones.Has(zone) {
+				allErrs = append(allErrs, field.NotFound(fldPath.Child("defaultStorageClass", "zones").Index(i), zone))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -101,6 +173,10 @@ func awsValidateInstanceGroup(ig *kops.InstanceGroup, cloud awsup.AWSCloud) fiel
 		allErrs = append(allErrs, awsValidateMaximumInstanceLifetime(field.NewPath(ig.GetName(), "spec"), ig.Spec.MaxInstanceLifetime)...)
 	}
 
+	if cloud != nil && ig.Spec.IAM != nil && ig.Spec.IAM.Profile != nil {
+		allErrs = append(allErrs, awsValidateARNPartition(field.NewPath("spec", "iam", "profile"), *ig.Spec.IAM.Profile, awspartition.ForRegion(cloud.Region()))...)
+	}
+
 	return allErrs
 }
 
@@ -73,6 +73,51 @@ func TestAWSValidateEBSCSIDriver(t *testing.T) {
 				},
 			},
 		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{
+					AWS: &kops.AWSSpec{
+						EBSCSIDriver: &kops.EBSCSIDriverSpec{
+							VolumeAttachLimit:         fi.PtrTo(25),
+							ReservedVolumeAttachments: fi.PtrTo(2),
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Forbidden::spec.cloudProvider.aws.ebsCSIDriver.reservedVolumeAttachments"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{
+					AWS: &kops.AWSSpec{
+						EBSCSIDriver: &kops.EBSCSIDriverSpec{
+							ReservedVolumeAttachments: fi.PtrTo(-1),
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::spec.cloudProvider.aws.ebsCSIDriver.reservedVolumeAttachments"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{
+					AWS: &kops.AWSSpec{
+						EBSCSIDriver: &kops.EBSCSIDriverSpec{
+							DefaultStorageClass: &kops.CSIStorageClassSpec{
+								Type:  "io2",
+								Zones: []string{"us-test-1a", "us-test-1b"},
+							},
+						},
+					},
+				},
+				Networking: kops.NetworkingSpec{
+					Subnets: []kops.ClusterSubnetSpec{
+						{Name: "us-test-1a", Zone: "us-test-1a"},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Not found::spec.cloudProvider.aws.ebsCSIDriver.defaultStorageClass.zones[1]"},
+		},
 	}
 	for _, g := range grid {
 		g.Input.KubernetesVersion = "1.21.0"
@@ -680,6 +725,79 @@ func TestAWSAuthentication(t *testing.T) {
 	}
 }
 
+func TestAWSClusterPartition(t *testing.T) {
+	tests := []struct {
+		zone             string
+		sslCertificate   string
+		externalPolicies map[string][]string
+		identityARN      string
+		expected         []string
+	}{
+		{ // standard partition
+			zone:             "us-east-1a",
+			sslCertificate:   "arn:aws:acm:us-east-1:123456789012:certificate/example",
+			externalPolicies: map[string][]string{"node": {"arn:aws:iam::123456789012:policy/KopsExamplePolicy"}},
+			identityARN:      "arn:aws:iam::123456789012:role/KopsExampleRole",
+		},
+		{ // China partition
+			zone:             "cn-north-1a",
+			sslCertificate:   "arn:aws-cn:acm:cn-north-1:123456789012:certificate/example",
+			externalPolicies: map[string][]string{"node": {"arn:aws-cn:iam::123456789012:policy/KopsExamplePolicy"}},
+			identityARN:      "arn:aws-cn:iam::123456789012:role/KopsExampleRole",
+		},
+		{ // standard ARNs in a GovCloud region
+			zone:             "us-gov-west-1a",
+			sslCertificate:   "arn:aws:acm:us-gov-west-1:123456789012:certificate/example",
+			externalPolicies: map[string][]string{"node": {"arn:aws:iam::123456789012:policy/KopsExamplePolicy"}},
+			identityARN:      "arn:aws:iam::123456789012:role/KopsExampleRole",
+			expected: []string{
+				"Invalid value::spec.api.loadBalancer.sslCertificate",
+				"Invalid value::spec.externalPolicies.node",
+				"Invalid value::spec.authentication.aws.identityMappings[0].arn",
+			},
+		},
+		{ // China ARNs in a standard region
+			zone:        "eu-west-1a",
+			identityARN: "arn:aws-cn:iam::123456789012:user/KopsExampleUser",
+			expected:    []string{"Invalid value::spec.authentication.aws.identityMappings[0].arn"},
+		},
+		{ // ARNs of the mock cloud
+			zone:           "us-test-1a",
+			sslCertificate: "arn:aws-test:acm:us-test-1:000000000000:certificate/example",
+		},
+	}
+
+	for _, test := range tests {
+		cluster := kops.Cluster{
+			Spec: kops.ClusterSpec{
+				API: kops.APISpec{
+					LoadBalancer: &kops.LoadBalancerAccessSpec{
+						SSLCertificate: test.sslCertificate,
+					},
+				},
+				Authentication: &kops.AuthenticationSpec{
+					AWS: &kops.AWSAuthenticationSpec{
+						IdentityMappings: []kops.AWSAuthenticationIdentityMappingSpec{
+							{ARN: test.identityARN, Username: "foo"},
+						},
+					},
+				},
+				CloudProvider: kops.CloudProviderSpec{
+					AWS: &kops.AWSSpec{},
+				},
+				ExternalPolicies: test.externalPolicies,
+				Networking: kops.NetworkingSpec{
+					Subnets: []kops.ClusterSubnetSpec{
+						{Name: "a", Zone: test.zone},
+					},
+				},
+			},
+		}
+		errs := awsValidateClusterPartition(&cluster)
+		testErrors(t, test, errs, test.expected)
+	}
+}
+
 func TestAWSAdditionalRoutes(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -0,0 +1,211 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/util"
+)
+
+// kubernetesFeatureGate records the graduation of a Kubernetes feature gate
+type kubernetesFeatureGate struct {
+	// LockedToDefault is the Kubernetes version from which the gate can only be set to its default
+	LockedToDefault string
+	// Default is the value of the gate once it is locked
+	Default bool
+	// Removed is the Kubernetes version from which the gate is no longer accepted
+	Removed string
+}
+
+// kubernetesFeatureGates are the feature gates kOps checks against the Kubernetes version of the cluster.
+// Components fail to start when given a removed gate, or a locked gate set to a value other than its default.
+var kubernetesFeatureGates = map[string]kubernetesFeatureGate{
+	"CSIMigration":                              {LockedToDefault: "1.25", Default: true, Removed: "1.27"},
+	"CSIMigrationAWS":                           {LockedToDefault: "1.25", Default: true, Removed: "1.27"},
+	"CronJobTimeZone":                           {LockedToDefault: "1.27", Default: true, Removed: "1.29"},
+	"DaemonSetUpdateSurge":                      {LockedToDefault: "1.25", Default: true, Removed: "1.27"},
+	"EphemeralContainers":                       {LockedToDefault: "1.25", Default: true, Removed: "1.27"},
+	"ExpandCSIVolumes":                          {LockedToDefault: "1.24", Default: true, Removed: "1.27"},
+	"ExpandPersistentVolumes":                   {LockedToDefault: "1.24", Default: true, Removed: "1.27"},
+	"IPv6DualStack":                             {LockedToDefault: "1.23", Default: true, Removed: "1.25"},
+	"JobTrackingWithFinalizers":                 {LockedToDefault: "1.26", Default: true, Removed: "1.28"},
+	"LegacyServiceAccountTokenNoAutoGeneration": {LockedToDefault: "1.26", Default: true, Removed: "1.29"},
+	"NetworkPolicyEndPort":                      {LockedToDefault: "1.25", Default: true, Removed: "1.27"},
+	"PodSecurity":                               {LockedToDefault: "1.25", Default: true, Removed: "1.28"},
+	"SidecarContainers":                         {LockedToDefault: "1.33", Default: true},
+	"TopologyManager":                           {LockedToDefault: "1.27", Default: true, Removed: "1.29"},
+}
+
+// removedAPIVersions are the API group versions no longer served by kube-apiserver, by the Kubernetes version removing them.
+// kube-apiserver fails to start when its runtime config refers to a group version it does not serve.
+var removedAPIVersions = map[string]string{
+	"autoscaling/v2beta1":                  "1.25",
+	"autoscaling/v2beta2":                  "1.26",
+	"batch/v1beta1":                        "1.25",
+	"batch/v2alpha1":                       "1.21",
+	"discovery.k8s.io/v1beta1":             "1.25",
+	"events.k8s.io/v1beta1":                "1.25",
+	"extensions/v1beta1":                   "1.22",
+	"flowcontrol.apiserver.k8s.io/v1beta1": "1.26",
+	"flowcontrol.apiserver.k8s.io/v1beta2": "1.29",
+	"flowcontrol.apiserver.k8s.io/v1beta3": "1.32",
+	"node.k8s.io/v1beta1":                  "1.25",
+	"policy/v1beta1":                       "1.25",
+}
+
+// runtimeConfigAPIKeys are the keys of the runtime config switching groups of APIs
+var runtimeConfigAPIKeys = map[string]bool{
+	"api/all":    true,
+	"api/ga":     true,
+	"api/beta":   true,
+	"api/alpha":  true,
+	"api/legacy": true,
+}
+
+var apiVersionRegexp = regexp.MustCompile(`^v[1-9][0-9]*((alpha|beta)[1-9][0-9]*)?$`)
+
+// clusterKubernetesVersion parses the Kubernetes version of the cluster, returning nil if it is not valid
+func clusterKubernetesVersion(c *kops.Cluster) *semver.Version {
+	version, err := util.ParseKubernetesVersion(c.Spec.KubernetesVersion)
+	if err != nil {
+		return nil
+	}
+	version.Pre = nil
+	version.Build = nil
+	return version
+}
+
+// isVersionGTE returns true if version is set and at least the Kubernetes version s
+func isVersionGTE(version *semver.Version, s string) bool {
+	if version == nil || s == "" {
+		return false
+	}
+	return version.GTE(semver.MustParse(s + ".0"))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateClusterFeatureGates validates the feature gates of the cluster.
+// The gates kOps does not know are returned as warnings by LintCluster.
+func validateClusterFeatureGates(c *kops.Cluster, featureGates map[string]bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	version := clusterKubernetesVersion(c)
+	for _, name := range sortedKeys(featureGates) {
+		allErrs = append(allErrs, validateFeatureGate(version, name, featureGates[name], fldPath.Key(name))...)
+	}
+	return allErrs
+}
+
+// validateFeatureGates validates the feature gates of a component
+func validateFeatureGates(c *kops.Cluster, featureGates map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	version := clusterKubernetesVersion(c)
+	for _, name := range sortedKeys(featureGates) {
+		value := featureGates[name]
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), value, "feature gates must be true or false"))
+			continue
+		}
+		allErrs = append(allErrs, validateFeatureGate(version, name, enabled, fldPath.Key(name))...)
+	}
+	return allErrs
+}
+
+func validateFeatureGate(version *semver.Version, name string, enabled bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	gate, found := kubernetesFeatureGates[name]
+	if !found {
+		return allErrs
+	}
+	if isVersionGTE(version, gate.Removed) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("feature gate %q was removed in Kubernetes %s", name, gate.Removed)))
+	} else if isVersionGTE(version, gate.LockedToDefault) && enabled != gate.Default {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("feature gate %q is locked to %v since Kubernetes %s", name, gate.Default, gate.LockedToDefault)))
+	}
+	return allErrs
+}
+
+// validateRuntimeConfig validates the keys of the kube-apiserver runtime config
+func validateRuntimeConfig(c *kops.Cluster, keys []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	version := clusterKubernetesVersion(c)
+	for _, key := range keys {
+		if runtimeConfigAPIKeys[key] || key == "v1" || key == "api/v1" {
+			continue
+		}
+		if strings.HasPrefix(key, "api/") {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(key), key, []string{"api/all", "api/ga", "api/beta", "api/alpha", "api/legacy"}))
+			continue
+		}
+		tokens := strings.Split(key, "/")
+		if len(tokens) < 2 || len(tokens) > 3 || tokens[0] == "" || tokens[len(tokens)-1] == "" || !apiVersionRegexp.MatchString(tokens[1]) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, "expected api/<all|ga|beta|alpha|legacy>, <group>/<version> or <group>/<version>/<resource>"))
+			continue
+		}
+		groupVersion := tokens[0] + "/" + tokens[1]
+		if removed, found := removedAPIVersions[groupVersion]; found && isVersionGTE(version, removed) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), fmt.Sprintf("%s is not served since Kubernetes %s", groupVersion, removed)))
+		}
+	}
+	return allErrs
+}
+
+// validateComponentFeatureGates validates the feature gates of the components, and the runtime config of kube-apiserver
+func validateComponentFeatureGates(c *kops.Cluster, spec *kops.ClusterSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.KubeAPIServer != nil {
+		allErrs = append(allErrs, validateFeatureGates(c, spec.KubeAPIServer.FeatureGates, fieldPath.Child("kubeAPIServer", "featureGates"))...)
+		allErrs = append(allErrs, validateRuntimeConfig(c, sortedKeys(spec.KubeAPIServer.RuntimeConfig), fieldPath.Child("kubeAPIServer", "runtimeConfig"))...)
+		for _, key := range sortedKeys(spec.KubeAPIServer.RuntimeConfig) {
+			if _, err := strconv.ParseBool(spec.KubeAPIServer.RuntimeConfig[key]); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("kubeAPIServer", "runtimeConfig").Key(key), spec.KubeAPIServer.RuntimeConfig[key], "runtime config values must be true or false"))
+			}
+		}
+	}
+	if spec.KubeControllerManager != nil {
+		allErrs = append(allErrs, validateFeatureGates(c, spec.KubeControllerManager.FeatureGates, fieldPath.Child("kubeControllerManager", "featureGates"))...)
+	}
+	if spec.KubeScheduler != nil {
+		allErrs = append(allErrs, validateFeatureGates(c, spec.KubeScheduler.FeatureGates, fieldPath.Child("kubeScheduler", "featureGates"))...)
+	}
+	if spec.KubeProxy != nil {
+		allErrs = append(allErrs, validateFeatureGates(c, spec.KubeProxy.FeatureGates, fieldPath.Child("kubeProxy", "featureGates"))...)
+	}
+	if spec.Kubelet != nil {
+		allErrs = append(allErrs, validateFeatureGates(c, spec.Kubelet.FeatureGates, fieldPath.Child("kubelet", "featureGates"))...)
+	}
+	if spec.ControlPlaneKubelet != nil {
+		allErrs = append(allErrs, validateFeatureGates(c, spec.ControlPlaneKubelet.FeatureGates, fieldPath.Child("controlPlaneKubelet", "featureGates"))...)
+	}
+	return allErrs
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func Test_Validate_ClusterFeatureGates(t *testing.T) {
+	grid := []struct {
+		KubernetesVersion string
+		Input             map[string]bool
+		ExpectedErrors    []string
+	}{
+		{
+			KubernetesVersion: "1.32.0",
+			Input: map[string]bool{
+				"InPlacePodVerticalScaling": true,
+				"SidecarContainers":         false,
+			},
+		},
+		{
+			KubernetesVersion: "1.33.0",
+			Input: map[string]bool{
+				"SidecarContainers": true,
+			},
+		},
+		{
+			KubernetesVersion: "1.33.0",
+			Input: map[string]bool{
+				"SidecarContainers": false,
+			},
+			ExpectedErrors: []string{"Forbidden::spec.featureGates[SidecarContainers]"},
+		},
+		{
+			KubernetesVersion: "1.26.0",
+			Input: map[string]bool{
+				"CSIMigrationAWS": true,
+			},
+		},
+		{
+			KubernetesVersion: "1.26.0",
+			Input: map[string]bool{
+				"CSIMigrationAWS": false,
+			},
+			ExpectedErrors: []string{"Forbidden::spec.featureGates[CSIMigrationAWS]"},
+		},
+		{
+			KubernetesVersion: "1.27.0",
+			Input: map[string]bool{
+				"CSIMigrationAWS": true,
+			},
+			ExpectedErrors: []string{"Forbidden::spec.featureGates[CSIMigrationAWS]"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: kops.ClusterSpec{KubernetesVersion: g.KubernetesVersion}}
+		errs := validateClusterFeatureGates(cluster, g.Input, field.NewPath("spec", "featureGates"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_ComponentFeatureGates(t *testing.T) {
+	grid := []struct {
+		Input          kops.ClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				KubeAPIServer: &kops.KubeAPIServerConfig{
+					FeatureGates: map[string]string{"InPlacePodVerticalScaling": "true"},
+				},
+				Kubelet: &kops.KubeletConfigSpec{
+					FeatureGates: map[string]string{"InPlacePodVerticalScaling": "true"},
+				},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				KubeScheduler: &kops.KubeSchedulerConfig{
+					FeatureGates: map[string]string{"InPlacePodVerticalScaling": "yes"},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::spec.kubeScheduler.featureGates[InPlacePodVerticalScaling]"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				KubernetesVersion: "1.32.0",
+				KubeControllerManager: &kops.KubeControllerManagerConfig{
+					FeatureGates: map[string]string{"PodSecurity": "true"},
+				},
+				ControlPlaneKubelet: &kops.KubeletConfigSpec{
+					FeatureGates: map[string]string{"TopologyManager": "true"},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::spec.kubeControllerManager.featureGates[PodSecurity]",
+				"Forbidden::spec.controlPlaneKubelet.featureGates[TopologyManager]",
+			},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: g.Input}
+		errs := validateComponentFeatureGates(cluster, &g.Input, field.NewPath("spec"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_RuntimeConfig(t *testing.T) {
+	grid := []struct {
+		KubernetesVersion string
+		Input             map[string]string
+		ExpectedErrors    []string
+	}{
+		{
+			KubernetesVersion: "1.32.0",
+			Input: map[string]string{
+				"api/beta":                        "true",
+				"v1":                              "true",
+				"resource.k8s.io/v1beta1":         "true",
+				"storage.k8s.io/v1beta1/csinodes": "false",
+			},
+		},
+		{
+			KubernetesVersion: "1.24.0",
+			Input: map[string]string{
+				"batch/v1beta1": "true",
+			},
+		},
+		{
+			KubernetesVersion: "1.25.0",
+			Input: map[string]string{
+				"batch/v1beta1": "true",
+			},
+			ExpectedErrors: []string{"Forbidden::spec.kubeAPIServer.runtimeConfig[batch/v1beta1]"},
+		},
+		{
+			KubernetesVersion: "1.32.0",
+			Input: map[string]string{
+				"api/everything": "true",
+				"batch":          "true",
+				"batch/latest":   "true",
+				"batch/v1":       "enabled",
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::spec.kubeAPIServer.runtimeConfig[api/everything]",
+				"Invalid value::spec.kubeAPIServer.runtimeConfig[batch]",
+				"Invalid value::spec.kubeAPIServer.runtimeConfig[batch/latest]",
+				"Invalid value::spec.kubeAPIServer.runtimeConfig[batch/v1]",
+			},
+		},
+	}
+	for _, g := range grid {
+		spec := kops.ClusterSpec{
+			KubernetesVersion: g.KubernetesVersion,
+			KubeAPIServer: &kops.KubeAPIServerConfig{
+				RuntimeConfig: g.Input,
+			},
+		}
+		cluster := &kops.Cluster{Spec: spec}
+		errs := validateComponentFeatureGates(cluster, &spec, field.NewPath("spec"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
@@ -18,17 +18,22 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"k8s.io/kops/pkg/nodeidentity/aws"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/util"
+	"k8s.io/kops/pkg/featureflag"
+	"k8s.io/kops/pkg/imagechannel"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
@@ -90,6 +95,14 @@ func ValidateInstanceGroup(g *kops.InstanceGroup, cloud fi.Cloud, strict bool) f
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "image"), "image must be specified."))
 	}
 
+	if g.Spec.ImageChannel != "" {
+		allErrs = append(allErrs, IsValidValue(field.NewPath("spec", "imageChannel"), &g.Spec.ImageChannel, imagechannel.Names())...)
+		// The full spec has the image the instance group is pinned to
+		if !strict && g.Spec.Image != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "imageChannel"), "imageChannel cannot be set together with image"))
+		}
+	}
+
 	if g.Spec.RootVolume != nil {
 		if fi.ValueOf(g.Spec.RootVolume.IOPS) < 0 {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rootVolume", "iops"), g.Spec.RootVolume.IOPS, "IOPS must be greater than 0"))
@@ -100,6 +113,20 @@ func ValidateInstanceGroup(g *kops.InstanceGroup, cloud fi.Cloud, strict bool) f
 		}
 	}
 
+	allErrs = append(allErrs, validateKubeletReservedResourcesMode(g.Spec.Kubelet, field.NewPath("spec", "kubelet"))...)
+
+	if g.Spec.Journald != nil {
+		allErrs = append(allErrs, validateJournald(g.Spec.Journald, field.NewPath("spec", "journald"))...)
+	}
+
+	if g.Spec.EtcdIOIsolation != nil {
+		fldPath := field.NewPath("spec", "etcdIOIsolation")
+		if !g.IsControlPlane() {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "etcdIOIsolation is only supported for control plane instance groups"))
+		}
+		allErrs = append(allErrs, validateEtcdIOIsolation(g.Spec.EtcdIOIsolation, fldPath)...)
+	}
+
 	// @check all the hooks are valid in this instancegroup
 	for i := range g.Spec.Hooks {
 		allErrs = append(allErrs, validateHookSpec(&g.Spec.Hooks[i], field.NewPath("spec", "hooks").Index(i))...)
@@ -110,6 +137,8 @@ func ValidateInstanceGroup(g *kops.InstanceGroup, cloud fi.Cloud, strict bool) f
 		allErrs = append(allErrs, validateFileAssetSpec(&g.Spec.FileAssets[i], field.NewPath("spec", "fileAssets").Index(i))...)
 	}
 
+	allErrs = append(allErrs, validateSSHAuthorizedKeys(g.Spec.SSHAuthorizedKeys, field.NewPath("spec", "sshAuthorizedKeys"))...)
+
 	for _, UserDataInfo := range g.Spec.AdditionalUserData {
 		allErrs = append(allErrs, validateExtraUserData(&UserDataInfo)...)
 	}
@@ -194,6 +223,15 @@ func ValidateInstanceGroup(g *kops.InstanceGroup, cloud fi.Cloud, strict bool) f
 		}
 	}
 
+	if g.Spec.ArchitectureTaint && g.Spec.Role != kops.InstanceGroupRoleNode {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "architectureTaint"), "architectureTaint is only supported for instance groups with role Node"))
+	}
+
+	allErrs = append(allErrs, IsValidValue(field.NewPath("spec", "operatingSystem"), &g.Spec.OperatingSystem, []kops.InstanceGroupOperatingSystem{"", kops.InstanceGroupOperatingSystemLinux, kops.InstanceGroupOperatingSystemWindows})...)
+	if g.IsWindows() {
+		allErrs = append(allErrs, validateWindowsInstanceGroup(g, field.NewPath("spec"))...)
+	}
+
 	return allErrs
 }
 
@@ -238,6 +276,10 @@ func CrossValidateInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster, cl
 		allErrs = append(allErrs, ValidateControlPlaneInstanceGroup(g, cluster)...)
 	}
 
+	if g.Spec.ImageChannel != "" && cluster.GetCloudProvider() != kops.CloudProviderAWS {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "imageChannel"), "imageChannel is only supported on AWS"))
+	}
+
 	if g.Spec.Role == kops.InstanceGroupRoleAPIServer {
 		if cluster.GetCloudProvider() != kops.CloudProviderAWS {
 			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "role"), "APIServer role only supported on AWS"))
@@ -247,6 +289,20 @@ func CrossValidateInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster, cl
 		}
 	}
 
+	if g.Spec.Role == kops.InstanceGroupRoleBastion {
+		if topology := cluster.Spec.Networking.Topology; topology != nil && topology.Bastion != nil && topology.Bastion.Managed != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "role"), "bastion instance groups cannot be used with a managed bastion"))
+		}
+	}
+
+	if fi.ValueOf(g.Spec.PreferSpot) {
+		allErrs = append(allErrs, validatePreferSpot(g, cluster, field.NewPath("spec", "preferSpot"))...)
+	}
+
+	if g.IsWindows() {
+		allErrs = append(allErrs, crossValidateWindowsInstanceGroup(cluster, field.NewPath("spec", "operatingSystem"))...)
+	}
+
 	// Check that instance groups are defined in subnets that are defined in the cluster
 	{
 		clusterSubnets := make(map[string]*kops.ClusterSubnetSpec)
@@ -295,6 +351,135 @@ func CrossValidateInstanceGroup(g *kops.InstanceGroup, cluster *kops.Cluster, cl
 		allErrs = append(allErrs, validateContainerdConfig(cluster, g.Spec.Containerd, field.NewPath("spec", "containerd"), false)...)
 	}
 
+	if g.Spec.ClusterAutoscaler != nil {
+		allErrs = append(allErrs, validateInstanceGroupClusterAutoscaler(cluster, g.Spec.ClusterAutoscaler, field.NewPath("spec", "clusterAutoscaler"))...)
+	}
+
+	return allErrs
+}
+
+func validatePreferSpot(g *kops.InstanceGroup, cluster *kops.Cluster, fldPath *field.Path) (allErrs field.ErrorList) {
+	switch cluster.GetCloudProvider() {
+	case kops.CloudProviderAWS:
+		if g.Spec.MaxPrice != nil || g.Spec.MixedInstancesPolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "preferSpot cannot be combined with maxPrice or mixedInstancesPolicy"))
+		}
+		if g.Spec.Manager == kops.InstanceManagerKarpenter {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "preferSpot is not supported for instance groups managed by Karpenter"))
+		}
+	case kops.CloudProviderGCE:
+		if g.Spec.GCPProvisioningModel != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "preferSpot cannot be combined with gcpProvisioningModel"))
+		}
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "preferSpot is only supported on AWS and GCE"))
+	}
+
+	if g.IsControlPlane() {
+		if !featureflag.UnsafeControlPlaneOnSpot.Enabled() {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "running the control plane on spot capacity requires the UnsafeControlPlaneOnSpot feature flag"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateWindowsInstanceGroup checks that a Windows instance group only uses the settings supported by the Windows bootstrap script,
+// which does not run nodeup.
+func validateWindowsInstanceGroup(g *kops.InstanceGroup, fldPath *field.Path) (allErrs field.ErrorList) {
+	if !featureflag.WindowsNodes.Enabled() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("operatingSystem"), "Windows instance groups require the WindowsNodes feature flag"))
+	}
+	if g.Spec.Role != kops.InstanceGroupRoleNode {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("operatingSystem"), "only instance groups with role Node can run Windows"))
+	}
+	if g.Spec.Manager == kops.InstanceManagerKarpenter {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("operatingSystem"), "Windows is not supported for instance groups managed by Karpenter"))
+	}
+	if len(g.Spec.AdditionalUserData) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalUserData"), "additionalUserData is not supported for Windows instance groups"))
+	}
+	if len(g.Spec.Packages) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("packages"), "packages are not supported for Windows instance groups"))
+	}
+	if len(g.Spec.SysctlParameters) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sysctlParameters"), "sysctlParameters are not supported for Windows instance groups"))
+	}
+	if len(g.Spec.FileAssets) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("fileAssets"), "fileAssets are not supported for Windows instance groups"))
+	}
+	if len(g.Spec.Hooks) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("hooks"), "hooks are not supported for Windows instance groups"))
+	}
+	return allErrs
+}
+
+// crossValidateWindowsInstanceGroup checks that the cluster supports Windows nodes:
+// they are joined with a bootstrap token by the Windows bootstrap script on AWS and GCE,
+// and networked by Calico in VXLAN mode with kube-proxy, as Windows does not support IP-in-IP encapsulation nor eBPF.
+func crossValidateWindowsInstanceGroup(cluster *kops.Cluster, fldPath *field.Path) (allErrs field.ErrorList) {
+	switch cluster.GetCloudProvider() {
+	case kops.CloudProviderAWS, kops.CloudProviderGCE:
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("Windows instance groups are not supported on %s", cluster.GetCloudProvider())))
+	}
+
+	calico := cluster.Spec.Networking.Calico
+	if calico == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Windows instance groups require Calico networking"))
+	} else {
+		if calico.EncapsulationMode != "vxlan" || calico.VXLANMode != "Always" {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "Windows instance groups require the vxlan encapsulationMode of Calico, with vxlanMode Always"))
+		}
+		if calico.BPFEnabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "Windows instance groups cannot be used with the eBPF dataplane of Calico"))
+		}
+	}
+
+	if cluster.Spec.KubeAPIServer == nil || !fi.ValueOf(cluster.Spec.KubeAPIServer.EnableBootstrapAuthToken) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Windows instance groups join the cluster with a bootstrap token, which requires spec.kubeAPIServer.enableBootstrapTokenAuth"))
+	}
+	if cluster.Spec.KubeProxy != nil && cluster.Spec.KubeProxy.Enabled != nil && !*cluster.Spec.KubeProxy.Enabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Windows instance groups require kube-proxy"))
+	}
+	if cluster.Spec.IsIPv6Only() {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Windows instance groups are not supported in IPv6 clusters"))
+	}
+
+	return allErrs
+}
+
+func validateInstanceGroupClusterAutoscaler(cluster *kops.Cluster, spec *kops.InstanceGroupClusterAutoscalerSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// The cluster autoscaler only reads per-node-group options from the tags of AWS autoscaling groups
+	if cluster.GetCloudProvider() != kops.CloudProviderAWS {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "instance group cluster autoscaler options are only supported on AWS"))
+		return allErrs
+	}
+
+	if spec.ScaleDownUtilizationThreshold != nil {
+		threshold, err := strconv.ParseFloat(*spec.ScaleDownUtilizationThreshold, 64)
+		if err != nil || threshold < 0 || threshold > 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUtilizationThreshold"), *spec.ScaleDownUtilizationThreshold, "must be a number between 0 and 1"))
+		} else if fi.ValueOf(spec.ScaleDownDisabled) && threshold != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scaleDownUtilizationThreshold"), "scaleDownUtilizationThreshold cannot be combined with scaleDownDisabled"))
+		}
+	}
+
+	for _, d := range []struct {
+		name     string
+		duration *metav1.Duration
+	}{
+		{"scaleDownUnneededTime", spec.ScaleDownUnneededTime},
+		{"scaleDownUnreadyTime", spec.ScaleDownUnreadyTime},
+		{"maxNodeProvisionTime", spec.MaxNodeProvisionTime},
+	} {
+		if d.duration != nil && d.duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(d.name), d.duration.Duration.String(), "must be greater than zero"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -437,3 +622,37 @@ func validateExternalLoadBalancer(lb *kops.LoadBalancerSpec, fldPath *field.Path
 
 	return allErrs
 }
+
+func validateEtcdIOIsolation(spec *kops.EtcdIOIsolationSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Scheduler != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("scheduler"), &spec.Scheduler, []string{"none", "mq-deadline", "bfq", "kyber"})...)
+	}
+	if spec.MaxReadBandwidth != nil && spec.MaxReadBandwidth.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReadBandwidth"), spec.MaxReadBandwidth.String(), "must be greater than 0"))
+	}
+	if spec.MaxWriteBandwidth != nil && spec.MaxWriteBandwidth.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxWriteBandwidth"), spec.MaxWriteBandwidth.String(), "must be greater than 0"))
+	}
+	if spec.MaxReadIOPS != nil && *spec.MaxReadIOPS <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReadIOPS"), *spec.MaxReadIOPS, "must be greater than 0"))
+	}
+	if spec.MaxWriteIOPS != nil && *spec.MaxWriteIOPS <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxWriteIOPS"), *spec.MaxWriteIOPS, "must be greater than 0"))
+	}
+	return allErrs
+}
+
+var journaldSizeRegex = regexp.MustCompile(`^[0-9]+[KMGTPE]?$`)
+
+func validateJournald(spec *kops.JournaldSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.SystemMaxUse != "" && !journaldSizeRegex.MatchString(spec.SystemMaxUse) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("systemMaxUse"), spec.SystemMaxUse, "must be a size in bytes, with an optional K, M, G, T, P or E suffix"))
+	}
+	if spec.RateLimitInterval != nil && spec.RateLimitInterval.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimitInterval"), spec.RateLimitInterval.Duration.String(), "must not be negative"))
+	}
+	if spec.RateLimitBurst != nil && *spec.RateLimitBurst < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimitBurst"), *spec.RateLimitBurst, "must not be negative"))
+	}
+	return allErrs
+}
@@ -18,12 +18,15 @@ package validation
 
 import (
 	"testing"
+	"time"
 
 	"k8s.io/kops/pkg/nodeidentity/aws"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/upup/pkg/fi"
 )
 
@@ -510,3 +513,384 @@ func createMinimalInstanceGroup() *kops.InstanceGroup {
 	}
 	return ig
 }
+
+func TestValidInstanceGroupClusterAutoscaler(t *testing.T) {
+	grid := []struct {
+		Input          kops.InstanceGroupClusterAutoscalerSpec
+		Cloud          kops.CloudProviderSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownDisabled:           fi.PtrTo(true),
+				MaxNodeProvisionTime:        &v1.Duration{Duration: 5 * time.Minute},
+				IgnoreDaemonSetsUtilization: fi.PtrTo(true),
+			},
+			Cloud: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		},
+		{
+			Input: kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUtilizationThreshold: fi.PtrTo("0.7"),
+				ScaleDownUnneededTime:         &v1.Duration{Duration: 30 * time.Minute},
+			},
+			Cloud: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		},
+		{
+			Input: kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUtilizationThreshold: fi.PtrTo("70%"),
+			},
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			ExpectedErrors: []string{"Invalid value::spec.clusterAutoscaler.scaleDownUtilizationThreshold"},
+		},
+		{
+			Input: kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownDisabled:             fi.PtrTo(true),
+				ScaleDownUtilizationThreshold: fi.PtrTo("0.5"),
+			},
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.clusterAutoscaler.scaleDownUtilizationThreshold"},
+		},
+		{
+			Input: kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownUnreadyTime: &v1.Duration{},
+			},
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			ExpectedErrors: []string{"Invalid value::spec.clusterAutoscaler.scaleDownUnreadyTime"},
+		},
+		{
+			Input: kops.InstanceGroupClusterAutoscalerSpec{
+				ScaleDownDisabled: fi.PtrTo(true),
+			},
+			Cloud:          kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.clusterAutoscaler"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudProvider: g.Cloud,
+			},
+		}
+		errs := validateInstanceGroupClusterAutoscaler(cluster, &g.Input, field.NewPath("spec", "clusterAutoscaler"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidJournald(t *testing.T) {
+	grid := []struct {
+		Input          kops.JournaldSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.JournaldSpec{
+				SystemMaxUse:      "1G",
+				RateLimitInterval: &v1.Duration{Duration: 30 * time.Second},
+				RateLimitBurst:    fi.PtrTo(int32(10000)),
+			},
+		},
+		{
+			Input: kops.JournaldSpec{
+				RateLimitInterval: &v1.Duration{},
+				RateLimitBurst:    fi.PtrTo(int32(0)),
+			},
+		},
+		{
+			Input: kops.JournaldSpec{
+				SystemMaxUse: "1Gi",
+			},
+			ExpectedErrors: []string{"Invalid value::spec.journald.systemMaxUse"},
+		},
+		{
+			Input: kops.JournaldSpec{
+				RateLimitInterval: &v1.Duration{Duration: -time.Second},
+				RateLimitBurst:    fi.PtrTo(int32(-1)),
+			},
+			ExpectedErrors: []string{"Invalid value::spec.journald.rateLimitInterval", "Invalid value::spec.journald.rateLimitBurst"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateJournald(&g.Input, field.NewPath("spec", "journald"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidEtcdIOIsolation(t *testing.T) {
+	grid := []struct {
+		Role           kops.InstanceGroupRole
+		Input          kops.EtcdIOIsolationSpec
+		ExpectedErrors []string
+	}{
+		{
+			Role: kops.InstanceGroupRoleControlPlane,
+			Input: kops.EtcdIOIsolationSpec{
+				Scheduler:         "mq-deadline",
+				MaxReadBandwidth:  fi.PtrTo(resource.MustParse("200Mi")),
+				MaxWriteBandwidth: fi.PtrTo(resource.MustParse("100Mi")),
+				MaxReadIOPS:       fi.PtrTo(int64(2000)),
+				MaxWriteIOPS:      fi.PtrTo(int64(1000)),
+			},
+		},
+		{
+			Role: kops.InstanceGroupRoleNode,
+			Input: kops.EtcdIOIsolationSpec{
+				Scheduler: "none",
+			},
+			ExpectedErrors: []string{"Forbidden::spec.etcdIOIsolation"},
+		},
+		{
+			Role: kops.InstanceGroupRoleControlPlane,
+			Input: kops.EtcdIOIsolationSpec{
+				Scheduler:         "cfq",
+				MaxWriteBandwidth: fi.PtrTo(resource.MustParse("0")),
+				MaxReadIOPS:       fi.PtrTo(int64(-1)),
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::spec.etcdIOIsolation.scheduler",
+				"Invalid value::spec.etcdIOIsolation.maxWriteBandwidth",
+				"Invalid value::spec.etcdIOIsolation.maxReadIOPS",
+			},
+		},
+	}
+	for _, g := range grid {
+		ig := createMinimalInstanceGroup()
+		ig.Spec.Role = g.Role
+		ig.Spec.Subnets = []string{"subnet-us-test-1a"}
+		ig.Spec.EtcdIOIsolation = &g.Input
+		errs := ValidateInstanceGroup(ig, nil, true)
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidImageChannel(t *testing.T) {
+	grid := []struct {
+		Image          string
+		ImageChannel   string
+		ExpectedErrors []string
+	}{
+		{
+			ImageChannel: "ubuntu-24.04-stable",
+		},
+		{
+			ImageChannel:   "ubuntu-24.04-daily",
+			ExpectedErrors: []string{"Unsupported value::spec.imageChannel"},
+		},
+		{
+			Image:          "ami-1234",
+			ImageChannel:   "debian-12-stable",
+			ExpectedErrors: []string{"Forbidden::spec.imageChannel"},
+		},
+	}
+	for _, g := range grid {
+		ig := createMinimalInstanceGroup()
+		ig.Spec.Image = g.Image
+		ig.Spec.ImageChannel = g.ImageChannel
+		errs := ValidateInstanceGroup(ig, nil, false)
+		testErrors(t, g.ImageChannel, errs, g.ExpectedErrors)
+	}
+}
+
+func TestValidPreferSpot(t *testing.T) {
+	grid := []struct {
+		Role           kops.InstanceGroupRole
+		Input          kops.InstanceGroupSpec
+		Cloud          kops.CloudProviderSpec
+		FeatureFlag    bool
+		ExpectedErrors []string
+	}{
+		{
+			Role:  kops.InstanceGroupRoleNode,
+			Cloud: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		},
+		{
+			Role:  kops.InstanceGroupRoleNode,
+			Cloud: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+		},
+		{
+			Role:           kops.InstanceGroupRoleNode,
+			Input:          kops.InstanceGroupSpec{MaxPrice: fi.PtrTo("0.1")},
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.preferSpot"},
+		},
+		{
+			Role:           kops.InstanceGroupRoleNode,
+			Input:          kops.InstanceGroupSpec{GCPProvisioningModel: fi.PtrTo("SPOT")},
+			Cloud:          kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.preferSpot"},
+		},
+		{
+			Role:           kops.InstanceGroupRoleNode,
+			Cloud:          kops.CloudProviderSpec{Hetzner: &kops.HetznerSpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.preferSpot"},
+		},
+		{
+			Role:           kops.InstanceGroupRoleControlPlane,
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.preferSpot"},
+		},
+		{
+			Role:        kops.InstanceGroupRoleControlPlane,
+			Cloud:       kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			FeatureFlag: true,
+		},
+	}
+	for _, g := range grid {
+		if g.FeatureFlag {
+			featureflag.ParseFlags("+UnsafeControlPlaneOnSpot")
+		}
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudProvider: g.Cloud,
+			},
+		}
+		ig := &kops.InstanceGroup{
+			ObjectMeta: v1.ObjectMeta{Name: "ig"},
+			Spec:       g.Input,
+		}
+		ig.Spec.Role = g.Role
+		ig.Spec.PreferSpot = fi.PtrTo(true)
+		errs := validatePreferSpot(ig, cluster, field.NewPath("spec", "preferSpot"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+		if g.FeatureFlag {
+			featureflag.ParseFlags("-UnsafeControlPlaneOnSpot")
+		}
+	}
+}
+
+func TestValidWindowsInstanceGroup(t *testing.T) {
+	grid := []struct {
+		Description    string
+		Role           kops.InstanceGroupRole
+		Input          kops.InstanceGroupSpec
+		FeatureFlag    bool
+		ExpectedErrors []string
+	}{
+		{
+			Description: "valid Windows node instance group",
+			Role:        kops.InstanceGroupRoleNode,
+			FeatureFlag: true,
+		},
+		{
+			Description:    "feature flag is required",
+			Role:           kops.InstanceGroupRoleNode,
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "control plane cannot run Windows",
+			Role:           kops.InstanceGroupRoleControlPlane,
+			FeatureFlag:    true,
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "bastion cannot run Windows",
+			Role:           kops.InstanceGroupRoleBastion,
+			FeatureFlag:    true,
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description: "nodeup settings are not supported",
+			Role:        kops.InstanceGroupRoleNode,
+			Input: kops.InstanceGroupSpec{
+				Packages: []string{"nfs-common"},
+				Hooks:    []kops.HookSpec{{Name: "hook"}},
+			},
+			FeatureFlag:    true,
+			ExpectedErrors: []string{"Forbidden::spec.packages", "Forbidden::spec.hooks"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Description, func(t *testing.T) {
+			if g.FeatureFlag {
+				featureflag.ParseFlags("+WindowsNodes")
+				defer featureflag.ParseFlags("-WindowsNodes")
+			}
+			ig := &kops.InstanceGroup{
+				ObjectMeta: v1.ObjectMeta{Name: "ig"},
+				Spec:       g.Input,
+			}
+			ig.Spec.Role = g.Role
+			ig.Spec.OperatingSystem = kops.InstanceGroupOperatingSystemWindows
+			errs := validateWindowsInstanceGroup(ig, field.NewPath("spec"))
+			testErrors(t, g.Description, errs, g.ExpectedErrors)
+		})
+	}
+}
+
+func TestCrossValidWindowsInstanceGroup(t *testing.T) {
+	grid := []struct {
+		Description       string
+		Cloud             kops.CloudProviderSpec
+		Networking        kops.NetworkingSpec
+		KubeProxy         *kops.KubeProxyConfig
+		NoBootstrapTokens bool
+		ExpectedErrors    []string
+	}{
+		{
+			Description: "Calico vxlan on AWS",
+			Cloud:       kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking:  kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan", VXLANMode: "Always"}},
+		},
+		{
+			Description: "Calico vxlan on GCE",
+			Cloud:       kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			Networking:  kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan", VXLANMode: "Always"}},
+		},
+		{
+			Description:    "Azure is not supported",
+			Cloud:          kops.CloudProviderSpec{Azure: &kops.AzureSpec{}},
+			Networking:     kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan", VXLANMode: "Always"}},
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "unsupported cloud",
+			Cloud:          kops.CloudProviderSpec{Hetzner: &kops.HetznerSpec{}},
+			Networking:     kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan", VXLANMode: "Always"}},
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "Cilium is not supported",
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking:     kops.NetworkingSpec{Cilium: &kops.CiliumNetworkingSpec{}},
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "Calico vxlan CrossSubnet is not supported",
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking:     kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan"}},
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "Calico IP-in-IP is not supported",
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking:     kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "ipip"}},
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:       "bootstrap tokens are required",
+			Cloud:             kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking:        kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan", VXLANMode: "Always"}},
+			NoBootstrapTokens: true,
+			ExpectedErrors:    []string{"Forbidden::spec.operatingSystem"},
+		},
+		{
+			Description:    "kube-proxy is required",
+			Cloud:          kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Networking:     kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{EncapsulationMode: "vxlan", VXLANMode: "Always"}},
+			KubeProxy:      &kops.KubeProxyConfig{Enabled: fi.PtrTo(false)},
+			ExpectedErrors: []string{"Forbidden::spec.operatingSystem"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Description, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				Spec: kops.ClusterSpec{
+					CloudProvider: g.Cloud,
+					Networking:    g.Networking,
+					KubeAPIServer: &kops.KubeAPIServerConfig{EnableBootstrapAuthToken: fi.PtrTo(!g.NoBootstrapTokens)},
+					KubeProxy:     g.KubeProxy,
+				},
+			}
+			errs := crossValidateWindowsInstanceGroup(cluster, field.NewPath("spec", "operatingSystem"))
+			testErrors(t, g.Description, errs, g.ExpectedErrors)
+		})
+	}
+}
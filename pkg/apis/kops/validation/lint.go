@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// Warning is a setting which passes validation, but is deprecated, has no effect, or conflicts with another setting.
+type Warning struct {
+	// Field is the path of the setting.
+	Field string `json:"field"`
+	// Message describes the problem.
+	Message string `json:"message"`
+	// Suggestion describes how to fix the problem.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// LintCluster returns the warnings about the settings of a cluster.
+// It does not repeat the errors returned by ValidateCluster.
+func LintCluster(c *kops.Cluster) []*Warning {
+	var warnings []*Warning
+	spec := &c.Spec
+	fieldPath := field.NewPath("spec")
+
+	if spec.KubeAPIServer != nil && spec.KubeAPIServer.Address != "" {
+		warnings = append(warnings, &Warning{
+			Field:      fieldPath.Child("kubeAPIServer", "address").String(),
+			Message:    "address is deprecated",
+			Suggestion: "set spec.kubeAPIServer.bindAddress instead",
+		})
+	}
+
+	if calico := spec.Networking.Calico; calico != nil && calico.CrossSubnet != nil {
+		w := &Warning{
+			Field:      fieldPath.Child("networking", "calico", "crossSubnet").String(),
+			Message:    "crossSubnet has no effect since kOps 1.22",
+			Suggestion: "remove it",
+		}
+		if fi.ValueOf(calico.CrossSubnet) {
+			w.Suggestion = "remove it, and set spec.networking.calico.awsSrcDstCheck to Disable"
+		}
+		warnings = append(warnings, w)
+	}
+
+	if spec.KubeProxy != nil && spec.KubeProxy.Enabled != nil && !*spec.KubeProxy.Enabled {
+		if spec.Networking.Cilium == nil || !spec.Networking.Cilium.EnableNodePort {
+			warnings = append(warnings, &Warning{
+				Field:      fieldPath.Child("kubeProxy", "enabled").String(),
+				Message:    "kube-proxy is disabled, but the networking does not replace it; Services will not be reachable",
+				Suggestion: "use Cilium with spec.networking.cilium.enableNodePort, or enable kube-proxy",
+			})
+		}
+	}
+
+	featureGates := make(map[string]string)
+	for name, enabled := range spec.FeatureGates {
+		featureGates[name] = strconv.FormatBool(enabled)
+	}
+	warnings = append(warnings, lintFeatureGates(c, featureGates, fieldPath.Child("featureGates"))...)
+	if spec.KubeAPIServer != nil {
+		warnings = append(warnings, lintFeatureGates(c, spec.KubeAPIServer.FeatureGates, fieldPath.Child("kubeAPIServer", "featureGates"))...)
+	}
+	if spec.KubeControllerManager != nil {
+		warnings = append(warnings, lintFeatureGates(c, spec.KubeControllerManager.FeatureGates, fieldPath.Child("kubeControllerManager", "featureGates"))...)
+	}
+	if spec.KubeScheduler != nil {
+		warnings = append(warnings, lintFeatureGates(c, spec.KubeScheduler.FeatureGates, fieldPath.Child("kubeScheduler", "featureGates"))...)
+	}
+	if spec.KubeProxy != nil {
+		warnings = append(warnings, lintFeatureGates(c, spec.KubeProxy.FeatureGates, fieldPath.Child("kubeProxy", "featureGates"))...)
+	}
+	if spec.Kubelet != nil {
+		warnings = append(warnings, lintFeatureGates(c, spec.Kubelet.FeatureGates, fieldPath.Child("kubelet", "featureGates"))...)
+	}
+	if spec.ControlPlaneKubelet != nil {
+		warnings = append(warnings, lintFeatureGates(c, spec.ControlPlaneKubelet.FeatureGates, fieldPath.Child("controlPlaneKubelet", "featureGates"))...)
+	}
+
+	return warnings
+}
+
+// LintInstanceGroup returns the warnings about the settings of an instance group of the cluster.
+func LintInstanceGroup(g *kops.InstanceGroup, c *kops.Cluster) []*Warning {
+	var warnings []*Warning
+	fieldPath := field.NewPath("spec")
+
+	if g.Spec.Kubelet != nil {
+		warnings = append(warnings, lintFeatureGates(c, g.Spec.Kubelet.FeatureGates, fieldPath.Child("kubelet", "featureGates"))...)
+	}
+
+	if g.IsControlPlane() && fi.ValueOf(g.Spec.PreferSpot) {
+		warnings = append(warnings, &Warning{
+			Field:      fieldPath.Child("preferSpot").String(),
+			Message:    "the control plane runs on spot capacity: interruptions make the Kubernetes API unavailable",
+			Suggestion: "do not use this for production clusters",
+		})
+	}
+
+	return warnings
+}
+
+// lintFeatureGates warns about the feature gates kOps cannot check, and those set to the value they are locked to.
+// The removed gates and those set against their locked value are validation errors.
+func lintFeatureGates(c *kops.Cluster, featureGates map[string]string, fldPath *field.Path) []*Warning {
+	var warnings []*Warning
+	version := clusterKubernetesVersion(c)
+	for _, name := range sortedKeys(featureGates) {
+		gate, found := kubernetesFeatureGates[name]
+		if !found {
+			warnings = append(warnings, &Warning{
+				Field:   fldPath.Key(name).String(),
+				Message: fmt.Sprintf("kOps cannot check feature gate %q against Kubernetes %s", name, c.Spec.KubernetesVersion),
+			})
+			continue
+		}
+		enabled, err := strconv.ParseBool(featureGates[name])
+		if err != nil || isVersionGTE(version, gate.Removed) || !isVersionGTE(version, gate.LockedToDefault) || enabled != gate.Default {
+			continue
+		}
+		warnings = append(warnings, &Warning{
+			Field:      fldPath.Key(name).String(),
+			Message:    fmt.Sprintf("feature gate %q is locked to %v since Kubernetes %s", name, gate.Default, gate.LockedToDefault),
+			Suggestion: "remove it",
+		})
+	}
+	return warnings
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func Test_LintCluster(t *testing.T) {
+	grid := []struct {
+		Name           string
+		Spec           kops.ClusterSpec
+		ExpectedFields []string
+	}{
+		{
+			Name: "no warnings",
+			Spec: kops.ClusterSpec{
+				KubeProxy: &kops.KubeProxyConfig{Enabled: fi.PtrTo(false)},
+				Networking: kops.NetworkingSpec{
+					Cilium: &kops.CiliumNetworkingSpec{EnableNodePort: true},
+				},
+			},
+		},
+		{
+			Name: "deprecated fields",
+			Spec: kops.ClusterSpec{
+				KubeAPIServer: &kops.KubeAPIServerConfig{Address: "0.0.0.0"},
+				Networking: kops.NetworkingSpec{
+					Calico: &kops.CalicoNetworkingSpec{CrossSubnet: fi.PtrTo(true)},
+				},
+			},
+			ExpectedFields: []string{"spec.kubeAPIServer.address", "spec.networking.calico.crossSubnet"},
+		},
+		{
+			Name: "kube-proxy disabled without replacement",
+			Spec: kops.ClusterSpec{
+				KubeProxy: &kops.KubeProxyConfig{Enabled: fi.PtrTo(false)},
+				Networking: kops.NetworkingSpec{
+					Calico: &kops.CalicoNetworkingSpec{},
+				},
+			},
+			ExpectedFields: []string{"spec.kubeProxy.enabled"},
+		},
+		{
+			Name: "feature gates",
+			Spec: kops.ClusterSpec{
+				FeatureGates: map[string]bool{"NotAFeatureGate": true, "SidecarContainers": true},
+				Kubelet: &kops.KubeletConfigSpec{
+					FeatureGates: map[string]string{
+						"NotAFeatureGate":   "true",
+						"SidecarContainers": "false",
+					},
+				},
+			},
+			ExpectedFields: []string{"spec.featureGates[NotAFeatureGate]", "spec.featureGates[SidecarContainers]", "spec.kubelet.featureGates[NotAFeatureGate]"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster := &kops.Cluster{Spec: g.Spec}
+			cluster.Spec.KubernetesVersion = "1.33.0"
+
+			var fields []string
+			for _, w := range LintCluster(cluster) {
+				fields = append(fields, w.Field)
+			}
+			if !reflect.DeepEqual(fields, g.ExpectedFields) {
+				t.Errorf("unexpected warnings for fields %v, expected %v", fields, g.ExpectedFields)
+			}
+		})
+	}
+}
+
+func Test_LintInstanceGroup(t *testing.T) {
+	grid := []struct {
+		Name           string
+		Spec           kops.InstanceGroupSpec
+		ExpectedFields []string
+	}{
+		{
+			Name: "no warnings",
+			Spec: kops.InstanceGroupSpec{Role: kops.InstanceGroupRoleNode, PreferSpot: fi.PtrTo(true)},
+		},
+		{
+			Name:           "control plane on spot",
+			Spec:           kops.InstanceGroupSpec{Role: kops.InstanceGroupRoleControlPlane, PreferSpot: fi.PtrTo(true)},
+			ExpectedFields: []string{"spec.preferSpot"},
+		},
+		{
+			Name: "feature gates",
+			Spec: kops.InstanceGroupSpec{
+				Role: kops.InstanceGroupRoleNode,
+				Kubelet: &kops.KubeletConfigSpec{
+					FeatureGates: map[string]string{"NotAFeatureGate": "true"},
+				},
+			},
+			ExpectedFields: []string{"spec.kubelet.featureGates[NotAFeatureGate]"},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster := &kops.Cluster{}
+			cluster.Spec.KubernetesVersion = "1.33.0"
+			ig := &kops.InstanceGroup{Spec: g.Spec}
+
+			var fields []string
+			for _, w := range LintInstanceGroup(ig, cluster) {
+				fields = append(fields, w.Field)
+			}
+			if !reflect.DeepEqual(fields, g.ExpectedFields) {
+				t.Errorf("unexpected warnings for fields %v, expected %v", fields, g.ExpectedFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+// userNameRegexp matches the user names that are portable across Linux distributions
+var userNameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_-]{0,31}$`)
+
+func validateUserName(user string, fldPath *field.Path) field.ErrorList {
+	if user == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	if !userNameRegexp.MatchString(user) {
+		return field.ErrorList{field.Invalid(fldPath, user, "must be a user name such as ubuntu")}
+	}
+	return nil
+}
+
+func validateSSHCertificateAuthority(spec *kops.SSHCertificateAuthoritySpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	for i, principal := range spec.Principals {
+		allErrs = append(allErrs, validateUserName(principal, fldPath.Child("principals").Index(i))...)
+	}
+	if spec.MaxTTL != nil && spec.MaxTTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxTTL"), spec.MaxTTL.Duration.String(), "must be positive"))
+	}
+	return allErrs
+}
+
+func validateSSHAuthorizedKeys(specs []kops.SSHAuthorizedKeysSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	users := sets.New[string]()
+	for i, spec := range specs {
+		path := fldPath.Index(i)
+		allErrs = append(allErrs, validateUserName(spec.User, path.Child("user"))...)
+		if users.Has(spec.User) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("user"), spec.User))
+		}
+		users.Insert(spec.User)
+
+		if len(spec.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("publicKeys"), "at least one public key is required"))
+		}
+		for j, publicKey := range spec.PublicKeys {
+			if _, _, _, rest, err := ssh.ParseAuthorizedKey([]byte(publicKey)); err != nil || len(rest) != 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("publicKeys").Index(j), publicKey, "must be a single SSH public key in authorized_keys format"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateBastion(c *kops.Cluster, spec *kops.BastionSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Managed != nil {
+		allErrs = append(allErrs, validateManagedBastion(c, spec.Managed, fldPath.Child("managed"))...)
+
+		// The remaining settings configure bastion instances
+		if spec.PublicName != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("publicName"), "not supported with a managed bastion"))
+		}
+		if spec.LoadBalancer != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("loadBalancer"), "not supported with a managed bastion"))
+		}
+		if len(spec.Access) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("access"), "not supported with a managed bastion"))
+		}
+		if spec.SessionIdleTimeout != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("sessionIdleTimeout"), "not supported with a managed bastion"))
+		}
+	}
+
+	for i, access := range spec.Access {
+		path := fldPath.Child("access").Index(i)
+		if len(access.Users) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("users"), "at least one user is required"))
+		}
+		for j, user := range access.Users {
+			if user != "*" {
+				allErrs = append(allErrs, validateUserName(user, path.Child("users").Index(j))...)
+			}
+		}
+		for j, cidr := range access.CIDRs {
+			allErrs = append(allErrs, validateCIDR(path.Child("cidrs").Index(j), cidr)...)
+		}
+	}
+
+	if spec.SessionIdleTimeout != nil && spec.SessionIdleTimeout.Duration < time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sessionIdleTimeout"), spec.SessionIdleTimeout.Duration.String(), "must be at least 1s"))
+	}
+
+	return allErrs
+}
+
+func validateManagedBastion(c *kops.Cluster, spec *kops.ManagedBastionSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	var cloudProvider kops.CloudProviderID
+	switch spec.Type {
+	case kops.ManagedBastionTypeSSM:
+		cloudProvider = kops.CloudProviderAWS
+	case kops.ManagedBastionTypeIAP:
+		cloudProvider = kops.CloudProviderGCE
+	case kops.ManagedBastionTypeAzureBastion:
+		cloudProvider = kops.CloudProviderAzure
+	default:
+		return append(allErrs, field.NotSupported(fldPath.Child("type"), spec.Type, kops.SupportedManagedBastionTypes))
+	}
+	if c.GetCloudProvider() != cloudProvider {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), fmt.Sprintf("%s is only supported on %s", spec.Type, cloudProvider)))
+	}
+
+	if spec.Type == kops.ManagedBastionTypeAzureBastion {
+		if spec.SubnetCIDR == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("subnetCIDR"), "the address range of the AzureBastionSubnet is required"))
+		} else {
+			allErrs = append(allErrs, validateCIDR(fldPath.Child("subnetCIDR"), spec.SubnetCIDR)...)
+		}
+	} else if spec.SubnetCIDR != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnetCIDR"), "only supported with AzureBastion"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func Test_Validate_SSHCertificateAuthority(t *testing.T) {
+	grid := []struct {
+		Input          kops.SSHCertificateAuthoritySpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.SSHCertificateAuthoritySpec{
+				Principals: []string{"ubuntu", "ec2-user"},
+				MaxTTL:     &metav1.Duration{Duration: 8 * time.Hour},
+			},
+		},
+		{
+			Input: kops.SSHCertificateAuthoritySpec{},
+		},
+		{
+			Input: kops.SSHCertificateAuthoritySpec{
+				Principals: []string{"Ubuntu", ""},
+				MaxTTL:     &metav1.Duration{},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::sshCertificateAuthority.principals[0]",
+				"Required value::sshCertificateAuthority.principals[1]",
+				"Invalid value::sshCertificateAuthority.maxTTL",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateSSHCertificateAuthority(&g.Input, field.NewPath("sshCertificateAuthority"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_SSHAuthorizedKeys(t *testing.T) {
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBtGFT6rxn6wLc9wBRqvBDUqTh7ZDNPZDtaoeZQiVsVd alice@example.com"
+	grid := []struct {
+		Input          []kops.SSHAuthorizedKeysSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: []kops.SSHAuthorizedKeysSpec{
+				{User: "ubuntu", PublicKeys: []string{publicKey}},
+				{User: "admin", PublicKeys: []string{publicKey}},
+			},
+		},
+		{
+			Input: []kops.SSHAuthorizedKeysSpec{
+				{User: "ubuntu", PublicKeys: []string{publicKey}},
+				{User: "ubuntu", PublicKeys: []string{"not a key"}},
+				{User: "../root"},
+			},
+			ExpectedErrors: []string{
+				"Duplicate value::sshAuthorizedKeys[1].user",
+				"Invalid value::sshAuthorizedKeys[1].publicKeys[0]",
+				"Invalid value::sshAuthorizedKeys[2].user",
+				"Required value::sshAuthorizedKeys[2].publicKeys",
+			},
+		},
+		{
+			Input: []kops.SSHAuthorizedKeysSpec{
+				{User: "ubuntu", PublicKeys: []string{publicKey + "\n" + publicKey}},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::sshAuthorizedKeys[0].publicKeys[0]",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateSSHAuthorizedKeys(g.Input, field.NewPath("sshAuthorizedKeys"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_Bastion(t *testing.T) {
+	grid := []struct {
+		CloudProvider  kops.CloudProviderSpec
+		Input          kops.BastionSpec
+		ExpectedErrors []string
+	}{
+		{
+			CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Input: kops.BastionSpec{
+				Access: []kops.BastionAccessSpec{
+					{Users: []string{"ubuntu"}, CIDRs: []string{"192.0.2.0/24", "2001:db8::/32"}},
+					{Users: []string{"*"}, CIDRs: []string{"10.0.0.0/8"}},
+				},
+				SessionIdleTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Input: kops.BastionSpec{
+				Access: []kops.BastionAccessSpec{
+					{CIDRs: []string{"192.0.2.1"}},
+					{Users: []string{"Admin"}},
+				},
+				SessionIdleTimeout: &metav1.Duration{Duration: time.Millisecond},
+			},
+			ExpectedErrors: []string{
+				"Required value::bastion.access[0].users",
+				"Invalid value::bastion.access[0].cidrs[0]",
+				"Invalid value::bastion.access[1].users[0]",
+				"Invalid value::bastion.sessionIdleTimeout",
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Input: kops.BastionSpec{
+				Managed: &kops.ManagedBastionSpec{Type: kops.ManagedBastionTypeSSM},
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			Input: kops.BastionSpec{
+				Managed: &kops.ManagedBastionSpec{Type: kops.ManagedBastionTypeIAP},
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{Azure: &kops.AzureSpec{}},
+			Input: kops.BastionSpec{
+				Managed: &kops.ManagedBastionSpec{Type: kops.ManagedBastionTypeAzureBastion, SubnetCIDR: "10.0.255.0/26"},
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{Azure: &kops.AzureSpec{}},
+			Input: kops.BastionSpec{
+				Managed: &kops.ManagedBastionSpec{Type: kops.ManagedBastionTypeAzureBastion},
+			},
+			ExpectedErrors: []string{
+				"Required value::bastion.managed.subnetCIDR",
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			Input: kops.BastionSpec{
+				Managed:      &kops.ManagedBastionSpec{Type: kops.ManagedBastionTypeSSM, SubnetCIDR: "10.0.255.0/26"},
+				PublicName:   "bastion.example.com",
+				LoadBalancer: &kops.BastionLoadBalancerSpec{},
+				Access: []kops.BastionAccessSpec{
+					{Users: []string{"ubuntu"}},
+				},
+				SessionIdleTimeout: &metav1.Duration{Duration: time.Hour},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::bastion.managed.type",
+				"Forbidden::bastion.managed.subnetCIDR",
+				"Forbidden::bastion.publicName",
+				"Forbidden::bastion.loadBalancer",
+				"Forbidden::bastion.access",
+				"Forbidden::bastion.sessionIdleTimeout",
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			Input: kops.BastionSpec{
+				Managed: &kops.ManagedBastionSpec{Type: "Teleport"},
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::bastion.managed.type",
+			},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudProvider: g.CloudProvider,
+			},
+		}
+		errs := validateBastion(cluster, &g.Input, field.NewPath("bastion"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
@@ -24,12 +24,16 @@ import (
 	"net/url"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/blang/semver/v4"
 	"golang.org/x/net/ipv4"
 	"golang.org/x/net/ipv6"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
@@ -39,10 +43,12 @@ import (
 	"k8s.io/kops/pkg/util/subnet"
 
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/model/components"
 	"k8s.io/kops/pkg/model/iam"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/utils"
+	"k8s.io/kops/util/pkg/signature"
 )
 
 func newValidateCluster(cluster *kops.Cluster, strict bool) field.ErrorList {
@@ -139,6 +145,10 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, validateKubeScheduler(spec.KubeScheduler, c, fieldPath.Child("kubeScheduler"), strict)...)
 	}
 
+	if spec.ExternalCloudControllerManager != nil {
+		allErrs = append(allErrs, validateCloudControllerManager(spec.ExternalCloudControllerManager, fieldPath.Child("cloudControllerManager"))...)
+	}
+
 	if spec.KubeProxy != nil {
 		allErrs = append(allErrs, validateKubeProxy(spec.KubeProxy, fieldPath.Child("kubeProxy"))...)
 	}
@@ -151,6 +161,16 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, validateKubelet(spec.ControlPlaneKubelet, c, fieldPath.Child("controlPlaneKubelet"))...)
 	}
 
+	if len(spec.FeatureGates) > 0 {
+		allErrs = append(allErrs, validateClusterFeatureGates(c, spec.FeatureGates, fieldPath.Child("featureGates"))...)
+	}
+
+	if len(spec.APIRuntimeConfig) > 0 {
+		allErrs = append(allErrs, validateRuntimeConfig(c, sortedKeys(spec.APIRuntimeConfig), fieldPath.Child("apiRuntimeConfig"))...)
+	}
+
+	allErrs = append(allErrs, validateComponentFeatureGates(c, spec, fieldPath)...)
+
 	allErrs = append(allErrs, validateNetworking(c, &spec.Networking, fieldPath.Child("networking"), strict, providerConstraints)...)
 
 	if spec.NodeAuthorization != nil {
@@ -173,6 +193,34 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, validateSnapshotController(c, spec.SnapshotController, fieldPath.Child("snapshotController"))...)
 	}
 
+	if spec.VerticalPodAutoscaler != nil {
+		allErrs = append(allErrs, validateVerticalPodAutoscaler(c, spec.VerticalPodAutoscaler, fieldPath.Child("verticalPodAutoscaler"))...)
+	}
+
+	if spec.ControlPlaneProfile != "" {
+		allErrs = append(allErrs, IsValidValue(fieldPath.Child("controlPlaneProfile"), &spec.ControlPlaneProfile, kops.SupportedControlPlaneProfiles)...)
+	}
+
+	if spec.Konnectivity != nil {
+		allErrs = append(allErrs, validateKonnectivity(c, spec.Konnectivity, fieldPath.Child("konnectivity"))...)
+	}
+
+	if spec.NamespaceDefaults != nil {
+		allErrs = append(allErrs, validateNamespaceDefaults(spec.NamespaceDefaults, fieldPath.Child("namespaceDefaults"))...)
+	}
+
+	if spec.Admission != nil {
+		allErrs = append(allErrs, validateAdmission(c, spec.Admission, fieldPath.Child("admission"))...)
+	}
+
+	if spec.AddonResources != nil {
+		allErrs = append(allErrs, validateAddonResources(spec.AddonResources, fieldPath.Child("addonResources"))...)
+	}
+
+	allErrs = append(allErrs, validateAdoptAddons(spec.AdoptAddons, fieldPath.Child("adoptAddons"))...)
+
+	allErrs = append(allErrs, validateAddons(spec.Addons, fieldPath.Child("addons"))...)
+
 	// IAM additional policies
 	for k, v := range spec.AdditionalPolicies {
 		allErrs = append(allErrs, validateAdditionalPolicy(k, v, fieldPath.Child("additionalPolicies"))...)
@@ -209,10 +257,26 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("docker"), "Docker CRI support was removed in Kubernetes 1.24: https://kubernetes.io/blog/2020/12/02/dockershim-faq"))
 	}
 
+	if spec.SSHCertificateAuthority != nil {
+		allErrs = append(allErrs, validateSSHCertificateAuthority(spec.SSHCertificateAuthority, fieldPath.Child("sshCertificateAuthority"))...)
+	}
+
 	if spec.Assets != nil {
 		if spec.Assets.ContainerProxy != nil && spec.Assets.ContainerRegistry != nil {
 			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("assets", "containerProxy"), "containerProxy cannot be used in conjunction with containerRegistry"))
 		}
+		for _, image := range sortedKeys(spec.Assets.ImageDigests) {
+			path := fieldPath.Child("assets", "imageDigests").Key(image)
+			if strings.Contains(image, "@") {
+				allErrs = append(allErrs, field.Invalid(path, image, "image is already pinned to a digest"))
+			}
+			if digest := spec.Assets.ImageDigests[image]; !imageDigestRegexp.MatchString(digest) {
+				allErrs = append(allErrs, field.Invalid(path, digest, "must be a sha256 digest such as sha256:0123..."))
+			}
+		}
+		if spec.Assets.SignatureVerification != nil {
+			allErrs = append(allErrs, validateAssetSignatureVerification(spec.Assets.SignatureVerification, fieldPath.Child("assets", "signatureVerification"))...)
+		}
 	}
 
 	for i, sysctlParameter := range spec.SysctlParameters {
@@ -293,6 +357,33 @@ func validateClusterSpec(spec *kops.ClusterSpec, c *kops.Cluster, fieldPath *fie
 	if spec.CertManager != nil && fi.ValueOf(spec.CertManager.Enabled) {
 		allErrs = append(allErrs, validateCertManager(c, spec.CertManager, fieldPath.Child("certManager"))...)
 	}
+	if spec.CertManager != nil && fi.ValueOf(spec.CertManager.UseClusterCA) {
+		allErrs = append(allErrs, validateCertManagerUseClusterCA(spec.CertManager, fieldPath.Child("certManager", "useClusterCA"))...)
+	}
+
+	if spec.NTP != nil {
+		allErrs = append(allErrs, validateNTP(spec.NTP, fieldPath.Child("ntp"))...)
+	}
+
+	if spec.Monitoring != nil {
+		allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fieldPath.Child("monitoring"))...)
+	}
+
+	if spec.GitOps != nil {
+		allErrs = append(allErrs, validateGitOps(c, spec.GitOps, fieldPath.Child("gitOps"))...)
+	}
+
+	if spec.ConnectivityProbes != nil {
+		allErrs = append(allErrs, validateConnectivityProbes(spec.ConnectivityProbes, fieldPath.Child("connectivityProbes"))...)
+	}
+
+	if spec.Hibernation != nil {
+		allErrs = append(allErrs, validateHibernation(c, spec.Hibernation, fieldPath.Child("hibernation"))...)
+	}
+
+	if spec.Notifications != nil {
+		allErrs = append(allErrs, validateNotifications(spec.Notifications, fieldPath.Child("notifications"))...)
+	}
 
 	return allErrs
 }
@@ -527,6 +618,14 @@ func validateTopology(c *kops.Cluster, topology *kops.TopologySpec, fieldPath *f
 		allErrs = append(allErrs, IsValidValue(fieldPath.Child("dns", "type"), &topology.DNS, kops.SupportedDnsTypes)...)
 	}
 
+	if topology.Bastion != nil {
+		allErrs = append(allErrs, validateBastion(c, topology.Bastion, fieldPath.Child("bastion"))...)
+	}
+
+	if topology.VPN != nil {
+		allErrs = append(allErrs, validateVPN(c, topology.VPN, fieldPath.Child("vpn"))...)
+	}
+
 	return allErrs
 }
 
@@ -748,7 +847,8 @@ func validateKubeAPIServer(v *kops.KubeAPIServerConfig, c *kops.Cluster, fldPath
 		}
 	}
 
-	if fi.ValueOf(v.EnableBootstrapAuthToken) {
+	// Bootstrap tokens are only used to join Windows nodes, which cannot run nodeup to authenticate to kops-controller
+	if fi.ValueOf(v.EnableBootstrapAuthToken) && !featureflag.WindowsNodes.Enabled() {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableBootstrapTokenAuth"), "bootstrap tokens are not supported"))
 	}
 
@@ -843,6 +943,9 @@ func validateKubeAPIServer(v *kops.KubeAPIServerConfig, c *kops.Cluster, fldPath
 		}
 	}
 
+	allErrs = append(allErrs, validateResourceLimit(v.CPURequest, "150m", v.CPULimit, fldPath.Child("cpuLimit"))...)
+	allErrs = append(allErrs, validateResourceLimit(v.MemoryRequest, "", v.MemoryLimit, fldPath.Child("memoryLimit"))...)
+
 	return allErrs
 }
 
@@ -855,6 +958,9 @@ func validateKubeControllerManager(v *kops.KubeControllerManagerConfig, c *kops.
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("experimentalClusterSigningDuration"), "experimentalClusterSigningDuration has been replaced with clusterSigningDuration as of kubernetes 1.25"))
 	}
 
+	allErrs = append(allErrs, validateResourceLimit(v.CPURequest, "100m", v.CPULimit, fldPath.Child("cpuLimit"))...)
+	allErrs = append(allErrs, validateResourceLimit(v.MemoryRequest, "", v.MemoryLimit, fldPath.Child("memoryLimit"))...)
+
 	return allErrs
 }
 
@@ -867,9 +973,141 @@ func validateKubeScheduler(v *kops.KubeSchedulerConfig, c *kops.Cluster, fldPath
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("usePolicyConfigMap"), "usePolicyConfigMap is deprecated, use KubeSchedulerConfiguration"))
 	}
 
+	allErrs = append(allErrs, validatePercentageOfNodesToScore(v.PercentageOfNodesToScore, fldPath.Child("percentageOfNodesToScore"))...)
+	allErrs = append(allErrs, validateResourceLimit(v.CPURequest, "100m", v.CPULimit, fldPath.Child("cpuLimit"))...)
+	allErrs = append(allErrs, validateResourceLimit(v.MemoryRequest, "", v.MemoryLimit, fldPath.Child("memoryLimit"))...)
+
+	schedulerNames := sets.NewString()
+	for i, profile := range v.Profiles {
+		profilePath := fldPath.Child("profiles").Index(i)
+		schedulerName := profile.SchedulerName
+		if schedulerName == "" {
+			schedulerName = "default-scheduler"
+		}
+		if schedulerNames.Has(schedulerName) {
+			allErrs = append(allErrs, field.Duplicate(profilePath.Child("schedulerName"), schedulerName))
+		}
+		schedulerNames.Insert(schedulerName)
+		allErrs = append(allErrs, validateKubeSchedulerProfile(&profile, profilePath)...)
+	}
+
 	return allErrs
 }
 
+func validatePercentageOfNodesToScore(v *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if v != nil && (*v < 0 || *v > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *v, "must be between 0 and 100"))
+	}
+	return allErrs
+}
+
+func validateKubeSchedulerProfile(profile *kops.KubeSchedulerProfile, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validatePercentageOfNodesToScore(profile.PercentageOfNodesToScore, fldPath.Child("percentageOfNodesToScore"))...)
+
+	if plugins := profile.Plugins; plugins != nil {
+		extensionPoints := []struct {
+			name      string
+			pluginSet *kops.KubeSchedulerPluginSet
+		}{
+			{"preEnqueue", plugins.PreEnqueue},
+			{"queueSort", plugins.QueueSort},
+			{"preFilter", plugins.PreFilter},
+			{"filter", plugins.Filter},
+			{"postFilter", plugins.PostFilter},
+			{"preScore", plugins.PreScore},
+			{"score", plugins.Score},
+			{"reserve", plugins.Reserve},
+			{"permit", plugins.Permit},
+			{"preBind", plugins.PreBind},
+			{"bind", plugins.Bind},
+			{"postBind", plugins.PostBind},
+			{"multiPoint", plugins.MultiPoint},
+		}
+		for _, extensionPoint := range extensionPoints {
+			if extensionPoint.pluginSet == nil {
+				continue
+			}
+			extensionPointPath := fldPath.Child("plugins", extensionPoint.name)
+			// Only score plugins are weighted; multiPoint passes the weight on to the score extension point
+			weighted := extensionPoint.name == "score" || extensionPoint.name == "multiPoint"
+			for j, plugin := range extensionPoint.pluginSet.Enabled {
+				pluginPath := extensionPointPath.Child("enabled").Index(j)
+				if plugin.Name == "" {
+					allErrs = append(allErrs, field.Required(pluginPath.Child("name"), ""))
+				}
+				if plugin.Weight != nil {
+					if !weighted {
+						allErrs = append(allErrs, field.Forbidden(pluginPath.Child("weight"), "weight can only be set for score plugins"))
+					} else if *plugin.Weight < 1 || *plugin.Weight > 100 {
+						allErrs = append(allErrs, field.Invalid(pluginPath.Child("weight"), *plugin.Weight, "must be between 1 and 100"))
+					}
+				}
+			}
+			for j, plugin := range extensionPoint.pluginSet.Disabled {
+				if plugin.Name == "" {
+					allErrs = append(allErrs, field.Required(extensionPointPath.Child("disabled").Index(j).Child("name"), ""))
+				}
+			}
+		}
+	}
+
+	pluginNames := sets.NewString()
+	for i, pluginConfig := range profile.PluginConfig {
+		pluginConfigPath := fldPath.Child("pluginConfig").Index(i)
+		if pluginConfig.Name == "" {
+			allErrs = append(allErrs, field.Required(pluginConfigPath.Child("name"), ""))
+		} else if pluginNames.Has(pluginConfig.Name) {
+			allErrs = append(allErrs, field.Duplicate(pluginConfigPath.Child("name"), pluginConfig.Name))
+		}
+		pluginNames.Insert(pluginConfig.Name)
+
+		if pluginConfig.Args == "" {
+			continue
+		}
+		var args struct {
+			ScoringStrategy *struct {
+				Type string `json:"type"`
+			} `json:"scoringStrategy"`
+		}
+		if err := utils.YamlUnmarshal([]byte(pluginConfig.Args), &args); err != nil {
+			allErrs = append(allErrs, field.Invalid(pluginConfigPath.Child("args"), pluginConfig.Args, fmt.Sprintf("must be a YAML map: %v", err)))
+			continue
+		}
+		if pluginConfig.Name == "NodeResourcesFit" && args.ScoringStrategy != nil && args.ScoringStrategy.Type != "" {
+			allErrs = append(allErrs, IsValidValue(pluginConfigPath.Child("args", "scoringStrategy", "type"), &args.ScoringStrategy.Type, []string{"LeastAllocated", "MostAllocated", "RequestedToCapacityRatio"})...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateCloudControllerManager(v *kops.CloudControllerManagerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, validateResourceLimit(v.CPURequest, "200m", v.CPULimit, fldPath.Child("cpuLimit"))...)
+	allErrs = append(allErrs, validateResourceLimit(v.MemoryRequest, "", v.MemoryLimit, fldPath.Child("memoryLimit"))...)
+	return allErrs
+}
+
+// validateResourceLimit checks that a limit is not lower than its request, or the default request if none is set
+func validateResourceLimit(request *resource.Quantity, defaultRequest string, limit *resource.Quantity, fldPath *field.Path) field.ErrorList {
+	if limit == nil {
+		return nil
+	}
+	if request == nil {
+		if defaultRequest == "" {
+			return nil
+		}
+		request = fi.PtrTo(resource.MustParse(defaultRequest))
+	}
+	if request.Cmp(*limit) > 0 {
+		return field.ErrorList{field.Invalid(fldPath, limit.String(), fmt.Sprintf("must not be lower than the request of %s", request.String()))}
+	}
+	return nil
+}
+
 func validateKubeProxy(k *kops.KubeProxyConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -968,10 +1206,19 @@ func validateKubelet(k *kops.KubeletConfigSpec, c *kops.Cluster, kubeletPath *fi
 		if k.MemorySwapBehavior != "" {
 			allErrs = append(allErrs, IsValidValue(kubeletPath.Child("memorySwapBehavior"), &k.MemorySwapBehavior, []string{"LimitedSwap", "UnlimitedSwap"})...)
 		}
+
+		allErrs = append(allErrs, validateKubeletReservedResourcesMode(k, kubeletPath)...)
 	}
 	return allErrs
 }
 
+func validateKubeletReservedResourcesMode(k *kops.KubeletConfigSpec, kubeletPath *field.Path) field.ErrorList {
+	if k == nil || k.ReservedResourcesMode == "" {
+		return nil
+	}
+	return IsValidValue(kubeletPath.Child("reservedResourcesMode"), &k.ReservedResourcesMode, []string{kops.KubeletReservedResourcesModeStatic, kops.KubeletReservedResourcesModeAuto})
+}
+
 func validateNetworking(cluster *kops.Cluster, v *kops.NetworkingSpec, fldPath *field.Path, strict bool, providerConstraints *cloudProviderConstraints) field.ErrorList {
 	c := &cluster.Spec
 	allErrs := field.ErrorList{}
@@ -1031,6 +1278,9 @@ func validateNetworking(cluster *kops.Cluster, v *kops.NetworkingSpec, fldPath *
 			if strings.Contains(v.NonMasqueradeCIDR, ":") && v.NonMasqueradeCIDR != "::/0" {
 				allErrs = append(allErrs, field.Forbidden(fldPath.Child("nonMasqueradeCIDR"), "IPv6 clusters must have a nonMasqueradeCIDR of \"::/0\""))
 			}
+			if strings.Contains(v.NonMasqueradeCIDR, ":") && cluster.GetCloudProvider() == kops.CloudProviderAzure {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("nonMasqueradeCIDR"), "Azure does not support IPv6 clusters"))
+			}
 
 			if len(nonMasqueradeCIDRs) > 0 && len(networkCIDRs) > 0 && v.AmazonVPC == nil && (v.Cilium == nil || v.Cilium.IPAM != kops.CiliumIpamEni) {
 				if subnet.Overlap(nonMasqueradeCIDRs[0], networkCIDRs[0]) {
@@ -1440,6 +1690,35 @@ func validateEtcdClusterSpec(spec kops.EtcdClusterSpec, c *kops.Cluster, fieldPa
 	for i, m := range spec.Members {
 		allErrs = append(allErrs, validateEtcdMemberSpec(m, fieldPath.Child("etcdMembers").Index(i))...)
 	}
+	allErrs = append(allErrs, validateResourceLimit(spec.CPURequest, "200m", spec.CPULimit, fieldPath.Child("cpuLimit"))...)
+	allErrs = append(allErrs, validateResourceLimit(spec.MemoryRequest, "100Mi", spec.MemoryLimit, fieldPath.Child("memoryLimit"))...)
+	allErrs = append(allErrs, validateEtcdQuotaAndCompaction(spec, fieldPath)...)
+
+	return allErrs
+}
+
+func validateEtcdQuotaAndCompaction(spec kops.EtcdClusterSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.QuotaBackendBytes != nil && spec.QuotaBackendBytes.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("quotaBackendBytes"), spec.QuotaBackendBytes.String(), "must be greater than 0"))
+	}
+
+	if spec.AutoCompactionMode != "" {
+		allErrs = append(allErrs, IsValidValue(fieldPath.Child("autoCompactionMode"), &spec.AutoCompactionMode, []string{"periodic", "revision"})...)
+	}
+	if retention := spec.AutoCompactionRetention; retention != "" {
+		// etcd reads a number as hours in periodic mode, the default, and as revisions in revision mode
+		if n, err := strconv.ParseInt(retention, 10, 64); err == nil {
+			if n < 0 {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("autoCompactionRetention"), retention, "must not be negative"))
+			}
+		} else if spec.AutoCompactionMode == "revision" {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("autoCompactionRetention"), retention, "must be a number of revisions"))
+		} else if d, err := time.ParseDuration(retention); err != nil || d < 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("autoCompactionRetention"), retention, "must be a duration, such as 1h, or a number of hours"))
+		}
+	}
 
 	return allErrs
 }
@@ -1844,10 +2123,17 @@ func validateNodeLocalDNS(spec *kops.ClusterSpec, fldpath *field.Path) field.Err
 
 func validateClusterAutoscaler(cluster *kops.Cluster, spec *kops.ClusterAutoscalerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
 	if spec.Expander != "" {
-		allErrs = append(allErrs, IsValidValue(fldPath.Child("expander"), &spec.Expander, []string{"least-waste", "random", "most-pods", "price", "priority"})...)
+		seen := sets.NewString()
+		for _, expander := range strings.Split(spec.Expander, ",") {
+			allErrs = append(allErrs, IsValidValue(fldPath.Child("expander"), &expander, []string{"least-waste", "random", "most-pods", "price", "priority"})...)
+			if seen.Has(expander) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("expander"), expander))
+			}
+			seen.Insert(expander)
+		}
 	}
 
-	if spec.Expander == "price" && cluster.Spec.CloudProvider.GCE == nil {
+	if spec.UsesExpander("price") && cluster.Spec.CloudProvider.GCE == nil {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("expander"), "Cluster autoscaler price expander is only supported on GCE"))
 	}
 
@@ -1944,6 +2230,397 @@ func validateSnapshotController(cluster *kops.Cluster, spec *kops.SnapshotContro
 	return allErrs
 }
 
+func validateVerticalPodAutoscaler(cluster *kops.Cluster, spec *kops.VerticalPodAutoscalerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if fi.ValueOf(spec.Enabled) {
+		if cluster.Spec.MetricsServer == nil || !fi.ValueOf(cluster.Spec.MetricsServer.Enabled) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "Vertical Pod Autoscaler requires that metrics server is enabled"))
+		}
+		if !fi.ValueOf(spec.RecommenderOnly) && !components.IsCertManagerEnabled(cluster) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("recommenderOnly"), "Vertical Pod Autoscaler requires that cert manager is enabled, unless only the recommender is run"))
+		}
+	}
+	return allErrs
+}
+
+func validateKonnectivity(cluster *kops.Cluster, spec *kops.KonnectivityConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if fi.ValueOf(spec.Enabled) {
+		// The agents connect directly to the konnectivity-server instances through the internal API name
+		if cluster.Spec.API.LoadBalancer != nil && cluster.Spec.API.LoadBalancer.UseForInternalAPI {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enabled"), "Konnectivity cannot be used when the API load balancer is used for the internal API"))
+		}
+	}
+	return allErrs
+}
+
+var imageDigestRegexp = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+func validateAssetSignatureVerification(spec *kops.AssetSignatureVerificationSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if len(spec.PublicKeys) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("publicKeys"), "at least one public key is required to verify signatures"))
+	}
+	for i, publicKey := range spec.PublicKeys {
+		if _, err := signature.NewVerifier([]string{publicKey}); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKeys").Index(i), "...", err.Error()))
+		}
+	}
+	if spec.SignedChecksums != nil && !isValidAPIServersURL(*spec.SignedChecksums) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("signedChecksums"), *spec.SignedChecksums, "must be a url"))
+	}
+	return allErrs
+}
+
+func validateNamespaceDefaults(spec *kops.NamespaceDefaultsSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	limitRangeNames := sets.NewString()
+	for i, limitRange := range spec.LimitRanges {
+		path := fldPath.Child("limitRanges").Index(i)
+		allErrs = append(allErrs, validateNamespacedObject(limitRange.Name, limitRange.Namespaces, limitRangeNames, path)...)
+		if len(limitRange.Limits) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("limits"), ""))
+		}
+		for j, limit := range limitRange.Limits {
+			allErrs = append(allErrs, IsValidValue(path.Child("limits").Index(j).Child("type"), &limit.Type, []corev1.LimitType{corev1.LimitTypeContainer, corev1.LimitTypePod, corev1.LimitTypePersistentVolumeClaim})...)
+		}
+	}
+
+	resourceQuotaNames := sets.NewString()
+	for i, resourceQuota := range spec.ResourceQuotas {
+		path := fldPath.Child("resourceQuotas").Index(i)
+		allErrs = append(allErrs, validateNamespacedObject(resourceQuota.Name, resourceQuota.Namespaces, resourceQuotaNames, path)...)
+		if len(resourceQuota.Hard) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("hard"), ""))
+		}
+		for name, quantity := range resourceQuota.Hard {
+			if quantity.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("hard").Key(string(name)), quantity.String(), "must be greater than or equal to 0"))
+			}
+		}
+	}
+
+	priorityClassNames := sets.NewString()
+	globalDefault := ""
+	for i, priorityClass := range spec.PriorityClasses {
+		path := fldPath.Child("priorityClasses").Index(i)
+		if priorityClass.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("name"), ""))
+		} else {
+			for _, msg := range utilvalidation.IsDNS1123Subdomain(priorityClass.Name) {
+				allErrs = append(allErrs, field.Invalid(path.Child("name"), priorityClass.Name, msg))
+			}
+			// The system- prefix is reserved for the PriorityClasses built into Kubernetes
+			if strings.HasPrefix(priorityClass.Name, "system-") {
+				allErrs = append(allErrs, field.Forbidden(path.Child("name"), "the system- prefix is reserved"))
+			}
+			if priorityClassNames.Has(priorityClass.Name) {
+				allErrs = append(allErrs, field.Duplicate(path.Child("name"), priorityClass.Name))
+			}
+			priorityClassNames.Insert(priorityClass.Name)
+		}
+		if priorityClass.Value > 1000000000 {
+			allErrs = append(allErrs, field.Invalid(path.Child("value"), priorityClass.Value, "must be less than or equal to 1000000000"))
+		}
+		if priorityClass.GlobalDefault {
+			if globalDefault != "" {
+				allErrs = append(allErrs, field.Forbidden(path.Child("globalDefault"), fmt.Sprintf("priorityClass %q is already the global default", globalDefault)))
+			}
+			globalDefault = priorityClass.Name
+		}
+		if priorityClass.PreemptionPolicy != nil {
+			allErrs = append(allErrs, IsValidValue(path.Child("preemptionPolicy"), priorityClass.PreemptionPolicy, []corev1.PreemptionPolicy{corev1.PreemptNever, corev1.PreemptLowerPriority})...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateNamespacedObject(name string, namespaces []string, names sets.String, fldPath *field.Path) (allErrs field.ErrorList) {
+	if name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), name, msg))
+		}
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), name))
+		}
+		names.Insert(name)
+	}
+	if len(namespaces) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespaces"), ""))
+	}
+	for i, namespace := range namespaces {
+		for _, msg := range utilvalidation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, msg))
+		}
+	}
+	return allErrs
+}
+
+func validateAddonResources(spec *kops.AddonResourcesSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	for i, override := range spec.Overrides {
+		path := fldPath.Child("overrides").Index(i)
+		if override.Addon == "" {
+			allErrs = append(allErrs, field.Required(path.Child("addon"), ""))
+		}
+		if override.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("name"), ""))
+		}
+		if override.Container == "" {
+			allErrs = append(allErrs, field.Required(path.Child("container"), ""))
+		}
+		for name, request := range override.Resources.Requests {
+			if limit, found := override.Resources.Limits[name]; found && request.Cmp(limit) > 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("resources", "requests").Key(string(name)), request.String(), "must be less than or equal to the limit"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateAddons(addons []kops.AddonSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	chartNames := sets.NewString()
+	for i, addon := range addons {
+		path := fldPath.Index(i)
+		if addon.Chart == nil {
+			continue
+		}
+		if addon.Manifest != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("chart"), "chart cannot be set together with manifest"))
+		}
+		allErrs = append(allErrs, validateHelmChart(addon.Chart, path.Child("chart"))...)
+
+		name := addon.Chart.Name
+		if name == "" {
+			name = addon.Chart.Chart
+		}
+		if chartNames.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("chart", "name"), name))
+		}
+		chartNames.Insert(name)
+	}
+	return allErrs
+}
+
+func validateHelmChart(spec *kops.HelmChartSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Repository == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("repository"), ""))
+	} else if u, err := url.Parse(spec.Repository); err != nil || (u.Scheme != "https" && u.Scheme != "http" && u.Scheme != "oci") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("repository"), spec.Repository, "must be an https:// chart repository or an oci:// registry"))
+	}
+	if spec.Chart == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("chart"), ""))
+	} else if strings.Contains(spec.Chart, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("chart"), spec.Chart, "must be the name of the chart in the repository"))
+	}
+	if spec.Version == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("version"), "the version of the chart must be pinned"))
+	}
+	if spec.Name != "" {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(spec.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), spec.Name, msg))
+		}
+	}
+	if spec.Namespace != "" {
+		for _, msg := range utilvalidation.IsDNS1123Label(spec.Namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), spec.Namespace, msg))
+		}
+	}
+	if spec.Values != "" {
+		values := map[string]interface{}{}
+		if err := utils.YamlUnmarshal([]byte(spec.Values), &values); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("values"), spec.Values, fmt.Sprintf("must be a YAML map: %v", err)))
+		}
+	}
+	for i, from := range spec.ValuesFrom {
+		if from.SecretName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("valuesFrom").Index(i).Child("secretName"), ""))
+		}
+	}
+	return allErrs
+}
+
+func validateAdoptAddons(names []string, fldPath *field.Path) (allErrs field.ErrorList) {
+	seen := sets.NewString()
+	for i, name := range names {
+		path := fldPath.Index(i)
+		if name == "" {
+			allErrs = append(allErrs, field.Required(path, ""))
+			continue
+		}
+		if seen.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(path, name))
+		}
+		seen.Insert(name)
+	}
+	return allErrs
+}
+
+func validateMonitoring(spec *kops.MonitoringSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if fi.ValueOf(spec.ServiceMonitors) && !spec.MetricsEnabled() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("serviceMonitors"), "ServiceMonitors require that metrics are enabled"))
+	}
+	if fi.ValueOf(spec.Health) && !spec.MetricsEnabled() {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("health"), "health metrics require that metrics are enabled"))
+	}
+	if spec.HealthInterval != nil && spec.HealthInterval.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("healthInterval"), spec.HealthInterval.Duration.String(), "must be at least 1m"))
+	}
+	if spec.CertificateExpiry != nil && spec.CertificateExpiry.Window != nil && spec.CertificateExpiry.Window.Duration < time.Hour {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("certificateExpiry", "window"), spec.CertificateExpiry.Window.Duration.String(), "must be at least 1h"))
+	}
+	return allErrs
+}
+
+func validateNTP(spec *kops.NTPConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Managed != nil && !*spec.Managed {
+		if len(spec.Servers) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("servers"), "servers cannot be set when NTP is not managed"))
+		}
+		if spec.MakeStep != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("makeStep"), "makeStep cannot be set when NTP is not managed"))
+		}
+	}
+	for i, server := range spec.Servers {
+		if net.ParseIP(server) == nil && len(utilvalidation.IsDNS1123Subdomain(server)) != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("servers").Index(i), server, "must be an IP address or a DNS name"))
+		}
+	}
+	if spec.MakeStep != nil {
+		if spec.MakeStep.Threshold != nil && spec.MakeStep.Threshold.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("makeStep", "threshold"), spec.MakeStep.Threshold.Duration.String(), "must be greater than 0"))
+		}
+		if limit := spec.MakeStep.Limit; limit != nil && (*limit == 0 || *limit < -1) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("makeStep", "limit"), *limit, "must be greater than 0, or -1 to always step the clock"))
+		}
+	}
+	return allErrs
+}
+
+func validateGitOps(cluster *kops.Cluster, spec *kops.GitOpsSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Mode != "" {
+		allErrs = append(allErrs, IsValidValue(fldPath.Child("mode"), &spec.Mode, []kops.GitOpsMode{kops.GitOpsModeReconcile, kops.GitOpsModeDriftReport})...)
+	}
+	if spec.Interval != nil && spec.Interval.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), spec.Interval.Duration.String(), "must be at least 1m"))
+	}
+	if spec.Source != "" {
+		if u, err := url.Parse(spec.Source); err != nil || u.Scheme == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("source"), spec.Source, "must be a URL, such as https://, s3:// or gs://"))
+		}
+	}
+	if spec.GrantPermissions && cluster.GetCloudProvider() != kops.CloudProviderAWS {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("grantPermissions"), "grantPermissions is only supported on AWS"))
+	}
+	return allErrs
+}
+
+// cronFieldRegex matches a field of a cron expression, as accepted by the scheduled actions of AWS autoscaling groups
+var cronFieldRegex = regexp.MustCompile(`^[0-9A-Za-z*?/,#-]+$`)
+
+func validateHibernation(cluster *kops.Cluster, spec *kops.HibernationSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if cluster.GetCloudProvider() != kops.CloudProviderAWS {
+		return append(allErrs, field.Forbidden(fldPath, "hibernation is only supported on AWS"))
+	}
+	for _, schedule := range []struct {
+		name  string
+		value string
+	}{
+		{"schedule", spec.Schedule},
+		{"resumeSchedule", spec.ResumeSchedule},
+	} {
+		if schedule.value == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child(schedule.name), ""))
+			continue
+		}
+		fields := strings.Fields(schedule.value)
+		valid := len(fields) == 5
+		for _, f := range fields {
+			valid = valid && cronFieldRegex.MatchString(f)
+		}
+		if !valid {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(schedule.name), schedule.value, "must be a cron expression with 5 fields"))
+		}
+	}
+	if spec.Schedule != "" && spec.Schedule == spec.ResumeSchedule {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("resumeSchedule"), spec.ResumeSchedule, "must be different from the schedule"))
+	}
+	if spec.TimeZone != "" {
+		if _, err := time.LoadLocation(spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), spec.TimeZone, "must be an IANA time zone"))
+		}
+	}
+	return allErrs
+}
+
+func validateNotifications(spec *kops.NotificationsSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	names := sets.New[string]()
+	for i := range spec.Sinks {
+		sink := &spec.Sinks[i]
+		sinkPath := fldPath.Child("sinks").Index(i)
+
+		if sink.Name == "" {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("name"), ""))
+		} else if names.Has(sink.Name) {
+			allErrs = append(allErrs, field.Duplicate(sinkPath.Child("name"), sink.Name))
+		}
+		names.Insert(sink.Name)
+
+		allErrs = append(allErrs, IsValidValue(sinkPath.Child("type"), &sink.Type, kops.SupportedNotificationSinkTypes)...)
+		switch sink.Type {
+		case kops.NotificationSinkTypeSlack, kops.NotificationSinkTypeWebhook:
+			if sink.URL == "" {
+				allErrs = append(allErrs, field.Required(sinkPath.Child("url"), fmt.Sprintf("url is required for %s sinks", sink.Type)))
+			} else if u, err := url.Parse(sink.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("url"), sink.URL, "must be an http or https URL"))
+			}
+		case kops.NotificationSinkTypeSNS:
+			if sink.TopicARN == "" {
+				allErrs = append(allErrs, field.Required(sinkPath.Child("topicARN"), "topicARN is required for SNS sinks"))
+			} else if parsedARN, err := arn.Parse(sink.TopicARN); err != nil || parsedARN.Service != "sns" {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("topicARN"), sink.TopicARN, "must be the ARN of an SNS topic"))
+			}
+		case kops.NotificationSinkTypeEventBridge:
+			if sink.EventBusARN == "" {
+				allErrs = append(allErrs, field.Required(sinkPath.Child("eventBusARN"), "eventBusARN is required for EventBridge sinks"))
+			} else if parsedARN, err := arn.Parse(sink.EventBusARN); err != nil || parsedARN.Service != "events" || !strings.HasPrefix(parsedARN.Resource, "event-bus/") {
+				allErrs = append(allErrs, field.Invalid(sinkPath.Child("eventBusARN"), sink.EventBusARN, "must be the ARN of an EventBridge event bus"))
+			}
+		}
+
+		for j := range sink.Events {
+			allErrs = append(allErrs, IsValidValue(sinkPath.Child("events").Index(j), &sink.Events[j], kops.SupportedNotificationEventTypes)...)
+		}
+	}
+	return allErrs
+}
+
+func validateConnectivityProbes(spec *kops.ConnectivityProbesSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	interval := time.Minute
+	if spec.Interval != nil {
+		interval = spec.Interval.Duration
+		if interval < 10*time.Second {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), interval.String(), "must be at least 10s"))
+		}
+	}
+	if spec.Timeout != nil {
+		if spec.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), spec.Timeout.Duration.String(), "must be greater than zero"))
+		} else if spec.Timeout.Duration >= interval {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), spec.Timeout.Duration.String(), "must be less than the interval"))
+		}
+	}
+	for i, target := range spec.AdditionalTargets {
+		host, port, err := net.SplitHostPort(target)
+		if err != nil || host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("additionalTargets").Index(i), target, "must be in the form host:port"))
+			continue
+		}
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("additionalTargets").Index(i), target, "port must be between 1 and 65535"))
+		}
+	}
+	return allErrs
+}
+
 func validatePodIdentityWebhook(cluster *kops.Cluster, spec *kops.PodIdentityWebhookSpec, fldPath *field.Path) (allErrs field.ErrorList) {
 	if spec != nil && spec.Enabled {
 		if !components.IsCertManagerEnabled(cluster) {
@@ -1954,6 +2631,13 @@ func validatePodIdentityWebhook(cluster *kops.Cluster, spec *kops.PodIdentityWeb
 	return allErrs
 }
 
+func validateCertManagerUseClusterCA(spec *kops.CertManagerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
+	if !fi.ValueOf(spec.Enabled) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Issuing certificates from the cluster CA requires that cert manager is enabled"))
+	}
+	return allErrs
+}
+
 func validateCertManager(cluster *kops.Cluster, spec *kops.CertManagerConfig, fldPath *field.Path) (allErrs field.ErrorList) {
 	if len(spec.HostedZoneIDs) > 0 {
 		if !fi.ValueOf(cluster.Spec.IAM.UseServiceAccountExternalPermissions) {
@@ -18,9 +18,12 @@ package validation
 
 import (
 	"net"
+	"strings"
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -374,6 +377,32 @@ func TestValidateKubeControllermanager(t *testing.T) {
 			},
 			ExpectedDetail: "experimentalClusterSigningDuration has been replaced with clusterSigningDuration as of kubernetes 1.25",
 		},
+		{
+			Input: kops.KubeControllerManagerConfig{
+				CPULimit:      resource.NewMilliQuantity(500, resource.DecimalSI),
+				MemoryRequest: resource.NewQuantity(256*1024*1024, resource.BinarySI),
+				MemoryLimit:   resource.NewQuantity(512*1024*1024, resource.BinarySI),
+			},
+		},
+		{
+			Input: kops.KubeControllerManagerConfig{
+				CPULimit: resource.NewMilliQuantity(50, resource.DecimalSI),
+			},
+			ExpectedErrors: []string{
+				"Invalid value::kubeControllerManager.cpuLimit",
+			},
+			ExpectedDetail: "must not be lower than the request of 100m",
+		},
+		{
+			Input: kops.KubeControllerManagerConfig{
+				MemoryRequest: resource.NewQuantity(512*1024*1024, resource.BinarySI),
+				MemoryLimit:   resource.NewQuantity(256*1024*1024, resource.BinarySI),
+			},
+			ExpectedErrors: []string{
+				"Invalid value::kubeControllerManager.memoryLimit",
+			},
+			ExpectedDetail: "must not be lower than the request of 512Mi",
+		},
 	}
 	for _, g := range grid {
 		if g.Cluster == nil {
@@ -405,6 +434,102 @@ func TestValidateKubeControllermanager(t *testing.T) {
 	}
 }
 
+func TestValidateKubeSchedulerProfiles(t *testing.T) {
+	grid := []struct {
+		Input          kops.KubeSchedulerConfig
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.KubeSchedulerConfig{
+				PercentageOfNodesToScore: fi.PtrTo(int32(50)),
+				Profiles: []kops.KubeSchedulerProfile{
+					{
+						Plugins: &kops.KubeSchedulerPlugins{
+							Score: &kops.KubeSchedulerPluginSet{
+								Enabled:  []kops.KubeSchedulerPlugin{{Name: "NodeResourcesFit", Weight: fi.PtrTo(int32(5))}},
+								Disabled: []kops.KubeSchedulerPlugin{{Name: "*"}},
+							},
+						},
+						PluginConfig: []kops.KubeSchedulerPluginConfig{
+							{Name: "NodeResourcesFit", Args: "scoringStrategy:\n  type: MostAllocated\n"},
+						},
+					},
+					{
+						SchedulerName: "batch-scheduler",
+					},
+				},
+			},
+		},
+		{
+			Input: kops.KubeSchedulerConfig{
+				PercentageOfNodesToScore: fi.PtrTo(int32(101)),
+				Profiles: []kops.KubeSchedulerProfile{
+					{},
+					{
+						SchedulerName:            "default-scheduler",
+						PercentageOfNodesToScore: fi.PtrTo(int32(-1)),
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::kubeScheduler.percentageOfNodesToScore",
+				"Duplicate value::kubeScheduler.profiles[1].schedulerName",
+				"Invalid value::kubeScheduler.profiles[1].percentageOfNodesToScore",
+			},
+		},
+		{
+			Input: kops.KubeSchedulerConfig{
+				Profiles: []kops.KubeSchedulerProfile{
+					{
+						Plugins: &kops.KubeSchedulerPlugins{
+							Filter: &kops.KubeSchedulerPluginSet{
+								Enabled:  []kops.KubeSchedulerPlugin{{Name: "NodePorts", Weight: fi.PtrTo(int32(1))}},
+								Disabled: []kops.KubeSchedulerPlugin{{}},
+							},
+							MultiPoint: &kops.KubeSchedulerPluginSet{
+								Enabled: []kops.KubeSchedulerPlugin{{Name: "ImageLocality", Weight: fi.PtrTo(int32(0))}},
+							},
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::kubeScheduler.profiles[0].plugins.filter.enabled[0].weight",
+				"Required value::kubeScheduler.profiles[0].plugins.filter.disabled[0].name",
+				"Invalid value::kubeScheduler.profiles[0].plugins.multiPoint.enabled[0].weight",
+			},
+		},
+		{
+			Input: kops.KubeSchedulerConfig{
+				Profiles: []kops.KubeSchedulerProfile{
+					{
+						PluginConfig: []kops.KubeSchedulerPluginConfig{
+							{Name: "NodeResourcesFit", Args: "scoringStrategy:\n  type: LeastRequested\n"},
+							{Name: "NodeResourcesFit"},
+							{Name: "PodTopologySpread", Args: "- not a map"},
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::kubeScheduler.profiles[0].pluginConfig[0].args.scoringStrategy.type",
+				"Duplicate value::kubeScheduler.profiles[0].pluginConfig[1].name",
+				"Invalid value::kubeScheduler.profiles[0].pluginConfig[2].args",
+			},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				KubernetesVersion: "1.30.0",
+			},
+		}
+		errs := validateKubeScheduler(&g.Input, cluster, field.NewPath("kubeScheduler"), true)
+
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
 func Test_Validate_Networking_Flannel(t *testing.T) {
 	grid := []struct {
 		Input          kops.FlannelNetworkingSpec
@@ -607,6 +732,30 @@ func Test_Validate_Networking_OverlappingCIDR(t *testing.T) {
 	}
 }
 
+func Test_Validate_Networking_IPv6Azure(t *testing.T) {
+	cluster := &kops.Cluster{
+		Spec: kops.ClusterSpec{
+			KubernetesVersion: "1.33.0",
+			CloudProvider: kops.CloudProviderSpec{
+				Azure: &kops.AzureSpec{},
+			},
+			Networking: kops.NetworkingSpec{
+				NonMasqueradeCIDR:     "::/0",
+				ServiceClusterIPRange: "fd00:5e4f:ce::/108",
+			},
+		},
+	}
+
+	errs := validateNetworking(cluster, &cluster.Spec.Networking, field.NewPath("networking"), true, &cloudProviderConstraints{})
+	testFieldErrors(t, errs, []*field.Error{
+		{
+			Type:   field.ErrorTypeForbidden,
+			Detail: "Azure does not support IPv6 clusters",
+			Field:  "networking.nonMasqueradeCIDR",
+		},
+	})
+}
+
 func testFieldErrors(t *testing.T, actual field.ErrorList, expectedErrors []*field.Error) {
 	t.Helper()
 
@@ -709,6 +858,105 @@ func Test_Validate_AdditionalPolicies(t *testing.T) {
 	}
 }
 
+func Test_Validate_ImageDigests(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("0123456789abcdef", 4)
+	grid := []struct {
+		Input          map[string]string
+		ExpectedErrors []string
+	}{
+		{
+			Input: map[string]string{
+				"registry.k8s.io/kube-proxy:v1.30.0": digest,
+			},
+		},
+		{
+			Input: map[string]string{
+				"registry.k8s.io/kube-proxy:v1.30.0": "0123456789abcdef",
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.imageDigests[registry.k8s.io/kube-proxy:v1.30.0]"},
+		},
+		{
+			Input: map[string]string{
+				"registry.k8s.io/kube-proxy@" + digest: digest,
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.imageDigests[registry.k8s.io/kube-proxy@" + digest + "]"},
+		},
+	}
+	for _, g := range grid {
+		clusterSpec := &kops.ClusterSpec{
+			KubernetesVersion: "1.30.0",
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+			Networking: kops.NetworkingSpec{
+				NetworkCIDR:           "10.10.0.0/16",
+				NonMasqueradeCIDR:     "100.64.0.0/10",
+				PodCIDR:               "100.96.0.0/11",
+				ServiceClusterIPRange: "100.64.0.0/13",
+				Subnets: []kops.ClusterSubnetSpec{
+					{
+						Name: "subnet1",
+						Type: kops.SubnetTypePublic,
+						CIDR: "10.10.10.0/24",
+					},
+				},
+			},
+			EtcdClusters: []kops.EtcdClusterSpec{
+				{
+					Name: "main",
+					Members: []kops.EtcdMemberSpec{
+						{
+							Name:          "us-test-1a",
+							InstanceGroup: fi.PtrTo("master-us-test-1a"),
+						},
+					},
+				},
+			},
+			Assets: &kops.AssetsSpec{
+				ImageDigests: g.Input,
+			},
+		}
+		errs := validateClusterSpec(clusterSpec, &kops.Cluster{Spec: *clusterSpec}, field.NewPath("spec"), true)
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_AssetSignatureVerification(t *testing.T) {
+	publicKey := "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEllmG5CKWOYvq0RAjEQQwT+/s08Jl\n13mi7XsYSicASWtgIXE04+UBeecMaczG3tIczUUl4ESS74xmEp3q7jpqZQ==\n-----END PUBLIC KEY-----\n"
+	grid := []struct {
+		Input          kops.AssetSignatureVerificationSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.AssetSignatureVerificationSpec{
+				PublicKeys:      []string{publicKey},
+				SignedChecksums: fi.PtrTo("https://example.com/SHA256SUMS"),
+			},
+		},
+		{
+			Input:          kops.AssetSignatureVerificationSpec{},
+			ExpectedErrors: []string{"Required value::spec.assets.signatureVerification.publicKeys"},
+		},
+		{
+			Input: kops.AssetSignatureVerificationSpec{
+				PublicKeys: []string{publicKey, "not a key"},
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.signatureVerification.publicKeys[1]"},
+		},
+		{
+			Input: kops.AssetSignatureVerificationSpec{
+				PublicKeys:      []string{publicKey},
+				SignedChecksums: fi.PtrTo("SHA256SUMS"),
+			},
+			ExpectedErrors: []string{"Invalid value::spec.assets.signatureVerification.signedChecksums"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateAssetSignatureVerification(&g.Input, field.NewPath("spec", "assets", "signatureVerification"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
 type caliInput struct {
 	Cluster *kops.ClusterSpec
 	Calico  *kops.CalicoNetworkingSpec
@@ -1851,3 +2099,795 @@ func Test_Validate_NriConfig(t *testing.T) {
 		testErrors(t, g.Input.Containerd, errs, g.ExpectedErrors)
 	}
 }
+
+func Test_Validate_Monitoring(t *testing.T) {
+	grid := []struct {
+		Input          kops.MonitoringSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.MonitoringSpec{},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				Metrics: fi.PtrTo(true),
+			},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				Metrics:         fi.PtrTo(true),
+				ServiceMonitors: fi.PtrTo(true),
+			},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				ServiceMonitors: fi.PtrTo(true),
+			},
+			ExpectedErrors: []string{"Forbidden::monitoring.serviceMonitors"},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				Metrics:         fi.PtrTo(false),
+				ServiceMonitors: fi.PtrTo(true),
+			},
+			ExpectedErrors: []string{"Forbidden::monitoring.serviceMonitors"},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				Metrics:        fi.PtrTo(true),
+				Health:         fi.PtrTo(true),
+				HealthInterval: &metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				Health: fi.PtrTo(true),
+			},
+			ExpectedErrors: []string{"Forbidden::monitoring.health"},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				Metrics:        fi.PtrTo(true),
+				Health:         fi.PtrTo(true),
+				HealthInterval: &metav1.Duration{Duration: 30 * time.Second},
+			},
+			ExpectedErrors: []string{"Invalid value::monitoring.healthInterval"},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				CertificateExpiry: &kops.CertificateExpirySpec{
+					Window: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+				},
+			},
+		},
+		{
+			Input: kops.MonitoringSpec{
+				CertificateExpiry: &kops.CertificateExpirySpec{
+					Window: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::monitoring.certificateExpiry.window"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateMonitoring(&g.Input, field.NewPath("monitoring"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_NTP(t *testing.T) {
+	grid := []struct {
+		Input          kops.NTPConfig
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.NTPConfig{
+				Servers: []string{"169.254.169.123", "time.example.com"},
+				MakeStep: &kops.NTPMakeStepSpec{
+					Threshold: &metav1.Duration{Duration: 500 * time.Millisecond},
+					Limit:     fi.PtrTo(int32(-1)),
+				},
+			},
+		},
+		{
+			Input: kops.NTPConfig{
+				Servers: []string{"time example com"},
+			},
+			ExpectedErrors: []string{"Invalid value::ntp.servers[0]"},
+		},
+		{
+			Input: kops.NTPConfig{
+				Managed: fi.PtrTo(false),
+				Servers: []string{"time.example.com"},
+			},
+			ExpectedErrors: []string{"Forbidden::ntp.servers"},
+		},
+		{
+			Input: kops.NTPConfig{
+				MakeStep: &kops.NTPMakeStepSpec{
+					Threshold: &metav1.Duration{},
+					Limit:     fi.PtrTo(int32(0)),
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::ntp.makeStep.threshold", "Invalid value::ntp.makeStep.limit"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateNTP(&g.Input, field.NewPath("ntp"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_GitOps(t *testing.T) {
+	grid := []struct {
+		Input          kops.GitOpsSpec
+		CloudProvider  kops.CloudProviderSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.GitOpsSpec{},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				Mode:     kops.GitOpsModeReconcile,
+				Interval: &metav1.Duration{Duration: 5 * time.Minute},
+				Source:   "https://raw.githubusercontent.com/example/clusters/main/dev.yaml",
+			},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				Mode:   kops.GitOpsModeDriftReport,
+				Source: "s3://example-bucket/clusters/dev.yaml",
+			},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				Mode: "Apply",
+			},
+			ExpectedErrors: []string{"Unsupported value::gitOps.mode"},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				Interval: &metav1.Duration{Duration: 10 * time.Second},
+			},
+			ExpectedErrors: []string{"Invalid value::gitOps.interval"},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				Source: "clusters/dev.yaml",
+			},
+			ExpectedErrors: []string{"Invalid value::gitOps.source"},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				Mode:             kops.GitOpsModeReconcile,
+				GrantPermissions: true,
+			},
+			CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		},
+		{
+			Input: kops.GitOpsSpec{
+				GrantPermissions: true,
+			},
+			CloudProvider:  kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			ExpectedErrors: []string{"Forbidden::gitOps.grantPermissions"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: kops.ClusterSpec{CloudProvider: g.CloudProvider}}
+		errs := validateGitOps(cluster, &g.Input, field.NewPath("gitOps"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_ConnectivityProbes(t *testing.T) {
+	grid := []struct {
+		Input          kops.ConnectivityProbesSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.ConnectivityProbesSpec{},
+		},
+		{
+			Input: kops.ConnectivityProbesSpec{
+				Interval:          &metav1.Duration{Duration: 30 * time.Second},
+				Timeout:           &metav1.Duration{Duration: 2 * time.Second},
+				AdditionalTargets: []string{"proxy.example.com:3128", "[2001:db8::1]:443"},
+			},
+		},
+		{
+			Input: kops.ConnectivityProbesSpec{
+				Interval: &metav1.Duration{Duration: time.Second},
+			},
+			ExpectedErrors: []string{"Invalid value::connectivityProbes.interval"},
+		},
+		{
+			Input: kops.ConnectivityProbesSpec{
+				Timeout: &metav1.Duration{Duration: 2 * time.Minute},
+			},
+			ExpectedErrors: []string{"Invalid value::connectivityProbes.timeout"},
+		},
+		{
+			Input: kops.ConnectivityProbesSpec{
+				AdditionalTargets: []string{"proxy.example.com", "proxy.example.com:http"},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::connectivityProbes.additionalTargets[0]",
+				"Invalid value::connectivityProbes.additionalTargets[1]",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateConnectivityProbes(&g.Input, field.NewPath("connectivityProbes"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_Hibernation(t *testing.T) {
+	grid := []struct {
+		CloudProvider  kops.CloudProviderSpec
+		Input          kops.HibernationSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.HibernationSpec{
+				Schedule:       "0 20 * * 1-5",
+				ResumeSchedule: "0 7 * * MON-FRI",
+				TimeZone:       "Europe/Paris",
+			},
+		},
+		{
+			Input: kops.HibernationSpec{
+				Schedule: "0 20 * * 1-5",
+			},
+			ExpectedErrors: []string{"Required value::hibernation.resumeSchedule"},
+		},
+		{
+			Input: kops.HibernationSpec{
+				Schedule:       "@daily",
+				ResumeSchedule: "0 7 * * 1-5 2026",
+			},
+			ExpectedErrors: []string{
+				"Invalid value::hibernation.schedule",
+				"Invalid value::hibernation.resumeSchedule",
+			},
+		},
+		{
+			Input: kops.HibernationSpec{
+				Schedule:       "0 20 * * *",
+				ResumeSchedule: "0 20 * * *",
+				TimeZone:       "Mars/Olympus_Mons",
+			},
+			ExpectedErrors: []string{
+				"Invalid value::hibernation.resumeSchedule",
+				"Invalid value::hibernation.timeZone",
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			Input: kops.HibernationSpec{
+				Schedule:       "0 20 * * 1-5",
+				ResumeSchedule: "0 7 * * 1-5",
+			},
+			ExpectedErrors: []string{"Forbidden::hibernation"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{}
+		cluster.Spec.CloudProvider = g.CloudProvider
+		if cluster.Spec.CloudProvider.GCE == nil {
+			cluster.Spec.CloudProvider.AWS = &kops.AWSSpec{}
+		}
+		errs := validateHibernation(cluster, &g.Input, field.NewPath("hibernation"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_Notifications(t *testing.T) {
+	grid := []struct {
+		Input          kops.NotificationsSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.NotificationsSpec{
+				Sinks: []kops.NotificationSinkSpec{
+					{Name: "slack", Type: kops.NotificationSinkTypeSlack, URL: "https://hooks.slack.com/services/T0/B0/X"},
+					{Name: "sns", Type: kops.NotificationSinkTypeSNS, TopicARN: "arn:aws:sns:us-east-1:123456789012:kops-events"},
+					{Name: "eventbridge", Type: kops.NotificationSinkTypeEventBridge, EventBusARN: "arn:aws:events:us-east-1:123456789012:event-bus/default"},
+					{Name: "webhook", Type: kops.NotificationSinkTypeWebhook, URL: "https://example.com/kops", Events: []kops.NotificationEventType{kops.NotificationEventTypeApply}},
+				},
+			},
+		},
+		{
+			Input: kops.NotificationsSpec{
+				Sinks: []kops.NotificationSinkSpec{
+					{Type: kops.NotificationSinkTypeSlack},
+					{Name: "sns", Type: kops.NotificationSinkTypeSNS},
+					{Name: "sns", Type: "Email"},
+				},
+			},
+			ExpectedErrors: []string{
+				"Required value::notifications.sinks[0].name",
+				"Required value::notifications.sinks[0].url",
+				"Required value::notifications.sinks[1].topicARN",
+				"Duplicate value::notifications.sinks[2].name",
+				"Unsupported value::notifications.sinks[2].type",
+			},
+		},
+		{
+			Input: kops.NotificationsSpec{
+				Sinks: []kops.NotificationSinkSpec{
+					{Name: "webhook", Type: kops.NotificationSinkTypeWebhook, URL: "ftp://example.com", Events: []kops.NotificationEventType{"Delete"}},
+					{Name: "sns", Type: kops.NotificationSinkTypeSNS, TopicARN: "arn:aws:sqs:us-east-1:123456789012:kops-events"},
+					{Name: "eventbridge", Type: kops.NotificationSinkTypeEventBridge, EventBusARN: "arn:aws:events:us-east-1:123456789012:rule/kops"},
+				},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::notifications.sinks[0].url",
+				"Unsupported value::notifications.sinks[0].events[0]",
+				"Invalid value::notifications.sinks[1].topicARN",
+				"Invalid value::notifications.sinks[2].eventBusARN",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateNotifications(&g.Input, field.NewPath("notifications"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_ClusterAutoscalerExpander(t *testing.T) {
+	grid := []struct {
+		Expander       string
+		ExpectedErrors []string
+	}{
+		{
+			Expander: "least-waste",
+		},
+		{
+			Expander: "priority,least-waste",
+		},
+		{
+			Expander:       "priority,fastest",
+			ExpectedErrors: []string{"Unsupported value::clusterAutoscaler.expander"},
+		},
+		{
+			Expander:       "priority,random,priority",
+			ExpectedErrors: []string{"Duplicate value::clusterAutoscaler.expander"},
+		},
+		{
+			Expander:       "priority,price",
+			ExpectedErrors: []string{"Forbidden::clusterAutoscaler.expander"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{
+					AWS: &kops.AWSSpec{},
+				},
+			},
+		}
+		spec := &kops.ClusterAutoscalerConfig{
+			Expander: g.Expander,
+		}
+		errs := validateClusterAutoscaler(cluster, spec, field.NewPath("clusterAutoscaler"))
+		testErrors(t, g.Expander, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_VerticalPodAutoscaler(t *testing.T) {
+	grid := []struct {
+		Input          kops.ClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.ClusterSpec{
+				MetricsServer: &kops.MetricsServerConfig{Enabled: fi.PtrTo(true)},
+				CertManager:   &kops.CertManagerConfig{Enabled: fi.PtrTo(true)},
+				VerticalPodAutoscaler: &kops.VerticalPodAutoscalerConfig{
+					Enabled: fi.PtrTo(true),
+				},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				MetricsServer: &kops.MetricsServerConfig{Enabled: fi.PtrTo(true)},
+				VerticalPodAutoscaler: &kops.VerticalPodAutoscalerConfig{
+					Enabled:         fi.PtrTo(true),
+					RecommenderOnly: fi.PtrTo(true),
+				},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				MetricsServer: &kops.MetricsServerConfig{Enabled: fi.PtrTo(true)},
+				VerticalPodAutoscaler: &kops.VerticalPodAutoscalerConfig{
+					Enabled: fi.PtrTo(true),
+				},
+			},
+			ExpectedErrors: []string{"Forbidden::verticalPodAutoscaler.recommenderOnly"},
+		},
+		{
+			Input: kops.ClusterSpec{
+				VerticalPodAutoscaler: &kops.VerticalPodAutoscalerConfig{
+					Enabled:         fi.PtrTo(true),
+					RecommenderOnly: fi.PtrTo(true),
+				},
+			},
+			ExpectedErrors: []string{"Forbidden::verticalPodAutoscaler.enabled"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: g.Input}
+		errs := validateVerticalPodAutoscaler(cluster, g.Input.VerticalPodAutoscaler, field.NewPath("verticalPodAutoscaler"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_Konnectivity(t *testing.T) {
+	grid := []struct {
+		Input          kops.ClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.ClusterSpec{
+				Konnectivity: &kops.KonnectivityConfig{Enabled: fi.PtrTo(true)},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				API: kops.APISpec{
+					LoadBalancer: &kops.LoadBalancerAccessSpec{UseForInternalAPI: true},
+				},
+				Konnectivity: &kops.KonnectivityConfig{Enabled: fi.PtrTo(false)},
+			},
+		},
+		{
+			Input: kops.ClusterSpec{
+				API: kops.APISpec{
+					LoadBalancer: &kops.LoadBalancerAccessSpec{UseForInternalAPI: true},
+				},
+				Konnectivity: &kops.KonnectivityConfig{Enabled: fi.PtrTo(true)},
+			},
+			ExpectedErrors: []string{"Forbidden::konnectivity.enabled"},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{Spec: g.Input}
+		errs := validateKonnectivity(cluster, g.Input.Konnectivity, field.NewPath("konnectivity"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_NamespaceDefaults(t *testing.T) {
+	grid := []struct {
+		Input          kops.NamespaceDefaultsSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.NamespaceDefaultsSpec{
+				LimitRanges: []kops.NamespaceLimitRangeSpec{
+					{
+						Name:       "defaults",
+						Namespaces: []string{"default", "team-a"},
+						Limits: []corev1.LimitRangeItem{
+							{
+								Type:           corev1.LimitTypeContainer,
+								DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+							},
+						},
+					},
+				},
+				ResourceQuotas: []kops.NamespaceResourceQuotaSpec{
+					{
+						Name:       "defaults",
+						Namespaces: []string{"team-a"},
+						Hard:       corev1.ResourceList{corev1.ResourcePods: resource.MustParse("100")},
+					},
+				},
+				PriorityClasses: []kops.PriorityClassSpec{
+					{Name: "batch", Value: 100, PreemptionPolicy: fi.PtrTo(corev1.PreemptNever)},
+					{Name: "standard", Value: 1000, GlobalDefault: true},
+				},
+			},
+		},
+		{
+			Input: kops.NamespaceDefaultsSpec{
+				LimitRanges: []kops.NamespaceLimitRangeSpec{
+					{
+						Name:       "defaults",
+						Namespaces: []string{"Team_A"},
+						Limits:     []corev1.LimitRangeItem{{Type: "Node"}},
+					},
+					{
+						Name: "defaults",
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::namespaceDefaults.limitRanges[0].namespaces[0]",
+				"Unsupported value::namespaceDefaults.limitRanges[0].limits[0].type",
+				"Duplicate value::namespaceDefaults.limitRanges[1].name",
+				"Required value::namespaceDefaults.limitRanges[1].namespaces",
+				"Required value::namespaceDefaults.limitRanges[1].limits",
+			},
+		},
+		{
+			Input: kops.NamespaceDefaultsSpec{
+				ResourceQuotas: []kops.NamespaceResourceQuotaSpec{
+					{
+						Namespaces: []string{"team-a"},
+						Hard:       corev1.ResourceList{corev1.ResourcePods: resource.MustParse("-1")},
+					},
+					{
+						Name:       "empty",
+						Namespaces: []string{"team-a"},
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Required value::namespaceDefaults.resourceQuotas[0].name",
+				"Invalid value::namespaceDefaults.resourceQuotas[0].hard[pods]",
+				"Required value::namespaceDefaults.resourceQuotas[1].hard",
+			},
+		},
+		{
+			Input: kops.NamespaceDefaultsSpec{
+				PriorityClasses: []kops.PriorityClassSpec{
+					{Name: "system-critical", Value: 2000000000},
+					{Name: "first", GlobalDefault: true},
+					{Name: "second", GlobalDefault: true, PreemptionPolicy: fi.PtrTo(corev1.PreemptionPolicy("Always"))},
+					{Name: "first"},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::namespaceDefaults.priorityClasses[0].name",
+				"Invalid value::namespaceDefaults.priorityClasses[0].value",
+				"Forbidden::namespaceDefaults.priorityClasses[2].globalDefault",
+				"Unsupported value::namespaceDefaults.priorityClasses[2].preemptionPolicy",
+				"Duplicate value::namespaceDefaults.priorityClasses[3].name",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateNamespaceDefaults(&g.Input, field.NewPath("namespaceDefaults"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_CertManagerUseClusterCA(t *testing.T) {
+	grid := []struct {
+		Input          kops.CertManagerConfig
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.CertManagerConfig{
+				Enabled:      fi.PtrTo(true),
+				UseClusterCA: fi.PtrTo(true),
+			},
+		},
+		{
+			Input: kops.CertManagerConfig{
+				Enabled:      fi.PtrTo(true),
+				Managed:      fi.PtrTo(false),
+				UseClusterCA: fi.PtrTo(true),
+			},
+		},
+		{
+			Input: kops.CertManagerConfig{
+				UseClusterCA: fi.PtrTo(true),
+			},
+			ExpectedErrors: []string{"Forbidden::certManager.useClusterCA"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateCertManagerUseClusterCA(&g.Input, field.NewPath("certManager", "useClusterCA"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_AddonResources(t *testing.T) {
+	grid := []struct {
+		Input          kops.AddonResourcesSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.AddonResourcesSpec{
+				Autosize: fi.PtrTo(true),
+				Overrides: []kops.AddonResourceOverride{
+					{
+						Addon:     "coredns.addons.k8s.io",
+						Name:      "coredns",
+						Container: "coredns",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+							Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("400Mi")},
+						},
+					},
+				},
+			},
+		},
+		{
+			Input: kops.AddonResourcesSpec{
+				Overrides: []kops.AddonResourceOverride{
+					{
+						Name: "coredns",
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Required value::addonResources.overrides[0].addon",
+				"Required value::addonResources.overrides[0].container",
+			},
+		},
+		{
+			Input: kops.AddonResourcesSpec{
+				Overrides: []kops.AddonResourceOverride{
+					{
+						Addon:     "coredns.addons.k8s.io",
+						Name:      "coredns",
+						Container: "coredns",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("400Mi")},
+							Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+						},
+					},
+				},
+			},
+			ExpectedErrors: []string{"Invalid value::addonResources.overrides[0].resources.requests[memory]"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateAddonResources(&g.Input, field.NewPath("addonResources"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_Addons(t *testing.T) {
+	grid := []struct {
+		Input          []kops.AddonSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: []kops.AddonSpec{
+				{Manifest: "s3://bucket/addons/addon.yaml"},
+				{
+					Chart: &kops.HelmChartSpec{
+						Repository: "https://kubernetes-sigs.github.io/external-dns",
+						Chart:      "external-dns",
+						Version:    "1.15.0",
+						Values:     "provider:\n  name: aws\n",
+						ValuesFrom: []kops.HelmValuesSource{{SecretName: "external-dns-values"}},
+					},
+				},
+				{
+					Chart: &kops.HelmChartSpec{
+						Name:       "podinfo.example.com",
+						Repository: "oci://ghcr.io/stefanprodan/charts",
+						Chart:      "podinfo",
+						Version:    "6.7.1",
+						Namespace:  "podinfo",
+					},
+				},
+			},
+		},
+		{
+			Input: []kops.AddonSpec{
+				{
+					Manifest: "s3://bucket/addons/addon.yaml",
+					Chart:    &kops.HelmChartSpec{Repository: "ftp://example.com", Chart: "charts/podinfo"},
+				},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::addons[0].chart",
+				"Invalid value::addons[0].chart.repository",
+				"Invalid value::addons[0].chart.chart",
+				"Required value::addons[0].chart.version",
+			},
+		},
+		{
+			Input: []kops.AddonSpec{
+				{
+					Chart: &kops.HelmChartSpec{
+						Repository: "oci://ghcr.io/stefanprodan/charts",
+						Chart:      "podinfo",
+						Version:    "6.7.1",
+						Values:     "- not\n- a map\n",
+						ValuesFrom: []kops.HelmValuesSource{{Key: "values.yaml"}},
+					},
+				},
+				{
+					Chart: &kops.HelmChartSpec{
+						Repository: "oci://ghcr.io/stefanprodan/charts",
+						Chart:      "podinfo",
+						Version:    "6.7.1",
+						Namespace:  "Podinfo",
+					},
+				},
+			},
+			ExpectedErrors: []string{
+				"Invalid value::addons[0].chart.values",
+				"Required value::addons[0].chart.valuesFrom[0].secretName",
+				"Invalid value::addons[1].chart.namespace",
+				"Duplicate value::addons[1].chart.name",
+			},
+		},
+	}
+	for _, g := range grid {
+		errs := validateAddons(g.Input, field.NewPath("addons"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_AdoptAddons(t *testing.T) {
+	grid := []struct {
+		Input          []string
+		ExpectedErrors []string
+	}{
+		{
+			Input: []string{"coredns.addons.k8s.io", "metrics-server.addons.k8s.io"},
+		},
+		{
+			Input:          []string{"coredns.addons.k8s.io", ""},
+			ExpectedErrors: []string{"Required value::adoptAddons[1]"},
+		},
+		{
+			Input:          []string{"coredns.addons.k8s.io", "coredns.addons.k8s.io"},
+			ExpectedErrors: []string{"Duplicate value::adoptAddons[1]"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateAdoptAddons(g.Input, field.NewPath("adoptAddons"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
+
+func Test_Validate_EtcdQuotaAndCompaction(t *testing.T) {
+	grid := []struct {
+		Input          kops.EtcdClusterSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.EtcdClusterSpec{
+				QuotaBackendBytes:       fi.PtrTo(resource.MustParse("8Gi")),
+				AutoCompactionMode:      "periodic",
+				AutoCompactionRetention: "30m",
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				AutoCompactionRetention: "8",
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				AutoCompactionMode:      "revision",
+				AutoCompactionRetention: "10000",
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				QuotaBackendBytes:       fi.PtrTo(resource.MustParse("0")),
+				AutoCompactionMode:      "hourly",
+				AutoCompactionRetention: "1 hour",
+			},
+			ExpectedErrors: []string{
+				"Invalid value::etcdClusters[0].quotaBackendBytes",
+				"Unsupported value::etcdClusters[0].autoCompactionMode",
+				"Invalid value::etcdClusters[0].autoCompactionRetention",
+			},
+		},
+		{
+			Input: kops.EtcdClusterSpec{
+				AutoCompactionMode:      "revision",
+				AutoCompactionRetention: "1h",
+			},
+			ExpectedErrors: []string{"Invalid value::etcdClusters[0].autoCompactionRetention"},
+		},
+	}
+	for _, g := range grid {
+		errs := validateEtcdQuotaAndCompaction(g.Input, field.NewPath("etcdClusters").Index(0))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
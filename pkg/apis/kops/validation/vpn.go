@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/util/subnet"
+)
+
+func validateVPN(c *kops.Cluster, spec *kops.VPNSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	switch spec.Type {
+	case kops.VPNTypeAWSClientVPN:
+		if c.GetCloudProvider() != kops.CloudProviderAWS {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), fmt.Sprintf("%s is only supported on %s", spec.Type, kops.CloudProviderAWS)))
+		}
+	default:
+		return append(allErrs, field.NotSupported(fldPath.Child("type"), spec.Type, kops.SupportedVPNTypes))
+	}
+
+	if spec.ClientCIDR == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientCIDR"), "the address range of the VPN clients is required"))
+	} else {
+		clientCIDR, errs := parseCIDR(fldPath.Child("clientCIDR"), spec.ClientCIDR)
+		allErrs = append(allErrs, errs...)
+		if len(errs) == 0 {
+			// AWS Client VPN assigns client addresses from an IPv4 range between /12 and /22
+			ones, bits := clientCIDR.Mask.Size()
+			if bits != 32 || ones < 12 || ones > 22 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("clientCIDR"), spec.ClientCIDR, "must be an IPv4 range between /12 and /22"))
+			}
+			for _, networkCIDR := range append([]string{c.Spec.Networking.NetworkCIDR}, c.Spec.Networking.AdditionalNetworkCIDRs...) {
+				if _, cidr, err := net.ParseCIDR(networkCIDR); err == nil && subnet.Overlap(clientCIDR, cidr) {
+					allErrs = append(allErrs, field.Forbidden(fldPath.Child("clientCIDR"), fmt.Sprintf("clientCIDR %q must not overlap the network CIDR %q", spec.ClientCIDR, networkCIDR)))
+				}
+			}
+		}
+	}
+
+	if spec.ServerCertificate == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serverCertificate"), "the ARN of the ACM certificate of the VPN server is required"))
+	} else if !strings.HasPrefix(spec.ServerCertificate, "arn:") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("serverCertificate"), spec.ServerCertificate, "must be the ARN of an ACM certificate"))
+	}
+	if spec.ClientCertificateAuthority != "" && !strings.HasPrefix(spec.ClientCertificateAuthority, "arn:") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("clientCertificateAuthority"), spec.ClientCertificateAuthority, "must be the ARN of an ACM certificate"))
+	}
+
+	subnets := make(map[string]*kops.ClusterSubnetSpec)
+	hasPrivateSubnets := false
+	for i := range c.Spec.Networking.Subnets {
+		s := &c.Spec.Networking.Subnets[i]
+		subnets[s.Name] = s
+		if s.Type == kops.SubnetTypePrivate {
+			hasPrivateSubnets = true
+		}
+	}
+	zones := make(map[string]string)
+	for i, name := range spec.Subnets {
+		path := fldPath.Child("subnets").Index(i)
+		s := subnets[name]
+		if s == nil {
+			allErrs = append(allErrs, field.NotFound(path, name))
+			continue
+		}
+		if other, found := zones[s.Zone]; found {
+			allErrs = append(allErrs, field.Invalid(path, name, fmt.Sprintf("subnet %q is in the same zone %q", other, s.Zone)))
+		}
+		zones[s.Zone] = name
+	}
+	if len(spec.Subnets) == 0 && !hasPrivateSubnets {
+		allErrs = append(allErrs, field.Required(fldPath.Child("subnets"), "subnets are required when the cluster has no private subnets"))
+	}
+
+	return allErrs
+}
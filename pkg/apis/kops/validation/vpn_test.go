@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func Test_Validate_VPN(t *testing.T) {
+	certificate := "arn:aws:acm:us-east-1:123456789012:certificate/11111111-2222-3333-4444-555555555555"
+	grid := []struct {
+		CloudProvider  kops.CloudProviderSpec
+		Subnets        []kops.ClusterSubnetSpec
+		Input          kops.VPNSpec
+		ExpectedErrors []string
+	}{
+		{
+			Input: kops.VPNSpec{
+				Type:              kops.VPNTypeAWSClientVPN,
+				ClientCIDR:        "172.31.0.0/22",
+				ServerCertificate: certificate,
+			},
+		},
+		{
+			Input: kops.VPNSpec{
+				Type:                       kops.VPNTypeAWSClientVPN,
+				ClientCIDR:                 "172.16.0.0/12",
+				ServerCertificate:          certificate,
+				ClientCertificateAuthority: certificate,
+				Subnets:                    []string{"utility-us-east-1a", "us-east-1b"},
+			},
+		},
+		{
+			Input: kops.VPNSpec{
+				Type: "WireGuard",
+			},
+			ExpectedErrors: []string{
+				"Unsupported value::vpn.type",
+			},
+		},
+		{
+			CloudProvider: kops.CloudProviderSpec{GCE: &kops.GCESpec{}},
+			Input: kops.VPNSpec{
+				Type:              kops.VPNTypeAWSClientVPN,
+				ClientCIDR:        "172.31.0.0/22",
+				ServerCertificate: certificate,
+			},
+			ExpectedErrors: []string{
+				"Forbidden::vpn.type",
+			},
+		},
+		{
+			Input: kops.VPNSpec{
+				Type:                       kops.VPNTypeAWSClientVPN,
+				ClientCIDR:                 "10.0.0.0/22",
+				ServerCertificate:          "certificate",
+				ClientCertificateAuthority: "ca",
+				Subnets:                    []string{"us-east-1a", "utility-us-east-1a", "missing"},
+			},
+			ExpectedErrors: []string{
+				"Forbidden::vpn.clientCIDR",
+				"Invalid value::vpn.serverCertificate",
+				"Invalid value::vpn.clientCertificateAuthority",
+				"Invalid value::vpn.subnets[1]",
+				"Not found::vpn.subnets[2]",
+			},
+		},
+		{
+			Subnets: []kops.ClusterSubnetSpec{
+				{Name: "us-east-1a", Zone: "us-east-1a", Type: kops.SubnetTypePublic},
+			},
+			Input: kops.VPNSpec{
+				Type:       kops.VPNTypeAWSClientVPN,
+				ClientCIDR: "172.31.0.0/24",
+			},
+			ExpectedErrors: []string{
+				"Invalid value::vpn.clientCIDR",
+				"Required value::vpn.serverCertificate",
+				"Required value::vpn.subnets",
+			},
+		},
+	}
+	for _, g := range grid {
+		cluster := &kops.Cluster{
+			Spec: kops.ClusterSpec{
+				CloudProvider: kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+				Networking: kops.NetworkingSpec{
+					NetworkCIDR: "10.0.0.0/16",
+					Subnets: []kops.ClusterSubnetSpec{
+						{Name: "us-east-1a", Zone: "us-east-1a", Type: kops.SubnetTypePrivate},
+						{Name: "us-east-1b", Zone: "us-east-1b", Type: kops.SubnetTypePrivate},
+						{Name: "utility-us-east-1a", Zone: "us-east-1a", Type: kops.SubnetTypeUtility},
+					},
+				},
+			},
+		}
+		if g.CloudProvider != (kops.CloudProviderSpec{}) {
+			cluster.Spec.CloudProvider = g.CloudProvider
+		}
+		if g.Subnets != nil {
+			cluster.Spec.Networking.Subnets = g.Subnets
+		}
+		errs := validateVPN(cluster, &g.Input, field.NewPath("vpn"))
+		testErrors(t, g.Input, errs, g.ExpectedErrors)
+	}
+}
@@ -31,6 +31,8 @@ type Config struct {
 	// Assets are locations where we can find files to be installed
 	// TODO: Remove once everything is in containers?
 	Assets map[architectures.Architecture][]string `json:",omitempty"`
+	// AssetSignatureVerification requires the Assets to be signed by one of the trusted keys.
+	AssetSignatureVerification *kops.AssetSignatureVerificationSpec `json:"assetSignatureVerification,omitempty"`
 	// Images are a list of images we should preload
 	Images map[architectures.Architecture][]*Image `json:"images,omitempty"`
 	// ClusterName is the name of the cluster
@@ -77,17 +79,33 @@ type Config struct {
 	UsesKubenet bool `json:",omitempty"`
 	// NTPUnmanaged is true when NTP is not managed by kOps.
 	NTPUnmanaged bool `json:",omitempty"`
+	// NTPServers are the NTP servers to use instead of the NTP service of the cloud provider.
+	NTPServers []string `json:",omitempty"`
+	// NTPMakeStep configures the stepping of the clock by chrony.
+	NTPMakeStep *kops.NTPMakeStepSpec `json:",omitempty"`
+	// ComponentMetrics is true when kOps-managed components should serve Prometheus metrics.
+	ComponentMetrics bool `json:",omitempty"`
 	// ServiceNodePortRange is the service NodePort range.
 	ServiceNodePortRange string `json:",omitempty"`
 	// SysctlParameters will configure kernel parameters using sysctl(8).
 	SysctlParameters []string `json:",omitempty"`
+	// Journald configures the limits of the systemd journal.
+	Journald *kops.JournaldSpec `json:",omitempty"`
+	// EtcdIOIsolation isolates the IO of the etcd volumes from the other workloads of the instance.
+	EtcdIOIsolation *kops.EtcdIOIsolationSpec `json:",omitempty"`
 	// UpdatePolicy determines the policy for applying upgrades automatically.
 	UpdatePolicy string
 	// VolumeMounts are a collection of volume mounts.
 	VolumeMounts []kops.VolumeMountSpec `json:",omitempty"`
+	// GrowFilesystems are the mount points whose partition and filesystem are grown when their disk is resized.
+	GrowFilesystems []string `json:",omitempty"`
 
 	// FileAssets are a collection of file assets for this instance group.
 	FileAssets []kops.FileAssetSpec `json:",omitempty"`
+	// SSHCertificateAuthorities are the SSH certificate authorities to trust, in authorized_keys format.
+	SSHCertificateAuthorities []string `json:"sshCertificateAuthorities,omitempty"`
+	// SSHAuthorizedKeys are additional SSH public keys that can log in to the instance.
+	SSHAuthorizedKeys []kops.SSHAuthorizedKeysSpec `json:"sshAuthorizedKeys,omitempty"`
 	// Hooks are for custom actions, for example on first installation.
 	Hooks [][]kops.HookSpec
 	// ContainerdConfig holds the configuration for containerd.
@@ -204,6 +222,8 @@ type APIServerConfig struct {
 	EncryptionConfigSecretHash string `json:",omitempty"`
 	// ServiceAccountPublicKeys are the service-account public keys to trust.
 	ServiceAccountPublicKeys string
+	// PodSecurity is a copy of the PodSecurityAdmissionSpec from the cluster spec.
+	PodSecurity *kops.PodSecurityAdmissionSpec `json:",omitempty"`
 }
 
 // ControlPlaneConfig is additional configuration for control-plane nodes.
@@ -234,10 +254,17 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 		VolumeMounts:         instanceGroup.Spec.VolumeMounts,
 		FileAssets:           append(filterFileAssets(instanceGroup.Spec.FileAssets, role), filterFileAssets(cluster.Spec.FileAssets, role)...),
 		Hooks:                [][]kops.HookSpec{igHooks, clusterHooks},
+		SSHAuthorizedKeys:    instanceGroup.Spec.SSHAuthorizedKeys,
+		Journald:             instanceGroup.Spec.Journald,
+		EtcdIOIsolation:      instanceGroup.Spec.EtcdIOIsolation,
 		UsesLegacyGossip:     cluster.UsesLegacyGossip(),
 		UsesNoneDNS:          cluster.UsesNoneDNS(),
 	}
 
+	if cluster.Spec.Assets != nil {
+		config.AssetSignatureVerification = cluster.Spec.Assets.SignatureVerification
+	}
+
 	bootConfig := BootConfig{
 		CloudProvider:     cluster.GetCloudProvider(),
 		ClusterName:       cluster.ObjectMeta.Name,
@@ -255,8 +282,16 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 
 	config.KubeProxy = buildKubeProxy(cluster, instanceGroup)
 
-	if cluster.Spec.NTP != nil && cluster.Spec.NTP.Managed != nil && !*cluster.Spec.NTP.Managed {
-		config.NTPUnmanaged = true
+	if cluster.Spec.NTP != nil {
+		if cluster.Spec.NTP.Managed != nil && !*cluster.Spec.NTP.Managed {
+			config.NTPUnmanaged = true
+		}
+		config.NTPServers = cluster.Spec.NTP.Servers
+		config.NTPMakeStep = cluster.Spec.NTP.MakeStep
+	}
+
+	if cluster.Spec.Monitoring.MetricsEnabled() {
+		config.ComponentMetrics = true
 	}
 
 	if cluster.Spec.CloudProvider.AWS != nil {
@@ -266,6 +301,13 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 			config.EnableLifecycleHook = true
 		}
 
+		if aws.ResizeVolumesInPlace != nil && *aws.ResizeVolumesInPlace {
+			config.GrowFilesystems = []string{"/"}
+			for _, volumeMount := range instanceGroup.Spec.VolumeMounts {
+				config.GrowFilesystems = append(config.GrowFilesystems, volumeMount.Path)
+			}
+		}
+
 		if instanceGroup.HasAPIServer() {
 			config.DisableSecurityGroupIngress = aws.DisableSecurityGroupIngress
 			config.ElbSecurityGroup = aws.ElbSecurityGroup
@@ -358,6 +400,9 @@ func NewConfig(cluster *kops.Cluster, instanceGroup *kops.InstanceGroup) (*Confi
 				config.APIServerConfig.Authentication.AWS = &kops.AWSAuthenticationSpec{}
 			}
 		}
+		if cluster.Spec.Admission != nil {
+			config.APIServerConfig.PodSecurity = cluster.Spec.Admission.PodSecurity
+		}
 		if cluster.Spec.API.DNS != nil {
 			config.APIServerConfig.API.DNS = &kops.DNSAccessSpec{}
 		}
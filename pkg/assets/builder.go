@@ -101,6 +101,8 @@ type ImageAsset struct {
 	DownloadLocation string
 	// CanonicalLocation will be the source location of the image.
 	CanonicalLocation string
+	// Digest is the digest the image is pinned to, if any.
+	Digest string
 }
 
 // FileAsset models a file's location.
@@ -224,23 +226,37 @@ func (a *AssetBuilder) RemapImage(image string) (string, error) {
 
 	a.ImageAssets = append(a.ImageAssets, asset)
 
-	if !featureflag.ImageDigest.Enabled() || os.Getenv("KOPS_BASE_URL") != "" {
+	if _, digest, found := strings.Cut(image, "@"); found {
+		asset.Digest = digest
 		return image, nil
 	}
 
-	if strings.Contains(image, "@") {
+	if a.AssetsLocation != nil {
+		if digest := a.AssetsLocation.ImageDigests[asset.CanonicalLocation]; digest != "" {
+			asset.Digest = digest
+			return image + "@" + digest, nil
+		}
+	}
+
+	if !featureflag.ImageDigest.Enabled() || os.Getenv("KOPS_BASE_URL") != "" {
 		return image, nil
 	}
 
-	digest, err := crane.Digest(image, crane.WithAuthFromKeychain(authn.DefaultKeychain))
+	digest, err := ResolveImageDigest(image)
 	if err != nil {
 		klog.Warningf("failed to digest image %q: %s", image, err)
 		return image, nil
 	}
+	asset.Digest = digest
 
 	return image + "@" + digest, nil
 }
 
+// ResolveImageDigest looks up the digest of the image in its registry.
+func ResolveImageDigest(image string) (string, error) {
+	return crane.Digest(image, crane.WithAuthFromKeychain(authn.DefaultKeychain))
+}
+
 // RemapFile returns a remapped URL for the file, if AssetsLocation is defined.
 // It is returns in a FileAsset, alongside the SHA hash of the file.
 // The SHA hash is is knownHash is provided, and otherwise will be found first by
@@ -151,6 +151,41 @@ func TestValidate_RemapImage_ContainerRegistry_MappingMultipleTimesConverges(t *
 	}
 }
 
+func TestValidate_RemapImage_ImageDigests_PinsCanonicalImage(t *testing.T) {
+	builder := buildAssetBuilder(t)
+
+	mirrorURL := "proxy.example.com"
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	image := "registry.k8s.io/kube-apiserver:1.2.3"
+	expected := "proxy.example.com/kube-apiserver:1.2.3@" + digest
+
+	builder.AssetsLocation.ContainerRegistry = &mirrorURL
+	builder.AssetsLocation.ImageDigests = map[string]string{image: digest}
+
+	remapped, err := builder.RemapImage(image)
+	if err != nil {
+		t.Error("Error remapping image", err)
+	}
+
+	if remapped != expected {
+		t.Errorf("Error remapping image (Expecting: %s, got %s)", expected, remapped)
+	}
+
+	if asset := builder.ImageAssets[0]; asset.Digest != digest {
+		t.Errorf("Error recording digest (Expecting: %s, got %s)", digest, asset.Digest)
+	}
+
+	// Remapping the pinned image again leaves it unchanged
+	remapped, err = builder.RemapImage(remapped)
+	if err != nil {
+		t.Error("Error remapping image", err)
+	}
+
+	if remapped != expected {
+		t.Errorf("Error remapping image (Expecting: %s, got %s)", expected, remapped)
+	}
+}
+
 func TestRemapEmptySection(t *testing.T) {
 	builder := buildAssetBuilder(t)
 
@@ -22,6 +22,7 @@ import (
 
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/util/pkg/signature"
 	"k8s.io/kops/util/pkg/vfs"
 )
 
@@ -32,12 +33,23 @@ type assetTask interface {
 func Copy(imageAssets []*ImageAsset, fileAssets []*FileAsset, vfsContext *vfs.VFSContext, cluster *kops.Cluster) error {
 	tasks := map[string]assetTask{}
 
+	var verifier *signature.Verifier
+	var signedChecksums signature.Checksums
+	if cluster.Spec.Assets != nil && cluster.Spec.Assets.SignatureVerification != nil {
+		var err error
+		verifier, signedChecksums, err = buildSignatureVerification(vfsContext, cluster.Spec.Assets.SignatureVerification)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, imageAsset := range imageAssets {
 		if imageAsset.DownloadLocation != imageAsset.CanonicalLocation {
 			copyImageTask := &CopyImage{
 				Name:        imageAsset.DownloadLocation,
 				SourceImage: imageAsset.CanonicalLocation,
 				TargetImage: imageAsset.DownloadLocation,
+				Verifier:    verifier,
 			}
 
 			if existing, ok := tasks[copyImageTask.Name]; ok {
@@ -59,6 +71,9 @@ func Copy(imageAssets []*ImageAsset, fileAssets []*FileAsset, vfsContext *vfs.VF
 				SHA:        fileAsset.SHAValue.Hex(),
 				VFSContext: vfsContext,
 				Cluster:    cluster,
+
+				Verifier:        verifier,
+				SignedChecksums: signedChecksums,
 			}
 
 			if existing, ok := tasks[copyFileTask.Name]; ok {
@@ -119,3 +134,33 @@ func Copy(imageAssets []*ImageAsset, fileAssets []*FileAsset, vfsContext *vfs.VF
 	}
 	return nil
 }
+
+// buildSignatureVerification builds the verifier for the trusted keys, and verifies and parses the signed checksums if set.
+func buildSignatureVerification(vfsContext *vfs.VFSContext, spec *kops.AssetSignatureVerificationSpec) (*signature.Verifier, signature.Checksums, error) {
+	verifier, err := signature.NewVerifier(spec.PublicKeys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building asset signature verifier: %w", err)
+	}
+
+	if spec.SignedChecksums == nil {
+		return verifier, nil, nil
+	}
+
+	checksumsURL := *spec.SignedChecksums
+	data, err := vfsContext.ReadFile(checksumsURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error downloading checksums file %q: %w", checksumsURL, err)
+	}
+	sig, err := vfsContext.ReadFile(checksumsURL + ".sig")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error downloading signature of checksums file %q: %w", checksumsURL, err)
+	}
+	if err := verifier.Verify(data, sig); err != nil {
+		return nil, nil, fmt.Errorf("signature verification of %q failed: %w", checksumsURL, err)
+	}
+	checksums, err := signature.ParseChecksums(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing checksums file %q: %w", checksumsURL, err)
+	}
+	return verifier, checksums, nil
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/kops/pkg/acls"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/util/pkg/hashing"
+	"k8s.io/kops/util/pkg/signature"
 	"k8s.io/kops/util/pkg/vfs"
 )
 
@@ -40,6 +41,11 @@ type CopyFile struct {
 	SHA        string
 	VFSContext *vfs.VFSContext
 	Cluster    *kops.Cluster
+	// Verifier, if set, requires the source file to be signed with one of its keys.
+	Verifier *signature.Verifier
+	// SignedChecksums, if set, are the verified hashes of the source files.
+	// Otherwise each source file must have a detached signature, which is copied alongside it.
+	SignedChecksums signature.Checksums
 }
 
 // fileExtensionForSHA returns the expected extension for the given hash
@@ -78,7 +84,7 @@ func (e *CopyFile) Run() error {
 	} else {
 		targetSHA := string(targetSHABytes)
 
-		if strings.TrimSpace(targetSHA) == expectedSHA {
+		if strings.TrimSpace(targetSHA) == expectedSHA && e.targetSignaturePresent() {
 			klog.V(8).Infof("found matching target sha for file: %q", e.TargetFile)
 			return nil
 		}
@@ -92,16 +98,25 @@ func (e *CopyFile) Run() error {
 
 	klog.V(2).Infof("copying bits from %q to %q", source, target)
 
-	if err := transferFile(ctx, e.VFSContext, e.Cluster, source, target, sourceSha); err != nil {
+	if err := transferFile(ctx, e.VFSContext, e.Cluster, source, target, sourceSha, e.Verifier, e.SignedChecksums); err != nil {
 		return fmt.Errorf("unable to transfer %q to %q: %v", source, target, err)
 	}
 
 	return nil
 }
 
-// transferFile downloads a file from the source location, validates the file matches the SHA,
-// and uploads the file to the target location.
-func transferFile(ctx context.Context, vfsContext *vfs.VFSContext, cluster *kops.Cluster, source string, target string, sha string) error {
+// targetSignaturePresent returns false if the target file needs a detached signature that has not been copied yet.
+func (e *CopyFile) targetSignaturePresent() bool {
+	if e.Verifier == nil || e.SignedChecksums != nil {
+		return true
+	}
+	_, err := e.VFSContext.ReadFile(e.TargetFile + ".sig")
+	return err == nil
+}
+
+// transferFile downloads a file from the source location, validates the file matches the SHA
+// and, if a verifier is set, is signed, and uploads the file to the target location.
+func transferFile(ctx context.Context, vfsContext *vfs.VFSContext, cluster *kops.Cluster, source string, target string, sha string, verifier *signature.Verifier, signedChecksums signature.Checksums) error {
 	// TODO drop file to disk, as vfs reads file into memory.  We load kubelet into memory for instance.
 	// TODO in s3 can we do a copy file ... would need to test
 
@@ -150,6 +165,27 @@ func transferFile(ctx context.Context, vfsContext *vfs.VFSContext, cluster *kops
 		return fmt.Errorf("the sha value in %q does not match %q calculated value %q", shaTarget, source, dataHash.String())
 	}
 
+	var sig []byte
+	if verifier != nil {
+		if signedChecksums != nil {
+			sha256Hash, err := hashing.HashAlgorithmSHA256.Hash(bytes.NewReader(data))
+			if err != nil {
+				return fmt.Errorf("unable to hash file %q downloaded: %v", source, err)
+			}
+			if err := signedChecksums.Check(source, sha256Hash.Hex()); err != nil {
+				return fmt.Errorf("signature verification of %q failed: %w", source, err)
+			}
+		} else {
+			sig, err = vfsContext.ReadFile(source + ".sig")
+			if err != nil {
+				return fmt.Errorf("signature verification of %q failed: error downloading signature: %w", source, err)
+			}
+			if err := verifier.Verify(data, sig); err != nil {
+				return fmt.Errorf("signature verification of %q failed: %w", source, err)
+			}
+		}
+	}
+
 	klog.Infof("uploading %q to %q", source, objectStore)
 	if err := writeFile(ctx, cluster, uploadVFS, data); err != nil {
 		return err
@@ -160,6 +196,17 @@ func transferFile(ctx context.Context, vfsContext *vfs.VFSContext, cluster *kops
 		return err
 	}
 
+	if sig != nil {
+		sigTarget := objectStore + ".sig"
+		sigVFS, err := vfsContext.BuildVfsPath(sigTarget)
+		if err != nil {
+			return fmt.Errorf("error building path %q: %v", sigTarget, err)
+		}
+		if err := writeFile(ctx, cluster, sigVFS, sig); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
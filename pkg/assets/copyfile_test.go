@@ -17,7 +17,21 @@ limitations under the License.
 package assets
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
 	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/testutils/testcontext"
+	"k8s.io/kops/util/pkg/signature"
+	"k8s.io/kops/util/pkg/vfs"
 )
 
 func Test_BuildVFSPath(t *testing.T) {
@@ -80,3 +94,89 @@ func Test_BuildVFSPath(t *testing.T) {
 		}
 	}
 }
+
+func Test_TransferFile_VerifiesSignature(t *testing.T) {
+	ctx := testcontext.ForTest(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("error marshalling public key: %v", err)
+	}
+	verifier, err := signature.NewVerifier([]string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))})
+	if err != nil {
+		t.Fatalf("error building verifier: %v", err)
+	}
+
+	data := []byte("kubelet")
+	digest := sha256.Sum256(data)
+	sha := hex.EncodeToString(digest[:])
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("error signing: %v", err)
+	}
+
+	grid := []struct {
+		name      string
+		signature []byte
+		expectErr bool
+	}{
+		{
+			name:      "valid signature",
+			signature: []byte(base64.StdEncoding.EncodeToString(sig)),
+		},
+		{
+			name:      "invalid signature",
+			signature: []byte(base64.StdEncoding.EncodeToString([]byte("invalid"))),
+			expectErr: true,
+		},
+		{
+			name:      "missing signature",
+			expectErr: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			vfsContext := vfs.NewVFSContext()
+			vfsContext.ResetMemfsContext(true)
+
+			files := map[string][]byte{"memfs://source/kubelet": data}
+			if g.signature != nil {
+				files["memfs://source/kubelet.sig"] = g.signature
+			}
+			for k, v := range files {
+				p, err := vfsContext.BuildVfsPath(k)
+				if err != nil {
+					t.Fatalf("error building vfs path for %s: %v", k, err)
+				}
+				if err := p.WriteFile(ctx, bytes.NewReader(v), nil); err != nil {
+					t.Fatalf("error writing vfs path %s: %v", k, err)
+				}
+			}
+
+			err := transferFile(ctx, vfsContext, &kops.Cluster{}, "memfs://source/kubelet", "memfs://target/kubelet", sha, verifier, nil)
+			if g.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				if _, err := vfsContext.ReadFile("memfs://target/kubelet"); err == nil {
+					t.Errorf("unverified file was copied")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			copied, err := vfsContext.ReadFile("memfs://target/kubelet.sig")
+			if err != nil {
+				t.Fatalf("signature was not copied: %v", err)
+			}
+			if !bytes.Equal(copied, g.signature) {
+				t.Errorf("unexpected copied signature %q", copied)
+			}
+		})
+	}
+}
@@ -17,21 +17,31 @@ limitations under the License.
 package assets
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"k8s.io/klog/v2"
+	"k8s.io/kops/util/pkg/signature"
 )
 
+// cosignSignatureAnnotation is the layer annotation holding the signature of a cosign simple signing payload.
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
 // CopyImage copies a docker image from a source registry, to a target registry,
 // typically used for highly secure clusters.
 type CopyImage struct {
 	Name        string
 	SourceImage string
 	TargetImage string
+	// Verifier, if set, requires the source image to have a cosign signature made with one of its keys.
+	Verifier *signature.Verifier
 }
 
 func (e *CopyImage) Run() error {
@@ -55,6 +65,13 @@ func (e *CopyImage) Run() error {
 		return fmt.Errorf("fetching %q: %v", source, err)
 	}
 
+	if e.Verifier != nil {
+		// Verify before the shortcut below, so a previously copied image is still checked
+		if err := copyImageSignature(e.Verifier, desc.Digest, sourceRef, targetRef, options...); err != nil {
+			return fmt.Errorf("verifying signature of %q: %w", source, err)
+		}
+	}
+
 	targetDesc, err := remote.Get(targetRef, options...)
 	if err == nil && desc.Digest.String() == targetDesc.Digest.String() {
 		klog.Infof("no need to copy image from %v to %v", sourceRef, targetRef)
@@ -96,3 +113,90 @@ func copyIndex(desc *remote.Descriptor, sourceRef name.Reference, targetRef name
 	}
 	return remote.WriteIndex(targetRef, idx, options...)
 }
+
+// cosignSignatureTag returns the tag that cosign stores the signatures of the image digest under.
+func cosignSignatureTag(repository name.Repository, digest v1.Hash) name.Tag {
+	return repository.Tag(digest.Algorithm + "-" + digest.Hex + ".sig")
+}
+
+// copyImageSignature verifies the cosign signature of the source image digest,
+// and copies the signature alongside the target image so it can be verified there too.
+func copyImageSignature(verifier *signature.Verifier, digest v1.Hash, sourceRef name.Reference, targetRef name.Reference, options ...remote.Option) error {
+	sourceTag := cosignSignatureTag(sourceRef.Context(), digest)
+	sigImage, err := remote.Image(sourceTag, options...)
+	if err != nil {
+		return fmt.Errorf("fetching signature %q: %w", sourceTag, err)
+	}
+
+	manifest, err := sigImage.Manifest()
+	if err != nil {
+		return fmt.Errorf("reading signature manifest %q: %w", sourceTag, err)
+	}
+
+	var errs []string
+	verified := false
+	for _, layer := range manifest.Layers {
+		sig, found := layer.Annotations[cosignSignatureAnnotation]
+		if !found {
+			continue
+		}
+		payload, err := readLayer(sigImage, layer.Digest)
+		if err != nil {
+			return fmt.Errorf("reading signature payload from %q: %w", sourceTag, err)
+		}
+		if err := verifySimpleSigningPayload(verifier, payload, []byte(sig), digest); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		verified = true
+		break
+	}
+	if !verified {
+		if len(errs) == 0 {
+			return fmt.Errorf("no signatures found in %q", sourceTag)
+		}
+		return fmt.Errorf("no valid signatures found in %q: %s", sourceTag, strings.Join(errs, "; "))
+	}
+
+	targetTag := cosignSignatureTag(targetRef.Context(), digest)
+	klog.Infof("copying image signature from %v to %v", sourceTag, targetTag)
+	return remote.Write(targetTag, sigImage, options...)
+}
+
+func readLayer(img v1.Image, digest v1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+	r, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// simpleSigningPayload is the part of the cosign simple signing payload that identifies the signed image.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifySimpleSigningPayload verifies the signature of a cosign payload, and that the payload is for the digest.
+func verifySimpleSigningPayload(verifier *signature.Verifier, payload []byte, sig []byte, digest v1.Hash) error {
+	if err := verifier.Verify(payload, sig); err != nil {
+		return err
+	}
+
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("parsing signature payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest.String() {
+		return fmt.Errorf("signature is for digest %q, not %q", p.Critical.Image.DockerManifestDigest, digest)
+	}
+	return nil
+}
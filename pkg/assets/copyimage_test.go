@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assets
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"k8s.io/kops/util/pkg/signature"
+)
+
+func Test_CosignSignatureTag(t *testing.T) {
+	repository, err := name.NewRepository("registry.k8s.io/kops/kops-controller")
+	if err != nil {
+		t.Fatalf("error parsing repository: %v", err)
+	}
+	digest := v1.Hash{Algorithm: "sha256", Hex: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"}
+
+	tag := cosignSignatureTag(repository, digest)
+	expected := "registry.k8s.io/kops/kops-controller:sha256-0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef.sig"
+	if tag.String() != expected {
+		t.Errorf("expected %q, got %q", expected, tag.String())
+	}
+}
+
+func Test_VerifySimpleSigningPayload(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("error marshalling public key: %v", err)
+	}
+	verifier, err := signature.NewVerifier([]string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))})
+	if err != nil {
+		t.Fatalf("error building verifier: %v", err)
+	}
+
+	sign := func(payload string) []byte {
+		digest := sha256.Sum256([]byte(payload))
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatalf("error signing: %v", err)
+		}
+		return []byte(base64.StdEncoding.EncodeToString(sig))
+	}
+
+	digest := v1.Hash{Algorithm: "sha256", Hex: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"}
+	payload := `{"critical":{"identity":{"docker-reference":"registry.k8s.io/kops/kops-controller"},"image":{"docker-manifest-digest":"` + digest.String() + `"},"type":"cosign container image signature"},"optional":null}`
+	otherPayload := `{"critical":{"image":{"docker-manifest-digest":"sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"}}}`
+
+	grid := []struct {
+		name      string
+		payload   string
+		signature []byte
+		expectErr bool
+	}{
+		{
+			name:      "valid",
+			payload:   payload,
+			signature: sign(payload),
+		},
+		{
+			name:      "signature of another payload",
+			payload:   payload,
+			signature: sign(otherPayload),
+			expectErr: true,
+		},
+		{
+			name:      "payload for another digest",
+			payload:   otherPayload,
+			signature: sign(otherPayload),
+			expectErr: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			err := verifySimpleSigningPayload(verifier, []byte(g.payload), g.signature, digest)
+			if g.expectErr && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !g.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// ResolveImageArchitectures looks up the linux architectures the image is built for in its registry,
+// from its image index for a multi-architecture image, or from its config otherwise.
+func ResolveImageArchitectures(image string) ([]string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, fmt.Errorf("parsing image reference %q: %w", image, err)
+	}
+
+	desc, err := remote.Get(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("getting image %q: %w", image, err)
+	}
+
+	switch desc.MediaType {
+	case types.OCIImageIndex, types.DockerManifestList:
+		index, err := v1.ParseIndexManifest(bytes.NewReader(desc.Manifest))
+		if err != nil {
+			return nil, fmt.Errorf("parsing index of image %q: %w", image, err)
+		}
+		return indexArchitectures(index), nil
+	default:
+		img, err := desc.Image()
+		if err != nil {
+			return nil, fmt.Errorf("reading image %q: %w", image, err)
+		}
+		config, err := img.ConfigFile()
+		if err != nil {
+			return nil, fmt.Errorf("reading config of image %q: %w", image, err)
+		}
+		return []string{config.Architecture}, nil
+	}
+}
+
+// indexArchitectures returns the linux architectures of the manifests of an image index.
+// Manifests without a platform, such as attestations, are skipped.
+func indexArchitectures(index *v1.IndexManifest) []string {
+	seen := make(map[string]bool)
+	var architectures []string
+	for _, manifest := range index.Manifests {
+		if manifest.Platform == nil || manifest.Platform.OS != "linux" || seen[manifest.Platform.Architecture] {
+			continue
+		}
+		seen[manifest.Platform.Architecture] = true
+		architectures = append(architectures, manifest.Platform.Architecture)
+	}
+	sort.Strings(architectures)
+	return architectures
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assets
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+func Test_IndexArchitectures(t *testing.T) {
+	index, err := v1.ParseIndexManifest(strings.NewReader(`{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.oci.image.index.v1+json",
+  "manifests": [
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 1, "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000001", "platform": {"os": "linux", "architecture": "arm64", "variant": "v8"}},
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 1, "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000002", "platform": {"os": "linux", "architecture": "amd64"}},
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 1, "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000003", "platform": {"os": "windows", "architecture": "s390x"}},
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 1, "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000004", "platform": {"os": "unknown", "architecture": "unknown"}},
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 1, "digest": "sha256:0000000000000000000000000000000000000000000000000000000000000005", "platform": {"os": "linux", "architecture": "arm64", "variant": "v9"}}
+  ]
+}`))
+	if err != nil {
+		t.Fatalf("error parsing index: %v", err)
+	}
+
+	expected := []string{"amd64", "arm64"}
+	if actual := indexArchitectures(index); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certinventory finds the certificates on a node, so that kops-controller can report
+// those about to expire and kops get certs can list them.
+package certinventory
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// NodeAnnotation is the annotation of a node holding the JSON list of its certificates.
+const NodeAnnotation = "kops.k8s.io/certificates"
+
+// certificateExtensions are the extensions of the files holding certificates
+var certificateExtensions = []string{".crt", ".pem", ".cert"}
+
+// Certificate describes a certificate found on a node.
+type Certificate struct {
+	// Path is the file holding the certificate. A file may hold several certificates, such as a CA bundle.
+	Path      string    `json:"path"`
+	Subject   string    `json:"subject,omitempty"`
+	Issuer    string    `json:"issuer,omitempty"`
+	IsCA      bool      `json:"isCA,omitempty"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+}
+
+// Scan returns the certificates in the files below the directories, sorted by path and expiry.
+// Directories which do not exist, and files which cannot be read or hold no certificate, are skipped.
+func Scan(dirs []string) ([]Certificate, error) {
+	var certificates []Certificate
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrPermission) {
+					klog.V(2).Infof("skipping %q: %v", path, err)
+					if d != nil && d.IsDir() {
+						return fs.SkipDir
+					}
+					return nil
+				}
+				return err
+			}
+			if !d.Type().IsRegular() || !hasCertificateExtension(path) {
+				return nil
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				klog.V(2).Infof("skipping %q: %v", path, err)
+				return nil
+			}
+			certificates = append(certificates, parseCertificates(path, data)...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(certificates, func(i, j int) bool {
+		if certificates[i].Path != certificates[j].Path {
+			return certificates[i].Path < certificates[j].Path
+		}
+		return certificates[i].NotAfter.Before(certificates[j].NotAfter)
+	})
+	return certificates, nil
+}
+
+// ExpiringWithin returns the certificates which expire before now plus window.
+func ExpiringWithin(certificates []Certificate, now time.Time, window time.Duration) []Certificate {
+	var expiring []Certificate
+	deadline := now.Add(window)
+	for _, certificate := range certificates {
+		if certificate.NotAfter.Before(deadline) {
+			expiring = append(expiring, certificate)
+		}
+	}
+	return expiring
+}
+
+func hasCertificateExtension(path string) bool {
+	for _, ext := range certificateExtensions {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCertificates returns the certificates of the PEM blocks in data.
+func parseCertificates(path string, data []byte) []Certificate {
+	var certificates []Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			klog.V(2).Infof("skipping certificate in %q: %v", path, err)
+			continue
+		}
+		certificates = append(certificates, Certificate{
+			Path:      path,
+			Subject:   cert.Subject.String(),
+			Issuer:    cert.Issuer.String(),
+			IsCA:      cert.IsCA,
+			NotBefore: cert.NotBefore.UTC(),
+			NotAfter:  cert.NotAfter.UTC(),
+		})
+	}
+	return certificates
+}
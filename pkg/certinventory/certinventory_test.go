@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certinventory
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificatePEM(t *testing.T, name string, isCA bool, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestScan(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+
+	files := map[string][]byte{
+		"pki/ca.crt": append(
+			testCertificatePEM(t, "kubernetes-ca", true, now.Add(10*365*24*time.Hour)),
+			testCertificatePEM(t, "kubernetes-ca-old", true, now.Add(24*time.Hour))...,
+		),
+		"kube-apiserver/server.crt": testCertificatePEM(t, "kubernetes-master", false, now.Add(7*24*time.Hour)),
+		"kube-apiserver/server.key": []byte("not a certificate"),
+		"etcd/peers.pem":            []byte("garbage"),
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+	}
+
+	certificates, err := Scan([]string{dir, filepath.Join(dir, "missing")})
+	require.NoError(t, err)
+
+	var actual []string
+	for _, c := range certificates {
+		rel, err := filepath.Rel(dir, c.Path)
+		require.NoError(t, err)
+		actual = append(actual, rel+" "+c.Subject)
+	}
+	assert.Equal(t, []string{
+		"kube-apiserver/server.crt CN=kubernetes-master",
+		"pki/ca.crt CN=kubernetes-ca-old",
+		"pki/ca.crt CN=kubernetes-ca",
+	}, actual)
+	assert.True(t, certificates[1].IsCA)
+
+	expiring := ExpiringWithin(certificates, now, 30*24*time.Hour)
+	assert.Len(t, expiring, 2)
+	assert.Equal(t, "CN=kubernetes-master", expiring[0].Subject)
+	assert.Equal(t, "CN=kubernetes-ca-old", expiring[1].Subject)
+}
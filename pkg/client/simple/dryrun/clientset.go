@@ -0,0 +1,350 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun implements the server-side dry-run of the kops commands: a simple.Clientset
+// which reads from the state store, but records the writes instead of making them.
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	kopsinternalversion "k8s.io/kops/pkg/client/clientset_generated/clientset/typed/kops/internalversion"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/diff"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// Action is the kind of change made to an entry of the state store.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a write to the state store which was recorded instead of being made.
+type Change struct {
+	Action Action `json:"action"`
+	// Kind is the kind of the entry, such as Cluster, InstanceGroup, Secret or Keyset.
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Cluster is the name of the cluster the entry belongs to.
+	Cluster string `json:"cluster,omitempty"`
+	// Diff shows the change of the entry. The contents of secrets and private keys are never shown.
+	Diff string `json:"diff,omitempty"`
+}
+
+// Clientset wraps a simple.Clientset, serving reads from the state store overlaid with the recorded writes.
+type Clientset struct {
+	simple.Clientset
+
+	mutex   sync.Mutex
+	changes []*Change
+
+	// clusters are the clusters created or updated, and nil for those deleted
+	clusters map[string]*kops.Cluster
+	// instanceGroups are the instance groups created or updated, and nil for those deleted, by cluster
+	instanceGroups map[string]map[string]*kops.InstanceGroup
+	// secrets are the secrets created or replaced, by cluster
+	secrets map[string]map[string]*fi.Secret
+	// keysets are the keysets stored, by cluster
+	keysets map[string]map[string]*fi.Keyset
+}
+
+var _ simple.Clientset = &Clientset{}
+
+// NewClientset returns a Clientset recording the writes made through it, instead of making them.
+func NewClientset(clientset simple.Clientset) *Clientset {
+	return &Clientset{
+		Clientset:      clientset,
+		clusters:       make(map[string]*kops.Cluster),
+		instanceGroups: make(map[string]map[string]*kops.InstanceGroup),
+		secrets:        make(map[string]map[string]*fi.Secret),
+		keysets:        make(map[string]map[string]*fi.Keyset),
+	}
+}
+
+// Changes returns the writes recorded, in order.
+func (c *Clientset) Changes() []*Change {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]*Change(nil), c.changes...)
+}
+
+func (c *Clientset) record(change *Change) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.changes = append(c.changes, change)
+}
+
+// ObjectDiff returns the difference between the YAML serializations of two objects, either of which may be nil,
+// or an empty string if they are the same.
+func ObjectDiff(before, after runtime.Object) (string, error) {
+	var beforeYAML, afterYAML []byte
+	if before != nil {
+		b, err := kopscodecs.ToVersionedYaml(before)
+		if err != nil {
+			return "", err
+		}
+		beforeYAML = b
+	}
+	if after != nil {
+		b, err := kopscodecs.ToVersionedYaml(after)
+		if err != nil {
+			return "", err
+		}
+		afterYAML = b
+	}
+	return textDiff(string(beforeYAML), string(afterYAML)), nil
+}
+
+// textDiff returns the difference between two texts, or an empty string if they are the same.
+func textDiff(before, after string) string {
+	if before == after {
+		return ""
+	}
+	return diff.FormatDiff(before, after)
+}
+
+// GetCluster reads a cluster, as created or updated in the dry run.
+func (c *Clientset) GetCluster(ctx context.Context, name string) (*kops.Cluster, error) {
+	c.mutex.Lock()
+	cluster, found := c.clusters[name]
+	c.mutex.Unlock()
+	if found {
+		if cluster == nil {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Group: kops.GroupName, Resource: "Cluster"}, name)
+		}
+		return cluster.DeepCopy(), nil
+	}
+	return c.Clientset.GetCluster(ctx, name)
+}
+
+// CreateCluster validates and records the creation of a cluster.
+func (c *Clientset) CreateCluster(ctx context.Context, cluster *kops.Cluster) (*kops.Cluster, error) {
+	if errs := validation.ValidateCluster(cluster, false, c.VFSContext()); len(errs) != 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	name := cluster.ObjectMeta.Name
+	if name == "" {
+		return nil, fmt.Errorf("clusterName is required")
+	}
+	if existing, err := c.GetCluster(ctx, name); err == nil && existing != nil {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Group: kops.GroupName, Resource: "Cluster"}, name)
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	created := cluster.DeepCopy()
+	if created.ObjectMeta.CreationTimestamp.IsZero() {
+		created.ObjectMeta.CreationTimestamp = metav1.Now()
+	}
+
+	d, err := ObjectDiff(nil, created)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	c.clusters[name] = created
+	c.mutex.Unlock()
+	c.record(&Change{Action: ActionCreate, Kind: "Cluster", Name: name, Cluster: name, Diff: d})
+
+	return created.DeepCopy(), nil
+}
+
+// UpdateCluster validates and records the update of a cluster.
+func (c *Clientset) UpdateCluster(ctx context.Context, cluster *kops.Cluster, status *kops.ClusterStatus) (*kops.Cluster, error) {
+	name := cluster.ObjectMeta.Name
+	old, err := c.GetCluster(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validation.ValidateClusterUpdate(cluster, status, old, c.VFSContext()).ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	updated := cluster.DeepCopy()
+	if !apiequality.Semantic.DeepEqual(old.Spec, updated.Spec) {
+		updated.SetGeneration(old.GetGeneration() + 1)
+	}
+
+	d, err := ObjectDiff(old, updated)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	c.clusters[name] = updated
+	c.mutex.Unlock()
+	if d != "" {
+		c.record(&Change{Action: ActionUpdate, Kind: "Cluster", Name: name, Cluster: name, Diff: d})
+	}
+
+	return updated.DeepCopy(), nil
+}
+
+// ListClusters lists the clusters, as created, updated or deleted in the dry run.
+func (c *Clientset) ListClusters(ctx context.Context, options metav1.ListOptions) (*kops.ClusterList, error) {
+	list, err := c.Clientset.ListClusters(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	result := &kops.ClusterList{}
+	seen := make(map[string]bool)
+	for i := range list.Items {
+		name := list.Items[i].Name
+		seen[name] = true
+		if cluster, found := c.clusters[name]; found {
+			if cluster != nil {
+				result.Items = append(result.Items, *cluster.DeepCopy())
+			}
+			continue
+		}
+		result.Items = append(result.Items, list.Items[i])
+	}
+	for name, cluster := range c.clusters {
+		if !seen[name] && cluster != nil {
+			result.Items = append(result.Items, *cluster.DeepCopy())
+		}
+	}
+	return result, nil
+}
+
+// InstanceGroupsFor returns the instance groups of a cluster, recording the writes made through it.
+func (c *Clientset) InstanceGroupsFor(cluster *kops.Cluster) kopsinternalversion.InstanceGroupInterface {
+	return &instanceGroups{
+		InstanceGroupInterface: c.Clientset.InstanceGroupsFor(cluster),
+		clientset:              c,
+		clusterName:            cluster.Name,
+	}
+}
+
+// AddonsFor returns the additional objects of a cluster, recording the writes made through it.
+func (c *Clientset) AddonsFor(cluster *kops.Cluster) simple.AddonsClient {
+	return &addons{
+		AddonsClient: c.Clientset.AddonsFor(cluster),
+		clientset:    c,
+		clusterName:  cluster.Name,
+	}
+}
+
+// SecretStore returns the secrets of a cluster, recording the writes made through it.
+func (c *Clientset) SecretStore(cluster *kops.Cluster) (fi.SecretStore, error) {
+	store, err := c.Clientset.SecretStore(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &secretStore{SecretStore: store, clientset: c, clusterName: cluster.Name}, nil
+}
+
+// KeyStore returns the keystore of a cluster, recording the writes made through it.
+func (c *Clientset) KeyStore(cluster *kops.Cluster) (fi.CAStore, error) {
+	store, err := c.Clientset.KeyStore(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &keyStore{CAStore: store, clientset: c, clusterName: cluster.Name}, nil
+}
+
+// SSHCredentialStore returns the SSH public keys of a cluster, recording the writes made through it.
+func (c *Clientset) SSHCredentialStore(cluster *kops.Cluster) (fi.SSHCredentialStore, error) {
+	store, err := c.Clientset.SSHCredentialStore(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &sshCredentialStore{SSHCredentialStore: store, clientset: c, clusterName: cluster.Name}, nil
+}
+
+// DeleteCluster records the deletion of all the state of a cluster:
+// the cluster, its instance groups, secrets and keysets.
+func (c *Clientset) DeleteCluster(ctx context.Context, cluster *kops.Cluster) error {
+	name := cluster.ObjectMeta.Name
+	old, err := c.GetCluster(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	igs, err := c.InstanceGroupsFor(old).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing instance groups: %w", err)
+	}
+	for i := range igs.Items {
+		if err := c.InstanceGroupsFor(old).Delete(ctx, igs.Items[i].Name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+	}
+
+	secretStore, err := c.SecretStore(old)
+	if err != nil {
+		return err
+	}
+	secretIDs, err := secretStore.ListSecrets()
+	if err != nil {
+		return fmt.Errorf("listing secrets: %w", err)
+	}
+	for _, id := range secretIDs {
+		if err := secretStore.DeleteSecret(id); err != nil {
+			return err
+		}
+	}
+
+	keyStore, err := c.KeyStore(old)
+	if err != nil {
+		return err
+	}
+	keysets, err := keyStore.ListKeysets()
+	if err != nil {
+		return fmt.Errorf("listing keysets: %w", err)
+	}
+	var keysetNames []string
+	for keysetName := range keysets {
+		keysetNames = append(keysetNames, keysetName)
+	}
+	sort.Strings(keysetNames)
+	for _, keysetName := range keysetNames {
+		c.record(&Change{Action: ActionDelete, Kind: "Keyset", Name: keysetName, Cluster: name, Diff: textDiff(describeKeyset(keysets[keysetName]), "")})
+	}
+
+	d, err := ObjectDiff(old, nil)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	c.clusters[name] = nil
+	c.mutex.Unlock()
+	c.record(&Change{Action: ActionDelete, Kind: "Cluster", Name: name, Cluster: name, Diff: d})
+
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestInstanceGroups(t *testing.T) {
+	ctx := context.TODO()
+	vfs.Context.ResetMemfsContext(true)
+
+	cluster := testutils.BuildMinimalCluster("test.k8s.local")
+	basePath, err := vfs.Context.BuildVfsPath("memfs://unittest-bucket/")
+	if err != nil {
+		t.Fatalf("error building path: %v", err)
+	}
+	underlying := vfsclientset.NewVFSClientset(vfs.Context, basePath)
+	clientset := NewClientset(underlying)
+
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, &ig, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error creating instance group: %v", err)
+	}
+
+	if _, err := clientset.InstanceGroupsFor(cluster).Create(ctx, &ig, metav1.CreateOptions{}); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected an already exists error, got %v", err)
+	}
+
+	updated, err := clientset.InstanceGroupsFor(cluster).Get(ctx, "nodes", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error reading instance group written in the dry run: %v", err)
+	}
+	updated.Spec.MaxSize = fi.PtrTo(int32(3))
+	if _, err := clientset.InstanceGroupsFor(cluster).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("error updating instance group: %v", err)
+	}
+
+	list, err := clientset.InstanceGroupsFor(cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("error listing instance groups: %v", err)
+	}
+	if len(list.Items) != 1 || fi.ValueOf(list.Items[0].Spec.MaxSize) != 3 {
+		t.Errorf("unexpected instance groups listed: %v", list.Items)
+	}
+
+	if err := clientset.InstanceGroupsFor(cluster).Delete(ctx, "nodes", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("error deleting instance group: %v", err)
+	}
+	if _, err := clientset.InstanceGroupsFor(cluster).Get(ctx, "nodes", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	if _, err := underlying.InstanceGroupsFor(cluster).Get(ctx, "nodes", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("instance group was written to the state store: %v", err)
+	}
+
+	changes := clientset.Changes()
+	var actions []string
+	for _, change := range changes {
+		actions = append(actions, string(change.Action)+" "+change.Kind+"/"+change.Name)
+	}
+	expected := "create InstanceGroup/nodes, update InstanceGroup/nodes, delete InstanceGroup/nodes"
+	if strings.Join(actions, ", ") != expected {
+		t.Errorf("unexpected changes %q, expected %q", strings.Join(actions, ", "), expected)
+	}
+	if !strings.Contains(changes[1].Diff, "+   maxSize: 3") {
+		t.Errorf("unexpected diff of update:\n%s", changes[1].Diff)
+	}
+}
+
+func TestSecretStore(t *testing.T) {
+	ctx := context.TODO()
+	vfs.Context.ResetMemfsContext(true)
+
+	cluster := testutils.BuildMinimalCluster("test.k8s.local")
+	basePath, err := vfs.Context.BuildVfsPath("memfs://unittest-bucket/")
+	if err != nil {
+		t.Fatalf("error building path: %v", err)
+	}
+	clientset := NewClientset(vfsclientset.NewVFSClientset(vfs.Context, basePath))
+
+	secretStore, err := clientset.SecretStore(cluster)
+	if err != nil {
+		t.Fatalf("error building secret store: %v", err)
+	}
+	secret := &fi.Secret{Data: []byte("hunter2")}
+	if _, created, err := secretStore.GetOrCreateSecret(ctx, "admin", secret); err != nil || !created {
+		t.Fatalf("expected the secret to be created, got %v, %v", created, err)
+	}
+	if _, created, err := secretStore.GetOrCreateSecret(ctx, "admin", secret); err != nil || created {
+		t.Fatalf("expected the secret to exist, got %v, %v", created, err)
+	}
+
+	ids, err := secretStore.ListSecrets()
+	if err != nil {
+		t.Fatalf("error listing secrets: %v", err)
+	}
+	if strings.Join(ids, ",") != "admin" {
+		t.Errorf("unexpected secrets listed: %v", ids)
+	}
+
+	changes := clientset.Changes()
+	if len(changes) != 1 || changes[0].Action != ActionCreate || changes[0].Kind != "Secret" || changes[0].Name != "admin" {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+	if strings.Contains(changes[0].Diff, "hunter2") {
+		t.Errorf("the contents of the secret were recorded")
+	}
+}
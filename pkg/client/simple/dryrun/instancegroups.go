@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/apis/kops/validation"
+	kopsinternalversion "k8s.io/kops/pkg/client/clientset_generated/clientset/typed/kops/internalversion"
+)
+
+// instanceGroups overlays the instance groups of a cluster with those written in the dry run
+type instanceGroups struct {
+	kopsinternalversion.InstanceGroupInterface
+
+	clientset   *Clientset
+	clusterName string
+}
+
+var _ kopsinternalversion.InstanceGroupInterface = &instanceGroups{}
+
+// overlay returns the instance group written in the dry run, and whether it was written
+func (c *instanceGroups) overlay(name string) (*kops.InstanceGroup, bool) {
+	c.clientset.mutex.Lock()
+	defer c.clientset.mutex.Unlock()
+
+	ig, found := c.clientset.instanceGroups[c.clusterName][name]
+	return ig, found
+}
+
+func (c *instanceGroups) store(name string, ig *kops.InstanceGroup) {
+	c.clientset.mutex.Lock()
+	defer c.clientset.mutex.Unlock()
+
+	if c.clientset.instanceGroups[c.clusterName] == nil {
+		c.clientset.instanceGroups[c.clusterName] = make(map[string]*kops.InstanceGroup)
+	}
+	c.clientset.instanceGroups[c.clusterName][name] = ig
+}
+
+func (c *instanceGroups) Get(ctx context.Context, name string, options metav1.GetOptions) (*kops.InstanceGroup, error) {
+	if ig, found := c.overlay(name); found {
+		if ig == nil {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Group: kops.GroupName, Resource: "InstanceGroup"}, name)
+		}
+		return ig.DeepCopy(), nil
+	}
+	return c.InstanceGroupInterface.Get(ctx, name, options)
+}
+
+func (c *instanceGroups) List(ctx context.Context, options metav1.ListOptions) (*kops.InstanceGroupList, error) {
+	list := &kops.InstanceGroupList{}
+	if _, err := c.clientset.Clientset.GetCluster(ctx, c.clusterName); err == nil {
+		l, err := c.InstanceGroupInterface.List(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		list = l
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	c.clientset.mutex.Lock()
+	defer c.clientset.mutex.Unlock()
+
+	written := c.clientset.instanceGroups[c.clusterName]
+	result := &kops.InstanceGroupList{}
+	seen := make(map[string]bool)
+	for i := range list.Items {
+		name := list.Items[i].Name
+		seen[name] = true
+		if ig, found := written[name]; found {
+			if ig != nil {
+				result.Items = append(result.Items, *ig.DeepCopy())
+			}
+			continue
+		}
+		result.Items = append(result.Items, list.Items[i])
+	}
+	var names []string
+	for name, ig := range written {
+		if !seen[name] && ig != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		result.Items = append(result.Items, *written[name].DeepCopy())
+	}
+	return result, nil
+}
+
+func (c *instanceGroups) Create(ctx context.Context, g *kops.InstanceGroup, opts metav1.CreateOptions) (*kops.InstanceGroup, error) {
+	if err := validation.ValidateInstanceGroup(g, nil, false).ToAggregate(); err != nil {
+		return nil, err
+	}
+	if existing, err := c.Get(ctx, g.Name, metav1.GetOptions{}); err == nil && existing != nil {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Group: kops.GroupName, Resource: "InstanceGroup"}, g.Name)
+	} else if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	created := g.DeepCopy()
+	if created.ObjectMeta.CreationTimestamp.IsZero() {
+		created.ObjectMeta.CreationTimestamp = metav1.Now()
+	}
+
+	d, err := ObjectDiff(nil, created)
+	if err != nil {
+		return nil, err
+	}
+	c.store(g.Name, created)
+	c.clientset.record(&Change{Action: ActionCreate, Kind: "InstanceGroup", Name: g.Name, Cluster: c.clusterName, Diff: d})
+
+	return created.DeepCopy(), nil
+}
+
+func (c *instanceGroups) Update(ctx context.Context, g *kops.InstanceGroup, opts metav1.UpdateOptions) (*kops.InstanceGroup, error) {
+	old, err := c.Get(ctx, g.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateInstanceGroup(g, nil, false).ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	updated := g.DeepCopy()
+	if !apiequality.Semantic.DeepEqual(old.Spec, updated.Spec) {
+		updated.SetGeneration(old.GetGeneration() + 1)
+	}
+
+	d, err := ObjectDiff(old, updated)
+	if err != nil {
+		return nil, err
+	}
+	c.store(g.Name, updated)
+	if d != "" {
+		c.clientset.record(&Change{Action: ActionUpdate, Kind: "InstanceGroup", Name: g.Name, Cluster: c.clusterName, Diff: d})
+	}
+
+	return updated.DeepCopy(), nil
+}
+
+func (c *instanceGroups) Delete(ctx context.Context, name string, options metav1.DeleteOptions) error {
+	old, err := c.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	d, err := ObjectDiff(old, nil)
+	if err != nil {
+		return err
+	}
+	c.store(name, nil)
+	c.clientset.record(&Change{Action: ActionDelete, Kind: "InstanceGroup", Name: name, Cluster: c.clusterName, Diff: d})
+
+	return nil
+}
+
+func (c *instanceGroups) DeleteCollection(ctx context.Context, options metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	return fmt.Errorf("DeleteCollection is not supported in a dry run")
+}
+
+func (c *instanceGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (*kops.InstanceGroup, error) {
+	return nil, fmt.Errorf("Patch is not supported in a dry run")
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// addons records the replacement of the additional objects of a cluster
+type addons struct {
+	simple.AddonsClient
+
+	clientset   *Clientset
+	clusterName string
+}
+
+func (c *addons) Replace(objects kubemanifest.ObjectList) error {
+	var before []byte
+	if existing, err := c.AddonsClient.List(context.TODO()); err == nil && len(existing) != 0 {
+		b, err := existing.ToYAML()
+		if err != nil {
+			return err
+		}
+		before = b
+	}
+	after, err := objects.ToYAML()
+	if err != nil {
+		return fmt.Errorf("error serializing addons: %w", err)
+	}
+
+	if d := textDiff(string(before), string(after)); d != "" {
+		c.clientset.record(&Change{Action: ActionUpdate, Kind: "Addons", Name: c.clusterName, Cluster: c.clusterName, Diff: d})
+	}
+	return nil
+}
+
+// secretStore records the writes of secrets, without their contents
+type secretStore struct {
+	fi.SecretStore
+
+	clientset   *Clientset
+	clusterName string
+}
+
+var _ fi.SecretStore = &secretStore{}
+
+func (s *secretStore) written(id string) (*fi.Secret, bool) {
+	s.clientset.mutex.Lock()
+	defer s.clientset.mutex.Unlock()
+
+	secret, found := s.clientset.secrets[s.clusterName][id]
+	return secret, found
+}
+
+func (s *secretStore) store(id string, secret *fi.Secret) {
+	s.clientset.mutex.Lock()
+	defer s.clientset.mutex.Unlock()
+
+	if s.clientset.secrets[s.clusterName] == nil {
+		s.clientset.secrets[s.clusterName] = make(map[string]*fi.Secret)
+	}
+	s.clientset.secrets[s.clusterName][id] = secret
+}
+
+func (s *secretStore) FindSecret(id string) (*fi.Secret, error) {
+	if secret, found := s.written(id); found {
+		return secret, nil
+	}
+	return s.SecretStore.FindSecret(id)
+}
+
+func (s *secretStore) Secret(id string) (*fi.Secret, error) {
+	secret, err := s.FindSecret(id)
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("Secret not found: %q", id)
+	}
+	return secret, nil
+}
+
+func (s *secretStore) DeleteSecret(id string) error {
+	s.store(id, nil)
+	s.clientset.record(&Change{Action: ActionDelete, Kind: "Secret", Name: id, Cluster: s.clusterName})
+	return nil
+}
+
+func (s *secretStore) GetOrCreateSecret(ctx context.Context, id string, secret *fi.Secret) (*fi.Secret, bool, error) {
+	existing, err := s.FindSecret(id)
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return existing, false, nil
+	}
+
+	s.store(id, secret)
+	s.clientset.record(&Change{Action: ActionCreate, Kind: "Secret", Name: id, Cluster: s.clusterName})
+	return secret, true, nil
+}
+
+func (s *secretStore) ReplaceSecret(id string, secret *fi.Secret) (*fi.Secret, error) {
+	existing, err := s.FindSecret(id)
+	if err != nil {
+		return nil, err
+	}
+
+	action := ActionUpdate
+	if existing == nil {
+		action = ActionCreate
+	} else if bytes.Equal(existing.Data, secret.Data) {
+		return secret, nil
+	}
+	s.store(id, secret)
+	s.clientset.record(&Change{Action: action, Kind: "Secret", Name: id, Cluster: s.clusterName})
+	return secret, nil
+}
+
+func (s *secretStore) ListSecrets() ([]string, error) {
+	ids, err := s.SecretStore.ListSecrets()
+	if err != nil {
+		return nil, err
+	}
+
+	s.clientset.mutex.Lock()
+	defer s.clientset.mutex.Unlock()
+
+	var result []string
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		seen[id] = true
+		if secret, found := s.clientset.secrets[s.clusterName][id]; found && secret == nil {
+			continue
+		}
+		result = append(result, id)
+	}
+	for id, secret := range s.clientset.secrets[s.clusterName] {
+		if !seen[id] && secret != nil {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (s *secretStore) MirrorTo(ctx context.Context, basedir vfs.Path) error {
+	return nil
+}
+
+// keyStore records the writes of keysets, showing the ids of their keypairs but not the keys
+type keyStore struct {
+	fi.CAStore
+
+	clientset   *Clientset
+	clusterName string
+}
+
+var _ fi.CAStore = &keyStore{}
+
+func (s *keyStore) FindKeyset(ctx context.Context, name string) (*fi.Keyset, error) {
+	s.clientset.mutex.Lock()
+	keyset, found := s.clientset.keysets[s.clusterName][name]
+	s.clientset.mutex.Unlock()
+	if found {
+		return keyset, nil
+	}
+	return s.CAStore.FindKeyset(ctx, name)
+}
+
+func (s *keyStore) StoreKeyset(ctx context.Context, name string, keyset *fi.Keyset) error {
+	existing, err := s.FindKeyset(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	action := ActionUpdate
+	if existing == nil {
+		action = ActionCreate
+	}
+
+	s.clientset.mutex.Lock()
+	if s.clientset.keysets[s.clusterName] == nil {
+		s.clientset.keysets[s.clusterName] = make(map[string]*fi.Keyset)
+	}
+	s.clientset.keysets[s.clusterName][name] = keyset
+	s.clientset.mutex.Unlock()
+
+	if d := textDiff(describeKeyset(existing), describeKeyset(keyset)); d != "" {
+		s.clientset.record(&Change{Action: action, Kind: "Keyset", Name: name, Cluster: s.clusterName, Diff: d})
+	}
+	return nil
+}
+
+func (s *keyStore) ListKeysets() (map[string]*fi.Keyset, error) {
+	keysets, err := s.CAStore.ListKeysets()
+	if err != nil {
+		return nil, err
+	}
+
+	s.clientset.mutex.Lock()
+	defer s.clientset.mutex.Unlock()
+
+	for name, keyset := range s.clientset.keysets[s.clusterName] {
+		keysets[name] = keyset
+	}
+	return keysets, nil
+}
+
+func (s *keyStore) MirrorTo(ctx context.Context, basedir vfs.Path) error {
+	return nil
+}
+
+// describeKeyset lists the ids of the keypairs of a keyset, one per line, with their state.
+func describeKeyset(keyset *fi.Keyset) string {
+	if keyset == nil {
+		return ""
+	}
+
+	var ids []string
+	for id := range keyset.Items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return fi.KeysetItemIdOlder(ids[i], ids[j])
+	})
+
+	var b strings.Builder
+	for _, id := range ids {
+		item := keyset.Items[id]
+		var attributes []string
+		if keyset.Primary != nil && keyset.Primary.Id == id {
+			attributes = append(attributes, "primary")
+		}
+		if item.DistrustTimestamp != nil {
+			attributes = append(attributes, "distrusted")
+		}
+		if item.PrivateKey != nil {
+			attributes = append(attributes, "private key")
+		}
+		fmt.Fprintf(&b, "keypair %s", id)
+		if len(attributes) != 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(attributes, ", "))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// sshCredentialStore records the writes of SSH public keys
+type sshCredentialStore struct {
+	fi.SSHCredentialStore
+
+	clientset   *Clientset
+	clusterName string
+}
+
+var _ fi.SSHCredentialStore = &sshCredentialStore{}
+
+func (s *sshCredentialStore) DeleteSSHCredential() error {
+	s.clientset.record(&Change{Action: ActionDelete, Kind: "SSHPublicKey", Name: "admin", Cluster: s.clusterName})
+	return nil
+}
+
+func (s *sshCredentialStore) AddSSHPublicKey(ctx context.Context, data []byte) error {
+	s.clientset.record(&Change{Action: ActionCreate, Kind: "SSHPublicKey", Name: "admin", Cluster: s.clusterName, Diff: textDiff("", strings.TrimSpace(string(data))+"\n")})
+	return nil
+}
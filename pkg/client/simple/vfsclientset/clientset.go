@@ -182,6 +182,10 @@ func DeleteAllClusterState(ctx context.Context, basePath vfs.Path) error {
 		if strings.HasPrefix(relativePath, "backups/") {
 			continue
 		}
+		// Snapshots taken with kops snapshot create
+		if strings.HasPrefix(relativePath, "snapshots/") {
+			continue
+		}
 
 		return fmt.Errorf("refusing to delete: unknown file found: %s", path)
 	}
@@ -0,0 +1,965 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+const (
+	clusterAPIVersion      = "cluster.x-k8s.io/v1beta1"
+	controlPlaneAPIVersion = "controlplane.cluster.x-k8s.io/v1beta1"
+	bootstrapAPIVersion    = "bootstrap.cluster.x-k8s.io/v1beta1"
+	infrastructureGroup    = "infrastructure.cluster.x-k8s.io"
+
+	autoscalerMinSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
+	autoscalerMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
+)
+
+// Export is the result of converting a kOps cluster to Cluster API manifests.
+type Export struct {
+	// Objects are the Cluster API objects, in the order they should be applied.
+	Objects kubemanifest.ObjectList
+	// Unmapped lists the kOps settings that have no equivalent in the generated manifests.
+	Unmapped []string
+}
+
+// ToYAML serializes the manifests, preceded by a comment listing the unmapped settings.
+func (e *Export) ToYAML() ([]byte, error) {
+	var b bytes.Buffer
+	if len(e.Unmapped) != 0 {
+		b.WriteString("# The following kOps settings could not be mapped to Cluster API and must be migrated manually:\n")
+		for _, s := range e.Unmapped {
+			fmt.Fprintf(&b, "#  - %s\n", s)
+		}
+		b.WriteString("\n")
+	}
+	y, err := e.Objects.ToYAML()
+	if err != nil {
+		return nil, err
+	}
+	b.Write(y)
+	return b.Bytes(), nil
+}
+
+// infrastructureProvider holds the provider-specific parts of the conversion.
+type infrastructureProvider struct {
+	// apiVersion is the version of the infrastructure provider's API
+	apiVersion string
+	// clusterKind is the kind of the infrastructure cluster object
+	clusterKind string
+	// machineTemplateKind is the kind of the infrastructure machine template object
+	machineTemplateKind string
+	// nodeName is the kubeadm node name, matching the name used by the provider's cloud provider
+	nodeName string
+}
+
+var infrastructureProviders = map[kops.CloudProviderID]infrastructureProvider{
+	kops.CloudProviderAWS: {
+		apiVersion:          infrastructureGroup + "/v1beta2",
+		clusterKind:         "AWSCluster",
+		machineTemplateKind: "AWSMachineTemplate",
+		nodeName:            "{{ ds.meta_data.local_hostname }}",
+	},
+	kops.CloudProviderGCE: {
+		apiVersion:          infrastructureGroup + "/v1beta1",
+		clusterKind:         "GCPCluster",
+		machineTemplateKind: "GCPMachineTemplate",
+		nodeName:            "{{ ds.meta_data.local_hostname.split(\".\")[0] }}",
+	},
+	kops.CloudProviderAzure: {
+		apiVersion:          infrastructureGroup + "/v1beta1",
+		clusterKind:         "AzureCluster",
+		machineTemplateKind: "AzureMachineTemplate",
+		nodeName:            "{{ ds.meta_data[\"local_hostname\"] }}",
+	},
+}
+
+type exporter struct {
+	cluster        *kops.Cluster
+	instanceGroups []*kops.InstanceGroup
+	namespace      string
+	cloudProvider  kops.CloudProviderID
+	provider       infrastructureProvider
+
+	unmapped []string
+}
+
+// ExportCluster converts a kOps cluster and its instance groups to the manifests for Cluster API,
+// using the infrastructure provider for the cluster's cloud (CAPA, CAPG or CAPZ) and the kubeadm
+// bootstrap and control plane providers.
+// Settings that cannot be expressed in Cluster API are reported in Export.Unmapped.
+func ExportCluster(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, namespace string) (*Export, error) {
+	cloudProvider := cluster.GetCloudProvider()
+	provider, found := infrastructureProviders[cloudProvider]
+	if !found {
+		return nil, fmt.Errorf("exporting clusters on %q to Cluster API is not supported", cloudProvider)
+	}
+	if cluster.Spec.KubernetesVersion == "" {
+		return nil, fmt.Errorf("cluster %q does not specify a kubernetesVersion", cluster.Name)
+	}
+
+	e := &exporter{
+		cluster:        cluster,
+		instanceGroups: instanceGroups,
+		namespace:      namespace,
+		cloudProvider:  cloudProvider,
+		provider:       provider,
+	}
+
+	var controlPlane []*kops.InstanceGroup
+	var nodes []*kops.InstanceGroup
+	for _, ig := range instanceGroups {
+		switch ig.Spec.Role {
+		case kops.InstanceGroupRoleControlPlane:
+			controlPlane = append(controlPlane, ig)
+		case kops.InstanceGroupRoleNode:
+			nodes = append(nodes, ig)
+		case kops.InstanceGroupRoleBastion:
+			if cloudProvider != kops.CloudProviderAWS {
+				e.addUnmapped(ig, "role", "bastion hosts are only supported by the AWS provider")
+			}
+		default:
+			e.addUnmapped(ig, "role", fmt.Sprintf("role %q has no Cluster API equivalent", ig.Spec.Role))
+		}
+	}
+	if len(controlPlane) == 0 {
+		return nil, fmt.Errorf("cluster %q has no control plane instance groups", cluster.Name)
+	}
+
+	infraCluster, err := e.buildInfrastructureCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Export{}
+	result.Objects = append(result.Objects, e.buildCluster(), infraCluster)
+
+	controlPlaneObjects, err := e.buildControlPlane(controlPlane)
+	if err != nil {
+		return nil, err
+	}
+	result.Objects = append(result.Objects, controlPlaneObjects...)
+
+	for _, ig := range nodes {
+		objects, err := e.buildMachineDeployment(ig)
+		if err != nil {
+			return nil, err
+		}
+		result.Objects = append(result.Objects, objects...)
+	}
+
+	e.reportClusterSettings()
+	result.Unmapped = e.unmapped
+	return result, nil
+}
+
+func (e *exporter) addUnmapped(ig *kops.InstanceGroup, field string, reason string) {
+	path := "spec." + field
+	if ig != nil {
+		path = "instancegroup/" + ig.Name + " " + path
+	}
+	e.unmapped = append(e.unmapped, path+": "+reason)
+}
+
+func (e *exporter) newObject(apiVersion, kind, name string, spec map[string]interface{}) *kubemanifest.Object {
+	return kubemanifest.NewObject(map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": e.namespace,
+		},
+		"spec": spec,
+	})
+}
+
+func objectRef(apiVersion, kind, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"name":       name,
+	}
+}
+
+func (e *exporter) version() string {
+	return "v" + strings.TrimPrefix(e.cluster.Spec.KubernetesVersion, "v")
+}
+
+func (e *exporter) buildCluster() *kubemanifest.Object {
+	networking := &e.cluster.Spec.Networking
+	clusterNetwork := map[string]interface{}{}
+	if networking.PodCIDR != "" {
+		clusterNetwork["pods"] = map[string]interface{}{"cidrBlocks": []interface{}{networking.PodCIDR}}
+	}
+	if networking.ServiceClusterIPRange != "" {
+		clusterNetwork["services"] = map[string]interface{}{"cidrBlocks": []interface{}{networking.ServiceClusterIPRange}}
+	}
+	if e.cluster.Spec.ClusterDNSDomain != "" {
+		clusterNetwork["serviceDomain"] = e.cluster.Spec.ClusterDNSDomain
+	}
+
+	spec := map[string]interface{}{
+		"infrastructureRef": objectRef(e.provider.apiVersion, e.provider.clusterKind, e.cluster.Name),
+		"controlPlaneRef":   objectRef(controlPlaneAPIVersion, "KubeadmControlPlane", e.cluster.Name+"-control-plane"),
+	}
+	if len(clusterNetwork) != 0 {
+		spec["clusterNetwork"] = clusterNetwork
+	}
+	return e.newObject(clusterAPIVersion, "Cluster", e.cluster.Name, spec)
+}
+
+func (e *exporter) buildInfrastructureCluster() (*kubemanifest.Object, error) {
+	var spec map[string]interface{}
+	var err error
+	switch e.cloudProvider {
+	case kops.CloudProviderAWS:
+		spec, err = e.buildAWSCluster()
+	case kops.CloudProviderGCE:
+		spec, err = e.buildGCPCluster()
+	case kops.CloudProviderAzure:
+		spec, err = e.buildAzureCluster()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e.newObject(e.provider.apiVersion, e.provider.clusterKind, e.cluster.Name, spec), nil
+}
+
+func (e *exporter) region() (string, error) {
+	for _, subnet := range e.cluster.Spec.Networking.Subnets {
+		if subnet.Region != "" {
+			return subnet.Region, nil
+		}
+		if subnet.Zone != "" && e.cloudProvider == kops.CloudProviderAWS {
+			return subnet.Zone[:len(subnet.Zone)-1], nil
+		}
+		if subnet.Zone != "" && e.cloudProvider == kops.CloudProviderGCE {
+			return subnet.Zone[:strings.LastIndex(subnet.Zone, "-")], nil
+		}
+	}
+	return "", fmt.Errorf("unable to determine the region of cluster %q from its subnets", e.cluster.Name)
+}
+
+func (e *exporter) buildAWSCluster() (map[string]interface{}, error) {
+	region, err := e.region()
+	if err != nil {
+		return nil, err
+	}
+
+	networking := &e.cluster.Spec.Networking
+	vpc := map[string]interface{}{}
+	if networking.NetworkID != "" {
+		vpc["id"] = networking.NetworkID
+	} else if networking.NetworkCIDR != "" {
+		vpc["cidrBlock"] = networking.NetworkCIDR
+	}
+	if len(networking.AdditionalNetworkCIDRs) != 0 {
+		e.addUnmapped(nil, "networking.additionalNetworkCIDRs", "CAPA does not add secondary CIDR blocks to the VPC")
+	}
+
+	var subnets []interface{}
+	for _, subnet := range networking.Subnets {
+		s := map[string]interface{}{
+			"availabilityZone": subnet.Zone,
+		}
+		if subnet.ID != "" {
+			s["id"] = subnet.ID
+		} else {
+			s["cidrBlock"] = subnet.CIDR
+		}
+		switch subnet.Type {
+		case kops.SubnetTypePublic, kops.SubnetTypeUtility:
+			s["isPublic"] = true
+		case kops.SubnetTypePrivate:
+			s["isPublic"] = false
+		default:
+			e.addUnmapped(nil, "networking.subnets["+subnet.Name+"].type", fmt.Sprintf("subnet type %q has no CAPA equivalent", subnet.Type))
+		}
+		if subnet.Egress != "" {
+			e.addUnmapped(nil, "networking.subnets["+subnet.Name+"].egress", "CAPA creates its own NAT gateways")
+		}
+		subnets = append(subnets, s)
+	}
+
+	network := map[string]interface{}{}
+	if len(vpc) != 0 {
+		network["vpc"] = vpc
+	}
+	if len(subnets) != 0 {
+		network["subnets"] = subnets
+	}
+
+	spec := map[string]interface{}{
+		"region": region,
+	}
+	if len(network) != 0 {
+		spec["network"] = network
+	}
+	if e.cluster.Spec.SSHKeyName != nil {
+		spec["sshKeyName"] = *e.cluster.Spec.SSHKeyName
+	}
+	if len(e.cluster.Spec.CloudLabels) != 0 {
+		spec["additionalTags"] = stringMap(e.cluster.Spec.CloudLabels)
+	}
+	if lb := e.cluster.Spec.API.LoadBalancer; lb != nil {
+		scheme := "internet-facing"
+		if lb.Type == kops.LoadBalancerTypeInternal {
+			scheme = "internal"
+		}
+		spec["controlPlaneLoadBalancer"] = map[string]interface{}{"scheme": scheme}
+	}
+	for _, ig := range e.instanceGroups {
+		if ig.IsBastion() {
+			bastion := map[string]interface{}{"enabled": true}
+			if ig.Spec.MachineType != "" {
+				bastion["instanceType"] = ig.Spec.MachineType
+			}
+			spec["bastion"] = bastion
+			break
+		}
+	}
+	return spec, nil
+}
+
+func (e *exporter) buildGCPCluster() (map[string]interface{}, error) {
+	region, err := e.region()
+	if err != nil {
+		return nil, err
+	}
+
+	networking := &e.cluster.Spec.Networking
+	network := map[string]interface{}{}
+	if networking.NetworkID != "" {
+		network["name"] = networking.NetworkID
+	}
+	var subnets []interface{}
+	for _, subnet := range networking.Subnets {
+		s := map[string]interface{}{
+			"name": subnet.Name,
+		}
+		if subnet.ID != "" {
+			s["name"] = subnet.ID
+		}
+		if subnet.CIDR != "" {
+			s["cidrBlock"] = subnet.CIDR
+		}
+		if subnet.Region != "" {
+			s["region"] = subnet.Region
+		}
+		subnets = append(subnets, s)
+	}
+	if len(subnets) != 0 {
+		network["subnets"] = subnets
+	}
+
+	spec := map[string]interface{}{
+		"region": region,
+	}
+	if gce := e.cluster.Spec.CloudProvider.GCE; gce != nil {
+		spec["project"] = gce.Project
+	}
+	if len(network) != 0 {
+		spec["network"] = network
+	}
+	if len(e.cluster.Spec.CloudLabels) != 0 {
+		spec["additionalLabels"] = stringMap(e.cluster.Spec.CloudLabels)
+	}
+	if lb := e.cluster.Spec.API.LoadBalancer; lb != nil && lb.Type == kops.LoadBalancerTypeInternal {
+		spec["loadBalancer"] = map[string]interface{}{"loadBalancerType": "Internal"}
+	}
+	return spec, nil
+}
+
+func (e *exporter) buildAzureCluster() (map[string]interface{}, error) {
+	region, err := e.region()
+	if err != nil {
+		return nil, err
+	}
+
+	networking := &e.cluster.Spec.Networking
+	vnet := map[string]interface{}{
+		"name": e.cluster.Name,
+	}
+	if networking.NetworkID != "" {
+		vnet["name"] = networking.NetworkID
+	}
+	if networking.NetworkCIDR != "" {
+		vnet["cidrBlocks"] = []interface{}{networking.NetworkCIDR}
+	}
+
+	// CAPZ separates control plane and node subnets, whereas kOps places both in the same subnets
+	var subnets []interface{}
+	for _, role := range []string{"control-plane", "node"} {
+		for _, subnet := range networking.Subnets {
+			s := map[string]interface{}{
+				"name": subnet.Name,
+				"role": role,
+			}
+			if subnet.CIDR != "" {
+				s["cidrBlocks"] = []interface{}{subnet.CIDR}
+			}
+			subnets = append(subnets, s)
+			break
+		}
+	}
+	if len(networking.Subnets) > 1 {
+		e.addUnmapped(nil, "networking.subnets", "CAPZ uses a single control plane subnet and a single node subnet; only the first subnet was mapped")
+	}
+
+	spec := map[string]interface{}{
+		"location":      region,
+		"resourceGroup": e.cluster.AzureResourceGroupName(),
+		"networkSpec": map[string]interface{}{
+			"vnet":    vnet,
+			"subnets": subnets,
+		},
+	}
+	if azure := e.cluster.Spec.CloudProvider.Azure; azure != nil && azure.SubscriptionID != "" {
+		spec["subscriptionID"] = azure.SubscriptionID
+	}
+	if len(e.cluster.Spec.CloudLabels) != 0 {
+		spec["additionalTags"] = stringMap(e.cluster.Spec.CloudLabels)
+	}
+	return spec, nil
+}
+
+func (e *exporter) buildControlPlane(instanceGroups []*kops.InstanceGroup) (kubemanifest.ObjectList, error) {
+	first := instanceGroups[0]
+	var replicas int64
+	for _, ig := range instanceGroups {
+		replicas += int64(minSize(ig))
+		if ig.Spec.MachineType != first.Spec.MachineType || ig.Spec.Image != first.Spec.Image {
+			e.addUnmapped(ig, "machineType", fmt.Sprintf("control plane machines all use the machine template of instance group %q", first.Name))
+		}
+	}
+	if len(instanceGroups) > 1 {
+		e.addUnmapped(nil, "subnets", "the KubeadmControlPlane spreads machines across all failure domains, rather than pinning each control plane instance group to its zone")
+	}
+
+	templateName := e.cluster.Name + "-control-plane"
+	machineTemplate, err := e.buildMachineTemplate(first, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeRegistration := e.buildNodeRegistration(first)
+	apiServer := map[string]interface{}{
+		"extraArgs": map[string]interface{}{
+			"cloud-provider": "external",
+		},
+	}
+	if len(e.cluster.Spec.API.AdditionalSANs) != 0 {
+		apiServer["certSANs"] = stringList(e.cluster.Spec.API.AdditionalSANs)
+	}
+
+	controlPlane := e.newObject(controlPlaneAPIVersion, "KubeadmControlPlane", templateName, map[string]interface{}{
+		"replicas": replicas,
+		"version":  e.version(),
+		"machineTemplate": map[string]interface{}{
+			"infrastructureRef": objectRef(e.provider.apiVersion, e.provider.machineTemplateKind, templateName),
+		},
+		"kubeadmConfigSpec": map[string]interface{}{
+			"clusterConfiguration": map[string]interface{}{
+				"apiServer": apiServer,
+				"controllerManager": map[string]interface{}{
+					"extraArgs": map[string]interface{}{
+						"cloud-provider": "external",
+					},
+				},
+			},
+			"initConfiguration": map[string]interface{}{
+				"nodeRegistration": nodeRegistration,
+			},
+			"joinConfiguration": map[string]interface{}{
+				"nodeRegistration": nodeRegistration,
+			},
+		},
+	})
+
+	for _, ig := range instanceGroups {
+		e.reportInstanceGroupSettings(ig)
+	}
+
+	return kubemanifest.ObjectList{controlPlane, machineTemplate}, nil
+}
+
+func (e *exporter) buildMachineDeployment(ig *kops.InstanceGroup) (kubemanifest.ObjectList, error) {
+	name := e.cluster.Name + "-" + ig.Name
+
+	machineTemplate, err := e.buildMachineTemplate(ig, name)
+	if err != nil {
+		return nil, err
+	}
+
+	configTemplate := e.newObject(bootstrapAPIVersion, "KubeadmConfigTemplate", name, map[string]interface{}{
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"joinConfiguration": map[string]interface{}{
+					"nodeRegistration": e.buildNodeRegistration(ig),
+				},
+			},
+		},
+	})
+
+	machineSpec := map[string]interface{}{
+		"clusterName": e.cluster.Name,
+		"version":     e.version(),
+		"bootstrap": map[string]interface{}{
+			"configRef": objectRef(bootstrapAPIVersion, "KubeadmConfigTemplate", name),
+		},
+		"infrastructureRef": objectRef(e.provider.apiVersion, e.provider.machineTemplateKind, name),
+	}
+	if zones := e.zones(ig); len(zones) == 1 && e.cloudProvider != kops.CloudProviderAzure {
+		machineSpec["failureDomain"] = zones[0]
+	} else if len(zones) > 1 {
+		e.addUnmapped(ig, "subnets", fmt.Sprintf("a MachineDeployment is placed in a single failure domain; create one MachineDeployment per zone to span %s", strings.Join(zones, ", ")))
+	}
+
+	machineDeployment := e.newObject(clusterAPIVersion, "MachineDeployment", name, map[string]interface{}{
+		"clusterName": e.cluster.Name,
+		"replicas":    int64(minSize(ig)),
+		"template": map[string]interface{}{
+			"spec": machineSpec,
+		},
+	})
+	if ig.Spec.MaxSize != nil && *ig.Spec.MaxSize > minSize(ig) && (ig.Spec.Autoscale == nil || *ig.Spec.Autoscale) {
+		metadata := machineDeployment.ToUnstructured().Object["metadata"].(map[string]interface{})
+		metadata["annotations"] = map[string]interface{}{
+			autoscalerMinSizeAnnotation: fmt.Sprintf("%d", minSize(ig)),
+			autoscalerMaxSizeAnnotation: fmt.Sprintf("%d", *ig.Spec.MaxSize),
+		}
+	}
+
+	e.reportInstanceGroupSettings(ig)
+
+	return kubemanifest.ObjectList{machineDeployment, configTemplate, machineTemplate}, nil
+}
+
+// buildNodeRegistration maps the node labels and taints of an instance group to kubeadm.
+func (e *exporter) buildNodeRegistration(ig *kops.InstanceGroup) map[string]interface{} {
+	kubeletExtraArgs := map[string]interface{}{
+		"cloud-provider": "external",
+	}
+
+	var labels []string
+	for k, v := range ig.Spec.NodeLabels {
+		if strings.HasPrefix(k, "node-role.kubernetes.io/") || strings.HasPrefix(k, "kops.k8s.io/") {
+			// The kubelet is not allowed to set node-role labels, and kops.k8s.io labels are used by kOps itself
+			continue
+		}
+		labels = append(labels, k+"="+v)
+	}
+	sort.Strings(labels)
+	if len(labels) != 0 {
+		kubeletExtraArgs["node-labels"] = strings.Join(labels, ",")
+	}
+
+	nodeRegistration := map[string]interface{}{
+		"name":             e.provider.nodeName,
+		"kubeletExtraArgs": kubeletExtraArgs,
+	}
+
+	var taints []interface{}
+	for _, taint := range ig.Spec.Taints {
+		t, err := parseTaint(taint)
+		if err != nil {
+			e.addUnmapped(ig, "taints", err.Error())
+			continue
+		}
+		taints = append(taints, t)
+	}
+	if len(taints) != 0 {
+		nodeRegistration["taints"] = taints
+	}
+	return nodeRegistration
+}
+
+// parseTaint parses a taint in the kOps format key[=value]:effect.
+func parseTaint(s string) (map[string]interface{}, error) {
+	keyValue, effect, found := strings.Cut(s, ":")
+	if !found || effect == "" {
+		return nil, fmt.Errorf("taint %q does not specify an effect", s)
+	}
+	key, value, _ := strings.Cut(keyValue, "=")
+	taint := map[string]interface{}{
+		"key":    key,
+		"effect": effect,
+	}
+	if value != "" {
+		taint["value"] = value
+	}
+	return taint, nil
+}
+
+func (e *exporter) buildMachineTemplate(ig *kops.InstanceGroup, name string) (*kubemanifest.Object, error) {
+	var spec map[string]interface{}
+	switch e.cloudProvider {
+	case kops.CloudProviderAWS:
+		spec = e.buildAWSMachine(ig)
+	case kops.CloudProviderGCE:
+		spec = e.buildGCPMachine(ig)
+	case kops.CloudProviderAzure:
+		spec = e.buildAzureMachine(ig)
+	}
+	if ig.Spec.MachineType == "" {
+		return nil, fmt.Errorf("instance group %q does not specify a machineType", ig.Name)
+	}
+	return e.newObject(e.provider.apiVersion, e.provider.machineTemplateKind, name, map[string]interface{}{
+		"template": map[string]interface{}{
+			"spec": spec,
+		},
+	}), nil
+}
+
+func (e *exporter) buildAWSMachine(ig *kops.InstanceGroup) map[string]interface{} {
+	spec := map[string]interface{}{
+		"instanceType": ig.Spec.MachineType,
+	}
+	if strings.HasPrefix(ig.Spec.Image, "ami-") {
+		spec["ami"] = map[string]interface{}{"id": ig.Spec.Image}
+	} else if ig.Spec.Image != "" {
+		e.addUnmapped(ig, "image", fmt.Sprintf("image %q is resolved by name, which CAPA does not support; set ami.id to the resolved AMI", ig.Spec.Image))
+	}
+	if e.cluster.Spec.SSHKeyName != nil {
+		spec["sshKeyName"] = *e.cluster.Spec.SSHKeyName
+	}
+	if rootVolume := ig.Spec.RootVolume; rootVolume != nil {
+		volume := map[string]interface{}{}
+		if rootVolume.Size != nil {
+			volume["size"] = int64(*rootVolume.Size)
+		}
+		if rootVolume.Type != nil {
+			volume["type"] = *rootVolume.Type
+		}
+		if rootVolume.IOPS != nil {
+			volume["iops"] = int64(*rootVolume.IOPS)
+		}
+		if rootVolume.Throughput != nil {
+			volume["throughput"] = int64(*rootVolume.Throughput)
+		}
+		if rootVolume.Encryption != nil {
+			volume["encrypted"] = *rootVolume.Encryption
+		}
+		if rootVolume.EncryptionKey != nil {
+			volume["encryptionKey"] = *rootVolume.EncryptionKey
+		}
+		if len(volume) != 0 {
+			spec["rootVolume"] = volume
+		}
+	}
+	if ig.Spec.MaxPrice != nil {
+		spec["spotMarketOptions"] = map[string]interface{}{"maxPrice": *ig.Spec.MaxPrice}
+	}
+	if len(ig.Spec.CloudLabels) != 0 {
+		spec["additionalTags"] = stringMap(ig.Spec.CloudLabels)
+	}
+	if len(ig.Spec.AdditionalSecurityGroups) != 0 {
+		var groups []interface{}
+		for _, id := range ig.Spec.AdditionalSecurityGroups {
+			groups = append(groups, map[string]interface{}{"id": id})
+		}
+		spec["additionalSecurityGroups"] = groups
+	}
+	if ig.Spec.AssociatePublicIP != nil {
+		spec["publicIP"] = *ig.Spec.AssociatePublicIP
+	}
+	if ig.Spec.Tenancy != "" {
+		spec["tenancy"] = ig.Spec.Tenancy
+	}
+	if metadata := ig.Spec.InstanceMetadata; metadata != nil {
+		options := map[string]interface{}{}
+		if metadata.HTTPTokens != nil {
+			options["httpTokens"] = *metadata.HTTPTokens
+		}
+		if metadata.HTTPPutResponseHopLimit != nil {
+			options["httpPutResponseHopLimit"] = *metadata.HTTPPutResponseHopLimit
+		}
+		if len(options) != 0 {
+			spec["instanceMetadataOptions"] = options
+		}
+	}
+	if ig.Spec.IAM != nil && ig.Spec.IAM.Profile != nil {
+		profile := *ig.Spec.IAM.Profile
+		spec["iamInstanceProfile"] = profile[strings.LastIndex(profile, "/")+1:]
+	} else if ig.IsControlPlane() {
+		spec["iamInstanceProfile"] = "control-plane.cluster-api-provider-aws.sigs.k8s.io"
+	} else {
+		spec["iamInstanceProfile"] = "nodes.cluster-api-provider-aws.sigs.k8s.io"
+	}
+	return spec
+}
+
+func (e *exporter) buildGCPMachine(ig *kops.InstanceGroup) map[string]interface{} {
+	spec := map[string]interface{}{
+		"instanceType": ig.Spec.MachineType,
+	}
+	if image := ig.Spec.Image; image != "" {
+		if strings.HasPrefix(image, "projects/") || strings.HasPrefix(image, "https://") {
+			spec["image"] = image
+		} else if project, name, found := strings.Cut(image, "/"); found {
+			spec["image"] = "projects/" + project + "/global/images/" + name
+		} else {
+			e.addUnmapped(ig, "image", fmt.Sprintf("image %q does not identify its project", image))
+		}
+	}
+	if rootVolume := ig.Spec.RootVolume; rootVolume != nil {
+		if rootVolume.Size != nil {
+			spec["rootDeviceSize"] = int64(*rootVolume.Size)
+		}
+		if rootVolume.Type != nil {
+			spec["rootDeviceType"] = *rootVolume.Type
+		}
+	}
+	if ig.Spec.GCPProvisioningModel != nil && *ig.Spec.GCPProvisioningModel == "SPOT" {
+		spec["provisioningModel"] = "Spot"
+	}
+	if len(ig.Spec.CloudLabels) != 0 {
+		spec["additionalLabels"] = stringMap(ig.Spec.CloudLabels)
+	}
+	if ig.Spec.AssociatePublicIP != nil {
+		spec["publicIP"] = *ig.Spec.AssociatePublicIP
+	}
+	if len(ig.Spec.Subnets) == 1 {
+		spec["subnet"] = ig.Spec.Subnets[0]
+		for _, subnet := range e.cluster.Spec.Networking.Subnets {
+			if subnet.Name == ig.Spec.Subnets[0] && subnet.ID != "" {
+				spec["subnet"] = subnet.ID
+			}
+		}
+	}
+	return spec
+}
+
+func (e *exporter) buildAzureMachine(ig *kops.InstanceGroup) map[string]interface{} {
+	osDisk := map[string]interface{}{
+		"osType": "Linux",
+	}
+	spec := map[string]interface{}{
+		"vmSize": ig.Spec.MachineType,
+		"osDisk": osDisk,
+	}
+	if rootVolume := ig.Spec.RootVolume; rootVolume != nil {
+		if rootVolume.Size != nil {
+			osDisk["diskSizeGB"] = int64(*rootVolume.Size)
+		}
+		if rootVolume.Type != nil {
+			osDisk["managedDisk"] = map[string]interface{}{"storageAccountType": *rootVolume.Type}
+		}
+	}
+	if image := ig.Spec.Image; image != "" {
+		// kOps uses image URNs of the form publisher:offer:sku:version
+		urn := strings.Split(image, ":")
+		if len(urn) == 4 {
+			spec["image"] = map[string]interface{}{
+				"marketplace": map[string]interface{}{
+					"publisher": urn[0],
+					"offer":     urn[1],
+					"sku":       urn[2],
+					"version":   urn[3],
+				},
+			}
+		} else {
+			e.addUnmapped(ig, "image", fmt.Sprintf("image %q is not a marketplace image URN", image))
+		}
+	}
+	if ig.Spec.MaxPrice != nil {
+		spec["spotVMOptions"] = map[string]interface{}{"maxPrice": *ig.Spec.MaxPrice}
+	}
+	if len(ig.Spec.CloudLabels) != 0 {
+		spec["additionalTags"] = stringMap(ig.Spec.CloudLabels)
+	}
+	if len(e.zones(ig)) != 0 {
+		e.addUnmapped(ig, "zones", "CAPZ failure domains are availability zone numbers, which are taken from the cluster location")
+	}
+	return spec
+}
+
+// zones returns the zones that an instance group is placed in, through its subnets or zones.
+func (e *exporter) zones(ig *kops.InstanceGroup) []string {
+	if len(ig.Spec.Zones) != 0 {
+		return ig.Spec.Zones
+	}
+	var zones []string
+	for _, name := range ig.Spec.Subnets {
+		for _, subnet := range e.cluster.Spec.Networking.Subnets {
+			if subnet.Name == name && subnet.Zone != "" {
+				zones = append(zones, subnet.Zone)
+			}
+		}
+	}
+	return zones
+}
+
+// reportInstanceGroupSettings records the instance group settings that the manifests do not carry over.
+func (e *exporter) reportInstanceGroupSettings(ig *kops.InstanceGroup) {
+	spec := &ig.Spec
+	if len(spec.AdditionalUserData) != 0 {
+		e.addUnmapped(ig, "additionalUserData", "add the content to the kubeadm preKubeadmCommands or files")
+	}
+	if len(spec.FileAssets) != 0 {
+		e.addUnmapped(ig, "fileAssets", "add the files to the kubeadm config files")
+	}
+	if len(spec.Hooks) != 0 {
+		e.addUnmapped(ig, "hooks", "add the hooks to the kubeadm preKubeadmCommands or postKubeadmCommands")
+	}
+	if spec.Kubelet != nil {
+		e.addUnmapped(ig, "kubelet", "set the kubelet flags in kubeletExtraArgs")
+	}
+	if spec.Containerd != nil {
+		e.addUnmapped(ig, "containerd", "containerd is configured by the machine image")
+	}
+	if len(spec.Packages) != 0 {
+		e.addUnmapped(ig, "packages", "packages are installed by the machine image")
+	}
+	if len(spec.SysctlParameters) != 0 {
+		e.addUnmapped(ig, "sysctlParameters", "add the parameters to the kubeadm config files")
+	}
+	if len(spec.Volumes) != 0 || len(spec.VolumeMounts) != 0 {
+		e.addUnmapped(ig, "volumes", "additional volumes are not converted")
+	}
+	if spec.MixedInstancesPolicy != nil {
+		e.addUnmapped(ig, "mixedInstancesPolicy", "MachineDeployments use a single instance type")
+	}
+	if spec.WarmPool != nil {
+		e.addUnmapped(ig, "warmPool", "MachineDeployments have no warm pool")
+	}
+	if len(spec.ExternalLoadBalancers) != 0 {
+		e.addUnmapped(ig, "externalLoadBalancers", "machines are not registered with external load balancers")
+	}
+	if len(spec.GuestAccelerators) != 0 {
+		e.addUnmapped(ig, "guestAccelerators", "guest accelerators are not converted")
+	}
+	if spec.MaxInstanceLifetime != nil {
+		e.addUnmapped(ig, "maxInstanceLifetime", "use a MachineHealthCheck or MachineDeployment rollout to replace machines")
+	}
+	if spec.RollingUpdate != nil {
+		e.addUnmapped(ig, "rollingUpdate", "set the MachineDeployment strategy instead")
+	}
+	if e.cloudProvider != kops.CloudProviderAWS {
+		if len(spec.AdditionalSecurityGroups) != 0 {
+			e.addUnmapped(ig, "additionalSecurityGroups", "additional security groups are only supported by the AWS provider")
+		}
+		if spec.IAM != nil {
+			e.addUnmapped(ig, "iam", "instance profiles are only supported by the AWS provider")
+		}
+	}
+}
+
+// reportClusterSettings records the cluster settings that the manifests do not carry over.
+func (e *exporter) reportClusterSettings() {
+	spec := &e.cluster.Spec
+
+	if options := spec.Networking.ConfiguredOptions(); options.Len() != 0 {
+		e.addUnmapped(nil, "networking", fmt.Sprintf("Cluster API does not install a CNI; install %s, for example with a ClusterResourceSet", strings.Join(sets.List(options), ", ")))
+	}
+	if len(spec.EtcdClusters) != 0 {
+		e.addUnmapped(nil, "etcdClusters", "kubeadm runs a stacked etcd; restore an etcd-manager backup to migrate the cluster state")
+	}
+	if spec.DNSZone != "" && !e.cluster.UsesNoneDNS() {
+		e.addUnmapped(nil, "dnsZone", "the API server is reached through the provider's load balancer; DNS records are not created")
+	}
+	if len(spec.Addons) != 0 {
+		e.addUnmapped(nil, "addons", "apply the addons with a ClusterResourceSet or an addon provider")
+	}
+	for field, enabled := range map[string]bool{
+		"certManager":                   spec.CertManager != nil && fi.ValueOf(spec.CertManager.Enabled),
+		"clusterAutoscaler":             spec.ClusterAutoscaler != nil && fi.ValueOf(spec.ClusterAutoscaler.Enabled),
+		"externalDNS":                   spec.ExternalDNS != nil,
+		"karpenter":                     spec.Karpenter != nil && spec.Karpenter.Enabled,
+		"metricsServer":                 spec.MetricsServer != nil && fi.ValueOf(spec.MetricsServer.Enabled),
+		"nodeProblemDetector":           spec.NodeProblemDetector != nil && fi.ValueOf(spec.NodeProblemDetector.Enabled),
+		"snapshotController":            spec.SnapshotController != nil && fi.ValueOf(spec.SnapshotController.Enabled),
+		"serviceAccountIssuerDiscovery": spec.ServiceAccountIssuerDiscovery != nil,
+	} {
+		if enabled {
+			e.addUnmapped(nil, field, "kOps managed addons are not converted")
+		}
+	}
+	if aws := spec.CloudProvider.AWS; aws != nil {
+		if aws.LoadBalancerController != nil && fi.ValueOf(aws.LoadBalancerController.Enabled) {
+			e.addUnmapped(nil, "cloudProvider.aws.loadBalancerController", "kOps managed addons are not converted")
+		}
+		if aws.NodeTerminationHandler != nil && fi.ValueOf(aws.NodeTerminationHandler.Enabled) {
+			e.addUnmapped(nil, "cloudProvider.aws.nodeTerminationHandler", "kOps managed addons are not converted")
+		}
+	}
+	for field, set := range map[string]bool{
+		"kubeAPIServer":         spec.KubeAPIServer != nil,
+		"kubeControllerManager": spec.KubeControllerManager != nil,
+		"kubeScheduler":         spec.KubeScheduler != nil,
+		"kubeProxy":             spec.KubeProxy != nil,
+		"kubelet":               spec.Kubelet != nil,
+		"controlPlaneKubelet":   spec.ControlPlaneKubelet != nil,
+	} {
+		if set {
+			e.addUnmapped(nil, field, "set the flags as extraArgs in the kubeadm configuration")
+		}
+	}
+	if spec.Authentication != nil {
+		e.addUnmapped(nil, "authentication", "authentication webhooks are not converted")
+	}
+	if spec.IAM != nil && (len(spec.ExternalPolicies) != 0 || len(spec.AdditionalPolicies) != 0 || len(spec.IAM.ServiceAccountExternalPermissions) != 0) {
+		e.addUnmapped(nil, "iam", "IAM policies are not converted; attach them to the provider's instance profiles")
+	}
+	if len(spec.Hooks) != 0 {
+		e.addUnmapped(nil, "hooks", "add the hooks to the kubeadm preKubeadmCommands or postKubeadmCommands")
+	}
+	if len(spec.FileAssets) != 0 {
+		e.addUnmapped(nil, "fileAssets", "add the files to the kubeadm config files")
+	}
+	if len(spec.SSHAccess) != 0 || len(spec.API.Access) != 0 {
+		e.addUnmapped(nil, "sshAccess", "access restrictions are not converted; configure the provider's security groups or firewall rules")
+	}
+	if spec.EncryptionConfig != nil && *spec.EncryptionConfig {
+		e.addUnmapped(nil, "encryptionConfig", "add the encryption configuration to the kubeadm config files")
+	}
+
+	sort.Strings(e.unmapped)
+}
+
+// minSize returns the minimum size of an instance group, defaulting to a single instance.
+func minSize(ig *kops.InstanceGroup) int32 {
+	if ig.Spec.MinSize == nil {
+		return 1
+	}
+	return *ig.Spec.MinSize
+}
+
+func stringMap(m map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+func stringList(l []string) []interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, s := range l {
+		out = append(out, s)
+	}
+	return out
+}
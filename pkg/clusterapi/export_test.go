@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterapi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func buildCluster(cloudProvider kops.CloudProviderSpec, subnets ...kops.ClusterSubnetSpec) *kops.Cluster {
+	return &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "minimal.example.com"},
+		Spec: kops.ClusterSpec{
+			CloudProvider:     cloudProvider,
+			KubernetesVersion: "1.32.0",
+			SSHKeyName:        fi.PtrTo("my-key"),
+			Networking: kops.NetworkingSpec{
+				NetworkCIDR:           "172.20.0.0/16",
+				PodCIDR:               "100.96.0.0/11",
+				ServiceClusterIPRange: "100.64.0.0/13",
+				Subnets:               subnets,
+				Cilium:                &kops.CiliumNetworkingSpec{},
+			},
+			API: kops.APISpec{
+				LoadBalancer:   &kops.LoadBalancerAccessSpec{Type: kops.LoadBalancerTypeInternal},
+				AdditionalSANs: []string{"api.internal.example.com"},
+			},
+			EtcdClusters: []kops.EtcdClusterSpec{{Name: "main"}},
+		},
+	}
+}
+
+func buildInstanceGroup(name string, role kops.InstanceGroupRole, image string, subnets ...string) *kops.InstanceGroup {
+	return &kops.InstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: kops.InstanceGroupSpec{
+			Role:        role,
+			Image:       image,
+			MachineType: "m5.large",
+			MinSize:     fi.PtrTo(int32(1)),
+			MaxSize:     fi.PtrTo(int32(1)),
+			Subnets:     subnets,
+		},
+	}
+}
+
+func findObject(t *testing.T, export *Export, kind, name string) map[string]interface{} {
+	t.Helper()
+	for _, o := range export.Objects {
+		u := o.ToUnstructured()
+		if u.GetKind() == kind && u.GetName() == name {
+			return u.Object
+		}
+	}
+	t.Fatalf("object %s/%s not found", kind, name)
+	return nil
+}
+
+func assertField(t *testing.T, obj map[string]interface{}, expected interface{}, fields ...string) {
+	t.Helper()
+	actual, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found {
+		t.Errorf("field %s not found in %s", strings.Join(fields, "."), obj["kind"])
+		return
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected %s.%s: got %#v, expected %#v", obj["kind"], strings.Join(fields, "."), actual, expected)
+	}
+}
+
+func TestExportClusterAWS(t *testing.T) {
+	cluster := buildCluster(kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+		kops.ClusterSubnetSpec{Name: "us-test-1a", Zone: "us-test-1a", CIDR: "172.20.32.0/19", Type: kops.SubnetTypePrivate},
+		kops.ClusterSubnetSpec{Name: "utility-us-test-1a", Zone: "us-test-1a", ID: "subnet-1234", Type: kops.SubnetTypeUtility},
+	)
+	controlPlane := buildInstanceGroup("control-plane-us-test-1a", kops.InstanceGroupRoleControlPlane, "ami-12345678", "us-test-1a")
+	nodes := buildInstanceGroup("nodes", kops.InstanceGroupRoleNode, "099720109477/ubuntu/images/hvm-ssd/ubuntu-noble-24.04-amd64-server-20250101", "us-test-1a")
+	nodes.Spec.MinSize = fi.PtrTo(int32(2))
+	nodes.Spec.MaxSize = fi.PtrTo(int32(5))
+	nodes.Spec.MaxPrice = fi.PtrTo("0.1")
+	nodes.Spec.NodeLabels = map[string]string{"team": "a", "node-role.kubernetes.io/node": ""}
+	nodes.Spec.Taints = []string{"dedicated=a:NoSchedule"}
+	nodes.Spec.WarmPool = &kops.WarmPoolSpec{}
+	bastion := buildInstanceGroup("bastions", kops.InstanceGroupRoleBastion, "ami-12345678", "utility-us-test-1a")
+
+	export, err := ExportCluster(cluster, []*kops.InstanceGroup{controlPlane, nodes, bastion}, "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var kinds []string
+	for _, o := range export.Objects {
+		kinds = append(kinds, o.GroupVersionKind().Kind)
+	}
+	expectedKinds := []string{"Cluster", "AWSCluster", "KubeadmControlPlane", "AWSMachineTemplate", "MachineDeployment", "KubeadmConfigTemplate", "AWSMachineTemplate"}
+	if !reflect.DeepEqual(kinds, expectedKinds) {
+		t.Errorf("unexpected kinds: got %v, expected %v", kinds, expectedKinds)
+	}
+
+	c := findObject(t, export, "Cluster", "minimal.example.com")
+	assertField(t, c, []interface{}{"100.96.0.0/11"}, "spec", "clusterNetwork", "pods", "cidrBlocks")
+	assertField(t, c, "KubeadmControlPlane", "spec", "controlPlaneRef", "kind")
+
+	awsCluster := findObject(t, export, "AWSCluster", "minimal.example.com")
+	assertField(t, awsCluster, "us-test-1", "spec", "region")
+	assertField(t, awsCluster, "172.20.0.0/16", "spec", "network", "vpc", "cidrBlock")
+	assertField(t, awsCluster, []interface{}{
+		map[string]interface{}{"availabilityZone": "us-test-1a", "cidrBlock": "172.20.32.0/19", "isPublic": false},
+		map[string]interface{}{"availabilityZone": "us-test-1a", "id": "subnet-1234", "isPublic": true},
+	}, "spec", "network", "subnets")
+	assertField(t, awsCluster, "internal", "spec", "controlPlaneLoadBalancer", "scheme")
+	assertField(t, awsCluster, true, "spec", "bastion", "enabled")
+
+	kcp := findObject(t, export, "KubeadmControlPlane", "minimal.example.com-control-plane")
+	assertField(t, kcp, int64(1), "spec", "replicas")
+	assertField(t, kcp, "v1.32.0", "spec", "version")
+	assertField(t, kcp, []interface{}{"api.internal.example.com"}, "spec", "kubeadmConfigSpec", "clusterConfiguration", "apiServer", "certSANs")
+
+	cpTemplate := findObject(t, export, "AWSMachineTemplate", "minimal.example.com-control-plane")
+	assertField(t, cpTemplate, "ami-12345678", "spec", "template", "spec", "ami", "id")
+
+	md := findObject(t, export, "MachineDeployment", "minimal.example.com-nodes")
+	assertField(t, md, int64(2), "spec", "replicas")
+	assertField(t, md, "us-test-1a", "spec", "template", "spec", "failureDomain")
+	assertField(t, md, "5", "metadata", "annotations", autoscalerMaxSizeAnnotation)
+
+	config := findObject(t, export, "KubeadmConfigTemplate", "minimal.example.com-nodes")
+	nodeRegistration := []string{"spec", "template", "spec", "joinConfiguration", "nodeRegistration"}
+	assertField(t, config, "team=a", append(nodeRegistration, "kubeletExtraArgs", "node-labels")...)
+	assertField(t, config, []interface{}{map[string]interface{}{"key": "dedicated", "value": "a", "effect": "NoSchedule"}}, append(nodeRegistration, "taints")...)
+
+	nodesTemplate := findObject(t, export, "AWSMachineTemplate", "minimal.example.com-nodes")
+	assertField(t, nodesTemplate, "0.1", "spec", "template", "spec", "spotMarketOptions", "maxPrice")
+
+	for _, expected := range []string{
+		"instancegroup/nodes spec.image: ",
+		"instancegroup/nodes spec.warmPool: ",
+		"spec.etcdClusters: ",
+		"spec.networking: Cluster API does not install a CNI; install cilium",
+	} {
+		found := false
+		for _, s := range export.Unmapped {
+			if strings.HasPrefix(s, expected) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected unmapped setting %q in %v", expected, export.Unmapped)
+		}
+	}
+
+	y, err := export.ToYAML()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(string(y), "# The following kOps settings could not be mapped") {
+		t.Errorf("expected unmapped settings to be listed first, got %q", string(y))
+	}
+}
+
+func TestExportClusterGCE(t *testing.T) {
+	cluster := buildCluster(kops.CloudProviderSpec{GCE: &kops.GCESpec{Project: "my-project"}},
+		kops.ClusterSubnetSpec{Name: "us-test1", Region: "us-test1", CIDR: "10.0.16.0/20", Type: kops.SubnetTypePrivate},
+	)
+	controlPlane := buildInstanceGroup("control-plane-us-test1-a", kops.InstanceGroupRoleControlPlane, "ubuntu-os-cloud/ubuntu-2404-noble-amd64-v20250101", "us-test1")
+	controlPlane.Spec.Zones = []string{"us-test1-a"}
+	nodes := buildInstanceGroup("nodes", kops.InstanceGroupRoleNode, "ubuntu-os-cloud/ubuntu-2404-noble-amd64-v20250101", "us-test1")
+	nodes.Spec.GCPProvisioningModel = fi.PtrTo("SPOT")
+
+	export, err := ExportCluster(cluster, []*kops.InstanceGroup{controlPlane, nodes}, "clusters")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gcpCluster := findObject(t, export, "GCPCluster", "minimal.example.com")
+	assertField(t, gcpCluster, "my-project", "spec", "project")
+	assertField(t, gcpCluster, "us-test1", "spec", "region")
+	assertField(t, gcpCluster, "clusters", "metadata", "namespace")
+
+	nodesTemplate := findObject(t, export, "GCPMachineTemplate", "minimal.example.com-nodes")
+	assertField(t, nodesTemplate, "projects/ubuntu-os-cloud/global/images/ubuntu-2404-noble-amd64-v20250101", "spec", "template", "spec", "image")
+	assertField(t, nodesTemplate, "Spot", "spec", "template", "spec", "provisioningModel")
+}
+
+func TestExportClusterUnsupported(t *testing.T) {
+	cluster := buildCluster(kops.CloudProviderSpec{Hetzner: &kops.HetznerSpec{}})
+	controlPlane := buildInstanceGroup("control-plane-fsn1", kops.InstanceGroupRoleControlPlane, "ubuntu-24.04")
+
+	_, err := ExportCluster(cluster, []*kops.InstanceGroup{controlPlane}, "default")
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("expected unsupported cloud provider error, got %v", err)
+	}
+}
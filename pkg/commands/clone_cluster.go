@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// CloneClusterOptions configures the copy of a cluster
+type CloneClusterOptions struct {
+	// TargetName is the name of the new cluster
+	TargetName string
+	// Region is the region of the new cluster, defaulting to the region of the source cluster
+	Region string
+	// DNSZone is the DNS zone of the new cluster, defaulting to the DNS zone of the source cluster
+	DNSZone string
+	// IsolateNetwork creates a new network for the new cluster, instead of sharing the network and subnets of the source cluster
+	IsolateNetwork bool
+}
+
+// CloneCluster returns copies of the spec and instance groups of a cluster for a new cluster.
+// The name of the source cluster, and its region when the region changes, are substituted in all the fields.
+// Resources that are specific to the source region, such as a shared VPC, are dropped; the returned
+// warnings list the settings that may need to be reviewed before creating the new cluster.
+func CloneCluster(source *api.Cluster, sourceInstanceGroups []*api.InstanceGroup, options *CloneClusterOptions) (*api.Cluster, []*api.InstanceGroup, []string, error) {
+	if options.TargetName == "" {
+		return nil, nil, nil, fmt.Errorf("the name of the new cluster is required")
+	}
+	if options.TargetName == source.ObjectMeta.Name {
+		return nil, nil, nil, fmt.Errorf("the new cluster must have a different name than %q", source.ObjectMeta.Name)
+	}
+
+	replacer, changeRegion, err := cloneReplacer(source, options)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var warnings []string
+
+	obj, err := cloneObject(source, replacer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cluster, ok := obj.(*api.Cluster)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("unexpected object type %T for cluster %q", obj, source.ObjectMeta.Name)
+	}
+	cluster.ObjectMeta = metav1.ObjectMeta{
+		Name:        options.TargetName,
+		Labels:      cluster.ObjectMeta.Labels,
+		Annotations: cluster.ObjectMeta.Annotations,
+	}
+	// The state of the new cluster is stored next to the other clusters, with new secrets
+	cluster.Spec.ConfigStore = api.ConfigStoreSpec{}
+	if options.DNSZone != "" {
+		cluster.Spec.DNSZone = options.DNSZone
+	}
+
+	if changeRegion || options.IsolateNetwork {
+		if cluster.Spec.Networking.NetworkID != "" {
+			warnings = append(warnings, fmt.Sprintf("the network %q is not shared with the new cluster, a new network will be created", cluster.Spec.Networking.NetworkID))
+			cluster.Spec.Networking.NetworkID = ""
+		}
+		for i := range cluster.Spec.Networking.Subnets {
+			subnet := &cluster.Spec.Networking.Subnets[i]
+			if subnet.ID != "" {
+				warnings = append(warnings, fmt.Sprintf("the subnet %q (%s) is not shared with the new cluster, a new subnet will be created", subnet.Name, subnet.ID))
+				subnet.ID = ""
+			}
+			if subnet.Egress != "" && subnet.Egress != api.EgressExternal {
+				warnings = append(warnings, fmt.Sprintf("the egress %q of subnet %q belongs to the network of the source cluster and is not copied", subnet.Egress, subnet.Name))
+				subnet.Egress = ""
+			}
+		}
+		if lb := cluster.Spec.API.LoadBalancer; lb != nil && (lb.SecurityGroupOverride != nil || len(lb.AdditionalSecurityGroups) != 0) {
+			warnings = append(warnings, "the security groups of the API load balancer belong to the network of the source cluster and are not copied")
+			lb.SecurityGroupOverride = nil
+			lb.AdditionalSecurityGroups = nil
+		}
+	}
+
+	var instanceGroups []*api.InstanceGroup
+	for _, sourceIG := range sourceInstanceGroups {
+		obj, err := cloneObject(sourceIG, replacer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		ig, ok := obj.(*api.InstanceGroup)
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("unexpected object type %T for instance group %q", obj, sourceIG.ObjectMeta.Name)
+		}
+		ig.ObjectMeta = metav1.ObjectMeta{
+			Name:        ig.ObjectMeta.Name,
+			Labels:      ig.ObjectMeta.Labels,
+			Annotations: ig.ObjectMeta.Annotations,
+		}
+		if ig.ObjectMeta.Labels == nil {
+			ig.ObjectMeta.Labels = make(map[string]string)
+		}
+		ig.ObjectMeta.Labels[api.LabelClusterName] = options.TargetName
+
+		if changeRegion && strings.HasPrefix(ig.Spec.Image, "ami-") {
+			warnings = append(warnings, fmt.Sprintf("the image %q of instance group %q is specific to the region of the source cluster", ig.Spec.Image, ig.ObjectMeta.Name))
+		}
+		if (changeRegion || options.IsolateNetwork) && len(ig.Spec.AdditionalSecurityGroups) != 0 {
+			warnings = append(warnings, fmt.Sprintf("the additional security groups of instance group %q belong to the network of the source cluster and are not copied", ig.ObjectMeta.Name))
+			ig.Spec.AdditionalSecurityGroups = nil
+		}
+
+		instanceGroups = append(instanceGroups, ig)
+	}
+
+	return cluster, instanceGroups, warnings, nil
+}
+
+// CloneAddons returns copies of the addon objects of a cluster for a new cluster, with the same substitutions as CloneCluster
+func CloneAddons(source *api.Cluster, addons kubemanifest.ObjectList, options *CloneClusterOptions) (kubemanifest.ObjectList, error) {
+	if len(addons) == 0 {
+		return nil, nil
+	}
+	replacer, _, err := cloneReplacer(source, options)
+	if err != nil {
+		return nil, err
+	}
+	data, err := addons.ToYAML()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing addons: %w", err)
+	}
+	cloned, err := kubemanifest.LoadObjectsFrom([]byte(replacer.Replace(string(data))))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing addons: %w", err)
+	}
+	return cloned, nil
+}
+
+// cloneReplacer returns the substitutions of a copy of the cluster, and whether the copy is in another region
+func cloneReplacer(source *api.Cluster, options *CloneClusterOptions) (*strings.Replacer, bool, error) {
+	replacements := []string{source.ObjectMeta.Name, options.TargetName}
+
+	changeRegion := false
+	if options.Region != "" {
+		sourceRegion, err := clusterRegion(source)
+		if err != nil {
+			return nil, false, err
+		}
+		if sourceRegion != options.Region {
+			changeRegion = true
+			replacements = append(replacements, sourceRegion, options.Region)
+		}
+	}
+	return strings.NewReplacer(replacements...), changeRegion, nil
+}
+
+// cloneObject copies a kOps object through its versioned representation, substituting strings in all the fields
+func cloneObject(obj runtime.Object, replacer *strings.Replacer) (runtime.Object, error) {
+	data, err := kopscodecs.ToVersionedYaml(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing %T: %w", obj, err)
+	}
+	cloned, _, err := kopscodecs.Decode([]byte(replacer.Replace(string(data))), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %T: %w", obj, err)
+	}
+	return cloned, nil
+}
+
+// clusterRegion returns the region of the cluster, as determined from its subnets
+func clusterRegion(cluster *api.Cluster) (string, error) {
+	switch cluster.GetCloudProvider() {
+	case api.CloudProviderAWS:
+		return awsup.FindRegion(cluster)
+	case api.CloudProviderGCE:
+		for _, subnet := range cluster.Spec.Networking.Subnets {
+			if subnet.Region != "" {
+				return subnet.Region, nil
+			}
+			if subnet.Zone != "" {
+				return gce.ZoneToRegion(subnet.Zone)
+			}
+		}
+		return "", fmt.Errorf("unable to determine the region of cluster %q", cluster.ObjectMeta.Name)
+	default:
+		return "", fmt.Errorf("changing the region of a cluster is not supported for %s", cluster.GetCloudProvider())
+	}
+}
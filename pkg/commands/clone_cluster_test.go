@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func TestCloneCluster(t *testing.T) {
+	source := &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "prod.example.com",
+			ResourceVersion: "42",
+		},
+		Spec: kops.ClusterSpec{
+			CloudProvider: kops.CloudProviderSpec{
+				AWS: &kops.AWSSpec{},
+			},
+			ConfigStore: kops.ConfigStoreSpec{
+				Base: "s3://state/prod.example.com",
+			},
+			DNSZone:           "example.com",
+			KubernetesVersion: "1.33.0",
+			API: kops.APISpec{
+				PublicName: "api.prod.example.com",
+			},
+			Networking: kops.NetworkingSpec{
+				NetworkID:   "vpc-12345678",
+				NetworkCIDR: "172.20.0.0/16",
+				Subnets: []kops.ClusterSubnetSpec{
+					{Name: "us-east-1a", Zone: "us-east-1a", CIDR: "172.20.32.0/19", Type: kops.SubnetTypePrivate, ID: "subnet-1", Egress: "nat-1"},
+					{Name: "utility-us-east-1a", Zone: "us-east-1a", CIDR: "172.20.0.0/22", Type: kops.SubnetTypeUtility},
+				},
+			},
+		},
+	}
+	sourceIGs := []*kops.InstanceGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "control-plane-us-east-1a",
+				Labels: map[string]string{kops.LabelClusterName: "prod.example.com"},
+			},
+			Spec: kops.InstanceGroupSpec{
+				Role:                     kops.InstanceGroupRoleControlPlane,
+				Image:                    "ami-12345678",
+				Subnets:                  []string{"us-east-1a"},
+				AdditionalSecurityGroups: []string{"sg-1"},
+			},
+		},
+	}
+
+	if _, _, _, err := CloneCluster(source, sourceIGs, &CloneClusterOptions{TargetName: "prod.example.com"}); err == nil {
+		t.Errorf("expected error cloning a cluster with the same name")
+	}
+
+	cluster, igs, warnings, err := CloneCluster(source, sourceIGs, &CloneClusterOptions{
+		TargetName: "staging.example.org",
+		Region:     "eu-west-1",
+		DNSZone:    "example.org",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cluster.ObjectMeta.Name != "staging.example.org" || cluster.ObjectMeta.ResourceVersion != "" {
+		t.Errorf("unexpected metadata %+v", cluster.ObjectMeta)
+	}
+	if cluster.Spec.ConfigStore.Base != "" {
+		t.Errorf("expected the config store to be reset, got %q", cluster.Spec.ConfigStore.Base)
+	}
+	if cluster.Spec.DNSZone != "example.org" || cluster.Spec.API.PublicName != "api.staging.example.org" {
+		t.Errorf("unexpected DNS settings %q, %q", cluster.Spec.DNSZone, cluster.Spec.API.PublicName)
+	}
+	if cluster.Spec.KubernetesVersion != "1.33.0" || cluster.Spec.Networking.NetworkCIDR != "172.20.0.0/16" {
+		t.Errorf("expected the spec to be copied, got %+v", cluster.Spec)
+	}
+	if cluster.Spec.Networking.NetworkID != "" {
+		t.Errorf("expected the shared network to be dropped, got %q", cluster.Spec.Networking.NetworkID)
+	}
+	expectedSubnets := []kops.ClusterSubnetSpec{
+		{Name: "eu-west-1a", Zone: "eu-west-1a", CIDR: "172.20.32.0/19", Type: kops.SubnetTypePrivate},
+		{Name: "utility-eu-west-1a", Zone: "eu-west-1a", CIDR: "172.20.0.0/22", Type: kops.SubnetTypeUtility},
+	}
+	if !reflect.DeepEqual(cluster.Spec.Networking.Subnets, expectedSubnets) {
+		t.Errorf("unexpected subnets %+v", cluster.Spec.Networking.Subnets)
+	}
+
+	if len(igs) != 1 {
+		t.Fatalf("unexpected instance groups %+v", igs)
+	}
+	ig := igs[0]
+	if ig.ObjectMeta.Name != "control-plane-eu-west-1a" || ig.ObjectMeta.Labels[kops.LabelClusterName] != "staging.example.org" {
+		t.Errorf("unexpected instance group metadata %+v", ig.ObjectMeta)
+	}
+	if !reflect.DeepEqual(ig.Spec.Subnets, []string{"eu-west-1a"}) || ig.Spec.AdditionalSecurityGroups != nil {
+		t.Errorf("unexpected instance group spec %+v", ig.Spec)
+	}
+	if len(warnings) != 5 {
+		t.Errorf("expected warnings for the network, subnet, egress, image and security groups, got %q", warnings)
+	}
+
+	// The source is not modified
+	if source.Spec.Networking.NetworkID != "vpc-12345678" || sourceIGs[0].ObjectMeta.Name != "control-plane-us-east-1a" {
+		t.Errorf("source cluster was modified")
+	}
+}
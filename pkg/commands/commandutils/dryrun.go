@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commandutils
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// DryRunStrategy is how a command previews its changes instead of making them.
+type DryRunStrategy string
+
+const (
+	// DryRunNone makes the changes.
+	DryRunNone DryRunStrategy = "none"
+	// DryRunClient prints the objects which would be written, without checking them against the state store.
+	DryRunClient DryRunStrategy = "client"
+	// DryRunServer reads the state store and runs the same checks as a real run,
+	// then prints the changes which would be made to the state store, without making them.
+	DryRunServer DryRunStrategy = "server"
+)
+
+var _ pflag.Value = new(DryRunStrategy)
+
+// Enabled returns true if the changes should only be previewed.
+func (s DryRunStrategy) Enabled() bool {
+	return s == DryRunClient || s == DryRunServer
+}
+
+func (s *DryRunStrategy) String() string {
+	if *s == "" {
+		return string(DryRunNone)
+	}
+	return string(*s)
+}
+
+// Set parses the value of the --dry-run flag.
+// The values true and false are accepted for compatibility with the former boolean flag.
+func (s *DryRunStrategy) Set(value string) error {
+	switch value {
+	case string(DryRunNone), "false":
+		*s = DryRunNone
+	case string(DryRunClient), "true":
+		*s = DryRunClient
+	case string(DryRunServer):
+		*s = DryRunServer
+	default:
+		return fmt.Errorf("invalid dry-run value %q, must be one of none, client or server", value)
+	}
+	return nil
+}
+
+func (s *DryRunStrategy) Type() string {
+	return "string"
+}
+
+// AddDryRunFlag adds the --dry-run flag, with the same semantics across commands.
+// A bare --dry-run means client.
+func AddDryRunFlag(flags *pflag.FlagSet, strategy *DryRunStrategy) {
+	if *strategy == "" {
+		*strategy = DryRunNone
+	}
+	flags.Var(strategy, "dry-run", "Only preview the changes: \"client\" prints the objects which would be written, \"server\" also checks them against the state store and prints the changes to it. One of none, client or server.")
+	flags.Lookup("dry-run").NoOptDefVal = string(DryRunClient)
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// drDrillPrefix is the prefix of the names of the clusters created by disaster recovery drills
+const drDrillPrefix = "drill-"
+
+// DRDrillClusterName returns the name of the temporary cluster of a disaster recovery drill of a cluster.
+// The name keeps the domain of the source cluster, so that the drill uses the same DNS zone, or gossip.
+func DRDrillClusterName(sourceName string, now time.Time) string {
+	return drDrillPrefix + now.UTC().Format("20060102-150405") + "-" + sourceName
+}
+
+// BuildDRDrillCluster returns the spec and instance groups of the temporary cluster of a disaster recovery drill:
+// a copy of the source cluster in a network of its own, with only its control plane.
+// The backup stores of etcd are left empty; the caller sets them below the config base of the new cluster.
+func BuildDRDrillCluster(source *api.Cluster, sourceInstanceGroups []*api.InstanceGroup, name string) (*api.Cluster, []*api.InstanceGroup, []string, error) {
+	cluster, instanceGroups, warnings, err := CloneCluster(source, sourceInstanceGroups, &CloneClusterOptions{
+		TargetName:     name,
+		IsolateNetwork: true,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	cluster.Spec.DeletionProtection = false
+	for i := range cluster.Spec.EtcdClusters {
+		// The backup store of the source cluster must never be written by the drill,
+		// even when its path doesn't hold the name of the cluster
+		if cluster.Spec.EtcdClusters[i].Backups != nil {
+			cluster.Spec.EtcdClusters[i].Backups.BackupStore = ""
+		}
+	}
+
+	var controlPlane []*api.InstanceGroup
+	for _, ig := range instanceGroups {
+		if ig.Spec.Role != api.InstanceGroupRoleControlPlane {
+			continue
+		}
+		ig.Spec.MinSize = fi.PtrTo(int32(1))
+		ig.Spec.MaxSize = fi.PtrTo(int32(1))
+		controlPlane = append(controlPlane, ig)
+	}
+	if len(controlPlane) == 0 {
+		return nil, nil, nil, fmt.Errorf("cluster %q has no control plane instance groups", source.ObjectMeta.Name)
+	}
+
+	return cluster, controlPlane, warnings, nil
+}
+
+// DRDrillObjectCount is the number of objects of a kind in the source cluster and in the restored cluster.
+type DRDrillObjectCount struct {
+	Kind string `json:"kind"`
+	// Source is the number of objects in the source cluster, or nil if it could not be read
+	Source *int `json:"source,omitempty"`
+	// Restored is the number of objects in the restored cluster
+	Restored int `json:"restored"`
+	// OK is true if enough objects were restored
+	OK bool `json:"ok"`
+}
+
+// drDrillKinds are the kinds of objects counted by a disaster recovery drill
+var drDrillKinds = []struct {
+	kind  string
+	count func(ctx context.Context, client kubernetes.Interface) (int, error)
+}{
+	{"Namespace", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"ServiceAccount", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.CoreV1().ServiceAccounts("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"ConfigMap", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"Secret", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"Service", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.CoreV1().Services("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"Deployment", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"DaemonSet", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.AppsV1().DaemonSets("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+	{"StatefulSet", func(ctx context.Context, client kubernetes.Interface) (int, error) {
+		l, err := client.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return len(l.Items), nil
+	}},
+}
+
+// CountDRDrillObjects returns the number of objects of each kind counted by a disaster recovery drill.
+func CountDRDrillObjects(ctx context.Context, client kubernetes.Interface) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, k := range drDrillKinds {
+		n, err := k.count(ctx, client)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s objects: %w", k.kind, err)
+		}
+		counts[k.kind] = n
+	}
+	return counts, nil
+}
+
+// CompareDRDrillObjects compares the objects of the restored cluster with those of the source cluster.
+// The source cluster has changed since the backup was taken, so a kind is OK when the restored cluster
+// has at least minRatio of its objects in the source cluster. Kinds missing from source, because the
+// source cluster could not be read, are always OK.
+func CompareDRDrillObjects(source map[string]int, restored map[string]int, minRatio float64) []DRDrillObjectCount {
+	var result []DRDrillObjectCount
+	for _, k := range drDrillKinds {
+		c := DRDrillObjectCount{
+			Kind:     k.kind,
+			Restored: restored[k.kind],
+		}
+		if n, found := source[k.kind]; found {
+			c.Source = fi.PtrTo(n)
+			c.OK = c.Restored >= int(math.Ceil(float64(n)*minRatio))
+		} else {
+			c.OK = true
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+// DRDrillFailures returns the kinds of objects which were not restored, as a readable list.
+func DRDrillFailures(counts []DRDrillObjectCount) string {
+	var failed []string
+	for _, c := range counts {
+		if c.OK {
+			continue
+		}
+		if c.Source != nil {
+			failed = append(failed, fmt.Sprintf("%s (%d of %d)", c.Kind, c.Restored, *c.Source))
+		} else {
+			failed = append(failed, fmt.Sprintf("%s (%d)", c.Kind, c.Restored))
+		}
+	}
+	return strings.Join(failed, ", ")
+}
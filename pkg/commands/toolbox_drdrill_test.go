@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestDRDrillClusterName(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	if name := DRDrillClusterName("prod.example.com", now); name != "drill-20260102-150405-prod.example.com" {
+		t.Errorf("unexpected name %q", name)
+	}
+}
+
+func TestBuildDRDrillCluster(t *testing.T) {
+	source := &kops.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "prod.example.com"},
+		Spec: kops.ClusterSpec{
+			CloudProvider:      kops.CloudProviderSpec{AWS: &kops.AWSSpec{}},
+			DeletionProtection: true,
+			EtcdClusters: []kops.EtcdClusterSpec{
+				{
+					Name:    "main",
+					Members: []kops.EtcdMemberSpec{{Name: "a", InstanceGroup: fi.PtrTo("control-plane-us-east-1a")}},
+					Backups: &kops.EtcdBackupSpec{BackupStore: "s3://backups/main"},
+				},
+			},
+			Networking: kops.NetworkingSpec{
+				NetworkID: "vpc-12345678",
+				Subnets: []kops.ClusterSubnetSpec{
+					{Name: "us-east-1a", Zone: "us-east-1a", CIDR: "172.20.32.0/19", Type: kops.SubnetTypePrivate, ID: "subnet-1", Egress: "nat-1"},
+				},
+			},
+		},
+	}
+	sourceIGs := []*kops.InstanceGroup{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane-us-east-1a"},
+			Spec: kops.InstanceGroupSpec{
+				Role:                     kops.InstanceGroupRoleControlPlane,
+				MinSize:                  fi.PtrTo(int32(1)),
+				MaxSize:                  fi.PtrTo(int32(2)),
+				AdditionalSecurityGroups: []string{"sg-1"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+			Spec:       kops.InstanceGroupSpec{Role: kops.InstanceGroupRoleNode},
+		},
+	}
+
+	cluster, igs, _, err := BuildDRDrillCluster(source, sourceIGs, "drill-1-prod.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cluster.Name != "drill-1-prod.example.com" {
+		t.Errorf("unexpected name %q", cluster.Name)
+	}
+	if cluster.Spec.DeletionProtection {
+		t.Errorf("expected deletion protection to be disabled")
+	}
+	if cluster.Spec.EtcdClusters[0].Backups.BackupStore != "" {
+		t.Errorf("expected the backup store to be cleared, got %q", cluster.Spec.EtcdClusters[0].Backups.BackupStore)
+	}
+	if cluster.Spec.Networking.NetworkID != "" || cluster.Spec.Networking.Subnets[0].ID != "" || cluster.Spec.Networking.Subnets[0].Egress != "" {
+		t.Errorf("expected a network of its own, got %+v", cluster.Spec.Networking)
+	}
+	if source.Spec.Networking.NetworkID != "vpc-12345678" || source.Spec.EtcdClusters[0].Backups.BackupStore != "s3://backups/main" {
+		t.Errorf("source cluster was modified")
+	}
+
+	if len(igs) != 1 || igs[0].Name != "control-plane-us-east-1a" {
+		t.Fatalf("expected only the control plane instance group, got %v", igs)
+	}
+	if fi.ValueOf(igs[0].Spec.MinSize) != 1 || fi.ValueOf(igs[0].Spec.MaxSize) != 1 || len(igs[0].Spec.AdditionalSecurityGroups) != 0 {
+		t.Errorf("unexpected instance group spec %+v", igs[0].Spec)
+	}
+	if igs[0].Labels[kops.LabelClusterName] != "drill-1-prod.example.com" {
+		t.Errorf("unexpected labels %v", igs[0].Labels)
+	}
+
+	if _, _, _, err := BuildDRDrillCluster(source, sourceIGs[1:], "drill-1-prod.example.com"); err == nil {
+		t.Errorf("expected an error for a cluster without control plane")
+	}
+}
+
+func TestCompareDRDrillObjects(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "default"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "kube-system"}},
+	)
+	restored, err := CountDRDrillObjects(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restored["Namespace"] != 2 || restored["ConfigMap"] != 2 || restored["Secret"] != 0 {
+		t.Fatalf("unexpected counts %v", restored)
+	}
+
+	source := map[string]int{"Namespace": 2, "ConfigMap": 3, "Secret": 0}
+	counts := CompareDRDrillObjects(source, restored, 0.9)
+	ok := make(map[string]bool)
+	for _, c := range counts {
+		ok[c.Kind] = c.OK
+	}
+	expected := map[string]bool{
+		"Namespace":      true,
+		"ServiceAccount": true,
+		"ConfigMap":      false,
+		"Secret":         true,
+		"Service":        true,
+		"Deployment":     true,
+		"DaemonSet":      true,
+		"StatefulSet":    true,
+	}
+	if !reflect.DeepEqual(ok, expected) {
+		t.Errorf("unexpected results %v", ok)
+	}
+	if failures := DRDrillFailures(counts[2:4]); failures != "ConfigMap (2 of 3)" {
+		t.Errorf("unexpected failures %q", failures)
+	}
+
+	counts = CompareDRDrillObjects(nil, restored, 0.9)
+	if !counts[0].OK || counts[0].Source != nil || !counts[3].OK {
+		t.Errorf("unexpected results without source %+v", counts)
+	}
+}
@@ -62,6 +62,19 @@ func TestUnsetClusterFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			Fields: []string{
+				"spec.deletionProtection",
+			},
+			Input: kops.Cluster{
+				Spec: kops.ClusterSpec{
+					DeletionProtection: true,
+				},
+			},
+			Output: kops.Cluster{
+				Spec: kops.ClusterSpec{},
+			},
+		},
 		{
 			Fields: []string{
 				"spec.api.dns",
@@ -32,12 +32,17 @@ import (
 	"golang.org/x/crypto/ssh/agent"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/nodeexec"
 )
 
 // logDumper gets all the nodes from a kubernetes cluster and dumps a well-known set of logs
 type logDumper struct {
 	sshClientFactory sshClientFactory
 
+	// transport is set when connecting to instances using a cloud transport rather than SSH;
+	// nodes are then addressed by instance ID rather than by IP.
+	transport nodeexec.Transport
+
 	artifactsDir string
 
 	services     []string
@@ -56,6 +61,17 @@ func NewLogDumper(bastionAddress string, sshConfig *ssh.ClientConfig, keyRing ag
 		sshClientFactory.bastion = bastionAddress
 	}
 
+	return newLogDumper(sshClientFactory, artifactsDir)
+}
+
+// NewLogDumperForTransport builds a logDumper that runs commands using a cloud transport instead of SSH
+func NewLogDumperForTransport(transport nodeexec.Transport, artifactsDir string) *logDumper {
+	d := newLogDumper(&transportClientFactory{transport: transport}, artifactsDir)
+	d.transport = transport
+	return d
+}
+
+func newLogDumper(sshClientFactory sshClientFactory, artifactsDir string) *logDumper {
 	d := &logDumper{
 		sshClientFactory: sshClientFactory,
 		artifactsDir:     artifactsDir,
@@ -102,6 +118,7 @@ func NewLogDumper(bastionAddress string, sshConfig *ssh.ClientConfig, keyRing ag
 // DumpAllNodes connects to every node from kubectl get nodes and dumps the logs.
 // additionalIPs holds IP addresses of instances found by the deployment tool;
 // if the IPs are not found from kubectl get nodes, then these will be dumped also.
+// When using a cloud transport, additionalIPs holds instance IDs instead.
 // This allows for dumping log on nodes even if they don't register as a kubernetes
 // node, or if a node fails to register, or if the whole cluster fails to start.
 func (d *logDumper) DumpAllNodes(ctx context.Context, nodes corev1.NodeList, maxNodesToDump int, additionalIPs, additionalPrivateIPs []string) error {
@@ -184,6 +201,14 @@ func (d *logDumper) dumpRegistered(ctx context.Context, node *corev1.Node) error
 		return ctx.Err()
 	}
 
+	if d.transport != nil {
+		instanceID, err := nodeexec.InstanceIDFromProviderID(node.Spec.ProviderID)
+		if err != nil {
+			return err
+		}
+		return d.dumpNode(ctx, node.Name, instanceID, false)
+	}
+
 	var publicIP, privateIP string
 	for _, address := range node.Status.Addresses {
 		if address.Type == "ExternalIP" {
@@ -230,6 +255,9 @@ func findInstancesNotDumped(ips []string, dumped []*corev1.Node) []string {
 		for _, address := range node.Status.Addresses {
 			dumpedAddresses[address.Address] = true
 		}
+		if instanceID, err := nodeexec.InstanceIDFromProviderID(node.Spec.ProviderID); err == nil {
+			dumpedAddresses[instanceID] = true
+		}
 	}
 
 	for _, ip := range ips {
@@ -630,3 +658,44 @@ func (f *sshClientFactoryImplementation) Dial(ctx context.Context, host string,
 		}, nil
 	}
 }
+
+// transportClientFactory is an implementation of sshClientFactory that runs commands using a nodeexec.Transport
+type transportClientFactory struct {
+	transport nodeexec.Transport
+}
+
+var _ sshClientFactory = &transportClientFactory{}
+
+// HasBastion implements sshClientFactory::HasBastion
+func (f *transportClientFactory) HasBastion() bool {
+	return false
+}
+
+// Dial implements sshClientFactory::Dial; host is the instance ID
+func (f *transportClientFactory) Dial(ctx context.Context, host string, useBastion bool) (sshClient, error) {
+	if host == "" {
+		return nil, fmt.Errorf("instance ID is empty")
+	}
+	return &transportClient{
+		transport:  f.transport,
+		instanceID: host,
+	}, nil
+}
+
+// transportClient is an implementation of sshClient bound to an instance, using a nodeexec.Transport
+type transportClient struct {
+	transport  nodeexec.Transport
+	instanceID string
+}
+
+var _ sshClient = &transportClient{}
+
+// ExecPiped implements sshClient::ExecPiped
+func (c *transportClient) ExecPiped(ctx context.Context, command string, stdout io.Writer, stderr io.Writer) error {
+	return c.transport.Exec(ctx, c.instanceID, command, stdout, stderr)
+}
+
+// Close implements sshClient::Close
+func (c *transportClient) Close() error {
+	return nil
+}
@@ -98,6 +98,12 @@ var (
 	Metal = new("Metal", Bool(false))
 	// AWSSingleNodesInstanceGroup enables the creation of a single node instance group instead of one per availability zone.
 	AWSSingleNodesInstanceGroup = new("AWSSingleNodesInstanceGroup", Bool(false))
+	// UnsafeControlPlaneOnSpot allows control plane instance groups to prefer spot capacity. Not for production clusters.
+	UnsafeControlPlaneOnSpot = new("UnsafeControlPlaneOnSpot", Bool(false))
+	// WindowsNodes enables the experimental support for instance groups of Windows nodes.
+	WindowsNodes = new("WindowsNodes", Bool(false))
+	// AWSDescribeCache caches and batches the EC2 describe calls made by the tasks of a cluster on AWS.
+	AWSDescribeCache = new("AWSDescribeCache", Bool(true))
 )
 
 // FeatureFlag defines a feature flag
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hibernation scales the instance groups of a cluster to zero, and stops its control plane where the cloud
+// supports it, then restores them.
+package hibernation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+const (
+	// AnnotationMinSize holds the minimum size of a hibernated instance group
+	AnnotationMinSize = "hibernation.kops.k8s.io/min-size"
+	// AnnotationMaxSize holds the maximum size of a hibernated instance group
+	AnnotationMaxSize = "hibernation.kops.k8s.io/max-size"
+	// AnnotationSuspendProcesses holds the suspended processes of a hibernated control plane instance group
+	AnnotationSuspendProcesses = "hibernation.kops.k8s.io/suspend-processes"
+)
+
+// controlPlaneSuspendProcesses are the processes of the autoscaling groups of the control plane which are suspended
+// while it is stopped, so that the stopped instances are not replaced
+var controlPlaneSuspendProcesses = []string{"Launch", "Terminate", "HealthCheck", "ReplaceUnhealthy", "AZRebalance"}
+
+// StopsControlPlane returns whether the control plane of the cluster is stopped while it is hibernated.
+// Only AWS supports it; on other clouds, the control plane keeps running.
+func StopsControlPlane(cluster *kops.Cluster) bool {
+	return cluster.GetCloudProvider() == kops.CloudProviderAWS
+}
+
+// IsHibernated returns whether any of the instance groups is hibernated
+func IsHibernated(instanceGroups []*kops.InstanceGroup) bool {
+	for _, ig := range instanceGroups {
+		if isHibernated(ig) {
+			return true
+		}
+	}
+	return false
+}
+
+func isHibernated(ig *kops.InstanceGroup) bool {
+	_, sizes := ig.Annotations[AnnotationMinSize]
+	_, processes := ig.Annotations[AnnotationSuspendProcesses]
+	return sizes || processes
+}
+
+// Hibernate changes the specs of the instance groups of a cluster to hibernate it, returning those which changed.
+// The instance groups other than the control plane are scaled to zero, and the processes of the autoscaling groups
+// of the control plane which would replace its stopped instances are suspended. The previous values are kept
+// in annotations, for Resume. Instance groups managed by Karpenter are left unchanged.
+func Hibernate(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) ([]*kops.InstanceGroup, error) {
+	if IsHibernated(instanceGroups) {
+		return nil, fmt.Errorf("cluster %q is already hibernated", cluster.Name)
+	}
+
+	var changed []*kops.InstanceGroup
+	for _, original := range instanceGroups {
+		if original.Spec.Manager == kops.InstanceManagerKarpenter {
+			continue
+		}
+
+		ig := original.DeepCopy()
+		if ig.Annotations == nil {
+			ig.Annotations = make(map[string]string)
+		}
+		if ig.Spec.Role == kops.InstanceGroupRoleControlPlane {
+			if !StopsControlPlane(cluster) {
+				continue
+			}
+			ig.Annotations[AnnotationSuspendProcesses] = strings.Join(ig.Spec.SuspendProcesses, ",")
+			for _, process := range controlPlaneSuspendProcesses {
+				if !slices.Contains(ig.Spec.SuspendProcesses, process) {
+					ig.Spec.SuspendProcesses = append(ig.Spec.SuspendProcesses, process)
+				}
+			}
+		} else {
+			ig.Annotations[AnnotationMinSize] = formatSize(ig.Spec.MinSize)
+			ig.Annotations[AnnotationMaxSize] = formatSize(ig.Spec.MaxSize)
+			ig.Spec.MinSize = fi.PtrTo(int32(0))
+			ig.Spec.MaxSize = fi.PtrTo(int32(0))
+		}
+		changed = append(changed, ig)
+	}
+	return changed, nil
+}
+
+// Resume restores the specs of the hibernated instance groups of a cluster, returning those which changed.
+func Resume(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) ([]*kops.InstanceGroup, error) {
+	if !IsHibernated(instanceGroups) {
+		return nil, fmt.Errorf("cluster %q is not hibernated", cluster.Name)
+	}
+
+	var changed []*kops.InstanceGroup
+	for _, original := range instanceGroups {
+		if !isHibernated(original) {
+			continue
+		}
+
+		ig := original.DeepCopy()
+		if processes, found := ig.Annotations[AnnotationSuspendProcesses]; found {
+			ig.Spec.SuspendProcesses = nil
+			if processes != "" {
+				ig.Spec.SuspendProcesses = strings.Split(processes, ",")
+			}
+			delete(ig.Annotations, AnnotationSuspendProcesses)
+		}
+		if _, found := ig.Annotations[AnnotationMinSize]; found {
+			minSize, maxSize, err := ResumeSizes(ig)
+			if err != nil {
+				return nil, err
+			}
+			ig.Spec.MinSize = minSize
+			ig.Spec.MaxSize = maxSize
+			delete(ig.Annotations, AnnotationMinSize)
+			delete(ig.Annotations, AnnotationMaxSize)
+		}
+		changed = append(changed, ig)
+	}
+	return changed, nil
+}
+
+// ResumeSizes returns the sizes an instance group is resumed to: those kept in its annotations while it is
+// hibernated, otherwise those of its spec. Nil sizes are unset.
+func ResumeSizes(ig *kops.InstanceGroup) (*int32, *int32, error) {
+	if _, found := ig.Annotations[AnnotationMinSize]; !found {
+		return ig.Spec.MinSize, ig.Spec.MaxSize, nil
+	}
+	minSize, err := sizeAnnotation(ig, AnnotationMinSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	maxSize, err := sizeAnnotation(ig, AnnotationMaxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	return minSize, maxSize, nil
+}
+
+// formatSize formats a size for an annotation, where an empty value is an unset size
+func formatSize(size *int32) string {
+	if size == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*size))
+}
+
+func sizeAnnotation(ig *kops.InstanceGroup, annotation string) (*int32, error) {
+	if ig.Annotations[annotation] == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(ig.Annotations[annotation], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid annotation %s of instance group %q: %w", annotation, ig.Name, err)
+	}
+	return fi.PtrTo(int32(n)), nil
+}
+
+// controlPlaneInstanceIDs returns the IDs of the instances of the control plane instance groups
+func controlPlaneInstanceIDs(cloud fi.Cloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) ([]string, error) {
+	var controlPlane []*kops.InstanceGroup
+	for _, ig := range instanceGroups {
+		if ig.Spec.Role == kops.InstanceGroupRoleControlPlane {
+			controlPlane = append(controlPlane, ig)
+		}
+	}
+
+	groups, err := cloud.GetCloudGroups(cluster, controlPlane, false, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the control plane instances: %w", err)
+	}
+	var ids []string
+	for _, group := range groups {
+		for _, instance := range append(group.Ready, group.NeedUpdate...) {
+			ids = append(ids, instance.ID)
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// StopControlPlane stops the instances of the control plane, returning their IDs.
+// The processes of their autoscaling groups must have been suspended by applying the specs returned by Hibernate.
+func StopControlPlane(ctx context.Context, cloud fi.Cloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) ([]string, error) {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil, fmt.Errorf("stopping the control plane is not supported on %s", cluster.GetCloudProvider())
+	}
+
+	ids, err := controlPlaneInstanceIDs(cloud, cluster, instanceGroups)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	if _, err := awsCloud.EC2().StopInstances(ctx, &ec2.StopInstancesInput{InstanceIds: ids}); err != nil {
+		return nil, fmt.Errorf("error stopping the control plane instances: %w", err)
+	}
+	return ids, nil
+}
+
+// StartControlPlane starts the instances of the control plane and waits for them to be running, returning their IDs.
+// It must be called before the processes of their autoscaling groups are resumed.
+func StartControlPlane(ctx context.Context, cloud fi.Cloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, timeout time.Duration) ([]string, error) {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return nil, fmt.Errorf("starting the control plane is not supported on %s", cluster.GetCloudProvider())
+	}
+
+	ids, err := controlPlaneInstanceIDs(cloud, cluster, instanceGroups)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	if _, err := awsCloud.EC2().StartInstances(ctx, &ec2.StartInstancesInput{InstanceIds: ids}); err != nil {
+		return nil, fmt.Errorf("error starting the control plane instances: %w", err)
+	}
+	waiter := ec2.NewInstanceRunningWaiter(awsCloud.EC2())
+	if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{InstanceIds: ids}, timeout); err != nil {
+		return nil, fmt.Errorf("error waiting for the control plane instances to be running: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func buildInstanceGroups() []*kops.InstanceGroup {
+	controlPlane := testutils.BuildMinimalMasterInstanceGroup("subnet-us-test-1a")
+	controlPlane.Spec.SuspendProcesses = []string{"AZRebalance"}
+
+	nodes := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	nodes.Spec.MinSize = fi.PtrTo(int32(2))
+	nodes.Spec.MaxSize = fi.PtrTo(int32(5))
+
+	defaults := testutils.BuildMinimalNodeInstanceGroup("defaults", "subnet-us-test-1a")
+
+	karpenter := testutils.BuildMinimalNodeInstanceGroup("karpenter", "subnet-us-test-1a")
+	karpenter.Spec.Manager = kops.InstanceManagerKarpenter
+
+	return []*kops.InstanceGroup{&controlPlane, &nodes, &defaults, &karpenter}
+}
+
+func TestHibernateAndResume(t *testing.T) {
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+	instanceGroups := buildInstanceGroups()
+
+	hibernated, err := Hibernate(cluster, instanceGroups)
+	if err != nil {
+		t.Fatalf("unexpected error hibernating: %v", err)
+	}
+	if len(hibernated) != 3 {
+		t.Fatalf("expected 3 instance groups to change, got %d", len(hibernated))
+	}
+
+	controlPlane, nodes := hibernated[0], hibernated[1]
+	expectedProcesses := []string{"AZRebalance", "Launch", "Terminate", "HealthCheck", "ReplaceUnhealthy"}
+	if !reflect.DeepEqual(controlPlane.Spec.SuspendProcesses, expectedProcesses) {
+		t.Errorf("expected suspended processes %v, got %v", expectedProcesses, controlPlane.Spec.SuspendProcesses)
+	}
+	if controlPlane.Spec.MinSize != nil || controlPlane.Spec.MaxSize != nil {
+		t.Errorf("expected the control plane not to be scaled")
+	}
+	if fi.ValueOf(nodes.Spec.MinSize) != 0 || fi.ValueOf(nodes.Spec.MaxSize) != 0 {
+		t.Errorf("expected nodes to be scaled to zero, got %d-%d", fi.ValueOf(nodes.Spec.MinSize), fi.ValueOf(nodes.Spec.MaxSize))
+	}
+	if fi.ValueOf(instanceGroups[1].Spec.MinSize) != 2 {
+		t.Errorf("expected the original instance groups to be unchanged")
+	}
+	if !IsHibernated(hibernated) {
+		t.Errorf("expected the instance groups to be hibernated")
+	}
+
+	if _, err := Hibernate(cluster, hibernated); err == nil {
+		t.Errorf("expected an error hibernating a hibernated cluster")
+	}
+
+	resumed, err := Resume(cluster, append(hibernated, instanceGroups[3]))
+	if err != nil {
+		t.Fatalf("unexpected error resuming: %v", err)
+	}
+	if len(resumed) != 3 {
+		t.Fatalf("expected 3 instance groups to change, got %d", len(resumed))
+	}
+	for i, ig := range resumed {
+		if !reflect.DeepEqual(ig.Spec, instanceGroups[i].Spec) {
+			t.Errorf("expected instance group %q to be restored, got %+v", ig.Name, ig.Spec)
+		}
+		if len(ig.Annotations) != 0 {
+			t.Errorf("expected the annotations of instance group %q to be removed, got %v", ig.Name, ig.Annotations)
+		}
+	}
+
+	if _, err := Resume(cluster, resumed); err == nil {
+		t.Errorf("expected an error resuming a cluster which is not hibernated")
+	}
+}
+
+func TestHibernateKeepsControlPlaneRunning(t *testing.T) {
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+	cluster.Spec.CloudProvider = kops.CloudProviderSpec{GCE: &kops.GCESpec{}}
+
+	hibernated, err := Hibernate(cluster, buildInstanceGroups())
+	if err != nil {
+		t.Fatalf("unexpected error hibernating: %v", err)
+	}
+	if len(hibernated) != 2 {
+		t.Fatalf("expected only the nodes to change, got %d instance groups", len(hibernated))
+	}
+	for _, ig := range hibernated {
+		if ig.Spec.Role == kops.InstanceGroupRoleControlPlane {
+			t.Errorf("expected the control plane not to change")
+		}
+	}
+	if StopsControlPlane(cluster) {
+		t.Errorf("expected the control plane not to be stopped on GCE")
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imagechannel resolves the image channels of instance groups to their latest image, and keeps track of the
+// image each instance group is pinned to.
+package imagechannel
+
+import (
+	"fmt"
+	"sort"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/util/pkg/architectures"
+)
+
+// AnnotationPinnedImage holds the image an instance group with an image channel is pinned to
+const AnnotationPinnedImage = "imagechannel.kops.k8s.io/pinned-image"
+
+// channels maps the name of each image channel to the SSM parameters holding its latest image, by architecture
+var channels = map[string]map[architectures.Architecture]string{
+	"ubuntu-22.04-stable": {
+		architectures.ArchitectureAmd64: "/aws/service/canonical/ubuntu/server/22.04/stable/current/amd64/hvm/ebs-gp2/ami-id",
+		architectures.ArchitectureArm64: "/aws/service/canonical/ubuntu/server/22.04/stable/current/arm64/hvm/ebs-gp2/ami-id",
+	},
+	"ubuntu-24.04-stable": {
+		architectures.ArchitectureAmd64: "/aws/service/canonical/ubuntu/server/24.04/stable/current/amd64/hvm/ebs-gp3/ami-id",
+		architectures.ArchitectureArm64: "/aws/service/canonical/ubuntu/server/24.04/stable/current/arm64/hvm/ebs-gp3/ami-id",
+	},
+	"debian-12-stable": {
+		architectures.ArchitectureAmd64: "/aws/service/debian/release/12/latest/amd64",
+		architectures.ArchitectureArm64: "/aws/service/debian/release/12/latest/arm64",
+	},
+	"amazonlinux-2023-stable": {
+		architectures.ArchitectureAmd64: "/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-x86_64",
+		architectures.ArchitectureArm64: "/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-arm64",
+	},
+}
+
+// ImageResolver resolves an image specification to an image, as AWSCloud does
+type ImageResolver interface {
+	ResolveImage(name string) (*ec2types.Image, error)
+}
+
+// Names returns the names of the known image channels, sorted
+func Names() []string {
+	var names []string
+	for name := range channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Latest returns the ID of the latest image of the channel for the architecture
+func Latest(resolver ImageResolver, channel string, architecture architectures.Architecture) (string, error) {
+	parameters, ok := channels[channel]
+	if !ok {
+		return "", fmt.Errorf("unknown image channel %q", channel)
+	}
+	parameter, ok := parameters[architecture]
+	if !ok {
+		return "", fmt.Errorf("image channel %q has no images for architecture %q", channel, architecture)
+	}
+
+	image, err := resolver.ResolveImage("ssm:" + parameter)
+	if err != nil {
+		return "", fmt.Errorf("resolving the latest image of channel %q: %w", channel, err)
+	}
+	if image == nil || image.ImageId == nil {
+		return "", fmt.Errorf("no image found for channel %q", channel)
+	}
+	return *image.ImageId, nil
+}
+
+// Pinned returns the image the instance group is pinned to, or "" if it isn't pinned yet
+func Pinned(ig *kops.InstanceGroup) string {
+	return ig.Annotations[AnnotationPinnedImage]
+}
+
+// Pin pins the instance group to the image
+func Pin(ig *kops.InstanceGroup, image string) {
+	if ig.Annotations == nil {
+		ig.Annotations = make(map[string]string)
+	}
+	ig.Annotations[AnnotationPinnedImage] = image
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagechannel
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/util/pkg/architectures"
+)
+
+// fakeResolver resolves the SSM parameters of the images to their ID
+type fakeResolver map[string]string
+
+func (r fakeResolver) ResolveImage(name string) (*ec2types.Image, error) {
+	id, ok := r[name]
+	if !ok {
+		return nil, fmt.Errorf("image %q not found", name)
+	}
+	return &ec2types.Image{ImageId: aws.String(id)}, nil
+}
+
+func TestLatest(t *testing.T) {
+	resolver := fakeResolver{
+		"ssm:/aws/service/canonical/ubuntu/server/24.04/stable/current/amd64/hvm/ebs-gp3/ami-id": "ami-amd64",
+		"ssm:/aws/service/canonical/ubuntu/server/24.04/stable/current/arm64/hvm/ebs-gp3/ami-id": "ami-arm64",
+	}
+
+	grid := []struct {
+		Channel       string
+		Architecture  architectures.Architecture
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			Channel:      "ubuntu-24.04-stable",
+			Architecture: architectures.ArchitectureAmd64,
+			Expected:     "ami-amd64",
+		},
+		{
+			Channel:      "ubuntu-24.04-stable",
+			Architecture: architectures.ArchitectureArm64,
+			Expected:     "ami-arm64",
+		},
+		{
+			Channel:       "ubuntu-24.04-daily",
+			Architecture:  architectures.ArchitectureAmd64,
+			ExpectedError: true,
+		},
+		{
+			Channel:       "debian-12-stable",
+			Architecture:  architectures.ArchitectureAmd64,
+			ExpectedError: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Channel+"/"+string(g.Architecture), func(t *testing.T) {
+			image, err := Latest(resolver, g.Channel, g.Architecture)
+			if g.ExpectedError {
+				if err == nil {
+					t.Fatalf("expected an error, got image %q", image)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if image != g.Expected {
+				t.Errorf("expected image %q, got %q", g.Expected, image)
+			}
+		})
+	}
+}
+
+func TestPin(t *testing.T) {
+	ig := testutils.BuildMinimalNodeInstanceGroup("nodes", "subnet-us-test-1a")
+	if pinned := Pinned(&ig); pinned != "" {
+		t.Fatalf("expected no pinned image, got %q", pinned)
+	}
+
+	Pin(&ig, "ami-1234")
+	if pinned := Pinned(&ig); pinned != "ami-1234" {
+		t.Errorf("expected pinned image %q, got %q", "ami-1234", pinned)
+	}
+}
@@ -24,6 +24,10 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup"
 
@@ -99,6 +103,15 @@ func promptInteractive(upgradedHostID, upgradedHostName string) (stopPrompting b
 
 // RollingUpdate performs a rolling update on a list of instances.
 func (c *RollingUpdateCluster) rollingUpdateInstanceGroup(ctx context.Context, group *cloudinstances.CloudInstanceGroup, sleepAfterTerminate time.Duration) (err error) {
+	ctx, span := tracer.Start(ctx, "RollingUpdateCluster::rollingUpdateInstanceGroup", trace.WithAttributes(attribute.String("instancegroup", group.InstanceGroup.Name)))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	isBastion := group.InstanceGroup.IsBastion()
 	// Do not need a k8s client if you are doing cloudonly.
 	if c.K8sClient == nil && !c.CloudOnly {
@@ -413,6 +426,9 @@ func (c *RollingUpdateCluster) patchExcludeFromLB(ctx context.Context, node *cor
 }
 
 func (c *RollingUpdateCluster) drainTerminateAndWait(ctx context.Context, u *cloudinstances.CloudInstance, sleepAfterTerminate time.Duration) error {
+	ctx, span := tracer.Start(ctx, "RollingUpdateCluster::drainTerminateAndWait", trace.WithAttributes(attribute.String("instance", u.ID)))
+	defer span.End()
+
 	instanceID := u.ID
 
 	nodeName := ""
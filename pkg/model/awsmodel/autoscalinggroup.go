@@ -28,6 +28,7 @@ import (
 
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/featureflag"
+	kopshibernation "k8s.io/kops/pkg/hibernation"
 	"k8s.io/kops/pkg/model"
 	"k8s.io/kops/pkg/model/defaults"
 	"k8s.io/kops/upup/pkg/fi"
@@ -129,12 +130,84 @@ func (b *AutoscalingGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) e
 
 			c.AddTask(lifecyleTask)
 
+			if err := b.buildHibernationTasks(c, ig, asg); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// buildHibernationTasks adds the scheduled actions which scale the autoscaling group to zero and back,
+// for the instance groups other than the control plane of clusters with scheduled hibernation.
+// The actions are listed in a tag of the autoscaling group, so that they are deleted when hibernation is turned off.
+func (b *AutoscalingGroupModelBuilder) buildHibernationTasks(c *fi.CloudupModelBuilderContext, ig *kops.InstanceGroup, asg *awstasks.AutoscalingGroup) error {
+	hibernation := b.Cluster.Spec.Hibernation
+	if hibernation == nil || ig.Spec.Role == kops.InstanceGroupRoleControlPlane {
+		return nil
+	}
+
+	timeZone := hibernation.TimeZone
+	if timeZone == "" {
+		timeZone = "Etc/UTC"
+	}
+
+	// The instance group may be hibernated manually, in which case its spec is scaled to zero
+	minSize, maxSize, err := kopshibernation.ResumeSizes(ig)
+	if err != nil {
+		return err
+	}
+	minSize, maxSize = autoscalingGroupSizes(ig, minSize, maxSize)
+
+	hibernate := &awstasks.AutoscalingScheduledAction{
+		Name:             aws.String("kops-hibernate-" + ig.GetName()),
+		Lifecycle:        b.Lifecycle,
+		ActionName:       aws.String("kops-hibernate"),
+		AutoscalingGroup: b.LinkToAutoscalingGroup(ig),
+		Recurrence:       aws.String(hibernation.Schedule),
+		TimeZone:         aws.String(timeZone),
+		MinSize:          aws.Int32(0),
+		MaxSize:          aws.Int32(0),
+		DesiredCapacity:  aws.Int32(0),
+	}
+	resume := &awstasks.AutoscalingScheduledAction{
+		Name:             aws.String("kops-resume-" + ig.GetName()),
+		Lifecycle:        b.Lifecycle,
+		ActionName:       aws.String("kops-resume"),
+		AutoscalingGroup: b.LinkToAutoscalingGroup(ig),
+		Recurrence:       aws.String(hibernation.ResumeSchedule),
+		TimeZone:         aws.String(timeZone),
+		MinSize:          minSize,
+		MaxSize:          maxSize,
+	}
+	c.AddTask(hibernate)
+	c.AddTask(resume)
+
+	if asg.Tags == nil {
+		asg.Tags = make(map[string]string)
+	}
+	asg.Tags[awsup.TagNameScheduledActions] = strings.Join([]string{*hibernate.ActionName, *resume.ActionName}, ",")
+	return nil
+}
+
+// autoscalingGroupSizes returns the sizes of the autoscaling group of the instance group, defaulting those which are unset
+func autoscalingGroupSizes(ig *kops.InstanceGroup, specMinSize, specMaxSize *int32) (*int32, *int32) {
+	minSize := fi.PtrTo(int32(1))
+	maxSize := fi.PtrTo(int32(1))
+	if specMinSize != nil {
+		minSize = fi.PtrTo(*specMinSize)
+	} else if ig.Spec.Role == kops.InstanceGroupRoleNode {
+		minSize = fi.PtrTo(int32(2))
+	}
+	if specMaxSize != nil {
+		maxSize = fi.PtrTo(*specMaxSize)
+	} else if ig.Spec.Role == kops.InstanceGroupRoleNode {
+		maxSize = fi.PtrTo(int32(2))
+	}
+	return minSize, maxSize
+}
+
 // buildLaunchTemplateTask is responsible for creating the template task into the aws model
 func (b *AutoscalingGroupModelBuilder) buildLaunchTemplateTask(c *fi.CloudupModelBuilderContext, name string, ig *kops.InstanceGroup) (*awstasks.LaunchTemplate, error) {
 	// @step: add the iam instance profile
@@ -419,21 +492,7 @@ func (b *AutoscalingGroupModelBuilder) buildAutoScalingGroupTask(c *fi.CloudupMo
 		InstanceProtection: fi.PtrTo(false),
 	}
 
-	minSize := fi.PtrTo(int32(1))
-	maxSize := fi.PtrTo(int32(1))
-	if ig.Spec.MinSize != nil {
-		minSize = fi.PtrTo(int32(*ig.Spec.MinSize))
-	} else if ig.Spec.Role == kops.InstanceGroupRoleNode {
-		minSize = fi.PtrTo(int32(2))
-	}
-	if ig.Spec.MaxSize != nil {
-		maxSize = fi.PtrTo(int32(*ig.Spec.MaxSize))
-	} else if ig.Spec.Role == kops.InstanceGroupRoleNode {
-		maxSize = fi.PtrTo(int32(2))
-	}
-
-	t.MinSize = minSize
-	t.MaxSize = maxSize
+	t.MinSize, t.MaxSize = autoscalingGroupSizes(ig, ig.Spec.MinSize, ig.Spec.MaxSize)
 
 	subnets, err := b.GatherSubnets(ig)
 	if err != nil {
@@ -474,14 +533,16 @@ func (b *AutoscalingGroupModelBuilder) buildAutoScalingGroupTask(c *fi.CloudupMo
 		(featureflag.SpotinstHybrid.Enabled() && !HybridInstanceGroup(ig)) {
 		if b.UseLoadBalancerForAPI() && ig.HasAPIServer() {
 			if b.UseNetworkLoadBalancer() {
-				t.TargetGroups = append(t.TargetGroups, b.LinkToTargetGroup("tcp"))
+				if b.IsAPILoadBalancerTarget(ig) {
+					t.TargetGroups = append(t.TargetGroups, b.LinkToTargetGroup("tcp"))
+				}
 				if b.Cluster.UsesNoneDNS() && ig.IsControlPlane() {
 					t.TargetGroups = append(t.TargetGroups, b.LinkToTargetGroup("kops-controller"))
 				}
-				if b.Cluster.Spec.API.LoadBalancer.SSLCertificate != "" {
+				if b.IsAPILoadBalancerTarget(ig) && b.Cluster.Spec.API.LoadBalancer.SSLCertificate != "" {
 					t.TargetGroups = append(t.TargetGroups, b.LinkToTargetGroup("tls"))
 				}
-			} else {
+			} else if b.IsAPILoadBalancerTarget(ig) {
 				t.LoadBalancers = append(t.LoadBalancers, b.LinkToCLB("api"))
 			}
 		}
@@ -522,8 +583,7 @@ func (b *AutoscalingGroupModelBuilder) buildAutoScalingGroupTask(c *fi.CloudupMo
 	sort.Stable(awstasks.OrderTargetGroupsByName(t.TargetGroups))
 
 	// @step: are we using a mixed instance policy
-	if ig.Spec.MixedInstancesPolicy != nil && ig.Spec.Manager == kops.InstanceManagerCloudGroup {
-		spec := ig.Spec.MixedInstancesPolicy
+	if spec := mixedInstancesPolicy(ig); spec != nil && ig.Spec.Manager == kops.InstanceManagerCloudGroup {
 
 		if spec.InstanceRequirements != nil {
 
@@ -587,3 +647,17 @@ func (b *AutoscalingGroupModelBuilder) buildAutoScalingGroupTask(c *fi.CloudupMo
 	}
 	return t, nil
 }
+
+// mixedInstancesPolicy returns the mixed instances policy of the instance group.
+// Groups that prefer spot capacity get a policy running only spot instances of their machine type.
+func mixedInstancesPolicy(ig *kops.InstanceGroup) *kops.MixedInstancesPolicySpec {
+	if ig.Spec.MixedInstancesPolicy == nil && fi.ValueOf(ig.Spec.PreferSpot) {
+		return &kops.MixedInstancesPolicySpec{
+			Instances:              []string{ig.Spec.MachineType},
+			OnDemandBase:           fi.PtrTo(int64(0)),
+			OnDemandAboveBase:      fi.PtrTo(int64(0)),
+			SpotAllocationStrategy: fi.PtrTo(kops.SpotAllocationStrategyPriceCapacityOptimized),
+		}
+	}
+	return ig.Spec.MixedInstancesPolicy
+}
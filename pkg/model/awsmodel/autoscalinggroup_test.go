@@ -18,6 +18,7 @@ package awsmodel
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -286,3 +287,189 @@ func TestAPIServerAdditionalSecurityGroupsWithNLB(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildHibernationTasks(t *testing.T) {
+	grid := []struct {
+		Name              string
+		Hibernation       *kops.HibernationSpec
+		Role              kops.InstanceGroupRole
+		Annotations       map[string]string
+		ExpectedActions   bool
+		ExpectedResumeMin int32
+		ExpectedResumeMax int32
+	}{
+		{
+			Name: "no hibernation",
+			Role: kops.InstanceGroupRoleNode,
+		},
+		{
+			Name:              "scheduled hibernation",
+			Hibernation:       &kops.HibernationSpec{Schedule: "0 20 * * 1-5", ResumeSchedule: "0 7 * * 1-5"},
+			Role:              kops.InstanceGroupRoleNode,
+			ExpectedActions:   true,
+			ExpectedResumeMin: 3,
+			ExpectedResumeMax: 5,
+		},
+		{
+			Name:        "hibernated manually",
+			Hibernation: &kops.HibernationSpec{Schedule: "0 20 * * 1-5", ResumeSchedule: "0 7 * * 1-5"},
+			Role:        kops.InstanceGroupRoleNode,
+			Annotations: map[string]string{
+				"hibernation.kops.k8s.io/min-size": "4",
+				"hibernation.kops.k8s.io/max-size": "6",
+			},
+			ExpectedActions:   true,
+			ExpectedResumeMin: 4,
+			ExpectedResumeMax: 6,
+		},
+		{
+			Name:        "control plane",
+			Hibernation: &kops.HibernationSpec{Schedule: "0 20 * * 1-5", ResumeSchedule: "0 7 * * 1-5"},
+			Role:        kops.InstanceGroupRoleControlPlane,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster := buildMinimalCluster()
+			cluster.Spec.Hibernation = g.Hibernation
+			ig := buildNodeInstanceGroup("subnet-us-test-1a")
+			ig.Spec.Role = g.Role
+			ig.Annotations = g.Annotations
+			ig.Spec.MinSize = fi.PtrTo(int32(3))
+			ig.Spec.MaxSize = fi.PtrTo(int32(5))
+			if g.Annotations != nil {
+				ig.Spec.MinSize = fi.PtrTo(int32(0))
+				ig.Spec.MaxSize = fi.PtrTo(int32(0))
+			}
+
+			b := AutoscalingGroupModelBuilder{
+				AWSModelContext: &AWSModelContext{
+					KopsModelContext: &model.KopsModelContext{
+						IAMModelContext:   iam.IAMModelContext{Cluster: cluster},
+						AllInstanceGroups: []*kops.InstanceGroup{ig},
+						InstanceGroups:    []*kops.InstanceGroup{ig},
+					},
+				},
+				Cluster: cluster,
+			}
+			c := &fi.CloudupModelBuilderContext{
+				Tasks: make(map[string]fi.CloudupTask),
+			}
+			asg := &awstasks.AutoscalingGroup{Tags: map[string]string{}}
+			if err := b.buildHibernationTasks(c, ig, asg); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			resume, found := c.Tasks["AutoscalingScheduledAction/kops-resume-nodes"].(*awstasks.AutoscalingScheduledAction)
+			if !g.ExpectedActions {
+				if len(c.Tasks) != 0 {
+					t.Errorf("expected no tasks, got %v", c.Tasks)
+				}
+				if tag, found := asg.Tags["kops.k8s.io/scheduled-actions"]; found {
+					t.Errorf("expected no scheduled actions tag, got %q", tag)
+				}
+				return
+			}
+			if !found {
+				t.Fatalf("expected resume action, got %v", c.Tasks)
+			}
+			if _, found := c.Tasks["AutoscalingScheduledAction/kops-hibernate-nodes"]; !found {
+				t.Errorf("expected hibernate action, got %v", c.Tasks)
+			}
+			if fi.ValueOf(resume.MinSize) != g.ExpectedResumeMin || fi.ValueOf(resume.MaxSize) != g.ExpectedResumeMax {
+				t.Errorf("expected resume sizes %d-%d, got %d-%d", g.ExpectedResumeMin, g.ExpectedResumeMax, fi.ValueOf(resume.MinSize), fi.ValueOf(resume.MaxSize))
+			}
+			if tag := asg.Tags["kops.k8s.io/scheduled-actions"]; tag != "kops-hibernate,kops-resume" {
+				t.Errorf("expected scheduled actions tag %q, got %q", "kops-hibernate,kops-resume", tag)
+			}
+		})
+	}
+}
+
+func TestPreferSpotMixedInstancesPolicy(t *testing.T) {
+	grid := []struct {
+		Name                      string
+		PreferSpot                bool
+		MixedInstancesPolicy      *kops.MixedInstancesPolicySpec
+		ExpectedOverrides         []string
+		ExpectedOnDemandAboveBase *int32
+		ExpectedSpotAllocation    *string
+	}{
+		{
+			Name: "on-demand",
+		},
+		{
+			Name:                      "prefer spot",
+			PreferSpot:                true,
+			ExpectedOverrides:         []string{"t3.medium"},
+			ExpectedOnDemandAboveBase: fi.PtrTo(int32(0)),
+			ExpectedSpotAllocation:    fi.PtrTo(kops.SpotAllocationStrategyPriceCapacityOptimized),
+		},
+		{
+			Name:       "prefer spot after on-demand fallback",
+			PreferSpot: true,
+			MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+				Instances:         []string{"t3.medium"},
+				OnDemandBase:      fi.PtrTo(int64(0)),
+				OnDemandAboveBase: fi.PtrTo(int64(100)),
+			},
+			ExpectedOverrides:         []string{"t3.medium"},
+			ExpectedOnDemandAboveBase: fi.PtrTo(int32(100)),
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster := buildMinimalCluster()
+			ig := buildNodeInstanceGroup("subnet-us-test-1a")
+			ig.Spec.MachineType = "t3.medium"
+			ig.Spec.Manager = kops.InstanceManagerCloudGroup
+			ig.Spec.PreferSpot = fi.PtrTo(g.PreferSpot)
+			ig.Spec.MixedInstancesPolicy = g.MixedInstancesPolicy
+
+			igs := []*kops.InstanceGroup{ig}
+			b := AutoscalingGroupModelBuilder{
+				AWSModelContext: &AWSModelContext{
+					KopsModelContext: &model.KopsModelContext{
+						IAMModelContext:   iam.IAMModelContext{Cluster: cluster},
+						SSHPublicKeys:     [][]byte{[]byte(sshPublicKeyEntry)},
+						AllInstanceGroups: igs,
+						InstanceGroups:    igs,
+					},
+				},
+				BootstrapScriptBuilder: &model.BootstrapScriptBuilder{
+					Lifecycle: fi.LifecycleSync,
+					KopsModelContext: &model.KopsModelContext{
+						IAMModelContext: iam.IAMModelContext{Cluster: cluster},
+					},
+				},
+				Cluster: cluster,
+			}
+
+			c := &fi.CloudupModelBuilderContext{
+				Tasks: make(map[string]fi.CloudupTask),
+			}
+			for _, keypair := range []string{fi.CertificateIDCA, "etcd-clients-ca"} {
+				c.AddTask(&fitasks.Keypair{
+					Name:    fi.PtrTo(keypair),
+					Subject: "cn=" + keypair,
+					Type:    "ca",
+				})
+			}
+
+			if err := b.Build(c); err != nil {
+				t.Fatalf("error from Build: %v", err)
+			}
+
+			asg := c.Tasks["AutoscalingGroup/nodes.testcluster.test.com"].(*awstasks.AutoscalingGroup)
+			if !reflect.DeepEqual(asg.MixedInstanceOverrides, g.ExpectedOverrides) {
+				t.Errorf("expected instance overrides %v, got %v", g.ExpectedOverrides, asg.MixedInstanceOverrides)
+			}
+			if !reflect.DeepEqual(asg.MixedOnDemandAboveBase, g.ExpectedOnDemandAboveBase) {
+				t.Errorf("expected on-demand above base %v, got %v", fi.ValueOf(g.ExpectedOnDemandAboveBase), fi.ValueOf(asg.MixedOnDemandAboveBase))
+			}
+			if !reflect.DeepEqual(asg.MixedSpotAllocationStrategy, g.ExpectedSpotAllocation) {
+				t.Errorf("expected spot allocation strategy %v, got %v", fi.ValueOf(g.ExpectedSpotAllocation), fi.ValueOf(asg.MixedSpotAllocationStrategy))
+			}
+		})
+	}
+}
@@ -332,6 +332,10 @@ func (b *IAMModelBuilder) buildIAMTasks(role iam.Subject, iamName string, c *fi.
 					}
 					externalPolicies = append(externalPolicies, b.Cluster.Spec.ExternalPolicies[key]...)
 				}
+				if b.ManagedBastionType() == kops.ManagedBastionTypeSSM && roleKey != "bastion" {
+					// Allow the SSM agent to register, so the instances can be reached with Session Manager
+					externalPolicies = append(externalPolicies, "arn:"+b.AWSPartition+":iam::aws:policy/AmazonSSMManagedInstanceCore")
+				}
 				sort.Strings(externalPolicies)
 
 				name := fmt.Sprintf("%s-policyoverride", roleKey)
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsmodel
+
+import (
+	"fmt"
+	"net"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awstasks"
+)
+
+// VPNModelBuilder adds model objects to support an AWS Client VPN endpoint
+//
+// The endpoint is associated with one subnet per zone, and clients connecting to it
+// are authorized to reach the network of the cluster, including the private API endpoint.
+type VPNModelBuilder struct {
+	*AWSModelContext
+	Lifecycle         fi.Lifecycle
+	SecurityLifecycle fi.Lifecycle
+}
+
+var _ fi.CloudupModelBuilder = &VPNModelBuilder{}
+
+// VPNName returns the name of the Client VPN endpoint of the cluster
+func VPNName(clusterName string) string {
+	return "vpn." + clusterName
+}
+
+func (b *VPNModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
+	topology := b.Cluster.Spec.Networking.Topology
+	if topology == nil || topology.VPN == nil || topology.VPN.Type != kops.VPNTypeAWSClientVPN {
+		return nil
+	}
+	spec := topology.VPN
+	name := VPNName(b.ClusterName())
+
+	nodeGroups, err := b.GetSecurityGroups(kops.InstanceGroupRoleNode)
+	if err != nil {
+		return err
+	}
+	masterGroups, err := b.GetSecurityGroups(kops.InstanceGroupRoleControlPlane)
+	if err != nil {
+		return err
+	}
+
+	vpnSG := &awstasks.SecurityGroup{
+		Name:        fi.PtrTo(name),
+		Lifecycle:   b.SecurityLifecycle,
+		Description: fi.PtrTo("Security group for the client VPN"),
+		VPC:         b.LinkToVPC(),
+	}
+	vpnSG.Tags = b.CloudTags(*vpnSG.Name, false)
+	c.AddTask(vpnSG)
+
+	// Allow traffic from the VPN to egress freely
+	{
+		t := &awstasks.SecurityGroupRule{
+			Name:          fi.PtrTo("ipv4-vpn-egress"),
+			Lifecycle:     b.SecurityLifecycle,
+			SecurityGroup: vpnSG,
+			Egress:        fi.PtrTo(true),
+			CIDR:          fi.PtrTo("0.0.0.0/0"),
+		}
+		AddDirectionalGroupRule(c, t)
+	}
+
+	// Allow VPN clients to reach the API and SSH on the control plane
+	for _, dest := range masterGroups {
+		for _, port := range []int32{443, 22} {
+			t := &awstasks.SecurityGroupRule{
+				Name:          fi.PtrTo(fmt.Sprintf("vpn-to-master-%d%s", port, dest.Suffix)),
+				Lifecycle:     b.SecurityLifecycle,
+				SecurityGroup: dest.Task,
+				SourceGroup:   vpnSG,
+				Protocol:      fi.PtrTo("tcp"),
+				FromPort:      fi.PtrTo(port),
+				ToPort:        fi.PtrTo(port),
+			}
+			AddDirectionalGroupRule(c, t)
+		}
+	}
+
+	// Allow VPN clients to SSH to nodes
+	for _, dest := range nodeGroups {
+		t := &awstasks.SecurityGroupRule{
+			Name:          fi.PtrTo("vpn-to-node-ssh" + dest.Suffix),
+			Lifecycle:     b.SecurityLifecycle,
+			SecurityGroup: dest.Task,
+			SourceGroup:   vpnSG,
+			Protocol:      fi.PtrTo("tcp"),
+			FromPort:      fi.PtrTo(int32(22)),
+			ToPort:        fi.PtrTo(int32(22)),
+		}
+		AddDirectionalGroupRule(c, t)
+	}
+
+	// Allow VPN clients to reach the API load balancer
+	if b.UseLoadBalancerForAPI() {
+		t := &awstasks.SecurityGroupRule{
+			Name:          fi.PtrTo("vpn-to-api-elb"),
+			Lifecycle:     b.SecurityLifecycle,
+			SecurityGroup: b.LinkToELBSecurityGroup("api"),
+			SourceGroup:   vpnSG,
+			Protocol:      fi.PtrTo("tcp"),
+			FromPort:      fi.PtrTo(int32(443)),
+			ToPort:        fi.PtrTo(int32(443)),
+		}
+		AddDirectionalGroupRule(c, t)
+	}
+
+	clientCertificate := spec.ClientCertificateAuthority
+	if clientCertificate == "" {
+		clientCertificate = spec.ServerCertificate
+	}
+
+	authorizedCIDRs := append([]string{b.Cluster.Spec.Networking.NetworkCIDR}, b.Cluster.Spec.Networking.AdditionalNetworkCIDRs...)
+
+	endpoint := &awstasks.ClientVPNEndpoint{
+		Name:                 fi.PtrTo(name),
+		Lifecycle:            b.Lifecycle,
+		ClientCIDR:           fi.PtrTo(spec.ClientCIDR),
+		ServerCertificateARN: fi.PtrTo(spec.ServerCertificate),
+		ClientCertificateARN: fi.PtrTo(clientCertificate),
+		VPC:                  b.LinkToVPC(),
+		SecurityGroups:       []*awstasks.SecurityGroup{vpnSG},
+		SplitTunnel:          fi.PtrTo(true),
+		AuthorizedCIDRs:      authorizedCIDRs,
+		Tags:                 b.CloudTags(name, false),
+	}
+	// Clients resolve the private names of the cluster using the resolver of the VPC
+	if dnsServer := vpcResolver(b.Cluster.Spec.Networking.NetworkCIDR); dnsServer != "" {
+		endpoint.DNSServers = []string{dnsServer}
+	}
+	c.AddTask(endpoint)
+
+	subnets, err := b.vpnSubnets(spec)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		c.AddTask(&awstasks.ClientVPNTargetNetwork{
+			Name:              fi.PtrTo(subnet.Name + "." + name),
+			Lifecycle:         b.Lifecycle,
+			ClientVPNEndpoint: endpoint,
+			Subnet:            b.LinkToSubnet(subnet),
+		})
+	}
+
+	return nil
+}
+
+// vpnSubnets returns the subnets the VPN is associated with, defaulting to the first private subnet of each zone
+func (b *VPNModelBuilder) vpnSubnets(spec *kops.VPNSpec) ([]*kops.ClusterSubnetSpec, error) {
+	var subnets []*kops.ClusterSubnetSpec
+	if len(spec.Subnets) != 0 {
+		for _, name := range spec.Subnets {
+			var found *kops.ClusterSubnetSpec
+			for i := range b.Cluster.Spec.Networking.Subnets {
+				if b.Cluster.Spec.Networking.Subnets[i].Name == name {
+					found = &b.Cluster.Spec.Networking.Subnets[i]
+				}
+			}
+			if found == nil {
+				return nil, fmt.Errorf("unable to find subnet %q of the VPN", name)
+			}
+			subnets = append(subnets, found)
+		}
+		return subnets, nil
+	}
+
+	zones := make(map[string]bool)
+	for i := range b.Cluster.Spec.Networking.Subnets {
+		subnet := &b.Cluster.Spec.Networking.Subnets[i]
+		if subnet.Type != kops.SubnetTypePrivate || zones[subnet.Zone] {
+			continue
+		}
+		zones[subnet.Zone] = true
+		subnets = append(subnets, subnet)
+	}
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("unable to find a private subnet for the VPN")
+	}
+	return subnets, nil
+}
+
+// vpcResolver returns the address of the Amazon DNS server of a VPC, which is the base of its CIDR plus two
+func vpcResolver(networkCIDR string) string {
+	_, cidr, err := net.ParseCIDR(networkCIDR)
+	if err != nil {
+		return ""
+	}
+	ip := cidr.IP.To4()
+	if ip == nil {
+		return ""
+	}
+	resolver := make(net.IP, len(ip))
+	copy(resolver, ip)
+	resolver[3] += 2
+	return resolver.String()
+}
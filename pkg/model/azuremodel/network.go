@@ -87,6 +87,22 @@ func (b *NetworkModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 			DestinationPortRange: fi.PtrTo("22"),
 		})
 	}
+	if b.ManagedBastionType() == kops.ManagedBastionTypeAzureBastion {
+		nsgTask.SecurityRules = append(nsgTask.SecurityRules, &azuretasks.NetworkSecurityRule{
+			Name:                fi.PtrTo("AllowSSHFromAzureBastion"),
+			Priority:            fi.PtrTo[int32](102),
+			Access:              network.SecurityRuleAccessAllow,
+			Direction:           network.SecurityRuleDirectionInbound,
+			Protocol:            network.SecurityRuleProtocolTCP,
+			SourceAddressPrefix: fi.PtrTo(b.Cluster.Spec.Networking.Topology.Bastion.Managed.SubnetCIDR),
+			SourcePortRange:     fi.PtrTo("*"),
+			DestinationApplicationSecurityGroupNames: []*string{
+				fi.PtrTo(b.NameForApplicationSecurityGroupControlPlane()),
+				fi.PtrTo(b.NameForApplicationSecurityGroupNodes()),
+			},
+			DestinationPortRange: fi.PtrTo("22"),
+		})
+	}
 	k8sAccessIPv4 := ipv4CIDRs(b.Cluster.Spec.API.Access)
 	if len(k8sAccessIPv4) > 0 {
 		nsgTask.SecurityRules = append(nsgTask.SecurityRules, &azuretasks.NetworkSecurityRule{
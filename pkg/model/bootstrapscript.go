@@ -20,6 +20,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"net"
 	"sort"
 
 	"k8s.io/klog/v2"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/kops/pkg/apis/nodeup"
 	"k8s.io/kops/pkg/assets"
 	"k8s.io/kops/pkg/model/resources"
+	"k8s.io/kops/pkg/tokens"
 	"k8s.io/kops/pkg/wellknownservices"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/fitasks"
@@ -73,14 +75,14 @@ var (
 	_ fi.CloudupHasDependencies = &BootstrapScript{}
 )
 
-// kubeEnv returns the boot config for the instance group
-func (b *BootstrapScript) kubeEnv(ig *kops.InstanceGroup, c *fi.CloudupContext) (*nodeup.BootConfig, error) {
+// kubeEnv returns the nodeup config and the boot config for the instance group
+func (b *BootstrapScript) kubeEnv(ig *kops.InstanceGroup, c *fi.CloudupContext) (*nodeup.Config, *nodeup.BootConfig, error) {
 	wellKnownAddresses := make(WellKnownAddresses)
 
 	for _, hasAddress := range b.hasAddressTasks {
 		addresses, err := hasAddress.FindAddresses(c)
 		if err != nil {
-			return nil, fmt.Errorf("error finding address for %v: %v", hasAddress, err)
+			return nil, nil, fmt.Errorf("error finding address for %v: %v", hasAddress, err)
 		}
 		if len(addresses) == 0 {
 			// Such tasks won't have an address in dry-run mode, until the resource is created
@@ -104,24 +106,24 @@ func (b *BootstrapScript) kubeEnv(ig *kops.InstanceGroup, c *fi.CloudupContext)
 		name := *caTask.Name
 		keyset := caTask.Keyset()
 		if keyset == nil {
-			return nil, fmt.Errorf("failed to get keyset from %q", name)
+			return nil, nil, fmt.Errorf("failed to get keyset from %q", name)
 		}
 		keysets[name] = keyset
 	}
 	config, bootConfig, err := b.builder.NodeUpConfigBuilder.BuildConfig(ig, wellKnownAddresses, keysets)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	configData, err := utils.YamlMarshal(config)
 	if err != nil {
-		return nil, fmt.Errorf("error converting nodeup config to yaml: %v", err)
+		return nil, nil, fmt.Errorf("error converting nodeup config to yaml: %v", err)
 	}
 	sum256 := sha256.Sum256(configData)
 	bootConfig.NodeupConfigHash = base64.StdEncoding.EncodeToString(sum256[:])
 	b.nodeupConfig.Resource = fi.NewBytesResource(configData)
 
-	return bootConfig, nil
+	return config, bootConfig, nil
 }
 
 func KeypairNamesForInstanceGroup(cluster *kops.Cluster, ig *kops.InstanceGroup) []string {
@@ -160,6 +162,11 @@ func KeypairNamesForInstanceGroup(cluster *kops.Cluster, ig *kops.InstanceGroup)
 		keypairs = nil
 	}
 
+	// All instances, including bastions, trust the SSH certificate authority
+	if cluster.Spec.SSHCertificateAuthority != nil {
+		keypairs = append(keypairs, "ssh-ca")
+	}
+
 	return keypairs
 }
 
@@ -169,8 +176,8 @@ func (b *BootstrapScriptBuilder) ResourceNodeUp(c *fi.CloudupModelBuilderContext
 	keypairNames := KeypairNamesForInstanceGroup(b.Cluster, ig)
 
 	if ig.IsBastion() {
-		// Bastions can have AdditionalUserData, but if there isn't any skip this part
-		if len(ig.Spec.AdditionalUserData) == 0 {
+		// Bastions can have AdditionalUserData and sshd settings, but if there aren't any skip this part
+		if len(ig.Spec.AdditionalUserData) == 0 && resources.BastionSSHDConfig(bastionSpec(b.Cluster), b.Cluster.Spec.SSHCertificateAuthority != nil) == nil {
 			return nil, nil
 		}
 	}
@@ -231,26 +238,56 @@ func (b *BootstrapScript) Run(c *fi.CloudupContext) error {
 		return nil
 	}
 
-	bootConfig, err := b.kubeEnv(b.ig, c)
+	config, bootConfig, err := b.kubeEnv(b.ig, c)
 	if err != nil {
 		return err
 	}
 
-	var nodeupScript resources.NodeUpScript
-	nodeupScript.NodeUpAssets = b.builder.NodeUpAssets
-	nodeupScript.BootConfig = bootConfig
+	if b.ig.IsWindows() {
+		// Windows nodes don't run nodeup; the boot script installs and configures the node
+		windowsScriptResource, err := b.windowsScript(c, config, bootConfig)
+		if err != nil {
+			return err
+		}
 
-	nodeupScript.WithEnvironmentVariables(b.cluster, b.ig)
-	nodeupScript.WithProxyEnv(b.cluster)
-	nodeupScript.WithSysctls()
+		b.resource.Resource = fi.FunctionToResource(func() ([]byte, error) {
+			script, err := fi.ResourceAsString(windowsScriptResource)
+			if err != nil {
+				return nil, err
+			}
+			if b.cluster.GetCloudProvider() == kops.CloudProviderAWS {
+				// EC2Launch runs the script at every boot, so the node can restart to enable the Containers feature
+				script = "<powershell>\n" + script + "</powershell>\n<persist>true</persist>\n"
+			}
+			return []byte(script), nil
+		})
+		return nil
+	}
 
-	nodeupScript.CompressUserData = fi.ValueOf(b.ig.Spec.CompressUserData)
+	var nodeupScriptResource fi.Resource
+	if b.ig.IsBastion() {
+		// Bastions don't run nodeup; the boot script only configures sshd
+		nodeupScriptResource, err = b.bastionScript()
+		if err != nil {
+			return err
+		}
+	} else {
+		var nodeupScript resources.NodeUpScript
+		nodeupScript.NodeUpAssets = b.builder.NodeUpAssets
+		nodeupScript.BootConfig = bootConfig
 
-	nodeupScript.CloudProvider = string(c.T.Cluster.GetCloudProvider())
+		nodeupScript.WithEnvironmentVariables(b.cluster, b.ig)
+		nodeupScript.WithProxyEnv(b.cluster)
+		nodeupScript.WithSysctls()
 
-	nodeupScriptResource, err := nodeupScript.Build()
-	if err != nil {
-		return err
+		nodeupScript.CompressUserData = fi.ValueOf(b.ig.Spec.CompressUserData)
+
+		nodeupScript.CloudProvider = string(c.T.Cluster.GetCloudProvider())
+
+		nodeupScriptResource, err = nodeupScript.Build()
+		if err != nil {
+			return err
+		}
 	}
 
 	b.resource.Resource = fi.FunctionToResource(func() ([]byte, error) {
@@ -268,3 +305,70 @@ func (b *BootstrapScript) Run(c *fi.CloudupContext) error {
 	})
 	return nil
 }
+
+// bastionScript returns the script configuring sshd on the bastion instances, which is empty if there is nothing to configure.
+func (b *BootstrapScript) bastionScript() (fi.Resource, error) {
+	var certificateAuthorities []string
+	if caTask := b.caTasks["ssh-ca"]; caTask != nil {
+		keyset := caTask.Keyset()
+		if keyset == nil {
+			return nil, fmt.Errorf("failed to get keyset from %q", *caTask.Name)
+		}
+		var err error
+		certificateAuthorities, err = keyset.ToSSHAuthorizedKeys()
+		if err != nil {
+			return nil, fmt.Errorf("encoding ssh-ca keys: %w", err)
+		}
+	}
+
+	sshdConfig := resources.BastionSSHDConfig(bastionSpec(b.cluster), len(certificateAuthorities) != 0)
+	if sshdConfig == nil {
+		return fi.NewStringResource(""), nil
+	}
+	return fi.NewStringResource(resources.BastionScript(certificateAuthorities, sshdConfig)), nil
+}
+
+// windowsScript returns the script bootstrapping the Windows nodes, built from the nodeup config of the instance group.
+func (b *BootstrapScript) windowsScript(c *fi.CloudupContext, config *nodeup.Config, bootConfig *nodeup.BootConfig) (fi.Resource, error) {
+	secret, err := c.T.SecretStore.FindSecret(tokens.WindowsBootstrapTokenSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s secret: %w", tokens.WindowsBootstrapTokenSecretName, err)
+	}
+	if secret == nil {
+		return nil, fmt.Errorf("%s secret is required for Windows InstanceGroup %q", tokens.WindowsBootstrapTokenSecretName, b.ig.ObjectMeta.Name)
+	}
+
+	script := &resources.WindowsScript{
+		CloudProvider:  string(b.cluster.GetCloudProvider()),
+		CACertificates: config.CAs[fi.CertificateIDCA],
+		APIServer:      "https://" + b.cluster.APIInternalName(),
+		BootstrapToken: string(secret.Data),
+		ClusterDNS:     config.KubeletConfig.ClusterDNS,
+		ClusterDomain:  config.KubeletConfig.ClusterDomain,
+		Taints:         config.KubeletConfig.Taints,
+		MaxPods:        fi.ValueOf(config.KubeletConfig.MaxPods),
+	}
+	if bootConfig.ConfigServer != nil {
+		// The CA is moved to the boot config when nodes are configured by kops-controller
+		script.CACertificates = bootConfig.ConfigServer.CACertificates
+	}
+	if len(bootConfig.APIServerIPs) != 0 {
+		script.APIServer = "https://" + net.JoinHostPort(bootConfig.APIServerIPs[0], "443")
+	}
+	if config.KubeProxy != nil {
+		script.ClusterCIDR = fi.ValueOf(config.KubeProxy.ClusterCIDR)
+	}
+	if err := script.WithAssets(config.Assets[architectures.ArchitectureAmd64]); err != nil {
+		return nil, fmt.Errorf("building Windows script for InstanceGroup %q: %w", b.ig.ObjectMeta.Name, err)
+	}
+
+	return script.Build()
+}
+
+// bastionSpec returns the bastion settings of the cluster, or nil if there are none.
+func bastionSpec(cluster *kops.Cluster) *kops.BastionSpec {
+	if cluster.Spec.Networking.Topology == nil {
+		return nil
+	}
+	return cluster.Spec.Networking.Topology.Bastion
+}
@@ -51,6 +51,14 @@ func RemapAddonManifest(addon *addonsapi.AddonSpec, context *model.KopsModelCont
 			}
 		}
 
+		if err := applyAddonResources(context.Cluster, context.AllInstanceGroups, name, objects); err != nil {
+			return nil, fmt.Errorf("failed to apply resources to %q: %w", name, err)
+		}
+
+		if err := addLinuxNodeSelector(context, objects); err != nil {
+			return nil, fmt.Errorf("failed to add node selector to %q: %w", name, err)
+		}
+
 		err = addLabels(addon, objects)
 		if err != nil {
 			return nil, fmt.Errorf("failed to annotate %q: %w", name, err)
@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addonmanifests
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// autosizeTarget is a container whose resources grow with the size of the cluster
+type autosizeTarget struct {
+	// name is the name of the Deployment
+	name string
+	// container is the name of the container
+	container string
+	// requests are used for the resources the manifest does not request
+	requests corev1.ResourceList
+}
+
+func requests(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+// autosizeTargets are the containers scaled by autosizing, by addon.
+// Only the controllers are scaled; the load on per-node agents does not grow with the number of nodes.
+var autosizeTargets = map[string][]autosizeTarget{
+	"coredns.addons.k8s.io": {
+		{name: "coredns", container: "coredns", requests: requests("100m", "70Mi")},
+	},
+	"networking.cilium.io": {
+		{name: "cilium-operator", container: "cilium-operator", requests: requests("25m", "128Mi")},
+	},
+	"networking.projectcalico.org": {
+		{name: "calico-kube-controllers", container: "calico-kube-controllers", requests: requests("25m", "64Mi")},
+	},
+	"aws-ebs-csi-driver.addons.k8s.io": {
+		{name: "ebs-csi-controller", container: "ebs-plugin", requests: requests("10m", "40Mi")},
+		{name: "ebs-csi-controller", container: "csi-provisioner", requests: requests("10m", "40Mi")},
+		{name: "ebs-csi-controller", container: "csi-attacher", requests: requests("10m", "40Mi")},
+		{name: "ebs-csi-controller", container: "csi-resizer", requests: requests("10m", "40Mi")},
+	},
+	"gcp-pd-csi-driver.addons.k8s.io": {
+		{name: "csi-gce-pd-controller", container: "gce-pd-driver", requests: requests("10m", "40Mi")},
+		{name: "csi-gce-pd-controller", container: "csi-provisioner", requests: requests("10m", "40Mi")},
+		{name: "csi-gce-pd-controller", container: "csi-attacher", requests: requests("10m", "40Mi")},
+		{name: "csi-gce-pd-controller", container: "csi-resizer", requests: requests("10m", "40Mi")},
+	},
+}
+
+// AutosizeFactor is how much the resources of the autosized addons are multiplied for a cluster of up to maxNodes nodes
+func AutosizeFactor(maxNodes int32) int64 {
+	switch {
+	case maxNodes <= 100:
+		return 1
+	case maxNodes <= 500:
+		return 2
+	case maxNodes <= 1000:
+		return 4
+	default:
+		return 8
+	}
+}
+
+// maxNodes is the number of nodes the instance groups of the cluster can scale up to
+func maxNodes(instanceGroups []*kops.InstanceGroup) int32 {
+	var n int32
+	for _, ig := range instanceGroups {
+		n += fi.ValueOf(ig.Spec.MaxSize)
+	}
+	return n
+}
+
+// applyAddonResources autosizes the containers of the addon and then applies the resource overrides
+func applyAddonResources(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, addonName string, objects kubemanifest.ObjectList) error {
+	spec := cluster.Spec.AddonResources
+	if spec == nil {
+		return nil
+	}
+
+	if fi.ValueOf(spec.Autosize) {
+		factor := AutosizeFactor(maxNodes(instanceGroups))
+		if factor > 1 {
+			for _, target := range autosizeTargets[addonName] {
+				if _, err := visitContainer(objects, target.name, target.container, func(container *corev1.Container) {
+					autosizeContainer(container, target.requests, factor)
+				}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, override := range spec.Overrides {
+		if override.Addon != addonName {
+			continue
+		}
+		found, err := visitContainer(objects, override.Name, override.Container, func(container *corev1.Container) {
+			overrideResources(container, override.Resources)
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("resource override for container %q of %q did not match any container of addon %q", override.Container, override.Name, addonName)
+		}
+	}
+
+	return nil
+}
+
+// visitContainer calls fn with the named container of the named Deployment or DaemonSet, returning whether it was found
+func visitContainer(objects kubemanifest.ObjectList, name string, containerName string, fn func(container *corev1.Container)) (bool, error) {
+	found := false
+	for _, object := range objects {
+		if !hasPodSpecTemplate(object) || object.GetName() != name {
+			continue
+		}
+		podSpec := &corev1.PodSpec{}
+		if err := object.Reparse(podSpec, "spec", "template", "spec"); err != nil {
+			return false, fmt.Errorf("failed to parse spec.template.spec from %s %q: %w", object.Kind(), name, err)
+		}
+		changed := false
+		for i := range podSpec.Containers {
+			if podSpec.Containers[i].Name == containerName {
+				fn(&podSpec.Containers[i])
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		found = true
+		if err := object.Set(podSpec, "spec", "template", "spec"); err != nil {
+			return false, fmt.Errorf("failed to set object: %w", err)
+		}
+	}
+	return found, nil
+}
+
+// autosizeContainer multiplies the requests and limits of the container by factor,
+// starting from the default requests for any resource the container does not request
+func autosizeContainer(container *corev1.Container, defaults corev1.ResourceList, factor int64) {
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = corev1.ResourceList{}
+	}
+	for name, q := range defaults {
+		if _, found := container.Resources.Requests[name]; !found {
+			container.Resources.Requests[name] = q
+		}
+	}
+	for name, q := range container.Resources.Requests {
+		container.Resources.Requests[name] = scaleQuantity(q, factor)
+	}
+	for name, q := range container.Resources.Limits {
+		container.Resources.Limits[name] = scaleQuantity(q, factor)
+	}
+}
+
+func scaleQuantity(q resource.Quantity, factor int64) resource.Quantity {
+	return *resource.NewMilliQuantity(q.MilliValue()*factor, q.Format)
+}
+
+// overrideResources sets the resources listed in the override, keeping any others
+func overrideResources(container *corev1.Container, override corev1.ResourceRequirements) {
+	if len(override.Requests) != 0 && container.Resources.Requests == nil {
+		container.Resources.Requests = corev1.ResourceList{}
+	}
+	for name, q := range override.Requests {
+		container.Resources.Requests[name] = q
+	}
+	if len(override.Limits) != 0 && container.Resources.Limits == nil {
+		container.Resources.Limits = corev1.ResourceList{}
+	}
+	for name, q := range override.Limits {
+		container.Resources.Limits[name] = q
+	}
+	if len(override.Claims) != 0 {
+		container.Resources.Claims = override.Claims
+	}
+}
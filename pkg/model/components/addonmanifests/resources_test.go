@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addonmanifests
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+const corednsManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coredns
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: coredns
+        image: registry.k8s.io/coredns/coredns:v1.11.3
+        resources:
+          limits:
+            memory: 170Mi
+          requests:
+            cpu: 100m
+            memory: 70Mi
+`
+
+func TestApplyAddonResources(t *testing.T) {
+	grid := []struct {
+		Description    string
+		Spec           *kops.AddonResourcesSpec
+		MaxSize        int32
+		ExpectedCPU    string
+		ExpectedMemory string
+		ExpectedLimit  string
+		ExpectedError  string
+	}{
+		{
+			Description:    "not configured",
+			MaxSize:        1000,
+			ExpectedCPU:    "100m",
+			ExpectedMemory: "70Mi",
+			ExpectedLimit:  "170Mi",
+		},
+		{
+			Description:    "small cluster is not autosized",
+			Spec:           &kops.AddonResourcesSpec{Autosize: fi.PtrTo(true)},
+			MaxSize:        100,
+			ExpectedCPU:    "100m",
+			ExpectedMemory: "70Mi",
+			ExpectedLimit:  "170Mi",
+		},
+		{
+			Description:    "large cluster is autosized",
+			Spec:           &kops.AddonResourcesSpec{Autosize: fi.PtrTo(true)},
+			MaxSize:        800,
+			ExpectedCPU:    "400m",
+			ExpectedMemory: "280Mi",
+			ExpectedLimit:  "680Mi",
+		},
+		{
+			Description: "override takes precedence over autosizing",
+			Spec: &kops.AddonResourcesSpec{
+				Autosize: fi.PtrTo(true),
+				Overrides: []kops.AddonResourceOverride{
+					{
+						Addon:     "coredns.addons.k8s.io",
+						Name:      "coredns",
+						Container: "coredns",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+						},
+					},
+				},
+			},
+			MaxSize:        300,
+			ExpectedCPU:    "200m",
+			ExpectedMemory: "1Gi",
+			ExpectedLimit:  "340Mi",
+		},
+		{
+			Description: "override of an unknown container",
+			Spec: &kops.AddonResourcesSpec{
+				Overrides: []kops.AddonResourceOverride{
+					{
+						Addon:     "coredns.addons.k8s.io",
+						Name:      "coredns",
+						Container: "autoscaler",
+					},
+				},
+			},
+			ExpectedError: `did not match any container`,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.Description, func(t *testing.T) {
+			objects, err := kubemanifest.LoadObjectsFrom([]byte(corednsManifest))
+			if err != nil {
+				t.Fatalf("error loading manifest: %v", err)
+			}
+			cluster := &kops.Cluster{Spec: kops.ClusterSpec{AddonResources: g.Spec}}
+			instanceGroups := []*kops.InstanceGroup{
+				{Spec: kops.InstanceGroupSpec{MaxSize: fi.PtrTo(g.MaxSize)}},
+			}
+
+			err = applyAddonResources(cluster, instanceGroups, "coredns.addons.k8s.io", objects)
+			if g.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), g.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", g.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			podSpec := &corev1.PodSpec{}
+			if err := objects[0].Reparse(podSpec, "spec", "template", "spec"); err != nil {
+				t.Fatalf("error parsing pod spec: %v", err)
+			}
+			resources := podSpec.Containers[0].Resources
+			if got := resources.Requests.Cpu().String(); got != g.ExpectedCPU {
+				t.Errorf("expected cpu request %s, got %s", g.ExpectedCPU, got)
+			}
+			if got := resources.Requests.Memory().String(); got != g.ExpectedMemory {
+				t.Errorf("expected memory request %s, got %s", g.ExpectedMemory, got)
+			}
+			if got := resources.Limits.Memory().String(); got != g.ExpectedLimit {
+				t.Errorf("expected memory limit %s, got %s", g.ExpectedLimit, got)
+			}
+		})
+	}
+}
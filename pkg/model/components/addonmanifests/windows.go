@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addonmanifests
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/pkg/model"
+)
+
+// addLinuxNodeSelector keeps the pods of the addons on Linux nodes when the cluster has Windows instance groups.
+// Windows nodes are tainted, but agents which tolerate all taints would otherwise be scheduled on them.
+// Pods which already select an operating system are left unchanged.
+func addLinuxNodeSelector(context *model.KopsModelContext, objects kubemanifest.ObjectList) error {
+	if !context.HasWindowsInstanceGroups() {
+		return nil
+	}
+
+	for _, object := range objects {
+		if !hasPodSpecTemplate(object) {
+			continue
+		}
+		podSpec := &corev1.PodSpec{}
+		if err := object.Reparse(podSpec, "spec", "template", "spec"); err != nil {
+			return fmt.Errorf("failed to parse spec.template.spec from %s %q: %w", object.Kind(), object.GetName(), err)
+		}
+		if _, found := podSpec.NodeSelector[corev1.LabelOSStable]; found {
+			continue
+		}
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = make(map[string]string)
+		}
+		podSpec.NodeSelector[corev1.LabelOSStable] = "linux"
+		if err := object.Set(podSpec, "spec", "template", "spec"); err != nil {
+			return fmt.Errorf("failed to set object: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addonmanifests
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/pkg/model"
+)
+
+const windowsTestManifest = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: calico-node
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: calico-node
+        image: calico/node
+      tolerations:
+      - operator: Exists
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: calico-node-windows
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: node
+        image: calico/node-windows
+      nodeSelector:
+        kubernetes.io/os: windows
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: calico-config
+  namespace: kube-system
+`
+
+func TestAddLinuxNodeSelector(t *testing.T) {
+	grid := []struct {
+		Description      string
+		OperatingSystems []kops.InstanceGroupOperatingSystem
+		Expected         []string
+	}{
+		{
+			Description:      "Linux cluster",
+			OperatingSystems: []kops.InstanceGroupOperatingSystem{"", kops.InstanceGroupOperatingSystemLinux},
+			Expected:         []string{"", "windows"},
+		},
+		{
+			Description:      "cluster with Windows nodes",
+			OperatingSystems: []kops.InstanceGroupOperatingSystem{"", kops.InstanceGroupOperatingSystemWindows},
+			Expected:         []string{"linux", "windows"},
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.Description, func(t *testing.T) {
+			objects, err := kubemanifest.LoadObjectsFrom([]byte(windowsTestManifest))
+			if err != nil {
+				t.Fatalf("error loading manifest: %v", err)
+			}
+			var instanceGroups []*kops.InstanceGroup
+			for _, os := range g.OperatingSystems {
+				instanceGroups = append(instanceGroups, &kops.InstanceGroup{Spec: kops.InstanceGroupSpec{OperatingSystem: os}})
+			}
+
+			context := &model.KopsModelContext{AllInstanceGroups: instanceGroups}
+			if err := addLinuxNodeSelector(context, objects); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for i, expected := range g.Expected {
+				podSpec := &corev1.PodSpec{}
+				if err := objects[i].Reparse(podSpec, "spec", "template", "spec"); err != nil {
+					t.Fatalf("error parsing pod spec: %v", err)
+				}
+				if got := podSpec.NodeSelector[corev1.LabelOSStable]; got != expected {
+					t.Errorf("expected %s of %q to be %q, got %q", corev1.LabelOSStable, objects[i].GetName(), expected, got)
+				}
+			}
+		})
+	}
+}
@@ -196,6 +196,28 @@ func (b *KubeAPIServerOptionsBuilder) BuildOptions(cluster *kops.Cluster) error
 		}
 	}
 
+	c.FeatureGates = mergeFeatureGates(c.FeatureGates, clusterSpec.FeatureGates)
+
+	if clusterSpec.ControlPlaneProfile == kops.ControlPlaneProfileLarge {
+		// Raise the limits for clusters with thousands of nodes, unless they are set explicitly
+		if c.MaxRequestsInflight == 0 {
+			c.MaxRequestsInflight = 800
+		}
+		if c.MaxMutatingRequestsInflight == 0 {
+			c.MaxMutatingRequestsInflight = 400
+		}
+		if len(c.WatchCacheSizes) == 0 {
+			c.WatchCacheSizes = []string{
+				"nodes#5000",
+				"pods#25000",
+				"endpointslices.discovery.k8s.io#5000",
+			}
+		}
+	}
+
+	// The runtime config of the cluster is merged the same way as its feature gates
+	c.RuntimeConfig = mergeFeatureGates(c.RuntimeConfig, clusterSpec.APIRuntimeConfig)
+
 	return nil
 }
 
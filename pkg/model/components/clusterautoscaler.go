@@ -100,7 +100,7 @@ func (b *ClusterAutoscalerOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 	if cas.MaxNodeProvisionTime == "" {
 		cas.MaxNodeProvisionTime = "15m0s"
 	}
-	if cas.Expander == "priority" {
+	if cas.UsesExpander("priority") {
 		cas.CreatePriorityExpenderConfig = fi.PtrTo(true)
 	}
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"strconv"
 	"strings"
 
 	"k8s.io/kops/pkg/apis/kops"
@@ -191,3 +192,24 @@ func Image(component string, clusterSpec *kops.ClusterSpec, assetsBuilder *asset
 func IsCertManagerEnabled(cluster *kops.Cluster) bool {
 	return cluster.Spec.CertManager != nil && fi.ValueOf(cluster.Spec.CertManager.Enabled)
 }
+
+// CertManagerUsesClusterCA returns true if the certificates of the addons are issued by cert-manager from the cluster CA
+func CertManagerUsesClusterCA(cluster *kops.Cluster) bool {
+	return IsCertManagerEnabled(cluster) && fi.ValueOf(cluster.Spec.CertManager.UseClusterCA)
+}
+
+// mergeFeatureGates adds the cluster feature gates which are not set in the feature gates of a component
+func mergeFeatureGates(featureGates map[string]string, clusterFeatureGates map[string]bool) map[string]string {
+	if len(clusterFeatureGates) == 0 {
+		return featureGates
+	}
+	if featureGates == nil {
+		featureGates = make(map[string]string)
+	}
+	for name, enabled := range clusterFeatureGates {
+		if _, found := featureGates[name]; !found {
+			featureGates[name] = strconv.FormatBool(enabled)
+		}
+	}
+	return featureGates
+}
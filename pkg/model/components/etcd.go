@@ -43,7 +43,27 @@ func (b *EtcdOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 			// We run the k8s-recommended versions of etcd
 			c.Version = DefaultEtcd3Version_1_22
 		}
+
+		if spec.ControlPlaneProfile == kops.ControlPlaneProfileLarge {
+			if c.Manager == nil {
+				c.Manager = &kops.EtcdManagerSpec{}
+			}
+			// Raise the storage quota and compact the history more often, unless set explicitly
+			c.Manager.Env = addDefaultEnv(c.Manager.Env, "ETCD_QUOTA_BACKEND_BYTES", "8589934592")
+			c.Manager.Env = addDefaultEnv(c.Manager.Env, "ETCD_AUTO_COMPACTION_MODE", "periodic")
+			c.Manager.Env = addDefaultEnv(c.Manager.Env, "ETCD_AUTO_COMPACTION_RETENTION", "1h")
+		}
 	}
 
 	return nil
 }
+
+// addDefaultEnv adds the environment variable if it is not already set
+func addDefaultEnv(env []kops.EnvVar, name string, value string) []kops.EnvVar {
+	for _, e := range env {
+		if e.Name == name {
+			return env
+		}
+	}
+	return append(env, kops.EnvVar{Name: name, Value: value})
+}
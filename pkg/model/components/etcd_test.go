@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package components
+
+import (
+	"reflect"
+	"testing"
+
+	api "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func Test_Build_Etcd_LargeProfile(t *testing.T) {
+	grid := []struct {
+		Profile  api.ControlPlaneProfile
+		Env      []api.EnvVar
+		Expected []api.EnvVar
+	}{
+		{
+			Profile: api.ControlPlaneProfileDefault,
+		},
+		{
+			Profile: api.ControlPlaneProfileLarge,
+			Expected: []api.EnvVar{
+				{Name: "ETCD_QUOTA_BACKEND_BYTES", Value: "8589934592"},
+				{Name: "ETCD_AUTO_COMPACTION_MODE", Value: "periodic"},
+				{Name: "ETCD_AUTO_COMPACTION_RETENTION", Value: "1h"},
+			},
+		},
+		{
+			Profile: api.ControlPlaneProfileLarge,
+			Env: []api.EnvVar{
+				{Name: "ETCD_QUOTA_BACKEND_BYTES", Value: "4294967296"},
+			},
+			Expected: []api.EnvVar{
+				{Name: "ETCD_QUOTA_BACKEND_BYTES", Value: "4294967296"},
+				{Name: "ETCD_AUTO_COMPACTION_MODE", Value: "periodic"},
+				{Name: "ETCD_AUTO_COMPACTION_RETENTION", Value: "1h"},
+			},
+		},
+	}
+
+	for _, g := range grid {
+		c := buildCluster()
+		c.Spec.ControlPlaneProfile = g.Profile
+		c.Spec.EtcdClusters = []api.EtcdClusterSpec{{Name: "main"}}
+		if g.Env != nil {
+			c.Spec.EtcdClusters[0].Manager = &api.EtcdManagerSpec{Env: g.Env}
+		}
+		b := assets.NewAssetBuilder(vfs.Context, c.Spec.Assets, false)
+
+		optionsContext, err := NewOptionsContext(c, b, b.KubeletSupportedVersion)
+		if err != nil {
+			t.Fatalf("error from NewOptionsContext: %v", err)
+		}
+
+		builder := &EtcdOptionsBuilder{
+			OptionsContext: optionsContext,
+		}
+		if err := builder.BuildOptions(c); err != nil {
+			t.Fatalf("unexpected error from BuildOptions: %v", err)
+		}
+
+		var env []api.EnvVar
+		if c.Spec.EtcdClusters[0].Manager != nil {
+			env = c.Spec.EtcdClusters[0].Manager.Env
+		}
+		if !reflect.DeepEqual(env, g.Expected) {
+			t.Errorf("unexpected etcd-manager env for profile %q: %v, expected %v", g.Profile, env, g.Expected)
+		}
+	}
+}
@@ -27,8 +27,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	"k8s.io/kops/pkg/assets"
-	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/pkg/flagbuilder"
 	"k8s.io/kops/pkg/k8scodecs"
 	"k8s.io/kops/pkg/kubemanifest"
@@ -343,7 +343,7 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 
 	var clientHost string
 
-	if featureflag.APIServerNodes.Enabled() {
+	if kopsmodel.UseAPIServerNodes(b.Cluster, b.AllInstanceGroups) {
 		clientHost = etcdCluster.Name + ".etcd.internal." + b.ClusterName()
 	} else {
 		clientHost = "__name__"
@@ -361,7 +361,7 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 		pod.Annotations = make(map[string]string)
 	}
 
-	if featureflag.APIServerNodes.Enabled() {
+	if kopsmodel.UseAPIServerNodes(b.Cluster, b.AllInstanceGroups) {
 		pod.Annotations["dns.alpha.kubernetes.io/internal"] = clientHost
 	}
 
@@ -389,7 +389,7 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 		// ok
 
 	case "cilium":
-		if !featureflag.APIServerNodes.Enabled() {
+		if !kopsmodel.UseAPIServerNodes(b.Cluster, b.AllInstanceGroups) {
 			clientHost = b.Cluster.APIInternalName()
 		}
 	default:
@@ -422,12 +422,19 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 		config.LogLevel = int(*etcdCluster.Manager.LogLevel)
 	}
 
+	// Spot interruptions are frequent, so we back up more often and rediscover the peers faster
+	controlPlaneOnSpot := b.controlPlaneOnSpot()
+
 	if etcdCluster.Manager != nil && etcdCluster.Manager.BackupInterval != nil {
 		config.BackupInterval = fi.PtrTo(etcdCluster.Manager.BackupInterval.Duration.String())
+	} else if controlPlaneOnSpot {
+		config.BackupInterval = fi.PtrTo("5m0s")
 	}
 
 	if etcdCluster.Manager != nil && etcdCluster.Manager.DiscoveryPollInterval != nil {
 		config.DiscoveryPollInterval = fi.PtrTo(etcdCluster.Manager.DiscoveryPollInterval.Duration.String())
+	} else if controlPlaneOnSpot {
+		config.DiscoveryPollInterval = fi.PtrTo("15s")
 	}
 
 	{
@@ -575,6 +582,15 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 				v1.ResourceMemory: memoryRequest,
 			},
 		}
+		if etcdCluster.CPULimit != nil || etcdCluster.MemoryLimit != nil {
+			container.Resources.Limits = v1.ResourceList{}
+			if etcdCluster.CPULimit != nil {
+				container.Resources.Limits[v1.ResourceCPU] = *etcdCluster.CPULimit
+			}
+			if etcdCluster.MemoryLimit != nil {
+				container.Resources.Limits[v1.ResourceMemory] = *etcdCluster.MemoryLimit
+			}
+		}
 
 		kubemanifest.AddHostPathMapping(pod, container, "varlogetcd", "/var/log/etcd.log",
 			kubemanifest.WithReadWrite(),
@@ -590,6 +606,26 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 
 	container.Env = envMap.ToEnvVars()
 
+	// etcd-manager passes the ETCD_ variables down to etcd; they can still be overridden with the manager env
+	if etcdCluster.QuotaBackendBytes != nil {
+		container.Env = append(container.Env, v1.EnvVar{
+			Name:  "ETCD_QUOTA_BACKEND_BYTES",
+			Value: strconv.FormatInt(etcdCluster.QuotaBackendBytes.Value(), 10),
+		})
+	}
+	if etcdCluster.AutoCompactionMode != "" {
+		container.Env = append(container.Env, v1.EnvVar{
+			Name:  "ETCD_AUTO_COMPACTION_MODE",
+			Value: etcdCluster.AutoCompactionMode,
+		})
+	}
+	if etcdCluster.AutoCompactionRetention != "" {
+		container.Env = append(container.Env, v1.EnvVar{
+			Name:  "ETCD_AUTO_COMPACTION_RETENTION",
+			Value: etcdCluster.AutoCompactionRetention,
+		})
+	}
+
 	if etcdCluster.Manager != nil {
 		if etcdCluster.Manager.BackupRetentionDays != nil {
 			envVar := v1.EnvVar{
@@ -644,6 +680,16 @@ func (b *EtcdManagerBuilder) buildPod(etcdCluster kops.EtcdClusterSpec, instance
 	return pod, nil
 }
 
+// controlPlaneOnSpot is true if any control plane instance group prefers spot capacity
+func (b *EtcdManagerBuilder) controlPlaneOnSpot() bool {
+	for _, ig := range b.AllInstanceGroups {
+		if ig.IsControlPlane() && fi.ValueOf(ig.Spec.PreferSpot) {
+			return true
+		}
+	}
+	return false
+}
+
 // config defines the flags for etcd-manager
 type config struct {
 	// LogLevel sets the log verbosity level
@@ -37,6 +37,9 @@ func Test_RunEtcdManagerBuilder(t *testing.T) {
 		"tests/interval",
 		"tests/proxy",
 		"tests/overwrite_settings",
+		"tests/quota_compaction",
+		"tests/etcd_limits",
+		"tests/large_gce",
 	}
 	for _, basedir := range tests {
 		basedir := basedir
@@ -23,15 +23,16 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kops/pkg/apis/kops"
-	"k8s.io/kops/pkg/featureflag"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	"k8s.io/kops/pkg/model/components/etcdmanager"
 	"k8s.io/kops/pkg/wellknownports"
 )
 
 // AddTemplateFunctions registers template functions for KopsController
-func AddTemplateFunctions(cluster *kops.Cluster, dest template.FuncMap) {
+func AddTemplateFunctions(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, dest template.FuncMap) {
 	t := &templateFunctions{
-		Cluster: cluster,
+		Cluster:        cluster,
+		InstanceGroups: instanceGroups,
 	}
 	dest["KopsController"] = func() *templateFunctions {
 		return t
@@ -40,7 +41,8 @@ func AddTemplateFunctions(cluster *kops.Cluster, dest template.FuncMap) {
 
 // templateFunctions implements the KopsController template object helper.
 type templateFunctions struct {
-	Cluster *kops.Cluster
+	Cluster        *kops.Cluster
+	InstanceGroups []*kops.InstanceGroup
 }
 
 // KopsControllerConfig returns the yaml configuration for kops-controller
@@ -82,7 +84,7 @@ func (t *templateFunctions) GossipServices() ([]*corev1.Service, error) {
 	}
 
 	// etcd services
-	if featureflag.APIServerNodes.Enabled() {
+	if kopsmodel.UseAPIServerNodes(t.Cluster, t.InstanceGroups) {
 		for _, etcdCluster := range t.Cluster.Spec.EtcdClusters {
 			name := "etcd-" + etcdCluster.Name + "-internal"
 			service := buildHeadlessService(types.NamespacedName{Name: name, Namespace: "kube-system"})
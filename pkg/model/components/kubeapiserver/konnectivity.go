@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeapiserver
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kops/pkg/kubemanifest"
+	"k8s.io/kops/pkg/wellknownports"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+const (
+	// KonnectivityServerDir is the directory holding the certificates, kubeconfig and socket of konnectivity-server (on the control-plane nodes)
+	KonnectivityServerDir = "/etc/kubernetes/konnectivity-server"
+	// KonnectivityServerSocketDir is the directory of the socket through which kube-apiserver connects to konnectivity-server
+	KonnectivityServerSocketDir = KonnectivityServerDir + "/socket"
+	// KonnectivityServerSocket is the socket through which kube-apiserver connects to konnectivity-server
+	KonnectivityServerSocket = KonnectivityServerSocketDir + "/konnectivity-server.socket"
+
+	// KonnectivityAgentServiceAccount is the service account konnectivity-agent authenticates with
+	KonnectivityAgentServiceAccount = "konnectivity-agent"
+	// KonnectivityServerAudience is the audience of the service account tokens of konnectivity-agent
+	KonnectivityServerAudience = "system:konnectivity-server"
+
+	defaultKonnectivityServerImage = "registry.k8s.io/kas-network-proxy/proxy-server:v0.31.2"
+)
+
+// buildKonnectivityServerSidecar builds the partial pod for the konnectivity-server sidecar.
+// nodeup will merge it into the kube-apiserver pod.
+func (b *KubeApiserverBuilder) buildKonnectivityServerSidecar() (*corev1.Pod, error) {
+	image := b.Cluster.Spec.Konnectivity.ServerImage
+	if image == "" {
+		image = defaultKonnectivityServerImage
+	}
+	image, err := b.AssetBuilder.RemapImage(image)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remap container image %q: %v", image, err)
+	}
+
+	// Agents connect to each server until they are connected to as many servers as the server count
+	serverCount := 0
+	for _, ig := range b.AllInstanceGroups {
+		if ig.HasAPIServer() {
+			serverCount += int(fi.ValueOf(ig.Spec.MinSize))
+		}
+	}
+	if serverCount == 0 {
+		serverCount = 1
+	}
+
+	container := corev1.Container{
+		Name:  "konnectivity-server",
+		Image: image,
+		Command: []string{
+			"/proxy-server",
+		},
+		Args: []string{
+			"--logtostderr=true",
+			"--uds-name=" + KonnectivityServerSocket,
+			"--delete-existing-uds-file",
+			"--cluster-cert=" + KonnectivityServerDir + "/server.crt",
+			"--cluster-key=" + KonnectivityServerDir + "/server.key",
+			"--server-port=0",
+			"--agent-port=" + strconv.Itoa(wellknownports.KonnectivityServerAgent),
+			"--admin-port=" + strconv.Itoa(wellknownports.KonnectivityServerAdmin),
+			"--health-port=" + strconv.Itoa(wellknownports.KonnectivityServerHealth),
+			"--mode=grpc",
+			"--agent-namespace=kube-system",
+			"--agent-service-account=" + KonnectivityAgentServiceAccount,
+			"--kubeconfig=" + KonnectivityServerDir + "/kubeconfig",
+			"--authentication-audience=" + KonnectivityServerAudience,
+			"--server-count=" + strconv.Itoa(serverCount),
+		},
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Host: "127.0.0.1",
+					Path: "/healthz",
+					Port: intstr.FromInt(wellknownports.KonnectivityServerHealth),
+				},
+			},
+			InitialDelaySeconds: 30,
+			TimeoutSeconds:      60,
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "agentport",
+				ContainerPort: wellknownports.KonnectivityServerAgent,
+				HostPort:      wellknownports.KonnectivityServerAgent,
+			},
+			{
+				Name:          "adminport",
+				ContainerPort: wellknownports.KonnectivityServerAdmin,
+				HostPort:      wellknownports.KonnectivityServerAdmin,
+			},
+			{
+				Name:          "healthport",
+				ContainerPort: wellknownports.KonnectivityServerHealth,
+				HostPort:      wellknownports.KonnectivityServerHealth,
+			},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("25m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+	}
+	kubemanifest.AddHostPathMapping(pod, &container, "konnectivity-server", KonnectivityServerDir, kubemanifest.WithReadWrite())
+	pod.Spec.Containers = append(pod.Spec.Containers, container)
+
+	return pod, nil
+}
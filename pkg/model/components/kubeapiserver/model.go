@@ -39,19 +39,38 @@ type KubeApiserverBuilder struct {
 var _ fi.CloudupModelBuilder = &KubeApiserverBuilder{}
 
 // Build creates the tasks relating to kube-apiserver
-// Currently we only build the kube-apiserver-healthcheck sidecar
+// Currently we only build the kube-apiserver-healthcheck and konnectivity-server sidecars
 func (b *KubeApiserverBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 	manifest, err := b.buildManifest()
 	if err != nil {
 		return err
 	}
 
+	if err := b.addStaticManifest(c, "kube-apiserver-healthcheck", manifest); err != nil {
+		return err
+	}
+
+	if b.Cluster.Spec.Konnectivity != nil && fi.ValueOf(b.Cluster.Spec.Konnectivity.Enabled) {
+		manifest, err := b.buildKonnectivityServerSidecar()
+		if err != nil {
+			return err
+		}
+
+		if err := b.addStaticManifest(c, "konnectivity-server", manifest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addStaticManifest publishes a partial pod for nodeup to merge into the kube-apiserver pod
+func (b *KubeApiserverBuilder) addStaticManifest(c *fi.CloudupModelBuilderContext, key string, manifest *corev1.Pod) error {
 	manifestYAML, err := k8scodecs.ToVersionedYaml(manifest)
 	if err != nil {
 		return fmt.Errorf("error marshaling manifest to yaml: %v", err)
 	}
 
-	key := "kube-apiserver-healthcheck"
 	location := "manifests/static/" + key + ".yaml"
 
 	c.AddTask(&fitasks.ManagedFile{
@@ -34,6 +34,7 @@ func Test_RunKubeApiserverBuilder(t *testing.T) {
 	featureflag.ParseFlags("-ImageDigest")
 	tests := []string{
 		"tests/minimal",
+		"tests/konnectivity",
 	}
 	for _, basedir := range tests {
 		basedir := basedir
@@ -167,5 +167,7 @@ func (b *KubeControllerManagerOptionsBuilder) BuildOptions(o *kops.Cluster) erro
 		}
 	}
 
+	kcm.FeatureGates = mergeFeatureGates(kcm.FeatureGates, clusterSpec.FeatureGates)
+
 	return nil
 }
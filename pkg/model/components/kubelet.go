@@ -187,6 +187,8 @@ func (b *KubeletOptionsBuilder) configureKubelet(cluster *kops.Cluster, kubelet
 		}
 	}
 
+	kubelet.FeatureGates = mergeFeatureGates(kubelet.FeatureGates, cluster.Spec.FeatureGates)
+
 	// Set systemd as the default cgroup driver for kubelet
 	if kubelet.CgroupDriver == "" {
 		kubelet.CgroupDriver = "systemd"
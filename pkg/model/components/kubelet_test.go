@@ -84,3 +84,30 @@ func TestFeatureGatesOverride(t *testing.T) {
 		t.Errorf("ExperimentalCriticalPodAnnotation feature should be disalbled")
 	}
 }
+
+func TestClusterFeatureGates(t *testing.T) {
+	cluster := buildKubeletTestCluster()
+	cluster.Spec.FeatureGates = map[string]bool{
+		"InPlacePodVerticalScaling": true,
+		"SidecarContainers":         true,
+	}
+	cluster.Spec.Kubelet.FeatureGates = map[string]string{
+		"SidecarContainers": "false",
+	}
+
+	err := buildOptions(cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gates := cluster.Spec.Kubelet.FeatureGates
+	if gates["InPlacePodVerticalScaling"] != "true" {
+		t.Errorf("InPlacePodVerticalScaling feature should be enabled from the cluster feature gates")
+	}
+	if gates["SidecarContainers"] != "false" {
+		t.Errorf("SidecarContainers feature should be disabled by the kubelet feature gates")
+	}
+	if cluster.Spec.ControlPlaneKubelet.FeatureGates["InPlacePodVerticalScaling"] != "true" {
+		t.Errorf("InPlacePodVerticalScaling feature should be enabled on the control plane kubelet")
+	}
+}
@@ -68,6 +68,8 @@ func (b *KubeProxyOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 		}
 	}
 
+	config.FeatureGates = mergeFeatureGates(config.FeatureGates, clusterSpec.FeatureGates)
+
 	return nil
 }
 
@@ -71,5 +71,8 @@ func (b *KubeSchedulerOptionsBuilder) BuildOptions(o *kops.Cluster) error {
 			config.FeatureGates["CSIMigrationAWS"] = "true"
 		}
 	}
+
+	config.FeatureGates = mergeFeatureGates(config.FeatureGates, clusterSpec.FeatureGates)
+
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/assets"
@@ -103,6 +104,15 @@ func (b *KubeSchedulerBuilder) buildSchedulerConfig() ([]byte, error) {
 		if err := MapToUnstructured(kubeScheduler, config); err != nil {
 			return nil, err
 		}
+
+		// Profiles of the cluster spec replace those of a KubeSchedulerConfiguration object
+		if len(kubeScheduler.Profiles) > 0 {
+			profiles, err := buildProfiles(kubeScheduler.Profiles)
+			if err != nil {
+				return nil, err
+			}
+			config.Object["profiles"] = profiles
+		}
 	}
 
 	configYAML, err := yaml.Marshal(config)
@@ -112,6 +122,41 @@ func (b *KubeSchedulerBuilder) buildSchedulerConfig() ([]byte, error) {
 	return configYAML, nil
 }
 
+// buildProfiles converts the scheduling profiles of the cluster spec to their kube-scheduler configuration
+func buildProfiles(profiles []kops.KubeSchedulerProfile) ([]interface{}, error) {
+	var out []interface{}
+	for _, profile := range profiles {
+		pluginConfigs := profile.PluginConfig
+		profile.PluginConfig = nil
+
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&profile)
+		if err != nil {
+			return nil, fmt.Errorf("error converting kube-scheduler profile %q: %w", profile.SchedulerName, err)
+		}
+
+		var pluginConfig []interface{}
+		for _, pc := range pluginConfigs {
+			entry := map[string]interface{}{
+				"name": pc.Name,
+			}
+			if pc.Args != "" {
+				args := make(map[string]interface{})
+				if err := yaml.Unmarshal([]byte(pc.Args), &args); err != nil {
+					return nil, fmt.Errorf("error parsing args of kube-scheduler plugin %q: %w", pc.Name, err)
+				}
+				entry["args"] = args
+			}
+			pluginConfig = append(pluginConfig, entry)
+		}
+		if len(pluginConfig) > 0 {
+			obj["pluginConfig"] = pluginConfig
+		}
+
+		out = append(out, obj)
+	}
+	return out, nil
+}
+
 // MapToUnstructured reflects the options interface and extracts the parameters for the config file
 func MapToUnstructured(options interface{}, target *unstructured.Unstructured) error {
 	setValue := func(targetPath string, val interface{}) error {
@@ -35,6 +35,7 @@ func Test_RunKubeSchedulerBuilder(t *testing.T) {
 		"tests/minimal",
 		"tests/kubeschedulerconfig",
 		"tests/mixing",
+		"tests/profiles",
 	}
 	for _, basedir := range tests {
 		basedir := basedir
@@ -19,6 +19,7 @@ package model
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -43,6 +44,8 @@ import (
 
 const (
 	clusterAutoscalerNodeTemplateTaint = "k8s.io/cluster-autoscaler/node-template/taint/"
+	// clusterAutoscalerAutoscalingOptions prefixes the tags overriding the cluster autoscaler options of an AWS autoscaling group
+	clusterAutoscalerAutoscalingOptions = "k8s.io/cluster-autoscaler/node-template/autoscaling-options/"
 )
 
 // KopsModelContext is the kops model
@@ -191,6 +194,30 @@ func (b *KopsModelContext) CloudTagsForInstanceGroup(ig *kops.InstanceGroup) (ma
 		}
 	}
 
+	// Apply the cluster autoscaler options of the instance group, only read from AWS autoscaling groups
+	if ig.Spec.ClusterAutoscaler != nil && b.Cluster.GetCloudProvider() == kops.CloudProviderAWS {
+		cas := ig.Spec.ClusterAutoscaler
+		if cas.ScaleDownUtilizationThreshold != nil {
+			labels[clusterAutoscalerAutoscalingOptions+"scaledownutilizationthreshold"] = *cas.ScaleDownUtilizationThreshold
+		}
+		if fi.ValueOf(cas.ScaleDownDisabled) {
+			// Nodes are never below a utilization threshold of 0, so are never removed
+			labels[clusterAutoscalerAutoscalingOptions+"scaledownutilizationthreshold"] = "0"
+		}
+		if cas.ScaleDownUnneededTime != nil {
+			labels[clusterAutoscalerAutoscalingOptions+"scaledownunneededtime"] = cas.ScaleDownUnneededTime.Duration.String()
+		}
+		if cas.ScaleDownUnreadyTime != nil {
+			labels[clusterAutoscalerAutoscalingOptions+"scaledownunreadytime"] = cas.ScaleDownUnreadyTime.Duration.String()
+		}
+		if cas.MaxNodeProvisionTime != nil {
+			labels[clusterAutoscalerAutoscalingOptions+"maxnodeprovisiontime"] = cas.MaxNodeProvisionTime.Duration.String()
+		}
+		if cas.IgnoreDaemonSetsUtilization != nil {
+			labels[clusterAutoscalerAutoscalingOptions+"ignoredaemonsetsutilization"] = strconv.FormatBool(*cas.IgnoreDaemonSetsUtilization)
+		}
+	}
+
 	switch b.Cluster.GetCloudProvider() {
 	case kops.CloudProviderHetzner:
 		labels[hetzner.TagKubernetesInstanceRole] = string(ig.Spec.Role)
@@ -296,11 +323,56 @@ func (b *KopsModelContext) UsesSSHBastion() bool {
 	return false
 }
 
+// HasWindowsInstanceGroups checks if the cluster has instance groups running Windows
+func (b *KopsModelContext) HasWindowsInstanceGroups() bool {
+	for _, ig := range b.AllInstanceGroups {
+		if ig.IsWindows() {
+			return true
+		}
+	}
+	return false
+}
+
+// ManagedBastionType returns the cloud service providing access to the instances, or "" if there is none
+func (b *KopsModelContext) ManagedBastionType() kops.ManagedBastionType {
+	topology := b.Cluster.Spec.Networking.Topology
+	if topology != nil && topology.Bastion != nil && topology.Bastion.Managed != nil {
+		return topology.Bastion.Managed.Type
+	}
+	return ""
+}
+
 // UseLoadBalancerForAPI checks if we are using a load balancer for the kubeapi
 func (b *KopsModelContext) UseLoadBalancerForAPI() bool {
 	return b.Cluster.Spec.API.LoadBalancer != nil
 }
 
+// UseDedicatedAPIServers is true if the API load balancer only targets the APIServer instance groups,
+// keeping the control plane nodes running etcd out of it.
+func (b *KopsModelContext) UseDedicatedAPIServers() bool {
+	if b.Cluster.Spec.ControlPlaneProfile != kops.ControlPlaneProfileLarge {
+		return false
+	}
+	// Without DNS, the nodes reach kops-controller on the control plane nodes through the API load balancer
+	if b.Cluster.UsesNoneDNS() {
+		return false
+	}
+	for _, ig := range b.AllInstanceGroups {
+		if ig.IsAPIServerOnly() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAPILoadBalancerTarget is true if the instances of the instance group should be registered with the API load balancer
+func (b *KopsModelContext) IsAPILoadBalancerTarget(ig *kops.InstanceGroup) bool {
+	if b.UseDedicatedAPIServers() {
+		return ig.IsAPIServerOnly()
+	}
+	return ig.HasAPIServer()
+}
+
 // UseLoadBalancerForInternalAPI check if true then we will use the created loadbalancer for internal kubelet
 // connections.  The intention here is to make connections to apiserver more
 // HA - see https://github.com/kubernetes/kops/issues/4252
@@ -168,6 +168,28 @@ func (b *APILoadBalancerBuilder) createInternalLB(c *fi.CloudupModelBuilderConte
 	}
 	c.AddTask(bs)
 
+	// Without DNS, the nodes reach kops-controller through the load balancer.
+	// It gets its own health check, so that requests only go to the control plane nodes where kops-controller is serving.
+	var kopsControllerBS *gcetasks.BackendService
+	if b.Cluster.UsesNoneDNS() {
+		kopsControllerHC := &gcetasks.HealthCheck{
+			Name:      s(b.NameForHealthCheck("kops-controller")),
+			Port:      wellknownports.KopsControllerPort,
+			Lifecycle: b.Lifecycle,
+		}
+		c.AddTask(kopsControllerHC)
+
+		kopsControllerBS = &gcetasks.BackendService{
+			Name:                  s(b.NameForBackendService("kops-controller")),
+			Protocol:              s("TCP"),
+			HealthChecks:          []*gcetasks.HealthCheck{kopsControllerHC},
+			Lifecycle:             b.Lifecycle,
+			LoadBalancingScheme:   s("INTERNAL"),
+			InstanceGroupManagers: igms,
+		}
+		c.AddTask(kopsControllerBS)
+	}
+
 	network, err := b.LinkToNetwork()
 	if err != nil {
 		return err
@@ -217,7 +239,7 @@ func (b *APILoadBalancerBuilder) createInternalLB(c *fi.CloudupModelBuilderConte
 			fr := &gcetasks.ForwardingRule{
 				Name:                s(b.NameForForwardingRule("kops-controller-" + sn.Name)),
 				Lifecycle:           b.Lifecycle,
-				BackendService:      bs,
+				BackendService:      kopsControllerBS,
 				Ports:               []string{strconv.Itoa(wellknownports.KopsControllerPort)},
 				IPAddress:           ipAddress,
 				IPProtocol:          "TCP",
@@ -78,6 +78,11 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 				volumeType = DefaultVolumeType
 			}
 
+			provisioningModel := ig.Spec.GCPProvisioningModel
+			if provisioningModel == nil && fi.ValueOf(ig.Spec.PreferSpot) {
+				provisioningModel = fi.PtrTo("SPOT")
+			}
+
 			namePrefix := gce.LimitedLengthName(name, gcetasks.InstanceTemplateNamePrefixMaxLength)
 			network, err := b.LinkToNetwork()
 			if err != nil {
@@ -93,8 +98,8 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 				BootDiskSizeGB: i64(int64(volumeSize)),
 				BootDiskImage:  s(ig.Spec.Image),
 
-				Preemptible:          fi.PtrTo(fi.ValueOf(ig.Spec.GCPProvisioningModel) == "SPOT"),
-				GCPProvisioningModel: ig.Spec.GCPProvisioningModel,
+				Preemptible:          fi.PtrTo(fi.ValueOf(provisioningModel) == "SPOT"),
+				GCPProvisioningModel: provisioningModel,
 
 				HasExternalIP: fi.PtrTo(subnet.Type == kops.SubnetTypePublic || subnet.Type == kops.SubnetTypeUtility || ig.IsBastion()),
 
@@ -111,7 +116,10 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 			}
 
 			if startupScript != nil {
-				if !fi.ValueOf(b.Cluster.Spec.CloudProvider.GCE.UseStartupScript) {
+				if ig.IsWindows() {
+					// GCE Windows images run the PowerShell startup script at every boot
+					t.Metadata["windows-startup-script-ps1"] = startupScript
+				} else if !fi.ValueOf(b.Cluster.Spec.CloudProvider.GCE.UseStartupScript) {
 					// Use "user-data" instead of "startup-script", for compatibility with cloud-init
 					t.Metadata["user-data"] = startupScript
 				} else {
@@ -124,6 +132,9 @@ func (b *AutoscalingGroupModelBuilder) buildInstanceTemplate(c *fi.CloudupModelB
 				if strings.HasPrefix(ig.Spec.Image, "cos-cloud/") {
 					autoscalerEnvVars = "os_distribution=cos;arch=amd64;os=linux"
 				}
+				if ig.IsWindows() {
+					autoscalerEnvVars = "os_distribution=windows;arch=amd64;os=windows"
+				}
 				t.Metadata["kube-env"] = fi.NewStringResource("AUTOSCALER_ENV_VARS: " + autoscalerEnvVars)
 			}
 
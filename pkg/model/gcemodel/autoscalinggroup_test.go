@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcemodel
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/fitasks"
+)
+
+func TestPreferSpotProvisioningModel(t *testing.T) {
+	grid := []struct {
+		Name                      string
+		PreferSpot                bool
+		GCPProvisioningModel      *string
+		ExpectedProvisioningModel string
+		ExpectedPreemptible       bool
+	}{
+		{
+			Name: "standard",
+		},
+		{
+			Name:                      "prefer spot",
+			PreferSpot:                true,
+			ExpectedProvisioningModel: "SPOT",
+			ExpectedPreemptible:       true,
+		},
+		{
+			Name:                      "prefer spot after on-demand fallback",
+			PreferSpot:                true,
+			GCPProvisioningModel:      fi.PtrTo("STANDARD"),
+			ExpectedProvisioningModel: "STANDARD",
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster := &kops.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "testcluster.test.com"},
+				Spec: kops.ClusterSpec{
+					CloudProvider: kops.CloudProviderSpec{
+						GCE: &kops.GCESpec{Project: "testproject"},
+					},
+					KubernetesVersion: "1.33.0",
+					Networking: kops.NetworkingSpec{
+						Subnets: []kops.ClusterSubnetSpec{
+							{Name: "us-test1", Region: "us-test1", Type: kops.SubnetTypePublic},
+						},
+					},
+				},
+			}
+			ig := &kops.InstanceGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+				Spec: kops.InstanceGroupSpec{
+					Role:                 kops.InstanceGroupRoleNode,
+					MachineType:          "e2-medium",
+					Subnets:              []string{"us-test1"},
+					PreferSpot:           fi.PtrTo(g.PreferSpot),
+					GCPProvisioningModel: g.GCPProvisioningModel,
+				},
+			}
+
+			modelContext := &model.KopsModelContext{
+				IAMModelContext:   iam.IAMModelContext{Cluster: cluster},
+				AllInstanceGroups: []*kops.InstanceGroup{ig},
+				InstanceGroups:    []*kops.InstanceGroup{ig},
+			}
+			b := &AutoscalingGroupModelBuilder{
+				GCEModelContext: &GCEModelContext{KopsModelContext: modelContext},
+				BootstrapScriptBuilder: &model.BootstrapScriptBuilder{
+					Lifecycle:        fi.LifecycleSync,
+					KopsModelContext: modelContext,
+				},
+				Lifecycle: fi.LifecycleSync,
+			}
+
+			c := &fi.CloudupModelBuilderContext{
+				Tasks: make(map[string]fi.CloudupTask),
+			}
+			for _, keypair := range []string{fi.CertificateIDCA, "etcd-clients-ca"} {
+				c.AddTask(&fitasks.Keypair{
+					Name:    fi.PtrTo(keypair),
+					Subject: "cn=" + keypair,
+					Type:    "ca",
+				})
+			}
+
+			template, err := b.buildInstanceTemplate(c, ig, &cluster.Spec.Networking.Subnets[0])
+			if err != nil {
+				t.Fatalf("error building instance template: %v", err)
+			}
+			if provisioningModel := fi.ValueOf(template.GCPProvisioningModel); provisioningModel != g.ExpectedProvisioningModel {
+				t.Errorf("expected provisioning model %q, got %q", g.ExpectedProvisioningModel, provisioningModel)
+			}
+			if preemptible := fi.ValueOf(template.Preemptible); preemptible != g.ExpectedPreemptible {
+				t.Errorf("expected preemptible %v, got %v", g.ExpectedPreemptible, preemptible)
+			}
+		})
+	}
+}
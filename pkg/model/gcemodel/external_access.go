@@ -26,6 +26,9 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup/gcetasks"
 )
 
+// iapTCPForwardingCIDR is the address range that Identity-Aware Proxy TCP forwarding connects from
+const iapTCPForwardingCIDR = "35.235.240.0/20"
+
 // ExternalAccessModelBuilder configures security group rules for external access
 // (SSHAccess, KubernetesAPIAccess)
 type ExternalAccessModelBuilder struct {
@@ -98,6 +101,20 @@ func (b *ExternalAccessModelBuilder) Build(c *fi.CloudupModelBuilderContext) err
 		})
 	}
 
+	if b.ManagedBastionType() == kops.ManagedBastionTypeIAP {
+		network, err := b.LinkToNetwork()
+		if err != nil {
+			return err
+		}
+		b.AddFirewallRulesTasks(c, "ssh-iap-to-instances", &gcetasks.FirewallRule{
+			Lifecycle:    b.Lifecycle,
+			TargetTags:   []string{b.GCETagForRole(kops.InstanceGroupRoleControlPlane), b.GCETagForRole("Master"), b.GCETagForRole(kops.InstanceGroupRoleNode)},
+			Allowed:      []string{"tcp:22"},
+			SourceRanges: []string{iapTCPForwardingCIDR},
+			Network:      network,
+		})
+	}
+
 	// NodePort access
 	{
 		nodePortRange, err := b.NodePortRange()
@@ -145,6 +145,9 @@ func (b *FirewallModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 			t.Allowed = append(t.Allowed, fmt.Sprintf("udp:%d", wellknownports.ProtokubeGossipMemberlist))
 			t.Allowed = append(t.Allowed, fmt.Sprintf("tcp:%d", wellknownports.ProtokubeGossipMemberlist))
 		}
+		if b.Cluster.Spec.Konnectivity != nil && fi.ValueOf(b.Cluster.Spec.Konnectivity.Enabled) {
+			t.Allowed = append(t.Allowed, fmt.Sprintf("tcp:%d", wellknownports.KonnectivityServerAgent))
+		}
 		if b.NetworkingIsCalico() {
 			t.Allowed = append(t.Allowed, "ipip")
 		}
@@ -74,7 +74,7 @@ func (b *ServerGroupModelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 			Size:       ig.Spec.MachineType,
 			Image:      ig.Spec.Image,
 			EnableIPv4: true,
-			EnableIPv6: false,
+			EnableIPv6: b.Cluster.Spec.IsIPv6Only(),
 			UserData:   userData,
 			Labels:     labels,
 		}
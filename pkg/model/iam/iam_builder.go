@@ -394,6 +394,10 @@ func (r *NodeRoleMaster) BuildAWSPolicy(b *PolicyBuilder) (*Policy, error) {
 		addKopsControllerIPAMPermissions(p)
 	}
 
+	if aws := b.Cluster.Spec.CloudProvider.AWS; aws != nil && fi.ValueOf(aws.ResizeVolumesInPlace) {
+		addKopsControllerVolumeResizePermissions(p)
+	}
+
 	if err := b.AddS3Permissions(p); err != nil {
 		return nil, fmt.Errorf("failed to generate AWS IAM S3 access statements: %v", err)
 	}
@@ -446,6 +450,10 @@ func (r *NodeRoleMaster) BuildAWSPolicy(b *PolicyBuilder) (*Policy, error) {
 		addKindnetSrcDstCheckPermissions(p)
 	}
 
+	if gitOps := b.Cluster.Spec.GitOps; gitOps != nil && gitOps.GrantPermissions {
+		addGitOpsPermissions(p, gitOps.ReconcileEnabled())
+	}
+
 	return p, nil
 }
 
@@ -683,6 +691,16 @@ func WriteableVFSPaths(cluster *kops.Cluster, role Subject) ([]vfs.Path, error)
 
 			backupStores.Insert(backupStore)
 		}
+
+		// kops-controller writes the configuration of the cluster when reconciling it
+		if cluster.Spec.GitOps.ReconcileEnabled() {
+			vfsPath, err := vfs.Context.BuildVfsPath(cluster.Spec.ConfigStore.Base)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse VFS path %q: %v", cluster.Spec.ConfigStore.Base, err)
+			}
+
+			paths = append(paths, vfsPath)
+		}
 	}
 
 	return paths, nil
@@ -818,6 +836,52 @@ func addKopsControllerIPAMPermissions(p *Policy) {
 	)
 }
 
+func addKopsControllerVolumeResizePermissions(p *Policy) {
+	p.unconditionalAction.Insert(
+		"autoscaling:DescribeAutoScalingGroups",
+		"autoscaling:DescribeTags",
+		"ec2:DescribeLaunchTemplates",
+		"ec2:DescribeLaunchTemplateVersions",
+		"ec2:DescribeVolumes",
+	)
+	p.clusterTaggedAction.Insert(
+		"ec2:ModifyVolume",
+	)
+}
+
+// addGitOpsPermissions grants kops-controller the permissions of "kops update cluster", so that it can compare
+// the cloud resources with the model and, when reconciling, create, update and delete them.
+func addGitOpsPermissions(p *Policy, reconcile bool) {
+	p.unconditionalAction.Insert(
+		"autoscaling:Describe*",
+		"ec2:Describe*",
+		"elasticloadbalancing:Describe*",
+		"events:DescribeRule",
+		"events:ListRules",
+		"events:ListTagsForResource",
+		"events:ListTargetsByRule",
+		"iam:Get*",
+		"iam:List*",
+		"route53:Get*",
+		"route53:List*",
+		"sqs:GetQueueAttributes",
+		"sqs:ListQueueTags",
+		"sqs:ListQueues",
+		"ssm:GetParameter",
+	)
+	if reconcile {
+		p.unconditionalAction.Insert(
+			"autoscaling:*",
+			"ec2:*",
+			"elasticloadbalancing:*",
+			"events:*",
+			"iam:*",
+			"route53:*",
+			"sqs:*",
+		)
+	}
+}
+
 func addEtcdManagerPermissions(p *Policy) {
 	p.unconditionalAction.Insert(
 		"ec2:DescribeVolumes", // aws.go
@@ -18,6 +18,7 @@ package iam
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -100,6 +101,7 @@ func TestPolicyGeneration(t *testing.T) {
 		Gossip                 bool
 		Role                   Subject
 		AllowContainerRegistry bool
+		GitOps                 *kops.GitOpsSpec
 		Policy                 string
 	}{
 		{
@@ -124,6 +126,16 @@ func TestPolicyGeneration(t *testing.T) {
 			AllowContainerRegistry: true,
 			Policy:                 "tests/iam_builder_master_gossip_ecr.json",
 		},
+		{
+			Role:   &NodeRoleMaster{},
+			GitOps: &kops.GitOpsSpec{GrantPermissions: true},
+			Policy: "tests/iam_builder_master_gitops_driftreport.json",
+		},
+		{
+			Role:   &NodeRoleMaster{},
+			GitOps: &kops.GitOpsSpec{Mode: kops.GitOpsModeReconcile, GrantPermissions: true},
+			Policy: "tests/iam_builder_master_gitops_reconcile.json",
+		},
 		{
 			Role:                   &NodeRoleNode{},
 			AllowContainerRegistry: false,
@@ -213,6 +225,7 @@ func TestPolicyGeneration(t *testing.T) {
 					Networking: kops.NetworkingSpec{
 						Kubenet: &kops.KubenetNetworkingSpec{},
 					},
+					GitOps: x.GitOps,
 				},
 			},
 			Role:      x.Role,
@@ -268,3 +281,33 @@ func TestEmptyPolicy(t *testing.T) {
 		t.Errorf("empty policy should result in empty string, but was %q", policy)
 	}
 }
+
+func TestPolicyPartition(t *testing.T) {
+	for _, partition := range []string{"aws-cn", "aws-us-gov", "aws-iso"} {
+		for _, role := range []Subject{&NodeRoleMaster{}, &NodeRoleNode{}} {
+			cluster := testutils.BuildMinimalCluster("iam-builder-test.nonexistant")
+			cluster.Spec.ConfigStore.Base = "s3://kops-tests/iam-builder-test.nonexistant"
+			b := &PolicyBuilder{
+				Cluster:   cluster,
+				Role:      role,
+				Partition: partition,
+			}
+
+			p, err := b.BuildAWSPolicy()
+			if err != nil {
+				t.Fatalf("failed to build an AWS IAM policy for %s: %v", partition, err)
+			}
+			policy, err := p.AsJSON()
+			if err != nil {
+				t.Fatalf("failed to convert generated IAM Policy to JSON: %v", err)
+			}
+
+			if !strings.Contains(policy, "arn:"+partition+":") {
+				t.Errorf("expected ARNs in the %s partition, got %s", partition, policy)
+			}
+			if strings.Contains(policy, "arn:aws:") {
+				t.Errorf("unexpected ARN in the aws partition in the policy for %s: %s", partition, policy)
+			}
+		}
+	}
+}
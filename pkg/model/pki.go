@@ -63,6 +63,16 @@ func (b *PKIModelBuilder) Build(c *fi.CloudupModelBuilderContext) error {
 		c.AddTask(serviceAccount)
 	}
 
+	if b.Cluster.Spec.SSHCertificateAuthority != nil {
+		sshCA := &fitasks.Keypair{
+			Name:      fi.PtrTo("ssh-ca"),
+			Lifecycle: b.Lifecycle,
+			Subject:   "cn=ssh-ca",
+			Type:      "ca",
+		}
+		c.AddTask(sshCA)
+	}
+
 	// Create auth tokens (though this is deprecated)
 	for _, x := range tokens.GetKubernetesAuthTokens_Deprecated() {
 		c.AddTask(&fitasks.Secret{Name: fi.PtrTo(x), Lifecycle: b.Lifecycle})
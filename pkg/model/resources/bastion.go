@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+const (
+	// BastionSSHCertificateAuthoritiesPath is where the bastion script writes the trusted SSH certificate authorities
+	BastionSSHCertificateAuthoritiesPath = "/etc/ssh/kops-ssh-ca.pub"
+	// BastionSSHDConfigPath is where the bastion script writes the sshd settings
+	BastionSSHDConfigPath = "/etc/ssh/sshd_config.d/40-kops.conf"
+)
+
+// BastionSSHDConfig returns the sshd settings for the bastion instances, or nil if sshd does not need to be configured.
+// Bastions do not run nodeup, so these are applied by a script in the user data.
+func BastionSSHDConfig(bastion *kops.BastionSpec, trustCertificateAuthorities bool) []string {
+	var config []string
+
+	if trustCertificateAuthorities {
+		config = append(config, "TrustedUserCAKeys "+BastionSSHCertificateAuthoritiesPath)
+	}
+
+	if bastion != nil {
+		var allowUsers []string
+		for _, access := range bastion.Access {
+			for _, user := range access.Users {
+				if len(access.CIDRs) == 0 {
+					allowUsers = append(allowUsers, user)
+				}
+				for _, cidr := range access.CIDRs {
+					allowUsers = append(allowUsers, user+"@"+cidr)
+				}
+			}
+		}
+		if len(allowUsers) != 0 {
+			config = append(config, "AllowUsers "+strings.Join(allowUsers, " "))
+		}
+
+		if bastion.SessionIdleTimeout != nil {
+			// ChannelTimeout closes idle shells and forwarded connections, then UnusedConnectionTimeout closes the connection
+			seconds := int64(bastion.SessionIdleTimeout.Seconds())
+			config = append(config,
+				fmt.Sprintf("ChannelTimeout session=%ds direct-tcpip=%ds", seconds, seconds),
+				fmt.Sprintf("UnusedConnectionTimeout %ds", seconds),
+			)
+		}
+	}
+
+	if len(config) == 0 {
+		return nil
+	}
+	return append([]string{"# Managed by kOps"}, config...)
+}
+
+// BastionScript returns the user data script that configures sshd on the bastion instances.
+func BastionScript(certificateAuthorities []string, sshdConfig []string) string {
+	var b strings.Builder
+	b.WriteString("#!/bin/bash\n")
+	b.WriteString("set -o errexit\n")
+	b.WriteString("set -o nounset\n")
+	b.WriteString("set -o pipefail\n\n")
+
+	if len(certificateAuthorities) != 0 {
+		b.WriteString("cat > " + BastionSSHCertificateAuthoritiesPath + " <<'__EOF_KOPS_SSH_CA'\n")
+		b.WriteString(strings.Join(certificateAuthorities, "\n") + "\n")
+		b.WriteString("__EOF_KOPS_SSH_CA\n\n")
+	}
+
+	b.WriteString("mkdir -p /etc/ssh/sshd_config.d\n")
+	b.WriteString("cat > " + BastionSSHDConfigPath + " <<'__EOF_KOPS_SSHD_CONFIG'\n")
+	b.WriteString(strings.Join(sshdConfig, "\n") + "\n")
+	b.WriteString("__EOF_KOPS_SSHD_CONFIG\n\n")
+
+	b.WriteString("systemctl try-reload-or-restart ssh.service sshd.service || true\n")
+	return b.String()
+}
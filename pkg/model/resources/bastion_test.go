@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/pkg/apis/kops"
+)
+
+func Test_BastionSSHDConfig(t *testing.T) {
+	grid := []struct {
+		Name     string
+		Bastion  *kops.BastionSpec
+		TrustCAs bool
+		Expected []string
+	}{
+		{
+			Name: "nothing to configure",
+			Bastion: &kops.BastionSpec{
+				PublicName: "bastion.example.com",
+			},
+		},
+		{
+			Name:     "certificate authority",
+			TrustCAs: true,
+			Expected: []string{
+				"# Managed by kOps",
+				"TrustedUserCAKeys /etc/ssh/kops-ssh-ca.pub",
+			},
+		},
+		{
+			Name: "access and idle timeout",
+			Bastion: &kops.BastionSpec{
+				Access: []kops.BastionAccessSpec{
+					{Users: []string{"alice", "bob"}, CIDRs: []string{"192.0.2.0/24", "2001:db8::/32"}},
+					{Users: []string{"ubuntu"}},
+				},
+				SessionIdleTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			Expected: []string{
+				"# Managed by kOps",
+				"AllowUsers alice@192.0.2.0/24 alice@2001:db8::/32 bob@192.0.2.0/24 bob@2001:db8::/32 ubuntu",
+				"ChannelTimeout session=900s direct-tcpip=900s",
+				"UnusedConnectionTimeout 900s",
+			},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			actual := BastionSSHDConfig(g.Bastion, g.TrustCAs)
+			if !reflect.DeepEqual(actual, g.Expected) {
+				t.Errorf("unexpected sshd config\nexpected: %q\n  actual: %q", g.Expected, actual)
+			}
+		})
+	}
+}
+
+func Test_BastionScript(t *testing.T) {
+	script := BastionScript([]string{"ssh-ed25519 AAAA ssh-ca"}, []string{"# Managed by kOps", "TrustedUserCAKeys /etc/ssh/kops-ssh-ca.pub"})
+
+	for _, expected := range []string{
+		"cat > /etc/ssh/kops-ssh-ca.pub <<'__EOF_KOPS_SSH_CA'\nssh-ed25519 AAAA ssh-ca\n__EOF_KOPS_SSH_CA\n",
+		"cat > /etc/ssh/sshd_config.d/40-kops.conf <<'__EOF_KOPS_SSHD_CONFIG'\n# Managed by kOps\nTrustedUserCAKeys /etc/ssh/kops-ssh-ca.pub\n__EOF_KOPS_SSHD_CONFIG\n",
+		"systemctl try-reload-or-restart ssh.service sshd.service",
+	} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("expected script to contain %q, got:\n%s", expected, script)
+		}
+	}
+}
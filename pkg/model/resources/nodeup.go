@@ -308,6 +308,11 @@ func AWSMultipartMIME(bootScript string, ig *kops.InstanceGroup) (string, error)
 			if err != nil {
 				return "", err
 			}
+		} else if bootScript != "" {
+			err := writeUserDataPart(mimeWriter, "bastion.sh", "text/x-shellscript", []byte(bootScript))
+			if err != nil {
+				return "", err
+			}
 		}
 
 		for _, d := range ig.Spec.AdditionalUserData {
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+var windowsTemplate = `$ErrorActionPreference = 'Stop'
+$ProgressPreference = 'SilentlyContinue'
+
+New-Item -ItemType Directory -Force -Path 'C:\k' | Out-Null
+Start-Transcript -Path 'C:\k\bootstrap.log' -Append
+
+# The script runs at every boot; the node is configured once
+if (Get-Service -Name kubelet -ErrorAction SilentlyContinue) {
+  Write-Output 'kubelet is already installed'
+  exit 0
+}
+
+if ((Get-WindowsFeature -Name Containers).InstallState -ne 'Installed') {
+  $result = Install-WindowsFeature -Name Containers
+  if ($result.RestartNeeded -eq 'Yes') {
+    Write-Output 'Restarting to enable the Containers feature'
+    Restart-Computer -Force
+    exit 0
+  }
+}
+
+# Retry a download until we get it
+function Get-KopsAsset([string]$Path, [string]$Hash, [string[]]$Urls) {
+  for ($attempt = 0; $attempt -lt 10; $attempt++) {
+    foreach ($url in $Urls) {
+      try {
+        Write-Output "Downloading ${Path} from ${url}"
+        Invoke-WebRequest -UseBasicParsing -Uri $url -OutFile $Path
+        $actual = (Get-FileHash -Algorithm SHA256 -Path $Path).Hash
+        if ($actual -eq $Hash) {
+          return
+        }
+        Write-Output "Hash mismatch for ${url}: expected ${Hash}, got ${actual}"
+      } catch {
+        Write-Output "Error downloading ${url}: $_"
+      }
+    }
+    Start-Sleep -Seconds 30
+  }
+  throw "Unable to download ${Path}"
+}
+
+{{ if eq .CloudProvider "aws" }}
+$imdsToken = Invoke-RestMethod -Method Put -Uri 'http://169.254.169.254/latest/api/token' -Headers @{ 'X-aws-ec2-metadata-token-ttl-seconds' = '300' }
+$nodeName = Invoke-RestMethod -Uri 'http://169.254.169.254/latest/meta-data/instance-id' -Headers @{ 'X-aws-ec2-metadata-token' = $imdsToken }
+{{ else if eq .CloudProvider "gce" }}
+$hostname = Invoke-RestMethod -Uri 'http://metadata.google.internal/computeMetadata/v1/instance/hostname' -Headers @{ 'Metadata-Flavor' = 'Google' }
+$nodeName = $hostname.Split('.')[0]
+{{ else }}
+$nodeName = $env:COMPUTERNAME
+{{ end }}
+$nodeName = $nodeName.ToLower()
+
+$containerdDir = "$env:ProgramFiles\containerd"
+New-Item -ItemType Directory -Force -Path $containerdDir, "$containerdDir\cni\bin", "$containerdDir\cni\conf" | Out-Null
+New-Item -ItemType Directory -Force -Path 'C:\var\lib\kubelet\pki' | Out-Null
+
+Get-KopsAsset -Path 'C:\k\containerd.tar.gz' -Hash {{ Quote .Containerd.Hash }} -Urls @({{ QuoteAll .Containerd.URLs }})
+tar.exe -xzf 'C:\k\containerd.tar.gz' -C $containerdDir
+Get-KopsAsset -Path 'C:\k\kubelet.exe' -Hash {{ Quote .Kubelet.Hash }} -Urls @({{ QuoteAll .Kubelet.URLs }})
+Get-KopsAsset -Path 'C:\k\kube-proxy.exe' -Hash {{ Quote .KubeProxy.Hash }} -Urls @({{ QuoteAll .KubeProxy.URLs }})
+
+$machinePath = [Environment]::GetEnvironmentVariable('Path', 'Machine')
+[Environment]::SetEnvironmentVariable('Path', "$machinePath;$containerdDir\bin;C:\k", 'Machine')
+
+& "$containerdDir\bin\containerd.exe" config default | Out-File -Encoding ascii -FilePath "$containerdDir\config.toml"
+& "$containerdDir\bin\containerd.exe" --register-service --config "$containerdDir\config.toml"
+Set-Service -Name containerd -StartupType Automatic
+Start-Service -Name containerd
+
+Set-Content -Encoding ascii -Path 'C:\k\ca.crt' -Value @'
+{{ .CACertificates }}
+'@
+
+foreach ($kubeconfig in @('C:\k\bootstrap-kubeconfig', 'C:\k\kube-proxy-kubeconfig')) {
+  Set-Content -Encoding ascii -Path $kubeconfig -Value @'
+apiVersion: v1
+kind: Config
+clusters:
+- name: kubernetes
+  cluster:
+    certificate-authority: C:/k/ca.crt
+    server: {{ .APIServer }}
+contexts:
+- name: bootstrap
+  context:
+    cluster: kubernetes
+    user: bootstrap
+current-context: bootstrap
+users:
+- name: bootstrap
+  user:
+    token: {{ .BootstrapToken }}
+'@
+}
+
+Set-Content -Encoding ascii -Path 'C:\k\kubelet-config.yaml' -Value @'
+apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+authentication:
+  anonymous:
+    enabled: false
+  webhook:
+    enabled: true
+  x509:
+    clientCAFile: C:/k/ca.crt
+authorization:
+  mode: Webhook
+cgroupsPerQOS: false
+enforceNodeAllocatable: []
+containerRuntimeEndpoint: npipe:////./pipe/containerd-containerd
+resolvConf: ""
+rotateCertificates: true
+{{- if .ClusterDNS }}
+clusterDNS:
+- {{ .ClusterDNS }}
+{{- end }}
+{{- if .ClusterDomain }}
+clusterDomain: {{ .ClusterDomain }}
+{{- end }}
+{{- if .MaxPods }}
+maxPods: {{ .MaxPods }}
+{{- end }}
+'@
+
+$kubeletArgs = @(
+  '--windows-service',
+  '--config=C:/k/kubelet-config.yaml',
+  '--bootstrap-kubeconfig=C:/k/bootstrap-kubeconfig',
+  '--kubeconfig=C:/var/lib/kubelet/kubeconfig',
+  '--cert-dir=C:/var/lib/kubelet/pki',
+  "--hostname-override=$nodeName",
+  '--cloud-provider=external',
+{{- if .Taints }}
+  {{ Quote (printf "--register-with-taints=%s" (Join .Taints ",")) }},
+{{- end }}
+  '--v=2'
+)
+New-Service -Name kubelet -BinaryPathName ('C:\k\kubelet.exe ' + ($kubeletArgs -join ' ')) -StartupType Automatic -DependsOn containerd | Out-Null
+New-NetFirewallRule -Name kubelet -DisplayName 'kubelet' -Enabled True -Direction Inbound -Protocol TCP -Action Allow -LocalPort 10250 | Out-Null
+New-NetFirewallRule -Name vxlan -DisplayName 'Calico VXLAN' -Enabled True -Direction Inbound -Protocol UDP -Action Allow -LocalPort 4789 | Out-Null
+Start-Service -Name kubelet
+
+# kube-proxy needs the overlay network and the source VIP of the node, which exist once Calico is running
+Set-Content -Encoding ascii -Path 'C:\k\kube-proxy.ps1' -Value @'
+param([string]$NodeName)
+$ErrorActionPreference = 'Stop'
+$cniDir = "$env:ProgramFiles\containerd\cni"
+while (-not (Get-HnsNetwork | Where-Object { $_.Name -eq 'Calico' })) {
+  Start-Sleep -Seconds 10
+}
+if (-not (Test-Path 'C:\k\source-vip.json')) {
+  $env:CNI_COMMAND = 'ADD'
+  $env:CNI_CONTAINERID = 'kube-proxy'
+  $env:CNI_NETNS = 'none'
+  $env:CNI_IFNAME = 'source-vip'
+  $env:CNI_PATH = "$cniDir\bin"
+  $env:KUBERNETES_NODE_NAME = $NodeName
+  $ipamConfig = @{
+    cniVersion = '0.3.1'
+    name = 'Calico'
+    type = 'calico-ipam'
+    datastore_type = 'kubernetes'
+    kubernetes = @{ kubeconfig = "$cniDir\conf\calico-kubeconfig" }
+    ipam = @{ type = 'calico-ipam' }
+  }
+  $ipamConfig | ConvertTo-Json | & "$cniDir\bin\calico-ipam.exe" | Out-File -Encoding ascii -FilePath 'C:\k\source-vip.json'
+}
+$sourceVip = ((Get-Content 'C:\k\source-vip.json' | ConvertFrom-Json).ips[0].address -split '/')[0]
+& 'C:\k\kube-proxy.exe' "--hostname-override=$NodeName" --kubeconfig=C:/k/kube-proxy-kubeconfig --proxy-mode=kernelspace --network-name=Calico "--source-vip=$sourceVip"{{ if .ClusterCIDR }} --cluster-cidr={{ .ClusterCIDR }}{{ end }} --v=2
+'@
+
+$action = New-ScheduledTaskAction -Execute 'powershell.exe' -Argument "-NoProfile -ExecutionPolicy Bypass -File C:\k\kube-proxy.ps1 -NodeName $nodeName"
+$trigger = New-ScheduledTaskTrigger -AtStartup
+$settings = New-ScheduledTaskSettingsSet -RestartCount 999 -RestartInterval (New-TimeSpan -Minutes 1) -ExecutionTimeLimit ([TimeSpan]::Zero)
+Register-ScheduledTask -TaskName kube-proxy -Action $action -Trigger $trigger -Settings $settings -User 'NT AUTHORITY\SYSTEM' -RunLevel Highest -Force | Out-Null
+Start-ScheduledTask -TaskName kube-proxy
+
+Write-Output 'Windows node bootstrap complete'
+`
+
+// WindowsAsset is a file downloaded by the Windows bootstrap script.
+type WindowsAsset struct {
+	// Hash is the sha256 hash of the file, in hex.
+	Hash string
+	// URLs are the locations of the file, tried in order.
+	URLs []string
+}
+
+// WindowsScript builds the script which bootstraps the Windows nodes.
+// Windows nodes don't run nodeup; the script installs containerd, the kubelet and kube-proxy,
+// and joins the cluster using a bootstrap token.
+type WindowsScript struct {
+	CloudProvider string
+
+	Containerd *WindowsAsset
+	Kubelet    *WindowsAsset
+	KubeProxy  *WindowsAsset
+
+	// CACertificates are the PEM encoded certificates trusted for the API server.
+	CACertificates string
+	// APIServer is the URL of the API server.
+	APIServer string
+	// BootstrapToken is the token the kubelet and kube-proxy authenticate with.
+	BootstrapToken string
+
+	ClusterDNS    string
+	ClusterDomain string
+	ClusterCIDR   string
+	Taints        []string
+	MaxPods       int32
+}
+
+// WithAssets sets the containerd, kubelet and kube-proxy assets from the assets of the nodeup config.
+func (s *WindowsScript) WithAssets(assets []string) error {
+	for _, asset := range assets {
+		hash, locations, found := strings.Cut(asset, "@")
+		if !found || hash == "" || locations == "" {
+			return fmt.Errorf("invalid asset %q", asset)
+		}
+		a := &WindowsAsset{
+			Hash: strings.ToUpper(hash),
+			URLs: strings.Split(locations, ","),
+		}
+		switch name := path.Base(a.URLs[0]); {
+		case name == "kubelet.exe":
+			s.Kubelet = a
+		case name == "kube-proxy.exe":
+			s.KubeProxy = a
+		case strings.HasPrefix(name, "containerd-") && strings.Contains(name, "windows"):
+			s.Containerd = a
+		}
+	}
+
+	if s.Containerd == nil || s.Kubelet == nil || s.KubeProxy == nil {
+		return fmt.Errorf("containerd, kubelet and kube-proxy assets are required for Windows nodes")
+	}
+	return nil
+}
+
+// Build returns the script as a resource.
+func (s *WindowsScript) Build() (fi.Resource, error) {
+	functions := template.FuncMap{
+		"Quote": powershellQuote,
+		"QuoteAll": func(values []string) string {
+			var quoted []string
+			for _, value := range values {
+				quoted = append(quoted, powershellQuote(value))
+			}
+			return strings.Join(quoted, ", ")
+		},
+		"Join": strings.Join,
+	}
+
+	return newTemplateResource("windows-bootstrap", windowsTemplate, functions, s)
+}
+
+// powershellQuote returns the value as a single-quoted PowerShell string.
+func powershellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
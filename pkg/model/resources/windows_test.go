@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func Test_WindowsScript(t *testing.T) {
+	script := &WindowsScript{
+		CloudProvider:  "aws",
+		CACertificates: "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----",
+		APIServer:      "https://api.internal.minimal.example.com",
+		BootstrapToken: "abcdef.0123456789abcdef",
+		ClusterDNS:     "100.64.0.10",
+		ClusterDomain:  "cluster.local",
+		ClusterCIDR:    "100.96.0.0/11",
+		Taints:         []string{"node.kubernetes.io/os=windows:NoSchedule"},
+	}
+
+	if err := script.WithAssets([]string{"abc@https://example.com/kubelet"}); err == nil {
+		t.Errorf("expected error when the Windows assets are missing")
+	}
+	if err := script.WithAssets([]string{
+		"0123@https://dl.k8s.io/release/v1.33.0/bin/windows/amd64/kubelet.exe",
+		"4567@https://dl.k8s.io/release/v1.33.0/bin/windows/amd64/kube-proxy.exe",
+		"89ab@https://github.com/containerd/containerd/releases/download/v1.7.28/containerd-1.7.28-windows-amd64.tar.gz,https://mirror.example.com/containerd-1.7.28-windows-amd64.tar.gz",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	resource, err := script.Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	actual, err := fi.ResourceAsString(resource)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{
+		"Get-KopsAsset -Path 'C:\\k\\containerd.tar.gz' -Hash '89AB' -Urls @('https://github.com/containerd/containerd/releases/download/v1.7.28/containerd-1.7.28-windows-amd64.tar.gz', 'https://mirror.example.com/containerd-1.7.28-windows-amd64.tar.gz')",
+		"Get-KopsAsset -Path 'C:\\k\\kubelet.exe' -Hash '0123' -Urls @('https://dl.k8s.io/release/v1.33.0/bin/windows/amd64/kubelet.exe')",
+		"http://169.254.169.254/latest/meta-data/instance-id",
+		"@'\n-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n'@",
+		"    server: https://api.internal.minimal.example.com\n",
+		"    token: abcdef.0123456789abcdef\n",
+		"clusterDNS:\n- 100.64.0.10\nclusterDomain: cluster.local\n'@",
+		"  '--register-with-taints=node.kubernetes.io/os=windows:NoSchedule',\n",
+		"--cluster-cidr=100.96.0.0/11 --v=2",
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("expected script to contain %q, got:\n%s", expected, actual)
+		}
+	}
+	if strings.Contains(actual, "metadata.google.internal") {
+		t.Errorf("expected AWS script not to query the GCE metadata server")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeexec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+// azureExitCodeMarker prefixes the line reporting the exit status of the command,
+// as Run Command only reports whether the script could be started.
+const azureExitCodeMarker = "kops-exit-code="
+
+// azureRunCommandTransport runs commands using Azure Run Command on VM Scale Set VMs.
+type azureRunCommandTransport struct {
+	vms           azure.VMScaleSetVMsClient
+	resourceGroup string
+}
+
+var _ Transport = &azureRunCommandTransport{}
+
+// NewAzureRunCommandTransport returns a Transport that runs commands using Azure Run Command.
+// Instance IDs are VM names, in the form <vmss>_<instance-id>.
+func NewAzureRunCommandTransport(vms azure.VMScaleSetVMsClient, resourceGroup string) Transport {
+	return &azureRunCommandTransport{
+		vms:           vms,
+		resourceGroup: resourceGroup,
+	}
+}
+
+// Exec implements Transport::Exec
+func (t *azureRunCommandTransport) Exec(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error {
+	i := strings.LastIndex(instanceID, "_")
+	if i <= 0 || i == len(instanceID)-1 {
+		return fmt.Errorf("invalid Azure instance ID %q, expected <vmss>_<instance-id>", instanceID)
+	}
+	vmssName, vmID := instanceID[:i], instanceID[i+1:]
+
+	klog.V(2).Infof("running Azure run command on %s: %v", instanceID, command)
+
+	result, err := t.vms.RunCommand(ctx, t.resourceGroup, vmssName, vmID, []string{
+		command,
+		"echo " + azureExitCodeMarker + "$?",
+	})
+	if err != nil {
+		return err
+	}
+
+	var message string
+	for _, status := range result.Value {
+		if status.Message != nil {
+			message += *status.Message
+		}
+	}
+	out, errOut := parseAzureRunCommandMessage(message)
+
+	exitCode := -1
+	var lines strings.Builder
+	for _, line := range strings.SplitAfter(out, "\n") {
+		if v, ok := strings.CutPrefix(line, azureExitCodeMarker); ok {
+			if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+				exitCode = n
+			}
+			continue
+		}
+		lines.WriteString(line)
+	}
+
+	if _, err := io.WriteString(stdout, lines.String()); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(stderr, errOut); err != nil {
+		return err
+	}
+
+	switch exitCode {
+	case 0:
+		return nil
+	case -1:
+		return fmt.Errorf("exit status of command on %s not reported, output may be truncated", instanceID)
+	default:
+		return &ExitError{ExitCode: exitCode}
+	}
+}
+
+// parseAzureRunCommandMessage splits the message of a RunShellScript result into stdout and stderr.
+// The message has the form "Enable succeeded: \n[stdout]\n...\n[stderr]\n...".
+func parseAzureRunCommandMessage(message string) (string, string) {
+	_, rest, ok := strings.Cut(message, "[stdout]\n")
+	if !ok {
+		return message, ""
+	}
+	out, errOut, _ := strings.Cut(rest, "[stderr]\n")
+	return strings.TrimSuffix(out, "\n"), errOut
+}
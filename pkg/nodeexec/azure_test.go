@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeexec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+type fakeVMScaleSetVMs struct {
+	azure.VMScaleSetVMsClient
+
+	vmssName   string
+	instanceID string
+	message    string
+}
+
+func (f *fakeVMScaleSetVMs) RunCommand(ctx context.Context, resourceGroupName, vmssName, instanceID string, script []string) (*compute.RunCommandResult, error) {
+	f.vmssName = vmssName
+	f.instanceID = instanceID
+	return &compute.RunCommandResult{
+		Value: []*compute.InstanceViewStatus{
+			{Message: &f.message},
+		},
+	}, nil
+}
+
+func TestAzureRunCommandTransport(t *testing.T) {
+	grid := []struct {
+		Name           string
+		Message        string
+		ExpectedStdout string
+		ExpectedStderr string
+		ExpectedExit   int
+		ExpectErr      bool
+	}{
+		{
+			Name:           "success",
+			Message:        "Enable succeeded: \n[stdout]\nhello\nkops-exit-code=0\n\n[stderr]\nwarning\n",
+			ExpectedStdout: "hello\n",
+			ExpectedStderr: "warning\n",
+		},
+		{
+			Name:           "non-zero exit",
+			Message:        "Enable succeeded: \n[stdout]\nkops-exit-code=2\n\n[stderr]\nnot found\n",
+			ExpectedStderr: "not found\n",
+			ExpectedExit:   2,
+			ExpectErr:      true,
+		},
+		{
+			Name:           "truncated",
+			Message:        "Enable succeeded: \n[stdout]\nlots of output\n\n[stderr]\n",
+			ExpectedStdout: "lots of output\n",
+			ExpectErr:      true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			fake := &fakeVMScaleSetVMs{message: g.Message}
+			transport := NewAzureRunCommandTransport(fake, "rg")
+
+			var stdout, stderr bytes.Buffer
+			err := transport.Exec(context.Background(), "nodes.minimal.example.com_3", "echo hello", &stdout, &stderr)
+			if g.ExpectErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				var exitErr *ExitError
+				if errors.As(err, &exitErr) != (g.ExpectedExit != 0) {
+					t.Fatalf("unexpected error type: %v", err)
+				}
+				if exitErr != nil && exitErr.ExitCode != g.ExpectedExit {
+					t.Errorf("expected exit code %d, got %d", g.ExpectedExit, exitErr.ExitCode)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if fake.vmssName != "nodes.minimal.example.com" || fake.instanceID != "3" {
+				t.Errorf("unexpected VM %q/%q", fake.vmssName, fake.instanceID)
+			}
+			if stdout.String() != g.ExpectedStdout {
+				t.Errorf("expected stdout %q, got %q", g.ExpectedStdout, stdout.String())
+			}
+			if stderr.String() != g.ExpectedStderr {
+				t.Errorf("expected stderr %q, got %q", g.ExpectedStderr, stderr.String())
+			}
+		})
+	}
+}
+
+func TestAzureRunCommandTransportInvalidInstanceID(t *testing.T) {
+	transport := NewAzureRunCommandTransport(&fakeVMScaleSetVMs{}, "rg")
+	var stdout, stderr bytes.Buffer
+	for _, id := range []string{"nodes", "_3", "nodes_"} {
+		if err := transport.Exec(context.Background(), id, "true", &stdout, &stderr); err == nil {
+			t.Errorf("expected error for instance ID %q", id)
+		}
+	}
+}
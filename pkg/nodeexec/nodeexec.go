@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodeexec runs commands on cluster instances through the cloud provider's
+// remote command APIs, so that instances can be reached without SSH.
+package nodeexec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// Transport runs commands on cloud instances.
+type Transport interface {
+	// Exec runs the shell command on the instance, copying its output to stdout and stderr.
+	// A command that runs but exits with a non-zero status returns an *ExitError.
+	Exec(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error
+}
+
+// ExitError is returned when a command ran but exited with a non-zero status.
+type ExitError struct {
+	ExitCode int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("command exited with status %d", e.ExitCode)
+}
+
+// Options holds the settings used when building a Transport.
+type Options struct {
+	// Signer is the SSH key used by transports that still connect over SSH (GCP OS Login).
+	Signer ssh.Signer
+}
+
+// NewTransport builds the Transport for the cloud provider of the cluster:
+// SSM Run Command on AWS, Run Command on Azure and OS Login on GCP.
+func NewTransport(cloud fi.Cloud, cluster *kops.Cluster, options Options) (Transport, error) {
+	switch c := cloud.(type) {
+	case awsup.AWSCloud:
+		return NewSSMTransport(c.SSM()), nil
+	case azure.AzureCloud:
+		return NewAzureRunCommandTransport(c.VMScaleSetVM(), cluster.AzureResourceGroupName()), nil
+	case gce.GCECloud:
+		if options.Signer == nil {
+			return nil, fmt.Errorf("an SSH key is required to connect using OS Login")
+		}
+		return NewOSLoginTransport(c, options.Signer), nil
+	default:
+		return nil, fmt.Errorf("running commands without SSH is not supported on %s", cloud.ProviderID())
+	}
+}
+
+// InstanceIDFromProviderID returns the cloud instance ID, in the form used by kops get instances,
+// for a Kubernetes node provider ID.
+func InstanceIDFromProviderID(providerID string) (string, error) {
+	scheme, path, ok := strings.Cut(providerID, "://")
+	if !ok {
+		return "", fmt.Errorf("invalid provider ID %q", providerID)
+	}
+	path = strings.TrimPrefix(path, "/")
+	tokens := strings.Split(path, "/")
+
+	switch scheme {
+	case "aws":
+		// aws:///us-east-1a/i-0123456789abcdef0
+		return tokens[len(tokens)-1], nil
+	case "azure":
+		// azure:///subscriptions/<sub>/resourceGroups/<rg>/providers/Microsoft.Compute/virtualMachineScaleSets/<vmss>/virtualMachines/<id>
+		if len(tokens) >= 4 && strings.EqualFold(tokens[len(tokens)-2], "virtualMachines") && strings.EqualFold(tokens[len(tokens)-4], "virtualMachineScaleSets") {
+			return tokens[len(tokens)-3] + "_" + tokens[len(tokens)-1], nil
+		}
+	case "gce":
+		// gce://<project>/<zone>/<name>
+		if len(tokens) == 3 {
+			return tokens[1] + "/" + tokens[2], nil
+		}
+	}
+	return "", fmt.Errorf("unsupported provider ID %q", providerID)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeexec
+
+import (
+	"testing"
+)
+
+func TestInstanceIDFromProviderID(t *testing.T) {
+	grid := []struct {
+		ProviderID string
+		Expected   string
+		ExpectErr  bool
+	}{
+		{
+			ProviderID: "aws:///us-test-1a/i-0123456789abcdef0",
+			Expected:   "i-0123456789abcdef0",
+		},
+		{
+			ProviderID: "azure:///subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachineScaleSets/nodes.minimal.example.com/virtualMachines/3",
+			Expected:   "nodes.minimal.example.com_3",
+		},
+		{
+			ProviderID: "gce://my-project/us-test1-a/nodes-abcd",
+			Expected:   "us-test1-a/nodes-abcd",
+		},
+		{
+			ProviderID: "azure:///subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm",
+			ExpectErr:  true,
+		},
+		{
+			ProviderID: "hcloud://1234",
+			ExpectErr:  true,
+		},
+		{
+			ProviderID: "i-0123456789abcdef0",
+			ExpectErr:  true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.ProviderID, func(t *testing.T) {
+			actual, err := InstanceIDFromProviderID(g.ProviderID)
+			if g.ExpectErr {
+				if err == nil {
+					t.Fatalf("expected error, got %q", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != g.Expected {
+				t.Errorf("expected %q, got %q", g.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseOSLoginProfile(t *testing.T) {
+	data := []byte(`{"loginProfile":{"name":"user@example.com","posixAccounts":[{"username":"ext_user","primary":false},{"username":"user_example_com","primary":true}]}}`)
+	username, err := parseOSLoginProfile(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if username != "user_example_com" {
+		t.Errorf("expected primary username, got %q", username)
+	}
+
+	if _, err := parseOSLoginProfile([]byte(`{"loginProfile":{}}`)); err == nil {
+		t.Errorf("expected error for profile without POSIX accounts")
+	}
+}
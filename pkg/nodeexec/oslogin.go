@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeexec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/oauth2/google"
+	compute "google.golang.org/api/compute/v1"
+	oauth2 "google.golang.org/api/oauth2/v2"
+	"google.golang.org/api/option"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+const (
+	osLoginEndpoint = "https://oslogin.googleapis.com/v1/"
+	// osLoginKeyTTL is how long the imported SSH public key remains valid in the OS Login profile.
+	osLoginKeyTTL = time.Hour
+)
+
+// osLoginTransport runs commands over SSH, authenticating with OS Login.
+// The SSH public key is imported into the OS Login profile of the active Google credential,
+// so no key needs to be configured on the instances.
+type osLoginTransport struct {
+	cloud  gce.GCECloud
+	signer ssh.Signer
+
+	mutex    sync.Mutex
+	username string
+}
+
+var _ Transport = &osLoginTransport{}
+
+// NewOSLoginTransport returns a Transport that connects over SSH using OS Login.
+// OS Login must be enabled for the instances, for example with the enable-oslogin project metadata.
+func NewOSLoginTransport(cloud gce.GCECloud, signer ssh.Signer) Transport {
+	return &osLoginTransport{
+		cloud:  cloud,
+		signer: signer,
+	}
+}
+
+// Exec implements Transport::Exec
+func (t *osLoginTransport) Exec(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error {
+	username, err := t.getUsername(ctx)
+	if err != nil {
+		return err
+	}
+
+	instance, err := t.findInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	address := instanceAddress(instance)
+	if address == "" {
+		return fmt.Errorf("no address found for instance %q", instanceID)
+	}
+
+	sshConfig := &ssh.ClientConfig{
+		User:            username,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(t.signer)},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         10 * time.Second,
+	}
+	client, err := ssh.Dial("tcp", net.JoinHostPort(address, "22"), sshConfig)
+	if err != nil {
+		return fmt.Errorf("connecting to %s as %q: %w", instanceID, username, err)
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return fmt.Errorf("creating ssh session: %w", err)
+	}
+	defer session.Close()
+	session.Stdout = stdout
+	session.Stderr = stderr
+
+	klog.V(2).Infof("running OS Login command on %s: %v", instanceID, command)
+
+	finished := make(chan error, 1)
+	go func() {
+		finished <- session.Run(command)
+	}()
+
+	select {
+	case <-ctx.Done():
+		client.Close()
+		<-finished
+		return ctx.Err()
+	case err := <-finished:
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) {
+			return &ExitError{ExitCode: exitErr.ExitStatus()}
+		}
+		return err
+	}
+}
+
+// getUsername imports the SSH public key into the OS Login profile of the active credential,
+// and returns the POSIX username for the profile.
+func (t *osLoginTransport) getUsername(ctx context.Context) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.username != "" {
+		return t.username, nil
+	}
+
+	httpClient, err := google.DefaultClient(ctx, compute.CloudPlatformScope, oauth2.UserinfoEmailScope)
+	if err != nil {
+		return "", fmt.Errorf("building google http client: %w", err)
+	}
+
+	oauth2Service, err := oauth2.NewService(ctx, option.WithHTTPClient(httpClient))
+	if err != nil {
+		return "", fmt.Errorf("creating oauth2 service: %w", err)
+	}
+	userInfo, err := oauth2Service.Userinfo.Get().Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("fetching email for the active google credential: %w", err)
+	}
+	if userInfo.Email == "" {
+		return "", fmt.Errorf("active google credential has no email")
+	}
+
+	request := map[string]string{
+		"key":                strings.TrimSpace(string(ssh.MarshalAuthorizedKey(t.signer.PublicKey()))),
+		"expirationTimeUsec": fmt.Sprintf("%d", time.Now().Add(osLoginKeyTTL).UnixMicro()),
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	u := osLoginEndpoint + "users/" + url.PathEscape(userInfo.Email) + ":importSshPublicKey?projectId=" + url.QueryEscape(t.cloud.Project())
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	response, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return "", fmt.Errorf("importing SSH public key into OS Login profile: %w", err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading OS Login response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("importing SSH public key into OS Login profile: %s: %s", response.Status, string(responseBody))
+	}
+
+	username, err := parseOSLoginProfile(responseBody)
+	if err != nil {
+		return "", err
+	}
+	klog.V(2).Infof("using OS Login user %q for %q", username, userInfo.Email)
+	t.username = username
+	return username, nil
+}
+
+// parseOSLoginProfile returns the primary POSIX username from an importSshPublicKey response.
+func parseOSLoginProfile(data []byte) (string, error) {
+	var response struct {
+		LoginProfile struct {
+			PosixAccounts []struct {
+				Primary  bool   `json:"primary"`
+				Username string `json:"username"`
+			} `json:"posixAccounts"`
+		} `json:"loginProfile"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return "", fmt.Errorf("parsing OS Login profile: %w", err)
+	}
+
+	username := ""
+	for _, account := range response.LoginProfile.PosixAccounts {
+		if account.Primary || username == "" {
+			username = account.Username
+		}
+	}
+	if username == "" {
+		return "", fmt.Errorf("OS Login profile has no POSIX account")
+	}
+	return username, nil
+}
+
+// findInstance looks up an instance by self link, by <zone>/<name>, or by name in the zones of the region.
+func (t *osLoginTransport) findInstance(ctx context.Context, instanceID string) (*compute.Instance, error) {
+	project := t.cloud.Project()
+
+	if strings.Contains(instanceID, "://") {
+		u, err := gce.ParseGoogleCloudURL(instanceID)
+		if err != nil {
+			return nil, err
+		}
+		return t.cloud.Compute().Instances().Get(u.Project, u.Zone, u.Name)
+	}
+
+	if zone, name, ok := strings.Cut(instanceID, "/"); ok {
+		return t.cloud.Compute().Instances().Get(project, zone, name)
+	}
+
+	zones, err := t.cloud.Zones()
+	if err != nil {
+		return nil, err
+	}
+	for _, zone := range zones {
+		instances, err := t.cloud.Compute().Instances().List(ctx, project, zone)
+		if err != nil {
+			return nil, fmt.Errorf("listing instances in zone %q: %w", zone, err)
+		}
+		for _, instance := range instances {
+			if instance.Name == instanceID {
+				return instance, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("instance %q not found", instanceID)
+}
+
+// instanceAddress returns the public address of the instance, or the private address if it has none.
+func instanceAddress(instance *compute.Instance) string {
+	var private string
+	for _, ni := range instance.NetworkInterfaces {
+		for _, ac := range ni.AccessConfigs {
+			if ac.NatIP != "" {
+				return ac.NatIP
+			}
+		}
+		if private == "" {
+			private = ni.NetworkIP
+		}
+	}
+	return private
+}
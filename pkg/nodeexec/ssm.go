@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+const (
+	// ssmDocumentName is the SSM document used to run shell commands on Linux instances.
+	ssmDocumentName = "AWS-RunShellScript"
+	// ssmExecutionTimeout is the maximum time a command may run on the instance.
+	ssmExecutionTimeout = 10 * time.Minute
+)
+
+// ssmTransport runs commands using AWS Systems Manager Run Command.
+type ssmTransport struct {
+	ssm          awsinterfaces.SSMAPI
+	pollInterval time.Duration
+}
+
+var _ Transport = &ssmTransport{}
+
+// NewSSMTransport returns a Transport that runs commands using SSM Run Command.
+// Instances must run the SSM agent and have an instance profile allowing it to register.
+func NewSSMTransport(ssmClient awsinterfaces.SSMAPI) Transport {
+	return &ssmTransport{
+		ssm:          ssmClient,
+		pollInterval: 2 * time.Second,
+	}
+}
+
+// Exec implements Transport::Exec
+func (t *ssmTransport) Exec(ctx context.Context, instanceID string, command string, stdout io.Writer, stderr io.Writer) error {
+	klog.V(2).Infof("running SSM command on %s: %v", instanceID, command)
+
+	response, err := t.ssm.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: aws.String(ssmDocumentName),
+		InstanceIds:  []string{instanceID},
+		Parameters: map[string][]string{
+			"commands":         {command},
+			"executionTimeout": {fmt.Sprintf("%d", int(ssmExecutionTimeout.Seconds()))},
+		},
+		Comment: aws.String("kops"),
+	})
+	if err != nil {
+		return fmt.Errorf("sending SSM command to %s: %w", instanceID, err)
+	}
+	commandID := aws.ToString(response.Command.CommandId)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(t.pollInterval):
+		}
+
+		invocation, err := t.ssm.GetCommandInvocation(ctx, &ssm.GetCommandInvocationInput{
+			CommandId:  aws.String(commandID),
+			InstanceId: aws.String(instanceID),
+		})
+		if err != nil {
+			var notExist *ssmtypes.InvocationDoesNotExist
+			if errors.As(err, &notExist) {
+				// The invocation is created asynchronously after SendCommand returns
+				continue
+			}
+			return fmt.Errorf("getting SSM command %s on %s: %w", commandID, instanceID, err)
+		}
+
+		switch invocation.Status {
+		case ssmtypes.CommandInvocationStatusPending, ssmtypes.CommandInvocationStatusInProgress, ssmtypes.CommandInvocationStatusDelayed:
+			continue
+		}
+
+		if _, err := io.WriteString(stdout, aws.ToString(invocation.StandardOutputContent)); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(stderr, aws.ToString(invocation.StandardErrorContent)); err != nil {
+			return err
+		}
+
+		switch invocation.Status {
+		case ssmtypes.CommandInvocationStatusSuccess:
+			return nil
+		case ssmtypes.CommandInvocationStatusFailed:
+			if invocation.ResponseCode > 0 {
+				return &ExitError{ExitCode: int(invocation.ResponseCode)}
+			}
+		}
+		return fmt.Errorf("SSM command %s on %s finished with status %s: %s", commandID, instanceID, invocation.Status, aws.ToString(invocation.StatusDetails))
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeexec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+type fakeSSM struct {
+	sent        *ssm.SendCommandInput
+	invocations []*ssm.GetCommandInvocationOutput
+	calls       int
+}
+
+func (f *fakeSSM) GetParameter(ctx context.Context, input *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeSSM) SendCommand(ctx context.Context, input *ssm.SendCommandInput, optFns ...func(*ssm.Options)) (*ssm.SendCommandOutput, error) {
+	f.sent = input
+	return &ssm.SendCommandOutput{
+		Command: &ssmtypes.Command{CommandId: aws.String("cmd-1")},
+	}, nil
+}
+
+func (f *fakeSSM) GetCommandInvocation(ctx context.Context, input *ssm.GetCommandInvocationInput, optFns ...func(*ssm.Options)) (*ssm.GetCommandInvocationOutput, error) {
+	f.calls++
+	if f.calls == 1 {
+		return nil, &ssmtypes.InvocationDoesNotExist{}
+	}
+	invocation := f.invocations[0]
+	if len(f.invocations) > 1 {
+		f.invocations = f.invocations[1:]
+	}
+	return invocation, nil
+}
+
+func TestSSMTransport(t *testing.T) {
+	grid := []struct {
+		Name           string
+		Final          *ssm.GetCommandInvocationOutput
+		ExpectedStdout string
+		ExpectedExit   int
+		ExpectErr      bool
+	}{
+		{
+			Name: "success",
+			Final: &ssm.GetCommandInvocationOutput{
+				Status:                ssmtypes.CommandInvocationStatusSuccess,
+				StandardOutputContent: aws.String("hello\n"),
+			},
+			ExpectedStdout: "hello\n",
+		},
+		{
+			Name: "non-zero exit",
+			Final: &ssm.GetCommandInvocationOutput{
+				Status:                ssmtypes.CommandInvocationStatusFailed,
+				ResponseCode:          3,
+				StandardOutputContent: aws.String("partial\n"),
+			},
+			ExpectedStdout: "partial\n",
+			ExpectedExit:   3,
+			ExpectErr:      true,
+		},
+		{
+			Name: "undeliverable",
+			Final: &ssm.GetCommandInvocationOutput{
+				Status:        ssmtypes.CommandInvocationStatusFailed,
+				ResponseCode:  -1,
+				StatusDetails: aws.String("Undeliverable"),
+			},
+			ExpectErr: true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			fake := &fakeSSM{
+				invocations: []*ssm.GetCommandInvocationOutput{
+					{Status: ssmtypes.CommandInvocationStatusInProgress},
+					g.Final,
+				},
+			}
+			transport := &ssmTransport{ssm: fake}
+
+			var stdout, stderr bytes.Buffer
+			err := transport.Exec(context.Background(), "i-0123456789abcdef0", "echo hello", &stdout, &stderr)
+			if g.ExpectErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				var exitErr *ExitError
+				if errors.As(err, &exitErr) != (g.ExpectedExit != 0) {
+					t.Fatalf("unexpected error type: %v", err)
+				}
+				if exitErr != nil && exitErr.ExitCode != g.ExpectedExit {
+					t.Errorf("expected exit code %d, got %d", g.ExpectedExit, exitErr.ExitCode)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if stdout.String() != g.ExpectedStdout {
+				t.Errorf("expected stdout %q, got %q", g.ExpectedStdout, stdout.String())
+			}
+			if got := fake.sent.Parameters["commands"]; len(got) != 1 || got[0] != "echo hello" {
+				t.Errorf("unexpected commands parameter %v", got)
+			}
+			if got := fake.sent.InstanceIds; len(got) != 1 || got[0] != "i-0123456789abcdef0" {
+				t.Errorf("unexpected instance IDs %v", got)
+			}
+		})
+	}
+}
@@ -20,7 +20,7 @@ import (
 	"fmt"
 
 	api "k8s.io/kops/pkg/apis/kops"
-	"k8s.io/kops/pkg/featureflag"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	"k8s.io/kops/util/pkg/reflectutils"
 )
 
@@ -72,7 +72,8 @@ func BuildNodeLabels(cluster *api.Cluster, instanceGroup *api.InstanceGroup) (ma
 		// We keep the featureflag as a placeholder to change the logic;
 		// when we drop the featureflag we should just always include the label, even for
 		// full control-plane nodes.
-		if isAPIServer || featureflag.APIServerNodes.Enabled() {
+		// The other instance groups are not known here; the label is harmless when there are no APIServer nodes.
+		if isAPIServer || kopsmodel.CanUseAPIServerNodes(cluster) {
 			nodeLabels[RoleLabelAPIServer16] = ""
 		}
 	}
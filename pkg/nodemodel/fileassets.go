@@ -48,6 +48,10 @@ func BuildKubernetesFileAssets(ig model.InstanceGroup, assetBuilder *assets.Asse
 		baseURL = "https://dl.k8s.io/release/v" + kubernetesVersion.String()
 	}
 
+	if ig.IsWindows() {
+		return buildWindowsFileAssets(ig, baseURL, assetBuilder)
+	}
+
 	kubernetesAssets := make(map[architectures.Architecture][]*assets.MirroredAsset)
 	for _, arch := range architectures.GetSupported() {
 		kubernetesAssets[arch] = []*assets.MirroredAsset{}
@@ -170,6 +174,42 @@ func BuildKubernetesFileAssets(ig model.InstanceGroup, assetBuilder *assets.Asse
 	}, nil
 }
 
+// buildWindowsFileAssets returns the assets installed by the bootstrap script of Windows instance groups,
+// which only run on amd64: the kubelet, kube-proxy and containerd.
+func buildWindowsFileAssets(ig model.InstanceGroup, baseURL string, assetBuilder *assets.AssetBuilder) (*KubernetesFileAssets, error) {
+	arch := architectures.ArchitectureAmd64
+	kubernetesAssets := map[architectures.Architecture][]*assets.MirroredAsset{
+		arch: {},
+	}
+
+	for _, an := range []string{
+		fmt.Sprintf("/bin/windows/%s/kubelet.exe", arch),
+		fmt.Sprintf("/bin/windows/%s/kube-proxy.exe", arch),
+	} {
+		k, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, err
+		}
+		k.Path = path.Join(k.Path, an)
+
+		asset, err := assetBuilder.RemapFile(k, nil)
+		if err != nil {
+			return nil, err
+		}
+		kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(asset))
+	}
+
+	containerdAsset, err := wellknownassets.FindContainerdWindowsAsset(ig, assetBuilder)
+	if err != nil {
+		return nil, err
+	}
+	kubernetesAssets[arch] = append(kubernetesAssets[arch], assets.BuildMirroredAsset(containerdAsset))
+
+	return &KubernetesFileAssets{
+		KubernetesFileAssets: kubernetesAssets,
+	}, nil
+}
+
 // NodeUpAssets are the assets for downloading nodeup
 type NodeUpAssets struct {
 	// NodeUpAssets are the assets for downloading nodeup
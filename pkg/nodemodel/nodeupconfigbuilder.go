@@ -63,7 +63,10 @@ func NewNodeUpConfigBuilder(cluster *kops.Cluster, assetBuilder *assets.AssetBui
 	}
 
 	for i := range cluster.Spec.Addons {
-		channels = append(channels, cluster.Spec.Addons[i].Manifest)
+		// Charts are applied as part of the bootstrap channel
+		if cluster.Spec.Addons[i].Manifest != "" {
+			channels = append(channels, cluster.Spec.Addons[i].Manifest)
+		}
 	}
 
 	etcdManifests := map[string][]string{}
@@ -247,6 +250,13 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 		}
 	}
 
+	if keysets["ssh-ca"] != nil {
+		config.SSHCertificateAuthorities, err = keysets["ssh-ca"].ToSSHAuthorizedKeys()
+		if err != nil {
+			return nil, nil, fmt.Errorf("encoding ssh-ca keys: %w", err)
+		}
+	}
+
 	if role != kops.InstanceGroupRoleBastion {
 		if err := loadCertificates(keysets, fi.CertificateIDCA, config, true); err != nil {
 			return nil, nil, err
@@ -349,18 +359,25 @@ func (n *nodeUpConfigBuilder) BuildConfig(ig *kops.InstanceGroup, wellKnownAddre
 		}
 
 	case kops.CloudProviderGCE:
-		// Use the IP address of the internal load balancer (forwarding-rule)
+		// Use the IP address of the internal load balancer (forwarding-rule), or any IPv6 addresses
 		// Note that on GCE subnets have IP ranges, networks do not
 		for _, apiserverIP := range wellKnownAddresses[wellknownservices.KubeAPIServer] {
+			ip, err := netip.ParseAddr(apiserverIP)
+			if err != nil {
+				continue
+			}
+			if ip.Is6() {
+				controlPlaneIPs = append(controlPlaneIPs, apiserverIP)
+				continue
+			}
 			for _, subnet := range cluster.Spec.Networking.Subnets {
+				if subnet.CIDR == "" {
+					continue
+				}
 				cidr, err := netip.ParsePrefix(subnet.CIDR)
 				if err != nil {
 					return nil, nil, fmt.Errorf("failed to parse subnet CIDR %q: %w", subnet.CIDR, err)
 				}
-				ip, err := netip.ParseAddr(apiserverIP)
-				if err != nil {
-					continue
-				}
 				if cidr.Contains(ip) {
 					controlPlaneIPs = append(controlPlaneIPs, apiserverIP)
 				}
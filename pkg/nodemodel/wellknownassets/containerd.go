@@ -35,6 +35,8 @@ const (
 	containerdReleaseUrlArm64 = "https://github.com/containerd/containerd/releases/download/v%s/containerd-%s-linux-arm64.tar.gz"
 	// containerd packages URLs for v1.4.x+
 	containerdBundleUrlAmd64 = "https://github.com/containerd/containerd/releases/download/v%s/cri-containerd-cni-%s-linux-amd64.tar.gz"
+	// containerd packages URL for Windows, for v1.6.x+
+	containerdReleaseUrlWindowsAmd64 = "https://github.com/containerd/containerd/releases/download/v%s/containerd-%s-windows-amd64.tar.gz"
 )
 
 func FindContainerdAsset(ig model.InstanceGroup, assetBuilder *assets.AssetBuilder, arch architectures.Architecture) (*assets.FileAsset, error) {
@@ -73,6 +75,29 @@ func FindContainerdAsset(ig model.InstanceGroup, assetBuilder *assets.AssetBuild
 	return buildFileAsset(assetBuilder, canonicalURL, knownHash)
 }
 
+// FindContainerdWindowsAsset returns the containerd release for Windows instance groups.
+// The packages of the containerd spec are Linux packages, so only the version is used.
+func FindContainerdWindowsAsset(ig model.InstanceGroup, assetBuilder *assets.AssetBuilder) (*assets.FileAsset, error) {
+	containerd := ig.RawClusterSpec().Containerd
+	if containerd == nil {
+		return nil, fmt.Errorf("unable to find containerd config")
+	}
+
+	version := fi.ValueOf(containerd.Version)
+	if version == "" {
+		return nil, fmt.Errorf("unable to find containerd version")
+	}
+	sv, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse version string: %q", version)
+	}
+	if sv.LT(semver.MustParse("1.6.0")) {
+		return nil, fmt.Errorf("unsupported containerd version for Windows: %q", version)
+	}
+
+	return buildFileAsset(assetBuilder, fmt.Sprintf(containerdReleaseUrlWindowsAmd64, version, version), "")
+}
+
 func findContainerdVersionUrl(arch architectures.Architecture, version string) (*url.URL, error) {
 	sv, err := semver.ParseTolerant(version)
 	if err != nil {
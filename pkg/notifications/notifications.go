@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notifications posts events of the kops operations on a cluster to the external sinks
+// configured in its spec, such as Slack, SNS, EventBridge or a webhook.
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/user"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+)
+
+// sendTimeout bounds how long posting an event to a sink may take, so that an unreachable sink
+// does not hold up the operation
+const sendTimeout = 10 * time.Second
+
+// Status is the outcome of the operation an event is about
+type Status string
+
+const (
+	StatusSucceeded Status = "Succeeded"
+	StatusFailed    Status = "Failed"
+)
+
+// Event is the structured payload posted to the sinks.
+type Event struct {
+	// Type is the kind of the operation, such as Apply.
+	Type kopsapi.NotificationEventType `json:"type"`
+	// Status is the outcome of the operation.
+	Status Status `json:"status"`
+	// Cluster is the name of the cluster.
+	Cluster string `json:"cluster"`
+	// Time is when the operation completed.
+	Time time.Time `json:"time"`
+	// Message is a human-readable summary of the operation.
+	Message string `json:"message"`
+	// Details are additional attributes of the operation, such as the instance groups involved.
+	Details map[string]string `json:"details,omitempty"`
+	// User is the local user who ran the operation.
+	User string `json:"user,omitempty"`
+	// KopsVersion is the version of kops which ran the operation.
+	KopsVersion string `json:"kopsVersion"`
+}
+
+// NewEvent returns an event about an operation on a cluster, which failed if err is not nil.
+func NewEvent(cluster *kopsapi.Cluster, eventType kopsapi.NotificationEventType, message string, err error) *Event {
+	event := &Event{
+		Type:        eventType,
+		Status:      StatusSucceeded,
+		Cluster:     cluster.ObjectMeta.Name,
+		Time:        time.Now().UTC(),
+		Message:     message,
+		KopsVersion: kops.Version,
+	}
+	if err != nil {
+		event.Status = StatusFailed
+		event.Message = fmt.Sprintf("%s: %v", message, err)
+	}
+	if u, err := user.Current(); err == nil {
+		event.User = u.Username
+	}
+	return event
+}
+
+// Sink is a destination events are posted to.
+type Sink interface {
+	Send(ctx context.Context, event *Event) error
+}
+
+// NewSink builds the sink of a spec.
+func NewSink(ctx context.Context, spec *kopsapi.NotificationSinkSpec) (Sink, error) {
+	httpClient := &http.Client{Timeout: sendTimeout}
+	switch spec.Type {
+	case kopsapi.NotificationSinkTypeSlack:
+		return &slackSink{url: spec.URL, httpClient: httpClient}, nil
+	case kopsapi.NotificationSinkTypeWebhook:
+		return &webhookSink{url: spec.URL, httpClient: httpClient}, nil
+	case kopsapi.NotificationSinkTypeSNS:
+		return newSNSSink(ctx, spec.TopicARN, httpClient)
+	case kopsapi.NotificationSinkTypeEventBridge:
+		return newEventBridgeSink(ctx, spec.EventBusARN)
+	default:
+		return nil, fmt.Errorf("unknown notification sink type %q", spec.Type)
+	}
+}
+
+// Notify posts an event to the sinks of the cluster which subscribe to its type.
+// Failing to post an event is logged, and never fails the operation.
+func Notify(ctx context.Context, cluster *kopsapi.Cluster, event *Event) {
+	if cluster.Spec.Notifications == nil {
+		return
+	}
+	for i := range cluster.Spec.Notifications.Sinks {
+		spec := &cluster.Spec.Notifications.Sinks[i]
+		if !spec.Subscribes(event.Type) {
+			continue
+		}
+		if err := send(ctx, spec, event); err != nil {
+			klog.Warningf("error posting %s event to notification sink %q: %v", event.Type, spec.Name, err)
+		}
+	}
+}
+
+func send(ctx context.Context, spec *kopsapi.NotificationSinkSpec, event *Event) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	sink, err := NewSink(ctx, spec)
+	if err != nil {
+		return err
+	}
+	return sink.Send(ctx, event)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/testutils"
+)
+
+// recorder is an HTTP server recording the requests it receives
+type recorder struct {
+	mutex    sync.Mutex
+	requests []*http.Request
+	bodies   []string
+	status   int
+}
+
+func newRecorder(t *testing.T, status int) (*recorder, *httptest.Server) {
+	r := &recorder{status: status}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mutex.Lock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, string(body))
+		r.mutex.Unlock()
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(server.Close)
+	return r, server
+}
+
+func buildEvent() *Event {
+	return &Event{
+		Type:        kopsapi.NotificationEventTypeRollingUpdate,
+		Status:      StatusFailed,
+		Cluster:     "test.k8s.io",
+		Time:        time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC),
+		Message:     "Rolling update failed: timed out",
+		Details:     map[string]string{"instanceGroups": "nodes"},
+		User:        "alice",
+		KopsVersion: "1.33.0",
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+
+	event := NewEvent(cluster, kopsapi.NotificationEventTypeApply, "Applied changes to the cloud", nil)
+	if event.Status != StatusSucceeded || event.Message != "Applied changes to the cloud" || event.Cluster != "test.k8s.io" {
+		t.Errorf("unexpected event %+v", event)
+	}
+
+	event = NewEvent(cluster, kopsapi.NotificationEventTypeApply, "Applying changes to the cloud", errors.New("access denied"))
+	if event.Status != StatusFailed || event.Message != "Applying changes to the cloud: access denied" {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	r, server := newRecorder(t, http.StatusOK)
+	sink := &webhookSink{url: server.URL, httpClient: server.Client()}
+
+	if err := sink.Send(context.Background(), buildEvent()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.bodies) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(r.bodies))
+	}
+	if contentType := r.requests[0].Header.Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("unexpected content type %q", contentType)
+	}
+	var posted Event
+	if err := json.Unmarshal([]byte(r.bodies[0]), &posted); err != nil {
+		t.Fatalf("error parsing posted event: %v", err)
+	}
+	if posted.Type != kopsapi.NotificationEventTypeRollingUpdate || posted.Status != StatusFailed || posted.Details["instanceGroups"] != "nodes" {
+		t.Errorf("unexpected posted event %+v", posted)
+	}
+}
+
+func TestWebhookSinkError(t *testing.T) {
+	_, server := newRecorder(t, http.StatusForbidden)
+	sink := &webhookSink{url: server.URL, httpClient: server.Client()}
+
+	err := sink.Send(context.Background(), buildEvent())
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Errorf("expected a 403 error, got %v", err)
+	}
+}
+
+func TestSlackSink(t *testing.T) {
+	r, server := newRecorder(t, http.StatusOK)
+	sink := &slackSink{url: server.URL, httpClient: server.Client()}
+
+	if err := sink.Send(context.Background(), buildEvent()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var posted map[string]string
+	if err := json.Unmarshal([]byte(r.bodies[0]), &posted); err != nil {
+		t.Fatalf("error parsing posted message: %v", err)
+	}
+	expected := ":x: *RollingUpdate* of cluster `test.k8s.io` failed (by alice)\nRolling update failed: timed out\n• instanceGroups: nodes"
+	if posted["text"] != expected {
+		t.Errorf("unexpected message\nexpected: %q\nactual:   %q", expected, posted["text"])
+	}
+}
+
+func TestSNSSink(t *testing.T) {
+	r, server := newRecorder(t, http.StatusOK)
+	sink := &snsSink{
+		topicARN:    "arn:aws:sns:us-test-1:123456789012:kops-events",
+		region:      "us-test-1",
+		endpoint:    server.URL,
+		credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		httpClient:  server.Client(),
+	}
+
+	if err := sink.Send(context.Background(), buildEvent()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authorization := r.requests[0].Header.Get("Authorization")
+	if !strings.Contains(authorization, "Credential=AKID/") || !strings.Contains(authorization, "/us-test-1/sns/aws4_request") {
+		t.Errorf("unexpected authorization %q", authorization)
+	}
+	form, err := url.ParseQuery(r.bodies[0])
+	if err != nil {
+		t.Fatalf("error parsing request: %v", err)
+	}
+	if form.Get("Action") != "Publish" || form.Get("TopicArn") != sink.topicARN {
+		t.Errorf("unexpected request %v", form)
+	}
+	if subject := form.Get("Subject"); subject != "kOps RollingUpdate of test.k8s.io failed" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+	var posted Event
+	if err := json.Unmarshal([]byte(form.Get("Message")), &posted); err != nil {
+		t.Fatalf("error parsing published event: %v", err)
+	}
+	if posted.Cluster != "test.k8s.io" {
+		t.Errorf("unexpected published event %+v", posted)
+	}
+}
+
+type fakeEventBridge struct {
+	input *eventbridge.PutEventsInput
+}
+
+func (f *fakeEventBridge) PutEvents(ctx context.Context, params *eventbridge.PutEventsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutEventsOutput, error) {
+	f.input = params
+	return &eventbridge.PutEventsOutput{}, nil
+}
+
+func TestEventBridgeSink(t *testing.T) {
+	client := &fakeEventBridge{}
+	sink := &eventBridgeSink{eventBusARN: "arn:aws:events:us-test-1:123456789012:event-bus/default", client: client}
+
+	if err := sink.Send(context.Background(), buildEvent()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(client.input.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(client.input.Entries))
+	}
+	entry := client.input.Entries[0]
+	if aws.ToString(entry.Source) != "kops" || aws.ToString(entry.DetailType) != "kOps RollingUpdate" || aws.ToString(entry.EventBusName) != sink.eventBusARN {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+}
+
+func TestNotify(t *testing.T) {
+	applyRecorder, applyServer := newRecorder(t, http.StatusOK)
+	allRecorder, allServer := newRecorder(t, http.StatusInternalServerError)
+
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+	cluster.Spec.Notifications = &kopsapi.NotificationsSpec{
+		Sinks: []kopsapi.NotificationSinkSpec{
+			{Name: "apply", Type: kopsapi.NotificationSinkTypeWebhook, URL: applyServer.URL, Events: []kopsapi.NotificationEventType{kopsapi.NotificationEventTypeApply}},
+			{Name: "all", Type: kopsapi.NotificationSinkTypeWebhook, URL: allServer.URL},
+		},
+	}
+
+	// A failing sink does not prevent posting to the others
+	Notify(context.Background(), cluster, buildEvent())
+	Notify(context.Background(), cluster, NewEvent(cluster, kopsapi.NotificationEventTypeApply, "Applied changes to the cloud", nil))
+
+	if len(applyRecorder.bodies) != 1 {
+		t.Errorf("expected 1 event posted to the apply sink, got %d", len(applyRecorder.bodies))
+	}
+	if len(allRecorder.bodies) != 2 {
+		t.Errorf("expected 2 events posted to the sink of all events, got %d", len(allRecorder.bodies))
+	}
+}
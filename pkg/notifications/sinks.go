@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"k8s.io/kops"
+	"k8s.io/kops/util/pkg/awspartition"
+)
+
+// postJSON posts a JSON document to a URL, failing unless the response is successful.
+func postJSON(ctx context.Context, httpClient *http.Client, url string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "kops/"+kops.Version)
+	return do(httpClient, req)
+}
+
+func do(httpClient *http.Client, req *http.Request) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// webhookSink posts the JSON events to a URL
+type webhookSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (s *webhookSink) Send(ctx context.Context, event *Event) error {
+	return postJSON(ctx, s.httpClient, s.url, event)
+}
+
+// slackSink posts a message summarizing the events to a Slack incoming webhook
+type slackSink struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (s *slackSink) Send(ctx context.Context, event *Event) error {
+	return postJSON(ctx, s.httpClient, s.url, map[string]string{"text": slackMessage(event)})
+}
+
+// slackMessage formats an event as a Slack message
+func slackMessage(event *Event) string {
+	icon := ":white_check_mark:"
+	if event.Status == StatusFailed {
+		icon = ":x:"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s *%s* of cluster `%s` %s", icon, event.Type, event.Cluster, strings.ToLower(string(event.Status)))
+	if event.User != "" {
+		fmt.Fprintf(&b, " (by %s)", event.User)
+	}
+	fmt.Fprintf(&b, "\n%s", event.Message)
+
+	keys := make([]string, 0, len(event.Details))
+	for k := range event.Details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\n• %s: %s", k, event.Details[k])
+	}
+	return b.String()
+}
+
+// snsSink publishes the JSON events to an SNS topic, with a request signed with the default AWS credentials
+type snsSink struct {
+	topicARN    string
+	region      string
+	endpoint    string
+	credentials aws.CredentialsProvider
+	httpClient  *http.Client
+}
+
+func newSNSSink(ctx context.Context, topicARN string, httpClient *http.Client) (*snsSink, error) {
+	parsed, err := arn.Parse(topicARN)
+	if err != nil {
+		return nil, fmt.Errorf("parsing topic ARN %q: %w", topicARN, err)
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(parsed.Region))
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS config: %w", err)
+	}
+
+	return &snsSink{
+		topicARN:    topicARN,
+		region:      parsed.Region,
+		endpoint:    "https://" + awspartition.ForRegion(parsed.Region).Endpoint("sns", parsed.Region) + "/",
+		credentials: cfg.Credentials,
+		httpClient:  httpClient,
+	}, nil
+}
+
+func (s *snsSink) Send(ctx context.Context, event *Event) error {
+	message, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	form := url.Values{
+		"Action":   {"Publish"},
+		"Version":  {"2010-03-31"},
+		"TopicArn": {s.topicARN},
+		"Subject":  {fmt.Sprintf("kOps %s of %s %s", event.Type, event.Cluster, strings.ToLower(string(event.Status)))},
+		"Message":  {string(message)},
+	}
+	body := form.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	credentials, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+	payloadHash := sha256.Sum256([]byte(body))
+	if err := v4.NewSigner().SignHTTP(ctx, credentials, req, hex.EncodeToString(payloadHash[:]), "sns", s.region, time.Now()); err != nil {
+		return fmt.Errorf("signing SNS request: %w", err)
+	}
+	return do(s.httpClient, req)
+}
+
+// eventBridgeAPI is the part of the EventBridge API used to put events
+type eventBridgeAPI interface {
+	PutEvents(ctx context.Context, params *eventbridge.PutEventsInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutEventsOutput, error)
+}
+
+// eventBridgeSink puts the events on an EventBridge event bus, with the source "kops"
+type eventBridgeSink struct {
+	eventBusARN string
+	client      eventBridgeAPI
+}
+
+func newEventBridgeSink(ctx context.Context, eventBusARN string) (*eventBridgeSink, error) {
+	parsed, err := arn.Parse(eventBusARN)
+	if err != nil {
+		return nil, fmt.Errorf("parsing event bus ARN %q: %w", eventBusARN, err)
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(parsed.Region))
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS config: %w", err)
+	}
+	return &eventBridgeSink{
+		eventBusARN: eventBusARN,
+		client:      eventbridge.NewFromConfig(cfg),
+	}, nil
+}
+
+func (s *eventBridgeSink) Send(ctx context.Context, event *Event) error {
+	detail, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	response, err := s.client.PutEvents(ctx, &eventbridge.PutEventsInput{
+		Entries: []eventbridgetypes.PutEventsRequestEntry{
+			{
+				EventBusName: aws.String(s.eventBusARN),
+				Source:       aws.String("kops"),
+				DetailType:   aws.String("kOps " + string(event.Type)),
+				Detail:       aws.String(string(detail)),
+				Time:         aws.Time(event.Time),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if response.FailedEntryCount > 0 {
+		for _, entry := range response.Entries {
+			if entry.ErrorCode != nil {
+				return fmt.Errorf("event rejected: %s: %s", aws.ToString(entry.ErrorCode), aws.ToString(entry.ErrorMessage))
+			}
+		}
+		return fmt.Errorf("event rejected")
+	}
+	return nil
+}
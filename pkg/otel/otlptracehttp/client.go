@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlptracehttp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// client is an otlptrace.Client that sends spans to an OTLP/HTTP collector using the binary protobuf encoding.
+type client struct {
+	cfg Config
+
+	mutex      sync.RWMutex
+	httpClient *http.Client
+}
+
+var _ otlptrace.Client = (*client)(nil)
+
+// newClient constructs a client.
+func newClient(opts ...Option) *client {
+	var cfg Config
+	for _, option := range opts {
+		option(&cfg)
+	}
+
+	c := &client{
+		cfg: cfg,
+	}
+
+	return c
+}
+
+// Start implements otlptrace.Client.
+func (c *client) Start(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.httpClient != nil {
+		return fmt.Errorf("already started")
+	}
+	if c.cfg.endpoint == "" {
+		return fmt.Errorf("endpoint must be specified")
+	}
+
+	httpClient := c.cfg.httpClient
+	if httpClient == nil {
+		// We deliberately do not use http.DefaultClient, which may be wrapped with otelhttp.
+		httpClient = &http.Client{
+			Transport: http.DefaultTransport,
+			Timeout:   10 * time.Second,
+		}
+	}
+	c.httpClient = httpClient
+
+	return nil
+}
+
+// Stop implements otlptrace.Client.
+func (c *client) Stop(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.httpClient = nil
+
+	return nil
+}
+
+var errShutdown = errors.New("the client is shutdown")
+
+// UploadTraces implements otlptrace.Client.
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	c.mutex.RLock()
+	httpClient := c.httpClient
+	c.mutex.RUnlock()
+
+	if httpClient == nil {
+		return errShutdown
+	}
+
+	body, err := proto.Marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: protoSpans,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling traces: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range c.cfg.headers {
+		req.Header.Set(k, v)
+	}
+
+	response, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending traces to %q: %w", c.cfg.endpoint, err)
+	}
+	defer response.Body.Close()
+
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response sending traces to %q: %s", c.cfg.endpoint, response.Status)
+	}
+
+	return nil
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Client.
+func (c *client) MarshalLog() interface{} {
+	return struct {
+		Type     string
+		Endpoint string
+	}{
+		Type:     "otlptracehttp",
+		Endpoint: c.cfg.endpoint,
+	}
+}
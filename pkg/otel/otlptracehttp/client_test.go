@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlptracehttp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestUploadTraces(t *testing.T) {
+	var got coltracepb.ExportTraceServiceRequest
+	var gotHeaders http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading body: %v", err)
+		}
+		if err := proto.Unmarshal(b, &got); err != nil {
+			t.Errorf("unmarshaling body: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newClient(WithEndpoint(server.URL+"/v1/traces"), WithHeaders(map[string]string{"X-Token": "secret"}))
+	if err := c.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+
+	spans := []*tracepb.ResourceSpans{
+		{
+			ScopeSpans: []*tracepb.ScopeSpans{
+				{Spans: []*tracepb.Span{{Name: "test-span"}}},
+			},
+		},
+	}
+	if err := c.UploadTraces(ctx, spans); err != nil {
+		t.Fatalf("UploadTraces: %v", err)
+	}
+
+	if ct := gotHeaders.Get("Content-Type"); ct != "application/x-protobuf" {
+		t.Errorf("unexpected content type %q", ct)
+	}
+	if token := gotHeaders.Get("X-Token"); token != "secret" {
+		t.Errorf("unexpected X-Token header %q", token)
+	}
+	if len(got.ResourceSpans) != 1 || got.ResourceSpans[0].ScopeSpans[0].Spans[0].Name != "test-span" {
+		t.Errorf("unexpected request %v", &got)
+	}
+
+	if err := c.Stop(ctx); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	if err := c.UploadTraces(ctx, spans); err != errShutdown {
+		t.Errorf("expected errShutdown after Stop, got %v", err)
+	}
+}
+
+func TestUploadTracesErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := newClient(WithEndpoint(server.URL))
+	if err := c.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if err := c.UploadTraces(ctx, nil); err == nil {
+		t.Errorf("expected error for non-2xx response")
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlptracehttp
+
+import (
+	"net/http"
+)
+
+// Option applies an option to the Config.
+type Option func(cfg *Config)
+
+type Config struct {
+	endpoint   string
+	headers    map[string]string
+	httpClient *http.Client
+}
+
+// WithEndpoint sets the full URL that traces are POSTed to, e.g. http://localhost:4318/v1/traces
+func WithEndpoint(endpoint string) Option {
+	return func(cfg *Config) {
+		cfg.endpoint = endpoint
+	}
+}
+
+// WithHeaders sets additional headers that are sent with every export request.
+func WithHeaders(headers map[string]string) Option {
+	return func(cfg *Config) {
+		cfg.headers = headers
+	}
+}
+
+// WithHTTPClient overrides the http client used to send traces.
+// The client should not itself be instrumented, otherwise exports will generate spans.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *Config) {
+		cfg.httpClient = httpClient
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestIssueSSHUserCertificate(t *testing.T) {
+	caPrivateKey, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("error generating CA private key: %v", err)
+	}
+	caCertificate := &Certificate{PublicKey: caPrivateKey.Key.Public()}
+	caAuthorizedKey, err := SSHAuthorizedKey(caCertificate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	caPublicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(caAuthorizedKey))
+	if err != nil {
+		t.Fatalf("error parsing CA authorized key %q: %v", caAuthorizedKey, err)
+	}
+
+	userPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating user key: %v", err)
+	}
+	sshUserPublicKey, err := ssh.NewPublicKey(userPublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	request := &SSHUserCertificateRequest{
+		PublicKey:  string(ssh.MarshalAuthorizedKey(sshUserPublicKey)),
+		KeyID:      "alice",
+		Principals: []string{"ubuntu"},
+		TTL:        time.Hour,
+	}
+	issued, err := IssueSSHUserCertificate(request, caPrivateKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(issued))
+	if err != nil {
+		t.Fatalf("error parsing issued certificate: %v", err)
+	}
+	certificate, ok := parsed.(*ssh.Certificate)
+	if !ok {
+		t.Fatalf("issued key is a %T, not a certificate", parsed)
+	}
+	if certificate.KeyId != "alice" {
+		t.Errorf("unexpected key id %q", certificate.KeyId)
+	}
+	if certificate.Signature.Format != ssh.KeyAlgoRSASHA512 {
+		t.Errorf("unexpected signature format %q", certificate.Signature.Format)
+	}
+	if validity := time.Duration(certificate.ValidBefore-certificate.ValidAfter) * time.Second; validity != time.Hour+5*time.Minute {
+		t.Errorf("unexpected validity %v", validity)
+	}
+
+	checker := &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(caPublicKey.Marshal())
+		},
+	}
+	if _, err := checker.Authenticate(fakeConnMetadata("ubuntu"), certificate); err != nil {
+		t.Errorf("certificate was not accepted for ubuntu: %v", err)
+	}
+	if _, err := checker.Authenticate(fakeConnMetadata("root"), certificate); err == nil {
+		t.Errorf("certificate was accepted for root")
+	}
+
+	request.Principals = nil
+	if _, err := IssueSSHUserCertificate(request, caPrivateKey); err == nil {
+		t.Errorf("expected error issuing a certificate without principals")
+	}
+}
+
+type fakeConnMetadata string
+
+var _ ssh.ConnMetadata = fakeConnMetadata("")
+
+func (f fakeConnMetadata) User() string          { return string(f) }
+func (f fakeConnMetadata) SessionID() []byte     { return nil }
+func (f fakeConnMetadata) ClientVersion() []byte { return nil }
+func (f fakeConnMetadata) ServerVersion() []byte { return nil }
+func (f fakeConnMetadata) RemoteAddr() net.Addr  { return nil }
+func (f fakeConnMetadata) LocalAddr() net.Addr   { return nil }
@@ -0,0 +1,442 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awspartition"
+)
+
+// awsInstanceClass is a class of EC2 instance families which share their vCPU quotas
+type awsInstanceClass struct {
+	// name is the name of the class in the quotas, e.g. "P" or "G and VT"
+	name string
+	// onDemandQuota is the code of the quota of the vCPUs of the running on-demand instances of the class
+	onDemandQuota string
+	// spotQuota is the code of the quota of the vCPUs of the spot instance requests of the class
+	spotQuota string
+}
+
+func (c *awsInstanceClass) onDemandName() string {
+	return "Running On-Demand " + c.name + " instances (vCPUs)"
+}
+
+func (c *awsInstanceClass) spotName() string {
+	return "All " + c.name + " Spot Instance Requests (vCPUs)"
+}
+
+var (
+	awsStandardInstances = &awsInstanceClass{name: "Standard (A, C, D, H, I, M, R, T, Z)", onDemandQuota: "L-1216C47A", spotQuota: "L-34B43A08"}
+
+	// awsInstanceClasses are the classes of the instance families which are not standard
+	awsInstanceClasses = map[string]*awsInstanceClass{
+		"f":   {name: "F", onDemandQuota: "L-74FC7D96", spotQuota: "L-88CF9481"},
+		"g":   {name: "G and VT", onDemandQuota: "L-DB2E81BA", spotQuota: "L-3819A6DF"},
+		"vt":  {name: "G and VT", onDemandQuota: "L-DB2E81BA", spotQuota: "L-3819A6DF"},
+		"inf": {name: "Inf", onDemandQuota: "L-1945791B", spotQuota: "L-B5D1601B"},
+		"p":   {name: "P", onDemandQuota: "L-417A185B", spotQuota: "L-7212CCBC"},
+		"x":   {name: "X", onDemandQuota: "L-7295265B", spotQuota: "L-E3A00192"},
+	}
+
+	// awsOtherFamilies are the instance families which start like the standard ones, but have quotas we don't check
+	awsOtherFamilies = sets.New("dl", "hpc", "mac", "trn")
+)
+
+// awsStandardFamilies are the first letters of the standard instance families
+const awsStandardFamilies = "acdhimrtz"
+
+// awsInstanceClassOf returns the class of an instance type, or nil if we don't check its quotas
+func awsInstanceClassOf(instanceType string) *awsInstanceClass {
+	family := strings.ToLower(instanceType)
+	if i := strings.IndexFunc(family, func(r rune) bool { return !unicode.IsLetter(r) }); i >= 0 {
+		family = family[:i]
+	}
+	if class, ok := awsInstanceClasses[family]; ok {
+		return class
+	}
+	if family == "" || awsOtherFamilies.Has(family) || !strings.ContainsRune(awsStandardFamilies, rune(family[0])) {
+		return nil
+	}
+	return awsStandardInstances
+}
+
+// awsPurchaseOptions returns how many of the instances of an instance group are on-demand and spot instances
+func awsPurchaseOptions(ig *kops.InstanceGroup, size int64) (onDemand, spot int64) {
+	policy := ig.Spec.MixedInstancesPolicy
+	switch {
+	case policy != nil:
+		base := min(size, fi.ValueOf(policy.OnDemandBase))
+		aboveBase := int64(100)
+		if policy.OnDemandAboveBase != nil {
+			aboveBase = *policy.OnDemandAboveBase
+		}
+		// The auto scaling group rounds the on-demand instances above the base up
+		onDemand = base + ((size-base)*aboveBase+99)/100
+		return onDemand, size - onDemand
+	case fi.ValueOf(ig.Spec.PreferSpot), ig.Spec.MaxPrice != nil:
+		return 0, size
+	default:
+		return size, 0
+	}
+}
+
+// serviceQuotaLookup returns the value of an AWS service quota
+type serviceQuotaLookup func(ctx context.Context, serviceCode, quotaCode string) (float64, error)
+
+func serviceQuotasEndpoint(region string) string {
+	return "https://" + awspartition.ForRegion(region).Endpoint("servicequotas", region) + "/"
+}
+
+// newServiceQuotaLookup returns a lookup of the quotas with the Service Quotas API, whose SDK we don't vendor,
+// with requests signed with the credentials of the AWS config. The AWS default is returned for the quotas which
+// were never changed in the account.
+func newServiceQuotaLookup(cfg aws.Config, region, endpoint string) serviceQuotaLookup {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	call := func(ctx context.Context, operation string, serviceCode, quotaCode string) (int, []byte, error) {
+		body, err := json.Marshal(map[string]string{"ServiceCode": serviceCode, "QuotaCode": quotaCode})
+		if err != nil {
+			return 0, nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+		if err != nil {
+			return 0, nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		req.Header.Set("X-Amz-Target", "ServiceQuotasV20190624."+operation)
+
+		credentials, err := cfg.Credentials.Retrieve(ctx)
+		if err != nil {
+			return 0, nil, fmt.Errorf("retrieving AWS credentials: %w", err)
+		}
+		payloadHash := sha256.Sum256(body)
+		if err := v4.NewSigner().SignHTTP(ctx, credentials, req, hex.EncodeToString(payloadHash[:]), "servicequotas", region, time.Now()); err != nil {
+			return 0, nil, fmt.Errorf("signing %s request: %w", operation, err)
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return 0, nil, err
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		if err != nil {
+			return 0, nil, fmt.Errorf("reading %s response: %w", operation, err)
+		}
+		return resp.StatusCode, data, nil
+	}
+
+	return func(ctx context.Context, serviceCode, quotaCode string) (float64, error) {
+		if cfg.Credentials == nil {
+			return 0, fmt.Errorf("no AWS credentials to look up service quota %s", quotaCode)
+		}
+
+		operation := "GetServiceQuota"
+		status, data, err := call(ctx, operation, serviceCode, quotaCode)
+		if err == nil && status == http.StatusBadRequest && bytes.Contains(data, []byte("NoSuchResourceException")) {
+			operation = "GetAWSDefaultServiceQuota"
+			status, data, err = call(ctx, operation, serviceCode, quotaCode)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if status != http.StatusOK {
+			return 0, fmt.Errorf("%s of %s returned status %d: %s", operation, quotaCode, status, strings.TrimSpace(string(data)))
+		}
+
+		var response struct {
+			Quota struct {
+				Value *float64 `json:"Value"`
+			} `json:"Quota"`
+		}
+		if err := json.Unmarshal(data, &response); err != nil {
+			return 0, fmt.Errorf("parsing %s response: %w", operation, err)
+		}
+		if response.Quota.Value == nil {
+			return 0, fmt.Errorf("service quota %s has no value", quotaCode)
+		}
+		return *response.Quota.Value, nil
+	}
+}
+
+func awsChecks(ctx context.Context, cloud awsup.AWSCloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, lookupServiceQuota serviceQuotaLookup) []*Check {
+	result := awsInstanceChecks(ctx, cloud, cluster, instanceGroups, lookupServiceQuota)
+	if check := awsElasticIPCheck(ctx, cloud, cluster); check != nil {
+		result = append(result, check)
+	}
+	if check := awsNetworkLoadBalancerCheck(ctx, cloud, cluster); check != nil {
+		result = append(result, check)
+	}
+	return result
+}
+
+// awsInstanceChecks returns the checks of the vCPU quotas of the classes of instances used by the cluster
+func awsInstanceChecks(ctx context.Context, cloud awsup.AWSCloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, lookupServiceQuota serviceQuotaLookup) []*Check {
+	required := checks{}
+	quotaCodes := make(map[string]string)
+	for ig, size := range instanceGroupsWithInstances(instanceGroups) {
+		if size == 0 {
+			continue
+		}
+		machineType := primaryMachineType(ig)
+		class := awsInstanceClassOf(machineType)
+		if class == nil {
+			klog.V(2).Infof("not checking the vCPU quota of machine type %q of instance group %q", machineType, ig.Name)
+			continue
+		}
+		info, err := awsup.GetMachineTypeInfo(cloud, ec2types.InstanceType(machineType))
+		if err != nil {
+			klog.Warningf("unable to check the vCPU quota of instance group %q: %v", ig.Name, err)
+			continue
+		}
+
+		onDemand, spot := awsPurchaseOptions(ig, size)
+		if onDemand > 0 {
+			required.get(class.onDemandName()).Required += float64(onDemand * int64(info.Cores))
+			quotaCodes[class.onDemandName()] = class.onDemandQuota
+		}
+		if spot > 0 {
+			required.get(class.spotName()).Required += float64(spot * int64(info.Cores))
+			quotaCodes[class.spotName()] = class.spotQuota
+		}
+	}
+	if len(required) == 0 {
+		return nil
+	}
+
+	used, err := awsInstanceVCPUs(ctx, cloud, cluster)
+	if err != nil {
+		klog.Warningf("unable to check the vCPU quotas: %v", err)
+		return nil
+	}
+
+	var result []*Check
+	for name, check := range required {
+		limit, err := lookupServiceQuota(ctx, "ec2", quotaCodes[name])
+		if err != nil {
+			klog.Warningf("unable to check quota %q: %v", name, err)
+			continue
+		}
+		check.Limit = limit
+		check.Used = used[name]
+		result = append(result, check)
+	}
+	return result
+}
+
+// awsInstanceVCPUs returns the vCPUs of the running instances which are not part of the cluster, by quota name
+func awsInstanceVCPUs(ctx context.Context, cloud awsup.AWSCloud, cluster *kops.Cluster) (map[string]float64, error) {
+	used := make(map[string]float64)
+	request := &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{awsup.NewEC2Filter("instance-state-name", "pending", "running")},
+	}
+	paginator := ec2.NewDescribeInstancesPaginator(cloud.EC2(), request)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing instances: %w", err)
+		}
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				if clusterName, _ := awsup.FindEC2Tag(instance.Tags, awsup.TagClusterName); clusterName == cluster.Name {
+					continue
+				}
+				class := awsInstanceClassOf(string(instance.InstanceType))
+				if class == nil {
+					continue
+				}
+
+				var vcpus float64
+				if cpu := instance.CpuOptions; cpu != nil && cpu.CoreCount != nil && cpu.ThreadsPerCore != nil {
+					vcpus = float64(*cpu.CoreCount * *cpu.ThreadsPerCore)
+				} else {
+					info, err := awsup.GetMachineTypeInfo(cloud, instance.InstanceType)
+					if err != nil {
+						return nil, err
+					}
+					vcpus = float64(info.Cores)
+				}
+
+				if instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot {
+					used[class.spotName()] += vcpus
+				} else {
+					used[class.onDemandName()] += vcpus
+				}
+			}
+		}
+	}
+	return used, nil
+}
+
+// awsElasticIPCheck returns the check of the quota of Elastic IP addresses, allocated for the NAT gateways of the cluster
+func awsElasticIPCheck(ctx context.Context, cloud awsup.AWSCloud, cluster *kops.Cluster) *Check {
+	// kOps allocates an address for the NAT gateway of each zone with managed private subnets,
+	// or with IPv6 public subnets in IPv6-only clusters
+	zones := sets.New[string]()
+	for i := range cluster.Spec.Networking.Subnets {
+		subnet := &cluster.Spec.Networking.Subnets[i]
+		if subnet.ID != "" || subnet.Egress != "" || subnet.PublicIP != "" {
+			continue
+		}
+		switch subnet.Type {
+		case kops.SubnetTypePrivate, kops.SubnetTypeDualStack:
+			zones.Insert(subnet.Zone)
+		case kops.SubnetTypePublic:
+			if cluster.Spec.IsIPv6Only() && subnet.IPv6CIDR != "" {
+				zones.Insert(subnet.Zone)
+			}
+		}
+	}
+	if zones.Len() == 0 {
+		return nil
+	}
+	check := &Check{Name: "Elastic IP addresses", Required: float64(zones.Len())}
+
+	attributes, err := cloud.EC2().DescribeAccountAttributes(ctx, &ec2.DescribeAccountAttributesInput{
+		AttributeNames: []ec2types.AccountAttributeName{"vpc-max-elastic-ips"},
+	})
+	if err != nil {
+		klog.Warningf("unable to check quota %q: %v", check.Name, err)
+		return nil
+	}
+	found := false
+	for _, attribute := range attributes.AccountAttributes {
+		if aws.ToString(attribute.AttributeName) != "vpc-max-elastic-ips" || len(attribute.AttributeValues) == 0 {
+			continue
+		}
+		value := aws.ToString(attribute.AttributeValues[0].AttributeValue)
+		if check.Limit, err = strconv.ParseFloat(value, 64); err != nil {
+			klog.Warningf("unable to parse the value %q of quota %q: %v", value, check.Name, err)
+			return nil
+		}
+		found = true
+	}
+	if !found {
+		klog.Warningf("unable to check quota %q: account attribute vpc-max-elastic-ips not found", check.Name)
+		return nil
+	}
+
+	addresses, err := cloud.EC2().DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		klog.Warningf("unable to check quota %q: listing addresses: %v", check.Name, err)
+		return nil
+	}
+	for _, address := range addresses.Addresses {
+		if clusterName, _ := awsup.FindEC2Tag(address.Tags, awsup.TagClusterName); clusterName != cluster.Name {
+			check.Used++
+		}
+	}
+	return check
+}
+
+// awsNetworkLoadBalancerCheck returns the check of the quota of Network Load Balancers, for the API load balancer
+func awsNetworkLoadBalancerCheck(ctx context.Context, cloud awsup.AWSCloud, cluster *kops.Cluster) *Check {
+	if lb := cluster.Spec.API.LoadBalancer; lb == nil || lb.Class != kops.LoadBalancerClassNetwork {
+		return nil
+	}
+	check := &Check{Name: "Network Load Balancers", Required: 1}
+
+	found := false
+	request := &elbv2.DescribeAccountLimitsInput{}
+	for {
+		response, err := cloud.ELBV2().DescribeAccountLimits(ctx, request)
+		if err != nil {
+			klog.Warningf("unable to check quota %q: %v", check.Name, err)
+			return nil
+		}
+		for _, limit := range response.Limits {
+			if aws.ToString(limit.Name) != "network-load-balancers" {
+				continue
+			}
+			if check.Limit, err = strconv.ParseFloat(aws.ToString(limit.Max), 64); err != nil {
+				klog.Warningf("unable to parse the value %q of quota %q: %v", aws.ToString(limit.Max), check.Name, err)
+				return nil
+			}
+			found = true
+		}
+		if response.NextMarker == nil {
+			break
+		}
+		request.Marker = response.NextMarker
+	}
+	if !found {
+		klog.Warningf("unable to check quota %q: account limit network-load-balancers not found", check.Name)
+		return nil
+	}
+
+	var arns []string
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(cloud.ELBV2(), &elbv2.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			klog.Warningf("unable to check quota %q: listing load balancers: %v", check.Name, err)
+			return nil
+		}
+		for _, lb := range page.LoadBalancers {
+			if lb.Type == elbv2types.LoadBalancerTypeEnumNetwork {
+				arns = append(arns, aws.ToString(lb.LoadBalancerArn))
+			}
+		}
+	}
+
+	// DescribeTags accepts up to 20 load balancers
+	for len(arns) > 0 {
+		n := min(len(arns), 20)
+		response, err := cloud.ELBV2().DescribeTags(ctx, &elbv2.DescribeTagsInput{ResourceArns: arns[:n]})
+		if err != nil {
+			klog.Warningf("unable to check quota %q: listing load balancer tags: %v", check.Name, err)
+			return nil
+		}
+		owned := 0
+		for _, description := range response.TagDescriptions {
+			for _, tag := range description.Tags {
+				if aws.ToString(tag.Key) == awsup.TagClusterName && aws.ToString(tag.Value) == cluster.Name {
+					owned++
+				}
+			}
+		}
+		check.Used += float64(n - owned)
+		arns = arns[n:]
+	}
+	return check
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	"k8s.io/kops/cloudmock/aws/mockelbv2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+// instancesEC2 returns fixed instances from DescribeInstances
+type instancesEC2 struct {
+	awsinterfaces.EC2API
+	instances []ec2types.Instance
+}
+
+func (c *instancesEC2) DescribeInstances(ctx context.Context, request *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	return &ec2.DescribeInstancesOutput{
+		Reservations: []ec2types.Reservation{{Instances: c.instances}},
+	}, nil
+}
+
+func buildAWSCloud(instances ...ec2types.Instance) (*awsup.MockAWSCloud, *mockec2.MockEC2, *mockelbv2.MockELBV2) {
+	mockEC2 := &mockec2.MockEC2{}
+	mockELBV2 := &mockelbv2.MockELBV2{EC2: mockEC2}
+	cloud := awsup.BuildMockAWSCloud("us-test-1", "abc")
+	cloud.MockEC2 = &instancesEC2{EC2API: mockEC2, instances: instances}
+	cloud.MockELBV2 = mockELBV2
+	return cloud, mockEC2, mockELBV2
+}
+
+func buildInstanceGroup(name, machineType string, minSize int32) *kops.InstanceGroup {
+	ig := testutils.BuildMinimalNodeInstanceGroup(name, "subnet-us-test-1a")
+	ig.Spec.MachineType = machineType
+	ig.Spec.MinSize = fi.PtrTo(minSize)
+	ig.Spec.MaxSize = fi.PtrTo(minSize * 2)
+	return &ig
+}
+
+func checkStrings(checks []*Check) []string {
+	var names []string
+	for _, check := range checks {
+		names = append(names, check.String())
+	}
+	return names
+}
+
+func TestAWSInstanceClassOf(t *testing.T) {
+	grid := map[string]string{
+		"m5.large":      "Standard (A, C, D, H, I, M, R, T, Z)",
+		"t3a.micro":     "Standard (A, C, D, H, I, M, R, T, Z)",
+		"c7gn.xlarge":   "Standard (A, C, D, H, I, M, R, T, Z)",
+		"g5.xlarge":     "G and VT",
+		"vt1.3xlarge":   "G and VT",
+		"p4d.24xlarge":  "P",
+		"inf2.xlarge":   "Inf",
+		"x2iedn.xlarge": "X",
+		"f1.2xlarge":    "F",
+		"trn1.2xlarge":  "",
+		"mac2.metal":    "",
+		"dl1.24xlarge":  "",
+		"u-6tb1.metal":  "",
+		"":              "",
+	}
+	for instanceType, expected := range grid {
+		actual := ""
+		if class := awsInstanceClassOf(instanceType); class != nil {
+			actual = class.name
+		}
+		if actual != expected {
+			t.Errorf("unexpected class of %q: expected %q, got %q", instanceType, expected, actual)
+		}
+	}
+}
+
+func TestAWSPurchaseOptions(t *testing.T) {
+	grid := []struct {
+		name     string
+		spec     kops.InstanceGroupSpec
+		onDemand int64
+		spot     int64
+	}{
+		{
+			name:     "on-demand",
+			onDemand: 5,
+		},
+		{
+			name: "max price",
+			spec: kops.InstanceGroupSpec{MaxPrice: fi.PtrTo("0.1")},
+			spot: 5,
+		},
+		{
+			name: "prefer spot",
+			spec: kops.InstanceGroupSpec{PreferSpot: fi.PtrTo(true)},
+			spot: 5,
+		},
+		{
+			name: "mixed instances with base",
+			spec: kops.InstanceGroupSpec{MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+				OnDemandBase:      fi.PtrTo(int64(2)),
+				OnDemandAboveBase: fi.PtrTo(int64(50)),
+			}},
+			onDemand: 4,
+			spot:     1,
+		},
+		{
+			name: "mixed instances all spot",
+			spec: kops.InstanceGroupSpec{MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{
+				OnDemandAboveBase: fi.PtrTo(int64(0)),
+			}},
+			spot: 5,
+		},
+		{
+			name:     "mixed instances default",
+			spec:     kops.InstanceGroupSpec{MixedInstancesPolicy: &kops.MixedInstancesPolicySpec{}},
+			onDemand: 5,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			ig := &kops.InstanceGroup{Spec: g.spec}
+			onDemand, spot := awsPurchaseOptions(ig, 5)
+			if onDemand != g.onDemand || spot != g.spot {
+				t.Errorf("expected %d on-demand and %d spot instances, got %d and %d", g.onDemand, g.spot, onDemand, spot)
+			}
+		})
+	}
+}
+
+func TestAWSChecks(t *testing.T) {
+	ctx := context.Background()
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+	cluster.Spec.Networking.Subnets = append(cluster.Spec.Networking.Subnets,
+		kops.ClusterSubnetSpec{Name: "private-a", Zone: "us-test-1a", Type: kops.SubnetTypePrivate},
+		kops.ClusterSubnetSpec{Name: "private-b", Zone: "us-test-1b", Type: kops.SubnetTypePrivate},
+		kops.ClusterSubnetSpec{Name: "private-c", Zone: "us-test-1c", Type: kops.SubnetTypePrivate, Egress: "nat-12345678"},
+	)
+	cluster.Spec.API.LoadBalancer = &kops.LoadBalancerAccessSpec{Class: kops.LoadBalancerClassNetwork}
+
+	cloud, mockEC2, mockELBV2 := buildAWSCloud(
+		ec2types.Instance{
+			InstanceType: "m5.xlarge",
+			CpuOptions:   &ec2types.CpuOptions{CoreCount: aws.Int32(2), ThreadsPerCore: aws.Int32(2)},
+		},
+		ec2types.Instance{
+			InstanceType:      "c5.large",
+			InstanceLifecycle: ec2types.InstanceLifecycleTypeSpot,
+		},
+		ec2types.Instance{
+			InstanceType: "m5.large",
+			Tags:         []ec2types.Tag{{Key: aws.String(awsup.TagClusterName), Value: aws.String(cluster.Name)}},
+		},
+		ec2types.Instance{
+			InstanceType: "mac2.metal",
+		},
+	)
+
+	for _, clusterName := range []string{"other.k8s.io", "other.k8s.io", cluster.Name} {
+		if _, err := mockEC2.AllocateAddress(ctx, &ec2.AllocateAddressInput{
+			TagSpecifications: []ec2types.TagSpecification{{
+				ResourceType: ec2types.ResourceTypeElasticIp,
+				Tags:         []ec2types.Tag{{Key: aws.String(awsup.TagClusterName), Value: aws.String(clusterName)}},
+			}},
+		}); err != nil {
+			t.Fatalf("error allocating address: %v", err)
+		}
+	}
+	for _, clusterName := range []string{"other.k8s.io", cluster.Name} {
+		if _, err := mockELBV2.CreateLoadBalancer(ctx, &elbv2.CreateLoadBalancerInput{
+			Name: aws.String("api-" + strings.ReplaceAll(clusterName, ".", "-")),
+			Type: elbv2types.LoadBalancerTypeEnumNetwork,
+			Tags: []elbv2types.Tag{{Key: aws.String(awsup.TagClusterName), Value: aws.String(clusterName)}},
+		}); err != nil {
+			t.Fatalf("error creating load balancer: %v", err)
+		}
+	}
+	if _, err := mockELBV2.CreateLoadBalancer(ctx, &elbv2.CreateLoadBalancerInput{
+		Name: aws.String("application"),
+		Type: elbv2types.LoadBalancerTypeEnumApplication,
+	}); err != nil {
+		t.Fatalf("error creating load balancer: %v", err)
+	}
+
+	instanceGroups := []*kops.InstanceGroup{
+		buildInstanceGroup("nodes", "m5.large,m5a.large", 3),
+		buildInstanceGroup("gpu", "p3.2xlarge", 1),
+		buildInstanceGroup("empty", "g5.xlarge", 0),
+		buildInstanceGroup("mac", "mac2.metal", 1),
+	}
+	spot := buildInstanceGroup("spot", "c5.large", 2)
+	spot.Spec.MaxPrice = fi.PtrTo("0.1")
+	instanceGroups = append(instanceGroups, spot)
+
+	limits := map[string]float64{
+		"L-1216C47A": 16,
+		"L-34B43A08": 8,
+	}
+	lookup := func(ctx context.Context, serviceCode, quotaCode string) (float64, error) {
+		if serviceCode != "ec2" {
+			t.Errorf("unexpected service code %q", serviceCode)
+		}
+		limit, ok := limits[quotaCode]
+		if !ok {
+			return 0, fmt.Errorf("access denied to quota %s", quotaCode)
+		}
+		return limit, nil
+	}
+
+	checks := awsChecks(ctx, cloud, cluster, instanceGroups, lookup)
+	sortChecks(checks)
+	expected := []string{
+		"All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests (vCPUs): limit 8, 2 used by other resources, 4 required by the cluster",
+		"Elastic IP addresses: limit 5, 2 used by other resources, 2 required by the cluster",
+		"Network Load Balancers: limit 50, 1 used by other resources, 1 required by the cluster",
+		"Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances (vCPUs): limit 16, 4 used by other resources, 6 required by the cluster",
+	}
+	if actual := checkStrings(checks); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected checks\nexpected: %q\nactual:   %q", expected, actual)
+	}
+	for _, check := range checks {
+		if check.Exceeded() {
+			t.Errorf("unexpected exceeded check %s", check)
+		}
+	}
+
+	mockEC2.AccountAttributes = map[string]string{"vpc-max-elastic-ips": "3"}
+	err := Verify(ctx, cloud, cluster, instanceGroups)
+	expectedError := "the cluster would exceed 1 quota(s) of the cloud account, which must be raised first:\n" +
+		"  Elastic IP addresses: limit 3, 2 used by other resources, 2 required by the cluster"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("unexpected error\nexpected: %s\nactual:   %v", expectedError, err)
+	}
+}
+
+func TestServiceQuotaLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+			t.Errorf("unexpected authorization %q", req.Header.Get("Authorization"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		var request map[string]string
+		if err := json.Unmarshal(body, &request); err != nil {
+			t.Errorf("error parsing request %q: %v", body, err)
+		}
+
+		target := req.Header.Get("X-Amz-Target")
+		switch {
+		case target == "ServiceQuotasV20190624.GetServiceQuota" && request["QuotaCode"] == "L-1216C47A":
+			w.Write([]byte(`{"Quota":{"QuotaCode":"L-1216C47A","Value":640.0}}`))
+		case target == "ServiceQuotasV20190624.GetServiceQuota":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"NoSuchResourceException","Message":"The request failed because the specified resource does not exist."}`))
+		case target == "ServiceQuotasV20190624.GetAWSDefaultServiceQuota" && request["QuotaCode"] == "L-417A185B":
+			w.Write([]byte(`{"Quota":{"QuotaCode":"L-417A185B","Value":0.0}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"AccessDeniedException"}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cfg := aws.Config{Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", "")}
+	lookup := newServiceQuotaLookup(cfg, "us-test-1", server.URL)
+
+	if limit, err := lookup(ctx, "ec2", "L-1216C47A"); err != nil || limit != 640 {
+		t.Errorf("unexpected quota %v: %v", limit, err)
+	}
+	if limit, err := lookup(ctx, "ec2", "L-417A185B"); err != nil || limit != 0 {
+		t.Errorf("unexpected default quota %v: %v", limit, err)
+	}
+	if _, err := lookup(ctx, "ec2", "L-7212CCBC"); err == nil || !strings.Contains(err.Error(), "AccessDeniedException") {
+		t.Errorf("expected an error, got %v", err)
+	}
+	if _, err := newServiceQuotaLookup(aws.Config{}, "us-test-1", server.URL)(ctx, "ec2", "L-1216C47A"); err == nil {
+		t.Errorf("expected an error without credentials")
+	}
+}
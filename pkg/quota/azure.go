@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+)
+
+const (
+	// azureRegionalCores is the usage of the vCPUs of all the VM sizes in a location
+	azureRegionalCores = "cores"
+	// azureScaleSets is the usage of the number of VM scale sets in a location
+	azureScaleSets = "virtualMachineScaleSets"
+	// azureMaxScaleSetInstances is the maximum number of instances of a VM scale set
+	azureMaxScaleSetInstances = 1000
+)
+
+// azureVMSize is the quota family and the number of vCPUs of a VM size
+type azureVMSize struct {
+	family string
+	vcpus  int64
+}
+
+// azureChecks returns the checks of the vCPU and VM scale set quotas of the location, and of the size of the VM scale sets
+func azureChecks(ctx context.Context, cloud azure.AzureCloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) []*Check {
+	location := cloud.Region()
+	skus, err := cloud.ResourceSKU().List(ctx, location)
+	if err != nil {
+		klog.Warningf("unable to check the quotas of location %q: %v", location, err)
+		return nil
+	}
+	vmSizes := make(map[string]azureVMSize)
+	for _, sku := range skus {
+		if fi.ValueOf(sku.ResourceType) != "virtualMachines" || sku.Name == nil || sku.Family == nil {
+			continue
+		}
+		for _, capability := range sku.Capabilities {
+			if fi.ValueOf(capability.Name) != "vCPUs" {
+				continue
+			}
+			if vcpus, err := strconv.ParseInt(fi.ValueOf(capability.Value), 10, 64); err == nil {
+				vmSizes[*sku.Name] = azureVMSize{family: *sku.Family, vcpus: vcpus}
+			}
+		}
+	}
+
+	var result []*Check
+	required := checks{}
+	for ig, size := range instanceGroupsWithInstances(instanceGroups) {
+		result = append(result, &Check{
+			Name:     fmt.Sprintf("Instances of the VM scale set of instance group %q at its maximum size", ig.Name),
+			Limit:    azureMaxScaleSetInstances,
+			Required: float64(fi.ValueOf(ig.Spec.MaxSize)),
+		})
+		required.get(azureScaleSets).Required++
+		if size == 0 {
+			continue
+		}
+		vmSize, ok := vmSizes[ig.Spec.MachineType]
+		if !ok {
+			klog.Warningf("unable to check the vCPU quota of instance group %q: VM size %q not found in location %q", ig.Name, ig.Spec.MachineType, location)
+			continue
+		}
+		required.get(azureRegionalCores).Required += float64(size * vmSize.vcpus)
+		required.get(vmSize.family).Required += float64(size * vmSize.vcpus)
+	}
+	if len(required) == 0 {
+		return result
+	}
+
+	// The usage of the quotas includes the VM scale sets of the cluster, which are already counted as required
+	clusterUsage := make(map[string]float64)
+	scaleSets, err := cloud.VMScaleSet().List(ctx, cluster.AzureResourceGroupName())
+	if err != nil {
+		klog.Warningf("unable to check the quotas of location %q: %v", location, err)
+		return result
+	}
+	for _, scaleSet := range scaleSets {
+		if clusterName := scaleSet.Tags[azure.TagClusterName]; clusterName == nil || *clusterName != cluster.Name {
+			continue
+		}
+		clusterUsage[azureScaleSets]++
+		if scaleSet.SKU == nil || scaleSet.SKU.Name == nil {
+			continue
+		}
+		if vmSize, ok := vmSizes[*scaleSet.SKU.Name]; ok {
+			vcpus := float64(fi.ValueOf(scaleSet.SKU.Capacity) * vmSize.vcpus)
+			clusterUsage[azureRegionalCores] += vcpus
+			clusterUsage[vmSize.family] += vcpus
+		}
+	}
+
+	usages, err := cloud.Usage().List(ctx, location)
+	if err != nil {
+		klog.Warningf("unable to check the quotas of location %q: %v", location, err)
+		return result
+	}
+	for _, usage := range usages {
+		if usage.Name == nil || usage.Name.Value == nil {
+			continue
+		}
+		check := required[*usage.Name.Value]
+		if check == nil {
+			continue
+		}
+		if usage.Name.LocalizedValue != nil {
+			check.Name = *usage.Name.LocalizedValue
+		}
+		check.Name = fmt.Sprintf("%s in location %s", check.Name, location)
+		check.Limit = float64(fi.ValueOf(usage.Limit))
+		check.Used = max(0, float64(fi.ValueOf(usage.CurrentValue))-clusterUsage[*usage.Name.Value])
+		result = append(result, check)
+	}
+	return result
+}
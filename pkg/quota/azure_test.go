@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azuretasks"
+)
+
+func azureVMSizeSKU(name, family, vcpus string) *compute.ResourceSKU {
+	return &compute.ResourceSKU{
+		Name:         fi.PtrTo(name),
+		Family:       fi.PtrTo(family),
+		ResourceType: fi.PtrTo("virtualMachines"),
+		Capabilities: []*compute.ResourceSKUCapabilities{
+			{Name: fi.PtrTo("MemoryGB"), Value: fi.PtrTo("8")},
+			{Name: fi.PtrTo("vCPUs"), Value: fi.PtrTo(vcpus)},
+		},
+	}
+}
+
+func azureUsage(name, localizedName string, limit int64, current int32) *compute.Usage {
+	return &compute.Usage{
+		Name:         &compute.UsageName{Value: fi.PtrTo(name), LocalizedValue: fi.PtrTo(localizedName)},
+		Limit:        fi.PtrTo(limit),
+		CurrentValue: fi.PtrTo(current),
+	}
+}
+
+func TestAzureChecks(t *testing.T) {
+	ctx := context.Background()
+	cloud := azuretasks.NewMockAzureCloud("eastus")
+	cloud.ResourceSKUsClient.SKUs = []*compute.ResourceSKU{
+		azureVMSizeSKU("Standard_D2s_v3", "standardDSv3Family", "2"),
+		azureVMSizeSKU("Standard_D4s_v3", "standardDSv3Family", "4"),
+		{Name: fi.PtrTo("Premium_LRS"), ResourceType: fi.PtrTo("disks")},
+	}
+	cloud.UsagesClient.Usages = []*compute.Usage{
+		azureUsage("availabilitySets", "Availability Sets", 2500, 1),
+		azureUsage("cores", "Total Regional vCPUs", 20, 10),
+		azureUsage("virtualMachineScaleSets", "Virtual Machine Scale Sets", 2500, 3),
+		azureUsage("standardDSv3Family", "Standard DSv3 Family vCPUs", 10, 6),
+	}
+
+	cluster := &kops.Cluster{}
+	cluster.Name = "test.k8s.io"
+	cluster.Spec.CloudProvider.Azure = &kops.AzureSpec{ResourceGroupName: "test-rg"}
+	cloud.VMScaleSetsClient.VMSSes["nodes"] = &compute.VirtualMachineScaleSet{
+		Tags: map[string]*string{azure.TagClusterName: fi.PtrTo(cluster.Name)},
+		SKU:  &compute.SKU{Name: fi.PtrTo("Standard_D2s_v3"), Capacity: fi.PtrTo(int64(2))},
+	}
+	cloud.VMScaleSetsClient.VMSSes["other"] = &compute.VirtualMachineScaleSet{
+		SKU: &compute.SKU{Name: fi.PtrTo("Standard_D2s_v3"), Capacity: fi.PtrTo(int64(3))},
+	}
+
+	big := buildInstanceGroup("big", "Standard_D4s_v3", 1)
+	big.Spec.MaxSize = fi.PtrTo(int32(1200))
+	instanceGroups := []*kops.InstanceGroup{
+		buildInstanceGroup("nodes", "Standard_D2s_v3", 3),
+		buildInstanceGroup("unknown", "Standard_Z1", 1),
+		big,
+	}
+
+	checks := azureChecks(ctx, cloud, cluster, instanceGroups)
+	sortChecks(checks)
+	expected := []string{
+		`Instances of the VM scale set of instance group "big" at its maximum size: limit 1000, 0 used by other resources, 1200 required by the cluster`,
+		`Instances of the VM scale set of instance group "nodes" at its maximum size: limit 1000, 0 used by other resources, 6 required by the cluster`,
+		`Instances of the VM scale set of instance group "unknown" at its maximum size: limit 1000, 0 used by other resources, 2 required by the cluster`,
+		"Standard DSv3 Family vCPUs in location eastus: limit 10, 2 used by other resources, 10 required by the cluster",
+		"Total Regional vCPUs in location eastus: limit 20, 6 used by other resources, 10 required by the cluster",
+		"Virtual Machine Scale Sets in location eastus: limit 2500, 2 used by other resources, 3 required by the cluster",
+	}
+	if actual := checkStrings(checks); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected checks\nexpected: %q\nactual:   %q", expected, actual)
+	}
+
+	var exceeded []string
+	for _, check := range checks {
+		if check.Exceeded() {
+			exceeded = append(exceeded, check.Name)
+		}
+	}
+	expectedExceeded := []string{
+		`Instances of the VM scale set of instance group "big" at its maximum size`,
+		"Standard DSv3 Family vCPUs in location eastus",
+	}
+	if !reflect.DeepEqual(exceeded, expectedExceeded) {
+		t.Errorf("unexpected exceeded checks\nexpected: %q\nactual:   %q", expectedExceeded, exceeded)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+const (
+	// gceCPUs is the quota of the CPUs of the machine families which don't have a quota of their own
+	gceCPUs = "CPUS"
+	// gcePreemptibleCPUs is the quota of the CPUs of spot instances, for projects which have one
+	gcePreemptibleCPUs = "PREEMPTIBLE_CPUS"
+	// gceInstances is the quota of the number of instances
+	gceInstances = "INSTANCES"
+)
+
+// gceCPUMetric returns the metric of the quota of the CPUs of a machine type: the quota of its family,
+// e.g. N2_CPUS, if the region has one, or CPUS
+func gceCPUMetric(quotas map[string]*compute.Quota, machineType string, spot bool) string {
+	if q := quotas[gcePreemptibleCPUs]; spot && q != nil && q.Limit > 0 {
+		return gcePreemptibleCPUs
+	}
+	family, _, _ := strings.Cut(machineType, "-")
+	if metric := strings.ToUpper(family) + "_CPUS"; quotas[metric] != nil {
+		return metric
+	}
+	return gceCPUs
+}
+
+// gceMachineTypes looks up the number of CPUs of machine types
+type gceMachineTypes struct {
+	cloud gce.GCECloud
+	cpus  map[string]int64
+}
+
+func (m *gceMachineTypes) CPUs(zone, machineType string) (int64, error) {
+	if cpus, ok := m.cpus[machineType]; ok {
+		return cpus, nil
+	}
+	info, err := m.cloud.Compute().MachineTypes().Get(m.cloud.Project(), zone, machineType)
+	if err != nil {
+		return 0, fmt.Errorf("getting machine type %q in zone %q: %w", machineType, zone, err)
+	}
+	m.cpus[machineType] = info.GuestCpus
+	return info.GuestCpus, nil
+}
+
+// gceChecks returns the checks of the regional quotas of CPUs and instances
+func gceChecks(ctx context.Context, cloud gce.GCECloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) []*Check {
+	project := cloud.Project()
+	region, err := cloud.Compute().Regions().Get(project, cloud.Region())
+	if err != nil {
+		klog.Warningf("unable to check the quotas of region %q: %v", cloud.Region(), err)
+		return nil
+	}
+	quotas := make(map[string]*compute.Quota)
+	for _, q := range region.Quotas {
+		quotas[q.Metric] = q
+	}
+
+	zones, err := cloud.Zones()
+	if err != nil || len(zones) == 0 {
+		klog.Warningf("unable to check the quotas of region %q: listing zones: %v", cloud.Region(), err)
+		return nil
+	}
+	machineTypes := &gceMachineTypes{cloud: cloud, cpus: make(map[string]int64)}
+
+	required := checks{}
+	for ig, size := range instanceGroupsWithInstances(instanceGroups) {
+		if size == 0 {
+			continue
+		}
+		zone := zones[0]
+		if len(ig.Spec.Zones) != 0 {
+			zone = ig.Spec.Zones[0]
+		}
+		machineType := primaryMachineType(ig)
+		cpus, err := machineTypes.CPUs(zone, machineType)
+		if err != nil {
+			klog.Warningf("unable to check the CPU quota of instance group %q: %v", ig.Name, err)
+			continue
+		}
+		spot := fi.ValueOf(ig.Spec.GCPProvisioningModel) == "SPOT" || (ig.Spec.GCPProvisioningModel == nil && fi.ValueOf(ig.Spec.PreferSpot))
+		required.get(gceCPUMetric(quotas, machineType, spot)).Required += float64(size * cpus)
+		required.get(gceInstances).Required += float64(size)
+	}
+	if len(required) == 0 {
+		return nil
+	}
+
+	// The usage of the quotas includes the instances of the cluster, which are already counted as required
+	clusterUsage := make(map[string]float64)
+	clusterLabel := gce.LabelForCluster(cluster.Name)
+	for _, zone := range zones {
+		instances, err := cloud.Compute().Instances().List(ctx, project, zone)
+		if err != nil {
+			klog.Warningf("unable to check the quotas of region %q: listing instances: %v", cloud.Region(), err)
+			return nil
+		}
+		for _, instance := range instances {
+			if instance.Labels[clusterLabel.Key] != clusterLabel.Value || instance.Status == "TERMINATED" || instance.Status == "SUSPENDED" {
+				continue
+			}
+			machineType := gce.LastComponent(instance.MachineType)
+			cpus, err := machineTypes.CPUs(zone, machineType)
+			if err != nil {
+				klog.Warningf("unable to check the quotas of region %q: %v", cloud.Region(), err)
+				return nil
+			}
+			spot := instance.Scheduling != nil && (instance.Scheduling.Preemptible || instance.Scheduling.ProvisioningModel == "SPOT")
+			clusterUsage[gceCPUMetric(quotas, machineType, spot)] += float64(cpus)
+			clusterUsage[gceInstances]++
+		}
+	}
+
+	var result []*Check
+	for metric, check := range required {
+		q := quotas[metric]
+		if q == nil {
+			klog.V(2).Infof("region %q has no quota %s", region.Name, metric)
+			continue
+		}
+		check.Name = fmt.Sprintf("%s in region %s", metric, region.Name)
+		check.Limit = q.Limit
+		check.Used = max(0, q.Usage-clusterUsage[metric])
+		result = append(result, check)
+	}
+	return result
+}
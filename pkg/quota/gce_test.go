@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	compute "google.golang.org/api/compute/v1"
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/cloudmock/gce/mockcompute"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// zonedGCECloud is a mock GCE cloud with zones
+type zonedGCECloud struct {
+	*gcemock.MockGCECloud
+}
+
+func (c *zonedGCECloud) Zones() ([]string, error) {
+	return []string{"us-test1-a", "us-test1-b"}, nil
+}
+
+func TestGCEChecks(t *testing.T) {
+	ctx := context.Background()
+	cloud := &zonedGCECloud{MockGCECloud: gcemock.InstallMockGCECloud("us-test1", "testproject")}
+	mock := cloud.Compute().(*mockcompute.MockClient)
+	mock.SetQuota("testproject", "us-test1", "CPUS", 24, 12)
+	mock.SetQuota("testproject", "us-test1", "INSTANCES", 24, 5)
+	mock.SetQuota("testproject", "us-test1", "N2_CPUS", 8, 0)
+	mock.SetQuota("testproject", "us-test1", "PREEMPTIBLE_CPUS", 0, 0)
+
+	cluster := &kops.Cluster{}
+	cluster.Name = "test.k8s.io"
+	clusterLabel := gce.LabelForCluster(cluster.Name)
+	for _, instance := range []*compute.Instance{
+		{Name: "nodes-1", MachineType: "zones/us-test1-a/machineTypes/e2-medium", Status: "RUNNING", Labels: map[string]string{clusterLabel.Key: clusterLabel.Value}},
+		{Name: "nodes-2", MachineType: "zones/us-test1-a/machineTypes/n2-standard-4", Status: "TERMINATED", Labels: map[string]string{clusterLabel.Key: clusterLabel.Value}},
+		{Name: "other", MachineType: "zones/us-test1-a/machineTypes/n2-standard-8", Status: "RUNNING"},
+	} {
+		if _, err := mock.Instances().Insert("testproject", "us-test1-a", instance); err != nil {
+			t.Fatalf("error inserting instance: %v", err)
+		}
+	}
+
+	big := buildInstanceGroup("big", "n2-standard-4", 2)
+	big.Spec.Zones = []string{"us-test1-b"}
+	spot := buildInstanceGroup("spot", "e2-standard-2", 2)
+	spot.Spec.GCPProvisioningModel = fi.PtrTo("SPOT")
+	instanceGroups := []*kops.InstanceGroup{
+		buildInstanceGroup("nodes", "e2-medium", 4),
+		buildInstanceGroup("empty", "n2-standard-64", 0),
+		big,
+		spot,
+	}
+
+	checks := gceChecks(ctx, cloud, cluster, instanceGroups)
+	sortChecks(checks)
+	expected := []string{
+		"CPUS in region us-test1: limit 24, 10 used by other resources, 12 required by the cluster",
+		"INSTANCES in region us-test1: limit 24, 4 used by other resources, 8 required by the cluster",
+		"N2_CPUS in region us-test1: limit 8, 0 used by other resources, 8 required by the cluster",
+	}
+	if actual := checkStrings(checks); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected checks\nexpected: %q\nactual:   %q", expected, actual)
+	}
+
+	mock.SetQuota("testproject", "us-test1", "N2_CPUS", 8, 1)
+	mock.SetQuota("testproject", "us-test1", "PREEMPTIBLE_CPUS", 16, 0)
+	err := Verify(ctx, cloud, cluster, instanceGroups)
+	expectedError := "the cluster would exceed 1 quota(s) of the cloud account, which must be raised first:\n" +
+		"  N2_CPUS in region us-test1: limit 8, 1 used by other resources, 8 required by the cluster"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("unexpected error\nexpected: %s\nactual:   %v", expectedError, err)
+	}
+}
+
+func TestGCECPUMetric(t *testing.T) {
+	quotas := map[string]*compute.Quota{
+		"CPUS":             {Metric: "CPUS", Limit: 24},
+		"N2_CPUS":          {Metric: "N2_CPUS", Limit: 24},
+		"PREEMPTIBLE_CPUS": {Metric: "PREEMPTIBLE_CPUS", Limit: 8},
+	}
+	grid := []struct {
+		machineType string
+		spot        bool
+		expected    string
+	}{
+		{machineType: "n2-standard-4", expected: "N2_CPUS"},
+		{machineType: "e2-medium", expected: "CPUS"},
+		{machineType: "n2-standard-4", spot: true, expected: "PREEMPTIBLE_CPUS"},
+	}
+	for _, g := range grid {
+		if actual := gceCPUMetric(quotas, g.machineType, g.spot); actual != g.expected {
+			t.Errorf("unexpected metric of %q (spot %v): expected %s, got %s", g.machineType, g.spot, g.expected, actual)
+		}
+	}
+}
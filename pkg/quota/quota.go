@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota checks that the quotas of the cloud account have room for the instances and other resources
+// of a cluster, so that applying it fails up front with a report of the quotas to raise, rather than part-way
+// through with the cluster half-created.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// Check is a quota of the cloud account, versus its usage by the cluster and by the other resources of the account.
+type Check struct {
+	// Name describes the quota
+	Name string
+	// Limit is the value of the quota
+	Limit float64
+	// Used is the usage of the quota by the resources of the account which are not part of the cluster
+	Used float64
+	// Required is the usage of the quota by the cluster, with its instance groups at their minimum size
+	Required float64
+}
+
+// Exceeded returns true if the cluster doesn't fit in what the other resources leave of the quota
+func (c *Check) Exceeded() bool {
+	return c.Used+c.Required > c.Limit
+}
+
+func (c *Check) String() string {
+	return fmt.Sprintf("%s: limit %s, %s used by other resources, %s required by the cluster",
+		c.Name, formatValue(c.Limit), formatValue(c.Used), formatValue(c.Required))
+}
+
+// Checks returns the quota checks of a cluster. Quotas are only checked on AWS, GCE and Azure.
+// Quotas which can't be looked up, e.g. for lack of permissions, are skipped with a warning.
+func Checks(ctx context.Context, cloud fi.Cloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) []*Check {
+	var checks []*Check
+	switch c := cloud.(type) {
+	case awsup.AWSCloud:
+		checks = awsChecks(ctx, c, cluster, instanceGroups, newServiceQuotaLookup(c.Config(), c.Region(), serviceQuotasEndpoint(c.Region())))
+	case gce.GCECloud:
+		checks = gceChecks(ctx, c, cluster, instanceGroups)
+	case azure.AzureCloud:
+		checks = azureChecks(ctx, c, cluster, instanceGroups)
+	default:
+		klog.V(2).Infof("quotas are not checked on cloud provider %q", cloud.ProviderID())
+	}
+	sortChecks(checks)
+	return checks
+}
+
+func sortChecks(checks []*Check) {
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Name < checks[j].Name
+	})
+}
+
+// Verify returns an error listing the quotas that the cluster would exceed
+func Verify(ctx context.Context, cloud fi.Cloud, cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup) error {
+	var exceeded []string
+	for _, check := range Checks(ctx, cloud, cluster, instanceGroups) {
+		klog.V(2).Infof("quota %s", check)
+		if check.Exceeded() {
+			exceeded = append(exceeded, "  "+check.String())
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the cluster would exceed %d quota(s) of the cloud account, which must be raised first:\n%s", len(exceeded), strings.Join(exceeded, "\n"))
+}
+
+// instanceGroupsWithInstances returns the instance groups whose instances are created by kOps, with their minimum size
+func instanceGroupsWithInstances(instanceGroups []*kops.InstanceGroup) map[*kops.InstanceGroup]int64 {
+	sizes := make(map[*kops.InstanceGroup]int64)
+	for _, ig := range instanceGroups {
+		if ig.Spec.Manager == kops.InstanceManagerKarpenter {
+			continue
+		}
+		sizes[ig] = int64(fi.ValueOf(ig.Spec.MinSize))
+	}
+	return sizes
+}
+
+// primaryMachineType returns the first machine type of an instance group
+func primaryMachineType(ig *kops.InstanceGroup) string {
+	machineType, _, _ := strings.Cut(ig.Spec.MachineType, ",")
+	return strings.TrimSpace(machineType)
+}
+
+// checks accumulates the usage of quotas, by name
+type checks map[string]*Check
+
+func (c checks) get(name string) *Check {
+	check := c[name]
+	if check == nil {
+		check = &Check{Name: name}
+		c[name] = check
+	}
+	return check
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
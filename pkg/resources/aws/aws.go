@@ -76,6 +76,7 @@ func ListResourcesAWS(cloud awsup.AWSCloud, clusterInfo resources.ClusterInfo) (
 		ListDhcpOptions,
 		ListInternetGateways,
 		ListEgressOnlyInternetGateways,
+		ListClientVPNEndpoints,
 		ListRouteTables,
 		ListSubnets,
 		ListENIs,
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/pkg/resources"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+const TypeClientVPNEndpoint = "client-vpn-endpoint"
+
+func DumpClientVPNEndpoint(op *resources.DumpOperation, r *resources.Resource) error {
+	data := make(map[string]interface{})
+	data["id"] = r.ID
+	data["type"] = r.Type
+	data["raw"] = r.Obj
+	op.Dump.Resources = append(op.Dump.Resources, data)
+	return nil
+}
+
+// DeleteClientVPNEndpoint disassociates the target networks of a Client VPN endpoint, then deletes it.
+// Disassociation is asynchronous, so the deletion is retried until no target network is left.
+func DeleteClientVPNEndpoint(cloud fi.Cloud, r *resources.Resource) error {
+	ctx := context.TODO()
+	c := cloud.(awsup.AWSCloud)
+
+	id := r.ID
+
+	networks, err := describeClientVPNTargetNetworks(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	pending := 0
+	for _, network := range networks {
+		if network.Status != nil && network.Status.Code == ec2types.AssociationStatusCodeDisassociated {
+			continue
+		}
+		pending++
+		if network.Status != nil && network.Status.Code == ec2types.AssociationStatusCodeDisassociating {
+			continue
+		}
+
+		klog.V(2).Infof("Disassociating subnet %q from Client VPN endpoint %q", aws.ToString(network.TargetNetworkId), id)
+		request := &ec2.DisassociateClientVpnTargetNetworkInput{
+			ClientVpnEndpointId: aws.String(id),
+			AssociationId:       network.AssociationId,
+		}
+		if _, err := c.EC2().DisassociateClientVpnTargetNetwork(ctx, request); err != nil {
+			return fmt.Errorf("error disassociating subnet %q from Client VPN endpoint %q: %w", aws.ToString(network.TargetNetworkId), id, err)
+		}
+	}
+	if pending != 0 {
+		networks, err = describeClientVPNTargetNetworks(ctx, c, id)
+		if err != nil {
+			return err
+		}
+		for _, network := range networks {
+			if network.Status == nil || network.Status.Code != ec2types.AssociationStatusCodeDisassociated {
+				return fmt.Errorf("waiting for the target networks of Client VPN endpoint %q to be disassociated", id)
+			}
+		}
+	}
+
+	klog.V(2).Infof("Deleting Client VPN endpoint %q", id)
+	request := &ec2.DeleteClientVpnEndpointInput{
+		ClientVpnEndpointId: aws.String(id),
+	}
+	if _, err := c.EC2().DeleteClientVpnEndpoint(ctx, request); err != nil {
+		if awsup.AWSErrorCode(err) == "InvalidClientVpnEndpointId.NotFound" {
+			klog.Infof("Client VPN endpoint %q not found; assuming already deleted", id)
+			return nil
+		}
+		if IsDependencyViolation(err) {
+			return err
+		}
+		return fmt.Errorf("error deleting Client VPN endpoint %q: %w", id, err)
+	}
+
+	return nil
+}
+
+func describeClientVPNTargetNetworks(ctx context.Context, c awsup.AWSCloud, endpointID string) ([]ec2types.TargetNetwork, error) {
+	var networks []ec2types.TargetNetwork
+	request := &ec2.DescribeClientVpnTargetNetworksInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+	}
+	paginator := ec2.NewDescribeClientVpnTargetNetworksPaginator(c.EC2(), request)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing target networks of Client VPN endpoint %q: %w", endpointID, err)
+		}
+		networks = append(networks, page.ClientVpnTargetNetworks...)
+	}
+	return networks, nil
+}
+
+func ListClientVPNEndpoints(cloud fi.Cloud, vpcID, clusterName string) ([]*resources.Resource, error) {
+	ctx := context.TODO()
+	c := cloud.(awsup.AWSCloud)
+
+	klog.V(2).Infof("Listing Client VPN endpoints")
+
+	clusterTags := c.Tags()
+
+	var resourceTrackers []*resources.Resource
+	paginator := ec2.NewDescribeClientVpnEndpointsPaginator(c.EC2(), &ec2.DescribeClientVpnEndpointsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Client VPN endpoints: %w", err)
+		}
+		for _, endpoint := range page.ClientVpnEndpoints {
+			if endpoint.Status != nil && endpoint.Status.Code == ec2types.ClientVpnEndpointStatusCodeDeleted {
+				continue
+			}
+			// Client VPN endpoints cannot be filtered by tag
+			matches := true
+			for k, v := range clusterTags {
+				if value, found := awsup.FindEC2Tag(endpoint.Tags, k); !found || value != v {
+					matches = false
+				}
+			}
+			if !matches {
+				continue
+			}
+
+			id := aws.ToString(endpoint.ClientVpnEndpointId)
+			resourceTracker := &resources.Resource{
+				Name:    FindName(endpoint.Tags),
+				ID:      id,
+				Type:    TypeClientVPNEndpoint,
+				Obj:     endpoint,
+				Dumper:  DumpClientVPNEndpoint,
+				Deleter: DeleteClientVPNEndpoint,
+				Shared:  HasSharedTag(TypeClientVPNEndpoint+":"+id, endpoint.Tags, clusterName),
+			}
+
+			var blocks []string
+			for _, sg := range endpoint.SecurityGroupIds {
+				blocks = append(blocks, "security-group:"+sg)
+			}
+			networks, err := describeClientVPNTargetNetworks(ctx, c, id)
+			if err != nil {
+				return nil, err
+			}
+			for _, network := range networks {
+				blocks = append(blocks, "subnet:"+aws.ToString(network.TargetNetworkId))
+			}
+			if endpoint.VpcId != nil {
+				blocks = append(blocks, "vpc:"+aws.ToString(endpoint.VpcId))
+			}
+			resourceTracker.Blocks = blocks
+
+			resourceTrackers = append(resourceTrackers, resourceTracker)
+		}
+	}
+
+	return resourceTrackers, nil
+}
@@ -0,0 +1,456 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshot records named points of a cluster in the state store: the cluster and instance group
+// specs, the versions of the addons and a copy of the etcd backups taken at that point, so that
+// kops snapshot restore can bring the cluster back to them.
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"k8s.io/kops"
+	channelsapi "k8s.io/kops/channels/pkg/api"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/kopscodecs"
+	"k8s.io/kops/util/pkg/text"
+	"k8s.io/kops/util/pkg/vfs"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// snapshotsDir is the directory of the snapshots, below the config base of the cluster
+	snapshotsDir = "snapshots"
+	// metadataFile describes a snapshot; it is written last, so that only complete snapshots are listed
+	metadataFile = "snapshot.yaml"
+	// specsFile holds the cluster and instance group manifests
+	specsFile = "specs.yaml"
+	// etcdDir holds the copies of the etcd backups
+	etcdDir = "etcd"
+
+	// etcdBackupMetaFile marks a backup in the backup store of etcd-manager
+	etcdBackupMetaFile = "_etcd_backup.meta"
+	// etcdControlDir is the directory of the commands of etcd-manager, below its backup store
+	etcdControlDir = "control"
+	// etcdClusterSpecFile is the spec of the etcd cluster written by kops update cluster, below etcdControlDir
+	etcdClusterSpecFile = "etcd-cluster-spec"
+	// etcdCommandFile is the name of a command to etcd-manager
+	etcdCommandFile = "_command.json"
+)
+
+// Snapshot describes a named point of a cluster.
+type Snapshot struct {
+	// Name is the name of the snapshot.
+	Name string `json:"name"`
+	// ClusterName is the name of the cluster.
+	ClusterName string `json:"clusterName"`
+	// CreationTimestamp is when the snapshot was taken.
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// KopsVersion is the version of kOps which took the snapshot, which determines the versions of the addons.
+	KopsVersion string `json:"kopsVersion"`
+	// KubernetesVersion is the Kubernetes version of the cluster.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// Addons are the addons of the bootstrap channel.
+	Addons []Addon `json:"addons,omitempty"`
+	// EtcdBackups are the etcd backups copied into the snapshot.
+	EtcdBackups []EtcdBackup `json:"etcdBackups,omitempty"`
+}
+
+// Addon is the version of an addon of the bootstrap channel.
+type Addon struct {
+	Name         string `json:"name"`
+	Version      string `json:"version,omitempty"`
+	ManifestHash string `json:"manifestHash,omitempty"`
+}
+
+// EtcdBackup is a backup of an etcd cluster taken by etcd-manager.
+type EtcdBackup struct {
+	// EtcdCluster is the name of the etcd cluster, such as main or events.
+	EtcdCluster string `json:"etcdCluster"`
+	// Backup is the name of the backup in the backup store of the etcd cluster.
+	Backup string `json:"backup"`
+	// Timestamp is when the backup was taken, if it can be parsed from its name.
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
+// Store reads and writes the snapshots of a cluster.
+type Store struct {
+	clientset simple.Clientset
+	cluster   *kopsapi.Cluster
+	base      vfs.Path
+}
+
+// NewStore is the constructor for the Store of the snapshots of a cluster.
+func NewStore(clientset simple.Clientset, cluster *kopsapi.Cluster) (*Store, error) {
+	configBase, err := clientset.ConfigBaseFor(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("error building config base for cluster %q: %w", cluster.Name, err)
+	}
+	return &Store{
+		clientset: clientset,
+		cluster:   cluster,
+		base:      configBase.Join(snapshotsDir),
+	}, nil
+}
+
+// Create takes a snapshot of the cluster, copying the latest backup of each etcd cluster.
+func (s *Store) Create(ctx context.Context, name string) (*Snapshot, error) {
+	if errs := utilvalidation.IsDNS1123Label(name); len(errs) != 0 {
+		return nil, fmt.Errorf("invalid snapshot name %q: %s", name, strings.Join(errs, ", "))
+	}
+	dir := s.base.Join(name)
+	if _, err := dir.Join(metadataFile).ReadFile(ctx); err == nil {
+		return nil, fmt.Errorf("snapshot %q already exists", name)
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading snapshot %q: %w", name, err)
+	}
+
+	snapshot := &Snapshot{
+		Name:              name,
+		ClusterName:       s.cluster.Name,
+		CreationTimestamp: metav1.Now(),
+		KopsVersion:       kops.Version,
+		KubernetesVersion: s.cluster.Spec.KubernetesVersion,
+	}
+
+	igs, err := s.clientset.InstanceGroupsFor(s.cluster).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instance groups: %w", err)
+	}
+	objects := []runtime.Object{s.cluster}
+	for i := range igs.Items {
+		objects = append(objects, &igs.Items[i])
+	}
+	var specs bytes.Buffer
+	for i, o := range objects {
+		y, err := kopscodecs.ToVersionedYaml(o)
+		if err != nil {
+			return nil, fmt.Errorf("error serializing %T: %w", o, err)
+		}
+		if i != 0 {
+			specs.WriteString("\n---\n\n")
+		}
+		specs.Write(y)
+	}
+
+	snapshot.Addons, err = s.Addons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, etcdCluster := range s.cluster.Spec.EtcdClusters {
+		backupStore, err := s.backupStore(etcdCluster.Name)
+		if err != nil {
+			return nil, err
+		}
+		backup, err := LatestEtcdBackup(ctx, backupStore)
+		if err != nil {
+			return nil, err
+		}
+		if backup == "" {
+			return nil, fmt.Errorf("no backup of etcd cluster %q found in %s", etcdCluster.Name, backupStore)
+		}
+		if err := copyDir(ctx, backupStore.Join(backup), dir.Join(etcdDir, etcdCluster.Name, backup)); err != nil {
+			return nil, fmt.Errorf("error copying backup %q of etcd cluster %q: %w", backup, etcdCluster.Name, err)
+		}
+		snapshot.EtcdBackups = append(snapshot.EtcdBackups, EtcdBackup{
+			EtcdCluster: etcdCluster.Name,
+			Backup:      backup,
+			Timestamp:   backupTimestamp(backup),
+		})
+	}
+
+	if err := dir.Join(specsFile).WriteFile(ctx, bytes.NewReader(specs.Bytes()), nil); err != nil {
+		return nil, fmt.Errorf("error writing snapshot %q: %w", name, err)
+	}
+	metadata, err := yaml.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing snapshot %q: %w", name, err)
+	}
+	if err := dir.Join(metadataFile).WriteFile(ctx, bytes.NewReader(metadata), nil); err != nil {
+		return nil, fmt.Errorf("error writing snapshot %q: %w", name, err)
+	}
+
+	return snapshot, nil
+}
+
+// List returns the snapshots of the cluster, oldest first.
+func (s *Store) List(ctx context.Context) ([]*Snapshot, error) {
+	files, err := s.base.ReadTree(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error listing snapshots: %w", err)
+	}
+
+	var snapshots []*Snapshot
+	for _, f := range files {
+		if f.Base() != metadataFile {
+			continue
+		}
+		snapshot, err := readMetadata(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if !snapshots[i].CreationTimestamp.Equal(&snapshots[j].CreationTimestamp) {
+			return snapshots[i].CreationTimestamp.Before(&snapshots[j].CreationTimestamp)
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return snapshots, nil
+}
+
+// Get returns a snapshot of the cluster.
+func (s *Store) Get(ctx context.Context, name string) (*Snapshot, error) {
+	snapshot, err := readMetadata(ctx, s.base.Join(name, metadataFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("snapshot %q of cluster %q not found", name, s.cluster.Name)
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Specs returns the cluster and instance groups recorded in a snapshot.
+func (s *Store) Specs(ctx context.Context, snapshot *Snapshot) (*kopsapi.Cluster, []*kopsapi.InstanceGroup, error) {
+	data, err := s.base.Join(snapshot.Name, specsFile).ReadFile(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading snapshot %q: %w", snapshot.Name, err)
+	}
+
+	var cluster *kopsapi.Cluster
+	var igs []*kopsapi.InstanceGroup
+	for _, section := range text.SplitContentToSections(data) {
+		o, _, err := kopscodecs.Decode(section, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing snapshot %q: %w", snapshot.Name, err)
+		}
+		switch v := o.(type) {
+		case *kopsapi.Cluster:
+			cluster = v
+		case *kopsapi.InstanceGroup:
+			igs = append(igs, v)
+		default:
+			return nil, nil, fmt.Errorf("unexpected object %T in snapshot %q", o, snapshot.Name)
+		}
+	}
+	if cluster == nil {
+		return nil, nil, fmt.Errorf("snapshot %q does not hold a cluster", snapshot.Name)
+	}
+	return cluster, igs, nil
+}
+
+// RestoreEtcd asks etcd-manager to restore the etcd backups of a snapshot, copying them back into the
+// backup stores if they have since been removed. The restore starts when etcd-manager next starts.
+func (s *Store) RestoreEtcd(ctx context.Context, snapshot *Snapshot) error {
+	for _, backup := range snapshot.EtcdBackups {
+		backupStore, err := s.backupStore(backup.EtcdCluster)
+		if err != nil {
+			return err
+		}
+
+		if _, err := backupStore.Join(backup.Backup, etcdBackupMetaFile).ReadFile(ctx); err != nil {
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("error reading backup %q of etcd cluster %q: %w", backup.Backup, backup.EtcdCluster, err)
+			}
+			klog.Infof("copying backup %q of etcd cluster %q back into %s", backup.Backup, backup.EtcdCluster, backupStore)
+			if err := copyDir(ctx, s.base.Join(snapshot.Name, etcdDir, backup.EtcdCluster, backup.Backup), backupStore.Join(backup.Backup)); err != nil {
+				return fmt.Errorf("error copying backup %q of etcd cluster %q: %w", backup.Backup, backup.EtcdCluster, err)
+			}
+		}
+
+		clusterSpec, err := ReadEtcdClusterSpec(ctx, backupStore)
+		if err != nil {
+			return fmt.Errorf("error reading the spec of etcd cluster %q: %w", backup.EtcdCluster, err)
+		}
+		if err := RequestEtcdRestore(ctx, backupStore, clusterSpec, backup.Backup); err != nil {
+			return fmt.Errorf("error writing restore command for etcd cluster %q: %w", backup.EtcdCluster, err)
+		}
+	}
+	return nil
+}
+
+// ReadEtcdClusterSpec returns the spec of an etcd cluster, as written to its backup store by kops update cluster.
+func ReadEtcdClusterSpec(ctx context.Context, backupStore vfs.Path) ([]byte, error) {
+	return backupStore.Join(etcdControlDir, etcdClusterSpecFile).ReadFile(ctx)
+}
+
+// RequestEtcdRestore asks etcd-manager to restore a backup of its backup store, the next time it starts.
+// clusterSpec is the spec of the etcd cluster, as returned by ReadEtcdClusterSpec.
+func RequestEtcdRestore(ctx context.Context, backupStore vfs.Path, clusterSpec []byte, backup string) error {
+	now := time.Now().UTC()
+	command, err := json.Marshal(&etcdCommand{
+		Timestamp: now.UnixNano(),
+		RestoreBackup: &etcdRestoreBackupCommand{
+			ClusterSpec: json.RawMessage(clusterSpec),
+			Backup:      backup,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	p := backupStore.Join(etcdControlDir, now.Format(time.RFC3339Nano), etcdCommandFile)
+	return p.WriteFile(ctx, bytes.NewReader(command), nil)
+}
+
+// etcdCommand is a command to etcd-manager, as written by etcd-manager-ctl.
+type etcdCommand struct {
+	Timestamp     int64                     `json:"timestamp,string"`
+	RestoreBackup *etcdRestoreBackupCommand `json:"restoreBackup,omitempty"`
+}
+
+type etcdRestoreBackupCommand struct {
+	ClusterSpec json.RawMessage `json:"clusterSpec"`
+	Backup      string          `json:"backup"`
+}
+
+// backupStore returns the backup store of an etcd cluster.
+func (s *Store) backupStore(etcdClusterName string) (vfs.Path, error) {
+	for _, etcdCluster := range s.cluster.Spec.EtcdClusters {
+		if etcdCluster.Name != etcdClusterName {
+			continue
+		}
+		if etcdCluster.Backups == nil || etcdCluster.Backups.BackupStore == "" {
+			return nil, fmt.Errorf("etcd cluster %q does not have a backupStore", etcdClusterName)
+		}
+		return s.clientset.VFSContext().BuildVfsPath(etcdCluster.Backups.BackupStore)
+	}
+	return nil, fmt.Errorf("etcd cluster %q not found in cluster %q", etcdClusterName, s.cluster.Name)
+}
+
+// Addons returns the current addons of the bootstrap channel, if it has been written.
+func (s *Store) Addons(ctx context.Context) ([]Addon, error) {
+	configBase, err := s.clientset.ConfigBaseFor(s.cluster)
+	if err != nil {
+		return nil, err
+	}
+	data, err := configBase.Join("addons", "bootstrap-channel.yaml").ReadFile(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading bootstrap channel: %w", err)
+	}
+	channel := &channelsapi.Addons{}
+	if err := yaml.Unmarshal(data, channel); err != nil {
+		return nil, fmt.Errorf("error parsing bootstrap channel: %w", err)
+	}
+
+	var addons []Addon
+	for _, spec := range channel.Spec.Addons {
+		if spec.Name == nil {
+			continue
+		}
+		addons = append(addons, Addon{Name: *spec.Name, Version: spec.Version, ManifestHash: spec.ManifestHash})
+	}
+	sort.Slice(addons, func(i, j int) bool { return addons[i].Name < addons[j].Name })
+	return addons, nil
+}
+
+func readMetadata(ctx context.Context, p vfs.Path) (*Snapshot, error) {
+	data, err := p.ReadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{}
+	if err := yaml.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", p, err)
+	}
+	return snapshot, nil
+}
+
+// LatestEtcdBackup returns the name of the newest backup in the backup store of etcd-manager, or "" if there is none.
+// Backups are named by the time they were taken, so the newest sorts last.
+func LatestEtcdBackup(ctx context.Context, backupStore vfs.Path) (string, error) {
+	files, err := backupStore.ReadTree(ctx)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error listing backups in %s: %w", backupStore, err)
+	}
+
+	prefix := strings.TrimSuffix(backupStore.Path(), "/") + "/"
+	var backups []string
+	for _, f := range files {
+		relative := strings.TrimPrefix(f.Path(), prefix)
+		if path.Base(relative) != etcdBackupMetaFile || path.Dir(relative) == "." || strings.Contains(path.Dir(relative), "/") {
+			continue
+		}
+		backups = append(backups, path.Dir(relative))
+	}
+	if len(backups) == 0 {
+		return "", nil
+	}
+	sort.Strings(backups)
+	return backups[len(backups)-1], nil
+}
+
+// backupTimestamp parses the time a backup was taken from its name, such as 2026-01-02T15:04:05Z-000001.
+func backupTimestamp(backup string) *metav1.Time {
+	i := strings.LastIndexByte(backup, '-')
+	if i == -1 {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, backup[:i])
+	if err != nil {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
+
+// CopyEtcdBackup copies a backup from the backup store of an etcd cluster to another.
+func CopyEtcdBackup(ctx context.Context, src vfs.Path, dest vfs.Path, backup string) error {
+	return copyDir(ctx, src.Join(backup), dest.Join(backup))
+}
+
+// copyDir copies the files below src to dest.
+func copyDir(ctx context.Context, src vfs.Path, dest vfs.Path) error {
+	files, err := src.ReadTree(ctx)
+	if err != nil {
+		return err
+	}
+	prefix := strings.TrimSuffix(src.Path(), "/") + "/"
+	for _, f := range files {
+		data, err := f.ReadFile(ctx)
+		if err != nil {
+			return err
+		}
+		relative := strings.TrimPrefix(f.Path(), prefix)
+		if err := dest.Join(relative).WriteFile(ctx, bytes.NewReader(data), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func writeFile(t *testing.T, p vfs.Path, contents string) {
+	t.Helper()
+	if err := p.WriteFile(context.Background(), bytes.NewReader([]byte(contents)), nil); err != nil {
+		t.Fatalf("error writing %s: %v", p, err)
+	}
+}
+
+func readFile(t *testing.T, p vfs.Path) string {
+	t.Helper()
+	data, err := p.ReadFile(context.Background())
+	if err != nil {
+		t.Fatalf("error reading %s: %v", p, err)
+	}
+	return string(data)
+}
+
+func TestCreateAndRestore(t *testing.T) {
+	ctx := context.Background()
+
+	vfs.Context.ResetMemfsContext(true)
+	basePath, err := vfs.Context.BuildVfsPath("memfs://snapshot-tests")
+	if err != nil {
+		t.Fatalf("error building vfs path: %v", err)
+	}
+	clientset := vfsclientset.NewVFSClientset(vfs.Context, basePath)
+
+	cluster := testutils.BuildMinimalCluster("test.k8s.io")
+	cluster.Spec.ConfigStore.Base = "memfs://snapshot-tests/test.k8s.io"
+	cluster.Spec.KubernetesVersion = "1.33.0"
+	backupStores := make(map[string]vfs.Path)
+	for i := range cluster.Spec.EtcdClusters {
+		etcdCluster := &cluster.Spec.EtcdClusters[i]
+		etcdCluster.Backups = &kopsapi.EtcdBackupSpec{BackupStore: "memfs://snapshot-tests/test.k8s.io/backups/etcd/" + etcdCluster.Name}
+		backupStores[etcdCluster.Name] = basePath.Join("test.k8s.io", "backups", "etcd", etcdCluster.Name)
+	}
+
+	configBase := basePath.Join("test.k8s.io")
+	writeFile(t, configBase.Join("instancegroup", "nodes"), `apiVersion: kops.k8s.io/v1alpha2
+kind: InstanceGroup
+metadata:
+  name: nodes
+  labels:
+    kops.k8s.io/cluster: test.k8s.io
+spec:
+  role: Node
+  minSize: 2
+  maxSize: 2
+  subnets:
+  - subnet-us-test-1a
+`)
+
+	writeFile(t, configBase.Join("addons", "bootstrap-channel.yaml"), `kind: Addons
+metadata:
+  name: bootstrap
+spec:
+  addons:
+  - name: kops-controller.addons.k8s.io
+    version: 9.99.0
+    manifestHash: abc
+  - name: coredns.addons.k8s.io
+    version: 9.99.0
+    manifestHash: def
+`)
+
+	for name, backupStore := range backupStores {
+		writeFile(t, backupStore.Join("control", "etcd-cluster-spec"), `{"memberCount":3,"etcdVersion":"3.5.21"}`)
+		for _, backup := range []string{"2026-10-15T08:00:00Z-000001", "2026-10-15T08:15:00Z-000002"} {
+			writeFile(t, backupStore.Join(backup, "_etcd_backup.meta"), `{}`)
+			writeFile(t, backupStore.Join(backup, "etcd.backup.gz"), name+"/"+backup)
+		}
+	}
+
+	store, err := NewStore(clientset, cluster)
+	if err != nil {
+		t.Fatalf("error building store: %v", err)
+	}
+
+	snapshot, err := store.Create(ctx, "before-upgrade")
+	if err != nil {
+		t.Fatalf("error creating snapshot: %v", err)
+	}
+	if _, err := store.Create(ctx, "before-upgrade"); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected error creating a snapshot twice, got %v", err)
+	}
+	if _, err := store.Create(ctx, "Not_Valid"); err == nil {
+		t.Errorf("expected error creating a snapshot with an invalid name")
+	}
+
+	if len(snapshot.Addons) != 2 || snapshot.Addons[0].Name != "coredns.addons.k8s.io" || snapshot.Addons[0].ManifestHash != "def" {
+		t.Errorf("unexpected addons %+v", snapshot.Addons)
+	}
+	if len(snapshot.EtcdBackups) != 2 {
+		t.Fatalf("unexpected etcd backups %+v", snapshot.EtcdBackups)
+	}
+	for _, backup := range snapshot.EtcdBackups {
+		if backup.Backup != "2026-10-15T08:15:00Z-000002" {
+			t.Errorf("expected the latest backup of etcd cluster %q, got %q", backup.EtcdCluster, backup.Backup)
+		}
+		if backup.Timestamp == nil || backup.Timestamp.UTC().Format("15:04") != "08:15" {
+			t.Errorf("unexpected timestamp %v of backup %q", backup.Timestamp, backup.Backup)
+		}
+	}
+
+	snapshots, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("error listing snapshots: %v", err)
+	}
+	if len(snapshots) != 1 || snapshots[0].Name != "before-upgrade" || snapshots[0].ClusterName != "test.k8s.io" {
+		t.Errorf("unexpected snapshots %+v", snapshots)
+	}
+
+	restoredCluster, restoredIGs, err := store.Specs(ctx, snapshot)
+	if err != nil {
+		t.Fatalf("error reading specs: %v", err)
+	}
+	if restoredCluster.Spec.KubernetesVersion != "1.33.0" {
+		t.Errorf("unexpected Kubernetes version %q", restoredCluster.Spec.KubernetesVersion)
+	}
+	if len(restoredIGs) != 1 || restoredIGs[0].Name != "nodes" || restoredIGs[0].Spec.MinSize == nil || *restoredIGs[0].Spec.MinSize != 2 {
+		t.Errorf("unexpected instance groups %+v", restoredIGs)
+	}
+
+	// The backup of the main cluster has been removed by the retention of etcd-manager since the snapshot
+	if err := backupStores["main"].Join("2026-10-15T08:15:00Z-000002").RemoveAll(ctx); err != nil {
+		t.Fatalf("error removing backup: %v", err)
+	}
+
+	if err := store.RestoreEtcd(ctx, snapshot); err != nil {
+		t.Fatalf("error restoring etcd: %v", err)
+	}
+	if got := readFile(t, backupStores["main"].Join("2026-10-15T08:15:00Z-000002", "etcd.backup.gz")); got != "main/2026-10-15T08:15:00Z-000002" {
+		t.Errorf("unexpected restored backup %q", got)
+	}
+	for name, backupStore := range backupStores {
+		files, err := backupStore.Join("control").ReadTree(ctx)
+		if err != nil {
+			t.Fatalf("error listing commands: %v", err)
+		}
+		var commands []string
+		for _, f := range files {
+			if f.Base() == "_command.json" {
+				commands = append(commands, readFile(t, f))
+			}
+		}
+		if len(commands) != 1 {
+			t.Fatalf("expected one command for etcd cluster %q, got %q", name, commands)
+		}
+		command := &etcdCommand{}
+		if err := json.Unmarshal([]byte(commands[0]), command); err != nil {
+			t.Fatalf("error parsing command %q: %v", commands[0], err)
+		}
+		if command.RestoreBackup == nil || command.RestoreBackup.Backup != "2026-10-15T08:15:00Z-000002" || string(command.RestoreBackup.ClusterSpec) != `{"memberCount":3,"etcdVersion":"3.5.21"}` {
+			t.Errorf("unexpected command %q", commands[0])
+		}
+	}
+
+	if _, err := store.Get(ctx, "missing"); err == nil {
+		t.Errorf("expected error getting a missing snapshot")
+	}
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package subnetcapacity estimates whether the subnets of a cluster have enough IP addresses
+// for its instance groups to scale to their maximum size.
+package subnetcapacity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+const (
+	// defaultMaxPods is the default number of pods of the kubelet
+	defaultMaxPods = 110
+	// prefixSize is the number of addresses of the /28 prefixes assigned to the ENIs in prefix delegation mode
+	prefixSize = 16
+	// defaultNodeCIDRMaskSize is the default size of the pod range of each node
+	defaultNodeCIDRMaskSize = 24
+)
+
+// Subnet is the IP address capacity of a subnet, versus the instance groups placed in it.
+type Subnet struct {
+	// Name is the name of the subnet in the cluster spec
+	Name string
+	// Usable is the number of addresses of the subnet which can be assigned to instances
+	Usable int64
+	// Required is the number of addresses used when all the instance groups are at their maximum size
+	Required int64
+	// Headroom is the number of addresses needed to scale the instance groups from their current to their maximum size
+	Headroom int64
+	// MaxNodes is the number of nodes in the subnet when all the instance groups are at their maximum size
+	MaxNodes int64
+	// AliasRangeNodes is the number of nodes the pod IP alias range can hold, or 0 if the cluster doesn't use IP aliases
+	AliasRangeNodes int64
+	// Free is the number of addresses that are not in use, if known
+	Free *int64
+}
+
+// Warnings describes the ways the subnet can't hold its instance groups at their maximum size
+func (s *Subnet) Warnings() []string {
+	var warnings []string
+	if s.Required > s.Usable {
+		warnings = append(warnings, fmt.Sprintf("subnet %q has %d usable IP addresses, but its instance groups use up to %d at their maximum size", s.Name, s.Usable, s.Required))
+	} else if s.Free != nil && s.Headroom > *s.Free {
+		warnings = append(warnings, fmt.Sprintf("subnet %q has %d free IP addresses, but scaling its instance groups to their maximum size needs %d", s.Name, *s.Free, s.Headroom))
+	}
+	if s.AliasRangeNodes != 0 && s.MaxNodes > s.AliasRangeNodes {
+		warnings = append(warnings, fmt.Sprintf("the pod IP range of subnet %q has room for %d nodes, but its instance groups have up to %d", s.Name, s.AliasRangeNodes, s.MaxNodes))
+	}
+	return warnings
+}
+
+// NodeAddresses returns the number of subnet addresses used by each node of an instance group
+type NodeAddresses func(ig *kops.InstanceGroup) (int64, error)
+
+// Compute returns the capacity of the IPv4 subnets of the cluster which have instance groups.
+// currentSizes holds the current number of instances of the instance groups, by name;
+// instance groups which are missing are assumed to have no instances.
+func Compute(cluster *kops.Cluster, instanceGroups []*kops.InstanceGroup, currentSizes map[string]int, nodeAddresses NodeAddresses) ([]*Subnet, error) {
+	subnets := make(map[string]*Subnet)
+	for _, ig := range instanceGroups {
+		if ig.Spec.Manager == kops.InstanceManagerKarpenter || len(ig.Spec.Subnets) == 0 {
+			continue
+		}
+
+		perNode, err := nodeAddresses(ig)
+		if err != nil {
+			return nil, err
+		}
+
+		n := int64(len(ig.Spec.Subnets))
+		maxSize := int64(fi.ValueOf(ig.Spec.MaxSize))
+		current := int64(currentSizes[ig.Name])
+		maxNodes := ceilDiv(maxSize, n)
+		headroom := int64(0)
+		if maxSize > current {
+			headroom = ceilDiv(maxSize-current, n)
+		}
+
+		for _, name := range ig.Spec.Subnets {
+			s := subnets[name]
+			if s == nil {
+				s = &Subnet{Name: name}
+				subnets[name] = s
+			}
+			s.MaxNodes += maxNodes
+			s.Required += maxNodes * perNode
+			s.Headroom += headroom * perNode
+		}
+	}
+
+	var aliasRangeNodes int64
+	if gce.UsesIPAliases(cluster) && cluster.Spec.Networking.PodCIDR != "" {
+		_, podCIDR, err := net.ParseCIDR(cluster.Spec.Networking.PodCIDR)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing pod CIDR %q: %w", cluster.Spec.Networking.PodCIDR, err)
+		}
+		nodeMaskSize := int32(defaultNodeCIDRMaskSize)
+		if cluster.Spec.KubeControllerManager != nil && cluster.Spec.KubeControllerManager.NodeCIDRMaskSize != nil {
+			nodeMaskSize = *cluster.Spec.KubeControllerManager.NodeCIDRMaskSize
+		}
+		ones, _ := podCIDR.Mask.Size()
+		if int(nodeMaskSize) >= ones {
+			aliasRangeNodes = int64(1) << (int(nodeMaskSize) - ones)
+		}
+	}
+
+	var result []*Subnet
+	for i := range cluster.Spec.Networking.Subnets {
+		subnet := &cluster.Spec.Networking.Subnets[i]
+		s := subnets[subnet.Name]
+		if s == nil || subnet.CIDR == "" {
+			// IPv6-only subnets don't run out of addresses
+			continue
+		}
+
+		_, cidr, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing CIDR %q of subnet %q: %w", subnet.CIDR, subnet.Name, err)
+		}
+		ones, bits := cidr.Mask.Size()
+		if bits != 32 {
+			continue
+		}
+		s.Usable = int64(1)<<(bits-ones) - reservedAddresses(cluster.GetCloudProvider())
+		s.AliasRangeNodes = aliasRangeNodes
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// reservedAddresses returns the number of addresses of a subnet that the cloud doesn't assign to instances
+func reservedAddresses(cloudProvider kops.CloudProviderID) int64 {
+	switch cloudProvider {
+	case kops.CloudProviderAWS, kops.CloudProviderAzure:
+		return 5
+	case kops.CloudProviderGCE:
+		return 4
+	default:
+		// The network and broadcast addresses
+		return 2
+	}
+}
+
+// NodeAddressesFor returns the number of subnet addresses used by the nodes of the cluster.
+// On AWS, nodes using the VPC CNI or Cilium in ENI mode draw the addresses of their pods from the subnet.
+func NodeAddressesFor(cluster *kops.Cluster, cloud fi.Cloud) NodeAddresses {
+	networking := &cluster.Spec.Networking
+	awsCloud, isAWS := cloud.(awsup.AWSCloud)
+	usesENIs := networking.AmazonVPC != nil || (networking.Cilium != nil && networking.Cilium.IPAM == kops.CiliumIpamEni)
+	if !isAWS || !usesENIs || cluster.Spec.IsIPv6Only() {
+		return func(ig *kops.InstanceGroup) (int64, error) {
+			return 1, nil
+		}
+	}
+
+	prefixDelegation := false
+	if networking.AmazonVPC != nil {
+		for _, e := range networking.AmazonVPC.Env {
+			if e.Name == "ENABLE_PREFIX_DELEGATION" && e.Value == "true" {
+				prefixDelegation = true
+			}
+		}
+	}
+
+	return func(ig *kops.InstanceGroup) (int64, error) {
+		if prefixDelegation {
+			maxPods := int64(defaultMaxPods)
+			if ig.Spec.Kubelet != nil && ig.Spec.Kubelet.MaxPods != nil {
+				maxPods = int64(*ig.Spec.Kubelet.MaxPods)
+			} else if cluster.Spec.Kubelet != nil && cluster.Spec.Kubelet.MaxPods != nil {
+				maxPods = int64(*cluster.Spec.Kubelet.MaxPods)
+			}
+			return 1 + ceilDiv(maxPods, prefixSize)*prefixSize, nil
+		}
+
+		// Every ENI of the largest machine type can be filled with pod addresses
+		var addresses int64
+		for _, machineType := range machineTypes(ig) {
+			info, err := awsup.GetMachineTypeInfo(awsCloud, ec2types.InstanceType(machineType))
+			if err != nil {
+				return 0, fmt.Errorf("error getting the network limits of machine type %q: %w", machineType, err)
+			}
+			addresses = max(addresses, int64(info.InstanceENIs)*int64(info.InstanceIPsPerENI))
+		}
+		return max(addresses, 1), nil
+	}
+}
+
+// machineTypes returns the machine types that the instances of an instance group can have
+func machineTypes(ig *kops.InstanceGroup) []string {
+	var machineTypes []string
+	for _, machineType := range strings.Split(ig.Spec.MachineType, ",") {
+		if machineType = strings.TrimSpace(machineType); machineType != "" {
+			machineTypes = append(machineTypes, machineType)
+		}
+	}
+	if ig.Spec.MixedInstancesPolicy != nil {
+		machineTypes = append(machineTypes, ig.Spec.MixedInstancesPolicy.Instances...)
+	}
+	return machineTypes
+}
+
+// AddFreeAddresses sets the number of free addresses of the subnets, as reported by the cloud.
+// It is only supported on AWS; on other clouds the subnets are left unchanged.
+func AddFreeAddresses(ctx context.Context, cloud fi.Cloud, cluster *kops.Cluster, subnets []*Subnet) error {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok || len(subnets) == 0 {
+		return nil
+	}
+
+	// Shared subnets are found by ID, the others by the name kops gives them
+	byID := make(map[string]*Subnet)
+	byName := make(map[string]*Subnet)
+	for _, s := range subnets {
+		for i := range cluster.Spec.Networking.Subnets {
+			spec := &cluster.Spec.Networking.Subnets[i]
+			if spec.Name != s.Name {
+				continue
+			}
+			if spec.ID != "" {
+				byID[spec.ID] = s
+			} else {
+				byName[s.Name+"."+cluster.Name] = s
+			}
+		}
+	}
+
+	var requests []*ec2.DescribeSubnetsInput
+	if len(byID) != 0 {
+		ids := make([]string, 0, len(byID))
+		for id := range byID {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		requests = append(requests, &ec2.DescribeSubnetsInput{SubnetIds: ids})
+	}
+	if len(byName) != 0 {
+		requests = append(requests, &ec2.DescribeSubnetsInput{
+			Filters: []ec2types.Filter{awsup.NewEC2Filter("tag:"+awsup.TagClusterName, cluster.Name)},
+		})
+	}
+
+	for _, request := range requests {
+		paginator := ec2.NewDescribeSubnetsPaginator(awsCloud.EC2(), request)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return fmt.Errorf("error listing subnets: %w", err)
+			}
+			for _, subnet := range page.Subnets {
+				s := byID[aws.ToString(subnet.SubnetId)]
+				if s == nil {
+					name, _ := awsup.FindEC2Tag(subnet.Tags, "Name")
+					s = byName[name]
+				}
+				if s == nil || subnet.AvailableIpAddressCount == nil {
+					continue
+				}
+				s.Free = fi.PtrTo(int64(*subnet.AvailableIpAddressCount))
+			}
+		}
+	}
+	return nil
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnetcapacity
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+func testCluster() *kops.Cluster {
+	cluster := &kops.Cluster{}
+	cluster.Name = "minimal.example.com"
+	cluster.Spec.CloudProvider.AWS = &kops.AWSSpec{}
+	cluster.Spec.Networking.Subnets = []kops.ClusterSubnetSpec{
+		{Name: "us-test-1a", CIDR: "172.20.32.0/26", Zone: "us-test-1a"},
+		{Name: "us-test-1b", CIDR: "172.20.64.0/19", Zone: "us-test-1b"},
+		{Name: "us-test-1c", IPv6CIDR: "/64#1", Zone: "us-test-1c"},
+	}
+	return cluster
+}
+
+func testInstanceGroup(name string, maxSize int32, subnets ...string) *kops.InstanceGroup {
+	ig := &kops.InstanceGroup{}
+	ig.Name = name
+	ig.Spec.Role = kops.InstanceGroupRoleNode
+	ig.Spec.MachineType = "m5.large"
+	ig.Spec.MaxSize = fi.PtrTo(maxSize)
+	ig.Spec.Subnets = subnets
+	return ig
+}
+
+func TestCompute(t *testing.T) {
+	cluster := testCluster()
+	igs := []*kops.InstanceGroup{
+		testInstanceGroup("nodes-a", 4, "us-test-1a"),
+		testInstanceGroup("nodes-ab", 5, "us-test-1a", "us-test-1b", "us-test-1c"),
+	}
+	karpenter := testInstanceGroup("karpenter", 100, "us-test-1a")
+	karpenter.Spec.Manager = kops.InstanceManagerKarpenter
+	igs = append(igs, karpenter)
+
+	perNode := func(ig *kops.InstanceGroup) (int64, error) {
+		return 10, nil
+	}
+	subnets, err := Compute(cluster, igs, map[string]int{"nodes-a": 2}, perNode)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []*Subnet{
+		{Name: "us-test-1a", Usable: 59, Required: 60, Headroom: 40, MaxNodes: 6},
+		{Name: "us-test-1b", Usable: 8187, Required: 20, Headroom: 20, MaxNodes: 2},
+	}
+	if !reflect.DeepEqual(subnets, expected) {
+		t.Fatalf("unexpected subnets: %+v", subnets)
+	}
+
+	if warnings := subnets[0].Warnings(); !reflect.DeepEqual(warnings, []string{"subnet \"us-test-1a\" has 59 usable IP addresses, but its instance groups use up to 60 at their maximum size"}) {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	if warnings := subnets[1].Warnings(); len(warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	subnets[1].Free = fi.PtrTo(int64(15))
+	if warnings := subnets[1].Warnings(); !reflect.DeepEqual(warnings, []string{"subnet \"us-test-1b\" has 15 free IP addresses, but scaling its instance groups to their maximum size needs 20"}) {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestComputeAliasRange(t *testing.T) {
+	cluster := &kops.Cluster{}
+	cluster.Spec.CloudProvider.GCE = &kops.GCESpec{}
+	cluster.Spec.Networking.GCP = &kops.GCPNetworkingSpec{}
+	cluster.Spec.Networking.PodCIDR = "100.96.0.0/20"
+	cluster.Spec.Networking.Subnets = []kops.ClusterSubnetSpec{
+		{Name: "us-test1", CIDR: "10.0.16.0/20", Region: "us-test1"},
+	}
+	igs := []*kops.InstanceGroup{testInstanceGroup("nodes", 20, "us-test1")}
+
+	subnets, err := Compute(cluster, igs, nil, func(ig *kops.InstanceGroup) (int64, error) { return 1, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(subnets) != 1 || subnets[0].Usable != 4092 || subnets[0].AliasRangeNodes != 16 {
+		t.Fatalf("unexpected subnets: %+v", subnets)
+	}
+	if warnings := subnets[0].Warnings(); !reflect.DeepEqual(warnings, []string{"the pod IP range of subnet \"us-test1\" has room for 16 nodes, but its instance groups have up to 20"}) {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestNodeAddressesFor(t *testing.T) {
+	cloud := awsup.BuildMockAWSCloud("us-test-1", "abc")
+	ig := testInstanceGroup("nodes", 1, "us-test-1a")
+
+	grid := []struct {
+		Name       string
+		Networking kops.NetworkingSpec
+		Kubelet    *kops.KubeletConfigSpec
+		Expected   int64
+	}{
+		{
+			Name:       "calico",
+			Networking: kops.NetworkingSpec{Calico: &kops.CalicoNetworkingSpec{}},
+			Expected:   1,
+		},
+		{
+			Name:       "amazonvpc",
+			Networking: kops.NetworkingSpec{AmazonVPC: &kops.AmazonVPCNetworkingSpec{}},
+			// The mock machine types have a single ENI with a single address
+			Expected: 1,
+		},
+		{
+			Name: "amazonvpc with prefix delegation",
+			Networking: kops.NetworkingSpec{AmazonVPC: &kops.AmazonVPCNetworkingSpec{
+				Env: []kops.EnvVar{{Name: "ENABLE_PREFIX_DELEGATION", Value: "true"}},
+			}},
+			Expected: 1 + 7*16,
+		},
+		{
+			Name: "amazonvpc with prefix delegation and max pods",
+			Networking: kops.NetworkingSpec{AmazonVPC: &kops.AmazonVPCNetworkingSpec{
+				Env: []kops.EnvVar{{Name: "ENABLE_PREFIX_DELEGATION", Value: "true"}},
+			}},
+			Kubelet:  &kops.KubeletConfigSpec{MaxPods: fi.PtrTo(int32(32))},
+			Expected: 1 + 2*16,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cluster := testCluster()
+			cluster.Spec.Networking = g.Networking
+			cluster.Spec.Kubelet = g.Kubelet
+
+			actual, err := NodeAddressesFor(cluster, cloud)(ig)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != g.Expected {
+				t.Errorf("expected %d, got %d", g.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokens
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// WindowsBootstrapTokenSecretName is the name of the secret holding the bootstrap token used by Windows nodes to join the cluster
+const WindowsBootstrapTokenSecretName = "windowsbootstraptoken"
+
+// WindowsBootstrapTokenGroup is the extra group of the Windows bootstrap token, which is allowed to request node client certificates
+const WindowsBootstrapTokenGroup = "system:bootstrappers:kops:windows"
+
+const bootstrapTokenCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+var bootstrapTokenRegexp = regexp.MustCompile(`^([a-z0-9]{6})\.([a-z0-9]{16})$`)
+
+// GenerateBootstrapToken returns a new random bootstrap token, in the id.secret format expected by the API server
+func GenerateBootstrapToken() (string, error) {
+	id, err := randomString(6)
+	if err != nil {
+		return "", err
+	}
+	secret, err := randomString(16)
+	if err != nil {
+		return "", err
+	}
+	return id + "." + secret, nil
+}
+
+// ParseBootstrapToken returns the id and the secret of a bootstrap token
+func ParseBootstrapToken(token string) (string, string, error) {
+	match := bootstrapTokenRegexp.FindStringSubmatch(strings.TrimSpace(token))
+	if match == nil {
+		return "", "", fmt.Errorf("bootstrap token does not match %s", bootstrapTokenRegexp)
+	}
+	return match[1], match[2], nil
+}
+
+func randomString(length int) (string, error) {
+	b := make([]byte, length)
+	max := big.NewInt(int64(len(bootstrapTokenCharset)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("generating random token: %w", err)
+		}
+		b[i] = bootstrapTokenCharset[n.Int64()]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokens
+
+import (
+	"testing"
+)
+
+func TestBootstrapToken(t *testing.T) {
+	token, err := GenerateBootstrapToken()
+	if err != nil {
+		t.Fatalf("error generating token: %v", err)
+	}
+	id, secret, err := ParseBootstrapToken(token)
+	if err != nil {
+		t.Fatalf("error parsing generated token %q: %v", token, err)
+	}
+	if id+"."+secret != token || len(id) != 6 || len(secret) != 16 {
+		t.Errorf("unexpected id %q and secret %q of token %q", id, secret, token)
+	}
+
+	for _, invalid := range []string{"", "abcdef", "abcdef.0123456789abcde", "ABCDEF.0123456789abcdef", "abcdef:0123456789abcdef"} {
+		if _, _, err := ParseBootstrapToken(invalid); err == nil {
+			t.Errorf("expected error parsing %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templater
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ValuesSchema describes the values accepted by a set of templates, using a subset of JSON Schema.
+// Unlike JSON Schema, an object that declares properties rejects any other keys unless
+// additionalProperties is explicitly set to true, so that typos in values files are caught.
+type ValuesSchema struct {
+	// Type is one of object, array, string, integer, number or boolean; empty accepts any value
+	Type string `json:"type,omitempty"`
+	// Description documents the value
+	Description string `json:"description,omitempty"`
+	// Properties are the known keys of an object
+	Properties map[string]*ValuesSchema `json:"properties,omitempty"`
+	// Required are the keys which must be set on an object
+	Required []string `json:"required,omitempty"`
+	// AdditionalProperties allows keys which are not listed in properties
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+	// Items is the schema of the elements of an array
+	Items *ValuesSchema `json:"items,omitempty"`
+	// Enum is the list of allowed values
+	Enum []interface{} `json:"enum,omitempty"`
+	// Default is used when an optional key is not set
+	Default interface{} `json:"default,omitempty"`
+}
+
+// ParseValuesSchema parses a values schema from yaml or json
+func ParseValuesSchema(data []byte) (*ValuesSchema, error) {
+	schema := &ValuesSchema{}
+	if err := yaml.UnmarshalStrict(data, schema); err != nil {
+		return nil, fmt.Errorf("unable to parse values schema: %w", err)
+	}
+	if schema.Type == "" {
+		schema.Type = "object"
+	}
+	if schema.Type != "object" {
+		return nil, fmt.Errorf("values schema must describe an object, not %q", schema.Type)
+	}
+
+	return schema, nil
+}
+
+// Validate checks the values against the schema, setting any defaults for missing optional keys.
+// All the violations are returned together, sorted by path.
+func (s *ValuesSchema) Validate(values map[string]interface{}) error {
+	var problems []string
+	s.validateObject("", values, &problems)
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+
+	return fmt.Errorf("values do not match the schema:\n  %s", strings.Join(problems, "\n  "))
+}
+
+func (s *ValuesSchema) validate(path string, value interface{}, problems *[]string) {
+	if len(s.Enum) != 0 {
+		found := false
+		for _, allowed := range s.Enum {
+			if reflect.DeepEqual(normalizeNumber(allowed), normalizeNumber(value)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			*problems = append(*problems, fmt.Sprintf("%s: value %v is not one of %v", path, value, s.Enum))
+			return
+		}
+	}
+
+	switch s.Type {
+	case "":
+		return
+	case "object":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: expected an object, got %s", path, describeType(value)))
+			return
+		}
+		s.validateObject(path, m, problems)
+	case "array":
+		list, ok := value.([]interface{})
+		if !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: expected an array, got %s", path, describeType(value)))
+			return
+		}
+		if s.Items != nil {
+			for i, item := range list {
+				s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, problems)
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: expected a string, got %s", path, describeType(value)))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: expected a boolean, got %s", path, describeType(value)))
+		}
+	case "integer":
+		f, ok := toFloat(value)
+		if !ok || f != math.Trunc(f) {
+			*problems = append(*problems, fmt.Sprintf("%s: expected an integer, got %s", path, describeType(value)))
+		}
+	case "number":
+		if _, ok := toFloat(value); !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: expected a number, got %s", path, describeType(value)))
+		}
+	default:
+		*problems = append(*problems, fmt.Sprintf("%s: unknown schema type %q", path, s.Type))
+	}
+}
+
+func (s *ValuesSchema) validateObject(path string, values map[string]interface{}, problems *[]string) {
+	for _, key := range s.Required {
+		if _, found := values[key]; !found {
+			*problems = append(*problems, fmt.Sprintf("%s: required value is missing", joinPath(path, key)))
+		}
+	}
+
+	for key, property := range s.Properties {
+		if _, found := values[key]; !found && property.Default != nil {
+			values[key] = property.Default
+		}
+	}
+
+	for key, value := range values {
+		property, found := s.Properties[key]
+		if !found {
+			if s.Properties != nil && (s.AdditionalProperties == nil || !*s.AdditionalProperties) {
+				*problems = append(*problems, fmt.Sprintf("%s: unknown value", joinPath(path, key)))
+			}
+			continue
+		}
+		property.validate(joinPath(path, key), value, problems)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func describeType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return fmt.Sprintf("string %q", value)
+	default:
+		return fmt.Sprintf("%T %v", value, value)
+	}
+}
+
+// toFloat converts the numeric types produced by the yaml decoder and --set to a float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func normalizeNumber(value interface{}) interface{} {
+	if f, ok := toFloat(value); ok {
+		return f
+	}
+	return value
+}
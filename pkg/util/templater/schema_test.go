@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templater
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+const testSchema = `
+required: [clusterName, instanceGroups]
+properties:
+  clusterName:
+    type: string
+  kubernetesVersion:
+    type: string
+    default: 1.32.0
+  cloud:
+    type: string
+    enum: [aws, gce]
+  labels:
+    type: object
+    additionalProperties: true
+    properties:
+      team:
+        type: string
+  instanceGroups:
+    type: array
+    items:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        minSize:
+          type: integer
+        spot:
+          type: boolean
+`
+
+func TestValuesSchemaValidate(t *testing.T) {
+	schema, err := ParseValuesSchema([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("unexpected error parsing schema: %v", err)
+	}
+
+	cases := []struct {
+		Values   string
+		Problems []string
+	}{
+		{
+			Values: `
+clusterName: dev.example.com
+cloud: aws
+labels:
+  team: a
+  owner: b
+instanceGroups:
+- name: nodes
+  minSize: 2
+  spot: true
+`,
+		},
+		{
+			Values: `
+clusterName: dev.example.com
+clusterNmae: typo
+cloud: azure
+instanceGroups:
+- minSize: 1.5
+  spot: "yes"
+`,
+			Problems: []string{
+				"cloud: value azure is not one of [aws gce]",
+				"clusterNmae: unknown value",
+				"instanceGroups[0].minSize: expected an integer, got float64 1.5",
+				"instanceGroups[0].name: required value is missing",
+				`instanceGroups[0].spot: expected a boolean, got string "yes"`,
+			},
+		},
+		{
+			Values: `
+labels: []
+`,
+			Problems: []string{
+				"clusterName: required value is missing",
+				"instanceGroups: required value is missing",
+				"labels: expected an object, got an array",
+			},
+		},
+	}
+	for i, x := range cases {
+		values := make(map[string]interface{})
+		if err := yaml.Unmarshal([]byte(x.Values), &values); err != nil {
+			t.Fatalf("case %d: unexpected error parsing values: %v", i, err)
+		}
+		err := schema.Validate(values)
+		if len(x.Problems) == 0 {
+			if err != nil {
+				t.Errorf("case %d: unexpected error: %v", i, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("case %d: expected an error", i)
+			continue
+		}
+		expected := "values do not match the schema:\n  " + strings.Join(x.Problems, "\n  ")
+		if err.Error() != expected {
+			t.Errorf("case %d: expected error:\n%s\ngot:\n%s", i, expected, err)
+		}
+	}
+}
+
+func TestValuesSchemaDefaults(t *testing.T) {
+	schema, err := ParseValuesSchema([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("unexpected error parsing schema: %v", err)
+	}
+	values := map[string]interface{}{
+		"clusterName":    "dev.example.com",
+		"instanceGroups": []interface{}{},
+	}
+	if err := schema.Validate(values); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if values["kubernetesVersion"] != "1.32.0" {
+		t.Errorf("expected the default kubernetesVersion to be set, got %v", values["kubernetesVersion"])
+	}
+}
+
+func TestParseValuesSchemaInvalid(t *testing.T) {
+	for _, x := range []string{
+		"type: array",
+		"properties:\n  name:\n    typ: string",
+	} {
+		if _, err := ParseValuesSchema([]byte(x)); err == nil {
+			t.Errorf("expected an error parsing schema %q", x)
+		}
+	}
+}
@@ -17,6 +17,10 @@ limitations under the License.
 package templater
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -25,6 +29,7 @@ import (
 	kopsapi "k8s.io/kops/pkg/apis/kops"
 	"k8s.io/kops/pkg/apis/kops/util"
 	"k8s.io/kops/util/pkg/architectures"
+	"sigs.k8s.io/yaml"
 )
 
 // templateFuncsMap returns a map if the template functions for this template
@@ -34,7 +39,7 @@ func (r *Templater) templateFuncsMap(tm *template.Template) template.FuncMap {
 
 	funcs["indent"] = indentContent
 	// @step: as far as i can see there's no native way in sprig in include external snippets of code
-	funcs["include"] = func(name string, context map[string]interface{}) string {
+	funcs["include"] = func(name string, context interface{}) string {
 		content, err := includeSnippet(tm, name, context)
 		if err != nil {
 			panic(err.Error())
@@ -42,6 +47,53 @@ func (r *Templater) templateFuncsMap(tm *template.Template) template.FuncMap {
 
 		return content
 	}
+	// @step: the functions helm adds to sprig, so charts and kops templates can share snippets
+	funcs["tpl"] = func(content string, context interface{}) string {
+		t, err := template.New("tpl").Funcs(funcs).Parse(content)
+		if err != nil {
+			panic(fmt.Sprintf("tpl: unable to parse template, error: %s", err))
+		}
+		b := new(bytes.Buffer)
+		if err := t.Execute(b, context); err != nil {
+			panic(fmt.Sprintf("tpl: unable to render template, error: %s", err))
+		}
+
+		return b.String()
+	}
+	funcs["required"] = func(message string, value interface{}) (interface{}, error) {
+		if value == nil {
+			return nil, fmt.Errorf("%s", message)
+		}
+		if s, ok := value.(string); ok && s == "" {
+			return nil, fmt.Errorf("%s", message)
+		}
+
+		return value, nil
+	}
+	funcs["toYaml"] = func(value interface{}) (string, error) {
+		b, err := yaml.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.TrimSuffix(string(b), "\n"), nil
+	}
+	funcs["fromYaml"] = func(content string) (map[string]interface{}, error) {
+		m := make(map[string]interface{})
+		if err := yaml.Unmarshal([]byte(content), &m); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	}
+	funcs["fromJson"] = func(content string) (map[string]interface{}, error) {
+		m := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(content), &m); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	}
 
 	funcs["ChannelRecommendedKubernetesUpgradeVersion"] = func(version string) string {
 		parsed, err := util.ParseKubernetesVersion(version)
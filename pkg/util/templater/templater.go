@@ -100,7 +100,7 @@ func indentContent(indent int, content string) string {
 }
 
 // includeSnippet is responsible for including a snippet
-func includeSnippet(tm *template.Template, name string, context map[string]interface{}) (string, error) {
+func includeSnippet(tm *template.Template, name string, context interface{}) (string, error) {
 	b := bytes.NewBufferString("")
 	if err := tm.ExecuteTemplate(b, name, context); err != nil {
 		return "", fmt.Errorf("snippet: %s, issue: %s", name, err)
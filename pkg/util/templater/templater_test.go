@@ -129,6 +129,60 @@ func TestRenderSnippet(t *testing.T) {
 	makeRenderTests(t, cases)
 }
 
+func TestRenderPartials(t *testing.T) {
+	cases := []renderTest{
+		{
+			Context: map[string]interface{}{
+				"clusterName": "dev.example.com",
+				"instanceGroups": []interface{}{
+					map[string]interface{}{"name": "nodes-a"},
+					map[string]interface{}{"name": "nodes-b"},
+				},
+			},
+			Snippets: map[string]string{"ig/name.yaml": "{{ .ig.name }}.{{ .cluster.clusterName }}"},
+			Template: `{{ range .instanceGroups }}{{ include "ig/name.yaml" (dict "cluster" $ "ig" .) }},{{ end }}`,
+			Expected: "nodes-a.dev.example.com,nodes-b.dev.example.com,",
+		},
+		{
+			Context:  map[string]interface{}{"name": "world"},
+			Snippets: map[string]string{"_helpers.tpl": `{{ define "greeting" }}hello {{ .name }}{{ end }}`},
+			Template: `{{ template "greeting" . }}`,
+			Expected: "hello world",
+		},
+	}
+	makeRenderTests(t, cases)
+}
+
+func TestRenderHelmFunctions(t *testing.T) {
+	cases := []renderTest{
+		{
+			Context:  map[string]interface{}{"labels": map[string]interface{}{"team": "a", "env": "dev"}},
+			Template: `{{ toYaml .labels }}`,
+			Expected: "env: dev\nteam: a",
+		},
+		{
+			Template: `{{ (fromYaml "name: nodes").name }}{{ (fromJson "{\"size\": 3}").size }}`,
+			Expected: "nodes3",
+		},
+		{
+			Context:  map[string]interface{}{"name": "world", "greeting": "hello {{ .name }}"},
+			Template: `{{ tpl .greeting . }}`,
+			Expected: "hello world",
+		},
+		{
+			Context:  map[string]interface{}{"name": "world"},
+			Template: `{{ required "name must be set" .name }}`,
+			Expected: "world",
+		},
+		{
+			Context:  map[string]interface{}{"name": ""},
+			Template: `{{ required "name must be set" .name }}`,
+			NotOK:    true,
+		},
+	}
+	makeRenderTests(t, cases)
+}
+
 func TestRenderContext(t *testing.T) {
 	cases := []renderTest{
 		{
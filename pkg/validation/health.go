@@ -0,0 +1,319 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+	"k8s.io/kops/pkg/subnetcapacity"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+// HealthCategory is a group of checks summarized in the health score.
+type HealthCategory string
+
+const (
+	// HealthCategoryControlPlane covers the control plane nodes, their static pods and the API DNS record.
+	HealthCategoryControlPlane HealthCategory = "controlPlane"
+	// HealthCategoryNodes covers the other instance groups and their nodes.
+	HealthCategoryNodes HealthCategory = "nodes"
+	// HealthCategoryAddons covers the system-critical pods.
+	HealthCategoryAddons HealthCategory = "addons"
+	// HealthCategoryCertificates covers the expiry of the API server certificates.
+	HealthCategoryCertificates HealthCategory = "certificates"
+	// HealthCategoryCapacity covers the room left to schedule pods and to scale instance groups.
+	HealthCategoryCapacity HealthCategory = "capacity"
+)
+
+// healthWeights are the weights of the categories in the overall score, in the order they are reported.
+var healthWeights = []struct {
+	category HealthCategory
+	weight   int
+}{
+	{HealthCategoryControlPlane, 30},
+	{HealthCategoryNodes, 25},
+	{HealthCategoryAddons, 20},
+	{HealthCategoryCertificates, 15},
+	{HealthCategoryCapacity, 10},
+}
+
+const (
+	// certificateExpiryWarning is how long before its expiry a certificate is reported
+	certificateExpiryWarning = 30 * 24 * time.Hour
+	// capacityHeadroomThreshold is the fraction of the allocatable resources of the nodes which may be requested
+	capacityHeadroomThreshold = 0.9
+)
+
+// ValidationHealth summarizes the validation as scores from 0 to 100, for the cluster and for each category of checks.
+type ValidationHealth struct {
+	// Score is the weighted average of the scores of the categories.
+	Score int `json:"score"`
+	// Categories are the scores of each category of checks.
+	Categories []*HealthCategoryScore `json:"categories,omitempty"`
+}
+
+// HealthCategoryScore is the share of passed checks in a category, from 0 to 100.
+type HealthCategoryScore struct {
+	Category HealthCategory `json:"category"`
+	Score    int            `json:"score"`
+	Checks   int            `json:"checks"`
+	Failed   int            `json:"failed"`
+	// Warnings describe the failed checks which do not fail validation, such as expiring certificates.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// recordCheck counts a check in the health score.
+func (v *ValidationCluster) recordCheck(category HealthCategory, passed bool) {
+	score := v.healthCategory(category)
+	score.Checks++
+	if !passed {
+		score.Failed++
+	}
+}
+
+// recordWarning counts a failed check which does not fail validation.
+func (v *ValidationCluster) recordWarning(category HealthCategory, message string) {
+	v.recordCheck(category, false)
+	score := v.healthCategory(category)
+	score.Warnings = append(score.Warnings, message)
+}
+
+func (v *ValidationCluster) healthCategory(category HealthCategory) *HealthCategoryScore {
+	if v.health == nil {
+		v.health = make(map[HealthCategory]*HealthCategoryScore)
+	}
+	score := v.health[category]
+	if score == nil {
+		score = &HealthCategoryScore{Category: category}
+		v.health[category] = score
+	}
+	return score
+}
+
+// summarizeHealth computes the scores from the recorded checks.
+// A category without any checks scores 100.
+func (v *ValidationCluster) summarizeHealth() {
+	health := &ValidationHealth{}
+	total := 0
+	for _, w := range healthWeights {
+		score := v.healthCategory(w.category)
+		score.Score = 100
+		if score.Checks != 0 {
+			score.Score = 100 * (score.Checks - score.Failed) / score.Checks
+		}
+		health.Categories = append(health.Categories, score)
+		total += w.weight * score.Score
+	}
+	health.Score = total / 100
+	v.Health = health
+}
+
+// roleHealthCategory returns the category of the checks of an instance group.
+func roleHealthCategory(ig *kops.InstanceGroup) HealthCategory {
+	if ig != nil && (ig.IsControlPlane() || ig.Spec.Role == kops.InstanceGroupRoleAPIServer) {
+		return HealthCategoryControlPlane
+	}
+	return HealthCategoryNodes
+}
+
+// checkInstanceGroupHeadroom checks that an instance group which can scale is below its maximum size.
+func (v *ValidationCluster) checkInstanceGroupHeadroom(cloudGroup *cloudinstances.CloudInstanceGroup) {
+	if cloudGroup.InstanceGroup.Spec.Role != kops.InstanceGroupRoleNode || cloudGroup.MinSize >= cloudGroup.MaxSize {
+		return
+	}
+	if cloudGroup.TargetSize >= cloudGroup.MaxSize {
+		v.recordWarning(HealthCategoryCapacity, fmt.Sprintf("InstanceGroup %q is at its maximum size %d", cloudGroup.InstanceGroup.Name, cloudGroup.MaxSize))
+		return
+	}
+	v.recordCheck(HealthCategoryCapacity, true)
+}
+
+// checkSubnetCapacity checks that the subnets have enough IP addresses for the instance groups
+// to scale from their current to their maximum size.
+func (v *ValidationCluster) checkSubnetCapacity(ctx context.Context, cluster *kops.Cluster, cloud fi.Cloud, instanceGroups []*kops.InstanceGroup, cloudGroups map[string]*cloudinstances.CloudInstanceGroup) {
+	currentSizes := make(map[string]int)
+	for _, cloudGroup := range cloudGroups {
+		if cloudGroup.InstanceGroup != nil {
+			currentSizes[cloudGroup.InstanceGroup.Name] = len(cloudGroup.Ready) + len(cloudGroup.NeedUpdate)
+		}
+	}
+
+	subnets, err := subnetcapacity.Compute(cluster, instanceGroups, currentSizes, subnetcapacity.NodeAddressesFor(cluster, cloud))
+	if err != nil {
+		klog.Warningf("cannot check the capacity of the subnets: %v", err)
+		return
+	}
+	if err := subnetcapacity.AddFreeAddresses(ctx, cloud, cluster, subnets); err != nil {
+		klog.Warningf("cannot find the free IP addresses of the subnets: %v", err)
+	}
+
+	for _, subnet := range subnets {
+		warnings := subnet.Warnings()
+		for _, warning := range warnings {
+			v.recordWarning(HealthCategoryCapacity, warning)
+		}
+		if len(warnings) == 0 {
+			v.recordCheck(HealthCategoryCapacity, true)
+		}
+	}
+}
+
+// checkResourceHeadroom checks that the pods request less than capacityHeadroomThreshold of the
+// allocatable cpu and memory of the nodes which are not part of the control plane.
+func (v *ValidationCluster) checkResourceHeadroom(nodes []v1.Node, requested map[string]v1.ResourceList) {
+	for _, resourceName := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		allocatable := resource.NewQuantity(0, resource.DecimalSI)
+		used := resource.NewQuantity(0, resource.DecimalSI)
+		for i := range nodes {
+			node := &nodes[i]
+			if _, found := node.Labels["node-role.kubernetes.io/control-plane"]; found {
+				continue
+			}
+			if q, found := node.Status.Allocatable[resourceName]; found {
+				allocatable.Add(q)
+			}
+			if q, found := requested[node.Name][resourceName]; found {
+				used.Add(q)
+			}
+		}
+		if allocatable.IsZero() {
+			continue
+		}
+
+		fraction := float64(used.MilliValue()) / float64(allocatable.MilliValue())
+		if fraction >= capacityHeadroomThreshold {
+			v.recordWarning(HealthCategoryCapacity, fmt.Sprintf("pods request %d%% of the allocatable %s of the nodes", int(fraction*100), resourceName))
+			continue
+		}
+		v.recordCheck(HealthCategoryCapacity, true)
+	}
+}
+
+// addPodRequests adds the resource requests of a pod to the requests of its node.
+func addPodRequests(requested map[string]v1.ResourceList, pod *v1.Pod) {
+	if pod.Spec.NodeName == "" || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return
+	}
+	nodeRequests := requested[pod.Spec.NodeName]
+	if nodeRequests == nil {
+		nodeRequests = v1.ResourceList{}
+		requested[pod.Spec.NodeName] = nodeRequests
+	}
+	for _, container := range pod.Spec.Containers {
+		for name, q := range container.Resources.Requests {
+			total := nodeRequests[name]
+			total.Add(q)
+			nodeRequests[name] = total
+		}
+	}
+}
+
+// checkCertificates checks the expiry of the certificates presented by the API server,
+// and of the CA trusted to verify them.
+func (v *ValidationCluster) checkCertificates(certificates []*x509.Certificate, now time.Time) {
+	seen := make(map[string]bool)
+	for _, certificate := range certificates {
+		key := string(certificate.Raw)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		remaining := certificate.NotAfter.Sub(now)
+		switch {
+		case remaining <= 0:
+			v.recordWarning(HealthCategoryCertificates, fmt.Sprintf("certificate %q expired at %s", certificate.Subject.CommonName, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		case remaining < certificateExpiryWarning:
+			v.recordWarning(HealthCategoryCertificates, fmt.Sprintf("certificate %q expires at %s", certificate.Subject.CommonName, certificate.NotAfter.UTC().Format(time.RFC3339)))
+		default:
+			v.recordCheck(HealthCategoryCertificates, true)
+		}
+	}
+}
+
+// fetchAPIServerCertificates returns the certificates presented by the API server, and the CA certificates trusted by the client.
+func fetchAPIServerCertificates(ctx context.Context, restConfig *rest.Config) ([]*x509.Certificate, error) {
+	u, err := url.Parse(restConfig.Host)
+	if err != nil {
+		return nil, fmt.Errorf("parsing API server URL %q: %w", restConfig.Host, err)
+	}
+	if u.Scheme != "https" {
+		return nil, nil
+	}
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	tlsConfig, err := rest.TLSConfigFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("building TLS configuration: %w", err)
+	}
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	tlsConfig = tlsConfig.Clone()
+	// Only the certificates are inspected; the client credentials are not needed
+	tlsConfig.Certificates = nil
+	tlsConfig.GetClientCertificate = nil
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = u.Hostname()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	dialer := &tls.Dialer{Config: tlsConfig}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to API server: %w", err)
+	}
+	defer conn.Close()
+
+	certificates := conn.(*tls.Conn).ConnectionState().PeerCertificates
+
+	caData := restConfig.CAData
+	for {
+		var block *pem.Block
+		block, caData = pem.Decode(caData)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing CA certificate: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/cloudinstances"
+)
+
+func Test_ValidateHealth(t *testing.T) {
+	groups := make(map[string]*cloudinstances.CloudInstanceGroup)
+	groups["node-1"] = &cloudinstances.CloudInstanceGroup{
+		InstanceGroup: &kopsapi.InstanceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-1",
+			},
+			Spec: kopsapi.InstanceGroupSpec{
+				Role: kopsapi.InstanceGroupRoleNode,
+			},
+		},
+		MinSize:    1,
+		MaxSize:    2,
+		TargetSize: 2,
+		Ready: []*cloudinstances.CloudInstance{
+			{
+				ID: "i-00001",
+				Node: &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1a"},
+					Status: v1.NodeStatus{
+						Conditions: []v1.NodeCondition{
+							{Type: "Ready", Status: v1.ConditionTrue},
+						},
+					},
+				},
+			},
+			{
+				ID: "i-00002",
+				Node: &v1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1b"},
+					Status: v1.NodeStatus{
+						Conditions: []v1.NodeCondition{
+							{Type: "Ready", Status: v1.ConditionFalse},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	v, err := testValidate(t, groups, nil)
+	require.NoError(t, err)
+	if !assert.Equal(t, &ValidationHealth{
+		Score: 81,
+		Categories: []*HealthCategoryScore{
+			{Category: HealthCategoryControlPlane, Score: 100},
+			{Category: HealthCategoryNodes, Score: 66, Checks: 3, Failed: 1},
+			{Category: HealthCategoryAddons, Score: 100},
+			{Category: HealthCategoryCertificates, Score: 100},
+			{Category: HealthCategoryCapacity, Score: 0, Checks: 1, Failed: 1, Warnings: []string{"InstanceGroup \"node-1\" is at its maximum size 2"}},
+		},
+	}, v.Health) {
+		printDebug(t, v)
+	}
+}
+
+func Test_CheckCertificates(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	certificate := func(name string, notAfter time.Time) *x509.Certificate {
+		return &x509.Certificate{
+			Raw:      []byte(name),
+			Subject:  pkix.Name{CommonName: name},
+			NotAfter: notAfter,
+		}
+	}
+
+	ca := certificate("kubernetes-ca", now.Add(10*365*24*time.Hour))
+	v := &ValidationCluster{}
+	v.checkCertificates([]*x509.Certificate{
+		certificate("kubernetes-master", now.Add(7*24*time.Hour)),
+		certificate("old", now.Add(-time.Hour)),
+		ca,
+		ca,
+	}, now)
+	v.summarizeHealth()
+
+	assert.Equal(t, &HealthCategoryScore{
+		Category: HealthCategoryCertificates,
+		Score:    33,
+		Checks:   3,
+		Failed:   2,
+		Warnings: []string{
+			"certificate \"kubernetes-master\" expires at 2026-01-08T00:00:00Z",
+			"certificate \"old\" expired at 2025-12-31T23:00:00Z",
+		},
+	}, v.Health.Categories[3])
+}
+
+func Test_CheckResourceHeadroom(t *testing.T) {
+	node := func(name string, controlPlane bool) v1.Node {
+		n := v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("2"),
+					v1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+		}
+		if controlPlane {
+			n.Labels["node-role.kubernetes.io/control-plane"] = ""
+		}
+		return n
+	}
+	pod := func(nodeName string, cpu string, phase v1.PodPhase) *v1.Pod {
+		return &v1.Pod{
+			Spec: v1.PodSpec{
+				NodeName: nodeName,
+				Containers: []v1.Container{
+					{
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceCPU:    resource.MustParse(cpu),
+								v1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+					},
+				},
+			},
+			Status: v1.PodStatus{Phase: phase},
+		}
+	}
+
+	requested := map[string]v1.ResourceList{}
+	for _, p := range []*v1.Pod{
+		pod("node-a", "1900m", v1.PodRunning),
+		pod("node-b", "1800m", v1.PodRunning),
+		pod("node-b", "2", v1.PodSucceeded),
+		pod("control-plane-a", "2", v1.PodRunning),
+		pod("", "2", v1.PodPending),
+	} {
+		addPodRequests(requested, p)
+	}
+
+	v := &ValidationCluster{}
+	v.checkResourceHeadroom([]v1.Node{
+		node("node-a", false),
+		node("node-b", false),
+		node("control-plane-a", true),
+	}, requested)
+	v.summarizeHealth()
+
+	assert.Equal(t, &HealthCategoryScore{
+		Category: HealthCategoryCapacity,
+		Score:    50,
+		Checks:   2,
+		Failed:   1,
+		Warnings: []string{"pods request 92% of the allocatable cpu of the nodes"},
+	}, v.Health.Categories[4])
+}
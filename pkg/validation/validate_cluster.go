@@ -18,11 +18,13 @@ package validation
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/url"
 	"sort"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -43,6 +45,12 @@ type ValidationCluster struct {
 	Failures []*ValidationError `json:"failures,omitempty"`
 
 	Nodes []*ValidationNode `json:"nodes,omitempty"`
+
+	// Health summarizes the checks made by the validation as scores.
+	Health *ValidationHealth `json:"health,omitempty"`
+
+	// health holds the checks recorded for each category of the health score
+	health map[HealthCategory]*HealthCategoryScore
 }
 
 // ValidationError holds a validation failure
@@ -73,6 +81,9 @@ type clusterValidatorImpl struct {
 
 	// filterPodsForValidation is a function that returns true if the pod should be validated
 	filterPodsForValidation func(pod *v1.Pod) bool
+
+	// fetchCertificates returns the certificates checked for the health score
+	fetchCertificates func(ctx context.Context, restConfig *rest.Config) ([]*x509.Certificate, error)
 }
 
 func (v *ValidationCluster) addError(failure *ValidationError) {
@@ -143,6 +154,7 @@ func NewClusterValidator(cluster *kops.Cluster, cloud fi.Cloud, instanceGroupLis
 		k8sClient:               k8sClient,
 		filterInstanceGroups:    filterInstanceGroups,
 		filterPodsForValidation: filterPodsForValidation,
+		fetchCertificates:       fetchAPIServerCertificates,
 	}, nil
 }
 
@@ -173,6 +185,8 @@ func (v *clusterValidatorImpl) Validate(ctx context.Context) (*ValidationCluster
 				Name:    "apiserver",
 				Message: message,
 			})
+			validation.recordCheck(HealthCategoryControlPlane, false)
+			validation.summarizeHealth()
 			return validation, nil
 		}
 	}
@@ -189,11 +203,25 @@ func (v *clusterValidatorImpl) Validate(ctx context.Context) (*ValidationCluster
 	}
 
 	readyNodes, nodeInstanceGroupMapping := validation.validateNodes(cloudGroups, v.allInstanceGroups, v.filterInstanceGroups)
+	validation.checkSubnetCapacity(ctx, v.cluster, v.cloud, v.allInstanceGroups, cloudGroups)
 
 	if err := validation.collectPodFailures(ctx, v.k8sClient, readyNodes, nodeInstanceGroupMapping, v.filterPodsForValidation); err != nil {
 		return nil, fmt.Errorf("cannot get pod health for %q: %v", v.cluster.Name, err)
 	}
 
+	if v.cluster.Spec.ConnectivityProbes != nil {
+		validation.collectConnectivityFailures(readyNodes, nodeInstanceGroupMapping)
+	}
+
+	certificates, err := v.fetchCertificates(ctx, v.restConfig)
+	if err != nil {
+		klog.Warningf("cannot check the API server certificates: %v", err)
+	} else {
+		validation.checkCertificates(certificates, time.Now())
+	}
+
+	validation.summarizeHealth()
+
 	return validation, nil
 }
 
@@ -208,6 +236,8 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 ) error {
 	masterWithoutPod := map[string]map[string]bool{}
 	nodeByAddress := map[string]string{}
+	// requested holds the resource requests of the pods on each node, for the capacity headroom
+	requested := map[string]v1.ResourceList{}
 
 	for _, node := range nodes {
 		labels := node.GetLabels()
@@ -228,6 +258,8 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 	})).EachListItem(context.TODO(), metav1.ListOptions{}, func(obj runtime.Object) error {
 		pod := obj.(*v1.Pod)
 
+		addPodRequests(requested, pod)
+
 		app := pod.GetLabels()["k8s-app"]
 		if pod.Namespace == "kube-system" && masterWithoutPod[nodeByAddress[pod.Status.HostIP]][app] {
 			delete(masterWithoutPod[nodeByAddress[pod.Status.HostIP]], app)
@@ -253,6 +285,7 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 		}
 
 		if pod.Status.Phase == v1.PodPending {
+			v.recordCheck(HealthCategoryAddons, false)
 			v.addError(&ValidationError{
 				Kind:          "Pod",
 				Name:          pod.Namespace + "/" + pod.Name,
@@ -262,6 +295,7 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 			return nil
 		}
 		if pod.Status.Phase == v1.PodUnknown {
+			v.recordCheck(HealthCategoryAddons, false)
 			v.addError(&ValidationError{
 				Kind:          "Pod",
 				Name:          pod.Namespace + "/" + pod.Name,
@@ -276,6 +310,7 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 				notready = append(notready, container.Name)
 			}
 		}
+		v.recordCheck(HealthCategoryAddons, len(notready) == 0)
 		if len(notready) != 0 {
 			v.addError(&ValidationError{
 				Kind:          "Pod",
@@ -291,6 +326,9 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 	}
 
 	for node, nodeMap := range masterWithoutPod {
+		for _, app := range masterStaticPods {
+			v.recordCheck(HealthCategoryControlPlane, !nodeMap[app])
+		}
 		for app := range nodeMap {
 			v.addError(&ValidationError{
 				Kind:          "Node",
@@ -301,9 +339,33 @@ func (v *ValidationCluster) collectPodFailures(ctx context.Context, client kuber
 		}
 	}
 
+	v.checkResourceHeadroom(nodes, requested)
+
 	return nil
 }
 
+// collectConnectivityFailures reports the nodes that could not reach the endpoints they depend on,
+// as recorded by the connectivity probes run by kops-controller.
+func (v *ValidationCluster) collectConnectivityFailures(nodes []v1.Node, nodeInstanceGroupMapping map[string]*kops.InstanceGroup) {
+	for i := range nodes {
+		node := &nodes[i]
+		cond := findNodeCondition(node, kops.NodeConditionConnectivity)
+		if cond == nil {
+			continue
+		}
+		v.recordCheck(roleHealthCategory(nodeInstanceGroupMapping[node.Name]), cond.Status != v1.ConditionFalse)
+		if cond.Status != v1.ConditionFalse {
+			continue
+		}
+		v.addError(&ValidationError{
+			Kind:          "Node",
+			Name:          node.Name,
+			Message:       fmt.Sprintf("node %q failed connectivity probes: %s", node.Name, cond.Message),
+			InstanceGroup: nodeInstanceGroupMapping[node.Name],
+		})
+	}
+}
+
 func (v *ValidationCluster) validateNodes(cloudGroups map[string]*cloudinstances.CloudInstanceGroup, groups []*kops.InstanceGroup, shouldValidateInstanceGroup func(ig *kops.InstanceGroup) bool) ([]v1.Node, map[string]*kops.InstanceGroup) {
 	var readyNodes []v1.Node
 	groupsSeen := map[string]bool{}
@@ -325,6 +387,9 @@ func (v *ValidationCluster) validateNodes(cloudGroups map[string]*cloudinstances
 				numNodes++
 			}
 		}
+		category := roleHealthCategory(cloudGroup.InstanceGroup)
+		v.recordCheck(category, numNodes >= cloudGroup.TargetSize)
+		v.checkInstanceGroupHeadroom(cloudGroup)
 		if numNodes < cloudGroup.TargetSize {
 			v.addError(&ValidationError{
 				Kind: "InstanceGroup",
@@ -355,6 +420,7 @@ func (v *ValidationCluster) validateNodes(cloudGroups map[string]*cloudinstances
 				}
 
 				if nodeExpectedToJoin {
+					v.recordCheck(category, false)
 					v.addError(&ValidationError{
 						Kind:          "Machine",
 						Name:          member.ID,
@@ -387,6 +453,7 @@ func (v *ValidationCluster) validateNodes(cloudGroups map[string]*cloudinstances
 
 			switch n.Role {
 			case "control-plane", "apiserver", "node":
+				v.recordCheck(category, ready)
 				if !ready {
 					v.addError(&ValidationError{
 						Kind:          "Node",
@@ -410,6 +477,7 @@ func (v *ValidationCluster) validateNodes(cloudGroups map[string]*cloudinstances
 		}
 
 		if !groupsSeen[ig.Name] {
+			v.recordCheck(roleHealthCategory(ig), false)
 			v.addError(&ValidationError{
 				Kind:          "InstanceGroup",
 				Name:          ig.Name,
@@ -18,6 +18,7 @@ package validation
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"testing"
 
@@ -137,6 +138,9 @@ func testValidate(t *testing.T, groups map[string]*cloudinstances.CloudInstanceG
 	if err != nil {
 		return nil, err
 	}
+	validator.(*clusterValidatorImpl).fetchCertificates = func(ctx context.Context, restConfig *rest.Config) ([]*x509.Certificate, error) {
+		return nil, nil
+	}
 	return validator.Validate(ctx)
 }
 
@@ -771,6 +775,61 @@ func Test_ValidatePodFailure(t *testing.T) {
 	}
 }
 
+func Test_ValidateConnectivityProbeFailures(t *testing.T) {
+	ig := &kopsapi.InstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-1",
+		},
+		Spec: kopsapi.InstanceGroupSpec{
+			Role: kopsapi.InstanceGroupRoleNode,
+		},
+	}
+	nodes := []v1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1a"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{Type: "Ready", Status: v1.ConditionTrue},
+					{Type: kopsapi.NodeConditionConnectivity, Status: v1.ConditionTrue, Message: "3 endpoints reachable"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1b"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{Type: "Ready", Status: v1.ConditionTrue},
+					{Type: kopsapi.NodeConditionConnectivity, Status: v1.ConditionFalse, Message: "registry (registry.k8s.io:443): i/o timeout"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1c"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{Type: "Ready", Status: v1.ConditionTrue},
+				},
+			},
+		},
+	}
+
+	v := &ValidationCluster{}
+	v.collectConnectivityFailures(nodes, map[string]*kopsapi.InstanceGroup{
+		"node-1a": ig,
+		"node-1b": ig,
+		"node-1c": ig,
+	})
+	if !assert.Len(t, v.Failures, 1) ||
+		!assert.Equal(t, &ValidationError{
+			Kind:          "Node",
+			Name:          "node-1b",
+			Message:       "node \"node-1b\" failed connectivity probes: registry (registry.k8s.io:443): i/o timeout",
+			InstanceGroup: ig,
+		}, v.Failures[0]) {
+		printDebug(t, v)
+	}
+}
+
 func printDebug(t *testing.T, v *ValidationCluster) {
 	t.Logf("cluster - %d failures", len(v.Failures))
 	for _, fail := range v.Failures {
@@ -64,6 +64,15 @@ const (
 	// EtcdCiliumClientPort is the port were the Cilium etcd cluster listens
 	EtcdCiliumClientPort = 4003
 
+	// KopsControllerMetrics is the port where kops-controller serves Prometheus metrics
+	KopsControllerMetrics = 4004
+
+	// DNSControllerMetrics is the port where dns-controller serves Prometheus metrics
+	DNSControllerMetrics = 4005
+
+	// ProtokubeMetrics is the port where protokube serves Prometheus metrics
+	ProtokubeMetrics = 4006
+
 	// CiliumOperatorPrometheusPort is the port the Cilium Operator exposes metrics
 	CiliumPrometheusOperatorPort = 6942
 
@@ -73,6 +82,21 @@ const (
 	// CiliumHubblePrometheusPort is the default port where Hubble exposes metrics
 	CiliumHubblePrometheusPort = 9965
 
+	// KonnectivityAgentHealth is the port where konnectivity-agent serves its health checks
+	KonnectivityAgentHealth = 8093
+
+	// KonnectivityAgentAdmin is the port where konnectivity-agent serves its admin interface
+	KonnectivityAgentAdmin = 8094
+
+	// KonnectivityServerAgent is the port where konnectivity-server accepts connections from konnectivity-agent
+	KonnectivityServerAgent = 8132
+
+	// KonnectivityServerAdmin is the port where konnectivity-server serves its admin interface
+	KonnectivityServerAdmin = 8133
+
+	// KonnectivityServerHealth is the port where konnectivity-server serves its health checks
+	KonnectivityServerHealth = 8134
+
 	// VxlanUDP is the port used by VXLAN tunneling over UDP
 	VxlanUDP = 8472
 
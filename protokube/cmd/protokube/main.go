@@ -19,10 +19,12 @@ package main
 import (
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"path"
 	"strings"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
 	"k8s.io/klog/v2"
 	"k8s.io/kops/pkg/wellknownports"
@@ -84,6 +86,9 @@ func run() error {
 	var removeDNSNames string
 	flag.StringVar(&removeDNSNames, "remove-dns-names", removeDNSNames, "If set, will remove the DNS records specified")
 
+	var metricsListen string
+	flag.StringVar(&metricsListen, "metrics-listen", metricsListen, "The address on which to listen for Prometheus metrics.")
+
 	// Trick to avoid 'logging before flag.Parse' warning
 	flag.CommandLine.Parse([]string{})
 
@@ -91,6 +96,13 @@ func run() error {
 	flags.AddGoFlagSet(flag.CommandLine)
 	flags.Parse(os.Args)
 
+	if metricsListen != "" {
+		go func() {
+			http.Handle("/metrics", promhttp.Handler())
+			klog.Fatal(http.ListenAndServe(metricsListen, nil))
+		}()
+	}
+
 	var cloudProvider protokube.CloudProvider
 	if cloud == "aws" {
 		awsCloudProvider, err := protokube.NewAWSCloudProvider()
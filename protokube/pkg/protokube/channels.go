@@ -21,6 +21,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"k8s.io/klog/v2"
 )
@@ -30,7 +31,12 @@ func applyChannel(channel string) error {
 	// We don't embed the channels code because we expect this will eventually be part of kubectl
 	klog.Infof("checking channel: %q", channel)
 
+	start := time.Now()
 	out, err := execChannels("apply", "channel", channel, "--v=4", "--yes")
+	channelApplyDuration.WithLabelValues(channel).Observe(time.Since(start).Seconds())
+	if err != nil {
+		channelApplyErrors.WithLabelValues(channel).Inc()
+	}
 	klog.V(4).Infof("apply channel output was: %v", out)
 	return err
 }
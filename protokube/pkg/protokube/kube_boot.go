@@ -72,6 +72,7 @@ func (k *KubeBoot) RunSyncLoop() {
 
 	for {
 		if err := k.syncOnce(ctx); err != nil {
+			syncLoopErrors.Inc()
 			klog.Warningf("error during attempt to bootstrap (will sleep and retry): %v", err)
 		}
 
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package protokube
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	channelApplyDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "protokube_channel_apply_duration_seconds",
+			Help:    "Time taken to apply an addon channel, partitioned by channel.",
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+		},
+		[]string{"channel"},
+	)
+
+	channelApplyErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "protokube_channel_apply_errors_total",
+			Help: "Number of failed attempts to apply an addon channel, partitioned by channel.",
+		},
+		[]string{"channel"},
+	)
+
+	syncLoopErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "protokube_sync_errors_total",
+			Help: "Number of failed protokube bootstrap sync iterations.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(channelApplyDuration, channelApplyErrors, syncLoopErrors)
+}
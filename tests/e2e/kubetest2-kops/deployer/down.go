@@ -64,6 +64,9 @@ func (d *deployer) Down() error {
 			"--wait=60m",
 		)
 	}
+	if version >= "1.33" {
+		args = append(args, "--confirm-name", d.ClusterName)
+	}
 	klog.Info(strings.Join(args, " "))
 	cmd := exec.Command(args[0], args[1:]...)
 	cmd.SetEnv(d.env()...)
@@ -446,7 +446,7 @@ resource "google_compute_forwarding_rule" "api-us-test1-minimal-gce-example-com"
 }
 
 resource "google_compute_forwarding_rule" "kops-controller-us-test1-minimal-gce-example-com" {
-  backend_service = google_compute_region_backend_service.api-minimal-gce-example-com.id
+  backend_service = google_compute_region_backend_service.kops-controller-minimal-gce-example-com.id
   ip_address      = google_compute_address.api-us-test1-minimal-gce-example-com.address
   ip_protocol     = "TCP"
   labels = {
@@ -598,6 +598,17 @@ resource "google_compute_region_backend_service" "api-minimal-gce-example-com" {
   protocol              = "TCP"
 }
 
+resource "google_compute_region_backend_service" "kops-controller-minimal-gce-example-com" {
+  backend {
+    balancing_mode = "CONNECTION"
+    group          = google_compute_instance_group_manager.a-master-us-test1-a-minimal-gce-example-com.instance_group
+  }
+  health_checks         = [google_compute_region_health_check.kops-controller-minimal-gce-example-com.id]
+  load_balancing_scheme = "INTERNAL"
+  name                  = "kops-controller-minimal-gce-example-com"
+  protocol              = "TCP"
+}
+
 resource "google_compute_region_health_check" "api-minimal-gce-example-com" {
   name = "api-minimal-gce-example-com"
   tcp_health_check {
@@ -605,6 +616,13 @@ resource "google_compute_region_health_check" "api-minimal-gce-example-com" {
   }
 }
 
+resource "google_compute_region_health_check" "kops-controller-minimal-gce-example-com" {
+  name = "kops-controller-minimal-gce-example-com"
+  tcp_health_check {
+    port = 3988
+  }
+}
+
 resource "google_compute_router" "nat-minimal-gce-example-com" {
   name    = "nat-minimal-gce-example-com"
   network = google_compute_network.minimal-gce-example-com.name
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TaskAction is what running a task did to its resource.
+type TaskAction string
+
+const (
+	// TaskActionCreated means the resource did not exist and was created.
+	TaskActionCreated TaskAction = "created"
+	// TaskActionUpdated means the resource existed and was modified.
+	TaskActionUpdated TaskAction = "updated"
+	// TaskActionUnchanged means the resource already matched the model.
+	TaskActionUnchanged TaskAction = "unchanged"
+	// TaskActionIgnored means the resource was not checked, because of the lifecycle of the task.
+	TaskActionIgnored TaskAction = "ignored"
+)
+
+// ApplyReport records what each task did while the tasks ran, for a machine-readable report of a run.
+// It is safe for use by concurrently running tasks.
+type ApplyReport struct {
+	mutex    sync.Mutex
+	tasks    map[string]*TaskReport
+	warnings []string
+}
+
+// TaskReport is the outcome of a task.
+type TaskReport struct {
+	// Key is the key of the task, such as SecurityGroup/nodes.example.com.
+	Key string `json:"key"`
+	// Action is what the task did to its resource.
+	// It is empty for the tasks which do not report their changes.
+	Action TaskAction `json:"action,omitempty"`
+	// ID is the cloud identifier of the resource, if known.
+	ID string `json:"id,omitempty"`
+	// ChangedFields are the fields of the resource which were modified by an update.
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Deleted are the resources deleted by the task, as task/name.
+	Deleted []string `json:"deleted,omitempty"`
+	// Attempts is the number of times the task ran, including retries.
+	Attempts int `json:"attempts"`
+	// DurationSeconds is the total time spent running the task, across its attempts.
+	DurationSeconds float64 `json:"durationSeconds"`
+	// Error is the error of the last attempt, if the task never succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// NewApplyReport is the constructor for an empty ApplyReport.
+func NewApplyReport() *ApplyReport {
+	return &ApplyReport{
+		tasks: make(map[string]*TaskReport),
+	}
+}
+
+// Tasks returns the reports of the tasks which ran, sorted by key.
+func (r *ApplyReport) Tasks() []*TaskReport {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var tasks []*TaskReport
+	for _, t := range r.tasks {
+		copy := *t
+		tasks = append(tasks, &copy)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].Key < tasks[j].Key })
+	return tasks
+}
+
+// Warnings returns the warnings raised by the tasks.
+func (r *ApplyReport) Warnings() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]string(nil), r.warnings...)
+}
+
+// AddWarning records a warning raised while running the tasks.
+func (r *ApplyReport) AddWarning(message string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.warnings = append(r.warnings, message)
+}
+
+// taskFinished records an attempt to run a task.
+func (r *ApplyReport) taskFinished(key string, duration time.Duration, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	t := r.task(key)
+	t.Attempts++
+	t.DurationSeconds += duration.Seconds()
+	t.Error = ""
+	if _, ok := err.(*ExistsAndWarnIfChangesError); err != nil && !ok {
+		t.Error = err.Error()
+	}
+}
+
+// recordAction records what a task did to its resource.
+func (r *ApplyReport) recordAction(key string, action TaskAction, id *string, changedFields []string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	t := r.task(key)
+	t.Action = action
+	t.ChangedFields = changedFields
+	if id != nil {
+		t.ID = *id
+	}
+}
+
+// recordDeletion records a resource deleted by a task.
+func (r *ApplyReport) recordDeletion(key string, taskName string, item string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	t := r.task(key)
+	t.Deleted = append(t.Deleted, taskName+"/"+item)
+}
+
+// task returns the report of a task, creating it if needed; the caller must hold the mutex.
+func (r *ApplyReport) task(key string) *TaskReport {
+	t := r.tasks[key]
+	if t == nil {
+		t = &TaskReport{Key: key}
+		r.tasks[key] = t
+	}
+	return t
+}
+
+// reportAction records the outcome of the task run with the context, if the run is reported.
+func (c *Context[T]) reportAction(a, e, changes Task[T], action TaskAction) {
+	if c.report == nil {
+		return
+	}
+
+	var changedFields []string
+	if action == TaskActionUpdated {
+		changeList, err := buildChangeList(a, e, changes)
+		if err == nil {
+			for _, change := range changeList {
+				changedFields = append(changedFields, change.FieldName)
+			}
+		}
+	}
+
+	var id *string
+	if hasID, ok := e.(CompareWithID); ok && !reflect.ValueOf(e).IsNil() {
+		id = hasID.CompareWithID()
+	}
+
+	c.report.recordAction(c.taskKey, action, id, changedFields)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/kops/pkg/assets"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+// reportTask is a task whose existing resource is actual.
+type reportTask struct {
+	ID    *string
+	Size  *int
+	Label *string
+
+	actual *reportTask
+}
+
+var _ InstallTask = &reportTask{}
+var _ CompareWithID = &reportTask{}
+
+func (e *reportTask) Find(c *InstallContext) (*reportTask, error) {
+	if e.actual == nil {
+		return nil, nil
+	}
+	e.ID = e.actual.ID
+	return &reportTask{ID: e.actual.ID, Size: e.actual.Size, Label: e.actual.Label}, nil
+}
+
+func (e *reportTask) CheckChanges(a, ex, changes *reportTask) error {
+	return nil
+}
+
+func (e *reportTask) CompareWithID() *string {
+	return e.ID
+}
+
+func (e *reportTask) Run(c *InstallContext) error {
+	return InstallDefaultDeltaRunMethod(e, c)
+}
+
+func TestApplyReportRecordsActions(t *testing.T) {
+	tasks := map[string]InstallTask{
+		"reportTask/new": &reportTask{Size: PtrTo(1)},
+		"reportTask/changed": &reportTask{
+			Size:   PtrTo(2),
+			Label:  PtrTo("a"),
+			actual: &reportTask{ID: PtrTo("id-changed"), Size: PtrTo(1), Label: PtrTo("a")},
+		},
+		"reportTask/unchanged": &reportTask{
+			Size:   PtrTo(1),
+			actual: &reportTask{ID: PtrTo("id-unchanged"), Size: PtrTo(1)},
+		},
+	}
+
+	var out bytes.Buffer
+	target := newDryRunTarget[InstallSubContext](assets.NewAssetBuilder(vfs.Context, nil, false), true, &out)
+	c, err := NewInstallContext(context.Background(), target, tasks)
+	if err != nil {
+		t.Fatalf("error building context: %v", err)
+	}
+
+	options := testRunTasksOptions()
+	options.Report = NewApplyReport()
+	if err := c.RunTasks(options); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var actual []TaskReport
+	for _, task := range options.Report.Tasks() {
+		task.DurationSeconds = 0
+		actual = append(actual, *task)
+	}
+	assert.Equal(t, []TaskReport{
+		{Key: "reportTask/changed", Action: TaskActionUpdated, ID: "id-changed", ChangedFields: []string{"Size"}, Attempts: 1},
+		{Key: "reportTask/new", Action: TaskActionCreated, Attempts: 1},
+		{Key: "reportTask/unchanged", Action: TaskActionUnchanged, ID: "id-unchanged", Attempts: 1},
+	}, actual)
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kops/upup/pkg/fi/utils"
 	"k8s.io/kops/util/pkg/hashing"
+	"k8s.io/kops/util/pkg/signature"
 )
 
 type asset struct {
@@ -93,6 +94,11 @@ func (r *assetResource) GetSource() *Source {
 type AssetStore struct {
 	cacheDir string
 	assets   []*asset
+
+	// verifier, if set, must verify the signature of every downloaded file
+	verifier *signature.Verifier
+	// signedChecksums, if set, are the verified hashes of the downloaded files
+	signedChecksums signature.Checksums
 }
 
 func NewAssetStore(cacheDir string) *AssetStore {
@@ -198,6 +204,84 @@ func hashFromHTTPHeader(url string) (*hashing.Hash, error) {
 	return nil, fmt.Errorf("unable to determine hash from HTTP HEAD: %q", url)
 }
 
+// VerifySignatures requires assets added from now on to be signed by one of the public keys.
+// If signedChecksumsURL is set, the assets must be listed in that signed SHA256SUMS file,
+// otherwise each asset must have a detached signature at its url with a .sig suffix.
+func (a *AssetStore) VerifySignatures(publicKeys []string, signedChecksumsURL string) error {
+	verifier, err := signature.NewVerifier(publicKeys)
+	if err != nil {
+		return err
+	}
+
+	if signedChecksumsURL != "" {
+		data, err := a.downloadVerified(verifier, signedChecksumsURL, path.Join(a.cacheDir, "signed-checksums"))
+		if err != nil {
+			return err
+		}
+		checksums, err := signature.ParseChecksums(data)
+		if err != nil {
+			return fmt.Errorf("error parsing checksums file %q: %w", signedChecksumsURL, err)
+		}
+		a.signedChecksums = checksums
+	}
+
+	a.verifier = verifier
+	return nil
+}
+
+// downloadVerified downloads the url and its detached signature, returning the contents if the signature is valid.
+func (a *AssetStore) downloadVerified(verifier *signature.Verifier, url string, localFile string) ([]byte, error) {
+	if err := downloadURLAlways(url, localFile, 0o755); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(localFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %q: %w", localFile, err)
+	}
+	if err := a.verifyFile(verifier, url, data, localFile); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// verifyFile verifies the detached signature of a file downloaded from url.
+func (a *AssetStore) verifyFile(verifier *signature.Verifier, url string, data []byte, localFile string) error {
+	sigFile := localFile + ".sig"
+	if err := downloadURLAlways(url+".sig", sigFile, 0o755); err != nil {
+		return fmt.Errorf("signature verification of %q failed: %w", url, err)
+	}
+	defer os.Remove(sigFile)
+
+	sig, err := os.ReadFile(sigFile)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %w", sigFile, err)
+	}
+	if err := verifier.Verify(data, sig); err != nil {
+		return fmt.Errorf("signature verification of %q failed: %w", url, err)
+	}
+	return nil
+}
+
+// verifyAsset fails unless the downloaded asset is signed, either directly or by the signed checksums.
+func (a *AssetStore) verifyAsset(url string, localFile string) error {
+	if a.signedChecksums != nil {
+		hash, err := hashing.HashAlgorithmSHA256.HashFile(localFile)
+		if err != nil {
+			return err
+		}
+		if err := a.signedChecksums.Check(url, hash.Hex()); err != nil {
+			return fmt.Errorf("signature verification of %q failed: %w", url, err)
+		}
+		return nil
+	}
+
+	data, err := os.ReadFile(localFile)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %w", localFile, err)
+	}
+	return a.verifyFile(a.verifier, url, data, localFile)
+}
+
 // Add an asset into the store, in one of the recognized formats (see Assets in types package)
 func (a *AssetStore) Add(id string) error {
 	if strings.HasPrefix(id, "http://") || strings.HasPrefix(id, "https://") {
@@ -250,9 +334,14 @@ func (a *AssetStore) addURLs(urls []string, hash *hashing.Hash) error {
 		if err != nil {
 			klog.Warningf("error downloading url %q: %v", url, err)
 			continue
-		} else {
-			break
 		}
+		if a.verifier != nil {
+			// Fail closed: a file that cannot be verified is never installed
+			if err = a.verifyAsset(url, localFile); err != nil {
+				return err
+			}
+		}
+		break
 	}
 	if err != nil {
 		return err
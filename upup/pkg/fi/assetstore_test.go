@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAssetStoreVerifySignatures(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("error marshalling public key: %v", err)
+	}
+	publicKeys := []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))}
+
+	sign := func(data string) string {
+		digest := sha256.Sum256([]byte(data))
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatalf("error signing: %v", err)
+		}
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+	hash := func(data string) string {
+		digest := sha256.Sum256([]byte(data))
+		return hex.EncodeToString(digest[:])
+	}
+
+	checksums := hash("kubelet") + "  kubelet\n" + hash("kubectl") + "  kubectl\n"
+	files := map[string]string{
+		"/kubelet":        "kubelet",
+		"/kubelet.sig":    sign("kubelet"),
+		"/kubectl":        "kubectl",
+		"/kubectl.sig":    sign("tampered"),
+		"/kube-proxy":     "kube-proxy",
+		"/SHA256SUMS":     checksums,
+		"/SHA256SUMS.sig": sign(checksums),
+		"/BADSUMS":        checksums,
+		"/BADSUMS.sig":    sign("tampered"),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, found := files[r.URL.Path]
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(data))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name      string
+		checksums string
+		asset     string
+		expectErr bool
+	}{
+		{
+			name:  "detached signature",
+			asset: "kubelet",
+		},
+		{
+			name:      "invalid detached signature",
+			asset:     "kubectl",
+			expectErr: true,
+		},
+		{
+			name:      "missing detached signature",
+			asset:     "kube-proxy",
+			expectErr: true,
+		},
+		{
+			name:      "signed checksums",
+			checksums: "/SHA256SUMS",
+			asset:     "kubectl",
+		},
+		{
+			name:      "not in signed checksums",
+			checksums: "/SHA256SUMS",
+			asset:     "kube-proxy",
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewAssetStore(t.TempDir())
+			checksumsURL := ""
+			if tc.checksums != "" {
+				checksumsURL = server.URL + tc.checksums
+			}
+			if err := a.VerifySignatures(publicKeys, checksumsURL); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			data := files["/"+tc.asset]
+			err := a.Add(hash(data) + "@" + server.URL + "/" + tc.asset)
+			if tc.expectErr && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	if err := NewAssetStore(t.TempDir()).VerifySignatures(publicKeys, server.URL+"/BADSUMS"); err == nil {
+		t.Errorf("expected error for checksums file with an invalid signature")
+	}
+}
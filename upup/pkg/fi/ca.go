@@ -178,6 +178,32 @@ func (k *Keyset) ToPublicKeys() (string, error) {
 	return buf.String(), nil
 }
 
+// ToSSHAuthorizedKeys returns the public keys of the trusted items, in authorized_keys format.
+func (k *Keyset) ToSSHAuthorizedKeys() ([]string, error) {
+	keys := make([]string, 0, len(k.Items))
+	for k, item := range k.Items {
+		if item.DistrustTimestamp == nil {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return KeysetItemIdOlder(k.Items[keys[i]].Id, k.Items[keys[j]].Id)
+	})
+
+	var authorizedKeys []string
+	for _, key := range keys {
+		item := k.Items[key]
+		if item.Certificate != nil {
+			authorizedKey, err := pki.SSHAuthorizedKey(item.Certificate)
+			if err != nil {
+				return nil, fmt.Errorf("public key %s: %v", item.Id, err)
+			}
+			authorizedKeys = append(authorizedKeys, authorizedKey)
+		}
+	}
+	return authorizedKeys, nil
+}
+
 // NewKeyset creates a Keyset.
 func NewKeyset(cert *pki.Certificate, privateKey *pki.PrivateKey) (*Keyset, error) {
 	keyset := &Keyset{
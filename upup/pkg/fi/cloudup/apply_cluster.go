@@ -51,7 +51,10 @@ import (
 	"k8s.io/kops/pkg/model/scalewaymodel"
 	"k8s.io/kops/pkg/nodemodel"
 	"k8s.io/kops/pkg/predicates"
+	"k8s.io/kops/pkg/quota"
+	"k8s.io/kops/pkg/subnetcapacity"
 	"k8s.io/kops/pkg/templates"
+	"k8s.io/kops/pkg/tokens"
 	"k8s.io/kops/upup/models"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
@@ -86,6 +89,18 @@ var TerraformCloudProviders = []kops.CloudProviderID{
 	kops.CloudProviderDO,
 }
 
+// PulumiCloudProviders is the list of cloud providers with pulumi target support
+var PulumiCloudProviders = []kops.CloudProviderID{
+	kops.CloudProviderAWS,
+	kops.CloudProviderGCE,
+}
+
+// CrossplaneCloudProviders is the list of cloud providers with crossplane target support
+var CrossplaneCloudProviders = []kops.CloudProviderID{
+	kops.CloudProviderAWS,
+	kops.CloudProviderGCE,
+}
+
 type ApplyClusterCmd struct {
 	Cloud   fi.Cloud
 	Cluster *kops.Cluster
@@ -109,6 +124,9 @@ type ApplyClusterCmd struct {
 	// AllowKopsDowngrade permits applying with a kops version older than what was last used to apply to the cluster.
 	AllowKopsDowngrade bool
 
+	// SkipQuotaCheck skips checking that the quotas of the cloud account have room for the cluster before creating its resources
+	SkipQuotaCheck bool
+
 	// RunTasksOptions defines parameters for task execution, e.g. retry interval
 	RunTasksOptions *fi.RunTasksOptions
 
@@ -140,6 +158,9 @@ type ApplyClusterCmd struct {
 
 	// The current oldest version of control plane nodes, defaults to version defined in cluster spec if IgnoreVersionSkew was set
 	ControlPlaneRunningVersion string
+
+	// DryRunOutput is where the report of a dry-run is written, defaults to stdout
+	DryRunOutput io.Writer
 }
 
 // ApplyResults holds information about an ApplyClusterCmd operation.
@@ -164,6 +185,30 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 			return nil, fmt.Errorf("DO Terraform requires the DOTerraform feature flag to be enabled")
 		}
 	}
+	if c.TargetName == TargetPulumi {
+		found := false
+		for _, cp := range PulumiCloudProviders {
+			if c.Cloud.ProviderID() == cp {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("cloud provider %v does not support the pulumi target", c.Cloud.ProviderID())
+		}
+	}
+	if c.TargetName == TargetCrossplane {
+		found := false
+		for _, cp := range CrossplaneCloudProviders {
+			if c.Cloud.ProviderID() == cp {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("cloud provider %v does not support the crossplane target", c.Cloud.ProviderID())
+		}
+	}
 	if c.InstanceGroups == nil {
 		list, err := c.Clientset.InstanceGroupsFor(c.Cluster).List(ctx, metav1.ListOptions{})
 		if err != nil {
@@ -236,6 +281,10 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		return nil, fmt.Errorf("unknown phase %q", c.Phase)
 	}
 
+	if err := c.pinImageChannels(ctx); err != nil {
+		return nil, err
+	}
+
 	assetBuilder := assets.NewAssetBuilder(c.Clientset.VFSContext(), c.Cluster.Spec.Assets, c.GetAssets)
 	if len(c.ControlPlaneRunningVersion) > 0 && c.ControlPlaneRunningVersion != c.Cluster.Spec.KubernetesVersion {
 		assetBuilder.KubeletSupportedVersion = c.ControlPlaneRunningVersion
@@ -255,6 +304,14 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		return nil, err
 	}
 
+	c.warnSubnetCapacity(ctx)
+
+	if c.TargetName == TargetDirect && !c.DryRun && !c.SkipQuotaCheck {
+		if err := quota.Verify(ctx, c.Cloud, c.Cluster, c.InstanceGroups); err != nil {
+			return nil, fmt.Errorf("%w\nTo apply the cluster anyway, use --skip-quota-check", err)
+		}
+	}
+
 	cluster := c.Cluster
 
 	configBase, err := c.Clientset.VFSContext().BuildVfsPath(cluster.Spec.ConfigStore.Base)
@@ -386,6 +443,23 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		}
 	}
 
+	for _, ig := range c.InstanceGroups {
+		if !ig.IsWindows() {
+			continue
+		}
+		secret, err := secretStore.FindSecret(tokens.WindowsBootstrapTokenSecretName)
+		if err != nil {
+			return nil, fmt.Errorf("could not load the %s secret: %w", tokens.WindowsBootstrapTokenSecretName, err)
+		}
+		if secret == nil {
+			fmt.Println("")
+			fmt.Printf("You have Windows instance groups, but no %s secret has been set.\n", tokens.WindowsBootstrapTokenSecretName)
+			fmt.Printf("See `kops create secret %s -h`\n", tokens.WindowsBootstrapTokenSecretName)
+			return nil, fmt.Errorf("could not find %s secret", tokens.WindowsBootstrapTokenSecretName)
+		}
+		break
+	}
+
 	project := ""
 	scwZone := ""
 
@@ -411,6 +485,10 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 		AdditionalObjects: c.AdditionalObjects,
 	}
 
+	if err := c.fallbackToOnDemand(ctx, cloud, modelContext); err != nil {
+		return nil, err
+	}
+
 	switch cluster.GetCloudProvider() {
 	case kops.CloudProviderGCE:
 		{
@@ -583,6 +661,7 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 			l.Builders = append(l.Builders,
 				&awsmodel.APILoadBalancerBuilder{AWSModelContext: awsModelContext, Lifecycle: clusterLifecycle, SecurityLifecycle: securityLifecycle},
 				&awsmodel.BastionModelBuilder{AWSModelContext: awsModelContext, Lifecycle: clusterLifecycle, SecurityLifecycle: securityLifecycle},
+				&awsmodel.VPNModelBuilder{AWSModelContext: awsModelContext, Lifecycle: clusterLifecycle, SecurityLifecycle: securityLifecycle},
 				&awsmodel.DNSModelBuilder{AWSModelContext: awsModelContext, Lifecycle: clusterLifecycle},
 				&awsmodel.ExternalAccessModelBuilder{AWSModelContext: awsModelContext, Lifecycle: securityLifecycle},
 				&awsmodel.FirewallModelBuilder{AWSModelContext: awsModelContext, Lifecycle: securityLifecycle},
@@ -737,9 +816,19 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 			return nil, fmt.Errorf("direct configuration not supported with CloudProvider:%q", cluster.GetCloudProvider())
 		}
 
-	case TargetTerraform:
+	case TargetTerraform, TargetPulumi, TargetCrossplane:
 		outDir := c.OutDir
-		tf := terraform.NewTerraformTarget(cloud, project, outDir, cluster.Spec.Target)
+		var tf *terraform.TerraformTarget
+		switch c.TargetName {
+		case TargetPulumi:
+			// The pulumi and crossplane targets reuse the terraform rendering of each task
+			tf = terraform.NewPulumiTarget(cloud, project, outDir, cluster.Spec.Target)
+		case TargetCrossplane:
+			tf = terraform.NewCrossplaneTarget(cloud, project, outDir, cluster.Spec.Target)
+		default:
+			tf = terraform.NewTerraformTarget(cloud, project, outDir, cluster.Spec.Target)
+		}
+		tf.ClusterName = cluster.ObjectMeta.Name
 
 		// We include a few "util" variables in the TF output
 		if err := tf.AddOutputVariable("region", terraformWriter.LiteralFromStringValue(cloud.Region())); err != nil {
@@ -771,6 +860,9 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 
 	case TargetDryRun:
 		var out io.Writer = os.Stdout
+		if c.DryRunOutput != nil {
+			out = c.DryRunOutput
+		}
 		checkExisting := true
 		if c.GetAssets {
 			out = io.Discard
@@ -834,6 +926,30 @@ func (c *ApplyClusterCmd) Run(ctx context.Context) (*ApplyResults, error) {
 	return applyResults, nil
 }
 
+// warnSubnetCapacity warns when the subnets don't have enough IP addresses for the instance groups
+// to scale to their maximum size. The instance groups are assumed to be at their minimum size.
+func (c *ApplyClusterCmd) warnSubnetCapacity(ctx context.Context) {
+	currentSizes := make(map[string]int)
+	for _, ig := range c.InstanceGroups {
+		currentSizes[ig.Name] = int(fi.ValueOf(ig.Spec.MinSize))
+	}
+
+	subnets, err := subnetcapacity.Compute(c.Cluster, c.InstanceGroups, currentSizes, subnetcapacity.NodeAddressesFor(c.Cluster, c.Cloud))
+	if err != nil {
+		klog.Warningf("unable to compute the capacity of the subnets: %v", err)
+		return
+	}
+	if err := subnetcapacity.AddFreeAddresses(ctx, c.Cloud, c.Cluster, subnets); err != nil {
+		klog.V(2).Infof("unable to find the free IP addresses of the subnets: %v", err)
+	}
+
+	for _, subnet := range subnets {
+		for _, warning := range subnet.Warnings() {
+			klog.Warningf("%s", warning)
+		}
+	}
+}
+
 // upgradeSpecs ensures that fields are fully populated / defaulted
 func (c *ApplyClusterCmd) upgradeSpecs(ctx context.Context, assetBuilder *assets.AssetBuilder) error {
 	fullCluster, err := PopulateClusterSpec(ctx, c.Clientset, c.Cluster, c.InstanceGroups, c.Cloud, assetBuilder)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -260,6 +261,16 @@ func (e *AutoscalingGroup) Find(c *fi.CloudupContext) (*AutoscalingGroup, error)
 		}
 	}
 
+	// Delete the scheduled actions kOps created which are no longer in the model
+	if managed := actual.Tags[awsup.TagNameScheduledActions]; managed != "" {
+		expected := strings.Split(e.Tags[awsup.TagNameScheduledActions], ",")
+		for _, actionName := range strings.Split(managed, ",") {
+			if !slices.Contains(expected, actionName) {
+				e.deletions = append(e.deletions, buildDeleteAutoscalingScheduledAction(aws.ToString(g.AutoScalingGroupName), actionName))
+			}
+		}
+	}
+
 	if g.LaunchTemplate != nil {
 		actual.LaunchTemplate = &LaunchTemplate{
 			Name: g.LaunchTemplate.LaunchTemplateName,
@@ -1109,7 +1120,7 @@ func (_ *AutoscalingGroup) RenderTerraform(t *terraform.TerraformTarget, a, e, c
 		}
 	}
 
-	return t.RenderResource("aws_autoscaling_group", *e.Name, tf)
+	return t.RenderInstanceGroupResource(*e.Name, "aws_autoscaling_group", *e.Name, tf)
 }
 
 // TerraformLink fills in the property
@@ -162,7 +162,7 @@ func (_ *AutoscalingLifecycleHook) RenderTerraform(t *terraform.TerraformTarget,
 		LifecycleTransition:  e.LifecycleTransition,
 	}
 
-	return t.RenderResource("aws_autoscaling_lifecycle_hook", *e.Name, tf)
+	return t.RenderInstanceGroupResource(*e.AutoscalingGroup.Name, "aws_autoscaling_lifecycle_hook", *e.Name, tf)
 }
 
 func (h *AutoscalingLifecycleHook) GetHookName() *string {
@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// AutoscalingScheduledAction is a recurring scheduled action of an autoscaling group, which changes its sizes.
+// +kops:fitask
+type AutoscalingScheduledAction struct {
+	ID        *string
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	// ActionName is the name of the scheduled action.
+	// It needs to be unique within the autoscaling group.
+	ActionName *string
+
+	AutoscalingGroup *AutoscalingGroup
+	// Recurrence is the cron expression of the action.
+	Recurrence *string
+	// TimeZone is the IANA time zone of the recurrence.
+	TimeZone *string
+
+	MinSize         *int32
+	MaxSize         *int32
+	DesiredCapacity *int32
+}
+
+var _ fi.CompareWithID = &AutoscalingScheduledAction{}
+
+func (a *AutoscalingScheduledAction) CompareWithID() *string {
+	return a.Name
+}
+
+func (a *AutoscalingScheduledAction) Find(c *fi.CloudupContext) (*AutoscalingScheduledAction, error) {
+	ctx := c.Context()
+	cloud := awsup.GetCloud(c)
+
+	request := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: a.AutoscalingGroup.Name,
+		ScheduledActionNames: []string{aws.ToString(a.ActionName)},
+	}
+
+	response, err := cloud.Autoscaling().DescribeScheduledActions(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("error listing ASG scheduled actions: %v", err)
+	}
+	if response == nil || len(response.ScheduledUpdateGroupActions) == 0 {
+		return nil, nil
+	}
+	if len(response.ScheduledUpdateGroupActions) > 1 {
+		return nil, fmt.Errorf("found multiple ASG scheduled actions with the same name")
+	}
+
+	action := response.ScheduledUpdateGroupActions[0]
+	actual := &AutoscalingScheduledAction{
+		ID:               a.Name,
+		Name:             a.Name,
+		ActionName:       a.ActionName,
+		Lifecycle:        a.Lifecycle,
+		AutoscalingGroup: a.AutoscalingGroup,
+		Recurrence:       action.Recurrence,
+		TimeZone:         action.TimeZone,
+		MinSize:          action.MinSize,
+		MaxSize:          action.MaxSize,
+		DesiredCapacity:  action.DesiredCapacity,
+	}
+
+	return actual, nil
+}
+
+func (a *AutoscalingScheduledAction) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(a, c)
+}
+
+func (_ *AutoscalingScheduledAction) CheckChanges(a, e, changes *AutoscalingScheduledAction) error {
+	if a == nil {
+		if e.Name == nil {
+			return field.Required(field.NewPath("Name"), "")
+		}
+		if e.AutoscalingGroup == nil {
+			return field.Required(field.NewPath("AutoScalingGroupName"), "")
+		}
+	}
+	if e.Recurrence == nil {
+		return field.Required(field.NewPath("Recurrence"), "")
+	}
+
+	return nil
+}
+
+func (*AutoscalingScheduledAction) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *AutoscalingScheduledAction) error {
+	ctx := context.TODO()
+
+	if changes != nil {
+		request := &autoscaling.PutScheduledUpdateGroupActionInput{
+			AutoScalingGroupName: e.AutoscalingGroup.Name,
+			ScheduledActionName:  e.ActionName,
+			Recurrence:           e.Recurrence,
+			TimeZone:             e.TimeZone,
+			MinSize:              e.MinSize,
+			MaxSize:              e.MaxSize,
+			DesiredCapacity:      e.DesiredCapacity,
+		}
+		_, err := t.Cloud.Autoscaling().PutScheduledUpdateGroupAction(ctx, request)
+		if err != nil {
+			return fmt.Errorf("error creating ASG scheduled action: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type terraformASGScheduledAction struct {
+	ScheduledActionName  *string                  `cty:"scheduled_action_name"`
+	AutoScalingGroupName *terraformWriter.Literal `cty:"autoscaling_group_name"`
+	Recurrence           *string                  `cty:"recurrence"`
+	TimeZone             *string                  `cty:"time_zone"`
+	MinSize              *int32                   `cty:"min_size"`
+	MaxSize              *int32                   `cty:"max_size"`
+	DesiredCapacity      *int32                   `cty:"desired_capacity"`
+}
+
+func (_ *AutoscalingScheduledAction) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *AutoscalingScheduledAction) error {
+	tf := &terraformASGScheduledAction{
+		ScheduledActionName:  e.ActionName,
+		AutoScalingGroupName: e.AutoscalingGroup.TerraformLink(),
+		Recurrence:           e.Recurrence,
+		TimeZone:             e.TimeZone,
+		MinSize:              e.MinSize,
+		MaxSize:              e.MaxSize,
+		DesiredCapacity:      e.DesiredCapacity,
+	}
+
+	return t.RenderInstanceGroupResource(*e.AutoscalingGroup.Name, "aws_autoscaling_schedule", *e.Name, tf)
+}
+
+type deleteAutoscalingScheduledAction struct {
+	autoScalingGroupName string
+	actionName           string
+}
+
+var _ fi.CloudupDeletion = &deleteAutoscalingScheduledAction{}
+
+func buildDeleteAutoscalingScheduledAction(autoScalingGroupName string, actionName string) *deleteAutoscalingScheduledAction {
+	return &deleteAutoscalingScheduledAction{
+		autoScalingGroupName: autoScalingGroupName,
+		actionName:           actionName,
+	}
+}
+
+func (d *deleteAutoscalingScheduledAction) Delete(t fi.CloudupTarget) error {
+	ctx := context.TODO()
+
+	awsTarget, ok := t.(*awsup.AWSAPITarget)
+	if !ok {
+		return fmt.Errorf("unexpected target type for deletion: %T", t)
+	}
+
+	// The action may have been deleted already, in which case only the tag listing it remains to be updated
+	response, err := awsTarget.Cloud.Autoscaling().DescribeScheduledActions(ctx, &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: aws.String(d.autoScalingGroupName),
+		ScheduledActionNames: []string{d.actionName},
+	})
+	if err != nil {
+		return fmt.Errorf("error listing ASG scheduled actions: %w", err)
+	}
+	if len(response.ScheduledUpdateGroupActions) == 0 {
+		return nil
+	}
+
+	request := &autoscaling.DeleteScheduledActionInput{
+		AutoScalingGroupName: aws.String(d.autoScalingGroupName),
+		ScheduledActionName:  aws.String(d.actionName),
+	}
+	if _, err := awsTarget.Cloud.Autoscaling().DeleteScheduledAction(ctx, request); err != nil {
+		return fmt.Errorf("error deleting ASG scheduled action: %w", err)
+	}
+	return nil
+}
+
+func (d *deleteAutoscalingScheduledAction) TaskName() string {
+	return "AutoscalingScheduledAction"
+}
+
+func (d *deleteAutoscalingScheduledAction) Item() string {
+	return d.autoScalingGroupName + ":" + d.actionName
+}
+
+func (d *deleteAutoscalingScheduledAction) DeferDeletion() bool {
+	return false
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstasks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// ClientVPNEndpoint is an AWS Client VPN endpoint using mutual certificate authentication
+// +kops:fitask
+type ClientVPNEndpoint struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	ID *string
+
+	// ClientCIDR is the range the addresses of the VPN clients are allocated from
+	ClientCIDR *string
+	// ServerCertificateARN is the ACM certificate presented by the endpoint
+	ServerCertificateARN *string
+	// ClientCertificateARN is the ACM certificate of the authority of the client certificates
+	ClientCertificateARN *string
+
+	VPC            *VPC
+	SecurityGroups []*SecurityGroup
+	DNSServers     []string
+	SplitTunnel    *bool
+
+	// AuthorizedCIDRs are the destination ranges all the VPN clients are authorized to access
+	AuthorizedCIDRs []string
+
+	// DNSName is the DNS name of the endpoint, as reported by AWS
+	DNSName *string
+
+	Tags map[string]string
+}
+
+var _ fi.CompareWithID = &ClientVPNEndpoint{}
+
+func (e *ClientVPNEndpoint) CompareWithID() *string {
+	return e.ID
+}
+
+// FindClientVPNEndpoint returns the Client VPN endpoint with the given name tag that is owned by the cluster, if any
+func FindClientVPNEndpoint(ctx context.Context, cloud awsup.AWSCloud, name string) (*ec2types.ClientVpnEndpoint, error) {
+	var found []ec2types.ClientVpnEndpoint
+
+	paginator := ec2.NewDescribeClientVpnEndpointsPaginator(cloud.EC2(), &ec2.DescribeClientVpnEndpointsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Client VPN endpoints: %w", err)
+		}
+		for _, endpoint := range page.ClientVpnEndpoints {
+			if endpoint.Status != nil && (endpoint.Status.Code == ec2types.ClientVpnEndpointStatusCodeDeleting || endpoint.Status.Code == ec2types.ClientVpnEndpointStatusCodeDeleted) {
+				continue
+			}
+			if aws.ToString(findNameTag(endpoint.Tags)) != name {
+				continue
+			}
+			if !matchesClusterTags(endpoint.Tags, cloud.Tags()) {
+				continue
+			}
+			found = append(found, endpoint)
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, nil
+	}
+	if len(found) != 1 {
+		return nil, fmt.Errorf("found multiple Client VPN endpoints named %q", name)
+	}
+	return &found[0], nil
+}
+
+// matchesClusterTags returns true if all the cluster tags are set on the resource
+func matchesClusterTags(tags []ec2types.Tag, clusterTags map[string]string) bool {
+	actual := mapEC2TagsToMap(tags)
+	for k, v := range clusterTags {
+		if actual[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *ClientVPNEndpoint) Find(c *fi.CloudupContext) (*ClientVPNEndpoint, error) {
+	ctx := c.Context()
+	cloud := awsup.GetCloud(c)
+
+	endpoint, err := FindClientVPNEndpoint(ctx, cloud, fi.ValueOf(e.Name))
+	if err != nil {
+		return nil, err
+	}
+	if endpoint == nil {
+		return nil, nil
+	}
+
+	actual := &ClientVPNEndpoint{
+		ID:                   endpoint.ClientVpnEndpointId,
+		Name:                 findNameTag(endpoint.Tags),
+		ClientCIDR:           endpoint.ClientCidrBlock,
+		ServerCertificateARN: endpoint.ServerCertificateArn,
+		DNSServers:           endpoint.DnsServers,
+		SplitTunnel:          endpoint.SplitTunnel,
+		DNSName:              endpoint.DnsName,
+		Tags:                 intersectTags(endpoint.Tags, e.Tags),
+	}
+	for _, auth := range endpoint.AuthenticationOptions {
+		if auth.MutualAuthentication != nil {
+			actual.ClientCertificateARN = auth.MutualAuthentication.ClientRootCertificateChain
+		}
+	}
+	if endpoint.VpcId != nil {
+		actual.VPC = &VPC{ID: endpoint.VpcId}
+	}
+	for _, id := range endpoint.SecurityGroupIds {
+		actual.SecurityGroups = append(actual.SecurityGroups, &SecurityGroup{ID: aws.String(id)})
+	}
+	sort.Sort(OrderSecurityGroupsById(actual.SecurityGroups))
+
+	rules, err := cloud.EC2().DescribeClientVpnAuthorizationRules(ctx, &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: endpoint.ClientVpnEndpointId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing authorization rules of Client VPN endpoint %q: %w", aws.ToString(endpoint.ClientVpnEndpointId), err)
+	}
+	for _, rule := range rules.AuthorizationRules {
+		if !aws.ToBool(rule.AccessAll) {
+			continue
+		}
+		actual.AuthorizedCIDRs = append(actual.AuthorizedCIDRs, aws.ToString(rule.DestinationCidr))
+	}
+	sort.Strings(actual.AuthorizedCIDRs)
+
+	klog.V(2).Infof("found matching Client VPN endpoint %q", aws.ToString(actual.ID))
+
+	// Prevent spurious comparison failures
+	actual.Lifecycle = e.Lifecycle
+	if e.ID == nil {
+		e.ID = actual.ID
+	}
+	if e.DNSName == nil {
+		e.DNSName = actual.DNSName
+	}
+
+	return actual, nil
+}
+
+func (e *ClientVPNEndpoint) Normalize(c *fi.CloudupContext) error {
+	sort.Stable(OrderSecurityGroupsById(e.SecurityGroups))
+	sort.Strings(e.AuthorizedCIDRs)
+	return nil
+}
+
+func (e *ClientVPNEndpoint) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(e, c)
+}
+
+func (_ *ClientVPNEndpoint) CheckChanges(a, e, changes *ClientVPNEndpoint) error {
+	if a == nil {
+		if e.ClientCIDR == nil {
+			return fi.RequiredField("ClientCIDR")
+		}
+		if e.ServerCertificateARN == nil {
+			return fi.RequiredField("ServerCertificateARN")
+		}
+		if e.ClientCertificateARN == nil {
+			return fi.RequiredField("ClientCertificateARN")
+		}
+		if e.VPC == nil {
+			return fi.RequiredField("VPC")
+		}
+	} else {
+		if changes.ClientCIDR != nil {
+			return fi.CannotChangeField("ClientCIDR")
+		}
+		if changes.ClientCertificateARN != nil {
+			return fi.CannotChangeField("ClientCertificateARN")
+		}
+	}
+	return nil
+}
+
+func (_ *ClientVPNEndpoint) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *ClientVPNEndpoint) error {
+	ctx := context.TODO()
+
+	var securityGroupIDs []string
+	for _, sg := range e.SecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, fi.ValueOf(sg.ID))
+	}
+
+	if a == nil {
+		klog.V(2).Infof("Creating Client VPN endpoint %q", fi.ValueOf(e.Name))
+
+		request := &ec2.CreateClientVpnEndpointInput{
+			ClientCidrBlock:      e.ClientCIDR,
+			ServerCertificateArn: e.ServerCertificateARN,
+			AuthenticationOptions: []ec2types.ClientVpnAuthenticationRequest{
+				{
+					Type: ec2types.ClientVpnAuthenticationTypeCertificateAuthentication,
+					MutualAuthentication: &ec2types.CertificateAuthenticationRequest{
+						ClientRootCertificateChainArn: e.ClientCertificateARN,
+					},
+				},
+			},
+			ConnectionLogOptions: &ec2types.ConnectionLogOptions{
+				Enabled: aws.Bool(false),
+			},
+			DnsServers:        e.DNSServers,
+			SecurityGroupIds:  securityGroupIDs,
+			SplitTunnel:       e.SplitTunnel,
+			VpcId:             e.VPC.ID,
+			TagSpecifications: awsup.EC2TagSpecification(ec2types.ResourceTypeClientVpnEndpoint, e.Tags),
+		}
+
+		response, err := t.Cloud.EC2().CreateClientVpnEndpoint(ctx, request)
+		if err != nil {
+			return fmt.Errorf("error creating Client VPN endpoint: %w", err)
+		}
+
+		e.ID = response.ClientVpnEndpointId
+		e.DNSName = response.DnsName
+	} else {
+		if changes.ServerCertificateARN != nil || changes.DNSServers != nil || changes.SplitTunnel != nil || changes.SecurityGroups != nil || changes.VPC != nil {
+			klog.V(2).Infof("Modifying Client VPN endpoint %q", fi.ValueOf(e.ID))
+
+			request := &ec2.ModifyClientVpnEndpointInput{
+				ClientVpnEndpointId:  e.ID,
+				ServerCertificateArn: e.ServerCertificateARN,
+				DnsServers: &ec2types.DnsServersOptionsModifyStructure{
+					CustomDnsServers: e.DNSServers,
+					Enabled:          aws.Bool(len(e.DNSServers) != 0),
+				},
+				SecurityGroupIds: securityGroupIDs,
+				SplitTunnel:      e.SplitTunnel,
+				VpcId:            e.VPC.ID,
+			}
+			if _, err := t.Cloud.EC2().ModifyClientVpnEndpoint(ctx, request); err != nil {
+				return fmt.Errorf("error modifying Client VPN endpoint %q: %w", fi.ValueOf(e.ID), err)
+			}
+		}
+
+		if err := t.UpdateTags(fi.ValueOf(e.ID), e.Tags); err != nil {
+			return err
+		}
+	}
+
+	var actualCIDRs []string
+	if a != nil {
+		actualCIDRs = a.AuthorizedCIDRs
+	}
+	for _, cidr := range e.AuthorizedCIDRs {
+		if slices.Contains(actualCIDRs, cidr) {
+			continue
+		}
+		klog.V(2).Infof("Authorizing access to %q through Client VPN endpoint %q", cidr, fi.ValueOf(e.ID))
+		request := &ec2.AuthorizeClientVpnIngressInput{
+			ClientVpnEndpointId: e.ID,
+			TargetNetworkCidr:   aws.String(cidr),
+			AuthorizeAllGroups:  aws.Bool(true),
+		}
+		if _, err := t.Cloud.EC2().AuthorizeClientVpnIngress(ctx, request); err != nil {
+			return fmt.Errorf("error authorizing access to %q through Client VPN endpoint %q: %w", cidr, fi.ValueOf(e.ID), err)
+		}
+	}
+	for _, cidr := range actualCIDRs {
+		if slices.Contains(e.AuthorizedCIDRs, cidr) {
+			continue
+		}
+		klog.V(2).Infof("Revoking access to %q through Client VPN endpoint %q", cidr, fi.ValueOf(e.ID))
+		request := &ec2.RevokeClientVpnIngressInput{
+			ClientVpnEndpointId: e.ID,
+			TargetNetworkCidr:   aws.String(cidr),
+			RevokeAllGroups:     aws.Bool(true),
+		}
+		if _, err := t.Cloud.EC2().RevokeClientVpnIngress(ctx, request); err != nil {
+			return fmt.Errorf("error revoking access to %q through Client VPN endpoint %q: %w", cidr, fi.ValueOf(e.ID), err)
+		}
+	}
+
+	return nil
+}
+
+type terraformClientVPNEndpointAuthentication struct {
+	Type                    *string `cty:"type"`
+	RootCertificateChainARN *string `cty:"root_certificate_chain_arn"`
+}
+
+type terraformClientVPNEndpointConnectionLog struct {
+	Enabled *bool `cty:"enabled"`
+}
+
+type terraformClientVPNEndpoint struct {
+	Description           *string                                   `cty:"description"`
+	ClientCIDRBlock       *string                                   `cty:"client_cidr_block"`
+	ServerCertificateARN  *string                                   `cty:"server_certificate_arn"`
+	AuthenticationOptions *terraformClientVPNEndpointAuthentication `cty:"authentication_options"`
+	ConnectionLogOptions  *terraformClientVPNEndpointConnectionLog  `cty:"connection_log_options"`
+	DNSServers            []string                                  `cty:"dns_servers"`
+	SplitTunnel           *bool                                     `cty:"split_tunnel"`
+	VPCID                 *terraformWriter.Literal                  `cty:"vpc_id"`
+	SecurityGroupIDs      []*terraformWriter.Literal                `cty:"security_group_ids"`
+	Tags                  map[string]string                         `cty:"tags"`
+}
+
+type terraformClientVPNAuthorizationRule struct {
+	ClientVPNEndpointID *terraformWriter.Literal `cty:"client_vpn_endpoint_id"`
+	TargetNetworkCIDR   *string                  `cty:"target_network_cidr"`
+	AuthorizeAllGroups  *bool                    `cty:"authorize_all_groups"`
+}
+
+func (_ *ClientVPNEndpoint) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *ClientVPNEndpoint) error {
+	tf := &terraformClientVPNEndpoint{
+		Description:          e.Name,
+		ClientCIDRBlock:      e.ClientCIDR,
+		ServerCertificateARN: e.ServerCertificateARN,
+		AuthenticationOptions: &terraformClientVPNEndpointAuthentication{
+			Type:                    aws.String(string(ec2types.ClientVpnAuthenticationTypeCertificateAuthentication)),
+			RootCertificateChainARN: e.ClientCertificateARN,
+		},
+		ConnectionLogOptions: &terraformClientVPNEndpointConnectionLog{
+			Enabled: aws.Bool(false),
+		},
+		DNSServers:  e.DNSServers,
+		SplitTunnel: e.SplitTunnel,
+		VPCID:       e.VPC.TerraformLink(),
+		Tags:        e.Tags,
+	}
+	for _, sg := range e.SecurityGroups {
+		tf.SecurityGroupIDs = append(tf.SecurityGroupIDs, sg.TerraformLink())
+	}
+
+	if err := t.RenderResource("aws_ec2_client_vpn_endpoint", *e.Name, tf); err != nil {
+		return err
+	}
+
+	for i, cidr := range e.AuthorizedCIDRs {
+		rule := &terraformClientVPNAuthorizationRule{
+			ClientVPNEndpointID: e.TerraformLink(),
+			TargetNetworkCIDR:   fi.PtrTo(cidr),
+			AuthorizeAllGroups:  aws.Bool(true),
+		}
+		if err := t.RenderResource("aws_ec2_client_vpn_authorization_rule", fmt.Sprintf("%s-%d", *e.Name, i), rule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *ClientVPNEndpoint) TerraformLink() *terraformWriter.Literal {
+	return terraformWriter.LiteralProperty("aws_ec2_client_vpn_endpoint", *e.Name, "id")
+}
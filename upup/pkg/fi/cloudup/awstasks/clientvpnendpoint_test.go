@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstasks
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+func TestClientVPNEndpointCreate(t *testing.T) {
+	ctx := context.TODO()
+
+	cloud := awsup.BuildMockAWSCloud("us-east-1", "abc")
+	c := &mockec2.MockEC2{}
+	cloud.MockEC2 = c
+
+	// We define a function so we can rebuild the tasks, because we modify in-place when running
+	buildTasks := func(authorizedCIDRs ...string) map[string]fi.CloudupTask {
+		vpc1 := &VPC{
+			Name:      s("vpc1"),
+			Lifecycle: fi.LifecycleSync,
+			CIDR:      s("172.20.0.0/16"),
+			Tags:      map[string]string{"Name": "vpc1"},
+		}
+		subnet1 := &Subnet{
+			Name:                s("subnet1"),
+			Lifecycle:           fi.LifecycleSync,
+			VPC:                 vpc1,
+			CIDR:                s("172.20.1.0/24"),
+			ResourceBasedNaming: fi.PtrTo(true),
+			Tags:                map[string]string{"Name": "subnet1"},
+		}
+		sg1 := &SecurityGroup{
+			Name:        s("sg1"),
+			Lifecycle:   fi.LifecycleSync,
+			Description: s("Security group for the client VPN"),
+			VPC:         vpc1,
+			Tags:        map[string]string{"Name": "sg1"},
+		}
+		vpn1 := &ClientVPNEndpoint{
+			Name:                 s("vpn1"),
+			Lifecycle:            fi.LifecycleSync,
+			ClientCIDR:           s("10.200.0.0/16"),
+			ServerCertificateARN: s("arn:aws:acm:us-east-1:123456789012:certificate/server"),
+			ClientCertificateARN: s("arn:aws:acm:us-east-1:123456789012:certificate/client"),
+			VPC:                  vpc1,
+			SecurityGroups:       []*SecurityGroup{sg1},
+			DNSServers:           []string{"172.20.0.2"},
+			SplitTunnel:          fi.PtrTo(true),
+			AuthorizedCIDRs:      authorizedCIDRs,
+			Tags:                 map[string]string{"Name": "vpn1"},
+		}
+		association1 := &ClientVPNTargetNetwork{
+			Name:              s("subnet1.vpn1"),
+			Lifecycle:         fi.LifecycleSync,
+			ClientVPNEndpoint: vpn1,
+			Subnet:            subnet1,
+		}
+
+		return map[string]fi.CloudupTask{
+			"association1": association1,
+			"sg1":          sg1,
+			"subnet1":      subnet1,
+			"vpc1":         vpc1,
+			"vpn1":         vpn1,
+		}
+	}
+
+	{
+		allTasks := buildTasks("172.20.0.0/16")
+		vpn1 := allTasks["vpn1"].(*ClientVPNEndpoint)
+		association1 := allTasks["association1"].(*ClientVPNTargetNetwork)
+
+		runTasks(t, cloud, allTasks)
+
+		if fi.ValueOf(vpn1.ID) == "" || fi.ValueOf(association1.ID) == "" {
+			t.Fatalf("ID not set after create")
+		}
+		if len(c.ClientVpnEndpoints) != 1 {
+			t.Fatalf("Expected exactly one ClientVpnEndpoint; found %v", c.ClientVpnEndpoints)
+		}
+		if len(c.ClientVpnTargetNetworks) != 1 {
+			t.Fatalf("Expected exactly one ClientVpnTargetNetwork; found %v", c.ClientVpnTargetNetworks)
+		}
+
+		actual := c.ClientVpnEndpoints[*vpn1.ID]
+		if aws.ToString(actual.ClientCidrBlock) != "10.200.0.0/16" || aws.ToString(actual.VpcId) != "vpc-1" || !reflect.DeepEqual(actual.SecurityGroupIds, []string{"sg-1"}) {
+			t.Fatalf("Unexpected ClientVpnEndpoint: %v", actual)
+		}
+		if got := authorizedCIDRs(c, *vpn1.ID); !reflect.DeepEqual(got, []string{"172.20.0.0/16"}) {
+			t.Fatalf("Unexpected authorized CIDRs: %v", got)
+		}
+	}
+
+	{
+		allTasks := buildTasks("172.20.0.0/16")
+		checkNoChanges(t, ctx, cloud, allTasks)
+	}
+
+	{
+		allTasks := buildTasks("10.0.0.0/16")
+		vpn1 := allTasks["vpn1"].(*ClientVPNEndpoint)
+
+		runTasks(t, cloud, allTasks)
+
+		if got := authorizedCIDRs(c, *vpn1.ID); !reflect.DeepEqual(got, []string{"10.0.0.0/16"}) {
+			t.Fatalf("Unexpected authorized CIDRs after update: %v", got)
+		}
+	}
+}
+
+func authorizedCIDRs(c *mockec2.MockEC2, endpointID string) []string {
+	var cidrs []string
+	for _, rule := range c.ClientVpnAuthorizationRules {
+		if aws.ToString(rule.ClientVpnEndpointId) == endpointID {
+			cidrs = append(cidrs, aws.ToString(rule.DestinationCidr))
+		}
+	}
+	sort.Strings(cidrs)
+	return cidrs
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// ClientVPNTargetNetwork is the association of a Client VPN endpoint with a subnet
+// +kops:fitask
+type ClientVPNTargetNetwork struct {
+	Name      *string
+	Lifecycle fi.Lifecycle
+
+	// ID is the ID of the association
+	ID *string
+
+	ClientVPNEndpoint *ClientVPNEndpoint
+	Subnet            *Subnet
+}
+
+var _ fi.CompareWithID = &ClientVPNTargetNetwork{}
+
+func (e *ClientVPNTargetNetwork) CompareWithID() *string {
+	return e.ID
+}
+
+func (e *ClientVPNTargetNetwork) Find(c *fi.CloudupContext) (*ClientVPNTargetNetwork, error) {
+	ctx := c.Context()
+	cloud := awsup.GetCloud(c)
+
+	endpointID := e.ClientVPNEndpoint.ID
+	subnetID := e.Subnet.ID
+	if endpointID == nil || subnetID == nil {
+		return nil, nil
+	}
+
+	request := &ec2.DescribeClientVpnTargetNetworksInput{
+		ClientVpnEndpointId: endpointID,
+		Filters:             []ec2types.Filter{awsup.NewEC2Filter("target-network-id", *subnetID)},
+	}
+	response, err := cloud.EC2().DescribeClientVpnTargetNetworks(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("error listing target networks of Client VPN endpoint %q: %w", *endpointID, err)
+	}
+
+	for _, network := range response.ClientVpnTargetNetworks {
+		if network.Status != nil && (network.Status.Code == ec2types.AssociationStatusCodeDisassociating || network.Status.Code == ec2types.AssociationStatusCodeDisassociated) {
+			continue
+		}
+
+		actual := &ClientVPNTargetNetwork{
+			ID:                network.AssociationId,
+			ClientVPNEndpoint: &ClientVPNEndpoint{ID: network.ClientVpnEndpointId},
+			Subnet:            &Subnet{ID: network.TargetNetworkId},
+		}
+
+		klog.V(2).Infof("found matching Client VPN target network %q", fi.ValueOf(actual.ID))
+
+		// Prevent spurious comparison failures
+		actual.Name = e.Name
+		actual.Lifecycle = e.Lifecycle
+		if e.ID == nil {
+			e.ID = actual.ID
+		}
+
+		return actual, nil
+	}
+
+	return nil, nil
+}
+
+func (e *ClientVPNTargetNetwork) Run(c *fi.CloudupContext) error {
+	return fi.CloudupDefaultDeltaRunMethod(e, c)
+}
+
+func (_ *ClientVPNTargetNetwork) CheckChanges(a, e, changes *ClientVPNTargetNetwork) error {
+	if a == nil {
+		if e.ClientVPNEndpoint == nil {
+			return fi.RequiredField("ClientVPNEndpoint")
+		}
+		if e.Subnet == nil {
+			return fi.RequiredField("Subnet")
+		}
+	} else {
+		if changes.ClientVPNEndpoint != nil {
+			return fi.CannotChangeField("ClientVPNEndpoint")
+		}
+		if changes.Subnet != nil {
+			return fi.CannotChangeField("Subnet")
+		}
+	}
+	return nil
+}
+
+func (_ *ClientVPNTargetNetwork) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *ClientVPNTargetNetwork) error {
+	ctx := context.TODO()
+
+	if a == nil {
+		klog.V(2).Infof("Associating Client VPN endpoint %q with subnet %q", fi.ValueOf(e.ClientVPNEndpoint.ID), fi.ValueOf(e.Subnet.ID))
+
+		request := &ec2.AssociateClientVpnTargetNetworkInput{
+			ClientVpnEndpointId: e.ClientVPNEndpoint.ID,
+			SubnetId:            e.Subnet.ID,
+		}
+		response, err := t.Cloud.EC2().AssociateClientVpnTargetNetwork(ctx, request)
+		if err != nil {
+			return fmt.Errorf("error associating Client VPN endpoint %q with subnet %q: %w", fi.ValueOf(e.ClientVPNEndpoint.ID), fi.ValueOf(e.Subnet.ID), err)
+		}
+
+		e.ID = response.AssociationId
+	}
+
+	return nil
+}
+
+type terraformClientVPNTargetNetwork struct {
+	ClientVPNEndpointID *terraformWriter.Literal `cty:"client_vpn_endpoint_id"`
+	SubnetID            *terraformWriter.Literal `cty:"subnet_id"`
+}
+
+func (_ *ClientVPNTargetNetwork) RenderTerraform(t *terraform.TerraformTarget, a, e, changes *ClientVPNTargetNetwork) error {
+	tf := &terraformClientVPNTargetNetwork{
+		ClientVPNEndpointID: e.ClientVPNEndpoint.TerraformLink(),
+		SubnetID:            e.Subnet.TerraformLink(),
+	}
+
+	return t.RenderResource("aws_ec2_client_vpn_network_association", *e.Name, tf)
+}
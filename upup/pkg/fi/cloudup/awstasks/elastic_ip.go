@@ -98,27 +98,19 @@ func (e *ElasticIP) find(ctx context.Context, cloud awsup.AWSCloud) (*ElasticIP,
 	// Find via tag on subnet
 	// TODO: Deprecated, because doesn't round-trip with terraform
 	if allocationID == nil && publicIP == nil && e.TagOnSubnet != nil && e.TagOnSubnet.ID != nil {
-		var filters []ec2types.Filter
-		filters = append(filters, awsup.NewEC2Filter("key", "AssociatedElasticIp"))
-		filters = append(filters, awsup.NewEC2Filter("resource-id", *e.TagOnSubnet.ID))
-
-		request := &ec2.DescribeTagsInput{
-			Filters: filters,
-		}
-
-		response, err := cloud.EC2().DescribeTags(ctx, request)
+		tags, err := awsup.DescribeResourceTags(ctx, cloud, *e.TagOnSubnet.ID, "AssociatedElasticIp")
 		if err != nil {
 			return nil, fmt.Errorf("error listing tags: %v", err)
 		}
 
-		if response == nil || len(response.Tags) == 0 {
+		if len(tags) == 0 {
 			return nil, nil
 		}
 
-		if len(response.Tags) != 1 {
+		if len(tags) != 1 {
 			return nil, fmt.Errorf("found multiple tags for: %v", e)
 		}
-		t := response.Tags[0]
+		t := tags[0]
 		publicIP = t.Value
 		klog.V(2).Infof("Found public IP via tag: %v", *publicIP)
 	}
@@ -152,19 +144,12 @@ func (e *ElasticIP) find(ctx context.Context, cloud awsup.AWSCloud) (*ElasticIP,
 		actual.AssociatedNatGatewayRouteTable = e.AssociatedNatGatewayRouteTable
 
 		{
-			tags, err := cloud.EC2().DescribeTags(ctx, &ec2.DescribeTagsInput{
-				Filters: []ec2types.Filter{
-					{
-						Name:   aws.String("resource-id"),
-						Values: []string{aws.ToString(a.AllocationId)},
-					},
-				},
-			})
+			tags, err := awsup.DescribeResourceTags(ctx, cloud, aws.ToString(a.AllocationId))
 			if err != nil {
 				return nil, fmt.Errorf("error querying tags for ElasticIP: %v", err)
 			}
 			var ec2Tags []ec2types.Tag
-			for _, t := range tags.Tags {
+			for _, t := range tags {
 				ec2Tags = append(ec2Tags, ec2types.Tag{
 					Key:   t.Key,
 					Value: t.Value,
@@ -67,31 +67,26 @@ func (s *Instance) CompareWithID() *string {
 func (e *Instance) Find(c *fi.CloudupContext) (*Instance, error) {
 	ctx := c.Context()
 	cloud := awsup.GetCloud(c)
-	var request *ec2.DescribeInstancesInput
 
+	var instances []ec2types.Instance
 	if fi.ValueOf(e.Shared) {
-		var instanceIds []string
-		instanceIds = append(instanceIds, aws.ToString(e.ID))
-		request = &ec2.DescribeInstancesInput{
-			InstanceIds: instanceIds,
+		request := &ec2.DescribeInstancesInput{
+			InstanceIds: []string{aws.ToString(e.ID)},
 		}
-	} else {
-		filters := cloud.BuildFilters(e.Name)
-		filters = append(filters, awsup.NewEC2Filter("instance-state-name", "pending", "running", "stopping", "stopped"))
-		request = &ec2.DescribeInstancesInput{
-			Filters: filters,
+		response, err := cloud.EC2().DescribeInstances(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("error listing instances: %v", err)
 		}
-	}
-
-	response, err := cloud.EC2().DescribeInstances(ctx, request)
-	if err != nil {
-		return nil, fmt.Errorf("error listing instances: %v", err)
-	}
-
-	instances := []ec2types.Instance{}
-	if response != nil {
-		for _, reservation := range response.Reservations {
-			instances = append(instances, reservation.Instances...)
+		if response != nil {
+			for _, reservation := range response.Reservations {
+				instances = append(instances, reservation.Instances...)
+			}
+		}
+	} else {
+		var err error
+		instances, err = awsup.FindInstancesByName(ctx, cloud, fi.ValueOf(e.Name))
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -295,6 +290,7 @@ func (_ *Instance) RenderAWS(t *awsup.AWSAPITarget, a, e, changes *Instance) err
 		}
 
 		e.ID = response.Instances[0].InstanceId
+		t.Cloud.DescribeCache().InvalidateInstances()
 	}
 
 	return t.AddAWSTags(*e.ID, e.Tags)
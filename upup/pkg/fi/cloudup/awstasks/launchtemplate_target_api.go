@@ -149,6 +149,8 @@ func (t *LaunchTemplate) RenderAWS(c *awsup.AWSAPITarget, a, e, changes *LaunchT
 			CpuCredits: t.CPUCredits,
 		}
 	}
+	defer c.Cloud.DescribeCache().InvalidateLaunchTemplate(fi.ValueOf(t.Name))
+
 	// @step: attempt to create the launch template
 	if a == nil {
 		input := &ec2.CreateLaunchTemplateInput{
@@ -380,30 +382,7 @@ func (t *LaunchTemplate) findAllLaunchTemplates(c *fi.CloudupContext) ([]ec2type
 
 // findLatestLaunchTemplateVersion returns the latest template version
 func (t *LaunchTemplate) findLatestLaunchTemplateVersion(c *fi.CloudupContext) (*ec2types.LaunchTemplateVersion, error) {
-	ctx := c.Context()
-
-	cloud := awsup.GetCloud(c)
-
-	input := &ec2.DescribeLaunchTemplateVersionsInput{
-		LaunchTemplateName: t.Name,
-		Versions:           []string{("$Latest")},
-	}
-
-	output, err := cloud.EC2().DescribeLaunchTemplateVersions(ctx, input)
-	if err != nil {
-		if awsup.AWSErrorCode(err) == "InvalidLaunchTemplateName.NotFoundException" {
-			klog.V(4).Infof("Got InvalidLaunchTemplateName.NotFoundException error describing latest launch template version: %q", aws.ToString(t.Name))
-			return nil, nil
-		} else {
-			return nil, err
-		}
-	}
-
-	if len(output.LaunchTemplateVersions) == 0 {
-		return nil, nil
-	}
-
-	return &output.LaunchTemplateVersions[0], nil
+	return awsup.FindLatestLaunchTemplateVersion(c.Context(), awsup.GetCloud(c), fi.ValueOf(t.Name))
 }
 
 // deleteLaunchTemplate tracks a LaunchConfiguration that we're going to delete
@@ -436,6 +415,7 @@ func (d *deleteLaunchTemplate) Delete(t fi.CloudupTarget) error {
 	}); err != nil {
 		return fmt.Errorf("error deleting LaunchTemplate %s: error: %s", d.Item(), err)
 	}
+	awsTarget.Cloud.DescribeCache().InvalidateLaunchTemplate(d.Item())
 
 	return nil
 }
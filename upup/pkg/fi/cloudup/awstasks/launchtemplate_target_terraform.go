@@ -311,7 +311,7 @@ func (t *LaunchTemplate) RenderTerraform(target *terraform.TerraformTarget, a, e
 		tf.Tags = e.Tags
 	}
 
-	return target.RenderResource("aws_launch_template", fi.ValueOf(e.Name), tf)
+	return target.RenderInstanceGroupResource(fi.ValueOf(e.Name), "aws_launch_template", fi.ValueOf(e.Name), tf)
 }
 
 func createTerraformLaunchTemplateBlockDevice(deviceName string, v *BlockDeviceMapping) *terraformLaunchTemplateBlockDevice {
@@ -148,31 +148,24 @@ func (e *NatGateway) findNatGateway(c *fi.CloudupContext) (*ec2types.NatGateway,
 	// Find via tag on subnet
 	// TODO: Obsolete - we can get from the route table instead
 	if id == nil && e.Subnet != nil {
-		var filters []ec2types.Filter
-		filters = append(filters, awsup.NewEC2Filter("key", "AssociatedNatgateway"))
 		if e.Subnet.ID == nil {
 			klog.V(2).Infof("Unable to find subnet, bypassing Find() for NatGateway")
 			return nil, nil
 		}
-		filters = append(filters, awsup.NewEC2Filter("resource-id", *e.Subnet.ID))
 
-		request := &ec2.DescribeTagsInput{
-			Filters: filters,
-		}
-
-		response, err := cloud.EC2().DescribeTags(ctx, request)
+		tags, err := awsup.DescribeResourceTags(ctx, cloud, *e.Subnet.ID, "AssociatedNatgateway")
 		if err != nil {
 			return nil, fmt.Errorf("error listing tags: %v", err)
 		}
 
-		if response == nil || len(response.Tags) == 0 {
+		if len(tags) == 0 {
 			return nil, nil
 		}
 
-		if len(response.Tags) != 1 {
+		if len(tags) != 1 {
 			return nil, fmt.Errorf("found multiple tags for: %v", e)
 		}
-		t := response.Tags[0]
+		t := tags[0]
 		id = t.Value
 		klog.V(2).Infof("Found NatGateway via subnet tag: %v", *id)
 	}
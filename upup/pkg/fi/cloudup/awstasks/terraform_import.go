@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awstasks
+
+import (
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraform"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+)
+
+// This file maps existing AWS resources to terraform import blocks, used when
+// spec.terraform.importExistingResources is set.
+// Shared resources are never imported, because they are not managed by kOps.
+
+var (
+	_ terraform.Importable = &VPC{}
+	_ terraform.Importable = &Subnet{}
+	_ terraform.Importable = &InternetGateway{}
+	_ terraform.Importable = &SecurityGroup{}
+	_ terraform.Importable = &RouteTable{}
+	_ terraform.Importable = &NatGateway{}
+	_ terraform.Importable = &ElasticIP{}
+	_ terraform.Importable = &EBSVolume{}
+	_ terraform.Importable = &IAMRole{}
+	_ terraform.Importable = &LaunchTemplate{}
+	_ terraform.Importable = &AutoscalingGroup{}
+)
+
+func newImport(resourceType string, resourceName string, id *string) *terraformWriter.Import {
+	if id == nil || *id == "" {
+		return nil
+	}
+	return &terraformWriter.Import{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		ID:           *id,
+	}
+}
+
+func (e *VPC) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*VPC)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_vpc", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *Subnet) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*Subnet)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_subnet", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *InternetGateway) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*InternetGateway)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_internet_gateway", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *SecurityGroup) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*SecurityGroup)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_security_group", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *RouteTable) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*RouteTable)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_route_table", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *NatGateway) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*NatGateway)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_nat_gateway", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *ElasticIP) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*ElasticIP)
+	if !ok || fi.ValueOf(e.Shared) {
+		return nil
+	}
+	return newImport("aws_eip", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *EBSVolume) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*EBSVolume)
+	if !ok {
+		return nil
+	}
+	tfName, _ := e.TerraformName()
+	return newImport("aws_ebs_volume", tfName, a.ID)
+}
+
+func (e *IAMRole) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*IAMRole)
+	if !ok {
+		return nil
+	}
+	// IAM roles are imported by name
+	return newImport("aws_iam_role", fi.ValueOf(e.Name), a.Name)
+}
+
+func (e *LaunchTemplate) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*LaunchTemplate)
+	if !ok {
+		return nil
+	}
+	return newImport("aws_launch_template", fi.ValueOf(e.Name), a.ID)
+}
+
+func (e *AutoscalingGroup) TerraformImport(actual fi.CloudupTask) *terraformWriter.Import {
+	a, ok := actual.(*AutoscalingGroup)
+	if !ok {
+		return nil
+	}
+	// Autoscaling groups are imported by name
+	return newImport("aws_autoscaling_group", fi.ValueOf(e.Name), a.Name)
+}
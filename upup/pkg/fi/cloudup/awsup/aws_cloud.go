@@ -46,6 +46,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
 	"k8s.io/klog/v2"
 
 	v1 "k8s.io/api/core/v1"
@@ -186,6 +187,9 @@ type AWSCloud interface {
 
 	// AccountInfo returns the AWS account ID and AWS partition that we are deploying into
 	AccountInfo(ctx context.Context) (string, string, error)
+
+	// DescribeCache returns the cache of the EC2 describe calls made by the tasks of the cluster
+	DescribeCache() *DescribeCache
 }
 
 // GetCloud returns the AWSCloud in the CloudupContext.
@@ -218,6 +222,8 @@ type awsCloudImplementation struct {
 
 	instanceTypes *instanceTypes
 
+	describeCache *DescribeCache
+
 	config aws.Config
 }
 
@@ -274,14 +280,33 @@ func getCloudInstancesFromRegion(region string) AWSCloud {
 }
 
 func loadAWSConfig(ctx context.Context, region string) (aws.Config, error) {
+	apiOptions := []func(*middleware.Stack) error{addTracingMiddleware}
+
+	requestRateLimiter, err := processRequestRateLimiter()
+	if err != nil {
+		return aws.Config{}, err
+	}
+	if requestRateLimiter != nil {
+		apiOptions = append(apiOptions, addRequestRateLimitMiddleware(requestRateLimiter))
+	}
+
+	retryRateLimiter, err := retryRateLimiterFromEnv()
+	if err != nil {
+		return aws.Config{}, err
+	}
+
 	loadOptions := []func(*awsconfig.LoadOptions) error{
 		awsconfig.WithRegion(region),
 		awsconfig.WithClientLogMode(aws.LogRetries),
 		awsconfig.WithLogger(awsLogger{}),
+		awsconfig.WithAPIOptions(apiOptions),
 		awsconfig.WithRetryer(func() aws.Retryer {
 			return retry.NewAdaptiveMode(func(ao *retry.AdaptiveModeOptions) {
 				ao.StandardOptions = append(ao.StandardOptions, func(so *retry.StandardOptions) {
 					so.MaxAttempts = ClientMaxRetries
+					if retryRateLimiter != nil {
+						so.RateLimiter = retryRateLimiter
+					}
 				})
 			})
 		}),
@@ -313,6 +338,7 @@ func NewAWSCloud(region string, tags map[string]string) (AWSCloud, error) {
 			instanceTypes: &instanceTypes{
 				typeMap: make(map[string]*ec2types.InstanceTypeInfo),
 			},
+			describeCache: NewDescribeCache(),
 		}
 
 		cfg, err := loadAWSConfig(ctx, region)
@@ -1062,6 +1088,17 @@ func awsBuildCloudInstanceGroup(ctx context.Context, c AWSCloud, cluster *kops.C
 		return nil, fmt.Errorf("failed to fetch instances: %v", err)
 	}
 
+	// Instances only needing larger volumes are resized in place by kops-controller
+	upToDate := func(configName string) bool {
+		return configName == newConfigName
+	}
+	if awsSpec := cluster.Spec.CloudProvider.AWS; awsSpec != nil && fi.ValueOf(awsSpec.ResizeVolumesInPlace) {
+		checker := newVolumeResizeChecker(ctx, c, newConfigName)
+		upToDate = func(configName string) bool {
+			return configName == newConfigName || checker.onlyVolumesResized(configName)
+		}
+	}
+
 	cg := &cloudinstances.CloudInstanceGroup{
 		HumanName:     aws.ToString(g.AutoScalingGroupName),
 		InstanceGroup: ig,
@@ -1072,7 +1109,7 @@ func awsBuildCloudInstanceGroup(ctx context.Context, c AWSCloud, cluster *kops.C
 	}
 
 	for _, i := range g.Instances {
-		err := buildCloudInstance(i, instances, instanceSeen, nodeMap, cg, newConfigName)
+		err := buildCloudInstance(i, instances, instanceSeen, nodeMap, cg, upToDate)
 		if err != nil {
 			return nil, err
 		}
@@ -1085,7 +1122,7 @@ func awsBuildCloudInstanceGroup(ctx context.Context, c AWSCloud, cluster *kops.C
 		return nil, err
 	}
 	for _, i := range result.Instances {
-		err := buildCloudInstance(i, instances, instanceSeen, nodeMap, cg, newConfigName)
+		err := buildCloudInstance(i, instances, instanceSeen, nodeMap, cg, upToDate)
 		if err != nil {
 			return nil, err
 		}
@@ -1115,7 +1152,7 @@ func awsBuildCloudInstanceGroup(ctx context.Context, c AWSCloud, cluster *kops.C
 	return cg, nil
 }
 
-func buildCloudInstance(i autoscalingtypes.Instance, instances map[string]*ec2types.Instance, instanceSeen map[string]bool, nodeMap map[string]*v1.Node, cg *cloudinstances.CloudInstanceGroup, newConfigName string) error {
+func buildCloudInstance(i autoscalingtypes.Instance, instances map[string]*ec2types.Instance, instanceSeen map[string]bool, nodeMap map[string]*v1.Node, cg *cloudinstances.CloudInstanceGroup, upToDate func(configName string) bool) error {
 	id := aws.ToString(i.InstanceId)
 	if id == "" {
 		klog.Warningf("ignoring instance with no instance id: %s in autoscaling group: %s", id, cg.HumanName)
@@ -1132,7 +1169,7 @@ func buildCloudInstance(i autoscalingtypes.Instance, instances map[string]*ec2ty
 	}
 	currentConfigName := findInstanceLaunchConfiguration(i)
 	status := cloudinstances.CloudInstanceStatusUpToDate
-	if newConfigName != currentConfigName {
+	if !upToDate(currentConfigName) {
 		status = cloudinstances.CloudInstanceStatusNeedsUpdate
 	}
 	cm, err := cg.NewCloudInstance(id, status, nodeMap[id])
@@ -1224,9 +1261,14 @@ func (c *awsCloudImplementation) WithTags(tags map[string]string) AWSCloud {
 	i := &awsCloudImplementation{}
 	*i = *c
 	i.tags = tags
+	i.describeCache = NewDescribeCache()
 	return i
 }
 
+func (c *awsCloudImplementation) DescribeCache() *DescribeCache {
+	return c.describeCache
+}
+
 var tagsEventualConsistencyErrors = map[string]bool{
 	"InvalidInstanceID.NotFound":        true,
 	"InvalidRouteTableID.NotFound":      true,
@@ -1265,6 +1307,17 @@ func getTags(c AWSCloud, resourceID string) (map[string]string, error) {
 
 	tags := map[string]string{}
 
+	cached, found, err := c.DescribeCache().cachedTags(ctx, c, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		for _, tag := range cached {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		return tags, nil
+	}
+
 	request := &ec2.DescribeTagsInput{
 		Filters: []ec2types.Filter{
 			NewEC2Filter("resource-id", resourceID),
@@ -1314,6 +1367,7 @@ func createTags(c AWSCloud, resourceID string, tags map[string]string) error {
 		return nil
 	}
 	ctx := context.TODO()
+	defer c.DescribeCache().InvalidateTags(resourceID)
 
 	ec2Tags := []ec2types.Tag{}
 	for k, v := range tags {
@@ -1363,6 +1417,7 @@ func deleteTags(c AWSCloud, resourceID string, tags map[string]string) error {
 		return nil
 	}
 	ctx := context.TODO()
+	defer c.DescribeCache().InvalidateTags(resourceID)
 
 	ec2Tags := []ec2types.Tag{}
 	for k, v := range tags {
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+)
+
+// volumeResizeChecker finds the launch template versions of the instances of an autoscaling group
+// that only differ from the launch template version of the group by smaller EBS volumes.
+type volumeResizeChecker struct {
+	ctx   context.Context
+	cloud AWSCloud
+
+	// configName is the launch template version of the autoscaling group, as returned by findAutoscalingGroupLaunchConfiguration
+	configName string
+	// desired is the launch template data of the autoscaling group, once fetched
+	desired *ec2types.ResponseLaunchTemplateData
+	// results caches the result of onlyVolumesResized by launch template version
+	results map[string]bool
+}
+
+func newVolumeResizeChecker(ctx context.Context, cloud AWSCloud, configName string) *volumeResizeChecker {
+	return &volumeResizeChecker{
+		ctx:        ctx,
+		cloud:      cloud,
+		configName: configName,
+		results:    make(map[string]bool),
+	}
+}
+
+// onlyVolumesResized returns true if the launch template version of an instance only differs
+// from the launch template version of its autoscaling group by smaller EBS volumes.
+func (r *volumeResizeChecker) onlyVolumesResized(configName string) bool {
+	if result, found := r.results[configName]; found {
+		return result
+	}
+
+	result, err := r.compare(configName)
+	if err != nil {
+		klog.Warningf("error comparing launch template %q with %q: %v", configName, r.configName, err)
+	}
+	r.results[configName] = result
+	return result
+}
+
+func (r *volumeResizeChecker) compare(configName string) (bool, error) {
+	id, version, found := strings.Cut(configName, ":")
+	if !found {
+		// Launch configurations can't be compared
+		return false, nil
+	}
+	desiredID, desiredVersion, found := strings.Cut(r.configName, ":")
+	if !found || id != desiredID {
+		return false, nil
+	}
+
+	versions := []string{version}
+	if r.desired == nil {
+		versions = append(versions, desiredVersion)
+	}
+	data, err := describeLaunchTemplateVersions(r.ctx, r.cloud, id, versions)
+	if err != nil {
+		return false, err
+	}
+	if r.desired == nil {
+		r.desired = data[desiredVersion]
+	}
+	return onlyVolumeSizesIncreased(data[version], r.desired), nil
+}
+
+// describeLaunchTemplateVersions returns the data of the versions of a launch template, by version number
+func describeLaunchTemplateVersions(ctx context.Context, c AWSCloud, id string, versions []string) (map[string]*ec2types.ResponseLaunchTemplateData, error) {
+	request := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+		Versions:         versions,
+	}
+	response, err := c.EC2().DescribeLaunchTemplateVersions(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("error describing versions %v of launch template %q: %w", versions, id, err)
+	}
+
+	data := make(map[string]*ec2types.ResponseLaunchTemplateData)
+	for _, version := range response.LaunchTemplateVersions {
+		data[strconv.FormatInt(aws.ToInt64(version.VersionNumber), 10)] = version.LaunchTemplateData
+	}
+	return data, nil
+}
+
+// onlyVolumeSizesIncreased returns true if the desired launch template data only differs from the current one
+// by larger EBS volumes.
+func onlyVolumeSizesIncreased(current, desired *ec2types.ResponseLaunchTemplateData) bool {
+	if current == nil || desired == nil {
+		return false
+	}
+
+	currentSizes := ebsVolumeSizes(current)
+	for device, size := range ebsVolumeSizes(desired) {
+		if size < currentSizes[device] {
+			return false
+		}
+	}
+
+	return reflect.DeepEqual(withoutVolumeSizes(current), withoutVolumeSizes(desired))
+}
+
+// ebsVolumeSizes returns the sizes of the EBS volumes of the launch template data, by device name
+func ebsVolumeSizes(data *ec2types.ResponseLaunchTemplateData) map[string]int32 {
+	sizes := make(map[string]int32)
+	for _, mapping := range data.BlockDeviceMappings {
+		if mapping.Ebs != nil && mapping.Ebs.VolumeSize != nil {
+			sizes[aws.ToString(mapping.DeviceName)] = aws.ToInt32(mapping.Ebs.VolumeSize)
+		}
+	}
+	return sizes
+}
+
+// withoutVolumeSizes returns a copy of the launch template data without the sizes of its EBS volumes
+func withoutVolumeSizes(data *ec2types.ResponseLaunchTemplateData) *ec2types.ResponseLaunchTemplateData {
+	out := *data
+	out.BlockDeviceMappings = nil
+	for _, mapping := range data.BlockDeviceMappings {
+		if mapping.Ebs != nil {
+			ebs := *mapping.Ebs
+			ebs.VolumeSize = nil
+			mapping.Ebs = &ebs
+		}
+		out.BlockDeviceMappings = append(out.BlockDeviceMappings, mapping)
+	}
+	return &out
+}
+
+// ResizeVolumesInPlace grows the EBS volumes of the instances of the cluster whose launch template
+// only differs from the launch template of their autoscaling group by smaller volumes.
+// It returns the IDs of the volumes being resized.
+func ResizeVolumesInPlace(ctx context.Context, c AWSCloud) ([]string, error) {
+	asgs, err := FindAutoscalingGroups(c, c.Tags())
+	if err != nil {
+		return nil, fmt.Errorf("unable to find autoscale groups: %w", err)
+	}
+
+	// The desired sizes of the volumes of the instances, by instance ID and device name
+	desiredSizes := make(map[string]map[string]int32)
+	for _, g := range asgs {
+		newConfigName, err := findAutoscalingGroupLaunchConfiguration(ctx, c, g)
+		if err != nil {
+			return nil, err
+		}
+
+		checker := newVolumeResizeChecker(ctx, c, newConfigName)
+		for _, i := range g.Instances {
+			if i.LifecycleState == autoscalingtypes.LifecycleStateTerminating {
+				continue
+			}
+			configName := findInstanceLaunchConfiguration(i)
+			if configName == newConfigName || !checker.onlyVolumesResized(configName) {
+				continue
+			}
+			desiredSizes[aws.ToString(i.InstanceId)] = ebsVolumeSizes(checker.desired)
+		}
+	}
+	if len(desiredSizes) == 0 {
+		return nil, nil
+	}
+
+	instanceIDs := make([]string, 0, len(desiredSizes))
+	for id := range desiredSizes {
+		instanceIDs = append(instanceIDs, id)
+	}
+
+	var resized []string
+	var errs []error
+	for i := 0; i < len(instanceIDs); i += 200 {
+		request := &ec2.DescribeVolumesInput{
+			Filters: []ec2types.Filter{
+				NewEC2Filter("attachment.instance-id", instanceIDs[i:minInt(i+200, len(instanceIDs))]...),
+			},
+		}
+		paginator := ec2.NewDescribeVolumesPaginator(c.EC2(), request)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return resized, fmt.Errorf("error listing volumes: %w", err)
+			}
+			for _, volume := range page.Volumes {
+				for _, attachment := range volume.Attachments {
+					size := desiredSizes[aws.ToString(attachment.InstanceId)][aws.ToString(attachment.Device)]
+					if size <= aws.ToInt32(volume.Size) {
+						continue
+					}
+
+					klog.Infof("resizing volume %q of instance %q from %dGiB to %dGiB", aws.ToString(volume.VolumeId), aws.ToString(attachment.InstanceId), aws.ToInt32(volume.Size), size)
+					_, err := c.EC2().ModifyVolume(ctx, &ec2.ModifyVolumeInput{
+						VolumeId: volume.VolumeId,
+						Size:     aws.Int32(size),
+					})
+					if err != nil {
+						// Volumes can only be modified every six hours; keep resizing the others
+						errs = append(errs, fmt.Errorf("error resizing volume %q: %w", aws.ToString(volume.VolumeId), err))
+						continue
+					}
+					resized = append(resized, aws.ToString(volume.VolumeId))
+				}
+			}
+		}
+	}
+
+	return resized, errors.Join(errs...)
+}
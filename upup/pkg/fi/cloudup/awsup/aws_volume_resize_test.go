@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestOnlyVolumeSizesIncreased(t *testing.T) {
+	launchTemplateData := func(rootSize, dataSize int32, instanceType string) *ec2types.ResponseLaunchTemplateData {
+		return &ec2types.ResponseLaunchTemplateData{
+			ImageId:      aws.String("ami-12345678"),
+			InstanceType: ec2types.InstanceType(instanceType),
+			BlockDeviceMappings: []ec2types.LaunchTemplateBlockDeviceMapping{
+				{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs: &ec2types.LaunchTemplateEbsBlockDevice{
+						VolumeSize: aws.Int32(rootSize),
+						VolumeType: ec2types.VolumeTypeGp3,
+					},
+				},
+				{
+					DeviceName: aws.String("/dev/xvdd"),
+					Ebs: &ec2types.LaunchTemplateEbsBlockDevice{
+						VolumeSize: aws.Int32(dataSize),
+						VolumeType: ec2types.VolumeTypeGp3,
+					},
+				},
+			},
+		}
+	}
+
+	grid := []struct {
+		Name     string
+		Current  *ec2types.ResponseLaunchTemplateData
+		Desired  *ec2types.ResponseLaunchTemplateData
+		Expected bool
+	}{
+		{
+			Name:     "unchanged",
+			Current:  launchTemplateData(64, 100, "m5.large"),
+			Desired:  launchTemplateData(64, 100, "m5.large"),
+			Expected: true,
+		},
+		{
+			Name:     "larger root volume",
+			Current:  launchTemplateData(64, 100, "m5.large"),
+			Desired:  launchTemplateData(128, 100, "m5.large"),
+			Expected: true,
+		},
+		{
+			Name:     "larger volumes",
+			Current:  launchTemplateData(64, 100, "m5.large"),
+			Desired:  launchTemplateData(128, 200, "m5.large"),
+			Expected: true,
+		},
+		{
+			Name:     "smaller volume",
+			Current:  launchTemplateData(64, 100, "m5.large"),
+			Desired:  launchTemplateData(128, 50, "m5.large"),
+			Expected: false,
+		},
+		{
+			Name:     "larger volume and other change",
+			Current:  launchTemplateData(64, 100, "m5.large"),
+			Desired:  launchTemplateData(128, 100, "m5.xlarge"),
+			Expected: false,
+		},
+		{
+			Name:     "unknown launch template data",
+			Current:  nil,
+			Desired:  launchTemplateData(128, 100, "m5.large"),
+			Expected: false,
+		},
+	}
+
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			actual := onlyVolumeSizesIncreased(g.Current, g.Desired)
+			if actual != g.Expected {
+				t.Errorf("expected %v, got %v", g.Expected, actual)
+			}
+		})
+	}
+
+	{
+		current := launchTemplateData(64, 100, "m5.large")
+		desired := launchTemplateData(64, 100, "m5.large")
+		desired.BlockDeviceMappings[1].Ebs.VolumeType = ec2types.VolumeTypeIo2
+		if onlyVolumeSizesIncreased(current, desired) {
+			t.Errorf("expected a change of volume type to require replacing the instances")
+		}
+		if aws.ToInt32(desired.BlockDeviceMappings[0].Ebs.VolumeSize) != 64 {
+			t.Errorf("launch template data was modified by the comparison")
+		}
+	}
+}
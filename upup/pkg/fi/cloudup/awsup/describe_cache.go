@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/featureflag"
+)
+
+// describeTagsBatchSize is the maximum number of resources in the resource-id filter of a DescribeTags call
+const describeTagsBatchSize = 200
+
+// describeCacheTagResourceTypes are the types of the resources whose tags are cached; the tags of the instances
+// and volumes of the cluster are left out, as there may be many of them and the tasks don't look them up.
+var describeCacheTagResourceTypes = []string{
+	string(ec2types.ResourceTypeDhcpOptions),
+	string(ec2types.ResourceTypeEgressOnlyInternetGateway),
+	string(ec2types.ResourceTypeElasticIp),
+	string(ec2types.ResourceTypeInternetGateway),
+	string(ec2types.ResourceTypeLaunchTemplate),
+	string(ec2types.ResourceTypeNatgateway),
+	string(ec2types.ResourceTypeRouteTable),
+	string(ec2types.ResourceTypeSecurityGroup),
+	string(ec2types.ResourceTypeSubnet),
+	string(ec2types.ResourceTypeVpc),
+}
+
+// DescribeCache caches the EC2 describe calls made by the tasks of a cluster, for the lifetime of an AWSCloud,
+// which is a single run of kops. The first lookup of an instance or of the tags of a resource describes those
+// of all the resources of the cluster in bulk, rather than each task describing its own. Launch template versions
+// can only be described one template at a time, so they are cached but not batched.
+// Changes made through kops invalidate the affected entries; resources which aren't tagged with the cluster,
+// such as shared subnets, are always described directly.
+type DescribeCache struct {
+	mutex sync.Mutex
+
+	// instances are the instances of the cluster which are not terminated, nil until loaded
+	instances []ec2types.Instance
+	// tags are the tags of the resources tagged with the cluster by resource ID, nil until loaded
+	tags map[string][]ec2types.TagDescription
+	// launchTemplateVersions are the latest versions of the launch templates by name, nil for those which don't exist
+	launchTemplateVersions map[string]*ec2types.LaunchTemplateVersion
+}
+
+// NewDescribeCache returns an empty cache
+func NewDescribeCache() *DescribeCache {
+	return &DescribeCache{
+		launchTemplateVersions: make(map[string]*ec2types.LaunchTemplateVersion),
+	}
+}
+
+func (d *DescribeCache) enabled() bool {
+	return d != nil && featureflag.AWSDescribeCache.Enabled()
+}
+
+// InvalidateInstances drops the cached instances, after instances were created or terminated
+func (d *DescribeCache) InvalidateInstances() {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.instances = nil
+}
+
+// InvalidateTags drops the cached tags of a resource, after they changed
+func (d *DescribeCache) InvalidateTags(resourceID string) {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.tags, resourceID)
+}
+
+// InvalidateLaunchTemplate drops the cached latest version of a launch template, after it changed
+func (d *DescribeCache) InvalidateLaunchTemplate(name string) {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.launchTemplateVersions, name)
+}
+
+// FindInstancesByName returns the instances of the cluster with a name which are not terminated
+func FindInstancesByName(ctx context.Context, c AWSCloud, name string) ([]ec2types.Instance, error) {
+	cache := c.DescribeCache()
+	if !cache.enabled() {
+		filters := c.BuildFilters(&name)
+		filters = append(filters, NewEC2Filter("instance-state-name", "pending", "running", "stopping", "stopped"))
+		return describeInstances(ctx, c, filters)
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.instances == nil {
+		var filters []ec2types.Filter
+		for k, v := range c.Tags() {
+			filters = append(filters, NewEC2Filter("tag:"+k, v))
+		}
+		filters = append(filters, NewEC2Filter("instance-state-name", "pending", "running", "stopping", "stopped"))
+		instances, err := describeInstances(ctx, c, filters)
+		if err != nil {
+			return nil, err
+		}
+		klog.V(2).Infof("described %d instances of the cluster", len(instances))
+		cache.instances = append([]ec2types.Instance{}, instances...)
+	}
+
+	var instances []ec2types.Instance
+	for _, instance := range cache.instances {
+		if v, ok := FindEC2Tag(instance.Tags, "Name"); ok && v == name {
+			instances = append(instances, instance)
+		}
+	}
+	return instances, nil
+}
+
+func describeInstances(ctx context.Context, c AWSCloud, filters []ec2types.Filter) ([]ec2types.Instance, error) {
+	var instances []ec2types.Instance
+	paginator := ec2.NewDescribeInstancesPaginator(c.EC2(), &ec2.DescribeInstancesInput{Filters: filters})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing instances: %w", err)
+		}
+		for _, reservation := range page.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+	}
+	return instances, nil
+}
+
+// cachedTags returns the cached tags of a resource, and whether the resource is cached.
+// On first use, it loads the tags of all the resources tagged with the cluster.
+func (d *DescribeCache) cachedTags(ctx context.Context, c AWSCloud, resourceID string) ([]ec2types.TagDescription, bool, error) {
+	if !d.enabled() {
+		return nil, false, nil
+	}
+	clusterName := c.Tags()[TagClusterName]
+	if clusterName == "" {
+		return nil, false, nil
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.tags == nil {
+		tags, err := describeClusterTags(ctx, c, clusterName)
+		if err != nil {
+			return nil, false, err
+		}
+		klog.V(2).Infof("described the tags of %d resources of the cluster", len(tags))
+		d.tags = tags
+	}
+
+	tags, found := d.tags[resourceID]
+	return tags, found, nil
+}
+
+// describeClusterTags describes the tags of the resources tagged with the cluster:
+// first the IDs of the resources, then all their tags, in batches
+func describeClusterTags(ctx context.Context, c AWSCloud, clusterName string) (map[string][]ec2types.TagDescription, error) {
+	var resourceIDs []string
+	{
+		request := &ec2.DescribeTagsInput{
+			Filters: []ec2types.Filter{
+				NewEC2Filter("key", TagClusterName),
+				NewEC2Filter("value", clusterName),
+				NewEC2Filter("resource-type", describeCacheTagResourceTypes...),
+			},
+		}
+		paginator := ec2.NewDescribeTagsPaginator(c.EC2(), request)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error listing the resources of cluster %q: %w", clusterName, err)
+			}
+			for _, tag := range page.Tags {
+				resourceIDs = append(resourceIDs, aws.ToString(tag.ResourceId))
+			}
+		}
+	}
+
+	tags := make(map[string][]ec2types.TagDescription)
+	for _, id := range resourceIDs {
+		tags[id] = []ec2types.TagDescription{}
+	}
+	for start := 0; start < len(resourceIDs); start += describeTagsBatchSize {
+		end := min(start+describeTagsBatchSize, len(resourceIDs))
+		request := &ec2.DescribeTagsInput{
+			Filters: []ec2types.Filter{
+				NewEC2Filter("resource-id", resourceIDs[start:end]...),
+			},
+		}
+		paginator := ec2.NewDescribeTagsPaginator(c.EC2(), request)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("error listing the tags of the resources of cluster %q: %w", clusterName, err)
+			}
+			for _, tag := range page.Tags {
+				id := aws.ToString(tag.ResourceId)
+				tags[id] = append(tags[id], tag)
+			}
+		}
+	}
+	return tags, nil
+}
+
+// DescribeResourceTags returns the tags of a resource, optionally only those with one of the keys
+func DescribeResourceTags(ctx context.Context, c AWSCloud, resourceID string, keys ...string) ([]ec2types.TagDescription, error) {
+	cached, found, err := c.DescribeCache().cachedTags(ctx, c, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		if len(keys) == 0 {
+			return cached, nil
+		}
+		var tags []ec2types.TagDescription
+		for _, tag := range cached {
+			for _, key := range keys {
+				if aws.ToString(tag.Key) == key {
+					tags = append(tags, tag)
+				}
+			}
+		}
+		return tags, nil
+	}
+
+	filters := []ec2types.Filter{NewEC2Filter("resource-id", resourceID)}
+	if len(keys) > 0 {
+		filters = append(filters, NewEC2Filter("key", keys...))
+	}
+	response, err := c.EC2().DescribeTags(ctx, &ec2.DescribeTagsInput{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tags, nil
+}
+
+// FindLatestLaunchTemplateVersion returns the latest version of a launch template, or nil if it doesn't exist
+func FindLatestLaunchTemplateVersion(ctx context.Context, c AWSCloud, name string) (*ec2types.LaunchTemplateVersion, error) {
+	cache := c.DescribeCache()
+	if cache.enabled() {
+		cache.mutex.Lock()
+		version, found := cache.launchTemplateVersions[name]
+		cache.mutex.Unlock()
+		if found {
+			return version, nil
+		}
+	}
+
+	version, err := describeLatestLaunchTemplateVersion(ctx, c, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if cache.enabled() {
+		cache.mutex.Lock()
+		cache.launchTemplateVersions[name] = version
+		cache.mutex.Unlock()
+	}
+	return version, nil
+}
+
+func describeLatestLaunchTemplateVersion(ctx context.Context, c AWSCloud, name string) (*ec2types.LaunchTemplateVersion, error) {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(name),
+		Versions:           []string{"$Latest"},
+	}
+
+	output, err := c.EC2().DescribeLaunchTemplateVersions(ctx, input)
+	if err != nil {
+		if AWSErrorCode(err) == "InvalidLaunchTemplateName.NotFoundException" {
+			klog.V(4).Infof("Got InvalidLaunchTemplateName.NotFoundException error describing latest launch template version: %q", name)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(output.LaunchTemplateVersions) == 0 {
+		return nil, nil
+	}
+	return &output.LaunchTemplateVersions[0], nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"k8s.io/kops/cloudmock/aws/mockec2"
+	"k8s.io/kops/util/pkg/awsinterfaces"
+)
+
+// countingEC2 counts the describe calls made to the EC2 API
+type countingEC2 struct {
+	awsinterfaces.EC2API
+	describeTags                   int
+	describeLaunchTemplateVersions int
+}
+
+func (c *countingEC2) DescribeTags(ctx context.Context, request *ec2.DescribeTagsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeTagsOutput, error) {
+	c.describeTags++
+	return c.EC2API.DescribeTags(ctx, request, optFns...)
+}
+
+func (c *countingEC2) DescribeLaunchTemplateVersions(ctx context.Context, request *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	c.describeLaunchTemplateVersions++
+	return c.EC2API.DescribeLaunchTemplateVersions(ctx, request, optFns...)
+}
+
+func buildDescribeCacheCloud(t *testing.T) (AWSCloud, *countingEC2) {
+	ctx := context.Background()
+	mock := &mockec2.MockEC2{}
+	for _, id := range []string{"subnet-1", "subnet-2", "vpc-1"} {
+		if _, err := mock.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags: []ec2types.Tag{
+				{Key: aws.String(TagClusterName), Value: aws.String("test.k8s.io")},
+				{Key: aws.String("Name"), Value: aws.String(id)},
+			},
+		}); err != nil {
+			t.Fatalf("error creating tags: %v", err)
+		}
+	}
+	if _, err := mock.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: []string{"subnet-shared"},
+		Tags:      []ec2types.Tag{{Key: aws.String("AssociatedNatgateway"), Value: aws.String("nat-1")}},
+	}); err != nil {
+		t.Fatalf("error creating tags: %v", err)
+	}
+
+	counting := &countingEC2{EC2API: mock}
+	cloud := BuildMockAWSCloud("us-test-1", "a")
+	cloud.MockEC2 = counting
+	return cloud.WithTags(map[string]string{TagClusterName: "test.k8s.io"}), counting
+}
+
+func TestDescribeResourceTags(t *testing.T) {
+	ctx := context.Background()
+	cloud, counting := buildDescribeCacheCloud(t)
+
+	// The tags of the resources of the cluster are described in bulk on first use
+	for _, id := range []string{"subnet-1", "subnet-2", "vpc-1", "subnet-1"} {
+		tags, err := DescribeResourceTags(ctx, cloud, id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(tags) != 2 {
+			t.Errorf("expected 2 tags for %s, got %v", id, tags)
+		}
+	}
+	if counting.describeTags != 2 {
+		t.Errorf("expected 2 DescribeTags calls, got %d", counting.describeTags)
+	}
+
+	tags, err := DescribeResourceTags(ctx, cloud, "subnet-2", "Name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 1 || aws.ToString(tags[0].Value) != "subnet-2" {
+		t.Errorf("unexpected tags %v", tags)
+	}
+
+	// Resources which aren't tagged with the cluster are described directly
+	tags, err = DescribeResourceTags(ctx, cloud, "subnet-shared", "AssociatedNatgateway")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 1 || aws.ToString(tags[0].Value) != "nat-1" {
+		t.Errorf("unexpected tags %v", tags)
+	}
+	if counting.describeTags != 3 {
+		t.Errorf("expected 3 DescribeTags calls, got %d", counting.describeTags)
+	}
+
+	// Changing the tags of a resource invalidates them
+	if err := cloud.CreateTags("subnet-1", map[string]string{"AssociatedElasticIp": "192.0.2.1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tags, err = DescribeResourceTags(ctx, cloud, "subnet-1", "AssociatedElasticIp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tags) != 1 || aws.ToString(tags[0].Value) != "192.0.2.1" {
+		t.Errorf("unexpected tags %v", tags)
+	}
+}
+
+func TestFindLatestLaunchTemplateVersion(t *testing.T) {
+	ctx := context.Background()
+	cloud, counting := buildDescribeCacheCloud(t)
+
+	if _, err := cloud.EC2().CreateLaunchTemplate(ctx, &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String("nodes.test.k8s.io"),
+		LaunchTemplateData: &ec2types.RequestLaunchTemplateData{ImageId: aws.String("ami-12345678")},
+	}); err != nil {
+		t.Fatalf("error creating launch template: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		version, err := FindLatestLaunchTemplateVersion(ctx, cloud, "nodes.test.k8s.io")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if version == nil || aws.ToString(version.LaunchTemplateData.ImageId) != "ami-12345678" {
+			t.Errorf("unexpected version %+v", version)
+		}
+	}
+	if counting.describeLaunchTemplateVersions != 1 {
+		t.Errorf("expected 1 DescribeLaunchTemplateVersions call, got %d", counting.describeLaunchTemplateVersions)
+	}
+
+	cloud.DescribeCache().InvalidateLaunchTemplate("nodes.test.k8s.io")
+	if _, err := FindLatestLaunchTemplateVersion(ctx, cloud, "nodes.test.k8s.io"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if counting.describeLaunchTemplateVersions != 2 {
+		t.Errorf("expected 2 DescribeLaunchTemplateVersions calls, got %d", counting.describeLaunchTemplateVersions)
+	}
+
+	version, err := FindLatestLaunchTemplateVersion(ctx, cloud, "missing.test.k8s.io")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != nil {
+		t.Errorf("expected no version, got %+v", version)
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/kops/pkg/resources/spotinst"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/util/pkg/awsinterfaces"
+	"k8s.io/kops/util/pkg/awspartition"
 )
 
 type MockAWSCloud struct {
@@ -41,6 +42,8 @@ type MockAWSCloud struct {
 	tags   map[string]string
 
 	zones []ec2types.AvailabilityZone
+
+	describeCache *DescribeCache
 }
 
 var _ fi.Cloud = (*MockAWSCloud)(nil)
@@ -55,7 +58,7 @@ func InstallMockAWSCloud(region string, zoneLetters string) *MockAWSCloud {
 }
 
 func BuildMockAWSCloud(region string, zoneLetters string) *MockAWSCloud {
-	i := &MockAWSCloud{region: region}
+	i := &MockAWSCloud{region: region, describeCache: NewDescribeCache()}
 	for _, c := range zoneLetters {
 		azName := fmt.Sprintf("%s%c", region, c)
 		az := ec2types.AvailabilityZone{
@@ -226,9 +229,14 @@ func (c *MockAWSCloud) WithTags(tags map[string]string) AWSCloud {
 	m := &MockAWSCloud{}
 	*m = *c
 	m.tags = tags
+	m.describeCache = NewDescribeCache()
 	return m
 }
 
+func (c *MockAWSCloud) DescribeCache() *DescribeCache {
+	return c.describeCache
+}
+
 func (c *MockAWSCloud) EC2() awsinterfaces.EC2API {
 	if c.MockEC2 == nil {
 		klog.Fatalf("MockAWSCloud MockEC2 not set")
@@ -382,8 +390,13 @@ func (c *MockAWSCloud) DescribeInstanceType(instanceType string) (*ec2types.Inst
 }
 
 // AccountInfo returns the AWS account ID and AWS partition that we are deploying into
+// The partition is "aws-test" in the standard partition, and the partition of the region otherwise.
 func (c *MockAWSCloud) AccountInfo(ctx context.Context) (string, string, error) {
-	return "123456789012", "aws-test", nil
+	partition := awspartition.ForRegion(c.Region())
+	if partition == awspartition.Standard {
+		return "123456789012", "aws-test", nil
+	}
+	return "123456789012", partition.ID, nil
 }
 
 func (c *MockAWSCloud) Config() aws.Config {
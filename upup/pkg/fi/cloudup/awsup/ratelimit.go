@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// envMaxRequestsPerSecond limits the rate of AWS API requests (including retries) made by a single kOps process.
+	envMaxRequestsPerSecond = "KOPS_AWS_MAX_REQUESTS_PER_SECOND"
+	// envRetryBudget overrides the size of the AWS SDK retry token bucket; retries fail fast once it is exhausted.
+	envRetryBudget = "KOPS_AWS_RETRY_BUDGET"
+)
+
+var (
+	sharedRequestRateLimiterMutex sync.Mutex
+	// sharedRequestRateLimiter was built for the value sharedRequestRateLimiterEnv of envMaxRequestsPerSecond
+	sharedRequestRateLimiter    *rate.Limiter
+	sharedRequestRateLimiterEnv string
+)
+
+// processRequestRateLimiter returns the request rate limiter configured in the environment, shared by all the AWS configs
+// built by the process, so that the limit applies to the process as a whole. It returns nil if none is configured.
+func processRequestRateLimiter() (*rate.Limiter, error) {
+	sharedRequestRateLimiterMutex.Lock()
+	defer sharedRequestRateLimiterMutex.Unlock()
+
+	s := os.Getenv(envMaxRequestsPerSecond)
+	if sharedRequestRateLimiter != nil && s == sharedRequestRateLimiterEnv {
+		return sharedRequestRateLimiter, nil
+	}
+	limiter, err := requestRateLimiterFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	sharedRequestRateLimiter = limiter
+	sharedRequestRateLimiterEnv = s
+	return limiter, nil
+}
+
+// requestRateLimiterFromEnv returns the client-side request rate limiter configured in the environment, or nil if none is configured.
+func requestRateLimiterFromEnv() (*rate.Limiter, error) {
+	s := os.Getenv(envMaxRequestsPerSecond)
+	if s == "" {
+		return nil, nil
+	}
+	qps, err := strconv.ParseFloat(s, 64)
+	if err != nil || qps <= 0 {
+		return nil, fmt.Errorf("invalid value %q for %s, must be a positive number", s, envMaxRequestsPerSecond)
+	}
+	burst := int(math.Ceil(qps))
+	return rate.NewLimiter(rate.Limit(qps), burst), nil
+}
+
+// retryRateLimiterFromEnv returns the retry token bucket configured in the environment, or nil to use the SDK default.
+func retryRateLimiterFromEnv() (retry.RateLimiter, error) {
+	s := os.Getenv(envRetryBudget)
+	if s == "" {
+		return nil, nil
+	}
+	tokens, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q for %s, must be a non-negative integer", s, envRetryBudget)
+	}
+	if tokens == 0 {
+		// Zero disables the retry budget entirely
+		return ratelimit.None, nil
+	}
+	return ratelimit.NewTokenRateLimit(uint(tokens)), nil
+}
+
+// addRequestRateLimitMiddleware returns a middleware that waits for the limiter before sending each request attempt.
+// It runs after the retry middleware, so that retries are also rate limited.
+func addRequestRateLimitMiddleware(limiter *rate.Limiter) func(stack *middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc("kopsRequestRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			return next.HandleFinalize(ctx, in)
+		}), "Retry", middleware.After)
+	}
+}
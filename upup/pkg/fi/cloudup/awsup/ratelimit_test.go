@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+)
+
+func TestRequestRateLimiterFromEnv(t *testing.T) {
+	grid := []struct {
+		value     string
+		wantNil   bool
+		wantBurst int
+		wantErr   bool
+	}{
+		{value: "", wantNil: true},
+		{value: "10", wantBurst: 10},
+		{value: "2.5", wantBurst: 3},
+		{value: "0", wantErr: true},
+		{value: "fast", wantErr: true},
+	}
+	for _, g := range grid {
+		t.Run(g.value, func(t *testing.T) {
+			t.Setenv(envMaxRequestsPerSecond, g.value)
+			limiter, err := requestRateLimiterFromEnv()
+			if g.wantErr {
+				if err == nil {
+					t.Errorf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if g.wantNil {
+				if limiter != nil {
+					t.Errorf("expected no limiter")
+				}
+				return
+			}
+			if limiter.Burst() != g.wantBurst {
+				t.Errorf("unexpected burst %d, want %d", limiter.Burst(), g.wantBurst)
+			}
+		})
+	}
+}
+
+func TestProcessRequestRateLimiter(t *testing.T) {
+	t.Setenv(envMaxRequestsPerSecond, "10")
+	first, err := processRequestRateLimiter()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := processRequestRateLimiter()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first == nil || first != second {
+		t.Errorf("expected the limiter to be shared, got %p and %p", first, second)
+	}
+
+	t.Setenv(envMaxRequestsPerSecond, "20")
+	third, err := processRequestRateLimiter()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if third == first || third.Burst() != 20 {
+		t.Errorf("expected a new limiter for the changed limit, got burst %d", third.Burst())
+	}
+}
+
+func TestRetryRateLimiterFromEnv(t *testing.T) {
+	t.Setenv(envRetryBudget, "")
+	if limiter, err := retryRateLimiterFromEnv(); err != nil || limiter != nil {
+		t.Errorf("expected SDK default, got %v, %v", limiter, err)
+	}
+
+	t.Setenv(envRetryBudget, "0")
+	if limiter, err := retryRateLimiterFromEnv(); err != nil || limiter != ratelimit.None {
+		t.Errorf("expected no retry budget, got %v, %v", limiter, err)
+	}
+
+	t.Setenv(envRetryBudget, "1000")
+	if limiter, err := retryRateLimiterFromEnv(); err != nil || limiter == nil {
+		t.Errorf("expected retry budget, got %v, %v", limiter, err)
+	}
+
+	t.Setenv(envRetryBudget, "-1")
+	if _, err := retryRateLimiterFromEnv(); err == nil {
+		t.Errorf("expected error for negative budget")
+	}
+}
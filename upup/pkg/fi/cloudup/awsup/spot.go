@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+)
+
+// spotCapacityErrors are the errors of the scaling activities that failed for lack of spot capacity
+var spotCapacityErrors = []string{
+	"InsufficientInstanceCapacity",
+	"UnfulfillableCapacity",
+	"SpotMaxPriceTooLow",
+	"MaxSpotInstanceCountExceeded",
+}
+
+// IsSpotCapacityUnavailable checks whether the latest scaling activity of the autoscaling group failed for lack of spot capacity.
+// It returns false if the autoscaling group does not exist.
+func IsSpotCapacityUnavailable(ctx context.Context, c AWSCloud, name string) (bool, error) {
+	request := &autoscaling.DescribeScalingActivitiesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int32(1),
+	}
+	response, err := c.Autoscaling().DescribeScalingActivities(ctx, request)
+	if err != nil {
+		if AWSErrorCode(err) == "ValidationError" {
+			// The autoscaling group does not exist yet
+			return false, nil
+		}
+		return false, fmt.Errorf("error describing scaling activities of autoscaling group %q: %w", name, err)
+	}
+
+	// Activities are returned newest first
+	if len(response.Activities) == 0 {
+		return false, nil
+	}
+	activity := response.Activities[0]
+	if activity.StatusCode != autoscalingtypes.ScalingActivityStatusCodeFailed {
+		return false, nil
+	}
+	message := aws.ToString(activity.StatusMessage)
+	for _, e := range spotCapacityErrors {
+		if strings.Contains(message, e) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+)
+
+func TestIsSpotCapacityUnavailable(t *testing.T) {
+	failed := func(message string) autoscalingtypes.Activity {
+		return autoscalingtypes.Activity{
+			StatusCode:    autoscalingtypes.ScalingActivityStatusCodeFailed,
+			StatusMessage: aws.String(message),
+		}
+	}
+	successful := autoscalingtypes.Activity{
+		StatusCode: autoscalingtypes.ScalingActivityStatusCodeSuccessful,
+	}
+
+	grid := []struct {
+		Name       string
+		Activities []autoscalingtypes.Activity
+		Expected   bool
+	}{
+		{
+			Name: "no activities",
+		},
+		{
+			Name:       "latest activity successful",
+			Activities: []autoscalingtypes.Activity{successful, failed("We currently do not have sufficient capacity. InsufficientInstanceCapacity")},
+		},
+		{
+			Name:       "latest activity failed for lack of spot capacity",
+			Activities: []autoscalingtypes.Activity{failed("Could not launch Spot Instances. InsufficientInstanceCapacity - There is no Spot capacity available."), successful},
+			Expected:   true,
+		},
+		{
+			Name:       "latest activity failed with max price too low",
+			Activities: []autoscalingtypes.Activity{failed("Could not launch Spot Instances. SpotMaxPriceTooLow - Your Spot request price is lower than the minimum required Spot request fulfillment price.")},
+			Expected:   true,
+		},
+		{
+			Name:       "latest activity failed for another reason",
+			Activities: []autoscalingtypes.Activity{failed("The requested configuration is currently not supported.")},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cloud := BuildMockAWSCloud("us-test-1", "a")
+			cloud.MockAutoscaling = &mockautoscaling.MockAutoscaling{
+				ScalingActivities: map[string][]autoscalingtypes.Activity{
+					"nodes.test.k8s.io": g.Activities,
+				},
+			}
+
+			unavailable, err := IsSpotCapacityUnavailable(context.Background(), cloud, "nodes.test.k8s.io")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if unavailable != g.Expected {
+				t.Errorf("expected %v, got %v", g.Expected, unavailable)
+			}
+		})
+	}
+}
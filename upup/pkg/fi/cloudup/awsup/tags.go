@@ -22,3 +22,7 @@ package awsup
 // it also happens for ELBs, when we cannot have two ELBs pointing at the same target group
 // and thus must create a second.
 const KopsResourceRevisionTag = "kops.k8s.io/revision"
+
+// TagNameScheduledActions is the tag of autoscaling groups listing the scheduled actions kOps manages for them,
+// so that those which are no longer in the model are deleted.
+const TagNameScheduledActions = "kops.k8s.io/scheduled-actions"
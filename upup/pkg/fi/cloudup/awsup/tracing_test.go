@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	grid := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+	}{
+		{name: "success", wantStatus: codes.Unset},
+		{name: "failure", err: errors.New("throttled"), wantStatus: codes.Error},
+	}
+	for _, g := range grid {
+		t.Run(g.name, func(t *testing.T) {
+			final := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, g.err
+			})
+			traced := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				return handleTracing(ctx, in, final)
+			})
+			metadata := &awsmiddleware.RegisterServiceMetadata{ServiceID: "EC2", OperationName: "DescribeInstances", Region: "us-test-1"}
+			if _, _, err := metadata.HandleInitialize(context.Background(), middleware.InitializeInput{}, traced); err != g.err {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			spans := recorder.Ended()
+			span := spans[len(spans)-1]
+			if span.Name() != "EC2.DescribeInstances" {
+				t.Errorf("unexpected span name %q", span.Name())
+			}
+			if span.Status().Code != g.wantStatus {
+				t.Errorf("unexpected span status %v, want %v", span.Status().Code, g.wantStatus)
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -83,8 +83,8 @@ func (c *ApplicationSecurityGroupsClientImpl) Delete(ctx context.Context, resour
 	return nil
 }
 
-func newApplicationSecurityGroupsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*ApplicationSecurityGroupsClientImpl, error) {
-	c, err := network.NewApplicationSecurityGroupsClient(subscriptionID, cred, nil)
+func newApplicationSecurityGroupsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*ApplicationSecurityGroupsClientImpl, error) {
+	c, err := network.NewApplicationSecurityGroupsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating application security groups client: %w", err)
 	}
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 	"k8s.io/klog/v2"
@@ -62,6 +64,8 @@ type AzureCloud interface {
 	LoadBalancer() LoadBalancersClient
 	PublicIPAddress() PublicIPAddressesClient
 	NatGateway() NatGatewaysClient
+	Usage() UsagesClient
+	ResourceSKU() ResourceSKUsClient
 }
 
 type azureCloudImplementation struct {
@@ -84,6 +88,8 @@ type azureCloudImplementation struct {
 	publicIPAddressesClient         PublicIPAddressesClient
 	natGatewaysClient               NatGatewaysClient
 	storageAccountsClient           StorageAccountsClient
+	usagesClient                    UsagesClient
+	resourceSKUsClient              ResourceSKUsClient
 }
 
 var _ fi.Cloud = &azureCloudImplementation{}
@@ -94,7 +100,13 @@ func NewAzureCloud(subscriptionID, resourceGroupName, location string, tags map[
 	if err != nil {
 		return nil, fmt.Errorf("error creating an identity: %s", err)
 	}
+	return NewAzureCloudWithCredential(subscriptionID, resourceGroupName, location, tags, cred, nil)
+}
 
+// NewAzureCloudWithCredential creates a new AzureCloud whose clients use the given credential and options,
+// such as a transport recording or replaying the API calls in tests.
+func NewAzureCloudWithCredential(subscriptionID, resourceGroupName, location string, tags map[string]string, cred azcore.TokenCredential, opts *arm.ClientOptions) (AzureCloud, error) {
+	var err error
 	azureCloudImpl := &azureCloudImplementation{
 		subscriptionID:    subscriptionID,
 		resourceGroupName: resourceGroupName,
@@ -102,49 +114,55 @@ func NewAzureCloud(subscriptionID, resourceGroupName, location string, tags map[
 		tags:              tags,
 	}
 
-	if azureCloudImpl.resourceGroupsClient, err = newResourceGroupsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.resourceGroupsClient, err = newResourceGroupsClientImpl(subscriptionID, cred, opts); err != nil {
+		return nil, err
+	}
+	if azureCloudImpl.vnetsClient, err = newVirtualNetworksClientImpl(subscriptionID, cred, opts); err != nil {
+		return nil, err
+	}
+	if azureCloudImpl.subnetsClient, err = newSubnetsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.vnetsClient, err = newVirtualNetworksClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.routeTablesClient, err = newRouteTablesClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.subnetsClient, err = newSubnetsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.networkSecurityGroupsClient, err = newNetworkSecurityGroupsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.routeTablesClient, err = newRouteTablesClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.applicationSecurityGroupsClient, err = newApplicationSecurityGroupsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.networkSecurityGroupsClient, err = newNetworkSecurityGroupsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.vmscaleSetsClient, err = newVMScaleSetsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.applicationSecurityGroupsClient, err = newApplicationSecurityGroupsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.vmscaleSetVMsClient, err = newVMScaleSetVMsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.vmscaleSetsClient, err = newVMScaleSetsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.disksClient, err = newDisksClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.vmscaleSetVMsClient, err = newVMScaleSetVMsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.roleAssignmentsClient, err = newRoleAssignmentsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.disksClient, err = newDisksClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.networkInterfacesClient, err = newNetworkInterfacesClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.roleAssignmentsClient, err = newRoleAssignmentsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.loadBalancersClient, err = newLoadBalancersClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.networkInterfacesClient, err = newNetworkInterfacesClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.publicIPAddressesClient, err = newPublicIPAddressesClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.loadBalancersClient, err = newLoadBalancersClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.natGatewaysClient, err = newNatGatewaysClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.publicIPAddressesClient, err = newPublicIPAddressesClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.storageAccountsClient, err = newStorageAccountsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.natGatewaysClient, err = newNatGatewaysClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.usagesClient, err = newUsagesClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
-	if azureCloudImpl.storageAccountsClient, err = newStorageAccountsClientImpl(subscriptionID, cred); err != nil {
+	if azureCloudImpl.resourceSKUsClient, err = newResourceSKUsClientImpl(subscriptionID, cred, opts); err != nil {
 		return nil, err
 	}
 
@@ -398,3 +416,11 @@ func (c *azureCloudImplementation) PublicIPAddress() PublicIPAddressesClient {
 func (c *azureCloudImplementation) NatGateway() NatGatewaysClient {
 	return c.natGatewaysClient
 }
+
+func (c *azureCloudImplementation) Usage() UsagesClient {
+	return c.usagesClient
+}
+
+func (c *azureCloudImplementation) ResourceSKU() ResourceSKUsClient {
+	return c.resourceSKUsClient
+}
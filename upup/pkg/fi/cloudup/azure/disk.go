@@ -20,7 +20,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 )
 
@@ -73,8 +74,8 @@ func (c *disksClientImpl) Delete(ctx context.Context, resourceGroupName, diskNam
 	return nil
 }
 
-func newDisksClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*disksClientImpl, error) {
-	c, err := compute.NewDisksClient(subscriptionID, cred, nil)
+func newDisksClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*disksClientImpl, error) {
+	c, err := compute.NewDisksClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating disks client: %w", err)
 	}
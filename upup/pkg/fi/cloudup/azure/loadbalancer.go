@@ -22,8 +22,8 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -96,8 +96,8 @@ func (c *loadBalancersClientImpl) Delete(ctx context.Context, resourceGroupName,
 	return nil
 }
 
-func newLoadBalancersClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*loadBalancersClientImpl, error) {
-	c, err := network.NewLoadBalancersClient(subscriptionID, cred, nil)
+func newLoadBalancersClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*loadBalancersClientImpl, error) {
+	c, err := network.NewLoadBalancersClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating load balancers client: %w", err)
 	}
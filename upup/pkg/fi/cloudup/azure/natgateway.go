@@ -22,7 +22,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -83,8 +83,8 @@ func (c *NatGatewaysClientImpl) Delete(ctx context.Context, resourceGroupName, n
 	return nil
 }
 
-func newNatGatewaysClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*NatGatewaysClientImpl, error) {
-	c, err := network.NewNatGatewaysClient(subscriptionID, cred, nil)
+func newNatGatewaysClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*NatGatewaysClientImpl, error) {
+	c, err := network.NewNatGatewaysClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating nat gateways client: %w", err)
 	}
@@ -20,7 +20,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -48,8 +49,8 @@ func (c *networkInterfacesClientImpl) ListScaleSetsNetworkInterfaces(ctx context
 	return l, nil
 }
 
-func newNetworkInterfacesClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*networkInterfacesClientImpl, error) {
-	c, err := network.NewInterfacesClient(subscriptionID, cred, nil)
+func newNetworkInterfacesClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*networkInterfacesClientImpl, error) {
+	c, err := network.NewInterfacesClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating network interfaces client: %w", err)
 	}
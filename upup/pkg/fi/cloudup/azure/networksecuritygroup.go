@@ -22,7 +22,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -83,8 +83,8 @@ func (c *NetworkSecurityGroupsClientImpl) Delete(ctx context.Context, resourceGr
 	return nil
 }
 
-func newNetworkSecurityGroupsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*NetworkSecurityGroupsClientImpl, error) {
-	c, err := network.NewSecurityGroupsClient(subscriptionID, cred, nil)
+func newNetworkSecurityGroupsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*NetworkSecurityGroupsClientImpl, error) {
+	c, err := network.NewSecurityGroupsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating network security groups client: %w", err)
 	}
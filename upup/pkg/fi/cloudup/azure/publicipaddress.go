@@ -22,7 +22,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -83,8 +83,8 @@ func (c *publicIPAddressesClientImpl) Delete(ctx context.Context, resourceGroupN
 	return nil
 }
 
-func newPublicIPAddressesClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*publicIPAddressesClientImpl, error) {
-	c, err := network.NewPublicIPAddressesClient(subscriptionID, cred, nil)
+func newPublicIPAddressesClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*publicIPAddressesClientImpl, error) {
+	c, err := network.NewPublicIPAddressesClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating public ip addresses client: %w", err)
 	}
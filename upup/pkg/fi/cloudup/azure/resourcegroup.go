@@ -20,7 +20,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	resources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 )
 
@@ -66,8 +67,8 @@ func (c *resourceGroupsClientImpl) Delete(ctx context.Context, name string) erro
 	return nil
 }
 
-func newResourceGroupsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*resourceGroupsClientImpl, error) {
-	c, err := resources.NewResourceGroupsClient(subscriptionID, cred, nil)
+func newResourceGroupsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*resourceGroupsClientImpl, error) {
+	c, err := resources.NewResourceGroupsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating resource group client: %w", err)
 	}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+)
+
+// ResourceSKUsClient is a client for listing the compute SKUs available to a subscription.
+type ResourceSKUsClient interface {
+	List(ctx context.Context, location string) ([]*compute.ResourceSKU, error)
+}
+
+type resourceSKUsClientImpl struct {
+	c *compute.ResourceSKUsClient
+}
+
+var _ ResourceSKUsClient = &resourceSKUsClientImpl{}
+
+func (c *resourceSKUsClientImpl) List(ctx context.Context, location string) ([]*compute.ResourceSKU, error) {
+	opts := &compute.ResourceSKUsClientListOptions{
+		Filter: to.Ptr(fmt.Sprintf("location eq '%s'", location)),
+	}
+	var l []*compute.ResourceSKU
+	pager := c.c.NewListPager(opts)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing resource SKUs: %w", err)
+		}
+		l = append(l, resp.Value...)
+	}
+	return l, nil
+}
+
+func newResourceSKUsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*resourceSKUsClientImpl, error) {
+	c, err := compute.NewResourceSKUsClient(subscriptionID, cred, opts)
+	if err != nil {
+		return nil, fmt.Errorf("creating resource SKUs client: %w", err)
+	}
+	return &resourceSKUsClientImpl{
+		c: c,
+	}, nil
+}
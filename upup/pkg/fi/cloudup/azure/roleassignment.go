@@ -20,7 +20,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	authz "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v3"
 )
 
@@ -68,8 +69,8 @@ func (c *roleAssignmentsClientImpl) Delete(ctx context.Context, scope, raName st
 	return nil
 }
 
-func newRoleAssignmentsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*roleAssignmentsClientImpl, error) {
-	c, err := authz.NewRoleAssignmentsClient(subscriptionID, cred, nil)
+func newRoleAssignmentsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*roleAssignmentsClientImpl, error) {
+	c, err := authz.NewRoleAssignmentsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating role assignments client: %w", err)
 	}
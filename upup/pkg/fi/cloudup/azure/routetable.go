@@ -22,7 +22,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -83,8 +83,8 @@ func (c *routeTablesClientImpl) Delete(ctx context.Context, resourceGroupName, v
 	return nil
 }
 
-func newRouteTablesClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*routeTablesClientImpl, error) {
-	c, err := network.NewRouteTablesClient(subscriptionID, cred, nil)
+func newRouteTablesClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*routeTablesClientImpl, error) {
+	c, err := network.NewRouteTablesClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating route tables client: %w", err)
 	}
@@ -78,6 +78,10 @@ func (c *mockVMScaleSetVMsClient) Delete(ctx context.Context, resourceGroupName,
 	return nil
 }
 
+func (c *mockVMScaleSetVMsClient) RunCommand(ctx context.Context, resourceGroupName, vmssName, instanceID string, script []string) (*compute.RunCommandResult, error) {
+	return nil, fmt.Errorf("RunCommand not implemented")
+}
+
 func TestFindEtcdStatus(t *testing.T) {
 	clusterName := "my-cluster"
 	c := &azureCloudImplementation{
@@ -20,7 +20,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
 )
 
@@ -48,8 +49,8 @@ func (c *storageAccountsClientImpl) List(ctx context.Context) ([]*armstorage.Acc
 	return l, nil
 }
 
-func newStorageAccountsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*storageAccountsClientImpl, error) {
-	c, err := armstorage.NewAccountsClient(subscriptionID, cred, nil)
+func newStorageAccountsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*storageAccountsClientImpl, error) {
+	c, err := armstorage.NewAccountsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating storage accounts client: %w", err)
 	}
@@ -20,7 +20,8 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -73,8 +74,8 @@ func (c *subnetsClientImpl) Delete(ctx context.Context, resourceGroupName, vnetN
 	return nil
 }
 
-func newSubnetsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*subnetsClientImpl, error) {
-	c, err := network.NewSubnetsClient(subscriptionID, cred, nil)
+func newSubnetsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*subnetsClientImpl, error) {
+	c, err := network.NewSubnetsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating subnets client: %w", err)
 	}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+)
+
+// UsagesClient is a client for listing the compute usages and limits of a subscription.
+type UsagesClient interface {
+	List(ctx context.Context, location string) ([]*compute.Usage, error)
+}
+
+type usagesClientImpl struct {
+	c *compute.UsageClient
+}
+
+var _ UsagesClient = &usagesClientImpl{}
+
+func (c *usagesClientImpl) List(ctx context.Context, location string) ([]*compute.Usage, error) {
+	var l []*compute.Usage
+	pager := c.c.NewListPager(location, nil)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing usages: %w", err)
+		}
+		l = append(l, resp.Value...)
+	}
+	return l, nil
+}
+
+func newUsagesClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*usagesClientImpl, error) {
+	c, err := compute.NewUsageClient(subscriptionID, cred, opts)
+	if err != nil {
+		return nil, fmt.Errorf("creating usages client: %w", err)
+	}
+	return &usagesClientImpl{
+		c: c,
+	}, nil
+}
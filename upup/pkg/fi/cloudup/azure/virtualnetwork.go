@@ -22,7 +22,7 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	network "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork"
 )
 
@@ -83,8 +83,8 @@ func (c *virtualNetworksClientImpl) Delete(ctx context.Context, resourceGroupNam
 	return nil
 }
 
-func newVirtualNetworksClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*virtualNetworksClientImpl, error) {
-	c, err := network.NewVirtualNetworksClient(subscriptionID, cred, nil)
+func newVirtualNetworksClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*virtualNetworksClientImpl, error) {
+	c, err := network.NewVirtualNetworksClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating virtual networks client: %w", err)
 	}
@@ -22,8 +22,8 @@ import (
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 )
 
@@ -96,8 +96,8 @@ func (c *vmScaleSetsClientImpl) Delete(ctx context.Context, resourceGroupName, v
 	return nil
 }
 
-func newVMScaleSetsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*vmScaleSetsClientImpl, error) {
-	c, err := compute.NewVirtualMachineScaleSetsClient(subscriptionID, cred, nil)
+func newVMScaleSetsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*vmScaleSetsClientImpl, error) {
+	c, err := compute.NewVirtualMachineScaleSetsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating VMSSs client: %w", err)
 	}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
 )
 
@@ -28,6 +30,7 @@ import (
 type VMScaleSetVMsClient interface {
 	List(ctx context.Context, resourceGroupName, vmssName string) ([]*compute.VirtualMachineScaleSetVM, error)
 	Delete(ctx context.Context, resourceGroupName, vmssName, instanceId string) error
+	RunCommand(ctx context.Context, resourceGroupName, vmssName, instanceId string, script []string) (*compute.RunCommandResult, error)
 }
 
 type vmScaleSetVMsClientImpl struct {
@@ -60,8 +63,26 @@ func (c *vmScaleSetVMsClientImpl) Delete(ctx context.Context, resourceGroupName,
 	return nil
 }
 
-func newVMScaleSetVMsClientImpl(subscriptionID string, cred *azidentity.DefaultAzureCredential) (*vmScaleSetVMsClientImpl, error) {
-	c, err := compute.NewVirtualMachineScaleSetVMsClient(subscriptionID, cred, nil)
+func (c *vmScaleSetVMsClientImpl) RunCommand(ctx context.Context, resourceGroupName, vmssName, instanceId string, script []string) (*compute.RunCommandResult, error) {
+	input := compute.RunCommandInput{
+		CommandID: to.Ptr("RunShellScript"),
+	}
+	for _, line := range script {
+		input.Script = append(input.Script, to.Ptr(line))
+	}
+	future, err := c.c.BeginRunCommand(ctx, resourceGroupName, vmssName, instanceId, input, nil)
+	if err != nil {
+		return nil, fmt.Errorf("running command on VMSS VM: %w", err)
+	}
+	resp, err := future.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("waiting for VMSS VM run command completion: %w", err)
+	}
+	return &resp.RunCommandResult, nil
+}
+
+func newVMScaleSetVMsClientImpl(subscriptionID string, cred azcore.TokenCredential, opts *arm.ClientOptions) (*vmScaleSetVMsClientImpl, error) {
+	c, err := compute.NewVirtualMachineScaleSetVMsClient(subscriptionID, cred, opts)
 	if err != nil {
 		return nil, fmt.Errorf("creating VMSS VMs client: %w", err)
 	}
@@ -19,11 +19,18 @@ package azuretasks
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	compute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute"
+	"k8s.io/kops/cloudmock/recorder"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/azure"
 )
@@ -200,3 +207,82 @@ func TestDiskCheckChanges(t *testing.T) {
 		})
 	}
 }
+
+const (
+	// recordedSubscriptionID is the placeholder of the subscription ID in the recordings
+	recordedSubscriptionID = "00000000-0000-0000-0000-000000000000"
+	// recordedResourceGroup is the resource group in the recordings, which must exist when recording
+	recordedResourceGroup = "kops-recorder-test"
+)
+
+// fakeTokenCredential authenticates the replayed requests
+type fakeTokenCredential struct{}
+
+func (fakeTokenCredential) GetToken(ctx context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "fake", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+// newRecordedAzureCloud returns an AzureCloud replaying the recording of a test, or recording it with the
+// subscription in AZURE_SUBSCRIPTION_ID and the default credentials when KOPS_CLOUDMOCK_RECORD is true.
+func newRecordedAzureCloud(t *testing.T, name string) azure.AzureCloud {
+	subscriptionID := recordedSubscriptionID
+	var cred azcore.TokenCredential = fakeTokenCredential{}
+	if recorder.ModeFromEnv() == recorder.ModeRecord {
+		subscriptionID = os.Getenv("AZURE_SUBSCRIPTION_ID")
+		c, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			t.Fatalf("error creating credential: %v", err)
+		}
+		cred = c
+	}
+
+	rec := recorder.Start(t, name, recorder.Options{
+		Redactions: map[string]string{subscriptionID: recordedSubscriptionID},
+	})
+	opts := &arm.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: rec,
+		},
+	}
+	cloud, err := azure.NewAzureCloudWithCredential(subscriptionID, recordedResourceGroup, "eastus", map[string]string{azure.TagClusterName: testClusterName}, cred, opts)
+	if err != nil {
+		t.Fatalf("error creating cloud: %v", err)
+	}
+	return cloud
+}
+
+func TestDiskRunRecorded(t *testing.T) {
+	cloud := newRecordedAzureCloud(t, "disk")
+	ctx := &fi.CloudupContext{
+		T: fi.CloudupSubContext{
+			Cloud: cloud,
+		},
+		Target: azure.NewAzureAPITarget(cloud),
+	}
+
+	disk := newTestDisk()
+	disk.ResourceGroup.Name = to.Ptr(recordedResourceGroup)
+	if err := disk.Normalize(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := disk.Run(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual, err := disk.Find(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if actual == nil {
+		t.Fatalf("disk was not found after creating it")
+	}
+	if a, e := *actual.SizeGB, *disk.SizeGB; a != e {
+		t.Errorf("unexpected disk size: expected %d, but got %d", e, a)
+	}
+	if a, e := *actual.VolumeType, *disk.VolumeType; a != e {
+		t.Errorf("unexpected volume type: expected %s, but got %s", e, a)
+	}
+	if a, e := actual.Tags, disk.Tags; !reflect.DeepEqual(a, e) {
+		t.Errorf("unexpected tags: expected %v, but got %v", e, a)
+	}
+}
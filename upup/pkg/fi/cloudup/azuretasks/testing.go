@@ -58,6 +58,8 @@ type MockAzureCloud struct {
 	PublicIPAddressesClient         *MockPublicIPAddressesClient
 	NatGatewaysClient               *MockNatGatewaysClient
 	StorageAccountsClient           *MockStorageAccountsClient
+	UsagesClient                    *MockUsagesClient
+	ResourceSKUsClient              *MockResourceSKUsClient
 }
 
 var _ azure.AzureCloud = &MockAzureCloud{}
@@ -111,6 +113,8 @@ func NewMockAzureCloud(location string) *MockAzureCloud {
 		StorageAccountsClient: &MockStorageAccountsClient{
 			SAs: map[string]*armstorage.Account{},
 		},
+		UsagesClient:       &MockUsagesClient{},
+		ResourceSKUsClient: &MockResourceSKUsClient{},
 	}
 }
 
@@ -263,6 +267,16 @@ func (c *MockAzureCloud) NatGateway() azure.NatGatewaysClient {
 	return c.NatGatewaysClient
 }
 
+// Usage returns the usage client.
+func (c *MockAzureCloud) Usage() azure.UsagesClient {
+	return c.UsagesClient
+}
+
+// ResourceSKU returns the resource SKU client.
+func (c *MockAzureCloud) ResourceSKU() azure.ResourceSKUsClient {
+	return c.ResourceSKUsClient
+}
+
 // MockResourceGroupsClient is a mock implementation of resource group client.
 type MockResourceGroupsClient struct {
 	RGs map[string]*resources.ResourceGroup
@@ -481,6 +495,11 @@ func (c *MockVMScaleSetVMsClient) Delete(ctx context.Context, resourceGroupName,
 	return nil
 }
 
+// RunCommand runs a shell script on a VM Scale Set VM.
+func (c *MockVMScaleSetVMsClient) RunCommand(ctx context.Context, resourceGroupName, vmssName, instanceID string, script []string) (*compute.RunCommandResult, error) {
+	return nil, fmt.Errorf("RunCommand not implemented")
+}
+
 // MockDisksClient is a mock implementation of disk client.
 type MockDisksClient struct {
 	Disks map[string]*compute.Disk
@@ -825,3 +844,27 @@ func (c *MockStorageAccountsClient) List(ctx context.Context) ([]*armstorage.Acc
 	}
 	return l, nil
 }
+
+// MockUsagesClient is a mock implementation of usage client.
+type MockUsagesClient struct {
+	Usages []*compute.Usage
+}
+
+var _ azure.UsagesClient = &MockUsagesClient{}
+
+// List returns a slice of usages.
+func (c *MockUsagesClient) List(ctx context.Context, location string) ([]*compute.Usage, error) {
+	return c.Usages, nil
+}
+
+// MockResourceSKUsClient is a mock implementation of resource SKU client.
+type MockResourceSKUsClient struct {
+	SKUs []*compute.ResourceSKU
+}
+
+var _ azure.ResourceSKUsClient = &MockResourceSKUsClient{}
+
+// List returns a slice of resource SKUs.
+func (c *MockResourceSKUsClient) List(ctx context.Context, location string) ([]*compute.ResourceSKU, error) {
+	return c.SKUs, nil
+}
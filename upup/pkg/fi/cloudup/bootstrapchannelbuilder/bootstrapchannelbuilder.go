@@ -22,6 +22,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	channelsapi "k8s.io/kops/channels/pkg/api"
 	"k8s.io/kops/pkg/apis/kops"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/model"
 	"k8s.io/kops/pkg/model/awsmodel"
+	"k8s.io/kops/pkg/model/components"
 	"k8s.io/kops/pkg/model/components/addonmanifests"
 	"k8s.io/kops/pkg/model/components/addonmanifests/awscloudcontrollermanager"
 	"k8s.io/kops/pkg/model/components/addonmanifests/awsebscsidriver"
@@ -271,10 +273,36 @@ func (b *BootstrapChannelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 		addons.Add(a)
 	}
 
+	for _, addon := range b.Cluster.Spec.Addons {
+		if addon.Chart == nil {
+			continue
+		}
+		a, err := buildChartAddon(addon.Chart)
+		if err != nil {
+			return err
+		}
+		addons.Add(a)
+	}
+
 	if err := b.addPruneDirectives(addons); err != nil {
 		return err
 	}
 
+	adoptAddons := sets.NewString(b.Cluster.Spec.AdoptAddons...)
+	for _, addon := range addons.Items {
+		if adoptAddons.Has(fi.ValueOf(addon.Spec.Name)) {
+			addon.Spec.Adopt = true
+		}
+	}
+
+	if components.CertManagerUsesClusterCA(b.Cluster) {
+		for _, addon := range addons.Items {
+			if addon.Spec.NeedsPKI {
+				addon.Spec.PKISecretName = fi.CertificateIDCA
+			}
+		}
+	}
+
 	addonsObject := &channelsapi.Addons{}
 	addonsObject.Kind = "Addons"
 	addonsObject.ObjectMeta.Name = "bootstrap"
@@ -303,6 +331,49 @@ func (b *BootstrapChannelBuilder) Build(c *fi.CloudupModelBuilderContext) error
 	return nil
 }
 
+// buildChartAddon builds the addon applying a Helm chart from spec.addons
+func buildChartAddon(chart *kops.HelmChartSpec) (*channelsapi.AddonSpec, error) {
+	name := chart.Name
+	if name == "" {
+		name = chart.Chart
+	}
+	namespace := chart.Namespace
+	if namespace == "" {
+		namespace = "kube-system"
+	}
+
+	a := &channelsapi.AddonSpec{
+		Name:      fi.PtrTo(name),
+		Namespace: fi.PtrTo(namespace),
+		Selector:  map[string]string{"k8s-addon": name},
+		Chart: &channelsapi.ChartSpec{
+			Repository: chart.Repository,
+			Chart:      chart.Chart,
+			Version:    chart.Version,
+			Values:     chart.Values,
+		},
+	}
+	for _, from := range chart.ValuesFrom {
+		a.Chart.ValuesFrom = append(a.Chart.ValuesFrom, channelsapi.ChartValuesSource{
+			SecretName: from.SecretName,
+			Key:        from.Key,
+		})
+	}
+
+	// The chart is applied again whenever its spec changes
+	chartYAML, err := utils.YamlMarshal(a.Chart)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing chart of addon %q: %w", name, err)
+	}
+	manifestHash, err := utils.HashString(string(chartYAML))
+	if err != nil {
+		return nil, fmt.Errorf("error hashing chart of addon %q: %w", name, err)
+	}
+	a.ManifestHash = manifestHash
+
+	return a, nil
+}
+
 type AddonList struct {
 	Items []*Addon
 }
@@ -324,6 +395,9 @@ type Addon struct {
 
 	// BuildPrune is set if we should automatically build prune specifiers, based on the manifest.
 	BuildPrune bool
+
+	// PruneGroupKinds are additional kinds that are pruned, beyond the well-known kinds.
+	PruneGroupKinds []schema.GroupKind
 }
 
 func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext) (*AddonList, map[types.NamespacedName]iam.Subject, error) {
@@ -438,6 +512,50 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		})
 	}
 
+	if b.Cluster.Spec.NamespaceDefaults != nil {
+		key := "namespace-defaults.addons.k8s.io"
+
+		{
+			location := key + "/k8s-1.24.yaml"
+			id := "k8s-1.24"
+
+			addon := addons.Add(&channelsapi.AddonSpec{
+				Name:     fi.PtrTo(key),
+				Selector: map[string]string{"k8s-addon": key},
+				Manifest: fi.PtrTo(location),
+				Id:       id,
+			})
+			// Removing an entry from the cluster spec removes the objects it created
+			addon.BuildPrune = true
+			addon.PruneGroupKinds = []schema.GroupKind{
+				{Group: "", Kind: "LimitRange"},
+				{Group: "", Kind: "ResourceQuota"},
+				{Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+			}
+		}
+	}
+
+	if admission := b.Cluster.Spec.Admission; admission != nil && admission.ValidatingAdmissionPolicies != "" {
+		key := "admission-policies.addons.k8s.io"
+
+		{
+			location := key + "/k8s-1.28.yaml"
+			id := "k8s-1.28"
+
+			addon := addons.Add(&channelsapi.AddonSpec{
+				Name:     fi.PtrTo(key),
+				Selector: map[string]string{"k8s-addon": key},
+				Manifest: fi.PtrTo(location),
+				Id:       id,
+			})
+			addon.BuildPrune = true
+			addon.PruneGroupKinds = []schema.GroupKind{
+				{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicy"},
+				{Group: "admissionregistration.k8s.io", Kind: "ValidatingAdmissionPolicyBinding"},
+			}
+		}
+	}
+
 	if !b.Cluster.UsesNoneDNS() {
 		if b.Cluster.Spec.ExternalDNS == nil || b.Cluster.Spec.ExternalDNS.Provider == kops.ExternalDNSProviderDNSController {
 			{
@@ -612,6 +730,23 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		}
 	}
 
+	if konnectivity := b.Cluster.Spec.Konnectivity; konnectivity != nil && fi.ValueOf(konnectivity.Enabled) {
+		key := "konnectivity.addons.k8s.io"
+
+		{
+			location := key + "/k8s-1.27.yaml"
+			id := "k8s-1.27"
+
+			addon := addons.Add(&channelsapi.AddonSpec{
+				Name:     fi.PtrTo(key),
+				Selector: map[string]string{"k8s-addon": key},
+				Manifest: fi.PtrTo(location),
+				Id:       id,
+			})
+			addon.BuildPrune = true
+		}
+	}
+
 	nvidia := b.Cluster.Spec.Containerd.NvidiaGPU
 	igNvidia := false
 	for _, ig := range b.KopsModelContext.InstanceGroups {
@@ -963,6 +1098,22 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 		}
 	}
 
+	if b.HasWindowsInstanceGroups() {
+		key := "windows-nodes.addons.k8s.io"
+
+		{
+			id := "k8s-1.25"
+			location := key + "/" + id + ".yaml"
+
+			addons.Add(&channelsapi.AddonSpec{
+				Name:     fi.PtrTo(key),
+				Selector: map[string]string{"k8s-addon": key},
+				Manifest: fi.PtrTo(location),
+				Id:       id,
+			})
+		}
+	}
+
 	if b.Cluster.Spec.Networking.Canal != nil {
 		key := "networking.projectcalico.org.canal"
 
@@ -1161,6 +1312,21 @@ func (b *BootstrapChannelBuilder) buildAddons(c *fi.CloudupModelBuilderContext)
 			})
 		}
 	}
+	if b.Cluster.Spec.VerticalPodAutoscaler != nil && fi.ValueOf(b.Cluster.Spec.VerticalPodAutoscaler.Enabled) {
+		key := "vertical-pod-autoscaler.addons.k8s.io"
+
+		{
+			id := "k8s-1.27"
+			location := key + "/" + id + ".yaml"
+			addons.Add(&channelsapi.AddonSpec{
+				Name:     fi.PtrTo(key),
+				Manifest: fi.PtrTo(location),
+				Selector: map[string]string{"k8s-addon": key},
+				NeedsPKI: !fi.ValueOf(b.Cluster.Spec.VerticalPodAutoscaler.RecommenderOnly),
+				Id:       id,
+			})
+		}
+	}
 	if b.Cluster.Spec.Karpenter != nil && b.Cluster.Spec.Karpenter.Enabled {
 		key := "karpenter.sh"
 
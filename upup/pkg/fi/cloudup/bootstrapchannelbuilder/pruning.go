@@ -79,6 +79,9 @@ func (b *BootstrapChannelBuilder) addPruneDirectivesForAddon(addon *Addon) error
 	for _, gk := range alwaysPruneGroupKinds {
 		pruneGroupKind[gk] = true
 	}
+	for _, gk := range addon.PruneGroupKinds {
+		pruneGroupKind[gk] = true
+	}
 
 	// In addition, we deliberately exclude a few types that are riskier to delete:
 	//
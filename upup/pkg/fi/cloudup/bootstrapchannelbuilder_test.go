@@ -32,6 +32,7 @@ import (
 	"k8s.io/kops/pkg/templates"
 	"k8s.io/kops/pkg/testutils"
 	"k8s.io/kops/pkg/testutils/golden"
+	"k8s.io/kops/pkg/tokens"
 	"k8s.io/kops/upup/models"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/bootstrapchannelbuilder"
@@ -55,6 +56,9 @@ func TestBootstrapChannelBuilder_BuildTasks(t *testing.T) {
 	runChannelBuilderTest(t, "metrics-server/insecure-1.19", []string{"metrics-server.addons.k8s.io-k8s-1.11"})
 	runChannelBuilderTest(t, "metrics-server/secure-1.19", []string{"metrics-server.addons.k8s.io-k8s-1.11"})
 	runChannelBuilderTest(t, "coredns", []string{"coredns.addons.k8s.io-k8s-1.12"})
+	runChannelBuilderTest(t, "konnectivity", []string{"konnectivity.addons.k8s.io-k8s-1.27"})
+	runChannelBuilderTest(t, "namespacedefaults", []string{"namespace-defaults.addons.k8s.io-k8s-1.24"})
+	runChannelBuilderTest(t, "admissionpolicies", []string{"admission-policies.addons.k8s.io-k8s-1.28"})
 }
 
 func TestBootstrapChannelBuilder_ServiceAccountIAM(t *testing.T) {
@@ -80,7 +84,79 @@ func TestBootstrapChannelBuilder_AWSCloudController(t *testing.T) {
 	runChannelBuilderTest(t, "awscloudcontroller", []string{"aws-cloud-controller.addons.k8s.io-k8s-1.18"})
 }
 
+func TestBootstrapChannelBuilder_AWSCloudControllerLimits(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	h.SetupMockAWS()
+
+	runChannelBuilderTest(t, "awscloudcontroller_limits", []string{"aws-cloud-controller.addons.k8s.io-k8s-1.18"})
+}
+
+func TestBootstrapChannelBuilder_ClusterAutoscaler(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	h.SetupMockAWS()
+
+	runChannelBuilderTest(t, "cluster-autoscaler-balancing", []string{"cluster-autoscaler.addons.k8s.io-k8s-1.15"})
+}
+
+func TestBootstrapChannelBuilder_AWSEBSCSIDriver(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	h.SetupMockAWS()
+
+	runChannelBuilderTest(t, "awsebscsidriver", []string{"aws-ebs-csi-driver.addons.k8s.io-k8s-1.17", "storage-aws.addons.k8s.io-v1.15.0"})
+}
+
+func TestBootstrapChannelBuilder_WindowsNodes(t *testing.T) {
+	h := testutils.NewIntegrationTestHarness(t)
+	defer h.Close()
+
+	h.SetupMockAWS()
+
+	featureflag.ParseFlags("+WindowsNodes")
+	defer featureflag.ParseFlags("-WindowsNodes")
+
+	instanceGroups := []*kopsapi.InstanceGroup{
+		{
+			Spec: kopsapi.InstanceGroupSpec{
+				Role: kopsapi.InstanceGroupRoleNode,
+			},
+		},
+		{
+			Spec: kopsapi.InstanceGroupSpec{
+				Role:            kopsapi.InstanceGroupRoleNode,
+				OperatingSystem: kopsapi.InstanceGroupOperatingSystemWindows,
+			},
+		},
+	}
+	runChannelBuilderTestWithInstanceGroups(t, "windows", []string{"windows-nodes.addons.k8s.io-k8s-1.25"}, instanceGroups)
+}
+
 func runChannelBuilderTest(t *testing.T, key string, addonManifests []string) {
+	role := "arn:aws:iam::1234567890108:instance-profile/kops-custom-node-role"
+	instanceGroups := []*kopsapi.InstanceGroup{
+		{
+			Spec: kopsapi.InstanceGroupSpec{
+				IAM: &kopsapi.IAMProfileSpec{
+					Profile: &role,
+				},
+				Role: kopsapi.InstanceGroupRoleNode,
+			},
+		},
+		{
+			Spec: kopsapi.InstanceGroupSpec{
+				Role: kopsapi.InstanceGroupRoleNode,
+			},
+		},
+	}
+	runChannelBuilderTestWithInstanceGroups(t, key, addonManifests, instanceGroups)
+}
+
+func runChannelBuilderTestWithInstanceGroups(t *testing.T, key string, addonManifests []string, instanceGroups []*kopsapi.InstanceGroup) {
 	ctx := context.TODO()
 
 	basedir := path.Join("tests/bootstrapchannelbuilder/", key)
@@ -128,33 +204,24 @@ func runChannelBuilderTest(t *testing.T, key string, addonManifests []string) {
 	if err != nil {
 		t.Error(err)
 	}
-	role := "arn:aws:iam::1234567890108:instance-profile/kops-custom-node-role"
 	kopsModel := model.KopsModelContext{
 		IAMModelContext: iam.IAMModelContext{
 			Cluster:      cluster,
 			AWSAccountID: "123456789012",
 			AWSPartition: "aws-test",
 		},
-		Region: "us-east-1",
-		InstanceGroups: []*kopsapi.InstanceGroup{
-			{
-				Spec: kopsapi.InstanceGroupSpec{
-					IAM: &kopsapi.IAMProfileSpec{
-						Profile: &role,
-					},
-					Role: kopsapi.InstanceGroupRoleNode,
-				},
-			},
-			{
-				Spec: kopsapi.InstanceGroupSpec{
-					Role: kopsapi.InstanceGroupRoleNode,
-				},
-			},
-		},
+		Region:         "us-east-1",
+		InstanceGroups: instanceGroups,
 	}
 
 	kopsModel.AllInstanceGroups = kopsModel.InstanceGroups
 
+	if kopsModel.HasWindowsInstanceGroups() {
+		if _, err := secretStore.ReplaceSecret(tokens.WindowsBootstrapTokenSecretName, &fi.Secret{Data: []byte("abcdef.0123456789abcdef")}); err != nil {
+			t.Fatalf("error creating bootstrap token: %v", err)
+		}
+	}
+
 	tf := &TemplateFunctions{
 		KopsModelContext: kopsModel,
 		cloud:            cloud,
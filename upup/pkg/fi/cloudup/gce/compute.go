@@ -27,6 +27,7 @@ type ComputeClient interface {
 	Projects() ProjectClient
 	Regions() RegionClient
 	Zones() ZoneClient
+	MachineTypes() MachineTypeClient
 	Networks() NetworkClient
 	Subnetworks() SubnetworkClient
 	Routes() RouteClient
@@ -78,6 +79,12 @@ func (c *computeClientImpl) Zones() ZoneClient {
 	}
 }
 
+func (c *computeClientImpl) MachineTypes() MachineTypeClient {
+	return &machineTypeClientImpl{
+		srv: c.srv.MachineTypes,
+	}
+}
+
 func (c *computeClientImpl) Networks() NetworkClient {
 	return &networkClientImpl{
 		srv: c.srv.Networks,
@@ -183,6 +190,7 @@ func (c *projectClientImpl) Get(project string) (*compute.Project, error) {
 }
 
 type RegionClient interface {
+	Get(project, region string) (*compute.Region, error)
 	List(ctx context.Context, project string) ([]*compute.Region, error)
 }
 
@@ -192,6 +200,10 @@ type regionClientImpl struct {
 
 var _ RegionClient = &regionClientImpl{}
 
+func (c *regionClientImpl) Get(project, region string) (*compute.Region, error) {
+	return c.srv.Get(project, region).Do()
+}
+
 func (c *regionClientImpl) List(ctx context.Context, project string) ([]*compute.Region, error) {
 	var regions []*compute.Region
 	err := c.srv.List(project).Pages(ctx, func(page *compute.RegionList) error {
@@ -226,6 +238,20 @@ func (c *zoneClientImpl) List(ctx context.Context, project string) ([]*compute.Z
 	return zones, nil
 }
 
+type MachineTypeClient interface {
+	Get(project, zone, name string) (*compute.MachineType, error)
+}
+
+type machineTypeClientImpl struct {
+	srv *compute.MachineTypesService
+}
+
+var _ MachineTypeClient = &machineTypeClientImpl{}
+
+func (c *machineTypeClientImpl) Get(project, zone, name string) (*compute.MachineType, error) {
+	return c.srv.Get(project, zone, name).Do()
+}
+
 type NetworkClient interface {
 	Insert(project string, nw *compute.Network) (*compute.Operation, error)
 	Get(project, name string) (*compute.Network, error)
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce
+
+import (
+	"context"
+	"fmt"
+)
+
+// spotCapacityErrors are the error codes of the instance creation attempts that failed for lack of spot capacity
+var spotCapacityErrors = map[string]bool{
+	"ZONE_RESOURCE_POOL_EXHAUSTED":              true,
+	"ZONE_RESOURCE_POOL_EXHAUSTED_WITH_DETAILS": true,
+}
+
+// IsSpotCapacityUnavailable checks whether the managed instance group failed to create instances for lack of spot capacity.
+// It returns false if the managed instance group does not exist.
+func IsSpotCapacityUnavailable(ctx context.Context, c GCECloud, zone string, name string) (bool, error) {
+	instances, err := c.Compute().InstanceGroupManagers().ListManagedInstances(ctx, c.Project(), zone, name)
+	if err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error listing ManagedInstances in %s: %w", name, err)
+	}
+
+	for _, instance := range instances {
+		if instance.LastAttempt == nil || instance.LastAttempt.Errors == nil {
+			continue
+		}
+		for _, e := range instance.LastAttempt.Errors.Errors {
+			if spotCapacityErrors[e.Code] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
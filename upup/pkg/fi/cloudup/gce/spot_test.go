@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gce_test
+
+import (
+	"context"
+	"testing"
+
+	compute "google.golang.org/api/compute/v1"
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/cloudmock/gce/mockcompute"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+func TestIsSpotCapacityUnavailable(t *testing.T) {
+	ctx := context.Background()
+
+	failed := func(code string) *compute.ManagedInstance {
+		return &compute.ManagedInstance{
+			LastAttempt: &compute.ManagedInstanceLastAttempt{
+				Errors: &compute.ManagedInstanceLastAttemptErrors{
+					Errors: []*compute.ManagedInstanceLastAttemptErrorsErrors{{Code: code}},
+				},
+			},
+		}
+	}
+	running := &compute.ManagedInstance{InstanceStatus: "RUNNING"}
+
+	grid := []struct {
+		Name      string
+		Instances []*compute.ManagedInstance
+		Expected  bool
+	}{
+		{
+			Name: "no instances",
+		},
+		{
+			Name:      "running instances",
+			Instances: []*compute.ManagedInstance{running, running},
+		},
+		{
+			Name:      "zone resource pool exhausted",
+			Instances: []*compute.ManagedInstance{running, failed("ZONE_RESOURCE_POOL_EXHAUSTED")},
+			Expected:  true,
+		},
+		{
+			Name:      "other error",
+			Instances: []*compute.ManagedInstance{failed("QUOTA_EXCEEDED")},
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			cloud := gcemock.InstallMockGCECloud("us-test1", "testproject")
+			if _, err := cloud.Compute().InstanceGroupManagers().Insert("testproject", "us-test1-a", &compute.InstanceGroupManager{Name: "a-nodes"}); err != nil {
+				t.Fatalf("error creating instance group manager: %v", err)
+			}
+			if err := cloud.Compute().(*mockcompute.MockClient).SetManagedInstances("testproject", "us-test1-a", "a-nodes", g.Instances); err != nil {
+				t.Fatalf("error setting managed instances: %v", err)
+			}
+
+			unavailable, err := gce.IsSpotCapacityUnavailable(ctx, cloud, "us-test1-a", "a-nodes")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if unavailable != g.Expected {
+				t.Errorf("expected %v, got %v", g.Expected, unavailable)
+			}
+		})
+	}
+
+	t.Run("missing instance group manager", func(t *testing.T) {
+		cloud := gcemock.InstallMockGCECloud("us-test1", "testproject")
+		unavailable, err := gce.IsSpotCapacityUnavailable(ctx, cloud, "us-test1-a", "a-nodes")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if unavailable {
+			t.Errorf("expected false for a missing instance group manager")
+		}
+	})
+}
@@ -222,6 +222,19 @@ func (_ *ForwardingRule) RenderGCE(t *gce.GCEAPITarget, a, e, changes *Forwardin
 		o.Subnetwork = e.Subnetwork.URL(project, t.Cloud.Region())
 	}
 
+	if a != nil && changes.BackendService != nil {
+		// The backend service of a forwarding rule cannot be changed, so we replace the rule
+		klog.Infof("Replacing ForwardingRule %q to change its backend service", o.Name)
+		op, err := t.Cloud.Compute().ForwardingRules().Delete(ctx, t.Cloud.Project(), t.Cloud.Region(), o.Name)
+		if err != nil {
+			return fmt.Errorf("error deleting ForwardingRule %q: %w", o.Name, err)
+		}
+		if err := t.Cloud.WaitForOp(op); err != nil {
+			return fmt.Errorf("error deleting ForwardingRule %q: %w", o.Name, err)
+		}
+		a = nil
+	}
+
 	if a == nil {
 		klog.V(4).Infof("Creating ForwardingRule %q", o.Name)
 
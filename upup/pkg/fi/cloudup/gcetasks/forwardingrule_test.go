@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcetasks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	gcemock "k8s.io/kops/cloudmock/gce"
+	"k8s.io/kops/upup/pkg/fi"
+)
+
+func TestForwardingRuleChangeBackendService(t *testing.T) {
+	ctx := context.TODO()
+
+	project := "testproject"
+	region := "us-test1"
+
+	cloud := gcemock.InstallMockGCECloud(region, project)
+
+	// We define a function so we can rebuild the tasks, because we modify in-place when running
+	buildTasks := func(backendServiceName string) map[string]fi.CloudupTask {
+		healthCheck := &HealthCheck{
+			Name:      fi.PtrTo("hc"),
+			Port:      3988,
+			Lifecycle: fi.LifecycleSync,
+		}
+		backendService := &BackendService{
+			Name:                fi.PtrTo(backendServiceName),
+			Protocol:            fi.PtrTo("TCP"),
+			HealthChecks:        []*HealthCheck{healthCheck},
+			LoadBalancingScheme: fi.PtrTo("INTERNAL"),
+			Lifecycle:           fi.LifecycleSync,
+		}
+		forwardingRule := &ForwardingRule{
+			Name:                fi.PtrTo("kops-controller"),
+			BackendService:      backendService,
+			Ports:               []string{"3988"},
+			IPProtocol:          "TCP",
+			LoadBalancingScheme: fi.PtrTo("INTERNAL"),
+			Labels:              map[string]string{"name": "kops-controller"},
+			Lifecycle:           fi.LifecycleSync,
+		}
+
+		return map[string]fi.CloudupTask{
+			"HealthCheck/hc":                       healthCheck,
+			"BackendService/" + backendServiceName: backendService,
+			"ForwardingRule/kops-controller":       forwardingRule,
+		}
+	}
+
+	{
+		allTasks := buildTasks("api")
+		runTasks(t, ctx, cloud, allTasks)
+		checkNoChanges(t, ctx, cloud, buildTasks("api"))
+	}
+
+	{
+		allTasks := buildTasks("kops-controller")
+		checkHasChanges(t, ctx, cloud, allTasks)
+	}
+
+	{
+		allTasks := buildTasks("kops-controller")
+		runTasks(t, ctx, cloud, allTasks)
+		checkNoChanges(t, ctx, cloud, buildTasks("kops-controller"))
+	}
+
+	rule, err := cloud.Compute().ForwardingRules().Get(ctx, project, region, "kops-controller")
+	if err != nil {
+		t.Fatalf("error getting forwarding rule: %v", err)
+	}
+	if !strings.HasSuffix(rule.BackendService, "/kops-controller") {
+		t.Errorf("expected the forwarding rule to target backend service kops-controller, got %q", rule.BackendService)
+	}
+	if rule.Labels["name"] != "kops-controller" {
+		t.Errorf("expected the labels of the forwarding rule to be set, got %v", rule.Labels)
+	}
+}
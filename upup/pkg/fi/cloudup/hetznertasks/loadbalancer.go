@@ -90,6 +90,10 @@ func (v *LoadBalancer) FindAddresses(c *fi.CloudupContext) ([]string, error) {
 				return nil, fmt.Errorf("failed to find load-balancer %q public address", fi.ValueOf(v.Name))
 			}
 			addresses = append(addresses, loadbalancer.PublicNet.IPv4.IP.String())
+			if c.T.Cluster.Spec.IsIPv6Only() && loadbalancer.PublicNet.IPv6.IP != nil {
+				// The nodes of IPv6 clusters have public IPv6 addresses, and can reach the load balancer without the private network
+				addresses = append(addresses, loadbalancer.PublicNet.IPv6.IP.String())
+			}
 			for _, privateNetwork := range loadbalancer.PrivateNet {
 				if privateNetwork.IP == nil {
 					return nil, fmt.Errorf("failed to find load-balancer %q private address", fi.ValueOf(v.Name))
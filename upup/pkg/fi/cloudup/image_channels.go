@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/imagechannel"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+)
+
+// LatestChannelImage returns the latest image of the image channel of the instance group
+func LatestChannelImage(cloud fi.Cloud, ig *kops.InstanceGroup) (string, error) {
+	awsCloud, ok := cloud.(awsup.AWSCloud)
+	if !ok {
+		return "", fmt.Errorf("image channels are only supported on AWS")
+	}
+	architecture, err := MachineArchitecture(cloud, ig.Spec.MachineType)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine machine architecture for InstanceGroup %q: %w", ig.ObjectMeta.Name, err)
+	}
+	return imagechannel.Latest(awsCloud, ig.Spec.ImageChannel, architecture)
+}
+
+// pinImageChannels pins the instance groups with an image channel which aren't pinned yet to the latest image
+// of their channel, and warns about those whose channel has a newer image than the one they are pinned to.
+// The pins are only recorded in the state store when applying.
+func (c *ApplyClusterCmd) pinImageChannels(ctx context.Context) error {
+	for i, ig := range c.InstanceGroups {
+		if ig.Spec.ImageChannel == "" {
+			continue
+		}
+
+		latest, err := LatestChannelImage(c.Cloud, ig)
+		if err != nil {
+			return err
+		}
+
+		pinned := imagechannel.Pinned(ig)
+		if pinned != "" {
+			if pinned != latest {
+				klog.Warningf("InstanceGroup %q is pinned to image %q, but channel %q has a newer image %q; use kops promote image --ig %s to update it",
+					ig.ObjectMeta.Name, pinned, ig.Spec.ImageChannel, latest, ig.ObjectMeta.Name)
+			}
+			continue
+		}
+
+		ig = ig.DeepCopy()
+		imagechannel.Pin(ig, latest)
+		if c.DryRun {
+			klog.Infof("InstanceGroup %q will be pinned to image %q of channel %q", ig.ObjectMeta.Name, latest, ig.Spec.ImageChannel)
+		} else {
+			klog.Infof("Pinning InstanceGroup %q to image %q of channel %q", ig.ObjectMeta.Name, latest, ig.Spec.ImageChannel)
+			updated, err := c.Clientset.InstanceGroupsFor(c.Cluster).Update(ctx, ig, metav1.UpdateOptions{})
+			if err != nil {
+				return fmt.Errorf("recording the image of InstanceGroup %q: %w", ig.ObjectMeta.Name, err)
+			}
+			ig = updated
+		}
+		c.InstanceGroups[i] = ig
+	}
+
+	return nil
+}
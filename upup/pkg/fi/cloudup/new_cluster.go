@@ -483,8 +483,8 @@ func NewCluster(opt *NewClusterOptions, clientset simple.Clientset) (*NewCluster
 				}
 			}
 		} else {
-			if g.IsAPIServerOnly() && !featureflag.APIServerNodes.Enabled() {
-				return nil, fmt.Errorf("apiserver nodes requires the APIServerNodes feature flag to be enabled")
+			if g.IsAPIServerOnly() && !model.CanUseAPIServerNodes(cluster) {
+				return nil, fmt.Errorf("apiserver nodes requires the APIServerNodes feature flag or the Large control plane profile on AWS with DNS")
 			}
 			if g.Spec.MachineType == "" {
 				g.Spec.MachineType, err = defaultMachineType(cloud, cluster, g)
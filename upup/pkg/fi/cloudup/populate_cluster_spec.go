@@ -250,6 +250,8 @@ func (c *populateClusterSpec) run(ctx context.Context, clientset simple.Clientse
 			}
 		}
 
+		defaultAPILoadBalancerForNoneDNS(cluster)
+
 		if cluster.Spec.API.LoadBalancer != nil && cluster.Spec.API.LoadBalancer.Type == "" {
 			cluster.Spec.API.LoadBalancer.Type = kopsapi.LoadBalancerTypePublic
 		}
@@ -391,3 +393,17 @@ func (c *populateClusterSpec) assignSubnets(cluster *kopsapi.Cluster) error {
 
 	return nil
 }
+
+// defaultAPILoadBalancerForNoneDNS adds an API load balancer to clusters without DNS on the clouds where
+// the nodes find the control plane through the addresses of the load balancer.
+func defaultAPILoadBalancerForNoneDNS(cluster *kopsapi.Cluster) {
+	if !cluster.UsesNoneDNS() || cluster.Spec.API.LoadBalancer != nil {
+		return
+	}
+	switch cluster.GetCloudProvider() {
+	case kopsapi.CloudProviderGCE, kopsapi.CloudProviderAzure:
+		klog.Infof("Adding an API load balancer, which is required without DNS on %s", cluster.GetCloudProvider())
+		cluster.Spec.API.DNS = nil
+		cluster.Spec.API.LoadBalancer = &kopsapi.LoadBalancerAccessSpec{}
+	}
+}
@@ -350,3 +350,56 @@ func TestPopulateCluster_KubeController_High_Enough_Version(t *testing.T) {
 		t.Fatalf("AttachDetachReconcileSyncPeriod not set correctly")
 	}
 }
+
+func TestDefaultAPILoadBalancerForNoneDNS(t *testing.T) {
+	grid := []struct {
+		Cloud                kopsapi.CloudProviderID
+		LoadBalancer         *kopsapi.LoadBalancerAccessSpec
+		ExpectedLoadBalancer bool
+	}{
+		{
+			Cloud:                kopsapi.CloudProviderGCE,
+			LoadBalancer:         &kopsapi.LoadBalancerAccessSpec{Type: kopsapi.LoadBalancerTypeInternal},
+			ExpectedLoadBalancer: true,
+		},
+		{
+			Cloud:                kopsapi.CloudProviderGCE,
+			ExpectedLoadBalancer: true,
+		},
+		{
+			Cloud:                kopsapi.CloudProviderAzure,
+			ExpectedLoadBalancer: true,
+		},
+		{
+			Cloud: kopsapi.CloudProviderHetzner,
+		},
+	}
+	for _, g := range grid {
+		t.Run(string(g.Cloud), func(t *testing.T) {
+			cluster := &kopsapi.Cluster{}
+			switch g.Cloud {
+			case kopsapi.CloudProviderGCE:
+				cluster.Spec.CloudProvider.GCE = &kopsapi.GCESpec{}
+			case kopsapi.CloudProviderAzure:
+				cluster.Spec.CloudProvider.Azure = &kopsapi.AzureSpec{}
+			case kopsapi.CloudProviderHetzner:
+				cluster.Spec.CloudProvider.Hetzner = &kopsapi.HetznerSpec{}
+			}
+			cluster.Spec.API.DNS = &kopsapi.DNSAccessSpec{}
+			cluster.Spec.API.LoadBalancer = g.LoadBalancer
+			cluster.Spec.Networking.Topology = &kopsapi.TopologySpec{DNS: kopsapi.DNSTypeNone}
+
+			defaultAPILoadBalancerForNoneDNS(cluster)
+
+			if (cluster.Spec.API.LoadBalancer != nil) != g.ExpectedLoadBalancer {
+				t.Errorf("expected load balancer %v, got %v", g.ExpectedLoadBalancer, cluster.Spec.API.LoadBalancer)
+			}
+			if g.LoadBalancer != nil && cluster.Spec.API.LoadBalancer.Type != kopsapi.LoadBalancerTypeInternal {
+				t.Errorf("expected the configured load balancer to be kept, got %v", cluster.Spec.API.LoadBalancer)
+			}
+			if g.LoadBalancer == nil && g.ExpectedLoadBalancer && cluster.Spec.API.DNS != nil {
+				t.Errorf("expected the API DNS to be replaced by the load balancer")
+			}
+		})
+	}
+}
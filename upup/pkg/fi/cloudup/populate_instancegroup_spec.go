@@ -26,12 +26,14 @@ import (
 	"k8s.io/klog/v2"
 
 	"k8s.io/kops/pkg/apis/kops"
+	kopsmodel "k8s.io/kops/pkg/apis/kops/model"
 	"k8s.io/kops/pkg/apis/kops/validation"
-	"k8s.io/kops/pkg/featureflag"
+	"k8s.io/kops/pkg/imagechannel"
 	"k8s.io/kops/pkg/nodelabels"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
 	"k8s.io/kops/upup/pkg/fi/cloudup/openstack"
+	"k8s.io/kops/util/pkg/architectures"
 	"k8s.io/kops/util/pkg/reflectutils"
 )
 
@@ -64,6 +66,9 @@ const (
 	defaultScalewayImageNoble = "ubuntu_noble"
 )
 
+// windowsTaintKey is the key of the taint which keeps the pods that do not tolerate Windows off Windows nodes
+const windowsTaintKey = "node.kubernetes.io/os"
+
 // TODO: this hardcoded list can be replaced with DescribeInstanceTypes' DedicatedHostsSupported field
 var awsDedicatedInstanceExceptions = map[string]bool{
 	"t2.nano":   true,
@@ -118,8 +123,8 @@ func PopulateInstanceGroupSpec(cluster *kops.Cluster, input *kops.InstanceGroup,
 			ig.Spec.MaxSize = fi.PtrTo(int32(1))
 		}
 	} else {
-		if ig.IsAPIServerOnly() && !featureflag.APIServerNodes.Enabled() {
-			return nil, fmt.Errorf("apiserver nodes requires the APIServerNodes feature flag to be enabled")
+		if ig.IsAPIServerOnly() && !kopsmodel.CanUseAPIServerNodes(cluster) {
+			return nil, fmt.Errorf("apiserver nodes requires the APIServerNodes feature flag or the Large control plane profile on AWS with DNS")
 		}
 		if ig.Spec.MachineType == "" {
 			ig.Spec.MachineType, err = defaultMachineType(cloud, cluster, ig)
@@ -135,6 +140,18 @@ func PopulateInstanceGroupSpec(cluster *kops.Cluster, input *kops.InstanceGroup,
 		}
 	}
 
+	if ig.Spec.Image == "" && ig.IsWindows() {
+		return nil, fmt.Errorf("spec.image must be set for Windows InstanceGroup %q", ig.ObjectMeta.Name)
+	}
+	if ig.Spec.Image == "" && ig.Spec.ImageChannel != "" {
+		ig.Spec.Image = imagechannel.Pinned(ig)
+		if ig.Spec.Image == "" {
+			ig.Spec.Image, err = LatestChannelImage(cloud, ig)
+			if err != nil {
+				return nil, fmt.Errorf("unable to determine image of instance group %q: %w", ig.ObjectMeta.Name, err)
+			}
+		}
+	}
 	if ig.Spec.Image == "" {
 		architecture, err := MachineArchitecture(cloud, ig.Spec.MachineType)
 		if err != nil {
@@ -232,6 +249,51 @@ func PopulateInstanceGroupSpec(cluster *kops.Cluster, input *kops.InstanceGroup,
 		}
 	}
 
+	if ig.Spec.ArchitectureTaint {
+		architecture, err := MachineArchitecture(cloud, ig.Spec.MachineType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine machine architecture for InstanceGroup %q: %v", ig.ObjectMeta.Name, err)
+		}
+		// The label is set by the kubelet, but is also needed by the cluster autoscaler to scale the instance group from zero
+		if ig.Spec.NodeLabels == nil {
+			ig.Spec.NodeLabels = make(map[string]string)
+		}
+		ig.Spec.NodeLabels[v1.LabelArchStable] = string(architecture)
+		hasArchitectureTaint := false
+		for _, taint := range ig.Spec.Taints {
+			if strings.HasPrefix(taint, v1.LabelArchStable+"=") {
+				hasArchitectureTaint = true
+			}
+		}
+		if !hasArchitectureTaint {
+			ig.Spec.Taints = append(ig.Spec.Taints, v1.LabelArchStable+"="+string(architecture)+":"+string(v1.TaintEffectNoSchedule))
+		}
+	}
+
+	if ig.IsWindows() {
+		architecture, err := MachineArchitecture(cloud, ig.Spec.MachineType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine machine architecture for InstanceGroup %q: %v", ig.ObjectMeta.Name, err)
+		}
+		if architecture != architectures.ArchitectureAmd64 {
+			return nil, fmt.Errorf("windows InstanceGroup %q requires an amd64 machine type, %q is %s", ig.ObjectMeta.Name, ig.Spec.MachineType, architecture)
+		}
+		// Linux pods which do not select an operating system would fail to start on Windows nodes
+		if ig.Spec.NodeLabels == nil {
+			ig.Spec.NodeLabels = make(map[string]string)
+		}
+		ig.Spec.NodeLabels[v1.LabelOSStable] = "windows"
+		hasWindowsTaint := false
+		for _, taint := range ig.Spec.Taints {
+			if strings.HasPrefix(taint, windowsTaintKey+"=") {
+				hasWindowsTaint = true
+			}
+		}
+		if !hasWindowsTaint {
+			ig.Spec.Taints = append(ig.Spec.Taints, windowsTaintKey+"=windows:"+string(v1.TaintEffectNoSchedule))
+		}
+	}
+
 	if ig.Spec.Manager == "" {
 		ig.Spec.Manager = kops.InstanceManagerCloudGroup
 	}
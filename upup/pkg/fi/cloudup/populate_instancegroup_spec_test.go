@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/featureflag"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/util/pkg/architectures"
 )
@@ -235,6 +236,85 @@ func TestPopulateInstanceGroup_AddTaints(t *testing.T) {
 	}
 }
 
+func TestPopulateInstanceGroup_ArchitectureTaint(t *testing.T) {
+	_, cluster := buildMinimalCluster()
+	input := buildMinimalNodeInstanceGroup()
+	input.Spec.MachineType = "a1.large"
+	input.Spec.ArchitectureTaint = true
+
+	channel := &kopsapi.Channel{}
+
+	cloud, err := BuildCloud(cluster)
+	if err != nil {
+		t.Fatalf("error from BuildCloud: %v", err)
+	}
+	output, err := PopulateInstanceGroupSpec(cluster, input, cloud, channel)
+	if err != nil {
+		t.Fatalf("error from PopulateInstanceGroupSpec: %v", err)
+	}
+	if len(output.Spec.Taints) != 1 || output.Spec.Taints[0] != "kubernetes.io/arch=arm64:NoSchedule" {
+		t.Errorf("Expected the arm64 taint, got %v", output.Spec.Taints)
+	}
+	if output.Spec.NodeLabels["kubernetes.io/arch"] != "arm64" {
+		t.Errorf("Expected the arm64 label, got %v", output.Spec.NodeLabels)
+	}
+
+	// A taint set by the user is kept
+	input.Spec.Taints = []string{"kubernetes.io/arch=arm64:PreferNoSchedule"}
+	output, err = PopulateInstanceGroupSpec(cluster, input, cloud, channel)
+	if err != nil {
+		t.Fatalf("error from PopulateInstanceGroupSpec: %v", err)
+	}
+	if len(output.Spec.Taints) != 1 || output.Spec.Taints[0] != "kubernetes.io/arch=arm64:PreferNoSchedule" {
+		t.Errorf("Expected only the user taint, got %v", output.Spec.Taints)
+	}
+}
+
+func TestPopulateInstanceGroup_ArchitectureTaintControlPlane(t *testing.T) {
+	_, cluster := buildMinimalCluster()
+	g := buildMinimalMasterInstanceGroup("subnet-us-test-1a")
+	g.Spec.ArchitectureTaint = true
+
+	channel := &kopsapi.Channel{}
+
+	expectErrorFromPopulateInstanceGroup(t, cluster, g, channel, "spec.architectureTaint")
+}
+
+func TestPopulateInstanceGroup_Windows(t *testing.T) {
+	featureflag.ParseFlags("+WindowsNodes")
+	defer featureflag.ParseFlags("-WindowsNodes")
+
+	_, cluster := buildMinimalCluster()
+	input := buildMinimalNodeInstanceGroup()
+	input.Spec.OperatingSystem = kopsapi.InstanceGroupOperatingSystemWindows
+	input.Spec.MachineType = "t3.large"
+	input.Spec.Image = "amazon/Windows_Server-2022-English-Core-EKS_Optimized-1.33"
+
+	channel := &kopsapi.Channel{}
+
+	cloud, err := BuildCloud(cluster)
+	if err != nil {
+		t.Fatalf("error from BuildCloud: %v", err)
+	}
+	output, err := PopulateInstanceGroupSpec(cluster, input, cloud, channel)
+	if err != nil {
+		t.Fatalf("error from PopulateInstanceGroupSpec: %v", err)
+	}
+	if len(output.Spec.Taints) != 1 || output.Spec.Taints[0] != "node.kubernetes.io/os=windows:NoSchedule" {
+		t.Errorf("Expected the windows taint, got %v", output.Spec.Taints)
+	}
+	if output.Spec.NodeLabels["kubernetes.io/os"] != "windows" {
+		t.Errorf("Expected the windows label, got %v", output.Spec.NodeLabels)
+	}
+
+	input.Spec.Image = ""
+	expectErrorFromPopulateInstanceGroup(t, cluster, input, channel, "spec.image must be set")
+
+	input.Spec.Image = "windows-image"
+	input.Spec.MachineType = "a1.large"
+	expectErrorFromPopulateInstanceGroup(t, cluster, input, channel, "requires an amd64 machine type")
+}
+
 func expectErrorFromPopulateInstanceGroup(t *testing.T, cluster *kopsapi.Cluster, g *kopsapi.InstanceGroup, channel *kopsapi.Channel, message string) {
 	cloud, err := BuildCloud(cluster)
 	if err != nil {
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/upup/pkg/fi/cloudup/gce"
+)
+
+// AnnotationOnDemandFallback is set on an instance group preferring spot capacity once it has fallen back to on-demand
+// capacity, and holds the time of the fallback. The group stays on on-demand capacity until the annotation is removed.
+const AnnotationOnDemandFallback = "spot.kops.k8s.io/on-demand-fallback"
+
+// fallbackToOnDemand switches the instance groups that prefer spot capacity to on-demand capacity
+// when they have fallen back before, or when the cloud reports that no spot capacity is available for them.
+// The cloud is only asked when applying directly, and the fallback is then recorded in an annotation of the instance group.
+func (c *ApplyClusterCmd) fallbackToOnDemand(ctx context.Context, cloud fi.Cloud, modelContext *model.KopsModelContext) error {
+	for _, ig := range modelContext.AllInstanceGroups {
+		if !fi.ValueOf(ig.Spec.PreferSpot) {
+			continue
+		}
+
+		if _, found := ig.ObjectMeta.Annotations[AnnotationOnDemandFallback]; !found {
+			if c.TargetName != TargetDirect || c.DryRun {
+				continue
+			}
+
+			unavailable, err := isSpotCapacityUnavailable(ctx, cloud, modelContext, ig)
+			if err != nil {
+				return err
+			}
+			if !unavailable {
+				continue
+			}
+
+			klog.Warningf("no spot capacity is available for InstanceGroup %q, falling back to on-demand capacity until annotation %s is removed", ig.ObjectMeta.Name, AnnotationOnDemandFallback)
+			annotated := ig.DeepCopy()
+			if annotated.ObjectMeta.Annotations == nil {
+				annotated.ObjectMeta.Annotations = make(map[string]string)
+			}
+			annotated.ObjectMeta.Annotations[AnnotationOnDemandFallback] = time.Now().UTC().Format(time.RFC3339)
+			updated, err := c.Clientset.InstanceGroupsFor(c.Cluster).Update(ctx, annotated, metav1.UpdateOptions{})
+			if err != nil {
+				return fmt.Errorf("recording the on-demand fallback of InstanceGroup %q: %w", ig.ObjectMeta.Name, err)
+			}
+			ig.ObjectMeta = updated.ObjectMeta
+		}
+
+		switch cloud.ProviderID() {
+		case kops.CloudProviderAWS:
+			ig.Spec.MixedInstancesPolicy = &kops.MixedInstancesPolicySpec{
+				Instances:         []string{ig.Spec.MachineType},
+				OnDemandBase:      fi.PtrTo(int64(0)),
+				OnDemandAboveBase: fi.PtrTo(int64(100)),
+			}
+		case kops.CloudProviderGCE:
+			ig.Spec.GCPProvisioningModel = fi.PtrTo("STANDARD")
+		}
+	}
+
+	return nil
+}
+
+// isSpotCapacityUnavailable returns true if the cloud reports that no spot capacity is available for the instance group.
+func isSpotCapacityUnavailable(ctx context.Context, cloud fi.Cloud, modelContext *model.KopsModelContext, ig *kops.InstanceGroup) (bool, error) {
+	switch cloud := cloud.(type) {
+	case awsup.AWSCloud:
+		return awsup.IsSpotCapacityUnavailable(ctx, cloud, modelContext.AutoscalingGroupName(ig))
+
+	case gce.GCECloud:
+		zones, err := modelContext.FindZonesForInstanceGroup(ig)
+		if err != nil {
+			return false, err
+		}
+		for _, zone := range zones {
+			name := gce.NameForInstanceGroupManager(modelContext.Cluster.ObjectMeta.Name, ig.ObjectMeta.Name, zone)
+			unavailable, err := gce.IsSpotCapacityUnavailable(ctx, cloud, zone, name)
+			if err != nil {
+				return false, err
+			}
+			if unavailable {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kops/cloudmock/aws/mockautoscaling"
+	gcemock "k8s.io/kops/cloudmock/gce"
+	kopsapi "k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/pkg/client/simple"
+	"k8s.io/kops/pkg/client/simple/vfsclientset"
+	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/model/iam"
+	"k8s.io/kops/pkg/testutils"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
+	"k8s.io/kops/util/pkg/vfs"
+)
+
+func TestFallbackToOnDemand(t *testing.T) {
+	noSpotCapacity := []autoscalingtypes.Activity{
+		{
+			StatusCode:    autoscalingtypes.ScalingActivityStatusCodeFailed,
+			StatusMessage: aws.String("Could not launch Spot Instances. InsufficientInstanceCapacity - There is no Spot capacity available."),
+		},
+	}
+
+	grid := []struct {
+		Name       string
+		TargetName Target
+		DryRun     bool
+		Annotated  bool
+		Activities []autoscalingtypes.Activity
+		Expected   bool
+	}{
+		{
+			Name:       "direct with spot capacity",
+			TargetName: TargetDirect,
+		},
+		{
+			Name:       "direct without spot capacity",
+			TargetName: TargetDirect,
+			Activities: noSpotCapacity,
+			Expected:   true,
+		},
+		{
+			Name:       "dry-run without spot capacity",
+			TargetName: TargetDirect,
+			DryRun:     true,
+			Activities: noSpotCapacity,
+		},
+		{
+			Name:       "terraform without spot capacity",
+			TargetName: TargetTerraform,
+			Activities: noSpotCapacity,
+		},
+		{
+			Name:       "terraform after fallback",
+			TargetName: TargetTerraform,
+			Annotated:  true,
+			Expected:   true,
+		},
+		{
+			Name:       "direct after fallback with spot capacity",
+			TargetName: TargetDirect,
+			Annotated:  true,
+			Expected:   true,
+		},
+	}
+	for _, g := range grid {
+		t.Run(g.Name, func(t *testing.T) {
+			ctx := context.TODO()
+			cluster, clientset, ig := buildSpotFallbackCluster(t, g.Annotated)
+
+			cloud := awsup.InstallMockAWSCloud(testAWSRegion, "abcd")
+			cloud.MockAutoscaling = &mockautoscaling.MockAutoscaling{
+				ScalingActivities: map[string][]autoscalingtypes.Activity{
+					"nodes." + cluster.Name: g.Activities,
+				},
+			}
+
+			c := &ApplyClusterCmd{
+				Cluster:    cluster,
+				Clientset:  clientset,
+				TargetName: g.TargetName,
+				DryRun:     g.DryRun,
+			}
+			modelContext := &model.KopsModelContext{
+				IAMModelContext:   iam.IAMModelContext{Cluster: cluster},
+				AllInstanceGroups: []*kopsapi.InstanceGroup{ig},
+			}
+			if err := c.fallbackToOnDemand(ctx, cloud, modelContext); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if g.Expected {
+				policy := ig.Spec.MixedInstancesPolicy
+				if policy == nil || fi.ValueOf(policy.OnDemandAboveBase) != 100 {
+					t.Errorf("expected an on-demand mixed instances policy, got %+v", policy)
+				}
+			} else if ig.Spec.MixedInstancesPolicy != nil {
+				t.Errorf("expected no mixed instances policy, got %+v", ig.Spec.MixedInstancesPolicy)
+			}
+
+			stored, err := clientset.InstanceGroupsFor(cluster).Get(ctx, ig.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("error reading instance group: %v", err)
+			}
+			if _, found := stored.Annotations[AnnotationOnDemandFallback]; found != g.Expected {
+				t.Errorf("expected annotation %s stored %v, got %v", AnnotationOnDemandFallback, g.Expected, found)
+			}
+			if stored.Spec.MixedInstancesPolicy != nil {
+				t.Errorf("expected the stored instance group spec to be unchanged, got %+v", stored.Spec.MixedInstancesPolicy)
+			}
+		})
+	}
+}
+
+func TestFallbackToOnDemandGCE(t *testing.T) {
+	ctx := context.TODO()
+	cluster, clientset, ig := buildSpotFallbackCluster(t, true)
+	cluster.Spec.CloudProvider = kopsapi.CloudProviderSpec{GCE: &kopsapi.GCESpec{}}
+
+	c := &ApplyClusterCmd{
+		Cluster:    cluster,
+		Clientset:  clientset,
+		TargetName: TargetTerraform,
+	}
+	modelContext := &model.KopsModelContext{
+		IAMModelContext:   iam.IAMModelContext{Cluster: cluster},
+		AllInstanceGroups: []*kopsapi.InstanceGroup{ig},
+	}
+	if err := c.fallbackToOnDemand(ctx, gcemock.InstallMockGCECloud("us-test1", "testproject"), modelContext); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provisioningModel := fi.ValueOf(ig.Spec.GCPProvisioningModel); provisioningModel != "STANDARD" {
+		t.Errorf("expected provisioning model STANDARD, got %q", provisioningModel)
+	}
+}
+
+// buildSpotFallbackCluster stores an instance group preferring spot capacity in a new state store
+func buildSpotFallbackCluster(t *testing.T, annotated bool) (*kopsapi.Cluster, simple.Clientset, *kopsapi.InstanceGroup) {
+	vfs.Context.ResetMemfsContext(true)
+	basePath, err := vfs.Context.BuildVfsPath("memfs://tests")
+	if err != nil {
+		t.Fatalf("error building vfspath: %v", err)
+	}
+	clientset := vfsclientset.NewVFSClientset(vfs.Context, basePath)
+
+	cluster := testutils.BuildMinimalCluster("testcluster.test.com")
+	ig := &kopsapi.InstanceGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodes"},
+		Spec: kopsapi.InstanceGroupSpec{
+			Role:        kopsapi.InstanceGroupRoleNode,
+			MachineType: "t3.medium",
+			MinSize:     fi.PtrTo[int32](1),
+			MaxSize:     fi.PtrTo[int32](1),
+			Subnets:     []string{"subnet-us-test-1a"},
+			PreferSpot:  fi.PtrTo(true),
+		},
+	}
+	if annotated {
+		ig.Annotations = map[string]string{AnnotationOnDemandFallback: "2026-01-01T00:00:00Z"}
+	}
+	if _, err := clientset.InstanceGroupsFor(cluster).Create(context.TODO(), ig, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error creating instance group: %v", err)
+	}
+	return cluster, clientset, ig
+}
@@ -33,6 +33,10 @@ const (
 	TargetDryRun Target = "dryrun"
 	// TargetTerraform means we will generate terraform code.
 	TargetTerraform Target = "terraform"
+	// TargetPulumi means we will generate a Pulumi YAML program.
+	TargetPulumi Target = "pulumi"
+	// TargetCrossplane means we will generate Crossplane managed resources.
+	TargetCrossplane Target = "crossplane"
 )
 
 // Target can be used as a flag value.
@@ -44,7 +48,7 @@ func (t *Target) String() string {
 
 func (t *Target) Set(value string) error {
 	switch strings.ToLower(value) {
-	case string(TargetDirect), string(TargetDryRun), string(TargetTerraform):
+	case string(TargetDirect), string(TargetDryRun), string(TargetTerraform), string(TargetPulumi), string(TargetCrossplane):
 		*t = Target(value)
 		return nil
 	default:
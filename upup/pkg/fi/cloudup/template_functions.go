@@ -33,18 +33,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"path"
 	"sort"
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	kopsroot "k8s.io/kops"
@@ -57,9 +60,11 @@ import (
 	"k8s.io/kops/pkg/flagbuilder"
 	"k8s.io/kops/pkg/kubemanifest"
 	"k8s.io/kops/pkg/model"
+	"k8s.io/kops/pkg/model/components"
 	"k8s.io/kops/pkg/model/components/kopscontroller"
 	"k8s.io/kops/pkg/model/iam"
 	"k8s.io/kops/pkg/resources/spotinst"
+	"k8s.io/kops/pkg/tokens"
 	"k8s.io/kops/pkg/wellknownports"
 	"k8s.io/kops/upup/pkg/fi"
 	"k8s.io/kops/upup/pkg/fi/cloudup/awsup"
@@ -70,6 +75,7 @@ import (
 	"k8s.io/kops/upup/pkg/fi/cloudup/hetzner"
 	"k8s.io/kops/upup/pkg/fi/cloudup/openstack"
 	"k8s.io/kops/upup/pkg/fi/cloudup/scaleway"
+	"k8s.io/kops/util/pkg/awspartition"
 	"k8s.io/kops/util/pkg/env"
 	"k8s.io/kops/util/pkg/maps"
 	"sigs.k8s.io/yaml"
@@ -123,6 +129,7 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 	dest["HasHighlyAvailableControlPlane"] = tf.HasHighlyAvailableControlPlane
 	dest["ControlPlaneControllerReplicas"] = tf.ControlPlaneControllerReplicas
 	dest["APIServerNodeRole"] = tf.APIServerNodeRole
+	dest["NamespaceDefaultsNamespaces"] = tf.NamespaceDefaultsNamespaces
 	dest["APIInternalName"] = tf.Cluster.APIInternalName
 
 	dest["CloudTags"] = tf.CloudTagsForInstanceGroup
@@ -157,8 +164,10 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 	}
 
 	dest["KopsControllerArgv"] = tf.KopsControllerArgv
+	dest["KopsControllerMetricsPort"] = func() int { return wellknownports.KopsControllerMetrics }
+	dest["DNSControllerMetricsPort"] = func() int { return wellknownports.DNSControllerMetrics }
 	dest["KopsControllerConfig"] = tf.KopsControllerConfig
-	kopscontroller.AddTemplateFunctions(cluster, dest)
+	kopscontroller.AddTemplateFunctions(cluster, tf.AllInstanceGroups, dest)
 	dest["DnsControllerArgv"] = tf.DNSControllerArgv
 	dest["ExternalDnsArgv"] = tf.ExternalDNSArgv
 	dest["CloudControllerConfigArgv"] = tf.CloudControllerConfigArgv
@@ -328,6 +337,24 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 		dest["CiliumSecret"] = func() string { return ciliumsecretString }
 	}
 
+	if tf.HasWindowsInstanceGroups() {
+		var tokenID, tokenSecret string
+		secret, err := secretStore.FindSecret(tokens.WindowsBootstrapTokenSecretName)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			tokenID, tokenSecret, err = tokens.ParseBootstrapToken(string(secret.Data))
+			if err != nil {
+				return fmt.Errorf("parsing %s secret: %w", tokens.WindowsBootstrapTokenSecretName, err)
+			}
+		}
+
+		dest["WindowsBootstrapTokenID"] = func() string { return tokenID }
+		dest["WindowsBootstrapTokenSecret"] = func() string { return tokenSecret }
+		dest["WindowsBootstrapTokenGroup"] = func() string { return tokens.WindowsBootstrapTokenGroup }
+	}
+
 	if cluster.Spec.Networking.Flannel != nil {
 		flannelBackendType := cluster.Spec.Networking.Flannel.Backend
 		if flannelBackendType == "" {
@@ -350,6 +377,9 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 	}
 
 	dest["IsIPv6Only"] = tf.IsIPv6Only
+	dest["CertManagerUsesClusterCA"] = func() bool {
+		return components.CertManagerUsesClusterCA(cluster)
+	}
 	dest["UseServiceAccountExternalPermissions"] = tf.UseServiceAccountExternalPermissions
 
 	if cluster.Spec.ClusterAutoscaler != nil {
@@ -384,11 +414,7 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 	if cluster.Spec.CloudProvider.AWS != nil && cluster.Spec.CloudProvider.AWS.NodeTerminationHandler != nil {
 		dest["DefaultQueueName"] = func() string {
 			s := strings.Replace(tf.ClusterName(), ".", "-", -1)
-			domain := ".amazonaws.com/"
-			if strings.Contains(tf.Region, "cn-") {
-				domain = ".amazonaws.com.cn/"
-			}
-			url := "https://sqs." + tf.Region + domain + tf.AWSAccountID + "/" + s + "-nth"
+			url := "https://" + awspartition.ForRegion(tf.Region).Endpoint("sqs", tf.Region) + "/" + tf.AWSAccountID + "/" + s + "-nth"
 			return url
 		}
 
@@ -412,6 +438,8 @@ func (tf *TemplateFunctions) AddTo(dest template.FuncMap, secretStore fi.SecretS
 		sc := cluster.Spec.SnapshotController
 		return sc != nil && fi.ValueOf(sc.Enabled)
 	}
+	dest["CSIDefaultStorageClass"] = tf.csiDefaultStorageClass
+	dest["CSISidecarResources"] = csiSidecarResources
 
 	dest["IsKubernetesGTE"] = tf.IsKubernetesGTE
 	dest["IsKubernetesLT"] = tf.IsKubernetesLT
@@ -491,12 +519,29 @@ func (tf *TemplateFunctions) ControlPlaneControllerReplicas(deployOnWorkersIfExt
 }
 
 func (tf *TemplateFunctions) APIServerNodeRole() string {
-	if featureflag.APIServerNodes.Enabled() {
+	if apiModel.UseAPIServerNodes(tf.Cluster, tf.AllInstanceGroups) {
 		return "node-role.kubernetes.io/api-server"
 	}
 	return "node-role.kubernetes.io/control-plane"
 }
 
+// NamespaceDefaultsNamespaces returns the namespaces referenced by the namespace defaults that Kubernetes does not create itself.
+func (tf *TemplateFunctions) NamespaceDefaultsNamespaces() []string {
+	spec := tf.Cluster.Spec.NamespaceDefaults
+	if spec == nil {
+		return nil
+	}
+	namespaces := sets.New[string]()
+	for _, limitRange := range spec.LimitRanges {
+		namespaces.Insert(limitRange.Namespaces...)
+	}
+	for _, resourceQuota := range spec.ResourceQuotas {
+		namespaces.Insert(resourceQuota.Namespaces...)
+	}
+	namespaces.Delete("default", "kube-system", "kube-public", "kube-node-lease")
+	return sets.List(namespaces)
+}
+
 // HasHighlyAvailableControlPlane returns true of the cluster has more than one control plane node. False otherwise.
 func (tf *TemplateFunctions) HasHighlyAvailableControlPlane() bool {
 	cp := 0
@@ -627,7 +672,8 @@ func (tf *TemplateFunctions) DNSControllerArgv() ([]string, error) {
 	} else {
 		switch cluster.GetCloudProvider() {
 		case kops.CloudProviderAWS:
-			if strings.HasPrefix(os.Getenv("AWS_REGION"), "cn-") {
+			// Route53 is not used for the records of clusters in the China regions
+			if awspartition.ForRegion(tf.Region) == awspartition.China {
 				argv = append(argv, "--dns=gossip")
 			} else {
 				argv = append(argv, "--dns=aws-route53")
@@ -665,6 +711,11 @@ func (tf *TemplateFunctions) DNSControllerArgv() ([]string, error) {
 
 	// permit wildcard updates
 	argv = append(argv, "--zone=*/*")
+
+	if cluster.Spec.Monitoring.MetricsEnabled() {
+		argv = append(argv, fmt.Sprintf("--metrics-listen=:%d", wellknownports.DNSControllerMetrics))
+	}
+
 	// Verbose, but not crazy logging
 	argv = append(argv, "-v=2")
 
@@ -792,6 +843,72 @@ func (tf *TemplateFunctions) KopsControllerConfig() (string, error) {
 		}
 	}
 
+	if cluster.Spec.Monitoring.MetricsEnabled() {
+		config.MetricsAddress = fmt.Sprintf(":%d", wellknownports.KopsControllerMetrics)
+	}
+
+	if gitOps := cluster.Spec.GitOps; gitOps != nil {
+		config.GitOps = &kopscontrollerconfig.GitOpsOptions{
+			Reconcile: gitOps.ReconcileEnabled(),
+			Interval:  metav1.Duration{Duration: 10 * time.Minute},
+			Source:    gitOps.Source,
+		}
+		if gitOps.Interval != nil {
+			config.GitOps.Interval = *gitOps.Interval
+		}
+	}
+
+	if components.CertManagerUsesClusterCA(cluster) {
+		config.ClusterCA = &kopscontrollerconfig.ClusterCAOptions{
+			Namespace:  "kube-system",
+			SecretName: fi.CertificateIDCA,
+		}
+	}
+
+	if cluster.Spec.Monitoring.HealthEnabled() {
+		config.Health = &kopscontrollerconfig.HealthOptions{
+			Interval: metav1.Duration{Duration: 5 * time.Minute},
+		}
+		if cluster.Spec.Monitoring.HealthInterval != nil {
+			config.Health.Interval = *cluster.Spec.Monitoring.HealthInterval
+		}
+	}
+
+	if cluster.Spec.Monitoring.CertificateExpiryEnabled() {
+		config.Certificates = &kopscontrollerconfig.CertificatesOptions{
+			ExpiryWindow: metav1.Duration{Duration: 30 * 24 * time.Hour},
+			Paths: []string{
+				"/etc/kubernetes/kops-controller/pki",
+				"/etc/kubernetes/pki",
+				"/srv/kubernetes",
+			},
+		}
+		if window := cluster.Spec.Monitoring.CertificateExpiry.Window; window != nil {
+			config.Certificates.ExpiryWindow = *window
+		}
+	}
+
+	if aws := cluster.Spec.CloudProvider.AWS; aws != nil && fi.ValueOf(aws.ResizeVolumesInPlace) {
+		config.VolumeResize = &kopscontrollerconfig.VolumeResizeOptions{
+			Interval: metav1.Duration{Duration: 5 * time.Minute},
+		}
+	}
+
+	if probes := cluster.Spec.ConnectivityProbes; probes != nil {
+		config.ConnectivityProbes = &kopscontrollerconfig.ConnectivityProbesOptions{
+			Interval:      metav1.Duration{Duration: time.Minute},
+			Timeout:       metav1.Duration{Duration: 5 * time.Second},
+			Targets:       tf.connectivityProbeTargets(),
+			EtcdPeerPorts: []int{wellknownports.EtcdMainGRPC, wellknownports.EtcdEventsGRPC},
+		}
+		if probes.Interval != nil {
+			config.ConnectivityProbes.Interval = *probes.Interval
+		}
+		if probes.Timeout != nil {
+			config.ConnectivityProbes.Timeout = *probes.Timeout
+		}
+	}
+
 	// To avoid indentation problems, we marshal as json.  json is a subset of yaml
 	b, err := json.Marshal(config)
 	if err != nil {
@@ -801,6 +918,78 @@ func (tf *TemplateFunctions) KopsControllerConfig() (string, error) {
 	return string(b), nil
 }
 
+// connectivityProbeTargets returns the endpoints the nodes depend on, probed by kops-controller --probe.
+func (tf *TemplateFunctions) connectivityProbeTargets() []kopscontrollerconfig.ProbeTarget {
+	cluster := tf.Cluster
+
+	var targets []kopscontrollerconfig.ProbeTarget
+
+	// Without DNS, nodes find the API load balancer through the cloud provider, so its address is not known here
+	if !cluster.UsesNoneDNS() {
+		targets = append(targets, kopscontrollerconfig.ProbeTarget{
+			Name:    "api",
+			Address: net.JoinHostPort(cluster.APIInternalName(), strconv.Itoa(wellknownports.KubeAPIServer)),
+		})
+	}
+
+	if host := stateStoreHost(cluster.Spec.ConfigStore.Base, tf.Region); host != "" {
+		targets = append(targets, kopscontrollerconfig.ProbeTarget{
+			Name:    "state-store",
+			Address: net.JoinHostPort(host, "443"),
+			// Nodes get their configuration from kops-controller, rather than from the state store
+			ControlPlaneOnly: apiModel.UseKopsControllerForNodeConfig(cluster),
+		})
+	}
+
+	registry := "registry.k8s.io"
+	if assets := cluster.Spec.Assets; assets != nil {
+		if assets.ContainerProxy != nil {
+			registry = fi.ValueOf(assets.ContainerProxy)
+		} else if assets.ContainerRegistry != nil {
+			registry = fi.ValueOf(assets.ContainerRegistry)
+		}
+	}
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	registry, _, _ = strings.Cut(registry, "/")
+	if _, _, err := net.SplitHostPort(registry); err != nil {
+		registry = net.JoinHostPort(registry, "443")
+	}
+	targets = append(targets, kopscontrollerconfig.ProbeTarget{
+		Name:    "registry",
+		Address: registry,
+	})
+
+	for _, target := range cluster.Spec.ConnectivityProbes.AdditionalTargets {
+		targets = append(targets, kopscontrollerconfig.ProbeTarget{
+			Name:    target,
+			Address: target,
+		})
+	}
+
+	return targets
+}
+
+// stateStoreHost returns the host serving the state store, or an empty string if it is not known.
+func stateStoreHost(configBase string, region string) string {
+	u, err := url.Parse(configBase)
+	if err != nil {
+		return ""
+	}
+	switch u.Scheme {
+	case "s3":
+		if region == "" {
+			return "s3.amazonaws.com"
+		}
+		return awspartition.ForRegion(region).Endpoint("s3", region)
+	case "gs":
+		return "storage.googleapis.com"
+	case "https":
+		return u.Hostname()
+	default:
+		return ""
+	}
+}
+
 // KopsControllerArgv returns the args to kops-controller
 func (tf *TemplateFunctions) KopsControllerArgv() ([]string, error) {
 	var argv []string
@@ -1134,3 +1323,61 @@ func (tf *TemplateFunctions) kopsFeatureEnabled(featureName string) (bool, error
 	}
 	return f.Enabled(), nil
 }
+
+// csiDefaultStorageClass returns the configuration of the default StorageClass of the CSI driver of the cloud provider.
+// It is never nil, so that templates can use its fields directly.
+func (tf *TemplateFunctions) csiDefaultStorageClass() *kops.CSIStorageClassSpec {
+	cloudProvider := &tf.Cluster.Spec.CloudProvider
+	var sc *kops.CSIStorageClassSpec
+	if cloudProvider.AWS != nil && cloudProvider.AWS.EBSCSIDriver != nil {
+		sc = cloudProvider.AWS.EBSCSIDriver.DefaultStorageClass
+	} else if cloudProvider.GCE != nil && cloudProvider.GCE.PDCSIDriver != nil {
+		sc = cloudProvider.GCE.PDCSIDriver.DefaultStorageClass
+	}
+	if sc == nil {
+		return &kops.CSIStorageClassSpec{}
+	}
+	return sc
+}
+
+// csiSidecarResources returns the resources of the sidecar containers of a CSI driver.
+// The values set in spec override the given defaults; empty defaults are left unset.
+// It returns nil if no resources are set.
+func csiSidecarResources(spec *kops.CSISidecarResources, cpuRequest, memoryRequest, memoryLimit string) (*corev1.ResourceRequirements, error) {
+	resources := &corev1.ResourceRequirements{}
+	set := func(list *corev1.ResourceList, name corev1.ResourceName, value *resource.Quantity, defaultValue string) error {
+		if value == nil {
+			if defaultValue == "" {
+				return nil
+			}
+			q, err := resource.ParseQuantity(defaultValue)
+			if err != nil {
+				return fmt.Errorf("parsing %s %q: %w", name, defaultValue, err)
+			}
+			value = &q
+		}
+		if *list == nil {
+			*list = corev1.ResourceList{}
+		}
+		(*list)[name] = *value
+		return nil
+	}
+
+	if spec == nil {
+		spec = &kops.CSISidecarResources{}
+	}
+	if err := set(&resources.Requests, corev1.ResourceCPU, spec.CPURequest, cpuRequest); err != nil {
+		return nil, err
+	}
+	if err := set(&resources.Requests, corev1.ResourceMemory, spec.MemoryRequest, memoryRequest); err != nil {
+		return nil, err
+	}
+	if err := set(&resources.Limits, corev1.ResourceMemory, spec.MemoryLimit, memoryLimit); err != nil {
+		return nil, err
+	}
+
+	if resources.Requests == nil && resources.Limits == nil {
+		return nil, nil
+	}
+	return resources, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+// crossplaneKind identifies the kind of a Crossplane managed resource.
+type crossplaneKind struct {
+	apiVersion string
+	kind       string
+}
+
+// crossplaneResourceKinds maps terraform resource types to the managed resources of the Upbound
+// Crossplane providers, which are generated from the terraform providers.
+var crossplaneResourceKinds = map[string]crossplaneKind{
+	"aws_autoscaling_group":               {"autoscaling.aws.upbound.io/v1beta1", "AutoscalingGroup"},
+	"aws_autoscaling_lifecycle_hook":      {"autoscaling.aws.upbound.io/v1beta1", "LifecycleHook"},
+	"aws_cloudwatch_event_rule":           {"cloudwatchevents.aws.upbound.io/v1beta1", "Rule"},
+	"aws_cloudwatch_event_target":         {"cloudwatchevents.aws.upbound.io/v1beta1", "Target"},
+	"aws_ebs_volume":                      {"ec2.aws.upbound.io/v1beta1", "EBSVolume"},
+	"aws_egress_only_internet_gateway":    {"ec2.aws.upbound.io/v1beta1", "EgressOnlyInternetGateway"},
+	"aws_eip":                             {"ec2.aws.upbound.io/v1beta1", "EIP"},
+	"aws_elb":                             {"elb.aws.upbound.io/v1beta1", "ELB"},
+	"aws_iam_instance_profile":            {"iam.aws.upbound.io/v1beta1", "InstanceProfile"},
+	"aws_iam_openid_connect_provider":     {"iam.aws.upbound.io/v1beta1", "OpenIDConnectProvider"},
+	"aws_iam_role":                        {"iam.aws.upbound.io/v1beta1", "Role"},
+	"aws_iam_role_policy":                 {"iam.aws.upbound.io/v1beta1", "RolePolicy"},
+	"aws_iam_role_policy_attachment":      {"iam.aws.upbound.io/v1beta1", "RolePolicyAttachment"},
+	"aws_internet_gateway":                {"ec2.aws.upbound.io/v1beta1", "InternetGateway"},
+	"aws_key_pair":                        {"ec2.aws.upbound.io/v1beta1", "KeyPair"},
+	"aws_launch_template":                 {"ec2.aws.upbound.io/v1beta1", "LaunchTemplate"},
+	"aws_lb":                              {"elbv2.aws.upbound.io/v1beta1", "LB"},
+	"aws_lb_listener":                     {"elbv2.aws.upbound.io/v1beta1", "LBListener"},
+	"aws_lb_target_group":                 {"elbv2.aws.upbound.io/v1beta1", "LBTargetGroup"},
+	"aws_nat_gateway":                     {"ec2.aws.upbound.io/v1beta1", "NATGateway"},
+	"aws_route":                           {"ec2.aws.upbound.io/v1beta1", "Route"},
+	"aws_route53_record":                  {"route53.aws.upbound.io/v1beta1", "Record"},
+	"aws_route53_zone_association":        {"route53.aws.upbound.io/v1beta1", "ZoneAssociation"},
+	"aws_route_table":                     {"ec2.aws.upbound.io/v1beta1", "RouteTable"},
+	"aws_route_table_association":         {"ec2.aws.upbound.io/v1beta1", "RouteTableAssociation"},
+	"aws_s3_object":                       {"s3.aws.upbound.io/v1beta1", "Object"},
+	"aws_security_group":                  {"ec2.aws.upbound.io/v1beta1", "SecurityGroup"},
+	"aws_security_group_rule":             {"ec2.aws.upbound.io/v1beta1", "SecurityGroupRule"},
+	"aws_sqs_queue":                       {"sqs.aws.upbound.io/v1beta1", "Queue"},
+	"aws_subnet":                          {"ec2.aws.upbound.io/v1beta1", "Subnet"},
+	"aws_vpc":                             {"ec2.aws.upbound.io/v1beta1", "VPC"},
+	"aws_vpc_dhcp_options":                {"ec2.aws.upbound.io/v1beta1", "VPCDHCPOptions"},
+	"aws_vpc_dhcp_options_association":    {"ec2.aws.upbound.io/v1beta1", "VPCDHCPOptionsAssociation"},
+	"aws_vpc_ipv4_cidr_block_association": {"ec2.aws.upbound.io/v1beta1", "VPCIPv4CidrBlockAssociation"},
+
+	"google_compute_address":                {"compute.gcp.upbound.io/v1beta1", "Address"},
+	"google_compute_disk":                   {"compute.gcp.upbound.io/v1beta1", "Disk"},
+	"google_compute_firewall":               {"compute.gcp.upbound.io/v1beta1", "Firewall"},
+	"google_compute_forwarding_rule":        {"compute.gcp.upbound.io/v1beta1", "ForwardingRule"},
+	"google_compute_http_health_check":      {"compute.gcp.upbound.io/v1beta1", "HTTPHealthCheck"},
+	"google_compute_instance":               {"compute.gcp.upbound.io/v1beta1", "Instance"},
+	"google_compute_instance_group_manager": {"compute.gcp.upbound.io/v1beta1", "InstanceGroupManager"},
+	"google_compute_instance_template":      {"compute.gcp.upbound.io/v1beta1", "InstanceTemplate"},
+	"google_compute_network":                {"compute.gcp.upbound.io/v1beta1", "Network"},
+	"google_compute_region_backend_service": {"compute.gcp.upbound.io/v1beta1", "RegionBackendService"},
+	"google_compute_region_health_check":    {"compute.gcp.upbound.io/v1beta1", "RegionHealthCheck"},
+	"google_compute_router":                 {"compute.gcp.upbound.io/v1beta1", "Router"},
+	"google_compute_router_nat":             {"compute.gcp.upbound.io/v1beta1", "RouterNAT"},
+	"google_compute_subnetwork":             {"compute.gcp.upbound.io/v1beta1", "Subnetwork"},
+	"google_compute_target_pool":            {"compute.gcp.upbound.io/v1beta1", "TargetPool"},
+	"google_service_account":                {"cloudplatform.gcp.upbound.io/v1beta1", "ServiceAccount"},
+	"google_storage_bucket_acl":             {"storage.gcp.upbound.io/v1beta1", "BucketACL"},
+	"google_storage_bucket_iam_member":      {"storage.gcp.upbound.io/v1beta1", "BucketIAMMember"},
+	"google_storage_bucket_object":          {"storage.gcp.upbound.io/v1beta1", "BucketObject"},
+	"google_storage_object_acl":             {"storage.gcp.upbound.io/v1beta1", "ObjectACL"},
+}
+
+// crossplaneNameIdentifiers maps resource types to the argument that names the cloud resource.
+// The Crossplane providers take that name from the external-name annotation, rather than from spec.forProvider.
+var crossplaneNameIdentifiers = map[string]string{
+	"aws_autoscaling_group":     "name",
+	"aws_cloudwatch_event_rule": "name",
+	"aws_elb":                   "name",
+	"aws_iam_instance_profile":  "name",
+	"aws_iam_role":              "name",
+	"aws_key_pair":              "key_name",
+	"aws_sqs_queue":             "name",
+
+	"google_compute_address":    "name",
+	"google_compute_disk":       "name",
+	"google_compute_firewall":   "name",
+	"google_compute_instance":   "name",
+	"google_compute_network":    "name",
+	"google_compute_router":     "name",
+	"google_compute_subnetwork": "name",
+}
+
+// crossplaneGlobalResourcePrefixes are the prefixes of the AWS resource types that have no region.
+var crossplaneGlobalResourcePrefixes = []string{
+	"aws_iam_",
+}
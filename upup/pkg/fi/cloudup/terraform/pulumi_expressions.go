@@ -0,0 +1,428 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// expression is a parsed terraform expression, limited to the forms that kOps renders.
+type expression interface{}
+
+type stringExpression struct {
+	value string
+}
+
+type numberExpression struct {
+	value string
+}
+
+type boolExpression struct {
+	value bool
+}
+
+type nullExpression struct{}
+
+// traversalExpression is a reference such as aws_vpc.example-com.id
+type traversalExpression struct {
+	parts []string
+}
+
+type callExpression struct {
+	function string
+	args     []expression
+}
+
+type listExpression struct {
+	items []expression
+}
+
+type indexExpression struct {
+	collection expression
+	index      expression
+}
+
+// expressionParser is a recursive descent parser for terraform expressions.
+type expressionParser struct {
+	s   string
+	pos int
+}
+
+// parseExpression parses a terraform expression, as rendered in a terraformWriter.Literal.
+func parseExpression(s string) (expression, error) {
+	p := &expressionParser{s: s}
+	e, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.s) {
+		return nil, fmt.Errorf("unsupported expression %q: unexpected %q", s, p.s[p.pos:])
+	}
+	return e, nil
+}
+
+func (p *expressionParser) skipSpace() {
+	for p.pos < len(p.s) && strings.ContainsRune(" \t\r\n", rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *expressionParser) expect(c byte) error {
+	if p.peek() != c {
+		return fmt.Errorf("unsupported expression %q: expected %q at offset %d", p.s, c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *expressionParser) parseExpression() (expression, error) {
+	e, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == '[' {
+		p.pos++
+		index, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(']'); err != nil {
+			return nil, err
+		}
+		e = &indexExpression{collection: e, index: index}
+	}
+	return e, nil
+}
+
+func (p *expressionParser) parsePrimary() (expression, error) {
+	c := p.peek()
+	switch {
+	case c == '"':
+		return p.parseString()
+	case c == '[':
+		p.pos++
+		list := &listExpression{}
+		for p.peek() != ']' {
+			item, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			list.items = append(list.items, item)
+			if p.peek() == ',' {
+				p.pos++
+			} else if p.peek() != ']' {
+				return nil, fmt.Errorf("unsupported expression %q: expected ',' or ']' at offset %d", p.s, p.pos)
+			}
+		}
+		p.pos++
+		return list, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.s) && strings.ContainsRune("0123456789.eE+-", rune(p.s[p.pos])) {
+			p.pos++
+		}
+		return &numberExpression{value: p.s[start:p.pos]}, nil
+	case isIdentifierStart(c):
+		var parts []string
+		for {
+			parts = append(parts, p.parseIdentifier())
+			if p.pos < len(p.s) && p.s[p.pos] == '.' {
+				p.pos++
+				continue
+			}
+			break
+		}
+		if len(parts) == 1 {
+			switch parts[0] {
+			case "true", "false":
+				return &boolExpression{value: parts[0] == "true"}, nil
+			case "null":
+				return &nullExpression{}, nil
+			}
+			if p.peek() == '(' {
+				p.pos++
+				call := &callExpression{function: parts[0]}
+				for p.peek() != ')' {
+					arg, err := p.parseExpression()
+					if err != nil {
+						return nil, err
+					}
+					call.args = append(call.args, arg)
+					if p.peek() == ',' {
+						p.pos++
+					} else if p.peek() != ')' {
+						return nil, fmt.Errorf("unsupported expression %q: expected ',' or ')' at offset %d", p.s, p.pos)
+					}
+				}
+				p.pos++
+				return call, nil
+			}
+		}
+		return &traversalExpression{parts: parts}, nil
+	default:
+		return nil, fmt.Errorf("unsupported expression %q", p.s)
+	}
+}
+
+func isIdentifierStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+}
+
+func (p *expressionParser) parseIdentifier() string {
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if isIdentifierStart(c) || (c >= '0' && c <= '9') || c == '-' {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *expressionParser) parseString() (expression, error) {
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return &stringExpression{value: b.String()}, nil
+		case '\\':
+			if p.pos >= len(p.s) {
+				break
+			}
+			escaped := p.s[p.pos]
+			p.pos++
+			switch escaped {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			default:
+				b.WriteByte(escaped)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return nil, fmt.Errorf("unsupported expression %q: unterminated string", p.s)
+}
+
+// pulumiConverter converts terraform expressions into Pulumi YAML values.
+type pulumiConverter struct {
+	// resourceNames maps terraform resource addresses (type.name) to Pulumi logical names
+	resourceNames map[string]string
+	// dataSourceNames maps terraform data source addresses (data.type.name) to Pulumi variable names
+	dataSourceNames map[string]string
+	// base64Files is the set of data files that are read with filebase64, and so must be written base64 encoded
+	base64Files map[string]bool
+}
+
+func (c *pulumiConverter) convertLiteral(s string) (interface{}, error) {
+	e, err := parseExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	return c.convert(e)
+}
+
+func (c *pulumiConverter) convert(e expression) (interface{}, error) {
+	switch e := e.(type) {
+	case *stringExpression:
+		return escapePulumiString(e.value), nil
+	case *numberExpression:
+		if i, err := strconv.ParseInt(e.value, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(e.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", e.value)
+		}
+		return f, nil
+	case *boolExpression:
+		return e.value, nil
+	case *nullExpression:
+		return nil, nil
+	case *traversalExpression:
+		return c.convertReference(e)
+	case *listExpression:
+		items := make([]interface{}, 0, len(e.items))
+		for _, item := range e.items {
+			v, err := c.convert(item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		return items, nil
+	case *indexExpression:
+		collection, err := c.convert(e.collection)
+		if err != nil {
+			return nil, err
+		}
+		index, err := c.convert(e.index)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"fn::select": []interface{}{index, collection}}, nil
+	case *callExpression:
+		return c.convertCall(e)
+	default:
+		return nil, fmt.Errorf("unhandled expression type %T", e)
+	}
+}
+
+// convertReference converts a reference to a resource or data source attribute into a Pulumi interpolation.
+func (c *pulumiConverter) convertReference(e *traversalExpression) (string, error) {
+	reference := strings.Join(e.parts, ".")
+	var name string
+	var attributes []string
+	if e.parts[0] == "data" && len(e.parts) >= 4 {
+		name = c.dataSourceNames[strings.Join(e.parts[:3], ".")]
+		attributes = e.parts[3:]
+	} else if len(e.parts) >= 3 {
+		name = c.resourceNames[strings.Join(e.parts[:2], ".")]
+		attributes = e.parts[2:]
+	}
+	if name == "" {
+		return "", fmt.Errorf("unsupported reference %q", reference)
+	}
+	s := "${" + name
+	for _, attribute := range attributes {
+		s += "." + pulumiPropertyName(attribute)
+	}
+	return s + "}", nil
+}
+
+func (c *pulumiConverter) convertCall(e *callExpression) (interface{}, error) {
+	switch e.function {
+	case "file", "filebase64":
+		if len(e.args) != 1 {
+			return nil, fmt.Errorf("unexpected arguments to %s", e.function)
+		}
+		p, ok := e.args[0].(*stringExpression)
+		if !ok || !strings.HasPrefix(p.value, "${path.module}/") {
+			return nil, fmt.Errorf("unsupported argument to %s", e.function)
+		}
+		filePath := strings.TrimPrefix(p.value, "${path.module}/")
+		if e.function == "filebase64" {
+			// Pulumi YAML reads files as strings, so we write the file already encoded
+			c.base64Files[filePath] = true
+		}
+		return map[string]interface{}{"fn::readFile": filePath}, nil
+
+	case "format":
+		if len(e.args) == 0 {
+			return nil, fmt.Errorf("unexpected arguments to format")
+		}
+		format, ok := e.args[0].(*stringExpression)
+		if !ok {
+			return nil, fmt.Errorf("unsupported format string")
+		}
+		var b strings.Builder
+		args := e.args[1:]
+		s := escapePulumiString(format.value)
+		for i := 0; i < len(s); i++ {
+			ch := s[i]
+			if ch != '%' || i+1 >= len(s) {
+				b.WriteByte(ch)
+				continue
+			}
+			i++
+			verb := s[i]
+			if verb == '%' {
+				b.WriteByte('%')
+				continue
+			}
+			if (verb != 's' && verb != 'd') || len(args) == 0 {
+				return nil, fmt.Errorf("unsupported format string %q", format.value)
+			}
+			v, err := c.convert(args[0])
+			if err != nil {
+				return nil, err
+			}
+			args = args[1:]
+			switch v := v.(type) {
+			case string:
+				b.WriteString(v)
+			case int64:
+				b.WriteString(strconv.FormatInt(v, 10))
+			default:
+				return nil, fmt.Errorf("unsupported format argument %v", v)
+			}
+		}
+		return b.String(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported function %q", e.function)
+	}
+}
+
+// escapePulumiString escapes a string so that it is not treated as a Pulumi YAML interpolation.
+func escapePulumiString(s string) string {
+	return strings.ReplaceAll(s, "${", "$${")
+}
+
+// pulumiPropertyName converts a terraform attribute name to the name used by the Pulumi bridged providers.
+func pulumiPropertyName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteString(strings.ToUpper(string(r)))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// pulumiListPropertyName returns the name of a list-valued property;
+// the Pulumi bridged providers pluralize the names of list attributes and blocks.
+func pulumiListPropertyName(name string) string {
+	name = pulumiPropertyName(name)
+	switch {
+	case strings.HasSuffix(name, "s"), name == "ingress", name == "egress":
+		return name
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ey"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	default:
+		return name + "s"
+	}
+}
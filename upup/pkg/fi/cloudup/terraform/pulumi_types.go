@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+// pulumiPackages maps terraform providers to the Pulumi packages bridged from them.
+var pulumiPackages = map[string]string{
+	"aws":    "aws",
+	"google": "gcp",
+}
+
+// pulumiResourceTypes maps terraform resource types to the Pulumi type tokens of the bridged providers.
+var pulumiResourceTypes = map[string]string{
+	"aws_autoscaling_group":               "aws:autoscaling:Group",
+	"aws_autoscaling_lifecycle_hook":      "aws:autoscaling:LifecycleHook",
+	"aws_cloudwatch_event_rule":           "aws:cloudwatch:EventRule",
+	"aws_cloudwatch_event_target":         "aws:cloudwatch:EventTarget",
+	"aws_ebs_volume":                      "aws:ebs:Volume",
+	"aws_egress_only_internet_gateway":    "aws:ec2:EgressOnlyInternetGateway",
+	"aws_eip":                             "aws:ec2:Eip",
+	"aws_elb":                             "aws:elb:LoadBalancer",
+	"aws_iam_instance_profile":            "aws:iam:InstanceProfile",
+	"aws_iam_openid_connect_provider":     "aws:iam:OpenIdConnectProvider",
+	"aws_iam_role":                        "aws:iam:Role",
+	"aws_iam_role_policy":                 "aws:iam:RolePolicy",
+	"aws_iam_role_policy_attachment":      "aws:iam:RolePolicyAttachment",
+	"aws_internet_gateway":                "aws:ec2:InternetGateway",
+	"aws_key_pair":                        "aws:ec2:KeyPair",
+	"aws_launch_template":                 "aws:ec2:LaunchTemplate",
+	"aws_lb":                              "aws:lb:LoadBalancer",
+	"aws_lb_listener":                     "aws:lb:Listener",
+	"aws_lb_target_group":                 "aws:lb:TargetGroup",
+	"aws_nat_gateway":                     "aws:ec2:NatGateway",
+	"aws_route":                           "aws:ec2:Route",
+	"aws_route53_record":                  "aws:route53:Record",
+	"aws_route53_zone_association":        "aws:route53:ZoneAssociation",
+	"aws_route_table":                     "aws:ec2:RouteTable",
+	"aws_route_table_association":         "aws:ec2:RouteTableAssociation",
+	"aws_s3_object":                       "aws:s3:BucketObjectv2",
+	"aws_security_group":                  "aws:ec2:SecurityGroup",
+	"aws_security_group_rule":             "aws:ec2:SecurityGroupRule",
+	"aws_sqs_queue":                       "aws:sqs:Queue",
+	"aws_subnet":                          "aws:ec2:Subnet",
+	"aws_vpc":                             "aws:ec2:Vpc",
+	"aws_vpc_dhcp_options":                "aws:ec2:VpcDhcpOptions",
+	"aws_vpc_dhcp_options_association":    "aws:ec2:VpcDhcpOptionsAssociation",
+	"aws_vpc_ipv4_cidr_block_association": "aws:ec2:VpcIpv4CidrBlockAssociation",
+
+	"google_compute_address":                "gcp:compute:Address",
+	"google_compute_disk":                   "gcp:compute:Disk",
+	"google_compute_firewall":               "gcp:compute:Firewall",
+	"google_compute_forwarding_rule":        "gcp:compute:ForwardingRule",
+	"google_compute_http_health_check":      "gcp:compute:HttpHealthCheck",
+	"google_compute_instance":               "gcp:compute:Instance",
+	"google_compute_instance_group_manager": "gcp:compute:InstanceGroupManager",
+	"google_compute_instance_template":      "gcp:compute:InstanceTemplate",
+	"google_compute_network":                "gcp:compute:Network",
+	"google_compute_region_backend_service": "gcp:compute:RegionBackendService",
+	"google_compute_region_health_check":    "gcp:compute:RegionHealthCheck",
+	"google_compute_router":                 "gcp:compute:Router",
+	"google_compute_router_nat":             "gcp:compute:RouterNat",
+	"google_compute_subnetwork":             "gcp:compute:Subnetwork",
+	"google_compute_target_pool":            "gcp:compute:TargetPool",
+	"google_project_iam_binding":            "gcp:projects:IAMBinding",
+	"google_service_account":                "gcp:serviceaccount:Account",
+	"google_storage_bucket_acl":             "gcp:storage:BucketACL",
+	"google_storage_bucket_iam_member":      "gcp:storage:BucketIAMMember",
+	"google_storage_bucket_object":          "gcp:storage:BucketObject",
+	"google_storage_object_acl":             "gcp:storage:ObjectACL",
+}
+
+// pulumiDataSourceFunctions maps terraform data sources to the Pulumi functions of the bridged providers.
+var pulumiDataSourceFunctions = map[string]string{
+	"aws_vpc": "aws:ec2:getVpc",
+}
+
+// pulumiListBlocks is the set of blocks (resourceType.block) that kOps renders as a single block,
+// but which are lists in the Pulumi schema because terraform does not limit them to a single item.
+var pulumiListBlocks = map[string]bool{
+	"aws_route53_record.alias": true,
+}
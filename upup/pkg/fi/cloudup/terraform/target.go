@@ -37,8 +37,19 @@ type TerraformTarget struct {
 	outDir string
 	// extra config to add to the provider block
 	clusterSpecTarget *kops.TargetSpec
+	// format is the format of the output; terraform is written unless another format is set
+	format outputFormat
 }
 
+// outputFormat is the format that the rendered resources are written in.
+type outputFormat string
+
+const (
+	outputFormatTerraform  outputFormat = ""
+	outputFormatPulumi     outputFormat = "pulumi"
+	outputFormatCrossplane outputFormat = "crossplane"
+)
+
 func NewTerraformTarget(cloud fi.Cloud, project string, outDir string, clusterSpecTarget *kops.TargetSpec) *TerraformTarget {
 	target := TerraformTarget{
 		Cloud:   cloud,
@@ -52,6 +63,13 @@ func NewTerraformTarget(cloud fi.Cloud, project string, outDir string, clusterSp
 }
 
 var _ fi.CloudupTarget = &TerraformTarget{}
+var _ fi.ExistingResourceRecorder[fi.CloudupSubContext] = &TerraformTarget{}
+
+// Importable is implemented by tasks that can be imported into the terraform state when they already exist.
+type Importable interface {
+	// TerraformImport returns the terraform resource corresponding to the existing (actual) resource, or nil if it should not be imported.
+	TerraformImport(actual fi.CloudupTask) *terraformWriter.Import
+}
 
 func (t *TerraformTarget) AddFileResource(resourceType string, resourceName string, key string, r fi.Resource, base64 bool) (*terraformWriter.Literal, error) {
 	d, err := fi.ResourceAsBytes(r)
@@ -67,6 +85,34 @@ func (t *TerraformTarget) DefaultCheckExisting() bool {
 	return false
 }
 
+// RecordExistingResources implements fi.ExistingResourceRecorder
+func (t *TerraformTarget) RecordExistingResources() bool {
+	return t.clusterSpecTarget != nil && t.clusterSpecTarget.Terraform != nil && fi.ValueOf(t.clusterSpecTarget.Terraform.ImportExistingResources)
+}
+
+// RecordExisting implements fi.ExistingResourceRecorder, generating an import block for tasks that support it.
+func (t *TerraformTarget) RecordExisting(e, a fi.CloudupTask) error {
+	importable, ok := e.(Importable)
+	if !ok {
+		return nil
+	}
+	imp := importable.TerraformImport(a)
+	if imp == nil || imp.ID == "" {
+		return nil
+	}
+	t.AddImport(imp.ResourceType, imp.ResourceName, imp.ID)
+	return nil
+}
+
+// RenderInstanceGroupResource renders a resource that belongs to the instance group (or autoscaling group) named group.
+// If instance group modules are enabled, the resource is written to a terraform module for that group.
+func (t *TerraformTarget) RenderInstanceGroupResource(group string, resourceType string, resourceName string, e interface{}) error {
+	if t.clusterSpecTarget != nil && t.clusterSpecTarget.Terraform != nil && fi.ValueOf(t.clusterSpecTarget.Terraform.InstanceGroupModules) {
+		return t.RenderModuleResource(group, resourceType, resourceName, e)
+	}
+	return t.RenderResource(resourceType, resourceName, e)
+}
+
 // tfGetProviderExtraConfig is a helper function to get extra config with safety checks on the pointers.
 func tfGetProviderExtraConfig(c *kops.TargetSpec) map[string]string {
 	if c != nil &&
@@ -85,8 +131,26 @@ func tfGetFilesProviderExtraConfig(c *kops.TargetSpec) map[string]string {
 	return nil
 }
 
+// tfGetProviderVersions is a helper function to get the provider version overrides with safety checks on the pointers.
+func tfGetProviderVersions(c *kops.TargetSpec) map[string]string {
+	if c != nil &&
+		c.Terraform != nil {
+		return c.Terraform.ProviderVersions
+	}
+	return nil
+}
+
 func (t *TerraformTarget) Finish(taskMap map[string]fi.CloudupTask) error {
-	if err := t.finishHCL2(); err != nil {
+	var err error
+	switch t.format {
+	case outputFormatPulumi:
+		err = t.finishPulumi()
+	case outputFormatCrossplane:
+		err = t.finishCrossplane()
+	default:
+		err = t.finishHCL2()
+	}
+	if err != nil {
 		return err
 	}
 
@@ -103,7 +167,14 @@ func (t *TerraformTarget) Finish(taskMap map[string]fi.CloudupTask) error {
 			return fmt.Errorf("error writing terraform data to output file %q: %v", p, err)
 		}
 	}
-	klog.Infof("Terraform output is in %s", t.outDir)
+	switch t.format {
+	case outputFormatPulumi:
+		klog.Infof("Pulumi output is in %s", t.outDir)
+	case outputFormatCrossplane:
+		klog.Infof("Crossplane output is in %s", t.outDir)
+	default:
+		klog.Infof("Terraform output is in %s", t.outDir)
+	}
 
 	return nil
 }
@@ -0,0 +1,509 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// crossplaneClusterLabel is the label that identifies the managed resources of a cluster
+	crossplaneClusterLabel = "kops.k8s.io/cluster"
+	// crossplaneExternalNameAnnotation is the annotation that holds the name of the cloud resource
+	crossplaneExternalNameAnnotation = "crossplane.io/external-name"
+	// crossplaneUnresolvedAnnotation lists the references that could not be expressed as managed resource references
+	crossplaneUnresolvedAnnotation = "kops.k8s.io/unresolved-references"
+)
+
+// crossplaneReferenceAttributes are the attributes that the Crossplane providers resolve
+// through references to other managed resources.
+var crossplaneReferenceAttributes = map[string]bool{
+	"arn":       true,
+	"email":     true,
+	"id":        true,
+	"name":      true,
+	"self_link": true,
+}
+
+// NewCrossplaneTarget builds a target that writes Crossplane managed resources.
+// Tasks render themselves exactly as they do for terraform; the resources are then
+// converted for the Crossplane providers that are generated from the terraform providers.
+func NewCrossplaneTarget(cloud fi.Cloud, project string, outDir string, clusterSpecTarget *kops.TargetSpec) *TerraformTarget {
+	target := NewTerraformTarget(cloud, project, outDir, clusterSpecTarget)
+	target.format = outputFormatCrossplane
+	return target
+}
+
+// crossplaneResourceName converts a terraform resource name to a valid Kubernetes object name.
+func crossplaneResourceName(resourceName string) string {
+	return strings.ToLower(strings.ReplaceAll(resourceName, "_", "-"))
+}
+
+// crossplaneFieldName converts a terraform attribute name to the name of the managed resource field;
+// the Crossplane providers use the same camel case names as the Pulumi bridged providers.
+func crossplaneFieldName(name string) string {
+	return pulumiPropertyName(name)
+}
+
+// crossplaneReferencesFieldName returns the name of the field that holds references for a list attribute,
+// e.g. subnetIdRefs for subnet_ids.
+func crossplaneReferencesFieldName(name string) string {
+	name = crossplaneFieldName(name)
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		name = strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s"):
+		name = strings.TrimSuffix(name, "s")
+	}
+	return name + "Refs"
+}
+
+func (t *TerraformTarget) finishCrossplane() error {
+	resourcesByType, err := t.GetResourcesByType()
+	if err != nil {
+		return err
+	}
+	dataSourcesByType, err := t.GetDataSourcesByType()
+	if err != nil {
+		return err
+	}
+	if len(dataSourcesByType) != 0 {
+		return fmt.Errorf("data source %q is not supported by the crossplane target", sortedKeysForMap(dataSourcesByType)[0])
+	}
+
+	c := &crossplaneConverter{
+		resourceNames: make(map[string]string),
+		files:         t.Files,
+	}
+	for resourceType, resources := range resourcesByType {
+		for resourceName := range resources {
+			c.resourceNames[resourceType+"."+resourceName] = crossplaneResourceName(resourceName)
+		}
+	}
+
+	imports := make(map[string]string)
+	for _, imp := range t.GetImports() {
+		imports[imp.ResourceType+"."+imp.ResourceName] = imp.ID
+	}
+
+	// Resources that use an aliased provider (e.g. for managed files) are created in the provider's region
+	providerRegions := make(map[string]string)
+	for _, provider := range t.TerraformWriter.Providers {
+		if region := provider.Arguments["region"]; region != "" {
+			providerRegions[provider.Name+".files"] = region
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "# Crossplane managed resources for Kubernetes cluster %s, generated by kOps\n", t.ClusterName)
+	unresolvedCount := 0
+	for _, resourceType := range sortedKeysForMap(resourcesByType) {
+		kind, found := crossplaneResourceKinds[resourceType]
+		if !found {
+			return fmt.Errorf("resource type %q is not supported by the crossplane target", resourceType)
+		}
+		for _, resourceName := range sortedKeysForMap(resourcesByType[resourceType]) {
+			r, err := c.convertResource(resourceType, resourcesByType[resourceType][resourceName])
+			if err != nil {
+				return fmt.Errorf("error converting %s.%s for crossplane: %w", resourceType, resourceName, err)
+			}
+
+			if t.Cloud.ProviderID() == kops.CloudProviderAWS && !isCrossplaneGlobalResource(resourceType) {
+				if _, found := r.forProvider["region"]; !found {
+					region := t.Cloud.Region()
+					if providerRegion, found := providerRegions[r.provider]; found {
+						region = providerRegion
+					}
+					r.forProvider["region"] = region
+				}
+			}
+
+			annotations := map[string]interface{}{}
+			if r.externalName != "" {
+				annotations[crossplaneExternalNameAnnotation] = r.externalName
+			}
+			if id := imports[resourceType+"."+resourceName]; id != "" {
+				// Existing resources are adopted by setting their external name
+				annotations[crossplaneExternalNameAnnotation] = id
+			}
+			if len(r.unresolved) != 0 {
+				annotations[crossplaneUnresolvedAnnotation] = strings.Join(r.unresolved, "\n")
+				unresolvedCount += len(r.unresolved)
+			}
+
+			metadata := map[string]interface{}{
+				"name": crossplaneResourceName(resourceName),
+			}
+			if t.ClusterName != "" {
+				metadata["labels"] = map[string]interface{}{crossplaneClusterLabel: t.ClusterName}
+			}
+			if len(annotations) != 0 {
+				metadata["annotations"] = annotations
+			}
+
+			spec := map[string]interface{}{
+				"forProvider": r.forProvider,
+			}
+			if len(r.initProvider) != 0 {
+				spec["initProvider"] = r.initProvider
+			}
+			if r.orphan {
+				spec["deletionPolicy"] = "Orphan"
+			}
+
+			b, err := yaml.Marshal(map[string]interface{}{
+				"apiVersion": kind.apiVersion,
+				"kind":       kind.kind,
+				"metadata":   metadata,
+				"spec":       spec,
+			})
+			if err != nil {
+				return fmt.Errorf("error writing crossplane resource %s.%s: %w", resourceType, resourceName, err)
+			}
+			buf.WriteString("---\n")
+			buf.Write(b)
+		}
+	}
+	if unresolvedCount != 0 {
+		klog.Warningf("%d references cannot be expressed as crossplane references; they are listed in the %s annotation and must be set once the referenced resources are ready", unresolvedCount, crossplaneUnresolvedAnnotation)
+	}
+
+	// Data files are inlined into the managed resources, so we only write the manifest
+	t.Files = map[string][]byte{
+		"crossplane.yaml": buf.Bytes(),
+	}
+	return nil
+}
+
+func isCrossplaneGlobalResource(resourceType string) bool {
+	for _, prefix := range crossplaneGlobalResourcePrefixes {
+		if strings.HasPrefix(resourceType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// crossplaneConverter converts terraform resources into Crossplane managed resources.
+type crossplaneConverter struct {
+	// resourceNames maps terraform resource addresses (type.name) to managed resource names
+	resourceNames map[string]string
+	// files holds the contents of the data files, which are inlined into the managed resources
+	files map[string][]byte
+}
+
+// crossplaneResource is the converted form of a terraform resource.
+type crossplaneResource struct {
+	forProvider  map[string]interface{}
+	initProvider map[string]interface{}
+	// provider is the aliased terraform provider of the resource, if any
+	provider string
+	// externalName is the name of the cloud resource, if the provider takes it from the external-name annotation
+	externalName string
+	// orphan is set if the cloud resource should be kept when the managed resource is deleted
+	orphan bool
+	// unresolved lists the references that could not be converted
+	unresolved []string
+}
+
+func (c *crossplaneConverter) convertResource(resourceType string, item interface{}) (*crossplaneResource, error) {
+	o, ok := toElement(item).(*object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", item)
+	}
+
+	r := &crossplaneResource{
+		forProvider:  map[string]interface{}{},
+		initProvider: map[string]interface{}{},
+	}
+
+	if provider, found := o.field["provider"]; found {
+		literal, ok := provider.(*terraformWriter.Literal)
+		if !ok {
+			return nil, fmt.Errorf("unexpected provider %v", provider)
+		}
+		r.provider = literal.String
+	}
+
+	// Attributes whose changes are ignored are only set when the resource is created
+	ignoreChanges := map[string]bool{}
+	if lifecycle, found := o.field["lifecycle"].(*object); found {
+		if literal, found := lifecycle.field["ignore_changes"].(*terraformWriter.Literal); found {
+			e, err := parseExpression(literal.String)
+			if err != nil {
+				return nil, err
+			}
+			list, ok := e.(*listExpression)
+			if !ok {
+				return nil, fmt.Errorf("unexpected ignore_changes %q", literal.String)
+			}
+			for _, item := range list.items {
+				if traversal, ok := item.(*traversalExpression); ok {
+					ignoreChanges[traversal.parts[0]] = true
+				}
+			}
+		}
+		if preventDestroy, found := lifecycle.field["prevent_destroy"].(*terraformWriter.Literal); found && preventDestroy.String == "true" {
+			r.orphan = true
+		}
+	}
+
+	nameField := crossplaneNameIdentifiers[resourceType]
+	for _, k := range sortedKeysForMap(o.field) {
+		v := o.field[k]
+		if k == "provider" || k == "lifecycle" {
+			continue
+		}
+		if k == nameField {
+			if literal, ok := v.(*terraformWriter.Literal); ok {
+				if e, err := parseExpression(literal.String); err == nil {
+					if s, ok := e.(*stringExpression); ok {
+						r.externalName = s.value
+						continue
+					}
+				}
+			}
+		}
+		dst := r.forProvider
+		if ignoreChanges[k] {
+			dst = r.initProvider
+		}
+		unresolved, err := c.convertField(dst, k, k, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		r.unresolved = append(r.unresolved, unresolved...)
+	}
+	return r, nil
+}
+
+// convertField converts an attribute or block into dst, returning any references that could not be converted.
+// path is the location of the attribute in the resource, used to report the unresolved references.
+func (c *crossplaneConverter) convertField(dst map[string]interface{}, path string, k string, v element) ([]string, error) {
+	switch v := v.(type) {
+	case *terraformWriter.Literal:
+		return c.convertLiteralField(dst, path, k, v.String)
+
+	case *object:
+		// The Crossplane providers represent all blocks as lists
+		m := map[string]interface{}{}
+		unresolved, err := c.convertBlock(m, path+"[0]", v)
+		if err != nil {
+			return nil, err
+		}
+		dst[crossplaneFieldName(k)] = []interface{}{m}
+		return unresolved, nil
+
+	case *sliceObject:
+		var values []interface{}
+		var unresolved []string
+		for i, member := range v.members {
+			member, ok := member.(*object)
+			if !ok {
+				return nil, fmt.Errorf("unexpected element %T", member)
+			}
+			m := map[string]interface{}{}
+			u, err := c.convertBlock(m, fmt.Sprintf("%s[%d]", path, i), member)
+			if err != nil {
+				return nil, err
+			}
+			unresolved = append(unresolved, u...)
+			values = append(values, m)
+		}
+		dst[crossplaneFieldName(k)] = values
+		return unresolved, nil
+
+	case *mapStringLiteral:
+		// Map keys (e.g. tags) are data, so they are not renamed
+		values := make(map[string]interface{}, len(v.members))
+		for mk, mv := range v.members {
+			e, err := parseExpression(mv.String)
+			if err != nil {
+				return nil, err
+			}
+			value, err := c.convertValue(e)
+			if err != nil {
+				return nil, err
+			}
+			values[mk] = value
+		}
+		dst[crossplaneFieldName(k)] = values
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unhandled element %T", v)
+	}
+}
+
+func (c *crossplaneConverter) convertBlock(dst map[string]interface{}, path string, o *object) ([]string, error) {
+	var unresolved []string
+	for _, k := range sortedKeysForMap(o.field) {
+		u, err := c.convertField(dst, path+"."+k, k, o.field[k])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		unresolved = append(unresolved, u...)
+	}
+	return unresolved, nil
+}
+
+func (c *crossplaneConverter) convertLiteralField(dst map[string]interface{}, path string, k string, s string) ([]string, error) {
+	e, err := parseExpression(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch e := e.(type) {
+	case *traversalExpression:
+		if name := c.reference(e); name != "" {
+			dst[crossplaneFieldName(k)+"Ref"] = map[string]interface{}{"name": name}
+			return nil, nil
+		}
+		if len(e.parts) == 3 && e.parts[0] == "aws_launch_template" && e.parts[2] == "latest_version" {
+			// Crossplane cannot reference the version, but the autoscaling group can track the latest version
+			dst[crossplaneFieldName(k)] = "$Latest"
+			return nil, nil
+		}
+		return []string{path + "=" + strings.Join(e.parts, ".")}, nil
+
+	case *listExpression:
+		var refs []interface{}
+		for _, item := range e.items {
+			if traversal, ok := item.(*traversalExpression); ok {
+				name := c.reference(traversal)
+				if name == "" {
+					return []string{path + "=" + s}, nil
+				}
+				refs = append(refs, map[string]interface{}{"name": name})
+			}
+		}
+		if len(refs) != 0 {
+			dst[crossplaneReferencesFieldName(k)] = refs
+			if len(refs) != len(e.items) {
+				// References are ignored once values are set, so the values must be added after the references are resolved
+				return []string{path + "=" + s}, nil
+			}
+			return nil, nil
+		}
+	}
+
+	value, err := c.convertValue(e)
+	if err != nil {
+		return nil, err
+	}
+	dst[crossplaneFieldName(k)] = value
+	return nil, nil
+}
+
+// reference returns the name of the managed resource that a traversal refers to,
+// or "" if it cannot be expressed as a managed resource reference.
+func (c *crossplaneConverter) reference(e *traversalExpression) string {
+	if len(e.parts) != 3 || !crossplaneReferenceAttributes[e.parts[2]] {
+		return ""
+	}
+	return c.resourceNames[e.parts[0]+"."+e.parts[1]]
+}
+
+// convertValue converts an expression that does not reference other resources.
+func (c *crossplaneConverter) convertValue(e expression) (interface{}, error) {
+	switch e := e.(type) {
+	case *stringExpression:
+		return e.value, nil
+	case *numberExpression:
+		if i, err := strconv.ParseInt(e.value, 10, 64); err == nil {
+			return i, nil
+		}
+		f, err := strconv.ParseFloat(e.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", e.value)
+		}
+		return f, nil
+	case *boolExpression:
+		return e.value, nil
+	case *nullExpression:
+		return nil, nil
+	case *listExpression:
+		items := make([]interface{}, 0, len(e.items))
+		for _, item := range e.items {
+			v, err := c.convertValue(item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		return items, nil
+	case *callExpression:
+		return c.convertCall(e)
+	case *traversalExpression:
+		return nil, fmt.Errorf("unsupported reference %q", strings.Join(e.parts, "."))
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", e)
+	}
+}
+
+func (c *crossplaneConverter) convertCall(e *callExpression) (interface{}, error) {
+	switch e.function {
+	case "file", "filebase64":
+		if len(e.args) != 1 {
+			return nil, fmt.Errorf("unexpected arguments to %s", e.function)
+		}
+		p, ok := e.args[0].(*stringExpression)
+		if !ok || !strings.HasPrefix(p.value, "${path.module}/") {
+			return nil, fmt.Errorf("unsupported argument to %s", e.function)
+		}
+		filePath := strings.TrimPrefix(p.value, "${path.module}/")
+		data, found := c.files[filePath]
+		if !found {
+			return nil, fmt.Errorf("file %q not found", filePath)
+		}
+		if e.function == "filebase64" {
+			return base64.StdEncoding.EncodeToString(data), nil
+		}
+		return string(data), nil
+
+	case "format":
+		if len(e.args) == 0 {
+			return nil, fmt.Errorf("unexpected arguments to format")
+		}
+		format, ok := e.args[0].(*stringExpression)
+		if !ok {
+			return nil, fmt.Errorf("unsupported format string")
+		}
+		var args []interface{}
+		for _, arg := range e.args[1:] {
+			v, err := c.convertValue(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, v)
+		}
+		return fmt.Sprintf(format.value, args...), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported function %q", e.function)
+	}
+}
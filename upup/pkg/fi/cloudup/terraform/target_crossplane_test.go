@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+	"sigs.k8s.io/yaml"
+)
+
+type testCrossplaneResource struct {
+	Name           *string                    `cty:"name"`
+	VPCID          *terraformWriter.Literal   `cty:"vpc_id"`
+	SecurityGroups []*terraformWriter.Literal `cty:"security_groups"`
+	UserData       *terraformWriter.Literal   `cty:"user_data"`
+	Version        *terraformWriter.Literal   `cty:"version"`
+	DNSName        *terraformWriter.Literal   `cty:"dns_name"`
+	Mappings       []*testPulumiBlock         `cty:"block_device_mappings"`
+	Single         *testPulumiBlock           `cty:"metadata_options"`
+	Tags           map[string]string          `cty:"tags"`
+	Provider       *terraformWriter.Literal   `cty:"provider"`
+	Lifecycle      *Lifecycle                 `cty:"lifecycle"`
+}
+
+func TestCrossplaneConvertResource(t *testing.T) {
+	c := &crossplaneConverter{
+		resourceNames: map[string]string{
+			"aws_vpc.example-com":                   "example-com",
+			"aws_security_group.nodes-example-com":  "nodes-example-com",
+			"aws_launch_template.nodes-example-com": "nodes-example-com",
+			"aws_lb.api-example-com":                "api-example-com",
+		},
+		files: map[string][]byte{
+			"data/user_data": []byte("#!/bin/bash"),
+		},
+	}
+
+	r, err := c.convertResource("aws_autoscaling_group", &testCrossplaneResource{
+		Name:  fi.PtrTo("nodes.example.com"),
+		VPCID: terraformWriter.LiteralProperty("aws_vpc", "example.com", "id"),
+		SecurityGroups: []*terraformWriter.Literal{
+			terraformWriter.LiteralProperty("aws_security_group", "nodes.example.com", "id"),
+			terraformWriter.LiteralFromStringValue("sg-12345678"),
+		},
+		UserData: terraformWriter.LiteralFunctionExpression("filebase64", terraformWriter.LiteralTokens(`"${path.module}/data/user_data"`)),
+		Version:  terraformWriter.LiteralProperty("aws_launch_template", "nodes.example.com", "latest_version"),
+		DNSName:  terraformWriter.LiteralProperty("aws_lb", "api.example.com", "dns_name"),
+		Mappings: []*testPulumiBlock{{DeviceName: fi.PtrTo("/dev/xvda")}},
+		Single:   &testPulumiBlock{DeviceName: fi.PtrTo("/dev/xvdb")},
+		Tags:     map[string]string{"kubernetes.io/cluster/example.com": "owned"},
+		Provider: terraformWriter.LiteralTokens("aws", "files"),
+		Lifecycle: &Lifecycle{
+			PreventDestroy: fi.PtrTo(true),
+			IgnoreChanges:  []*terraformWriter.Literal{terraformWriter.LiteralTokens("user_data")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if r.externalName != "nodes.example.com" {
+		t.Errorf("expected name to be used as the external name, got %q", r.externalName)
+	}
+	if r.provider != "aws.files" {
+		t.Errorf("expected provider aws.files, got %q", r.provider)
+	}
+	if !r.orphan {
+		t.Errorf("expected prevent_destroy to orphan the resource")
+	}
+	expectedUnresolved := []string{
+		"dns_name=aws_lb.api-example-com.dns_name",
+		`security_groups=[aws_security_group.nodes-example-com.id, "sg-12345678"]`,
+	}
+	if !reflect.DeepEqual(r.unresolved, expectedUnresolved) {
+		t.Errorf("expected unresolved references %q, got %q", expectedUnresolved, r.unresolved)
+	}
+
+	actual, err := yaml.Marshal(map[string]interface{}{
+		"forProvider":  r.forProvider,
+		"initProvider": r.initProvider,
+	})
+	if err != nil {
+		t.Fatalf("error marshaling: %v", err)
+	}
+	expected := `forProvider:
+  blockDeviceMappings:
+  - deviceName: /dev/xvda
+  metadataOptions:
+  - deviceName: /dev/xvdb
+  securityGroupRefs:
+  - name: nodes-example-com
+  tags:
+    kubernetes.io/cluster/example.com: owned
+  version: $Latest
+  vpcIdRef:
+    name: example-com
+initProvider:
+  userData: IyEvYmluL2Jhc2g=
+`
+	if string(actual) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestCrossplaneConvertResourceUnsupported(t *testing.T) {
+	c := &crossplaneConverter{
+		resourceNames: map[string]string{},
+		files:         map[string][]byte{},
+	}
+	_, err := c.convertResource("aws_subnet", &testCrossplaneResource{
+		UserData: terraformWriter.LiteralFunctionExpression("cidrsubnet", terraformWriter.LiteralFromStringValue("10.0.0.0/16"), terraformWriter.LiteralFromIntValue(8), terraformWriter.LiteralFromIntValue(1)),
+	})
+	if err == nil {
+		t.Errorf("expected error converting unsupported function")
+	}
+}
+
+func TestCrossplaneNames(t *testing.T) {
+	for name, expected := range map[string]string{
+		"nodes-example-com": "nodes-example-com",
+		"prefix_1-Example":  "prefix-1-example",
+	} {
+		if actual := crossplaneResourceName(name); actual != expected {
+			t.Errorf("crossplaneResourceName(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+	for name, expected := range map[string]string{
+		"subnet_ids":          "subnetIdRefs",
+		"security_groups":     "securityGroupRefs",
+		"vpc_zone_identifier": "vpcZoneIdentifierRefs",
+		"target_group_arns":   "targetGroupArnRefs",
+		"policies":            "policyRefs",
+	} {
+		if actual := crossplaneReferencesFieldName(name); actual != expected {
+			t.Errorf("crossplaneReferencesFieldName(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+}
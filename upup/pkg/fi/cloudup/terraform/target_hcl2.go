@@ -19,6 +19,7 @@ package terraform
 import (
 	"bytes"
 	"fmt"
+	"path"
 	"sort"
 
 	"k8s.io/klog/v2"
@@ -44,7 +45,9 @@ func (t *TerraformTarget) finishHCL2() error {
 		return err
 	}
 
-	t.writeResources(buf, resourcesByType)
+	resourceModules := t.GetResourceModules()
+	moduleBuffers := make(map[string]*bytes.Buffer)
+	t.writeResources(buf, moduleBuffers, resourcesByType, resourceModules)
 
 	dataSourcesByType, err := t.GetDataSourcesByType()
 	if err != nil {
@@ -53,13 +56,65 @@ func (t *TerraformTarget) finishHCL2() error {
 
 	t.writeDataSources(buf, dataSourcesByType)
 
-	t.writeTerraform(buf)
+	imports := t.GetImports()
+	writeImports(buf, imports, resourceModules)
 
-	t.Files["kubernetes.tf"] = buf.Bytes()
+	if len(moduleBuffers) == 0 {
+		t.writeTerraform(buf, len(imports) != 0, false)
+		t.Files["kubernetes.tf"] = buf.Bytes()
+		return nil
+	}
+
+	// Move the instance group resources into child modules, rewriting the references between modules
+	knownResources := make(map[string]bool)
+	for resourceType, resources := range resourcesByType {
+		for resourceName := range resources {
+			knownResources[resourceType+"."+resourceName] = true
+		}
+	}
+	splitter := newModuleSplitter(resourceModules, knownResources)
+	for _, module := range sortedKeysForMap(moduleBuffers) {
+		splitter.addModuleBody(module, moduleBuffers[module].String())
+	}
+
+	root := &bytes.Buffer{}
+	root.WriteString(splitter.rewrite("", buf.String()))
+	splitter.writeModuleCalls(root)
+	t.writeTerraform(root, len(imports) != 0, false)
+	t.Files["kubernetes.tf"] = root.Bytes()
+
+	moduleTerraform := &bytes.Buffer{}
+	t.writeTerraform(moduleTerraform, false, true)
+	for _, module := range sortedKeysForMap(splitter.modules) {
+		m := splitter.modules[module]
+		m.moveModuleFiles(t.Files)
+		t.Files[path.Join(modulePath(module), "main.tf")] = m.moduleContents(moduleTerraform.String())
+	}
 
 	return nil
 }
 
+// writeImports writes an import block for each existing resource that should be adopted by terraform.
+// Example:
+//
+//	import {
+//	  to = aws_vpc.example-com
+//	  id = "vpc-12345678"
+//	}
+func writeImports(buf *bytes.Buffer, imports []*terraformWriter.Import, resourceModules map[string]string) {
+	for _, imp := range imports {
+		address := imp.ResourceType + "." + imp.ResourceName
+		to := address
+		if module := resourceModules[address]; module != "" {
+			to = "module." + module + "." + address
+		}
+		buf.WriteString("import {\n")
+		buf.WriteString("  to = " + to + "\n")
+		buf.WriteString("  id = " + quote(imp.ID) + "\n")
+		buf.WriteString("}\n\n")
+	}
+}
+
 type output struct {
 	Value *terraformWriter.Literal
 }
@@ -165,7 +220,7 @@ func sortedKeysForMap[K ~string, V any](m map[K]V) []K {
 	return keys
 }
 
-func (t *TerraformTarget) writeResources(buf *bytes.Buffer, resourcesByType map[string]map[string]interface{}) {
+func (t *TerraformTarget) writeResources(buf *bytes.Buffer, moduleBuffers map[string]*bytes.Buffer, resourcesByType map[string]map[string]interface{}, resourceModules map[string]string) {
 	resourceTypes := make([]string, 0, len(resourcesByType))
 	for resourceType := range resourcesByType {
 		resourceTypes = append(resourceTypes, resourceType)
@@ -179,9 +234,17 @@ func (t *TerraformTarget) writeResources(buf *bytes.Buffer, resourcesByType map[
 		}
 		sort.Strings(resourceNames)
 		for _, resourceName := range resourceNames {
+			out := buf
+			if module := resourceModules[resourceType+"."+resourceName]; module != "" {
+				out = moduleBuffers[module]
+				if out == nil {
+					out = &bytes.Buffer{}
+					moduleBuffers[module] = out
+				}
+			}
 			toElement(resources[resourceName]).
-				Write(buf, 0, fmt.Sprintf("resource %q %q", resourceType, resourceName))
-			buf.WriteString("\n")
+				Write(out, 0, fmt.Sprintf("resource %q %q", resourceType, resourceName))
+			out.WriteString("\n")
 		}
 	}
 }
@@ -207,9 +270,15 @@ func (t *TerraformTarget) writeDataSources(buf *bytes.Buffer, dataSourcesByType
 	}
 }
 
-func (t *TerraformTarget) writeTerraform(buf *bytes.Buffer) {
+// writeTerraform writes the terraform block, declaring the required providers.
+// Import blocks require terraform 1.5; child modules (forModule) do not declare provider aliases.
+func (t *TerraformTarget) writeTerraform(buf *bytes.Buffer, hasImports bool, forModule bool) {
+	requiredVersion := ">= 0.15.0"
+	if hasImports {
+		requiredVersion = ">= 1.5.0"
+	}
 	buf.WriteString("terraform {\n")
-	buf.WriteString("  required_version = \">= 0.15.0\"\n")
+	buf.WriteString("  required_version = \"" + requiredVersion + "\"\n")
 	buf.WriteString("  required_providers {\n")
 
 	providers := make(map[string]bool)
@@ -229,9 +298,11 @@ func (t *TerraformTarget) writeTerraform(buf *bytes.Buffer) {
 		providers["digitalocean"] = true
 	}
 
-	for _, tfProvider := range t.TerraformWriter.Providers {
-		providers[tfProvider.Name] = true
-		providerAliases[tfProvider.Name] = append(providerAliases[tfProvider.Name], "files")
+	if !forModule {
+		for _, tfProvider := range t.TerraformWriter.Providers {
+			providers[tfProvider.Name] = true
+			providerAliases[tfProvider.Name] = append(providerAliases[tfProvider.Name], "files")
+		}
 	}
 
 	providerKeys := sortedKeysForMap(providers)
@@ -274,6 +345,9 @@ func (t *TerraformTarget) writeTerraform(buf *bytes.Buffer) {
 		for k, v := range providerVersion {
 			tf[k] = terraformWriter.LiteralFromStringValue(v)
 		}
+		if version, found := tfGetProviderVersions(t.clusterSpecTarget)[provider]; found {
+			tf["version"] = terraformWriter.LiteralFromStringValue(version)
+		}
 
 		if aliases := providerAliases[provider]; len(aliases) != 0 {
 			var configurationAliases []*terraformWriter.Literal
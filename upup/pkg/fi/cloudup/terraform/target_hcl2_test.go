@@ -85,3 +85,101 @@ output "key1" {
 		})
 	}
 }
+
+func TestWriteImports(t *testing.T) {
+	imports := []*terraformWriter.Import{
+		{ResourceType: "aws_autoscaling_group", ResourceName: "nodes-example-com", ID: "nodes.example.com"},
+		{ResourceType: "aws_vpc", ResourceName: "example-com", ID: "vpc-12345678"},
+	}
+	resourceModules := map[string]string{
+		"aws_autoscaling_group.nodes-example-com": "nodes-example-com",
+	}
+
+	buf := &bytes.Buffer{}
+	writeImports(buf, imports, resourceModules)
+	actual := strings.TrimSpace(buf.String())
+	expected := strings.TrimSpace(`
+import {
+  to = module.nodes-example-com.aws_autoscaling_group.nodes-example-com
+  id = "nodes.example.com"
+}
+
+import {
+  to = aws_vpc.example-com
+  id = "vpc-12345678"
+}`)
+	if actual != expected {
+		diffString := diff.FormatDiff(expected, actual)
+		t.Logf("diff:\n%s\n", diffString)
+		t.Errorf("expected: '%s', got: '%s'\n", expected, actual)
+	}
+}
+
+func TestModuleSplitter(t *testing.T) {
+	resourceModules := map[string]string{
+		"aws_launch_template.nodes-example-com":   "nodes-example-com",
+		"aws_autoscaling_group.nodes-example-com": "nodes-example-com",
+	}
+	knownResources := map[string]bool{
+		"aws_launch_template.nodes-example-com":   true,
+		"aws_autoscaling_group.nodes-example-com": true,
+		"aws_subnet.us-test-1a-example-com":       true,
+	}
+	splitter := newModuleSplitter(resourceModules, knownResources)
+
+	splitter.addModuleBody("nodes-example-com", `resource "aws_autoscaling_group" "nodes-example-com" {
+  launch_template {
+    id = aws_launch_template.nodes-example-com.id
+  }
+  vpc_zone_identifier = [aws_subnet.us-test-1a-example-com.id]
+  user_data = filebase64("${path.module}/data/aws_launch_template_nodes.example.com_user_data")
+  image_id = data.aws_ami.ubuntu.id
+}
+`)
+	root := splitter.rewrite("", `output "node_autoscaling_group_ids" {
+  value = [aws_autoscaling_group.nodes-example-com.id]
+}
+`)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(root)
+	splitter.writeModuleCalls(buf)
+	actual := strings.TrimSpace(buf.String())
+	expected := strings.TrimSpace(`
+output "node_autoscaling_group_ids" {
+  value = [module.nodes-example-com.aws_autoscaling_group_nodes_example_com_id]
+}
+module "nodes-example-com" {
+  source = "./modules/nodes-example-com"
+  aws_subnet_us_test_1a_example_com_id = aws_subnet.us-test-1a-example-com.id
+  data_aws_ami_ubuntu_id               = data.aws_ami.ubuntu.id
+}`)
+	if actual != expected {
+		diffString := diff.FormatDiff(expected, actual)
+		t.Logf("diff:\n%s\n", diffString)
+		t.Errorf("expected: '%s', got: '%s'\n", expected, actual)
+	}
+
+	files := map[string][]byte{
+		"data/aws_launch_template_nodes.example.com_user_data": []byte("#!/bin/bash"),
+	}
+	m := splitter.modules["nodes-example-com"]
+	m.moveModuleFiles(files)
+	if _, found := files["modules/nodes-example-com/data/aws_launch_template_nodes.example.com_user_data"]; !found || len(files) != 1 {
+		t.Errorf("expected data file to be moved into the module, got %v", files)
+	}
+
+	contents := string(m.moduleContents(""))
+	for _, s := range []string{
+		`variable "aws_subnet_us_test_1a_example_com_id" {`,
+		`variable "data_aws_ami_ubuntu_id" {`,
+		`id = aws_launch_template.nodes-example-com.id`,
+		`vpc_zone_identifier = [var.aws_subnet_us_test_1a_example_com_id]`,
+		`image_id = var.data_aws_ami_ubuntu_id`,
+		"output \"aws_autoscaling_group_nodes_example_com_id\" {\n  value = aws_autoscaling_group.nodes-example-com.id\n}",
+	} {
+		if !strings.Contains(contents, s) {
+			t.Errorf("expected module contents to contain %q, got:\n%s", s, contents)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// referenceRegexp matches references to resource or data source attributes, e.g. aws_vpc.example-com.id
+var referenceRegexp = regexp.MustCompile(`\b(data\.)?([a-z][a-z0-9_]*)\.([A-Za-z_][A-Za-z0-9_-]*)\.([a-z][a-z0-9_]*)\b`)
+
+// moduleFileRegexp matches references to files written to the data directory.
+var moduleFileRegexp = regexp.MustCompile(`\$\{path\.module\}/data/([^"]+)`)
+
+// terraformModule holds the rendered contents of a child module.
+type terraformModule struct {
+	name string
+	// body is the rendered resources in the module
+	body string
+	// variables maps the module's input variables to the expressions (in the root module) that populate them
+	variables map[string]string
+	// outputs maps the module's outputs to the expressions (in the module) that populate them
+	outputs map[string]string
+}
+
+// moduleSplitter rewrites references between the root module and child modules,
+// so that resources can be moved into child modules without changing their meaning.
+type moduleSplitter struct {
+	// resourceModules maps resource addresses (type.name) to their module; resources not in the map are in the root module
+	resourceModules map[string]string
+	// knownResources is the set of all resource addresses (type.name), including those in the root module
+	knownResources map[string]bool
+
+	modules map[string]*terraformModule
+}
+
+func newModuleSplitter(resourceModules map[string]string, knownResources map[string]bool) *moduleSplitter {
+	return &moduleSplitter{
+		resourceModules: resourceModules,
+		knownResources:  knownResources,
+		modules:         make(map[string]*terraformModule),
+	}
+}
+
+func (s *moduleSplitter) module(name string) *terraformModule {
+	m := s.modules[name]
+	if m == nil {
+		m = &terraformModule{
+			name:      name,
+			variables: make(map[string]string),
+			outputs:   make(map[string]string),
+		}
+		s.modules[name] = m
+	}
+	return m
+}
+
+// moduleValueName builds a variable or output name for a reference.
+func moduleValueName(reference string) string {
+	return strings.NewReplacer(".", "_", "-", "_").Replace(reference)
+}
+
+// rootExpression returns the expression that evaluates a reference from the root module.
+func (s *moduleSplitter) rootExpression(address, attribute string) string {
+	reference := address + "." + attribute
+	module := s.resourceModules[address]
+	if module == "" {
+		return reference
+	}
+	outputName := moduleValueName(reference)
+	s.module(module).outputs[outputName] = reference
+	return "module." + module + "." + outputName
+}
+
+// rewrite rewrites the references in text, which is in the named module (or the root module if module is empty).
+func (s *moduleSplitter) rewrite(module string, text string) string {
+	return referenceRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		parts := referenceRegexp.FindStringSubmatch(match)
+		isData := parts[1] != ""
+		address := parts[2] + "." + parts[3]
+		attribute := parts[4]
+
+		if isData {
+			// Data sources are always in the root module
+			if module == "" {
+				return match
+			}
+			variable := moduleValueName(match)
+			s.module(module).variables[variable] = match
+			return "var." + variable
+		}
+
+		if !s.knownResources[address] {
+			// Not a reference, e.g. part of a DNS name
+			return match
+		}
+
+		targetModule := s.resourceModules[address]
+		if targetModule == module {
+			return match
+		}
+		if module == "" {
+			return s.rootExpression(address, attribute)
+		}
+		variable := moduleValueName(match)
+		s.module(module).variables[variable] = s.rootExpression(address, attribute)
+		return "var." + variable
+	})
+}
+
+// addModuleBody records the rendered resources for a module, rewriting references out of the module.
+func (s *moduleSplitter) addModuleBody(module string, body string) {
+	s.module(module).body = s.rewrite(module, body)
+}
+
+// writeModuleCalls writes the module blocks that instantiate each child module.
+func (s *moduleSplitter) writeModuleCalls(buf *bytes.Buffer) {
+	for _, name := range sortedKeysForMap(s.modules) {
+		m := s.modules[name]
+		fmt.Fprintf(buf, "module %q {\n", name)
+		fmt.Fprintf(buf, "  source = %q\n", "./"+modulePath(name))
+		variables := sortedKeysForMap(m.variables)
+		maxLen := 0
+		for _, k := range variables {
+			if len(k) > maxLen {
+				maxLen = len(k)
+			}
+		}
+		for _, k := range variables {
+			fmt.Fprintf(buf, "  %s%s = %s\n", k, strings.Repeat(" ", maxLen-len(k)), m.variables[k])
+		}
+		buf.WriteString("}\n\n")
+	}
+}
+
+// moduleContents renders the contents of a child module's main.tf; terraformBlock is appended verbatim.
+func (m *terraformModule) moduleContents(terraformBlock string) []byte {
+	buf := &bytes.Buffer{}
+	for _, k := range sortedKeysForMap(m.variables) {
+		fmt.Fprintf(buf, "variable %q {\n}\n\n", k)
+	}
+	buf.WriteString(m.body)
+	for _, k := range sortedKeysForMap(m.outputs) {
+		fmt.Fprintf(buf, "output %q {\n  value = %s\n}\n\n", k, m.outputs[k])
+	}
+	buf.WriteString(terraformBlock)
+	return buf.Bytes()
+}
+
+// moveModuleFiles moves any data files referenced by the module into the module's directory,
+// so that ${path.module} continues to resolve them.
+func (m *terraformModule) moveModuleFiles(files map[string][]byte) {
+	for _, match := range moduleFileRegexp.FindAllStringSubmatch(m.body, -1) {
+		src := path.Join("data", match[1])
+		data, found := files[src]
+		if !found {
+			continue
+		}
+		delete(files, src)
+		files[path.Join(modulePath(m.name), "data", match[1])] = data
+	}
+}
+
+func modulePath(name string) string {
+	return path.Join("modules", name)
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kops/pkg/apis/kops"
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+	"sigs.k8s.io/yaml"
+)
+
+// NewPulumiTarget builds a target that writes a Pulumi YAML program.
+// Tasks render themselves exactly as they do for terraform; the resources are then
+// converted for the Pulumi providers that are bridged from the terraform providers.
+func NewPulumiTarget(cloud fi.Cloud, project string, outDir string, clusterSpecTarget *kops.TargetSpec) *TerraformTarget {
+	target := NewTerraformTarget(cloud, project, outDir, clusterSpecTarget)
+	target.format = outputFormatPulumi
+	return target
+}
+
+// pulumiResourceName returns the Pulumi logical name for a terraform resource.
+// Pulumi logical names must be unique across all types, so we include the type.
+func pulumiResourceName(resourceType, resourceName string) string {
+	return resourceType + "-" + resourceName
+}
+
+func (t *TerraformTarget) pulumiProviderName() string {
+	switch t.Cloud.ProviderID() {
+	case kops.CloudProviderGCE:
+		return "google"
+	default:
+		return string(t.Cloud.ProviderID())
+	}
+}
+
+func (t *TerraformTarget) finishPulumi() error {
+	providerName := t.pulumiProviderName()
+	pkg := pulumiPackages[providerName]
+	if pkg == "" {
+		return fmt.Errorf("cloud provider %v does not support the pulumi target", t.Cloud.ProviderID())
+	}
+
+	resourcesByType, err := t.GetResourcesByType()
+	if err != nil {
+		return err
+	}
+	dataSourcesByType, err := t.GetDataSourcesByType()
+	if err != nil {
+		return err
+	}
+
+	c := &pulumiConverter{
+		resourceNames:   make(map[string]string),
+		dataSourceNames: make(map[string]string),
+		base64Files:     make(map[string]bool),
+	}
+	for resourceType, resources := range resourcesByType {
+		for resourceName := range resources {
+			c.resourceNames[resourceType+"."+resourceName] = pulumiResourceName(resourceType, resourceName)
+		}
+	}
+	for dataType, dataSources := range dataSourcesByType {
+		for dataName := range dataSources {
+			c.dataSourceNames["data."+dataType+"."+dataName] = pulumiResourceName("data-"+dataType, dataName)
+		}
+	}
+
+	imports := make(map[string]string)
+	for _, imp := range t.GetImports() {
+		imports[imp.ResourceType+"."+imp.ResourceName] = imp.ID
+	}
+
+	// The default provider is configured through the project configuration
+	config := map[string]interface{}{}
+	if t.Cloud.ProviderID() == kops.CloudProviderGCE {
+		config[pkg+":project"] = map[string]interface{}{"value": t.Project}
+	}
+	config[pkg+":region"] = map[string]interface{}{"value": t.Cloud.Region()}
+	for k, v := range tfGetProviderExtraConfig(t.clusterSpecTarget) {
+		config[pkg+":"+pulumiPropertyName(k)] = map[string]interface{}{"value": v}
+	}
+
+	resources := map[string]interface{}{}
+
+	// Managed files are written using an explicit provider, matching the "files" alias in terraform
+	for _, key := range sortedKeysForMap(t.TerraformWriter.Providers) {
+		provider := t.TerraformWriter.Providers[key]
+		properties := map[string]interface{}{}
+		for k, v := range provider.Arguments {
+			properties[pulumiPropertyName(k)] = v
+		}
+		for k, v := range tfGetFilesProviderExtraConfig(t.clusterSpecTarget) {
+			properties[pulumiPropertyName(k)] = v
+		}
+		resources[provider.Name+"-files"] = map[string]interface{}{
+			"type":       "pulumi:providers:" + pulumiPackages[provider.Name],
+			"properties": properties,
+		}
+		c.resourceNames[provider.Name+".files"] = provider.Name + "-files"
+	}
+
+	for _, resourceType := range sortedKeysForMap(resourcesByType) {
+		typeToken := pulumiResourceTypes[resourceType]
+		if typeToken == "" {
+			return fmt.Errorf("resource type %q is not supported by the pulumi target", resourceType)
+		}
+		for _, resourceName := range sortedKeysForMap(resourcesByType[resourceType]) {
+			resource, err := c.convertResource(resourceType, resourcesByType[resourceType][resourceName])
+			if err != nil {
+				return fmt.Errorf("error converting %s.%s for pulumi: %w", resourceType, resourceName, err)
+			}
+			resource["type"] = typeToken
+			if id := imports[resourceType+"."+resourceName]; id != "" {
+				options, _ := resource["options"].(map[string]interface{})
+				if options == nil {
+					options = map[string]interface{}{}
+					resource["options"] = options
+				}
+				options["import"] = id
+			}
+			resources[pulumiResourceName(resourceType, resourceName)] = resource
+		}
+	}
+
+	variables := map[string]interface{}{}
+	for _, dataType := range sortedKeysForMap(dataSourcesByType) {
+		function := pulumiDataSourceFunctions[dataType]
+		if function == "" {
+			return fmt.Errorf("data source %q is not supported by the pulumi target", dataType)
+		}
+		for _, dataName := range sortedKeysForMap(dataSourcesByType[dataType]) {
+			arguments, err := c.convertObject(dataType, toElement(dataSourcesByType[dataType][dataName]))
+			if err != nil {
+				return fmt.Errorf("error converting data.%s.%s for pulumi: %w", dataType, dataName, err)
+			}
+			variables[pulumiResourceName("data-"+dataType, dataName)] = map[string]interface{}{
+				"fn::invoke": map[string]interface{}{
+					"function":  function,
+					"arguments": arguments,
+				},
+			}
+		}
+	}
+
+	outputValues, err := t.GetOutputs()
+	if err != nil {
+		return err
+	}
+	outputs := map[string]interface{}{}
+	for _, name := range sortedKeysForMap(outputValues) {
+		v := outputValues[name]
+		var value interface{}
+		if v.Value != nil {
+			value, err = c.convertLiteral(v.Value.String)
+		} else {
+			var values []interface{}
+			for _, literal := range v.ValueArray {
+				var item interface{}
+				item, err = c.convertLiteral(literal.String)
+				if err != nil {
+					break
+				}
+				values = append(values, item)
+			}
+			value = values
+		}
+		if err != nil {
+			// Outputs are informational, so we don't fail if one cannot be expressed in Pulumi YAML
+			klog.Warningf("skipping output %q, which cannot be converted for pulumi: %v", name, err)
+			continue
+		}
+		outputs[name] = value
+	}
+
+	for p := range c.base64Files {
+		if data, found := t.Files[p]; found {
+			t.Files[p] = []byte(base64.StdEncoding.EncodeToString(data))
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	projectName := strings.ReplaceAll(t.ClusterName, ".", "-")
+	if projectName == "" {
+		projectName = "kops"
+	}
+	fmt.Fprintf(buf, "name: %s\n", projectName)
+	buf.WriteString("runtime: yaml\n")
+	fmt.Fprintf(buf, "description: Kubernetes cluster %s, generated by kOps\n", t.ClusterName)
+	for _, section := range []struct {
+		key   string
+		value map[string]interface{}
+	}{
+		{"config", config},
+		{"variables", variables},
+		{"resources", resources},
+		{"outputs", outputs},
+	} {
+		if len(section.value) == 0 {
+			continue
+		}
+		b, err := yaml.Marshal(map[string]interface{}{section.key: section.value})
+		if err != nil {
+			return fmt.Errorf("error writing pulumi %s: %w", section.key, err)
+		}
+		buf.WriteString("\n")
+		buf.Write(b)
+	}
+
+	t.Files["Pulumi.yaml"] = buf.Bytes()
+	return nil
+}
+
+// convertResource converts a resource into a Pulumi YAML resource, with its properties and options.
+func (c *pulumiConverter) convertResource(resourceType string, item interface{}) (map[string]interface{}, error) {
+	o, ok := toElement(item).(*object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type %T", item)
+	}
+
+	options := map[string]interface{}{}
+	if provider, found := o.field["provider"]; found {
+		literal, ok := provider.(*terraformWriter.Literal)
+		if !ok {
+			return nil, fmt.Errorf("unexpected provider %v", provider)
+		}
+		name := c.resourceNames[literal.String]
+		if name == "" {
+			return nil, fmt.Errorf("unknown provider %q", literal.String)
+		}
+		options["provider"] = "${" + name + "}"
+	}
+	if lifecycle, found := o.field["lifecycle"].(*object); found {
+		if ignoreChanges, found := lifecycle.field["ignore_changes"].(*terraformWriter.Literal); found {
+			e, err := parseExpression(ignoreChanges.String)
+			if err != nil {
+				return nil, err
+			}
+			list, ok := e.(*listExpression)
+			if !ok {
+				return nil, fmt.Errorf("unexpected ignore_changes %q", ignoreChanges.String)
+			}
+			var names []interface{}
+			for _, item := range list.items {
+				if traversal, ok := item.(*traversalExpression); ok {
+					names = append(names, pulumiPropertyName(strings.Join(traversal.parts, ".")))
+				}
+			}
+			options["ignoreChanges"] = names
+		}
+		if preventDestroy, found := lifecycle.field["prevent_destroy"].(*terraformWriter.Literal); found && preventDestroy.String == "true" {
+			options["protect"] = true
+		}
+		// Pulumi creates replacements before deleting by default, so create_before_destroy needs no translation
+	}
+
+	properties := &object{field: make(map[string]element)}
+	for k, v := range o.field {
+		if k == "provider" || k == "lifecycle" {
+			continue
+		}
+		properties.field[k] = v
+	}
+	converted, err := c.convertObject(resourceType, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := map[string]interface{}{}
+	if len(converted) != 0 {
+		resource["properties"] = converted
+	}
+	if len(options) != 0 {
+		resource["options"] = options
+	}
+	return resource, nil
+}
+
+// convertObject converts a block into a map of Pulumi properties.
+func (c *pulumiConverter) convertObject(resourceType string, e element) (map[string]interface{}, error) {
+	o, ok := e.(*object)
+	if !ok {
+		return nil, fmt.Errorf("unexpected element %T", e)
+	}
+	properties := make(map[string]interface{}, len(o.field))
+	for k, v := range o.field {
+		switch v := v.(type) {
+		case *terraformWriter.Literal:
+			value, err := c.convertLiteral(v.String)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if _, isList := value.([]interface{}); isList {
+				properties[pulumiListPropertyName(k)] = value
+			} else {
+				properties[pulumiPropertyName(k)] = value
+			}
+		case *object:
+			value, err := c.convertObject(resourceType, v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			if pulumiListBlocks[resourceType+"."+k] {
+				properties[pulumiListPropertyName(k)] = []interface{}{value}
+			} else {
+				properties[pulumiPropertyName(k)] = value
+			}
+		case *sliceObject:
+			var values []interface{}
+			for _, member := range v.members {
+				value, err := c.convertObject(resourceType, member)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				values = append(values, value)
+			}
+			properties[pulumiListPropertyName(k)] = values
+		case *mapStringLiteral:
+			// Map keys (e.g. tags) are data, so they are not renamed
+			values := make(map[string]interface{}, len(v.members))
+			for mk, mv := range v.members {
+				value, err := c.convertLiteral(mv.String)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				values[mk] = value
+			}
+			properties[pulumiPropertyName(k)] = values
+		default:
+			return nil, fmt.Errorf("%s: unhandled element %T", k, v)
+		}
+	}
+	return properties, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kops/upup/pkg/fi"
+	"k8s.io/kops/upup/pkg/fi/cloudup/terraformWriter"
+	"sigs.k8s.io/yaml"
+)
+
+func newTestPulumiConverter() *pulumiConverter {
+	return &pulumiConverter{
+		resourceNames: map[string]string{
+			"aws_vpc.example-com":            "aws_vpc-example-com",
+			"google_service_account.control": "google_service_account-control",
+		},
+		dataSourceNames: map[string]string{
+			"data.aws_vpc.example-com": "data-aws_vpc-example-com",
+		},
+		base64Files: map[string]bool{},
+	}
+}
+
+func TestPulumiConvertLiteral(t *testing.T) {
+	cases := []struct {
+		literal  *terraformWriter.Literal
+		expected interface{}
+	}{
+		{
+			literal:  terraformWriter.LiteralFromStringValue("value"),
+			expected: "value",
+		},
+		{
+			literal:  terraformWriter.LiteralFromStringValue("${not-interpolated}"),
+			expected: "$${not-interpolated}",
+		},
+		{
+			literal:  terraformWriter.LiteralFromIntValue(42),
+			expected: int64(42),
+		},
+		{
+			literal:  terraformWriter.LiteralTokens("true"),
+			expected: true,
+		},
+		{
+			literal:  terraformWriter.LiteralProperty("aws_vpc", "example.com", "id"),
+			expected: "${aws_vpc-example-com.id}",
+		},
+		{
+			literal:  terraformWriter.LiteralData("aws_vpc", "example.com", "ipv6_cidr_block"),
+			expected: "${data-aws_vpc-example-com.ipv6CidrBlock}",
+		},
+		{
+			literal: terraformWriter.LiteralListExpression(
+				terraformWriter.LiteralFromStringValue("a"),
+				terraformWriter.LiteralProperty("aws_vpc", "example.com", "id"),
+			),
+			expected: []interface{}{"a", "${aws_vpc-example-com.id}"},
+		},
+		{
+			literal: terraformWriter.LiteralFunctionExpression(
+				"format",
+				terraformWriter.LiteralFromStringValue("serviceAccount:%s"),
+				terraformWriter.LiteralProperty("google_service_account", "control", "email"),
+			),
+			expected: "serviceAccount:${google_service_account-control.email}",
+		},
+		{
+			literal: terraformWriter.LiteralIndexExpression(
+				terraformWriter.LiteralProperty("aws_vpc", "example.com", "ids"),
+				terraformWriter.LiteralFromIntValue(0),
+			),
+			expected: map[string]interface{}{"fn::select": []interface{}{int64(0), "${aws_vpc-example-com.ids}"}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.literal.String, func(t *testing.T) {
+			c := newTestPulumiConverter()
+			actual, err := c.convertLiteral(tc.literal.String)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPulumiConvertLiteralUnsupported(t *testing.T) {
+	for _, literal := range []*terraformWriter.Literal{
+		terraformWriter.LiteralTokens("local", "vpc_ipv6_cidr_block"),
+		terraformWriter.LiteralProperty("aws_subnet", "unknown", "id"),
+		terraformWriter.LiteralFunctionExpression("cidrsubnet", terraformWriter.LiteralFromStringValue("10.0.0.0/8")),
+		terraformWriter.LiteralEmptyStrConditionalExpression(
+			terraformWriter.LiteralFromStringValue(""),
+			terraformWriter.LiteralFromStringValue("value"),
+		),
+	} {
+		c := newTestPulumiConverter()
+		if _, err := c.convertLiteral(literal.String); err == nil {
+			t.Errorf("expected error converting %q", literal.String)
+		}
+	}
+}
+
+type testPulumiBlock struct {
+	DeviceName *string `cty:"device_name"`
+}
+
+type testPulumiResource struct {
+	Name      *string                  `cty:"name"`
+	VPCID     *terraformWriter.Literal `cty:"vpc_id"`
+	UserData  *terraformWriter.Literal `cty:"user_data"`
+	Zones     []string                 `cty:"availability_zone"`
+	Mappings  []*testPulumiBlock       `cty:"block_device_mappings"`
+	Single    *testPulumiBlock         `cty:"metadata_options"`
+	Tags      map[string]string        `cty:"tags"`
+	Provider  *terraformWriter.Literal `cty:"provider"`
+	Lifecycle *Lifecycle               `cty:"lifecycle"`
+}
+
+func TestPulumiConvertResource(t *testing.T) {
+	c := newTestPulumiConverter()
+	c.resourceNames["aws.files"] = "aws-files"
+
+	resource, err := c.convertResource("aws_launch_template", &testPulumiResource{
+		Name:     fi.PtrTo("nodes.example.com"),
+		VPCID:    terraformWriter.LiteralProperty("aws_vpc", "example.com", "id"),
+		UserData: terraformWriter.LiteralFunctionExpression("filebase64", terraformWriter.LiteralTokens(`"${path.module}/data/user_data"`)),
+		Zones:    []string{"us-test-1a"},
+		Mappings: []*testPulumiBlock{{DeviceName: fi.PtrTo("/dev/xvda")}},
+		Single:   &testPulumiBlock{DeviceName: fi.PtrTo("/dev/xvdb")},
+		Tags:     map[string]string{"kubernetes.io/cluster/example.com": "owned"},
+		Provider: terraformWriter.LiteralTokens("aws", "files"),
+		Lifecycle: &Lifecycle{
+			IgnoreChanges: []*terraformWriter.Literal{terraformWriter.LiteralTokens("user_data")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	actual, err := yaml.Marshal(resource)
+	if err != nil {
+		t.Fatalf("error marshaling: %v", err)
+	}
+	expected := `options:
+  ignoreChanges:
+  - userData
+  provider: ${aws-files}
+properties:
+  availabilityZones:
+  - us-test-1a
+  blockDeviceMappings:
+  - deviceName: /dev/xvda
+  metadataOptions:
+    deviceName: /dev/xvdb
+  name: nodes.example.com
+  tags:
+    kubernetes.io/cluster/example.com: owned
+  userData:
+    fn::readFile: data/user_data
+  vpcId: ${aws_vpc-example-com.id}
+`
+	if string(actual) != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+	if !c.base64Files["data/user_data"] {
+		t.Errorf("expected user data file to be marked for base64 encoding")
+	}
+}
+
+func TestPulumiListPropertyName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"tag":                   "tags",
+		"security_groups":       "securityGroups",
+		"vpc_zone_identifier":   "vpcZoneIdentifiers",
+		"auto_healing_policy":   "autoHealingPolicies",
+		"ingress":               "ingress",
+		"block_device_mappings": "blockDeviceMappings",
+	} {
+		if actual := pulumiListPropertyName(name); actual != expected {
+			t.Errorf("pulumiListPropertyName(%q): expected %q, got %q", name, expected, actual)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"path"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -36,6 +37,8 @@ type TerraformWriter struct {
 	resources []*terraformResource
 	// outputs is a list of our TF output variables
 	outputs map[string]*terraformOutputVariable
+	// imports is a list of existing resources that should be imported into the terraform state
+	imports []*Import
 
 	// Providers is a list of TF Providers we need for writing files
 	Providers map[string]*TerraformProvider
@@ -59,6 +62,16 @@ type terraformResource struct {
 	ResourceType string
 	ResourceName string
 	Item         interface{}
+	// Module is the name of the terraform module the resource should be written to, or empty for the root module
+	Module string
+}
+
+// Import identifies an existing cloud resource that should be imported into the terraform state.
+type Import struct {
+	ResourceType string
+	ResourceName string
+	// ID is the cloud identifier that terraform uses to import the resource
+	ID string
 }
 
 type terraformOutputVariable struct {
@@ -151,10 +164,16 @@ func (t *TerraformWriter) RenderDataSource(dataType string, dataName string, e i
 }
 
 func (t *TerraformWriter) RenderResource(resourceType string, resourceName string, e interface{}) error {
+	return t.RenderModuleResource("", resourceType, resourceName, e)
+}
+
+// RenderModuleResource renders a resource into the named terraform module; an empty module name means the root module.
+func (t *TerraformWriter) RenderModuleResource(module string, resourceType string, resourceName string, e interface{}) error {
 	res := &terraformResource{
 		ResourceType: resourceType,
 		ResourceName: resourceName,
 		Item:         e,
+		Module:       module,
 	}
 
 	t.mutex.Lock()
@@ -165,6 +184,18 @@ func (t *TerraformWriter) RenderResource(resourceType string, resourceName strin
 	return nil
 }
 
+// AddImport records that an existing resource should be imported into the terraform state.
+func (t *TerraformWriter) AddImport(resourceType string, resourceName string, id string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.imports = append(t.imports, &Import{
+		ResourceType: resourceType,
+		ResourceName: sanitizeName(resourceName),
+		ID:           id,
+	})
+}
+
 func (t *TerraformWriter) AddOutputVariable(key string, literal *Literal) error {
 	v := &terraformOutputVariable{
 		Key:   key,
@@ -245,6 +276,30 @@ func (t *TerraformWriter) GetResourcesByType() (map[string]map[string]interface{
 	return resourcesByType, nil
 }
 
+// GetResourceModules returns a map from resource address (type.name) to the module it belongs to,
+// for all resources that are not in the root module.
+func (t *TerraformWriter) GetResourceModules() map[string]string {
+	modules := make(map[string]string)
+	for _, res := range t.resources {
+		if res.Module != "" {
+			modules[res.ResourceType+"."+sanitizeName(res.ResourceName)] = sanitizeName(res.Module)
+		}
+	}
+	return modules
+}
+
+// GetImports returns the resources that should be imported, sorted by address.
+func (t *TerraformWriter) GetImports() []*Import {
+	imports := append([]*Import(nil), t.imports...)
+	sort.Slice(imports, func(i, j int) bool {
+		if imports[i].ResourceType != imports[j].ResourceType {
+			return imports[i].ResourceType < imports[j].ResourceType
+		}
+		return imports[i].ResourceName < imports[j].ResourceName
+	})
+	return imports
+}
+
 func (t *TerraformWriter) GetOutputs() (map[string]OutputValue, error) {
 	values := map[string]OutputValue{}
 	for _, v := range t.outputs {
@@ -40,6 +40,10 @@ type Context[T SubContext] struct {
 
 	deletionProcessingMode DeletionProcessingMode
 
+	// report, if set, records the outcome of the task with key taskKey
+	report  *ApplyReport
+	taskKey string
+
 	T T
 }
 
@@ -70,6 +74,14 @@ func (c *Context[T]) Context() context.Context {
 	return c.ctx
 }
 
+// withContext returns a shallow copy of the Context that uses ctx in place of the original context.
+// This lets us thread per-task values (such as the tracing span) through to the task implementations.
+func (c *Context[T]) withContext(ctx context.Context) *Context[T] {
+	copy := *c
+	copy.ctx = ctx
+	return &copy
+}
+
 // Warning holds the details of a warning encountered during validation/creation
 type Warning[T SubContext] struct {
 	Task    Task[T]
@@ -260,9 +272,10 @@ func (c *Context[T]) AddWarning(task Task[T], message string) {
 		Task:    task,
 		Message: message,
 	}
-	// We don't actually do anything with these warnings yet, other than log them to glog below.
-	// In future we might produce a structured warning report.
 	c.warnings = append(c.warnings, warning)
+	if c.report != nil {
+		c.report.AddWarning(fmt.Sprintf("%s: %s", c.taskKey, message))
+	}
 	klog.Warningf("warning during task %s: %s", task, message)
 }
 
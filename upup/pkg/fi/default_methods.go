@@ -57,6 +57,7 @@ func defaultDeltaRunMethod[T SubContext](e Task[T], c *Context[T]) error {
 	}
 
 	if lifecycle == LifecycleIgnore {
+		c.reportAction(nil, e, nil, TaskActionIgnored)
 		return nil
 	}
 
@@ -76,9 +77,21 @@ func defaultDeltaRunMethod[T SubContext](e Task[T], c *Context[T]) error {
 			}
 			return err
 		}
+	} else if recorder, ok := c.Target.(ExistingResourceRecorder[T]); ok && recorder.RecordExistingResources() {
+		// We deliberately don't set a, so the task is still rendered in full.
+		existing, err := invokeFind(e, c)
+		if err != nil {
+			klog.Warningf("error checking if %v exists; it will not be recorded as existing: %v", e, err)
+		} else if existing != nil {
+			if err := recorder.RecordExisting(e, existing); err != nil {
+				return err
+			}
+		}
 	}
 
+	action := TaskActionUpdated
 	if a == nil {
+		action = TaskActionCreated
 		// This is kind of subtle.  We want an interface pointer to a struct of the correct type...
 		a = reflect.New(reflect.TypeOf(e)).Elem().Interface().(Task[T])
 	}
@@ -102,8 +115,13 @@ func defaultDeltaRunMethod[T SubContext](e Task[T], c *Context[T]) error {
 			if err != nil {
 				return err
 			}
+		} else {
+			action = TaskActionUnchanged
 		}
+	} else {
+		action = TaskActionUnchanged
 	}
+	c.reportAction(a, e, changes, action)
 
 	if producesDeletions, ok := e.(ProducesDeletions[T]); ok && c.deletionProcessingMode != DeletionProcessingModeIgnore {
 		deletions, err := producesDeletions.FindDeletions(c)
@@ -130,6 +148,9 @@ func defaultDeltaRunMethod[T SubContext](e Task[T], c *Context[T]) error {
 				if err := deletion.Delete(c.Target); err != nil {
 					return err
 				}
+				if c.report != nil {
+					c.report.recordDeletion(c.taskKey, deletion.TaskName(), deletion.Item())
+				}
 			}
 		}
 	}
@@ -310,6 +310,59 @@ func (t *DryRunTarget[T]) PrintReport(taskMap map[string]Task[T], out io.Writer)
 	return err
 }
 
+// DriftedResource is an existing resource whose live attributes differ from the model
+type DriftedResource struct {
+	// Task is the type of the resource, such as SecurityGroup
+	Task string `json:"task"`
+	// Name identifies the resource
+	Name string `json:"name"`
+	// Fields are the attributes which differ
+	Fields []DriftedField `json:"fields"`
+}
+
+// DriftedField is an attribute of a resource whose live value differs from the model
+type DriftedField struct {
+	// Name is the name of the attribute
+	Name string `json:"name"`
+	// Description shows the live and modelled values, or the difference between them
+	Description string `json:"description"`
+}
+
+// Drift returns the existing resources which would be modified, with the attributes that differ.
+// Resources that would be created or deleted are not drift, and are not included.
+func (t *DryRunTarget[T]) Drift(taskMap map[string]Task[T]) ([]*DriftedResource, error) {
+	var updates []*render[T]
+	for _, r := range t.changes {
+		if !r.aIsNil {
+			updates = append(updates, r)
+		}
+	}
+	sort.Sort(ByTaskKey[T](updates))
+
+	var drift []*DriftedResource
+	for _, r := range updates {
+		changeList, err := buildChangeList(r.a, r.e, r.changes)
+		if err != nil {
+			return nil, err
+		}
+		if len(changeList) == 0 {
+			continue
+		}
+		resource := &DriftedResource{
+			Task: getTaskName(r.changes),
+			Name: idForTask(taskMap, r.e),
+		}
+		for _, change := range changeList {
+			resource.Fields = append(resource.Fields, DriftedField{
+				Name:        change.FieldName,
+				Description: strings.TrimSpace(change.Description),
+			})
+		}
+		drift = append(drift, resource)
+	}
+	return drift, nil
+}
+
 type change struct {
 	FieldName   string
 	Description string
@@ -92,3 +92,47 @@ func Test_DryrunTarget_PrintReport(t *testing.T) {
 	err = target.PrintReport(tasks, &out)
 	assert.NoError(t, err, "target.PrintReport()")
 }
+
+func Test_DryrunTarget_Drift(t *testing.T) {
+	builder := assets.NewAssetBuilder(vfs.Context, nil, false)
+	target := newDryRunTarget[CloudupSubContext](builder, true, &bytes.Buffer{})
+	tasks := map[string]CloudupTask{}
+
+	render := func(a, e *testTask) {
+		changes := &testTask{}
+		if a != nil {
+			_ = BuildChanges(a, e, changes)
+		} else {
+			changes = e
+		}
+		tasks["testTask/"+*e.Name] = e
+		assert.NoError(t, target.Render(a, e, changes), "target.Render()")
+	}
+
+	// An existing resource whose tags were edited is drift
+	render(&testTask{
+		Name: PtrTo("edited"),
+		Tags: map[string]string{"key": "manual"},
+	}, &testTask{
+		Name: PtrTo("edited"),
+		Tags: map[string]string{"key": "value"},
+	})
+	// A resource which does not exist yet is not drift
+	render(nil, &testTask{
+		Name: PtrTo("missing"),
+		Tags: map[string]string{"key": "value"},
+	})
+
+	drift, err := target.Drift(tasks)
+	assert.NoError(t, err, "target.Drift()")
+	expected := []*DriftedResource{
+		{
+			Task: "testTask",
+			Name: "edited",
+			Fields: []DriftedField{
+				{Name: "Tags", Description: "{key: manual} -> {key: value}"},
+			},
+		},
+	}
+	assert.Equal(t, expected, drift)
+}
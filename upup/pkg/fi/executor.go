@@ -20,10 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/klog/v2"
 )
 
@@ -35,26 +40,49 @@ type executor[T SubContext] struct {
 
 type taskState[T SubContext] struct {
 	done         bool
+	running      bool
 	key          string
 	task         Task[T]
 	deadline     time.Time
+	retryAt      time.Time
 	lastError    error
 	dependencies []*taskState[T]
 }
 
+type taskResult[T SubContext] struct {
+	ts  *taskState[T]
+	err error
+}
+
 type RunTasksOptions struct {
 	MaxTaskDuration         time.Duration
 	WaitAfterAllTasksFailed time.Duration
+
+	// MaxConcurrency is the maximum number of tasks that will be run at the same time.
+	// Zero means that all tasks whose dependencies are satisfied are run immediately.
+	MaxConcurrency int
+
+	// Progress, if set, receives a live display of the state of the tasks.
+	Progress io.Writer
+
+	// Report, if set, records what each task did, for a machine-readable report of the run.
+	Report *ApplyReport
 }
 
 func (o *RunTasksOptions) InitDefaults() {
 	o.MaxTaskDuration = 10 * time.Minute
 	o.WaitAfterAllTasksFailed = 10 * time.Second
+	o.MaxConcurrency = 0
 }
 
-// RunTasks executes all the tasks, considering their dependencies
+// RunTasks executes all the tasks, considering their dependencies.
+// A task is started as soon as all of its dependencies have completed (subject to MaxConcurrency),
+// rather than waiting for all the tasks at the same level of the dependency graph.
 // It will perform some re-execution on error, retrying as long as progress is still being made
 func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T]) error {
+	ctx, span := tracer.Start(ctx, "RunTasks", trace.WithAttributes(attribute.Int("kops.tasks.count", len(taskMap))))
+	defer span.End()
+
 	dependencies := FindTaskDependencies(taskMap)
 
 	for _, task := range taskMap {
@@ -85,14 +113,44 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 		}
 	}
 
+	// We start tasks in a stable order, so that MaxConcurrency behaves predictably.
+	var ordered []*taskState[T]
+	for _, ts := range taskStates {
+		ordered = append(ordered, ts)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].key < ordered[j].key })
+
+	var progress *progressReporter
+	if e.options.Progress != nil {
+		progress = newProgressReporter(e.options.Progress, len(ordered))
+		defer progress.Stop()
+	}
+
+	// If we return early, the running tasks are cancelled and we wait for them to return.
+	// The channel is buffered so that they never block on sending their results.
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	results := make(chan taskResult[T], len(ordered))
+	running := 0
+
 	for {
+		now := time.Now()
+
 		var canRun []*taskState[T]
+		var nextRetry time.Time
 		doneCount := 0
-		for _, ts := range taskStates {
+		for _, ts := range ordered {
 			if ts.done {
 				doneCount++
 				continue
 			}
+			if ts.running {
+				continue
+			}
 			ready := true
 			for _, dep := range ts.dependencies {
 				if !dep.done {
@@ -100,85 +158,83 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 					break
 				}
 			}
-			if ready {
-				if ts.deadline.IsZero() {
-					ts.deadline = time.Now().Add(e.options.MaxTaskDuration)
-				} else if time.Now().After(ts.deadline) {
-					return fmt.Errorf("deadline exceeded executing task %v. Example error: %v", ts.key, ts.lastError)
+			if !ready {
+				continue
+			}
+			if ts.deadline.IsZero() {
+				ts.deadline = now.Add(e.options.MaxTaskDuration)
+			} else if now.After(ts.deadline) {
+				return fmt.Errorf("deadline exceeded executing task %v. Example error: %v", ts.key, ts.lastError)
+			}
+			if now.Before(ts.retryAt) {
+				if nextRetry.IsZero() || ts.retryAt.Before(nextRetry) {
+					nextRetry = ts.retryAt
 				}
-				canRun = append(canRun, ts)
+				continue
 			}
+			canRun = append(canRun, ts)
 		}
 
-		klog.Infof("Tasks: %d done / %d total; %d can run", doneCount, len(taskStates), len(canRun))
-		if len(canRun) == 0 {
+		if doneCount == len(ordered) {
 			break
 		}
 
-		progress := false
-
-		var tasks []*taskState[T]
-		tasks = append(tasks, canRun...)
-
-		taskErrors := e.forkJoin(ctx, tasks)
-		var errs []error
-		for i, err := range taskErrors {
-			ts := tasks[i]
-			if err != nil {
-				//  print warning message and continue like the task succeeded
-				if _, ok := err.(*ExistsAndWarnIfChangesError); ok {
-					klog.Warning(err.Error())
-					ts.done = true
-					ts.lastError = nil
-					progress = true
-					continue
-				}
-
-				remaining := time.Second * time.Duration(int(time.Until(ts.deadline).Seconds()))
-				if _, ok := err.(*TryAgainLaterError); ok {
-					klog.V(2).Infof("Task %q not ready: %v", ts.key, err)
-				} else {
-					klog.Warningf("error running task %q (%v remaining to succeed): %v", ts.key, remaining, err)
-				}
-				errs = append(errs, err)
-				ts.lastError = err
-			} else {
-				ts.done = true
-				ts.lastError = nil
-				progress = true
+		started := 0
+		for _, ts := range canRun {
+			if e.options.MaxConcurrency > 0 && running >= e.options.MaxConcurrency {
+				break
+			}
+			ts.running = true
+			running++
+			started++
+			if progress != nil {
+				progress.TaskStarted(ts.key)
 			}
+			wg.Add(1)
+			go func(ts *taskState[T]) {
+				defer wg.Done()
+				results <- taskResult[T]{ts: ts, err: e.runTask(ctx, ts)}
+			}(ts)
+		}
+		// The progress display shows the same counts, and would be interleaved with the log.
+		if started != 0 && progress == nil {
+			klog.Infof("Tasks: %d done / %d total; %d running; %d waiting to run", doneCount, len(ordered), running, len(canRun)-started)
 		}
 
-		if !progress {
-			n := len(errs)
-
-			if n == 0 {
-				// Logic error!
-				panic("did not make progress executing tasks; but no errors reported")
+		if running == 0 {
+			if nextRetry.IsZero() {
+				// Nothing is running and nothing can run; we are either done or have a circular dependency
+				break
 			}
 
-			tryAgainLaterCount := 0
-			for _, err := range errs {
-				var tryAgainLaterError TryAgainLaterError
-				if !errors.Is(err, &tryAgainLaterError) {
-					tryAgainLaterCount++
-				}
+			// Every runnable task has failed since anything last succeeded
+			klog.Infof("No progress made, sleeping before retrying %s", formatTaskCount(e.countFailed(ordered)))
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Until(nextRetry)):
 			}
-			formatTaskCount := func(n int) string {
-				return fmt.Sprintf("%d task(s)", n)
-			}
-			if tryAgainLaterCount == n {
-				klog.Infof("Continuing to run %s", formatTaskCount(tryAgainLaterCount))
-			} else {
-				klog.Infof("No progress made, sleeping before retrying %s", formatTaskCount(n))
-			}
-			time.Sleep(e.options.WaitAfterAllTasksFailed)
+			continue
+		}
+
+		var retryTimer <-chan time.Time
+		if !nextRetry.IsZero() {
+			retryTimer = time.After(time.Until(nextRetry))
+		}
+
+		select {
+		case result := <-results:
+			running--
+			e.handleResult(result, ordered, progress)
+		case <-retryTimer:
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 
 	// Raise error if not all tasks done - this means they depended on each other
 	var notDone []string
-	for _, ts := range taskStates {
+	for _, ts := range ordered {
 		if !ts.done {
 			notDone = append(notDone, ts.key)
 		}
@@ -190,45 +246,111 @@ func (e *executor[T]) RunTasks(ctx context.Context, taskMap map[string]Task[T])
 	return nil
 }
 
-func (e *executor[T]) forkJoin(ctx context.Context, tasks []*taskState[T]) []error {
-	if len(tasks) == 0 {
-		return nil
+// handleResult records the outcome of a task run.
+func (e *executor[T]) handleResult(result taskResult[T], taskStates []*taskState[T], progress *progressReporter) {
+	ts := result.ts
+	ts.running = false
+	err := result.err
+
+	if err != nil {
+		//  print warning message and continue like the task succeeded
+		if _, ok := err.(*ExistsAndWarnIfChangesError); ok {
+			klog.Warning(err.Error())
+			if e.options.Report != nil {
+				e.options.Report.AddWarning(fmt.Sprintf("%s: %v", ts.key, err))
+			}
+			err = nil
+		}
 	}
 
-	results := make([]error, len(tasks))
-	var resultsMutex sync.Mutex
+	if progress != nil {
+		progress.TaskFinished(ts.key, err)
+	}
 
-	var wg sync.WaitGroup
-	for i := 0; i < len(tasks); i++ {
-		wg.Add(1)
-		go func(ts *taskState[T], index int) {
-			defer wg.Done()
+	if err != nil {
+		remaining := time.Second * time.Duration(int(time.Until(ts.deadline).Seconds()))
+		if _, ok := err.(*TryAgainLaterError); ok {
+			klog.V(2).Infof("Task %q not ready: %v", ts.key, err)
+		} else {
+			klog.Warningf("error running task %q (%v remaining to succeed): %v", ts.key, remaining, err)
+		}
+		ts.lastError = err
+		ts.retryAt = time.Now().Add(e.options.WaitAfterAllTasksFailed)
+		return
+	}
 
-			_, span := tracer.Start(ctx, "task-"+ts.key)
-			defer span.End()
+	ts.done = true
+	ts.lastError = nil
 
-			resultsMutex.Lock()
-			results[index] = fmt.Errorf("function panic")
-			resultsMutex.Unlock()
+	// We made progress, so failed tasks might now succeed; retry them without waiting.
+	for _, other := range taskStates {
+		if other.lastError != nil && !other.running {
+			other.retryAt = time.Time{}
+		}
+	}
+}
 
-			klog.V(2).Infof("Executing task %q: %v\n", ts.key, ts.task)
+// countFailed returns the number of tasks that are waiting to be retried.
+func (e *executor[T]) countFailed(taskStates []*taskState[T]) int {
+	n := 0
+	for _, ts := range taskStates {
+		if !ts.done && ts.lastError != nil {
+			n++
+		}
+	}
+	return n
+}
 
-			if taskNormalize, ok := ts.task.(TaskNormalize[T]); ok {
-				if err := taskNormalize.Normalize(e.context); err != nil {
-					results[index] = err
-					return
-				}
-			}
+func formatTaskCount(n int) string {
+	return fmt.Sprintf("%d task(s)", n)
+}
+
+// runTask runs a single task, returning the error from Normalize or Run.
+func (e *executor[T]) runTask(ctx context.Context, ts *taskState[T]) (err error) {
+	taskCtx, span := tracer.Start(ctx, "task-"+ts.key, trace.WithAttributes(
+		attribute.String("kops.task.key", ts.key),
+		attribute.String("kops.task.type", fmt.Sprintf("%T", ts.task)),
+	))
+	defer span.End()
+
+	// Tasks make their cloud calls using the context, so give each task its own
+	// context so that those calls are recorded as children of the task span.
+	c := e.context.withContext(taskCtx)
+	if e.options.Report != nil {
+		c.report = e.options.Report
+		c.taskKey = ts.key
+
+		start := time.Now()
+		defer func() {
+			e.options.Report.taskFinished(ts.key, time.Since(start), err)
+		}()
+	}
 
-			result := ts.task.Run(e.context)
+	klog.V(2).Infof("Executing task %q: %v\n", ts.key, ts.task)
 
-			resultsMutex.Lock()
-			results[index] = result
-			resultsMutex.Unlock()
-		}(tasks[i], i)
+	if taskNormalize, ok := ts.task.(TaskNormalize[T]); ok {
+		if err := taskNormalize.Normalize(c); err != nil {
+			recordTaskError(span, err)
+			return err
+		}
 	}
 
-	wg.Wait()
+	err = ts.task.Run(c)
+	if err != nil {
+		recordTaskError(span, err)
+	}
+	return err
+}
 
-	return results
+// recordTaskError records a task failure on the task's span.
+// Tasks that are simply not ready yet are not marked as errors, so that they don't dominate trace views.
+func recordTaskError(span trace.Span, err error) {
+	var tryAgainLaterError *TryAgainLaterError
+	if errors.As(err, &tryAgainLaterError) {
+		span.SetAttributes(attribute.Bool("kops.task.try_again_later", true))
+		span.AddEvent(err.Error())
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
 }
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeTask is a task that records when it ran, for testing the executor.
+type fakeTask struct {
+	Name string
+	Deps []*fakeTask
+
+	sleep    time.Duration
+	failures int
+	warning  string
+
+	recorder *fakeRecorder
+}
+
+var _ InstallHasDependencies = &fakeTask{}
+
+func (t *fakeTask) GetDependencies(tasks map[string]InstallTask) []InstallTask {
+	var deps []InstallTask
+	for _, d := range t.Deps {
+		deps = append(deps, d)
+	}
+	return deps
+}
+
+func (t *fakeTask) Run(c *InstallContext) error {
+	t.recorder.start(t.Name)
+	defer t.recorder.end(t.Name)
+
+	time.Sleep(t.sleep)
+	if t.warning != "" {
+		c.AddWarning(t, t.warning)
+	}
+
+	t.recorder.mutex.Lock()
+	defer t.recorder.mutex.Unlock()
+	if t.failures > 0 {
+		t.failures--
+		return fmt.Errorf("task %s failed", t.Name)
+	}
+	return nil
+}
+
+type fakeRecorder struct {
+	mutex      sync.Mutex
+	running    int
+	maxRunning int
+	completed  []string
+}
+
+func (r *fakeRecorder) start(name string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.running++
+	if r.running > r.maxRunning {
+		r.maxRunning = r.running
+	}
+}
+
+func (r *fakeRecorder) end(name string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.running--
+	r.completed = append(r.completed, name)
+}
+
+func runFakeTasks(t *testing.T, options RunTasksOptions, tasks ...*fakeTask) error {
+	taskMap := make(map[string]InstallTask)
+	for _, task := range tasks {
+		taskMap[task.Name] = task
+	}
+	c, err := NewInstallContext(context.Background(), nil, taskMap)
+	if err != nil {
+		t.Fatalf("error building context: %v", err)
+	}
+	return c.RunTasks(options)
+}
+
+func testRunTasksOptions() RunTasksOptions {
+	var options RunTasksOptions
+	options.InitDefaults()
+	options.MaxTaskDuration = 10 * time.Second
+	options.WaitAfterAllTasksFailed = 10 * time.Millisecond
+	return options
+}
+
+func TestRunTasksMaxConcurrency(t *testing.T) {
+	recorder := &fakeRecorder{}
+	var tasks []*fakeTask
+	for i := 0; i < 10; i++ {
+		tasks = append(tasks, &fakeTask{Name: fmt.Sprintf("task-%d", i), sleep: 20 * time.Millisecond, recorder: recorder})
+	}
+
+	options := testRunTasksOptions()
+	options.MaxConcurrency = 3
+	if err := runFakeTasks(t, options, tasks...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(recorder.completed) != len(tasks) {
+		t.Errorf("expected %d tasks to complete, got %d", len(tasks), len(recorder.completed))
+	}
+	if recorder.maxRunning > 3 {
+		t.Errorf("expected at most 3 tasks to run concurrently, got %d", recorder.maxRunning)
+	}
+	if recorder.maxRunning < 2 {
+		t.Errorf("expected tasks to run concurrently, got %d", recorder.maxRunning)
+	}
+}
+
+func TestRunTasksDoesNotWaitForUnrelatedTasks(t *testing.T) {
+	recorder := &fakeRecorder{}
+	slow := &fakeTask{Name: "slow", sleep: 200 * time.Millisecond, recorder: recorder}
+	fast := &fakeTask{Name: "fast", recorder: recorder}
+	dependent := &fakeTask{Name: "dependent", Deps: []*fakeTask{fast}, recorder: recorder}
+
+	if err := runFakeTasks(t, testRunTasksOptions(), slow, fast, dependent); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// dependent only needs fast, so it should complete before slow, even though slow started first.
+	got := strings.Join(recorder.completed, ",")
+	if got != "fast,dependent,slow" {
+		t.Errorf("unexpected completion order %q", got)
+	}
+}
+
+func TestRunTasksRetriesFailures(t *testing.T) {
+	recorder := &fakeRecorder{}
+	flaky := &fakeTask{Name: "flaky", failures: 2, recorder: recorder}
+	dependent := &fakeTask{Name: "dependent", Deps: []*fakeTask{flaky}, recorder: recorder}
+
+	var progress bytes.Buffer
+	options := testRunTasksOptions()
+	options.Progress = &progress
+	if err := runFakeTasks(t, options, flaky, dependent); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := strings.Join(recorder.completed, ",")
+	if got != "flaky,flaky,flaky,dependent" {
+		t.Errorf("unexpected execution order %q", got)
+	}
+	if !strings.Contains(progress.String(), "Tasks: 2/2 done") {
+		t.Errorf("expected final progress summary, got %q", progress.String())
+	}
+	if !strings.Contains(progress.String(), "Slowest tasks:") {
+		t.Errorf("expected slowest tasks summary, got %q", progress.String())
+	}
+}
+
+func TestRunTasksReport(t *testing.T) {
+	recorder := &fakeRecorder{}
+	flaky := &fakeTask{Name: "flaky", failures: 2, recorder: recorder}
+	warning := &fakeTask{Name: "warning", warning: "something looks wrong", recorder: recorder}
+
+	options := testRunTasksOptions()
+	options.Report = NewApplyReport()
+	if err := runFakeTasks(t, options, flaky, warning); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tasks := options.Report.Tasks()
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 task reports, got %d", len(tasks))
+	}
+	if tasks[0].Key != "flaky" || tasks[0].Attempts != 3 || tasks[0].Error != "" {
+		t.Errorf("unexpected report for flaky task: %+v", tasks[0])
+	}
+	if tasks[1].Key != "warning" || tasks[1].Attempts != 1 {
+		t.Errorf("unexpected report for warning task: %+v", tasks[1])
+	}
+	warnings := options.Report.Warnings()
+	if len(warnings) != 1 || warnings[0] != "warning: something looks wrong" {
+		t.Errorf("unexpected warnings %q", warnings)
+	}
+}
+
+func TestRunTasksDeadlineExceeded(t *testing.T) {
+	recorder := &fakeRecorder{}
+	broken := &fakeTask{Name: "broken", failures: 1000, recorder: recorder}
+
+	options := testRunTasksOptions()
+	options.MaxTaskDuration = 50 * time.Millisecond
+	err := runFakeTasks(t, options, broken)
+	if err == nil || !strings.Contains(err.Error(), "deadline exceeded executing task broken") {
+		t.Errorf("expected deadline exceeded error, got %v", err)
+	}
+}
+
+func TestRunTasksWaitsForRunningTasksOnError(t *testing.T) {
+	recorder := &fakeRecorder{}
+	broken := &fakeTask{Name: "broken", failures: 1000, recorder: recorder}
+	slow := &fakeTask{Name: "slow", sleep: 300 * time.Millisecond, recorder: recorder}
+
+	options := testRunTasksOptions()
+	options.MaxTaskDuration = 50 * time.Millisecond
+	if err := runFakeTasks(t, options, broken, slow); err == nil {
+		t.Fatalf("expected deadline exceeded error")
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	if recorder.running != 0 {
+		t.Errorf("expected no tasks to be running after RunTasks returned, got %d", recorder.running)
+	}
+}
+
+func TestRunTasksCircularDependency(t *testing.T) {
+	recorder := &fakeRecorder{}
+	a := &fakeTask{Name: "a", recorder: recorder}
+	b := &fakeTask{Name: "b", Deps: []*fakeTask{a}, recorder: recorder}
+	a.Deps = []*fakeTask{b}
+
+	err := runFakeTasks(t, testRunTasksOptions(), a, b)
+	if err == nil || !strings.Contains(err.Error(), "circular dependency") {
+		t.Errorf("expected circular dependency error, got %v", err)
+	}
+}
+
+func TestProgressKeepsLogLines(t *testing.T) {
+	var terminal bytes.Buffer
+	p := &progressReporter{
+		out:         &terminal,
+		logOut:      &terminal,
+		interactive: true,
+		start:       time.Now(),
+		total:       2,
+		running:     make(map[string]time.Time),
+		retrying:    make(map[string]error),
+		durations:   make(map[string]time.Duration),
+	}
+	p.TaskStarted("slow")
+	p.render()
+	p.writeLog([]byte("W1015 12:00:00.000000       1 executor.go:1] something happened"))
+	p.render()
+
+	_, afterLog, found := strings.Cut(terminal.String(), "something happened\n")
+	if !found {
+		t.Fatalf("expected the log line on its own line, got %q", terminal.String())
+	}
+	// The redraws after the log line must only move up over the display drawn below it
+	display, redrawn, found := strings.Cut(afterLog, "\x1b[")
+	if !found {
+		t.Fatalf("expected the display to be redrawn, got %q", afterLog)
+	}
+	if lines := strings.Count(display, "\n"); !strings.HasPrefix(redrawn, fmt.Sprintf("%dA", lines)) {
+		t.Errorf("expected the redraw to move up over the %d display lines only, got %q", lines, afterLog)
+	}
+	if !strings.HasPrefix(display, "Tasks: 0/2 done, 1 running") {
+		t.Errorf("expected the display below the log line, got %q", display)
+	}
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fi
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+)
+
+const (
+	// progressInteractiveInterval is how often we redraw the progress display on a terminal.
+	progressInteractiveInterval = time.Second
+	// progressLogInterval is how often we print a progress summary when not on a terminal.
+	progressLogInterval = 15 * time.Second
+	// progressMaxListed is the maximum number of individual tasks we show.
+	progressMaxListed = 5
+)
+
+// progressReporter renders a live display of task execution state.
+// On a terminal the display is redrawn in place; otherwise a summary is printed periodically.
+type progressReporter struct {
+	out         io.Writer
+	interactive bool
+	start       time.Time
+
+	// logOut receives the klog output while it is diverted, so that the display can be moved below each log line.
+	logOut io.Writer
+	// restoreLogging restores the klog configuration when the display stops.
+	restoreLogging func()
+
+	mutex     sync.Mutex
+	total     int
+	done      int
+	running   map[string]time.Time
+	retrying  map[string]error
+	durations map[string]time.Duration
+	lastLines int
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newProgressReporter(out io.Writer, total int) *progressReporter {
+	interactive := false
+	if f, ok := out.(*os.File); ok {
+		interactive = term.IsTerminal(int(f.Fd()))
+	}
+
+	p := &progressReporter{
+		out:         out,
+		interactive: interactive,
+		start:       time.Now(),
+		total:       total,
+		running:     make(map[string]time.Time),
+		retrying:    make(map[string]error),
+		durations:   make(map[string]time.Duration),
+		stopCh:      make(chan struct{}),
+	}
+
+	// Log lines written to the same terminal would be overwritten when the display is redrawn in place
+	if interactive && klogToTerminal() {
+		p.logOut = os.Stderr
+		state := klog.CaptureState()
+		logger := textlogger.NewLogger(textlogger.NewConfig(
+			textlogger.Output(progressLogWriter{p}),
+			// klog has already checked the verbosity
+			textlogger.Verbosity(math.MaxInt32),
+		))
+		klog.SetLoggerWithOptions(logger, klog.WriteKlogBuffer(p.writeLog))
+		p.restoreLogging = state.Restore
+	}
+
+	interval := progressLogInterval
+	if interactive {
+		interval = progressInteractiveInterval
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				p.render()
+			case <-p.stopCh:
+				return
+			}
+		}
+	}()
+
+	return p
+}
+
+// TaskStarted records that a task has started running.
+func (p *progressReporter) TaskStarted(key string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.running[key] = time.Now()
+}
+
+// TaskFinished records that a task has finished running, successfully if err is nil.
+func (p *progressReporter) TaskFinished(key string, err error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if startedAt, found := p.running[key]; found {
+		p.durations[key] += time.Since(startedAt)
+		delete(p.running, key)
+	}
+	if err != nil {
+		p.retrying[key] = err
+		return
+	}
+	delete(p.retrying, key)
+	p.done++
+}
+
+// Stop stops the periodic display and prints a final summary, including the slowest tasks.
+func (p *progressReporter) Stop() {
+	close(p.stopCh)
+	p.wg.Wait()
+
+	if p.restoreLogging != nil {
+		defer p.restoreLogging()
+	}
+
+	p.render()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	type taskDuration struct {
+		key      string
+		duration time.Duration
+	}
+	var slowest []taskDuration
+	for k, d := range p.durations {
+		slowest = append(slowest, taskDuration{key: k, duration: d})
+	}
+	sort.Slice(slowest, func(i, j int) bool {
+		if slowest[i].duration != slowest[j].duration {
+			return slowest[i].duration > slowest[j].duration
+		}
+		return slowest[i].key < slowest[j].key
+	})
+	if len(slowest) > progressMaxListed {
+		slowest = slowest[:progressMaxListed]
+	}
+	if len(slowest) == 0 {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("Slowest tasks:\n")
+	for _, t := range slowest {
+		fmt.Fprintf(&b, "  %8s  %s\n", t.duration.Round(100*time.Millisecond), t.key)
+	}
+	fmt.Fprint(p.out, b.String())
+}
+
+func (p *progressReporter) render() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.renderLocked()
+}
+
+// writeLog writes a log line above the display, which is then redrawn below it.
+func (p *progressReporter) writeLog(data []byte) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.clearLocked()
+	_, _ = p.logOut.Write(data)
+	if len(data) != 0 && data[len(data)-1] != '\n' {
+		_, _ = p.logOut.Write([]byte("\n"))
+	}
+	p.renderLocked()
+}
+
+// clearLocked moves the cursor back to the start of the previous display, and clears it
+func (p *progressReporter) clearLocked() {
+	if p.interactive && p.lastLines != 0 {
+		fmt.Fprintf(p.out, "\x1b[%dA\r\x1b[J", p.lastLines)
+		p.lastLines = 0
+	}
+}
+
+func (p *progressReporter) renderLocked() {
+	now := time.Now()
+
+	var b strings.Builder
+	if p.interactive && p.lastLines != 0 {
+		// Move the cursor back to the start of the previous display, and clear it
+		fmt.Fprintf(&b, "\x1b[%dA\r\x1b[J", p.lastLines)
+	}
+
+	lines := 0
+	fmt.Fprintf(&b, "Tasks: %d/%d done, %d running, %d retrying (%s elapsed)\n",
+		p.done, p.total, len(p.running), len(p.retrying), now.Sub(p.start).Round(time.Second))
+	lines++
+
+	// Show the longest-running tasks, as these are usually the ones worth looking at
+	var keys []string
+	for k := range p.running {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ti, tj := p.running[keys[i]], p.running[keys[j]]
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return keys[i] < keys[j]
+	})
+	for i, k := range keys {
+		if i >= progressMaxListed {
+			fmt.Fprintf(&b, "  ... and %d more\n", len(keys)-progressMaxListed)
+			lines++
+			break
+		}
+		fmt.Fprintf(&b, "  running   %6s  %s\n", now.Sub(p.running[k]).Round(time.Second), k)
+		lines++
+	}
+
+	var retrying []string
+	for k := range p.retrying {
+		if _, isRunning := p.running[k]; !isRunning {
+			retrying = append(retrying, k)
+		}
+	}
+	sort.Strings(retrying)
+	for i, k := range retrying {
+		if i >= progressMaxListed {
+			fmt.Fprintf(&b, "  ... and %d more\n", len(retrying)-progressMaxListed)
+			lines++
+			break
+		}
+		fmt.Fprintf(&b, "  retrying          %s: %v\n", k, firstLine(p.retrying[k]))
+		lines++
+	}
+
+	p.lastLines = lines
+	fmt.Fprint(p.out, b.String())
+}
+
+// progressLogWriter writes the structured klog output through the progress reporter.
+type progressLogWriter struct {
+	p *progressReporter
+}
+
+func (w progressLogWriter) Write(data []byte) (int, error) {
+	w.p.writeLog(data)
+	return len(data), nil
+}
+
+// klogToTerminal returns true if klog writes to stderr, and stderr is a terminal.
+func klogToTerminal() bool {
+	if f := flag.Lookup("logtostderr"); f != nil && f.Value.String() != "true" {
+		return false
+	}
+	return term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// firstLine returns the (truncated) first line of the error message, so that the display stays compact.
+func firstLine(err error) string {
+	s := err.Error()
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	if len(s) > 100 {
+		s = s[:97] + "..."
+	}
+	return s
+}
@@ -25,6 +25,15 @@ type Target[T SubContext] interface {
 	DefaultCheckExisting() bool
 }
 
+// ExistingResourceRecorder is implemented by targets that do not compare against existing cloud resources,
+// but still want to be told about them (for example, to generate terraform import blocks).
+type ExistingResourceRecorder[T SubContext] interface {
+	// RecordExistingResources returns true if Find() should be invoked for tasks, and any results passed to RecordExisting.
+	RecordExistingResources() bool
+	// RecordExisting is called with the expected and actual state of each task, for which an existing resource was found.
+	RecordExisting(e, a Task[T]) error
+}
+
 type CloudupTarget = Target[CloudupSubContext]
 type InstallTarget = Target[InstallSubContext]
 type NodeupTarget = Target[NodeupSubContext]
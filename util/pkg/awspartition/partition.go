@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package awspartition describes the AWS partitions: the groups of regions, such as the China or GovCloud regions,
+// which have their own ARNs, service endpoints and global regions.
+package awspartition
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Partition is an AWS partition
+type Partition struct {
+	// ID is the partition of the ARNs, such as aws-cn
+	ID string
+	// DNSSuffix is the domain of the service endpoints, such as amazonaws.com.cn
+	DNSSuffix string
+	// DefaultRegion is the region of the S3 buckets which have no location constraint
+	DefaultRegion string
+	// RegionPrefix is the prefix of the names of the regions of the partition, empty for the standard partition
+	RegionPrefix string
+}
+
+var (
+	// Standard is the partition of the commercial regions
+	Standard = &Partition{ID: "aws", DNSSuffix: "amazonaws.com", DefaultRegion: "us-east-1"}
+	// China is the partition of the Beijing and Ningxia regions
+	China = &Partition{ID: "aws-cn", DNSSuffix: "amazonaws.com.cn", DefaultRegion: "cn-north-1", RegionPrefix: "cn-"}
+	// GovCloud is the partition of the AWS GovCloud (US) regions
+	GovCloud = &Partition{ID: "aws-us-gov", DNSSuffix: "amazonaws.com", DefaultRegion: "us-gov-west-1", RegionPrefix: "us-gov-"}
+	// ISO is the partition of the US ISO regions
+	ISO = &Partition{ID: "aws-iso", DNSSuffix: "c2s.ic.gov", DefaultRegion: "us-iso-east-1", RegionPrefix: "us-iso-"}
+	// ISOB is the partition of the US ISOB regions
+	ISOB = &Partition{ID: "aws-iso-b", DNSSuffix: "sc2s.sgov.gov", DefaultRegion: "us-isob-east-1", RegionPrefix: "us-isob-"}
+)
+
+// All are the known partitions, the standard partition first
+var All = []*Partition{Standard, China, GovCloud, ISO, ISOB}
+
+// ForRegion returns the partition of a region; regions which aren't part of another known partition
+// are assumed to be in the standard partition.
+func ForRegion(region string) *Partition {
+	for _, p := range All {
+		if p.RegionPrefix != "" && strings.HasPrefix(region, p.RegionPrefix) {
+			return p
+		}
+	}
+	return Standard
+}
+
+// ForID returns the partition with an ID, or nil if it is not known.
+func ForID(id string) *Partition {
+	for _, p := range All {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// Endpoint returns the host of the regional endpoint of a service, such as sqs.cn-north-1.amazonaws.com.cn
+func (p *Partition) Endpoint(service, region string) string {
+	return service + "." + region + "." + p.DNSSuffix
+}
+
+// ARN returns an ARN in the partition
+func (p *Partition) ARN(service, region, accountID, resource string) string {
+	return arn.ARN{
+		Partition: p.ID,
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resource,
+	}.String()
+}
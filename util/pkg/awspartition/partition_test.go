@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awspartition
+
+import "testing"
+
+func TestForRegion(t *testing.T) {
+	grid := []struct {
+		region   string
+		id       string
+		endpoint string
+	}{
+		{region: "us-east-1", id: "aws", endpoint: "sqs.us-east-1.amazonaws.com"},
+		{region: "randomunknown", id: "aws", endpoint: "sqs.randomunknown.amazonaws.com"},
+		{region: "cn-north-1", id: "aws-cn", endpoint: "sqs.cn-north-1.amazonaws.com.cn"},
+		{region: "cn-northwest-1", id: "aws-cn", endpoint: "sqs.cn-northwest-1.amazonaws.com.cn"},
+		{region: "us-gov-west-1", id: "aws-us-gov", endpoint: "sqs.us-gov-west-1.amazonaws.com"},
+		{region: "us-iso-east-1", id: "aws-iso", endpoint: "sqs.us-iso-east-1.c2s.ic.gov"},
+		{region: "us-isob-east-1", id: "aws-iso-b", endpoint: "sqs.us-isob-east-1.sc2s.sgov.gov"},
+	}
+	for _, g := range grid {
+		p := ForRegion(g.region)
+		if p.ID != g.id {
+			t.Errorf("expected partition %s for region %s, got %s", g.id, g.region, p.ID)
+		}
+		if endpoint := p.Endpoint("sqs", g.region); endpoint != g.endpoint {
+			t.Errorf("expected endpoint %s for region %s, got %s", g.endpoint, g.region, endpoint)
+		}
+		if ForID(p.ID) != p {
+			t.Errorf("expected partition %s to be found by its ID", p.ID)
+		}
+	}
+	if ForID("aws-test") != nil {
+		t.Errorf("expected unknown partition not to be found")
+	}
+}
+
+func TestARN(t *testing.T) {
+	actual := China.ARN("sqs", "cn-north-1", "123456789012", "queue")
+	if expected := "arn:aws-cn:sqs:cn-north-1:123456789012:queue"; actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
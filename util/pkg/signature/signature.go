@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Verifier verifies detached signatures made with one of the trusted keys,
+// in the format of cosign sign-blob: the base64 encoded signature of the SHA-256 hash of the data.
+type Verifier struct {
+	keys []crypto.PublicKey
+}
+
+// NewVerifier builds a Verifier trusting the PEM-encoded ECDSA, Ed25519 and RSA public keys.
+func NewVerifier(publicKeys []string) (*Verifier, error) {
+	v := &Verifier{}
+	for i, publicKey := range publicKeys {
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil || block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("public key %d is not a PEM-encoded PUBLIC KEY", i)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key %d: %w", i, err)
+		}
+		switch key.(type) {
+		case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+			v.keys = append(v.keys, key)
+		default:
+			return nil, fmt.Errorf("public key %d has unsupported type %T", i, key)
+		}
+	}
+	if len(v.keys) == 0 {
+		return nil, fmt.Errorf("no public keys were specified")
+	}
+	return v, nil
+}
+
+// Verify returns nil if the signature of the data was made with one of the trusted keys.
+// The signature can be base64 encoded or raw.
+func (v *Verifier) Verify(data []byte, signature []byte) error {
+	sig := signature
+	if decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature))); err == nil {
+		sig = decoded
+	}
+
+	digest := sha256.Sum256(data)
+	for _, key := range v.keys {
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, digest[:], sig) {
+				return nil
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, data, sig) {
+				return nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig) == nil {
+				return nil
+			}
+		}
+	}
+	return errors.New("the signature does not match any of the trusted keys")
+}
+
+// Checksums are the SHA-256 hashes of files, by file name
+type Checksums map[string]string
+
+// ParseChecksums parses a SHA256SUMS file, made of lines of a hex-encoded hash and a file name.
+func ParseChecksums(data []byte) (Checksums, error) {
+	checksums := make(Checksums)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || len(fields[0]) != 2*sha256.Size {
+			return nil, fmt.Errorf("unexpected line in checksums file: %q", line)
+		}
+		// A leading * marks files hashed in binary mode
+		checksums[path.Base(strings.TrimPrefix(fields[1], "*"))] = strings.ToLower(fields[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return checksums, nil
+}
+
+// Check returns nil if the file at the URL is listed with the hex-encoded SHA-256 hash.
+func (c Checksums) Check(url string, sha256Hex string) error {
+	name := path.Base(url)
+	expected, found := c[name]
+	if !found {
+		return fmt.Errorf("%q is not listed in the checksums file", name)
+	}
+	if !strings.EqualFold(expected, sha256Hex) {
+		return fmt.Errorf("the checksums file lists %q with hash %s, not %s", name, expected, sha256Hex)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+)
+
+func encodePublicKey(t *testing.T, key any) string {
+	b, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("error marshalling public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: b}))
+}
+
+func TestVerify(t *testing.T) {
+	data := []byte("kubelet binary")
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	digest := sha256.Sum256(data)
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	if err != nil {
+		t.Fatalf("error signing: %v", err)
+	}
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	edSig := ed25519.Sign(edPrivate, data)
+
+	verifier, err := NewVerifier([]string{encodePublicKey(t, &ecKey.PublicKey), encodePublicKey(t, edPublic)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		data      []byte
+		signature []byte
+		expectErr bool
+	}{
+		{
+			name:      "ecdsa base64",
+			data:      data,
+			signature: []byte(base64.StdEncoding.EncodeToString(ecSig) + "\n"),
+		},
+		{
+			name:      "ecdsa raw",
+			data:      data,
+			signature: ecSig,
+		},
+		{
+			name:      "ed25519",
+			data:      data,
+			signature: []byte(base64.StdEncoding.EncodeToString(edSig)),
+		},
+		{
+			name:      "tampered data",
+			data:      []byte("evil binary"),
+			signature: []byte(base64.StdEncoding.EncodeToString(ecSig)),
+			expectErr: true,
+		},
+		{
+			name:      "garbage signature",
+			data:      data,
+			signature: []byte("garbage"),
+			expectErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifier.Verify(tc.data, tc.signature)
+			if tc.expectErr && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewVerifierRejectsInvalidKeys(t *testing.T) {
+	for _, keys := range [][]string{nil, {"not a key"}, {"-----BEGIN PUBLIC KEY-----\nAAAA\n-----END PUBLIC KEY-----\n"}} {
+		if _, err := NewVerifier(keys); err == nil {
+			t.Errorf("expected error for keys %q", keys)
+		}
+	}
+}
+
+func TestChecksums(t *testing.T) {
+	hash := "8e5ba2a7ba5a6fb5a8f2a2b8a4e0b8b9a9f3a4f3b7c7f0a6b5d2e6c1a3b4d5e6"
+	checksums, err := ParseChecksums([]byte("# kubernetes\n" + hash + "  bin/linux/amd64/kubelet\n" + hash + " *kubectl\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := checksums.Check("https://dl.k8s.io/release/v1.33.0/bin/linux/amd64/kubelet", hash); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checksums.Check("https://example.com/kubectl", hash); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checksums.Check("https://example.com/kubelet", "00"+hash[2:]); err == nil {
+		t.Errorf("expected error for mismatched hash")
+	}
+	if err := checksums.Check("https://example.com/kube-proxy", hash); err == nil {
+		t.Errorf("expected error for unlisted file")
+	}
+
+	if _, err := ParseChecksums([]byte("abc kubelet\n")); err == nil {
+		t.Errorf("expected error for malformed line")
+	}
+}
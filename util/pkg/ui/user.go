@@ -100,3 +100,34 @@ func GetConfirm(c *ConfirmArgs) (bool, error) {
 		c.retryCount++
 	}
 }
+
+// ConfirmNameArgs encapsulates the arguments that can be passed to GetConfirmName
+type ConfirmNameArgs struct {
+	Out     io.Writer // os.Stdout or &bytes.Buffer used to output the message above the confirmation
+	Message string    // what you want to say to the user before confirming
+	Name    string    // the name the user must type to confirm, such as the name of the cluster
+	TestVal string    // if you need to test without the interactive prompt then set the user response here
+}
+
+// GetConfirmName prompts a user to type a name, guarding destructive operations against
+// acting on the wrong object. It returns true only if the typed name matches exactly.
+func GetConfirmName(c *ConfirmNameArgs) (bool, error) {
+	fmt.Fprintf(c.Out, "%s\nType the name %q to confirm: ", c.Message, c.Name)
+
+	response := c.TestVal
+
+	// only prompt user if no predefined answer was passed in
+	if response == "" {
+		var err error
+
+		reader := bufio.NewReader(os.Stdin)
+		response, err = reader.ReadString('\n')
+		if err != nil && !(err == io.EOF && response != "") {
+			return false, fmt.Errorf("error reading from input: %v", err)
+		}
+	} else {
+		fmt.Fprintln(c.Out)
+	}
+
+	return strings.TrimSpace(response) == c.Name, nil
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	smithyendpoints "github.com/aws/smithy-go/endpoints"
 	"k8s.io/klog/v2"
+	"k8s.io/kops/util/pkg/awspartition"
 )
 
 // matches regional naming conventions of S3:
@@ -215,8 +216,8 @@ func (s *S3Context) getDetailsForBucket(ctx context.Context, bucket string) (*S3
 	}
 
 	if len(response.LocationConstraint) == 0 {
-		// US Classic does not return a region
-		bucketDetails.region = "us-east-1"
+		// US Classic does not return a region, nor do the buckets in the default regions of the other partitions
+		bucketDetails.region = awspartition.ForRegion(awsRegion).DefaultRegion
 	} else {
 		bucketDetails.region = string(response.LocationConstraint)
 		// Another special case: "EU" can mean eu-west-1